@@ -97,6 +97,9 @@ import (
 	epochskeeper "github.com/NibiruChain/nibiru/x/epochs/keeper"
 	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
 	"github.com/NibiruChain/nibiru/x/genmsg"
+	"github.com/NibiruChain/nibiru/x/incentives"
+	incentiveskeeper "github.com/NibiruChain/nibiru/x/incentives/keeper"
+	incentivestypes "github.com/NibiruChain/nibiru/x/incentives/types"
 	"github.com/NibiruChain/nibiru/x/inflation"
 	inflationkeeper "github.com/NibiruChain/nibiru/x/inflation/keeper"
 	inflationtypes "github.com/NibiruChain/nibiru/x/inflation/types"
@@ -166,6 +169,7 @@ var (
 		stablecoin.AppModuleBasic{},
 		perpv2.AppModuleBasic{},
 		inflation.AppModuleBasic{},
+		incentives.AppModuleBasic{},
 		sudo.AppModuleBasic{},
 		wasm.AppModuleBasic{},
 		devgas.AppModuleBasic{},
@@ -195,8 +199,11 @@ var (
 		epochstypes.ModuleName:                {},
 		stablecointypes.StableEFModuleAccount: {authtypes.Burner},
 		sudotypes.ModuleName:                  {},
+		incentivestypes.ModuleName:            {},
+		inflationtypes.VestingModuleAccount:   {},
 		common.TreasuryPoolModuleAccount:      {},
 		wasm.ModuleName:                       {authtypes.Burner},
+		devgastypes.ModuleName:                {},
 	}
 )
 
@@ -273,6 +280,7 @@ type NibiruApp struct {
 	OracleKeeper     oraclekeeper.Keeper
 	StablecoinKeeper stablecoinkeeper.Keeper
 	InflationKeeper  inflationkeeper.Keeper
+	IncentivesKeeper incentiveskeeper.Keeper
 	SudoKeeper       keeper.Keeper
 	DevGasKeeper     devgaskeeper.Keeper
 
@@ -311,6 +319,7 @@ func GetWasmOpts(nibiru NibiruApp, appOpts servertypes.AppOptions) []wasm.Option
 		nibiru.PerpKeeperV2,
 		nibiru.SudoKeeper,
 		nibiru.OracleKeeper,
+		nibiru.SpotKeeper,
 	)...)
 
 	return wasmOpts
@@ -393,8 +402,16 @@ func NewNibiruApp(
 	if err != nil {
 		panic(fmt.Errorf("failed to create sdk.AnteHandler: %s", err))
 	}
+	postHandler, err := NewPostHandler(PostHandlerOptions{
+		DevGasKeeper:     &app.DevGasKeeper,
+		DevGasBankKeeper: app.BankKeeper,
+	})
+	if err != nil {
+		panic(fmt.Errorf("failed to create sdk.PostHandler: %s", err))
+	}
 
 	app.SetAnteHandler(anteHandler)
+	app.SetPostHandler(postHandler)
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
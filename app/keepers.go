@@ -83,6 +83,9 @@ import (
 	epochskeeper "github.com/NibiruChain/nibiru/x/epochs/keeper"
 	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
 	"github.com/NibiruChain/nibiru/x/genmsg"
+	"github.com/NibiruChain/nibiru/x/incentives"
+	incentiveskeeper "github.com/NibiruChain/nibiru/x/incentives/keeper"
+	incentivestypes "github.com/NibiruChain/nibiru/x/incentives/types"
 	"github.com/NibiruChain/nibiru/x/inflation"
 	inflationkeeper "github.com/NibiruChain/nibiru/x/inflation/keeper"
 	inflationtypes "github.com/NibiruChain/nibiru/x/inflation/types"
@@ -136,11 +139,12 @@ func initStoreKeys() (
 		epochstypes.StoreKey,
 		perpv2types.StoreKey,
 		inflationtypes.StoreKey,
+		incentivestypes.StoreKey,
 		sudotypes.StoreKey,
 		wasm.StoreKey,
 		devgastypes.StoreKey,
 	)
-	tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey, devgastypes.TStoreKey)
 	memKeys = sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey, stablecointypes.MemStoreKey)
 	return keys, tkeys, memKeys
 }
@@ -270,9 +274,11 @@ func (app *NibiruApp) InitKeepers(
 
 	// ---------------------------------- Nibiru Chain x/ keepers
 
+	govModuleAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
 	app.SpotKeeper = spotkeeper.NewKeeper(
 		appCodec, keys[spottypes.StoreKey], app.GetSubspace(spottypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.DistrKeeper)
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, govModuleAddr)
 
 	app.OracleKeeper = oraclekeeper.NewKeeper(appCodec, keys[oracletypes.StoreKey],
 		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.stakingKeeper, distrtypes.ModuleName,
@@ -285,7 +291,7 @@ func (app *NibiruApp) InitKeepers(
 	)
 
 	app.EpochsKeeper = epochskeeper.NewKeeper(
-		appCodec, keys[epochstypes.StoreKey],
+		appCodec, keys[epochstypes.StoreKey], govModuleAddr,
 	)
 
 	app.PerpKeeperV2 = perpv2keeper.NewKeeper(
@@ -295,20 +301,17 @@ func (app *NibiruApp) InitKeepers(
 
 	app.InflationKeeper = inflationkeeper.NewKeeper(
 		appCodec, keys[inflationtypes.StoreKey], app.GetSubspace(inflationtypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.stakingKeeper, authtypes.FeeCollectorName,
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.stakingKeeper, app.OracleKeeper,
+		app.EpochsKeeper, authtypes.FeeCollectorName, govModuleAddr,
 	)
 
-	app.SudoKeeper = keeper.NewKeeper(
-		appCodec, keys[sudotypes.StoreKey],
+	app.IncentivesKeeper = incentiveskeeper.NewKeeper(
+		appCodec, keys[incentivestypes.StoreKey],
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.SpotKeeper, app.EpochsKeeper, govModuleAddr,
 	)
 
-	app.EpochsKeeper.SetHooks(
-		epochstypes.NewMultiEpochHooks(
-			app.StablecoinKeeper.Hooks(),
-			app.PerpKeeperV2.Hooks(),
-			app.InflationKeeper.Hooks(),
-			app.OracleKeeper.Hooks(),
-		),
+	app.SudoKeeper = keeper.NewKeeper(
+		appCodec, keys[sudotypes.StoreKey],
 	)
 
 	// ---------------------------------- IBC keepers
@@ -371,9 +374,9 @@ func (app *NibiruApp) InitKeepers(
 	)
 
 	// DevGas uses WasmKeeper
-	govModuleAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
 	app.DevGasKeeper = devgaskeeper.NewKeeper(
 		keys[devgastypes.StoreKey],
+		tkeys[devgastypes.TStoreKey],
 		appCodec,
 		app.BankKeeper,
 		app.WasmKeeper,
@@ -382,6 +385,18 @@ func (app *NibiruApp) InitKeepers(
 		govModuleAddr,
 	)
 
+	app.EpochsKeeper.SetHooks(
+		epochstypes.NewMultiEpochHooks(
+			app.StablecoinKeeper.Hooks(),
+			app.PerpKeeperV2.Hooks(),
+			app.InflationKeeper.Hooks(),
+			app.IncentivesKeeper.Hooks(),
+			app.OracleKeeper.Hooks(),
+			app.SudoKeeper.Hooks(),
+			app.DevGasKeeper.Hooks(),
+		),
+	)
+
 	// register the proposal types
 
 	// Create evidence keeper.
@@ -510,6 +525,7 @@ func (app *NibiruApp) initAppModules(
 		epochs.NewAppModule(appCodec, app.EpochsKeeper),
 		perpv2.NewAppModule(appCodec, app.PerpKeeperV2, app.AccountKeeper, app.BankKeeper, app.OracleKeeper),
 		inflation.NewAppModule(app.InflationKeeper, app.AccountKeeper, *app.stakingKeeper),
+		incentives.NewAppModule(app.IncentivesKeeper),
 		sudo.NewAppModule(appCodec, app.SudoKeeper),
 		genmsg.NewAppModule(app.MsgServiceRouter()),
 
@@ -520,10 +536,13 @@ func (app *NibiruApp) initAppModules(
 		ibcfee.NewAppModule(app.ibcFeeKeeper),
 
 		// wasm
-		wasm.NewAppModule(
-			appCodec, &app.WasmKeeper, app.stakingKeeper, app.AccountKeeper,
-			app.BankKeeper, app.MsgServiceRouter(),
-			app.GetSubspace(wasmtypes.ModuleName)),
+		NewWasmModule(
+			wasm.NewAppModule(
+				appCodec, &app.WasmKeeper, app.stakingKeeper, app.AccountKeeper,
+				app.BankKeeper, app.MsgServiceRouter(),
+				app.GetSubspace(wasmtypes.ModuleName)),
+			&app.WasmKeeper, app.GetSubspace(wasmtypes.ModuleName),
+			app.DevGasKeeper),
 		devgas.NewAppModule(
 			app.DevGasKeeper, app.AccountKeeper,
 			app.GetSubspace(devgastypes.ModuleName)),
@@ -577,6 +596,7 @@ func orderedModuleNames() []string {
 		oracletypes.ModuleName,
 		perpv2types.ModuleName,
 		inflationtypes.ModuleName,
+		incentivestypes.ModuleName,
 		sudotypes.ModuleName,
 
 		// --------------------------------------------------------------------
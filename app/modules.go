@@ -3,8 +3,13 @@ package app
 import (
 	"encoding/json"
 
+	"github.com/CosmWasm/wasmd/x/wasm"
+	"github.com/CosmWasm/wasmd/x/wasm/exported"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
@@ -14,6 +19,8 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	devgaskeeper "github.com/NibiruChain/nibiru/x/devgas/v1/keeper"
 )
 
 // BankModule defines a custom wrapper around the x/bank module's AppModuleBasic
@@ -96,3 +103,43 @@ func NewGovModuleBasic(proposalHandlers ...govclient.ProposalHandler) GovModule
 		gov.NewAppModuleBasic(proposalHandlers),
 	}
 }
+
+// WasmModule defines a custom wrapper around the x/wasm module's AppModule
+// implementation to record the gas consumed by contract executions for the
+// x/devgas payouts.
+type WasmModule struct {
+	wasm.AppModule
+	keeper         *wasmkeeper.Keeper
+	legacySubspace exported.Subspace
+	devGasKeeper   devgaskeeper.Keeper
+}
+
+func NewWasmModule(
+	appModule wasm.AppModule,
+	keeper *wasmkeeper.Keeper,
+	legacySubspace exported.Subspace,
+	devGasKeeper devgaskeeper.Keeper,
+) WasmModule {
+	return WasmModule{
+		AppModule:      appModule,
+		keeper:         keeper,
+		legacySubspace: legacySubspace,
+		devGasKeeper:   devGasKeeper,
+	}
+}
+
+// RegisterServices registers the x/wasm services with the message server
+// wrapped by x/devgas.
+func (am WasmModule) RegisterServices(cfg module.Configurator) {
+	wasmtypes.RegisterMsgServer(cfg.MsgServer(), devgaskeeper.NewWasmMsgServer(
+		wasmkeeper.NewMsgServerImpl(am.keeper), am.devGasKeeper))
+	wasmtypes.RegisterQueryServer(cfg.QueryServer(), wasm.NewQuerier(am.keeper))
+
+	m := wasmkeeper.NewMigrator(*am.keeper, am.legacySubspace)
+	if err := cfg.RegisterMigration(wasmtypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(err)
+	}
+	if err := cfg.RegisterMigration(wasmtypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,37 @@
+package app
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+
+	devgasante "github.com/NibiruChain/nibiru/x/devgas/v1/ante"
+	devgaskeeper "github.com/NibiruChain/nibiru/x/devgas/v1/keeper"
+)
+
+type PostHandlerOptions struct {
+	DevGasKeeper     *devgaskeeper.Keeper
+	DevGasBankKeeper devgasante.BankKeeper
+}
+
+// NewPostHandler returns a PostHandler that pays the contract developers
+// their share of the transaction fees once the messages were executed.
+func NewPostHandler(options PostHandlerOptions) (sdk.PostHandler, error) {
+	if options.DevGasKeeper == nil {
+		return nil, PostHandlerError("devgas keeper")
+	}
+	if options.DevGasBankKeeper == nil {
+		return nil, PostHandlerError("devgas bank keeper")
+	}
+
+	postDecorators := []sdk.PostDecorator{
+		devgasante.NewDevGasPayoutDecorator(
+			options.DevGasBankKeeper, options.DevGasKeeper),
+	}
+
+	return sdk.ChainPostDecorators(postDecorators...), nil
+}
+
+func PostHandlerError(shortDesc string) error {
+	return sdkerrors.Wrapf(errors.ErrLogic, "%s is required for PostHandler", shortDesc)
+}
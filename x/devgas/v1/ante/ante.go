@@ -12,11 +12,20 @@ import (
 	devgastypes "github.com/NibiruChain/nibiru/x/devgas/v1/types"
 )
 
-var _ sdk.AnteDecorator = (*DevGasPayoutDecorator)(nil)
+var (
+	_ sdk.AnteDecorator = (*DevGasPayoutDecorator)(nil)
+	_ sdk.PostDecorator = (*DevGasPayoutDecorator)(nil)
+)
 
-// DevGasPayoutDecorator Run his after we already deduct the fee from the
-// account with the ante.NewDeductFeeDecorator() decorator. We pull funds from
-// the FeeCollector ModuleAccount
+// DevGasPayoutDecorator pays the contract developers their share of the
+// transaction fees, which were already deducted from the account with the
+// ante.NewDeductFeeDecorator() decorator. We pull funds from the FeeCollector
+// ModuleAccount.
+//
+// With EvenSplitPayouts the share is split evenly between the executed
+// contracts in the ante handler, so the developers are also paid for failed
+// transactions. Otherwise it is split by the gas each contract consumed in the
+// post handler, which only runs for successful transactions.
 type DevGasPayoutDecorator struct {
 	bankKeeper   BankKeeper
 	devgasKeeper IDevGasKeeper
@@ -39,14 +48,34 @@ func (a DevGasPayoutDecorator) AnteHandle(
 		return ctx, sdkerrors.ErrTxDecode.Wrap("Tx must be a FeeTx")
 	}
 
-	err = a.devGasPayout(
-		ctx, feeTx,
-	)
+	params := a.devgasKeeper.GetParams(ctx)
+	if params.EvenSplitPayouts {
+		if err := a.devGasPayout(ctx, feeTx, params); err != nil {
+			return ctx, sdkerrors.ErrInsufficientFunds.Wrap(err.Error())
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (a DevGasPayoutDecorator) PostHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler,
+) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.ErrTxDecode.Wrap("Tx must be a FeeTx")
+	}
+
+	params := a.devgasKeeper.GetParams(ctx)
+	if !params.EvenSplitPayouts {
+		err = a.devGasPayout(ctx, feeTx, params)
+	}
+	a.devgasKeeper.ClearContractGas(ctx)
 	if err != nil {
 		return ctx, sdkerrors.ErrInsufficientFunds.Wrap(err.Error())
 	}
 
-	return next(ctx, tx, simulate)
+	return next(ctx, tx, simulate, success)
 }
 
 // devGasPayout takes the total fees and redistributes 50% (or param set) to
@@ -54,13 +83,19 @@ func (a DevGasPayoutDecorator) AnteHandle(
 func (a DevGasPayoutDecorator) devGasPayout(
 	ctx sdk.Context,
 	tx sdk.FeeTx,
+	params devgastypes.ModuleParams,
 ) error {
-	params := a.devgasKeeper.GetParams(ctx)
 	if !params.EnableFeeShare {
 		return nil
 	}
 
-	toPay, err := a.getWithdrawAddressesFromMsgs(ctx, tx.GetMsgs())
+	var toPay []contractPayout
+	var err error
+	if params.EvenSplitPayouts {
+		toPay, err = a.getEvenPayoutsFromMsgs(ctx, tx.GetMsgs(), params, tx.GetFee())
+	} else {
+		toPay = a.getGasWeightedPayouts(ctx, params, tx.GetFee())
+	}
 	if err != nil {
 		return err
 	}
@@ -70,7 +105,7 @@ func (a DevGasPayoutDecorator) devGasPayout(
 		return nil
 	}
 
-	feesPaidOutput, err := a.settleFeePayments(ctx, toPay, params, tx.GetFee())
+	feesPaidOutput, err := a.settleFeePayments(ctx, toPay)
 	if err != nil {
 		return err
 	}
@@ -86,39 +121,120 @@ func (a DevGasPayoutDecorator) devGasPayout(
 }
 
 type FeeSharePayoutEventOutput struct {
+	Contract        sdk.AccAddress `json:"contract"`
 	WithdrawAddress sdk.AccAddress `json:"withdraw_address"`
 	FeesPaid        sdk.Coins      `json:"fees_paid"`
+	GasUsed         uint64         `json:"gas_used,omitempty"`
 }
 
-// settleFeePayments sends the funds to the contract developers
+// contractPayout is the share of the fees of a registered contract.
+type contractPayout struct {
+	feeShare devgastypes.FeeShare
+	fees     sdk.Coins
+	gasUsed  uint64
+}
+
+// settleFeePayments moves the funds of the contract developers from the
+// FeeCollector to the x/devgas module account, where they accrue until they are
+// withdrawn. The fees of each contract are split between its recipients by
+// weight.
 func (a DevGasPayoutDecorator) settleFeePayments(
-	ctx sdk.Context, toPay []sdk.AccAddress, params devgastypes.ModuleParams, totalFees sdk.Coins,
+	ctx sdk.Context, toPay []contractPayout,
 ) ([]FeeSharePayoutEventOutput, error) {
-	allowedFees := getAllowedFees(params, totalFees)
-
-	numPairs := len(toPay)
-	feesPaidOutput := make([]FeeSharePayoutEventOutput, numPairs)
-	if numPairs > 0 {
-		govPercent := params.DeveloperShares
-		splitFees := FeePayLogic(allowedFees, govPercent, numPairs)
-
-		// pay fees evenly between all withdraw addresses
-		for i, withdrawAddr := range toPay {
-			err := a.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, withdrawAddr, splitFees)
-			feesPaidOutput[i] = FeeSharePayoutEventOutput{
-				WithdrawAddress: withdrawAddr,
-				FeesPaid:        splitFees,
+	var feesPaidOutput []FeeSharePayoutEventOutput
+	totalFeesPaid := sdk.NewCoins()
+	for _, payout := range toPay {
+		contractAddr, err := sdk.AccAddressFromBech32(payout.feeShare.ContractAddress)
+		if err != nil {
+			continue
+		}
+		recipients := payout.feeShare.PayoutRecipients()
+		recipientFees := WeightedFeePayLogic(payout.fees, recipients)
+		for i, recipient := range recipients {
+			if recipientFees[i].IsZero() {
+				continue
 			}
-
+			withdrawAddr, err := sdk.AccAddressFromBech32(recipient.Address)
 			if err != nil {
-				return nil, devgastypes.ErrFeeSharePayment.Wrapf("failed to pay allowedFees to contract developer: %s", err.Error())
+				return nil, devgastypes.ErrFeeSharePayment.Wrapf("invalid recipient address: %s", err.Error())
 			}
+
+			totalFeesPaid = totalFeesPaid.Add(recipientFees[i]...)
+			feesPaidOutput = append(feesPaidOutput, FeeSharePayoutEventOutput{
+				Contract:        contractAddr,
+				WithdrawAddress: withdrawAddr,
+				FeesPaid:        recipientFees[i],
+				GasUsed:         payout.gasUsed,
+			})
 		}
 	}
+	if totalFeesPaid.IsZero() {
+		return feesPaidOutput, nil
+	}
+
+	err := a.bankKeeper.SendCoinsFromModuleToModule(
+		ctx, authtypes.FeeCollectorName, devgastypes.ModuleName, totalFeesPaid)
+	if err != nil {
+		return nil, devgastypes.ErrFeeSharePayment.Wrapf("failed to pay allowedFees to contract developer: %s", err.Error())
+	}
+	for _, output := range feesPaidOutput {
+		a.devgasKeeper.AccrueRewards(ctx, output.WithdrawAddress, output.FeesPaid)
+	}
 
 	return feesPaidOutput, nil
 }
 
+// getGasWeightedPayouts splits the developer shares between the registered
+// contracts proportionally to the gas they consumed in the transaction.
+func (a DevGasPayoutDecorator) getGasWeightedPayouts(
+	ctx sdk.Context, params devgastypes.ModuleParams, totalFees sdk.Coins,
+) []contractPayout {
+	var toPay []contractPayout
+	var gasUsed []uint64
+	for _, contractGas := range a.devgasKeeper.GetContractGas(ctx) {
+		contractAddr, err := sdk.AccAddressFromBech32(contractGas.Contract)
+		if err != nil {
+			continue
+		}
+		shareData, _ := a.devgasKeeper.GetFeeShare(ctx, contractAddr)
+
+		withdrawAddr := shareData.GetWithdrawerAddr()
+		if withdrawAddr != nil && !withdrawAddr.Empty() {
+			toPay = append(toPay, contractPayout{feeShare: shareData, gasUsed: contractGas.GasUsed})
+			gasUsed = append(gasUsed, contractGas.GasUsed)
+		}
+	}
+	if len(toPay) == 0 {
+		return nil
+	}
+
+	allowedFees := getAllowedFees(params, totalFees)
+	splitFees := GasWeightedFeePayLogic(allowedFees, params.DeveloperShares, gasUsed)
+	for i := range toPay {
+		toPay[i].fees = splitFees[i]
+	}
+	return toPay
+}
+
+// getEvenPayoutsFromMsgs splits the developer shares evenly between the
+// registered contracts executed by the messages.
+func (a DevGasPayoutDecorator) getEvenPayoutsFromMsgs(
+	ctx sdk.Context, msgs []sdk.Msg, params devgastypes.ModuleParams, totalFees sdk.Coins,
+) ([]contractPayout, error) {
+	feeShares, err := a.getFeeSharesFromMsgs(ctx, msgs)
+	if err != nil || len(feeShares) == 0 {
+		return nil, err
+	}
+
+	allowedFees := getAllowedFees(params, totalFees)
+	splitFees := FeePayLogic(allowedFees, params.DeveloperShares, len(feeShares))
+	toPay := make([]contractPayout, len(feeShares))
+	for i, feeShare := range feeShares {
+		toPay[i] = contractPayout{feeShare: feeShare, fees: splitFees}
+	}
+	return toPay, nil
+}
+
 // getAllowedFees gets the allowed fees to be paid based on the module
 // parameters of x/devgas
 func getAllowedFees(params devgastypes.ModuleParams, totalFees sdk.Coins) sdk.Coins {
@@ -140,10 +256,10 @@ func getAllowedFees(params devgastypes.ModuleParams, totalFees sdk.Coins) sdk.Co
 	return allowedFees
 }
 
-// getWithdrawAddressesFromMsgs returns a list of all contract addresses that
-// have opted-in to receiving payments
-func (a DevGasPayoutDecorator) getWithdrawAddressesFromMsgs(ctx sdk.Context, msgs []sdk.Msg) ([]sdk.AccAddress, error) {
-	toPay := make([]sdk.AccAddress, 0)
+// getFeeSharesFromMsgs returns the fee shares of all contracts that have
+// opted-in to receiving payments
+func (a DevGasPayoutDecorator) getFeeSharesFromMsgs(ctx sdk.Context, msgs []sdk.Msg) ([]devgastypes.FeeShare, error) {
+	toPay := make([]devgastypes.FeeShare, 0)
 	for _, msg := range msgs {
 		if _, ok := msg.(*wasmtypes.MsgExecuteContract); ok {
 			contractAddr, err := sdk.AccAddressFromBech32(
@@ -157,7 +273,7 @@ func (a DevGasPayoutDecorator) getWithdrawAddressesFromMsgs(ctx sdk.Context, msg
 
 			withdrawAddr := shareData.GetWithdrawerAddr()
 			if withdrawAddr != nil && !withdrawAddr.Empty() {
-				toPay = append(toPay, withdrawAddr)
+				toPay = append(toPay, shareData)
 			}
 		}
 	}
@@ -179,3 +295,54 @@ func FeePayLogic(fees sdk.Coins, govPercent sdk.Dec, numPairs int) sdk.Coins {
 
 	return splitFees
 }
+
+// GasWeightedFeePayLogic takes the total fees and splits the governance
+// percentage of them between the contracts proportionally to the gas each one
+// used. The amounts are rounded down. tested in ante_test.go
+func GasWeightedFeePayLogic(fees sdk.Coins, govPercent sdk.Dec, gasUsed []uint64) []sdk.Coins {
+	var devFees sdk.Coins
+	for _, c := range fees.Sort() {
+		rewardAmount := govPercent.MulInt(c.Amount).TruncateInt()
+		if !rewardAmount.IsZero() {
+			devFees = devFees.Add(sdk.NewCoin(c.Denom, rewardAmount))
+		}
+	}
+
+	return splitByWeight(devFees, gasUsed)
+}
+
+// WeightedFeePayLogic splits the fees of a contract between its recipients
+// proportionally to their weights. The amounts are rounded down, so the dust
+// stays in the fee collector. tested in ante_test.go
+func WeightedFeePayLogic(fees sdk.Coins, recipients []devgastypes.Recipient) []sdk.Coins {
+	weights := make([]uint64, len(recipients))
+	for i, recipient := range recipients {
+		weights[i] = recipient.Weight
+	}
+
+	return splitByWeight(fees, weights)
+}
+
+// splitByWeight splits the fees proportionally to the weights, rounding down.
+// Nothing is split if all the weights are zero.
+func splitByWeight(fees sdk.Coins, weights []uint64) []sdk.Coins {
+	totalWeight := sdk.ZeroInt()
+	for _, weight := range weights {
+		totalWeight = totalWeight.Add(sdk.NewIntFromUint64(weight))
+	}
+
+	splitFees := make([]sdk.Coins, len(weights))
+	if totalWeight.IsZero() {
+		return splitFees
+	}
+	for i, weight := range weights {
+		for _, c := range fees.Sort() {
+			amount := c.Amount.Mul(sdk.NewIntFromUint64(weight)).Quo(totalWeight)
+			if !amount.IsZero() {
+				splitFees[i] = splitFees[i].Add(sdk.NewCoin(c.Denom, amount))
+			}
+		}
+	}
+
+	return splitFees
+}
@@ -124,6 +124,247 @@ func (suite *AnteTestSuite) TestFeeLogic() {
 	}
 }
 
+func (suite *AnteTestSuite) TestWeightedFeeLogic() {
+	_, addrs := testutil.PrivKeyAddressPairs(3)
+	fees := sdk.NewCoins(sdk.NewCoin("unibi", sdk.NewInt(500)), sdk.NewCoin("utoken", sdk.NewInt(250)))
+
+	testCases := []struct {
+		name       string
+		recipients []devgastypes.Recipient
+		want       []sdk.Coins
+	}{
+		{
+			name:       "single recipient gets everything",
+			recipients: []devgastypes.Recipient{{Address: addrs[0].String(), Weight: 1}},
+			want:       []sdk.Coins{fees},
+		},
+		{
+			name: "1:3 split",
+			recipients: []devgastypes.Recipient{
+				{Address: addrs[0].String(), Weight: 1},
+				{Address: addrs[1].String(), Weight: 3},
+			},
+			want: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin("unibi", sdk.NewInt(125)), sdk.NewCoin("utoken", sdk.NewInt(62))),
+				sdk.NewCoins(sdk.NewCoin("unibi", sdk.NewInt(375)), sdk.NewCoin("utoken", sdk.NewInt(187))),
+			},
+		},
+		{
+			name: "dust is rounded down",
+			recipients: []devgastypes.Recipient{
+				{Address: addrs[0].String(), Weight: 1},
+				{Address: addrs[1].String(), Weight: 1},
+				{Address: addrs[2].String(), Weight: 1_000},
+			},
+			want: []sdk.Coins{
+				sdk.NewCoins(),
+				sdk.NewCoins(),
+				sdk.NewCoins(sdk.NewCoin("unibi", sdk.NewInt(499)), sdk.NewCoin("utoken", sdk.NewInt(249))),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		got := devgasante.WeightedFeePayLogic(fees, tc.recipients)
+		suite.Require().Len(got, len(tc.want), tc.name)
+		for i := range got {
+			suite.Require().True(tc.want[i].IsEqual(got[i]), "%s: want %s, got %s", tc.name, tc.want[i], got[i])
+		}
+	}
+}
+
+func (suite *AnteTestSuite) TestGasWeightedFeeLogic() {
+	fees := sdk.NewCoins(sdk.NewCoin("unibi", sdk.NewInt(1_000)), sdk.NewCoin("utoken", sdk.NewInt(99)))
+
+	testCases := []struct {
+		name       string
+		govPercent sdk.Dec
+		gasUsed    []uint64
+		want       []sdk.Coins
+	}{
+		{
+			name:       "50% fee / 1 contract",
+			govPercent: sdk.NewDecWithPrec(50, 2),
+			gasUsed:    []uint64{123},
+			want: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin("unibi", sdk.NewInt(500)), sdk.NewCoin("utoken", sdk.NewInt(49))),
+			},
+		},
+		{
+			name:       "50% fee / 1:4 gas",
+			govPercent: sdk.NewDecWithPrec(50, 2),
+			gasUsed:    []uint64{20_000, 80_000},
+			want: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin("unibi", sdk.NewInt(100)), sdk.NewCoin("utoken", sdk.NewInt(9))),
+				sdk.NewCoins(sdk.NewCoin("unibi", sdk.NewInt(400)), sdk.NewCoin("utoken", sdk.NewInt(39))),
+			},
+		},
+		{
+			name:       "no gas used",
+			govPercent: sdk.NewDecWithPrec(50, 2),
+			gasUsed:    []uint64{0, 0},
+			want:       []sdk.Coins{sdk.NewCoins(), sdk.NewCoins()},
+		},
+	}
+
+	for _, tc := range testCases {
+		got := devgasante.GasWeightedFeePayLogic(fees, tc.govPercent, tc.gasUsed)
+		suite.Require().Len(got, len(tc.want), tc.name)
+		for i := range got {
+			suite.Require().True(tc.want[i].IsEqual(got[i]), "%s: want %s, got %s", tc.name, tc.want[i], got[i])
+		}
+	}
+}
+
+func (suite *AnteTestSuite) TestDevGasPayoutGasWeighted() {
+	txGasCoins := sdk.NewCoins(sdk.NewCoin("unibi", sdk.NewInt(1_000)))
+
+	_, addrs := testutil.PrivKeyAddressPairs(6)
+	contracts, withdrawers, deployer := addrs[:3], addrs[3:5], addrs[5]
+
+	bapp, ctx := testapp.NewNibiruTestAppAndContext()
+	ctx = ctx.WithChainID("mock-chain-id")
+	suite.NoError(testapp.FundModuleAccount(
+		bapp.BankKeeper, ctx, authtypes.FeeCollectorName, txGasCoins))
+	for i, withdrawer := range withdrawers {
+		bapp.DevGasKeeper.SetFeeShare(ctx, devgastypes.FeeShare{
+			ContractAddress:   contracts[i].String(),
+			DeployerAddress:   deployer.String(),
+			WithdrawerAddress: withdrawer.String(),
+		})
+	}
+
+	// the third contract is not registered, so its gas is ignored
+	bapp.DevGasKeeper.RecordContractGas(ctx, contracts[0].String(), 30_000)
+	bapp.DevGasKeeper.RecordContractGas(ctx, contracts[1].String(), 10_000)
+	bapp.DevGasKeeper.RecordContractGas(ctx, contracts[0].String(), 10_000)
+	bapp.DevGasKeeper.RecordContractGas(ctx, contracts[2].String(), 1_000_000)
+
+	encCfg := app.MakeEncodingConfigAndRegister()
+	txBuilder, err := sdkclienttx.Factory{}.
+		WithFees(txGasCoins.String()).
+		WithChainID(ctx.ChainID()).
+		WithTxConfig(encCfg.TxConfig).
+		BuildUnsignedTx(&wasmtypes.MsgExecuteContract{Contract: contracts[0].String()})
+	suite.NoError(err)
+
+	payoutDecorator := devgasante.NewDevGasPayoutDecorator(bapp.BankKeeper, bapp.DevGasKeeper)
+
+	// the ante handler only pays out the even split
+	ctx, err = payoutDecorator.AnteHandle(
+		ctx, txBuilder.GetTx(), false,
+		func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil },
+	)
+	suite.NoError(err)
+	suite.True(bapp.DevGasKeeper.GetRewards(ctx, withdrawers[0]).IsZero())
+
+	ctx, err = payoutDecorator.PostHandle(
+		ctx, txBuilder.GetTx(), false, true,
+		func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) { return ctx, nil },
+	)
+	suite.NoError(err)
+
+	// 50% of the fees are split 4:1 by gas between the registered contracts
+	suite.Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("unibi", 400)),
+		bapp.DevGasKeeper.GetRewards(ctx, withdrawers[0]),
+	)
+	suite.Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("unibi", 100)),
+		bapp.DevGasKeeper.GetRewards(ctx, withdrawers[1]),
+	)
+	suite.Empty(bapp.DevGasKeeper.GetContractGas(ctx))
+}
+
+func (suite *AnteTestSuite) TestDevGasPayoutEvenSplitPostHandle() {
+	txGasCoins := sdk.NewCoins(sdk.NewCoin("unibi", sdk.NewInt(1_000)))
+
+	_, addrs := testutil.PrivKeyAddressPairs(3)
+	contract, deployer, withdrawer := addrs[0], addrs[1], addrs[2]
+
+	bapp, ctx := testapp.NewNibiruTestAppAndContext()
+	ctx = ctx.WithChainID("mock-chain-id")
+	suite.NoError(testapp.FundModuleAccount(
+		bapp.BankKeeper, ctx, authtypes.FeeCollectorName, txGasCoins))
+	bapp.DevGasKeeper.SetFeeShare(ctx, devgastypes.FeeShare{
+		ContractAddress:   contract.String(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	})
+	params := bapp.DevGasKeeper.GetParams(ctx)
+	params.EvenSplitPayouts = true
+	bapp.DevGasKeeper.ModuleParams.Set(ctx, params)
+
+	encCfg := app.MakeEncodingConfigAndRegister()
+	txBuilder, err := sdkclienttx.Factory{}.
+		WithFees(txGasCoins.String()).
+		WithChainID(ctx.ChainID()).
+		WithTxConfig(encCfg.TxConfig).
+		BuildUnsignedTx(&wasmtypes.MsgExecuteContract{Contract: contract.String()})
+	suite.NoError(err)
+
+	bapp.DevGasKeeper.RecordContractGas(ctx, contract.String(), 10_000)
+
+	// the even split was already paid out by the ante handler, so the post
+	// handler only clears the recorded gas
+	postDecorator := devgasante.NewDevGasPayoutDecorator(bapp.BankKeeper, bapp.DevGasKeeper)
+	ctx, err = postDecorator.PostHandle(
+		ctx, txBuilder.GetTx(), false, true,
+		func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) { return ctx, nil },
+	)
+	suite.NoError(err)
+	suite.True(bapp.DevGasKeeper.GetRewards(ctx, withdrawer).IsZero())
+	suite.Empty(bapp.DevGasKeeper.GetContractGas(ctx))
+}
+
+func (suite *AnteTestSuite) TestDevGasPayoutRecipients() {
+	txGasCoins := sdk.NewCoins(sdk.NewCoin("unibi", sdk.NewInt(1_000)))
+
+	_, addrs := testutil.PrivKeyAddressPairs(4)
+	contract, deployer, recipientA, recipientB := addrs[0], addrs[1], addrs[2], addrs[3]
+
+	bapp, ctx := testapp.NewNibiruTestAppAndContext()
+	ctx = ctx.WithChainID("mock-chain-id")
+	suite.NoError(testapp.FundModuleAccount(
+		bapp.BankKeeper, ctx, authtypes.FeeCollectorName, txGasCoins))
+	bapp.DevGasKeeper.SetFeeShare(ctx, devgastypes.FeeShare{
+		ContractAddress:   contract.String(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: recipientA.String(),
+		Recipients: []devgastypes.Recipient{
+			{Address: recipientA.String(), Weight: 1},
+			{Address: recipientB.String(), Weight: 4},
+		},
+	})
+
+	encCfg := app.MakeEncodingConfigAndRegister()
+	txBuilder, err := sdkclienttx.Factory{}.
+		WithFees(txGasCoins.String()).
+		WithChainID(ctx.ChainID()).
+		WithTxConfig(encCfg.TxConfig).
+		BuildUnsignedTx(&wasmtypes.MsgExecuteContract{Contract: contract.String()})
+	suite.NoError(err)
+
+	bapp.DevGasKeeper.RecordContractGas(ctx, contract.String(), 10_000)
+
+	postDecorator := devgasante.NewDevGasPayoutDecorator(bapp.BankKeeper, bapp.DevGasKeeper)
+	ctx, err = postDecorator.PostHandle(
+		ctx, txBuilder.GetTx(), true, true,
+		func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) { return ctx, nil },
+	)
+	suite.NoError(err)
+
+	// 50% of the fees go to the contract, split 1:4 between the recipients
+	suite.Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("unibi", 100)),
+		bapp.DevGasKeeper.GetRewards(ctx, recipientA),
+	)
+	suite.Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("unibi", 400)),
+		bapp.DevGasKeeper.GetRewards(ctx, recipientB),
+	)
+}
+
 func (suite *AnteTestSuite) TestDevGasPayout() {
 	txGasCoins := sdk.NewCoins(
 		sdk.NewCoin("unibi", sdk.NewInt(1_000)),
@@ -240,6 +481,9 @@ func (suite *AnteTestSuite) TestDevGasPayout() {
 			anteDecorator := devgasante.NewDevGasPayoutDecorator(
 				bapp.BankKeeper, bapp.DevGasKeeper,
 			)
+			params := bapp.DevGasKeeper.GetParams(ctx)
+			params.EvenSplitPayouts = true
+			bapp.DevGasKeeper.ModuleParams.Set(ctx, params)
 
 			t.Log("set dev gas state based on test case")
 			for _, devGas := range tc.devGasState {
@@ -271,7 +515,7 @@ func (suite *AnteTestSuite) TestDevGasPayout() {
 
 			t.Log("tc withdrawers should have the expected funds")
 			for _, devGas := range tc.devGasState {
-				withdrawerCoins := bapp.BankKeeper.SpendableCoins(
+				withdrawerCoins := bapp.DevGasKeeper.GetRewards(
 					ctx, devGas.GetWithdrawerAddr(),
 				)
 				wantWithdrawerRoyalties := tc.wantWithdrawerRoyalties.Sub(
@@ -1,6 +1,6 @@
 package ante
 
-// Interfaces needed for the for the Nibiru Chain ante handler
+// Interfaces needed for the for the Nibiru Chain ante and post handlers
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,9 +17,15 @@ type BankKeeper interface {
 		ctx sdk.Context, senderModule string,
 		recipientAddr sdk.AccAddress, amt sdk.Coins,
 	) error
+	SendCoinsFromModuleToModule(
+		ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins,
+	) error
 }
 
 type IDevGasKeeper interface {
 	GetParams(ctx sdk.Context) devgastypes.ModuleParams
 	GetFeeShare(ctx sdk.Context, contract sdk.Address) (devgastypes.FeeShare, bool)
+	GetContractGas(ctx sdk.Context) []devgastypes.ContractGas
+	ClearContractGas(ctx sdk.Context)
+	AccrueRewards(ctx sdk.Context, withdrawer sdk.AccAddress, fees sdk.Coins)
 }
@@ -28,6 +28,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryFeeShare(),
 		GetCmdQueryParams(),
 		GetCmdQueryFeeSharesByWithdrawer(),
+		GetCmdQueryRewards(),
 	)
 
 	return feesQueryCmd
@@ -178,3 +179,39 @@ func GetCmdQueryFeeSharesByWithdrawer() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryRewards implements a command to return the transaction fees
+// accrued by a withdrawer and not yet paid out
+func GetCmdQueryRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rewards [withdraw_address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the dev gas rewards accrued by a withdrawer address",
+		Long:    "Query the dev gas rewards accrued by a withdrawer address and not yet paid out",
+		Example: fmt.Sprintf("%s query %s rewards <withdrawer-address>", version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRewardsRequest{
+				WithdrawerAddress: args[0],
+			}
+			if err := req.ValidateBasic(); err != nil {
+				return err
+			}
+
+			res, err := queryClient.Rewards(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -28,6 +30,7 @@ func NewTxCmd() *cobra.Command {
 		NewRegisterFeeShare(),
 		NewCancelFeeShare(),
 		NewUpdateFeeShare(),
+		NewWithdrawDevGasRewards(),
 	)
 	return txCmd
 }
@@ -51,10 +54,16 @@ func NewRegisterFeeShare() *cobra.Command {
 			contract := args[0]
 			withdrawer := args[1]
 
+			recipients, err := parseRecipients(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgRegisterFeeShare{
 				ContractAddress:   contract,
 				DeployerAddress:   deployer.String(),
 				WithdrawerAddress: withdrawer,
+				Recipients:        recipients,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -65,6 +74,7 @@ func NewRegisterFeeShare() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagRecipients, "", "weighted fee recipients, e.g. nibi1...:1,nibi1...:3")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -127,10 +137,16 @@ func NewUpdateFeeShare() *cobra.Command {
 
 			withdrawer := args[1]
 
+			recipients, err := parseRecipients(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgUpdateFeeShare{
 				ContractAddress:   contract,
 				DeployerAddress:   deployer.String(),
 				WithdrawerAddress: withdrawer,
+				Recipients:        recipients,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -141,6 +157,63 @@ func NewUpdateFeeShare() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagRecipients, "", "weighted fee recipients, e.g. nibi1...:1,nibi1...:3")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewWithdrawDevGasRewards returns a CLI command handler for withdrawing the
+// transaction fees accrued by the sender
+func NewWithdrawDevGasRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-rewards",
+		Short: "Withdraw the dev gas rewards accrued by the sender.",
+		Long:  "Withdraw the transaction fees accrued by the sender as the withdrawer or a recipient of registered contracts.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWithdrawDevGasRewards{
+				WithdrawerAddress: cliCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// FlagRecipients is the flag of the weighted fee recipients of a contract.
+const FlagRecipients = "recipients"
+
+// parseRecipients parses the recipients flag, formatted as a comma separated
+// list of address:weight pairs.
+func parseRecipients(cmd *cobra.Command) ([]types.Recipient, error) {
+	recipientsStr, err := cmd.Flags().GetString(FlagRecipients)
+	if err != nil || recipientsStr == "" {
+		return nil, err
+	}
+
+	var recipients []types.Recipient
+	for _, recipientStr := range strings.Split(recipientsStr, ",") {
+		parts := strings.Split(strings.TrimSpace(recipientStr), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid recipient %q, expected address:weight", recipientStr)
+		}
+		weight, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight of recipient %q: %w", recipientStr, err)
+		}
+		recipients = append(recipients, types.Recipient{Address: parts[0], Weight: weight})
+	}
+	return recipients, nil
+}
@@ -25,6 +25,10 @@ func InitGenesis(
 		// Set initial contracts receiving transaction fees
 		k.SetFeeShare(ctx, share)
 	}
+
+	for _, rewards := range data.Rewards {
+		k.Rewards.Insert(ctx, rewards.Withdrawer, rewards)
+	}
 }
 
 // ExportGenesis export module state
@@ -32,5 +36,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
 		Params:   k.GetParams(ctx),
 		FeeShare: k.DevGasStore.Iterate(ctx, collections.Range[string]{}).Values(),
+		Rewards:  k.Rewards.Iterate(ctx, collections.Range[string]{}).Values(),
 	}
 }
@@ -100,6 +100,36 @@ func (s *GenesisTestSuite) TestGenesis() {
 			},
 			expPanic: false,
 		},
+		{
+			name: "custom genesis - accrued rewards, auto flush",
+			genesis: devgastypes.GenesisState{
+				Params: devgastypes.ModuleParams{
+					EnableFeeShare:       true,
+					DeveloperShares:      sdk.NewDecWithPrec(50, 2),
+					AllowedDenoms:        []string{"unibi"},
+					AutoFlushThreshold:   sdk.NewCoins(sdk.NewInt64Coin("unibi", 1_000)),
+					FlushEpochIdentifier: "week",
+				},
+				Rewards: []devgastypes.DevGasRewards{
+					{
+						Withdrawer: randomAddr,
+						Rewards:    sdk.NewCoins(sdk.NewInt64Coin("unibi", 10)),
+					},
+				},
+			},
+			expPanic: false,
+		},
+		{
+			name: "invalid genesis - duplicate rewards",
+			genesis: devgastypes.GenesisState{
+				Params: devgastypes.DefaultParams(),
+				Rewards: []devgastypes.DevGasRewards{
+					{Withdrawer: randomAddr, Rewards: sdk.NewCoins(sdk.NewInt64Coin("unibi", 10))},
+					{Withdrawer: randomAddr, Rewards: sdk.NewCoins(sdk.NewInt64Coin("unibi", 20))},
+				},
+			},
+			expPanic: true,
+		},
 		{
 			name:     "empty genesis",
 			genesis:  devgastypes.GenesisState{},
@@ -121,10 +151,11 @@ func (s *GenesisTestSuite) TestGenesis() {
 				})
 
 				params := s.app.DevGasKeeper.GetParams(s.ctx)
-				s.Require().EqualValues(tc.genesis.Params, params)
+				s.Require().EqualValues(tc.genesis.Params.Sanitize(), params)
 
 				gen := devgas.ExportGenesis(s.ctx, s.app.DevGasKeeper)
 				s.NoError(gen.Validate())
+				s.Require().Len(gen.Rewards, len(tc.genesis.Rewards))
 			}
 		})
 	}
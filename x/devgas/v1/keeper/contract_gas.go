@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/devgas/v1/types"
+)
+
+// RecordContractGas adds the gas consumed by an execution of the contract to
+// the gas recorded in the current transaction.
+func (k Keeper) RecordContractGas(ctx sdk.Context, contract string, gasUsed uint64) {
+	ctx = gasFreeCtx(ctx)
+	k.ContractGas.Insert(ctx, contract, k.ContractGas.GetOr(ctx, contract, 0)+gasUsed)
+	k.ContractGasTotal.Set(ctx, k.ContractGasTotal.GetOr(ctx, 0)+gasUsed)
+}
+
+// GetContractGas returns the gas consumed by every contract executed in the
+// current transaction, sorted by contract address.
+func (k Keeper) GetContractGas(ctx sdk.Context) []types.ContractGas {
+	ctx = gasFreeCtx(ctx)
+	var contractGas []types.ContractGas
+	for _, kv := range k.ContractGas.Iterate(ctx, collections.Range[string]{}).KeyValues() {
+		contractGas = append(contractGas, types.ContractGas{
+			Contract: kv.Key,
+			GasUsed:  kv.Value,
+		})
+	}
+	return contractGas
+}
+
+// ClearContractGas forgets the gas recorded in the current transaction. The
+// transient store is only reset at the end of the block, so the payouts must
+// clear it after every transaction.
+func (k Keeper) ClearContractGas(ctx sdk.Context) {
+	ctx = gasFreeCtx(ctx)
+	if k.ContractGasTotal.GetOr(ctx, 0) == 0 {
+		return
+	}
+	for _, contract := range k.ContractGas.Iterate(ctx, collections.Range[string]{}).Keys() {
+		_ = k.ContractGas.Delete(ctx, contract)
+	}
+	k.ContractGasTotal.Set(ctx, 0)
+}
+
+// GetContractGasTotal returns the total gas recorded in the current
+// transaction.
+func (k Keeper) GetContractGasTotal(ctx sdk.Context) uint64 {
+	return k.ContractGasTotal.GetOr(gasFreeCtx(ctx), 0)
+}
+
+// gasFreeCtx returns a context that does not charge the gas of the transient
+// bookkeeping to the transaction, so that it does not skew the recorded gas.
+func gasFreeCtx(ctx sdk.Context) sdk.Context {
+	return ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+}
+
+var _ wasmtypes.MsgServer = (*wasmMsgServer)(nil)
+
+// wasmMsgServer wraps the x/wasm message server to record the gas consumed by
+// the executed contracts.
+type wasmMsgServer struct {
+	wasmtypes.MsgServer
+	keeper Keeper
+}
+
+// NewWasmMsgServer returns a x/wasm message server that records the gas
+// consumed by every contract execution, including the ones dispatched by other
+// contracts, for the gas-weighted payouts.
+func NewWasmMsgServer(msgServer wasmtypes.MsgServer, k Keeper) wasmtypes.MsgServer {
+	return &wasmMsgServer{MsgServer: msgServer, keeper: k}
+}
+
+// ExecuteContract records the gas consumed by the contract itself, which
+// excludes the gas of the contracts that it executed.
+func (s wasmMsgServer) ExecuteContract(
+	goCtx context.Context, msg *wasmtypes.MsgExecuteContract,
+) (*wasmtypes.MsgExecuteContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	gasBefore := ctx.GasMeter().GasConsumed()
+	nestedGasBefore := s.keeper.GetContractGasTotal(ctx)
+
+	resp, err := s.MsgServer.ExecuteContract(goCtx, msg)
+	if err != nil {
+		return resp, err
+	}
+
+	gasUsed := ctx.GasMeter().GasConsumed() - gasBefore
+	nestedGas := s.keeper.GetContractGasTotal(ctx) - nestedGasBefore
+	if nestedGas > gasUsed {
+		nestedGas = gasUsed
+	}
+	s.keeper.RecordContractGas(ctx, msg.Contract, gasUsed-nestedGas)
+	return resp, nil
+}
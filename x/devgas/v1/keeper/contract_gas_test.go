@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *IntegrationTestSuite) TestRecordContractGas() {
+	_, _, sender := testdata.KeyTestPubAddr()
+	_ = s.FundAccount(s.ctx, sender, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1_000_000))))
+	contractAddress := s.InstantiateContract(sender.String(), "")
+	s.app.DevGasKeeper.ClearContractGas(s.ctx)
+
+	s.Run("execution is recorded", func() {
+		msg := &wasmtypes.MsgExecuteContract{
+			Sender:   sender.String(),
+			Contract: contractAddress,
+			Msg:      []byte(fmt.Sprintf(`{"change_owner":{"owner":%q}}`, sender.String())),
+		}
+		gasBefore := s.ctx.GasMeter().GasConsumed()
+		_, err := s.app.MsgServiceRouter().Handler(msg)(s.ctx, msg)
+		s.Require().NoError(err)
+
+		contractGas := s.app.DevGasKeeper.GetContractGas(s.ctx)
+		s.Require().Len(contractGas, 1)
+		s.Equal(contractAddress, contractGas[0].Contract)
+		s.Positive(contractGas[0].GasUsed)
+		s.LessOrEqual(contractGas[0].GasUsed, s.ctx.GasMeter().GasConsumed()-gasBefore)
+	})
+
+	s.Run("failed execution is not recorded", func() {
+		s.app.DevGasKeeper.ClearContractGas(s.ctx)
+		msg := &wasmtypes.MsgExecuteContract{
+			Sender:   sender.String(),
+			Contract: contractAddress,
+			Msg:      []byte(`{"unknown":{}}`),
+		}
+		_, err := s.app.MsgServiceRouter().Handler(msg)(s.ctx, msg)
+		s.Require().Error(err)
+		s.Empty(s.app.DevGasKeeper.GetContractGas(s.ctx))
+	})
+
+	s.Run("executions accumulate and are cleared", func() {
+		s.app.DevGasKeeper.RecordContractGas(s.ctx, contractAddress, 10)
+		s.app.DevGasKeeper.RecordContractGas(s.ctx, contractAddress, 5)
+		contractGas := s.app.DevGasKeeper.GetContractGas(s.ctx)
+		s.Require().Len(contractGas, 1)
+		s.EqualValues(15, contractGas[0].GasUsed)
+		s.EqualValues(15, s.app.DevGasKeeper.GetContractGasTotal(s.ctx))
+
+		s.app.DevGasKeeper.ClearContractGas(s.ctx)
+		s.Empty(s.app.DevGasKeeper.GetContractGas(s.ctx))
+		s.EqualValues(0, s.app.DevGasKeeper.GetContractGasTotal(s.ctx))
+	})
+}
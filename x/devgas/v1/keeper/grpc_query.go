@@ -98,3 +98,26 @@ func (q Querier) FeeSharesByWithdrawer( // nolint: dupl
 		Feeshare: q.DevGasStore.Collect(ctx, iter),
 	}, nil
 }
+
+// Rewards returns the transaction fees accrued by a withdrawer and not yet
+// paid out
+func (q Querier) Rewards(
+	goCtx context.Context,
+	req *types.QueryRewardsRequest,
+) (*types.QueryRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	withdrawer, err := sdk.AccAddressFromBech32(req.WithdrawerAddress)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for withdraw addr %s, should be bech32 ('nibi...')", req.WithdrawerAddress,
+		)
+	}
+
+	return &types.QueryRewardsResponse{Rewards: q.GetRewards(ctx, withdrawer)}, nil
+}
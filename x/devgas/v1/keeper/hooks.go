@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
+)
+
+// Hooks wrapper struct for devgas keeper.
+type Hooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks Return the wrapper struct.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// BeforeEpochStart epochs hooks.
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
+}
+
+// AfterEpochEnd epochs hooks. Pays out the accrued rewards at the end of the
+// flush epoch.
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
+	flushEpoch := h.k.GetParams(ctx).FlushEpochIdentifier
+	if flushEpoch != "" && flushEpoch == epochIdentifier {
+		h.k.FlushAllRewards(ctx)
+	}
+}
@@ -19,8 +19,9 @@ import (
 // Keeper of this module maintains collections of feeshares for contracts
 // registered to receive Nibiru Chain gas fees.
 type Keeper struct {
-	storeKey storetypes.StoreKey
-	cdc      codec.BinaryCodec
+	storeKey  storetypes.StoreKey
+	tStoreKey storetypes.StoreKey
+	cdc       codec.BinaryCodec
 
 	bankKeeper    devgastypes.BankKeeper
 	wasmKeeper    wasmkeeper.Keeper
@@ -37,6 +38,19 @@ type Keeper struct {
 
 	ModuleParams collections.Item[devgastypes.ModuleParams]
 
+	// Rewards: map from withdrawer address to the transaction fees it accrued
+	// and that were not paid out yet. The fees are held by the module account.
+	Rewards collections.Map[string, devgastypes.DevGasRewards]
+	// FlushCursor: the last withdrawer whose rewards were flushed at the end
+	// of an epoch. The next epoch resumes after it.
+	FlushCursor collections.Item[sdk.AccAddress]
+
+	// ContractGas: transient map from contract address to the gas consumed by
+	// its executions in the current transaction.
+	ContractGas collections.Map[string, uint64]
+	// ContractGasTotal: transient sum of the values of ContractGas.
+	ContractGasTotal collections.Item[uint64]
+
 	// the address capable of executing a MsgUpdateParams message. Typically,
 	// this should be the x/gov module account.
 	authority string
@@ -45,6 +59,7 @@ type Keeper struct {
 // NewKeeper creates new instances of the fees Keeper
 func NewKeeper(
 	storeKey storetypes.StoreKey,
+	tStoreKey storetypes.StoreKey,
 	cdc codec.BinaryCodec,
 	bk devgastypes.BankKeeper,
 	wk wasmkeeper.Keeper,
@@ -54,6 +69,7 @@ func NewKeeper(
 ) Keeper {
 	return Keeper{
 		storeKey:         storeKey,
+		tStoreKey:        tStoreKey,
 		cdc:              cdc,
 		bankKeeper:       bk,
 		wasmKeeper:       wk,
@@ -65,6 +81,23 @@ func NewKeeper(
 			storeKey, devgastypes.KeyPrefixParams,
 			collections.ProtoValueEncoder[devgastypes.ModuleParams](cdc),
 		),
+		Rewards: collections.NewMap(
+			storeKey, devgastypes.KeyPrefixRewards,
+			collections.StringKeyEncoder,
+			collections.ProtoValueEncoder[devgastypes.DevGasRewards](cdc),
+		),
+		FlushCursor: collections.NewItem(
+			storeKey, devgastypes.KeyPrefixFlushCursor,
+			collections.AccAddressValueEncoder,
+		),
+		ContractGas: collections.NewMap(
+			tStoreKey, devgastypes.KeyPrefixContractGas,
+			collections.StringKeyEncoder, collections.Uint64ValueEncoder,
+		),
+		ContractGasTotal: collections.NewItem(
+			tStoreKey, devgastypes.KeyPrefixContractGasTotal,
+			collections.Uint64ValueEncoder,
+		),
 	}
 }
 
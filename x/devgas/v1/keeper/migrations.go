@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 keeps the even split of the developer shares between the
+// executed contracts on existing chains, which the stored params would
+// otherwise switch to the gas-weighted split, and disables the auto flush.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.EvenSplitPayouts = true
+	params.AutoFlushThreshold = sdk.Coins{}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	m.keeper.ModuleParams.Set(ctx, params)
+	return nil
+}
@@ -153,6 +153,12 @@ func (k Keeper) RegisterFeeShare(
 				msg.WithdrawerAddress, msg.ContractAddress,
 			)
 		}
+		if len(msg.Recipients) > 0 {
+			return nil, types.ErrFeeShareInvalidRecipients.Wrapf(
+				"a factory contract cannot split its fees between recipients, contract: %s",
+				msg.ContractAddress,
+			)
+		}
 
 		// set the deployer address to the contract address so it can self register
 		msg.DeployerAddress = msg.ContractAddress
@@ -171,6 +177,7 @@ func (k Keeper) RegisterFeeShare(
 
 	// prevent storing the same address for deployer and withdrawer
 	feeshare := types.NewFeeShare(contract, deployer, withdrawer)
+	feeshare.Recipients = msg.Recipients
 	k.SetFeeShare(ctx, feeshare)
 
 	k.Logger(ctx).Debug(
@@ -185,13 +192,14 @@ func (k Keeper) RegisterFeeShare(
 			Deployer:   msg.DeployerAddress,
 			Contract:   msg.ContractAddress,
 			Withdrawer: msg.WithdrawerAddress,
+			Recipients: msg.Recipients,
 		},
 	)
 }
 
-// UpdateFeeShare updates the withdraw address of a given FeeShare. If the given
-// withdraw address is empty or the same as the deployer address, the withdraw
-// address is removed.
+// UpdateFeeShare updates the withdraw address and the recipients of a given
+// FeeShare. If the given withdraw address is empty or the same as the deployer
+// address, the withdraw address is removed.
 func (k Keeper) UpdateFeeShare(
 	goCtx context.Context,
 	msg *types.MsgUpdateFeeShare,
@@ -218,8 +226,10 @@ func (k Keeper) UpdateFeeShare(
 			)
 	}
 
-	// feeshare with the given withdraw address is already registered
-	if msg.WithdrawerAddress == feeshare.WithdrawerAddress {
+	// feeshare with the given withdraw address and recipients is already
+	// registered
+	if msg.WithdrawerAddress == feeshare.WithdrawerAddress &&
+		types.RecipientsEqual(msg.Recipients, feeshare.Recipients) {
 		return nil, types.ErrFeeShareAlreadyRegistered.Wrapf(
 			"feeshare with withdraw address %s is already registered", msg.WithdrawerAddress,
 		)
@@ -238,8 +248,9 @@ func (k Keeper) UpdateFeeShare(
 		)
 	}
 
-	// update feeshare with new withdrawer
+	// update feeshare with new withdrawer and recipients
 	feeshare.WithdrawerAddress = newWithdrawAddr.String()
+	feeshare.Recipients = msg.Recipients
 	k.SetFeeShare(ctx, feeshare)
 
 	return &types.MsgUpdateFeeShareResponse{}, ctx.EventManager().EmitTypedEvent(
@@ -247,6 +258,7 @@ func (k Keeper) UpdateFeeShare(
 			Deployer:   msg.DeployerAddress,
 			Contract:   msg.ContractAddress,
 			Withdrawer: msg.WithdrawerAddress,
+			Recipients: msg.Recipients,
 		},
 	)
 }
@@ -309,3 +321,23 @@ func (k Keeper) UpdateParams(
 
 	return &types.MsgUpdateParamsResponse{}, err
 }
+
+// WithdrawDevGasRewards pays out the transaction fees accrued by the
+// withdrawer.
+func (k Keeper) WithdrawDevGasRewards(
+	goCtx context.Context, msg *types.MsgWithdrawDevGasRewards,
+) (*types.MsgWithdrawDevGasRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	withdrawer, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid withdrawer address %s", msg.WithdrawerAddress)
+	}
+
+	rewards, err := k.WithdrawRewards(ctx, withdrawer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawDevGasRewardsResponse{Rewards: rewards}, nil
+}
@@ -326,6 +326,34 @@ func (s *IntegrationTestSuite) TestUpdateFeeShare() {
 			resp:      &types.MsgCancelFeeShareResponse{},
 			shouldErr: false,
 		},
+		{
+			desc: "Success - same withdrawer, new recipients",
+			msg: &types.MsgUpdateFeeShare{
+				ContractAddress:   contractAddress,
+				DeployerAddress:   sender.String(),
+				WithdrawerAddress: newWithdrawer.String(),
+				Recipients: []types.Recipient{
+					{Address: withdrawer.String(), Weight: 1},
+					{Address: newWithdrawer.String(), Weight: 2},
+				},
+			},
+			resp:      &types.MsgCancelFeeShareResponse{},
+			shouldErr: false,
+		},
+		{
+			desc: "Invalid - recipients not change",
+			msg: &types.MsgUpdateFeeShare{
+				ContractAddress:   contractAddress,
+				DeployerAddress:   sender.String(),
+				WithdrawerAddress: newWithdrawer.String(),
+				Recipients: []types.Recipient{
+					{Address: withdrawer.String(), Weight: 1},
+					{Address: newWithdrawer.String(), Weight: 2},
+				},
+			},
+			resp:      nil,
+			shouldErr: true,
+		},
 	} {
 		tc := tc
 		s.Run(tc.desc, func() {
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/devgas/v1/types"
+)
+
+// GetRewards returns the transaction fees accrued by the withdrawer and not
+// yet paid out.
+func (k Keeper) GetRewards(ctx sdk.Context, withdrawer sdk.AccAddress) sdk.Coins {
+	rewards, err := k.Rewards.Get(ctx, withdrawer.String())
+	if err != nil {
+		return sdk.NewCoins()
+	}
+	return rewards.Rewards
+}
+
+// AccrueRewards adds the fees to the rewards of the withdrawer. The fees must
+// already be held by the module account. The rewards are paid out right away
+// if they reach the auto flush threshold.
+func (k Keeper) AccrueRewards(ctx sdk.Context, withdrawer sdk.AccAddress, fees sdk.Coins) {
+	rewards := k.GetRewards(ctx, withdrawer).Add(fees...)
+	k.Rewards.Insert(ctx, withdrawer.String(), types.DevGasRewards{
+		Withdrawer: withdrawer.String(),
+		Rewards:    rewards,
+	})
+
+	if k.GetParams(ctx).ShouldAutoFlush(rewards) {
+		k.flushRewards(ctx, withdrawer)
+	}
+}
+
+// WithdrawRewards pays out the rewards accrued by the withdrawer.
+func (k Keeper) WithdrawRewards(ctx sdk.Context, withdrawer sdk.AccAddress) (sdk.Coins, error) {
+	return k.payoutRewards(ctx, withdrawer, false)
+}
+
+// MaxRewardFlushesPerEpoch is the maximum number of withdrawers whose rewards
+// are paid out at the end of an epoch.
+const MaxRewardFlushesPerEpoch = 500
+
+// FlushAllRewards pays out the rewards accrued by up to
+// MaxRewardFlushesPerEpoch withdrawers, resuming after the last withdrawer
+// flushed in the previous epoch and wrapping around to the first one. The
+// withdrawers that cannot receive their rewards keep them.
+func (k Keeper) FlushAllRewards(ctx sdk.Context) {
+	for _, withdrawer := range k.nextWithdrawersToFlush(ctx, MaxRewardFlushesPerEpoch) {
+		addr, err := sdk.AccAddressFromBech32(withdrawer)
+		if err != nil {
+			continue
+		}
+		k.flushRewards(ctx, addr)
+		k.FlushCursor.Set(ctx, addr)
+	}
+}
+
+// nextWithdrawersToFlush returns up to limit withdrawers with accrued rewards,
+// starting after the flush cursor and wrapping around to the first one.
+func (k Keeper) nextWithdrawersToFlush(ctx sdk.Context, limit int) []string {
+	cursorAddr, err := k.FlushCursor.Get(ctx)
+	if err != nil {
+		return collectKeys(k.Rewards.Iterate(ctx, collections.Range[string]{}), limit)
+	}
+	cursor := cursorAddr.String()
+
+	withdrawers := collectKeys(
+		k.Rewards.Iterate(ctx, collections.Range[string]{}.StartExclusive(cursor)), limit)
+	return append(withdrawers, collectKeys(
+		k.Rewards.Iterate(ctx, collections.Range[string]{}.EndInclusive(cursor)),
+		limit-len(withdrawers))...)
+}
+
+// collectKeys returns up to limit keys of the iterator and closes it.
+func collectKeys[V any](iter collections.Iterator[string, V], limit int) []string {
+	defer iter.Close()
+	var keys []string
+	for ; iter.Valid() && len(keys) < limit; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	return keys
+}
+
+// flushRewards pays out the rewards of the withdrawer automatically. If the
+// payout fails, the rewards stay accrued.
+func (k Keeper) flushRewards(ctx sdk.Context, withdrawer sdk.AccAddress) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if _, err := k.payoutRewards(cacheCtx, withdrawer, true); err != nil {
+		k.Logger(ctx).Error("failed to flush devgas rewards", "withdrawer", withdrawer, "error", err)
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+func (k Keeper) payoutRewards(
+	ctx sdk.Context, withdrawer sdk.AccAddress, autoFlush bool,
+) (sdk.Coins, error) {
+	rewards := k.GetRewards(ctx, withdrawer)
+	if rewards.IsZero() {
+		return nil, types.ErrNoDevGasRewards.Wrapf("withdrawer %s", withdrawer)
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawer, rewards)
+	if err != nil {
+		return nil, types.ErrFeeSharePayment.Wrapf("failed to pay out devgas rewards: %s", err.Error())
+	}
+	if err := k.Rewards.Delete(ctx, withdrawer.String()); err != nil {
+		return nil, err
+	}
+
+	return rewards, ctx.EventManager().EmitTypedEvent(&types.EventWithdrawDevGasRewards{
+		Withdrawer: withdrawer.String(),
+		Rewards:    rewards,
+		AutoFlush:  autoFlush,
+	})
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	devgaskeeper "github.com/NibiruChain/nibiru/x/devgas/v1/keeper"
+	devgastypes "github.com/NibiruChain/nibiru/x/devgas/v1/types"
+)
+
+func (s *IntegrationTestSuite) TestRewards() {
+	s.SetupTest()
+	withdrawer := testutil.AccAddress()
+	fees := sdk.NewCoins(sdk.NewInt64Coin("unibi", 100))
+	s.Require().NoError(testapp.FundModuleAccount(
+		s.app.BankKeeper, s.ctx, devgastypes.ModuleName, fees.Add(fees...)))
+
+	s.Run("accrue without auto flush", func() {
+		s.app.DevGasKeeper.AccrueRewards(s.ctx, withdrawer, fees)
+		s.app.DevGasKeeper.AccrueRewards(s.ctx, withdrawer, fees)
+		s.Equal(fees.Add(fees...), s.app.DevGasKeeper.GetRewards(s.ctx, withdrawer))
+		s.True(s.app.BankKeeper.GetAllBalances(s.ctx, withdrawer).IsZero())
+
+		resp, err := s.queryClient.Rewards(sdk.WrapSDKContext(s.ctx), &devgastypes.QueryRewardsRequest{
+			WithdrawerAddress: withdrawer.String(),
+		})
+		s.Require().NoError(err)
+		s.Equal(fees.Add(fees...), resp.Rewards)
+	})
+
+	s.Run("withdraw", func() {
+		resp, err := s.devgasMsgServer.WithdrawDevGasRewards(
+			sdk.WrapSDKContext(s.ctx), &devgastypes.MsgWithdrawDevGasRewards{
+				WithdrawerAddress: withdrawer.String(),
+			})
+		s.Require().NoError(err)
+		s.Equal(fees.Add(fees...), resp.Rewards)
+		s.Equal(fees.Add(fees...), s.app.BankKeeper.GetAllBalances(s.ctx, withdrawer))
+		s.True(s.app.DevGasKeeper.GetRewards(s.ctx, withdrawer).IsZero())
+	})
+
+	s.Run("withdraw without rewards fails", func() {
+		_, err := s.devgasMsgServer.WithdrawDevGasRewards(
+			sdk.WrapSDKContext(s.ctx), &devgastypes.MsgWithdrawDevGasRewards{
+				WithdrawerAddress: withdrawer.String(),
+			})
+		s.ErrorIs(err, devgastypes.ErrNoDevGasRewards)
+	})
+}
+
+func (s *IntegrationTestSuite) TestRewardsAutoFlush() {
+	s.SetupTest()
+	withdrawer := testutil.AccAddress()
+	fees := sdk.NewCoins(sdk.NewInt64Coin("unibi", 100))
+	s.Require().NoError(testapp.FundModuleAccount(
+		s.app.BankKeeper, s.ctx, devgastypes.ModuleName, fees.Add(fees...)))
+
+	params := s.app.DevGasKeeper.GetParams(s.ctx)
+	params.AutoFlushThreshold = sdk.NewCoins(sdk.NewInt64Coin("unibi", 150))
+	s.app.DevGasKeeper.ModuleParams.Set(s.ctx, params)
+
+	s.app.DevGasKeeper.AccrueRewards(s.ctx, withdrawer, fees)
+	s.Equal(fees, s.app.DevGasKeeper.GetRewards(s.ctx, withdrawer))
+
+	s.app.DevGasKeeper.AccrueRewards(s.ctx, withdrawer, fees)
+	s.True(s.app.DevGasKeeper.GetRewards(s.ctx, withdrawer).IsZero())
+	s.Equal(fees.Add(fees...), s.app.BankKeeper.GetAllBalances(s.ctx, withdrawer))
+}
+
+func (s *IntegrationTestSuite) TestRewardsEpochFlush() {
+	s.SetupTest()
+	withdrawers := []sdk.AccAddress{testutil.AccAddress(), testutil.AccAddress()}
+	fees := sdk.NewCoins(sdk.NewInt64Coin("unibi", 100))
+	s.Require().NoError(testapp.FundModuleAccount(
+		s.app.BankKeeper, s.ctx, devgastypes.ModuleName, fees.Add(fees...)))
+	for _, withdrawer := range withdrawers {
+		s.app.DevGasKeeper.AccrueRewards(s.ctx, withdrawer, fees)
+	}
+
+	params := s.app.DevGasKeeper.GetParams(s.ctx)
+	params.FlushEpochIdentifier = "week"
+	s.app.DevGasKeeper.ModuleParams.Set(s.ctx, params)
+
+	s.T().Log("other epochs do not flush")
+	s.app.DevGasKeeper.Hooks().AfterEpochEnd(s.ctx, "day", 1)
+	for _, withdrawer := range withdrawers {
+		s.Equal(fees, s.app.DevGasKeeper.GetRewards(s.ctx, withdrawer))
+	}
+
+	s.T().Log("the flush epoch pays out every withdrawer")
+	s.app.DevGasKeeper.Hooks().AfterEpochEnd(s.ctx, "week", 1)
+	for _, withdrawer := range withdrawers {
+		s.True(s.app.DevGasKeeper.GetRewards(s.ctx, withdrawer).IsZero())
+		s.Equal(fees, s.app.BankKeeper.GetAllBalances(s.ctx, withdrawer))
+	}
+}
+
+func (s *IntegrationTestSuite) TestRewardsEpochFlushPaging() {
+	s.SetupTest()
+	fees := sdk.NewCoins(sdk.NewInt64Coin("unibi", 100))
+	numWithdrawers := devgaskeeper.MaxRewardFlushesPerEpoch + 2
+	withdrawers := make([]sdk.AccAddress, numWithdrawers)
+	for i := range withdrawers {
+		withdrawers[i] = testutil.AccAddress()
+	}
+	s.Require().NoError(testapp.FundModuleAccount(
+		s.app.BankKeeper, s.ctx, devgastypes.ModuleName,
+		sdk.NewCoins(sdk.NewInt64Coin("unibi", 100*int64(numWithdrawers)))))
+	for _, withdrawer := range withdrawers {
+		s.app.DevGasKeeper.AccrueRewards(s.ctx, withdrawer, fees)
+	}
+
+	countAccrued := func() (count int) {
+		for _, withdrawer := range withdrawers {
+			if !s.app.DevGasKeeper.GetRewards(s.ctx, withdrawer).IsZero() {
+				count++
+			}
+		}
+		return count
+	}
+
+	s.T().Log("an epoch flushes at most MaxRewardFlushesPerEpoch withdrawers")
+	s.app.DevGasKeeper.FlushAllRewards(s.ctx)
+	s.Equal(2, countAccrued())
+
+	s.T().Log("the next epoch resumes after the last flushed withdrawer")
+	s.app.DevGasKeeper.FlushAllRewards(s.ctx)
+	s.Equal(0, countAccrued())
+
+	s.T().Log("it wraps around to the first withdrawer")
+	s.app.DevGasKeeper.AccrueRewards(s.ctx, withdrawers[0], fees)
+	s.Require().NoError(testapp.FundModuleAccount(
+		s.app.BankKeeper, s.ctx, devgastypes.ModuleName, fees))
+	s.app.DevGasKeeper.FlushAllRewards(s.ctx)
+	s.Equal(0, countAccrued())
+}
+
+func (s *IntegrationTestSuite) TestMigrate2to3() {
+	s.SetupTest()
+	params := s.app.DevGasKeeper.GetParams(s.ctx)
+	params.EvenSplitPayouts = false
+	params.AutoFlushThreshold = nil
+	s.app.DevGasKeeper.ModuleParams.Set(s.ctx, params)
+
+	s.Require().NoError(devgaskeeper.NewMigrator(s.app.DevGasKeeper).Migrate2to3(s.ctx))
+
+	params = s.app.DevGasKeeper.GetParams(s.ctx)
+	s.True(params.EvenSplitPayouts)
+	s.Equal(sdk.Coins{}, params.AutoFlushThreshold)
+}
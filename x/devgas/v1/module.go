@@ -30,7 +30,7 @@ var (
 )
 
 // ConsensusVersion defines the current module consensus version.
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 // AppModuleBasic type for the fees module
 type AppModuleBasic struct{}
@@ -143,6 +143,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	devgastypes.RegisterQueryServer(
 		cfg.QueryServer(), devgaskeeper.NewQuerier(am.keeper),
 	)
+
+	m := devgaskeeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(devgastypes.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", devgastypes.ModuleName, err))
+	}
 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the fees module.
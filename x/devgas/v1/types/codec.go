@@ -28,6 +28,7 @@ const (
 	registerFeeShareName = "/nibiru/MsgRegisterFeeShare"
 	updateFeeShareName   = "nibiru/MsgUpdateFeeShare"
 	updateFeeShareParams = "nibiru/MsgUpdateParams"
+	withdrawRewardsName  = "nibiru/MsgWithdrawDevGasRewards"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -50,6 +51,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgCancelFeeShare{},
 		&MsgUpdateFeeShare{},
 		&MsgUpdateParams{},
+		&MsgWithdrawDevGasRewards{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -63,4 +65,5 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterFeeShare{}, registerFeeShareName, nil)
 	cdc.RegisterConcrete(&MsgUpdateFeeShare{}, updateFeeShareName, nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateFeeShareParams, nil)
+	cdc.RegisterConcrete(&MsgWithdrawDevGasRewards{}, withdrawRewardsName, nil)
 }
@@ -23,11 +23,12 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 	RegisterInterfaces(registry)
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
-	suite.Require().Equal(4, len(impls))
+	suite.Require().Equal(5, len(impls))
 	suite.Require().ElementsMatch([]string{
 		"/nibiru.devgas.v1.MsgRegisterFeeShare",
 		"/nibiru.devgas.v1.MsgCancelFeeShare",
 		"/nibiru.devgas.v1.MsgUpdateFeeShare",
 		"/nibiru.devgas.v1.MsgUpdateParams",
+		"/nibiru.devgas.v1.MsgWithdrawDevGasRewards",
 	}, impls)
 }
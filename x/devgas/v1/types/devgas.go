@@ -7,6 +7,10 @@ import (
 	sdkerror "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// MaxRecipients is the maximum number of recipients of the transaction fees of
+// a contract. It bounds the number of payouts of the ante handler.
+const MaxRecipients = 10
+
 // NewFeeShare returns an instance of FeeShare.
 func NewFeeShare(contract sdk.Address, deployer, withdrawer sdk.AccAddress) FeeShare {
 	return FeeShare{
@@ -62,5 +66,71 @@ func (fs FeeShare) Validate() error {
 		return err
 	}
 
+	return ValidateRecipients(fs.Recipients)
+}
+
+// PayoutRecipients returns the recipients of the transaction fees of the
+// contract. If no recipients are set, the withdrawer receives all of them.
+func (fs FeeShare) PayoutRecipients() []Recipient {
+	if len(fs.Recipients) > 0 {
+		return fs.Recipients
+	}
+	return []Recipient{{Address: fs.WithdrawerAddress, Weight: 1}}
+}
+
+// ValidateRecipients checks that the recipients have valid and distinct
+// addresses and positive weights.
+func ValidateRecipients(recipients []Recipient) error {
+	if len(recipients) > MaxRecipients {
+		return ErrFeeShareInvalidRecipients.Wrapf(
+			"%d recipients, expected at most %d", len(recipients), MaxRecipients)
+	}
+
+	seen := make(map[string]bool)
+	for _, recipient := range recipients {
+		if _, err := sdk.AccAddressFromBech32(recipient.Address); err != nil {
+			return ErrFeeShareInvalidRecipients.Wrapf(
+				"invalid recipient address %s: %s", recipient.Address, err)
+		}
+		if seen[recipient.Address] {
+			return ErrFeeShareInvalidRecipients.Wrapf("duplicate recipient %s", recipient.Address)
+		}
+		seen[recipient.Address] = true
+
+		if recipient.Weight == 0 {
+			return ErrFeeShareInvalidRecipients.Wrapf("recipient %s has a zero weight", recipient.Address)
+		}
+	}
+	return nil
+}
+
+// RecipientsEqual returns true if both lists have the same recipients with the
+// same weights in the same order.
+func RecipientsEqual(a, b []Recipient) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ContractGas is the gas consumed by a contract in a transaction.
+type ContractGas struct {
+	Contract string
+	GasUsed  uint64
+}
+
+// Validate performs a stateless validation of the accrued rewards.
+func (r DevGasRewards) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Withdrawer); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdrawer address of rewards %s", r.Withdrawer)
+	}
+	if err := r.Rewards.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "invalid rewards of withdrawer %s", r.Withdrawer)
+	}
 	return nil
 }
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -34,6 +37,9 @@ type FeeShare struct {
 	// withdrawer_address is the bech32 address of account receiving the
 	// transaction fees.
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// recipients split the transaction fees by weight. If empty, the withdrawer
+	// receives all of them.
+	Recipients []Recipient `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients"`
 }
 
 func (m *FeeShare) Reset()         { *m = FeeShare{} }
@@ -90,28 +96,160 @@ func (m *FeeShare) GetWithdrawerAddress() string {
 	return ""
 }
 
+func (m *FeeShare) GetRecipients() []Recipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// Recipient is an account receiving a weighted part of the transaction fees of
+// a contract.
+type Recipient struct {
+	// address is the bech32 address of the recipient
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight of the recipient, relative to the sum of the weights of all the
+	// recipients of the contract
+	Weight uint64 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *Recipient) Reset()         { *m = Recipient{} }
+func (m *Recipient) String() string { return proto.CompactTextString(m) }
+func (*Recipient) ProtoMessage()    {}
+func (*Recipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71dc4524d1e4ffb, []int{1}
+}
+func (m *Recipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Recipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Recipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Recipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Recipient.Merge(m, src)
+}
+func (m *Recipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *Recipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_Recipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Recipient proto.InternalMessageInfo
+
+func (m *Recipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Recipient) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// DevGasRewards are the transaction fees accrued by a withdrawer and not yet
+// paid out.
+type DevGasRewards struct {
+	// withdrawer is the bech32 address of the account that accrued the rewards
+	Withdrawer string `protobuf:"bytes,1,opt,name=withdrawer,proto3" json:"withdrawer,omitempty"`
+	// rewards accrued by the withdrawer
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *DevGasRewards) Reset()         { *m = DevGasRewards{} }
+func (m *DevGasRewards) String() string { return proto.CompactTextString(m) }
+func (*DevGasRewards) ProtoMessage()    {}
+func (*DevGasRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f71dc4524d1e4ffb, []int{2}
+}
+func (m *DevGasRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DevGasRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DevGasRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DevGasRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DevGasRewards.Merge(m, src)
+}
+func (m *DevGasRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *DevGasRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_DevGasRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DevGasRewards proto.InternalMessageInfo
+
+func (m *DevGasRewards) GetWithdrawer() string {
+	if m != nil {
+		return m.Withdrawer
+	}
+	return ""
+}
+
+func (m *DevGasRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*FeeShare)(nil), "nibiru.devgas.v1.FeeShare")
+	proto.RegisterType((*Recipient)(nil), "nibiru.devgas.v1.Recipient")
+	proto.RegisterType((*DevGasRewards)(nil), "nibiru.devgas.v1.DevGasRewards")
 }
 
 func init() { proto.RegisterFile("nibiru/devgas/v1/devgas.proto", fileDescriptor_f71dc4524d1e4ffb) }
 
 var fileDescriptor_f71dc4524d1e4ffb = []byte{
-	// 210 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0xcb, 0x4c, 0xca,
-	0x2c, 0x2a, 0xd5, 0x4f, 0x49, 0x2d, 0x4b, 0x4f, 0x2c, 0xd6, 0x2f, 0x33, 0x84, 0xb2, 0xf4, 0x0a,
-	0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0x20, 0xd2, 0x7a, 0x50, 0xc1, 0x32, 0x43, 0xa5, 0x7e, 0x46,
-	0x2e, 0x0e, 0xb7, 0xd4, 0xd4, 0xe0, 0x8c, 0xc4, 0xa2, 0x54, 0x21, 0x4d, 0x2e, 0x81, 0xe4, 0xfc,
-	0xbc, 0x92, 0xa2, 0xc4, 0xe4, 0x92, 0xf8, 0xc4, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0x62, 0x09, 0x46,
-	0x05, 0x46, 0x0d, 0xce, 0x20, 0x7e, 0x98, 0xb8, 0x23, 0x44, 0x18, 0xa4, 0x34, 0x25, 0xb5, 0x20,
-	0x27, 0xbf, 0x32, 0xb5, 0x08, 0xae, 0x94, 0x09, 0xa2, 0x14, 0x26, 0x0e, 0x53, 0xaa, 0xcb, 0x25,
-	0x54, 0x9e, 0x59, 0x92, 0x91, 0x52, 0x94, 0x58, 0x8e, 0xa4, 0x98, 0x19, 0xac, 0x58, 0x10, 0x21,
-	0x03, 0x55, 0xee, 0xe4, 0x79, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9,
-	0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xfa,
-	0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x7e, 0x60, 0x8f, 0x38, 0x67,
-	0x24, 0x66, 0xe6, 0xe9, 0x43, 0xfd, 0x5c, 0x81, 0xe4, 0xeb, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24,
-	0x36, 0xb0, 0xaf, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2b, 0xbb, 0x00, 0xe8, 0x16, 0x01,
-	0x00, 0x00,
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xb1, 0xce, 0xd3, 0x30,
+	0x14, 0x85, 0x93, 0xbf, 0x55, 0x4b, 0x8d, 0x10, 0x25, 0x42, 0x28, 0x14, 0xe1, 0x56, 0x9d, 0xca,
+	0x50, 0x9b, 0xc0, 0xcc, 0xd0, 0x16, 0x81, 0x58, 0x18, 0xc2, 0xc6, 0x82, 0x9c, 0xe4, 0x2a, 0xb1,
+	0xa0, 0x71, 0x64, 0xbb, 0x09, 0x7d, 0x0b, 0x26, 0x1e, 0x82, 0x27, 0xe9, 0x58, 0x31, 0x31, 0x01,
+	0x6a, 0x5f, 0x04, 0xc5, 0x4e, 0xda, 0xf0, 0x4f, 0xb6, 0xcf, 0xf9, 0x7c, 0x75, 0x7d, 0x7c, 0xd1,
+	0xd3, 0x9c, 0x47, 0x5c, 0xee, 0x68, 0x02, 0x65, 0xca, 0x14, 0x2d, 0x83, 0x66, 0x47, 0x0a, 0x29,
+	0xb4, 0xf0, 0xc6, 0xd6, 0x26, 0x8d, 0x58, 0x06, 0x93, 0x87, 0xa9, 0x48, 0x85, 0x31, 0x69, 0xbd,
+	0xb3, 0xdc, 0x04, 0xc7, 0x42, 0x6d, 0x85, 0xa2, 0x11, 0x53, 0x40, 0xcb, 0x20, 0x02, 0xcd, 0x02,
+	0x1a, 0x0b, 0x9e, 0x5b, 0x7f, 0xfe, 0xd3, 0x45, 0x77, 0xde, 0x00, 0x7c, 0xc8, 0x98, 0x04, 0xef,
+	0x19, 0x1a, 0xc7, 0x22, 0xd7, 0x92, 0xc5, 0xfa, 0x13, 0x4b, 0x12, 0x09, 0x4a, 0xf9, 0xee, 0xcc,
+	0x5d, 0x8c, 0xc2, 0xfb, 0xad, 0xbe, 0xb2, 0x72, 0x8d, 0x26, 0x50, 0x7c, 0x11, 0x7b, 0x90, 0x17,
+	0xf4, 0xc6, 0xa2, 0xad, 0xde, 0xa2, 0x4b, 0xe4, 0x55, 0x5c, 0x67, 0x89, 0x64, 0x55, 0x07, 0xee,
+	0x19, 0xf8, 0xc1, 0xd5, 0x69, 0xf1, 0x15, 0x42, 0x12, 0x62, 0x5e, 0x70, 0xc8, 0xb5, 0xf2, 0xfb,
+	0xb3, 0xde, 0xe2, 0xee, 0x8b, 0x27, 0xe4, 0xf6, 0x73, 0x49, 0xd8, 0x32, 0xeb, 0xfe, 0xe1, 0xf7,
+	0xd4, 0x09, 0x3b, 0x97, 0xe6, 0xaf, 0xd0, 0xe8, 0x62, 0x7b, 0x3e, 0x1a, 0xfe, 0xff, 0x96, 0xf6,
+	0xe8, 0x3d, 0x42, 0x83, 0x0a, 0x78, 0x9a, 0x69, 0xd3, 0x79, 0x3f, 0x6c, 0x4e, 0xf3, 0xef, 0x2e,
+	0xba, 0xf7, 0x1a, 0xca, 0xb7, 0x4c, 0x85, 0x50, 0x31, 0x99, 0x28, 0x0f, 0x23, 0x74, 0x6d, 0xb4,
+	0x29, 0xd3, 0x51, 0x3c, 0x40, 0x43, 0x69, 0x51, 0xff, 0xc6, 0x34, 0xfc, 0x98, 0xd8, 0xdc, 0x49,
+	0x9d, 0x3b, 0x69, 0x72, 0x27, 0x1b, 0xc1, 0xf3, 0xf5, 0xf3, 0xba, 0xdd, 0x1f, 0x7f, 0xa6, 0x8b,
+	0x94, 0xeb, 0x6c, 0x17, 0x91, 0x58, 0x6c, 0x69, 0xf3, 0x49, 0x76, 0x59, 0xaa, 0xe4, 0x33, 0xd5,
+	0xfb, 0x02, 0x94, 0xb9, 0xa0, 0xc2, 0xb6, 0xf6, 0xfa, 0xdd, 0xe1, 0x84, 0xdd, 0xe3, 0x09, 0xbb,
+	0x7f, 0x4f, 0xd8, 0xfd, 0x76, 0xc6, 0xce, 0xf1, 0x8c, 0x9d, 0x5f, 0x67, 0xec, 0x7c, 0xa4, 0x9d,
+	0x62, 0xef, 0x4d, 0x54, 0x9b, 0x8c, 0xf1, 0x9c, 0x36, 0x43, 0xf4, 0xb5, 0x33, 0x46, 0xa6, 0x72,
+	0x34, 0x30, 0xdf, 0xff, 0xf2, 0xdf, 0x00, 0xf2, 0x7a, 0x6c, 0x38, 0x67, 0x02, 0x00, 0x00,
 }
 
 func (m *FeeShare) Marshal() (dAtA []byte, err error) {
@@ -134,6 +272,20 @@ func (m *FeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDevgas(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.WithdrawerAddress) > 0 {
 		i -= len(m.WithdrawerAddress)
 		copy(dAtA[i:], m.WithdrawerAddress)
@@ -158,6 +310,85 @@ func (m *FeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Recipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Recipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Recipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintDevgas(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDevgas(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DevGasRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DevGasRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DevGasRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDevgas(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Withdrawer) > 0 {
+		i -= len(m.Withdrawer)
+		copy(dAtA[i:], m.Withdrawer)
+		i = encodeVarintDevgas(dAtA, i, uint64(len(m.Withdrawer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDevgas(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDevgas(v)
 	base := offset
@@ -187,6 +418,47 @@ func (m *FeeShare) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDevgas(uint64(l))
 	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovDevgas(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Recipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDevgas(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovDevgas(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *DevGasRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Withdrawer)
+	if l > 0 {
+		n += 1 + l + sovDevgas(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDevgas(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -321,6 +593,257 @@ func (m *FeeShare) Unmarshal(dAtA []byte) error {
 			}
 			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevgas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevgas
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevgas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, Recipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevgas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDevgas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Recipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevgas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Recipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Recipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevgas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevgas
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevgas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevgas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDevgas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDevgas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DevGasRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDevgas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DevGasRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DevGasRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevgas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDevgas
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevgas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDevgas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDevgas
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDevgas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDevgas(dAtA[iNdEx:])
@@ -81,6 +81,7 @@ func (suite *FeeShareTestSuite) TestFee() {
 				suite.contract.String(),
 				suite.address1.String(),
 				suite.address2.String(),
+				nil,
 			},
 			true,
 		},
@@ -90,6 +91,7 @@ func (suite *FeeShareTestSuite) TestFee() {
 				"nibi15u3dt79t6sxxa3x3kpkhzsy56edaa5a66kxmukqjz2sx0hes5sn38g",
 				suite.address1.String(),
 				suite.address2.String(),
+				nil,
 			},
 			false,
 		},
@@ -99,6 +101,7 @@ func (suite *FeeShareTestSuite) TestFee() {
 				suite.contract.String(),
 				"nibi1hj5fveer5cjtn4wd6wstzugjfdxzl0xps73ftl",
 				suite.address2.String(),
+				nil,
 			},
 			false,
 		},
@@ -108,6 +111,43 @@ func (suite *FeeShareTestSuite) TestFee() {
 				suite.contract.String(),
 				suite.address1.String(),
 				"nibi1hj5fveer5cjtn4wd6wstzugjfdxzl0xps73ftl",
+				nil,
+			},
+			false,
+		},
+		{
+			"Create feeshare- weighted recipients",
+			FeeShare{
+				suite.contract.String(),
+				suite.address1.String(),
+				suite.address2.String(),
+				[]Recipient{
+					{Address: suite.address1.String(), Weight: 1},
+					{Address: suite.address2.String(), Weight: 3},
+				},
+			},
+			true,
+		},
+		{
+			"Create feeshare- duplicate recipient",
+			FeeShare{
+				suite.contract.String(),
+				suite.address1.String(),
+				suite.address2.String(),
+				[]Recipient{
+					{Address: suite.address2.String(), Weight: 1},
+					{Address: suite.address2.String(), Weight: 3},
+				},
+			},
+			false,
+		},
+		{
+			"Create feeshare- zero weight recipient",
+			FeeShare{
+				suite.contract.String(),
+				suite.address1.String(),
+				suite.address2.String(),
+				[]Recipient{{Address: suite.address2.String(), Weight: 0}},
 			},
 			false,
 		},
@@ -130,6 +170,7 @@ func (suite *FeeShareTestSuite) TestFeeShareGetters() {
 		contract.String(),
 		suite.address1.String(),
 		suite.address2.String(),
+		nil,
 	}
 	suite.Equal(fs.GetContractAddr(), contract)
 	suite.Equal(fs.GetDeployerAddr(), suite.address1)
@@ -139,8 +180,21 @@ func (suite *FeeShareTestSuite) TestFeeShareGetters() {
 		contract.String(),
 		suite.address1.String(),
 		"",
+		nil,
 	}
 	suite.Equal(fs.GetContractAddr(), contract)
 	suite.Equal(fs.GetDeployerAddr(), suite.address1)
 	suite.Equal(len(fs.GetWithdrawerAddr()), 0)
 }
+
+func (suite *FeeShareTestSuite) TestPayoutRecipients() {
+	fs := FeeShare{
+		ContractAddress:   suite.contract.String(),
+		DeployerAddress:   suite.address1.String(),
+		WithdrawerAddress: suite.address2.String(),
+	}
+	suite.Equal([]Recipient{{Address: suite.address2.String(), Weight: 1}}, fs.PayoutRecipients())
+
+	fs.Recipients = []Recipient{{Address: suite.address1.String(), Weight: 2}}
+	suite.Equal(fs.Recipients, fs.PayoutRecipients())
+}
@@ -12,4 +12,6 @@ var (
 	ErrFeeShareContractNotRegistered = errorsmod.Register(ModuleName, 4, "no feeshare registered for contract")
 	ErrFeeSharePayment               = errorsmod.Register(ModuleName, 5, "feeshare payment error")
 	ErrFeeShareInvalidWithdrawer     = errorsmod.Register(ModuleName, 6, "invalid withdrawer address")
+	ErrFeeShareInvalidRecipients     = errorsmod.Register(ModuleName, 7, "invalid feeshare recipients")
+	ErrNoDevGasRewards               = errorsmod.Register(ModuleName, 8, "no accrued devgas rewards")
 )
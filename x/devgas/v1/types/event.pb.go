@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -35,6 +38,8 @@ type EventRegisterDevGas struct {
 	// contract. This could be the deployer address or a separate withdrawer
 	// address specified.
 	Withdrawer string `protobuf:"bytes,3,opt,name=withdrawer,proto3" json:"withdrawer,omitempty"`
+	// The recipients splitting the fee sharing payouts by weight, if any.
+	Recipients []Recipient `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients"`
 }
 
 func (m *EventRegisterDevGas) Reset()         { *m = EventRegisterDevGas{} }
@@ -91,6 +96,13 @@ func (m *EventRegisterDevGas) GetWithdrawer() string {
 	return ""
 }
 
+func (m *EventRegisterDevGas) GetRecipients() []Recipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
 // ABCI event emitted when a deployer cancels fee sharing for a contract,
 // specifying the deployer and contract addresses.
 type EventCancelDevGas struct {
@@ -162,6 +174,8 @@ type EventUpdateDevGas struct {
 	// contract. This could be the deployer address or a separate withdrawer
 	// address specified.
 	Withdrawer string `protobuf:"bytes,3,opt,name=withdrawer,proto3" json:"withdrawer,omitempty"`
+	// The recipients splitting the fee sharing payouts by weight, if any.
+	Recipients []Recipient `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients"`
 }
 
 func (m *EventUpdateDevGas) Reset()         { *m = EventUpdateDevGas{} }
@@ -218,8 +232,15 @@ func (m *EventUpdateDevGas) GetWithdrawer() string {
 	return ""
 }
 
-// ABCI event emitted when fee sharing payouts are made, containing details on
-// the payouts in JSON format.
+func (m *EventUpdateDevGas) GetRecipients() []Recipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// ABCI event emitted when fee sharing payouts are accrued, containing details
+// on the payouts in JSON format.
 type EventPayoutDevGas struct {
 	Payouts string `protobuf:"bytes,1,opt,name=payouts,proto3" json:"payouts,omitempty"`
 }
@@ -264,33 +285,110 @@ func (m *EventPayoutDevGas) GetPayouts() string {
 	return ""
 }
 
+// ABCI event emitted when the rewards accrued by a withdrawer are paid out.
+type EventWithdrawDevGasRewards struct {
+	// withdrawer is the address of the account receiving the rewards
+	Withdrawer string `protobuf:"bytes,1,opt,name=withdrawer,proto3" json:"withdrawer,omitempty"`
+	// rewards paid out to the withdrawer
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	// auto_flush is true if the rewards were paid out automatically, either
+	// because they reached the threshold or at the end of the flush epoch.
+	AutoFlush bool `protobuf:"varint,3,opt,name=auto_flush,json=autoFlush,proto3" json:"auto_flush,omitempty"`
+}
+
+func (m *EventWithdrawDevGasRewards) Reset()         { *m = EventWithdrawDevGasRewards{} }
+func (m *EventWithdrawDevGasRewards) String() string { return proto.CompactTextString(m) }
+func (*EventWithdrawDevGasRewards) ProtoMessage()    {}
+func (*EventWithdrawDevGasRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd3ce94d3a226edf, []int{4}
+}
+func (m *EventWithdrawDevGasRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWithdrawDevGasRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventWithdrawDevGasRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventWithdrawDevGasRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWithdrawDevGasRewards.Merge(m, src)
+}
+func (m *EventWithdrawDevGasRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventWithdrawDevGasRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWithdrawDevGasRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventWithdrawDevGasRewards proto.InternalMessageInfo
+
+func (m *EventWithdrawDevGasRewards) GetWithdrawer() string {
+	if m != nil {
+		return m.Withdrawer
+	}
+	return ""
+}
+
+func (m *EventWithdrawDevGasRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *EventWithdrawDevGasRewards) GetAutoFlush() bool {
+	if m != nil {
+		return m.AutoFlush
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*EventRegisterDevGas)(nil), "nibiru.devgas.v1.EventRegisterDevGas")
 	proto.RegisterType((*EventCancelDevGas)(nil), "nibiru.devgas.v1.EventCancelDevGas")
 	proto.RegisterType((*EventUpdateDevGas)(nil), "nibiru.devgas.v1.EventUpdateDevGas")
 	proto.RegisterType((*EventPayoutDevGas)(nil), "nibiru.devgas.v1.EventPayoutDevGas")
+	proto.RegisterType((*EventWithdrawDevGasRewards)(nil), "nibiru.devgas.v1.EventWithdrawDevGasRewards")
 }
 
 func init() { proto.RegisterFile("nibiru/devgas/v1/event.proto", fileDescriptor_dd3ce94d3a226edf) }
 
 var fileDescriptor_dd3ce94d3a226edf = []byte{
-	// 256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0xcb, 0x4c, 0xca,
-	0x2c, 0x2a, 0xd5, 0x4f, 0x49, 0x2d, 0x4b, 0x4f, 0x2c, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b,
-	0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc8, 0xea, 0x41, 0x64, 0xf5,
-	0xca, 0x0c, 0x95, 0x72, 0xb9, 0x84, 0x5d, 0x41, 0x0a, 0x82, 0x52, 0xd3, 0x33, 0x8b, 0x4b, 0x52,
-	0x8b, 0x5c, 0x52, 0xcb, 0xdc, 0x13, 0x8b, 0x85, 0xa4, 0xb8, 0x38, 0x52, 0x52, 0x0b, 0x72, 0xf2,
-	0x2b, 0x53, 0x8b, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0xe0, 0x7c, 0x90, 0x5c, 0x72, 0x7e,
-	0x5e, 0x49, 0x51, 0x62, 0x72, 0x89, 0x04, 0x13, 0x44, 0x0e, 0xc6, 0x17, 0x92, 0xe3, 0xe2, 0x2a,
-	0xcf, 0x2c, 0xc9, 0x48, 0x29, 0x4a, 0x2c, 0x4f, 0x2d, 0x92, 0x60, 0x06, 0xcb, 0x22, 0x89, 0x28,
-	0x79, 0x73, 0x09, 0x82, 0xad, 0x73, 0x4e, 0xcc, 0x4b, 0x4e, 0xcd, 0xa1, 0xcc, 0x32, 0xa5, 0x6c,
-	0xa8, 0x61, 0xa1, 0x05, 0x29, 0x89, 0x25, 0xa9, 0x34, 0x76, 0xb9, 0x2e, 0xd4, 0xb2, 0x80, 0xc4,
-	0xca, 0xfc, 0xd2, 0x12, 0xa8, 0x65, 0x12, 0x5c, 0xec, 0x05, 0x60, 0x7e, 0x31, 0xd4, 0x2e, 0x18,
-	0xd7, 0xc9, 0xf3, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xf4, 0xd3, 0x33,
-	0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xfd, 0xc0, 0xd1, 0xe1, 0x9c, 0x91, 0x98,
-	0x99, 0xa7, 0x0f, 0x8d, 0xb8, 0x0a, 0xa4, 0xa8, 0x2b, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03,
-	0xc7, 0x9d, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x32, 0xd6, 0x7e, 0xdb, 0x01, 0x00, 0x00,
+	// 427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0x4d, 0x6e, 0xd4, 0x30,
+	0x14, 0xc7, 0xc7, 0x6d, 0x45, 0x5b, 0xb3, 0x81, 0xc0, 0x22, 0x0c, 0xd4, 0xad, 0xb2, 0x9a, 0x4d,
+	0x6d, 0x02, 0x27, 0x60, 0x86, 0x0f, 0x21, 0x24, 0x84, 0x22, 0x21, 0x24, 0x36, 0xc8, 0x49, 0x1e,
+	0x89, 0xc5, 0xd4, 0x8e, 0x6c, 0x27, 0xc3, 0xdc, 0x82, 0x73, 0xb0, 0x40, 0x9c, 0x81, 0x55, 0x97,
+	0x5d, 0xb2, 0x02, 0x34, 0x73, 0x11, 0x14, 0xdb, 0x41, 0x51, 0xbb, 0x64, 0xd3, 0x55, 0xf2, 0xde,
+	0xff, 0x7d, 0xfc, 0xfc, 0xf4, 0xc7, 0x0f, 0xa4, 0xc8, 0x85, 0x6e, 0x59, 0x09, 0x5d, 0xc5, 0x0d,
+	0xeb, 0x52, 0x06, 0x1d, 0x48, 0x4b, 0x1b, 0xad, 0xac, 0x8a, 0x6e, 0x79, 0x95, 0x7a, 0x95, 0x76,
+	0xe9, 0xf4, 0x6e, 0xa5, 0x2a, 0xe5, 0x44, 0xd6, 0xff, 0xf9, 0xba, 0x29, 0x29, 0x94, 0x39, 0x53,
+	0x86, 0xe5, 0xdc, 0x00, 0xeb, 0xd2, 0x1c, 0x2c, 0x4f, 0x59, 0xa1, 0x84, 0x0c, 0xfa, 0xd1, 0x95,
+	0x2d, 0x61, 0xa2, 0x93, 0x93, 0xef, 0x08, 0xdf, 0x79, 0xd6, 0xaf, 0xcd, 0xa0, 0x12, 0xc6, 0x82,
+	0x7e, 0x0a, 0xdd, 0x0b, 0x6e, 0xa2, 0x29, 0x3e, 0x28, 0xa1, 0x59, 0xaa, 0x35, 0xe8, 0x18, 0x9d,
+	0xa0, 0xd9, 0x61, 0xf6, 0x2f, 0xee, 0xb5, 0x42, 0x49, 0xab, 0x79, 0x61, 0xe3, 0x1d, 0xaf, 0x0d,
+	0x71, 0x44, 0x30, 0x5e, 0x09, 0x5b, 0x97, 0x9a, 0xaf, 0x40, 0xc7, 0xbb, 0x4e, 0x1d, 0x65, 0xa2,
+	0x27, 0x18, 0x6b, 0x28, 0x44, 0x23, 0x40, 0x5a, 0x13, 0xef, 0x9d, 0xec, 0xce, 0x6e, 0x3e, 0xba,
+	0x4f, 0x2f, 0xbf, 0x95, 0x66, 0x43, 0xcd, 0x7c, 0xef, 0xfc, 0xd7, 0xf1, 0x24, 0x1b, 0x35, 0x25,
+	0xaf, 0xf0, 0x6d, 0x47, 0xbc, 0xe0, 0xb2, 0x80, 0xe5, 0xff, 0xf1, 0x26, 0xdf, 0x50, 0x98, 0xf6,
+	0xb6, 0x29, 0xb9, 0x85, 0xeb, 0xff, 0xfa, 0xd3, 0xc0, 0xfb, 0x86, 0xaf, 0x55, 0x6b, 0x03, 0x6f,
+	0x8c, 0xf7, 0x1b, 0x17, 0x9b, 0x80, 0x3b, 0x84, 0xc9, 0x0f, 0x84, 0xa7, 0xae, 0xfe, 0x5d, 0xa0,
+	0xf0, 0x1d, 0x19, 0xac, 0xb8, 0x2e, 0xcd, 0x25, 0x60, 0x74, 0x05, 0x18, 0xf0, 0xbe, 0xf6, 0xa5,
+	0xf1, 0x8e, 0xa3, 0xbd, 0x47, 0xbd, 0xdf, 0x68, 0xef, 0x37, 0x1a, 0xfc, 0x46, 0x17, 0x4a, 0xc8,
+	0xf9, 0xc3, 0x9e, 0xf5, 0xeb, 0xef, 0xe3, 0x59, 0x25, 0x6c, 0xdd, 0xe6, 0xb4, 0x50, 0x67, 0x2c,
+	0x98, 0xd3, 0x7f, 0x4e, 0x4d, 0xf9, 0x89, 0xd9, 0x75, 0x03, 0xc6, 0x35, 0x98, 0x6c, 0x98, 0x1d,
+	0x1d, 0x61, 0xcc, 0x5b, 0xab, 0x3e, 0x7c, 0x5c, 0xb6, 0xa6, 0x76, 0x77, 0x3b, 0xc8, 0x0e, 0xfb,
+	0xcc, 0xf3, 0x3e, 0x31, 0x7f, 0x79, 0xbe, 0x21, 0xe8, 0x62, 0x43, 0xd0, 0x9f, 0x0d, 0x41, 0x5f,
+	0xb6, 0x64, 0x72, 0xb1, 0x25, 0x93, 0x9f, 0x5b, 0x32, 0x79, 0xcf, 0x46, 0xbb, 0x5e, 0xbb, 0x33,
+	0x2e, 0x6a, 0x2e, 0x24, 0x0b, 0xa6, 0xff, 0x3c, 0xb2, 0xbd, 0x5b, 0x9c, 0xdf, 0x70, 0xb6, 0x7f,
+	0xfc, 0x77, 0x00, 0x77, 0x4c, 0x18, 0x7e, 0x7d, 0x03, 0x00, 0x00,
 }
 
 func (m *EventRegisterDevGas) Marshal() (dAtA []byte, err error) {
@@ -313,6 +411,20 @@ func (m *EventRegisterDevGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Withdrawer) > 0 {
 		i -= len(m.Withdrawer)
 		copy(dAtA[i:], m.Withdrawer)
@@ -394,6 +506,20 @@ func (m *EventUpdateDevGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Withdrawer) > 0 {
 		i -= len(m.Withdrawer)
 		copy(dAtA[i:], m.Withdrawer)
@@ -448,6 +574,60 @@ func (m *EventPayoutDevGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventWithdrawDevGasRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWithdrawDevGasRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWithdrawDevGasRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoFlush {
+		i--
+		if m.AutoFlush {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Withdrawer) > 0 {
+		i -= len(m.Withdrawer)
+		copy(dAtA[i:], m.Withdrawer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Withdrawer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -477,6 +657,12 @@ func (m *EventRegisterDevGas) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -515,6 +701,12 @@ func (m *EventUpdateDevGas) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -531,6 +723,28 @@ func (m *EventPayoutDevGas) Size() (n int) {
 	return n
 }
 
+func (m *EventWithdrawDevGasRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Withdrawer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.AutoFlush {
+		n += 2
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -662,6 +876,40 @@ func (m *EventRegisterDevGas) Unmarshal(dAtA []byte) error {
 			}
 			m.Withdrawer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, Recipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -922,6 +1170,40 @@ func (m *EventUpdateDevGas) Unmarshal(dAtA []byte) error {
 			}
 			m.Withdrawer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, Recipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1025,6 +1307,142 @@ func (m *EventPayoutDevGas) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventWithdrawDevGasRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdrawDevGasRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdrawDevGasRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoFlush", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoFlush = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,6 +16,7 @@ func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		Params:   DefaultParams(),
 		FeeShare: []FeeShare{},
+		Rewards:  []DevGasRewards{},
 	}
 }
 
@@ -36,5 +37,18 @@ func (gs GenesisState) Validate() error {
 		seenContract[fs.ContractAddress] = true
 	}
 
+	seenWithdrawer := make(map[string]bool)
+	for _, rewards := range gs.Rewards {
+		if seenWithdrawer[rewards.Withdrawer] {
+			return fmt.Errorf("withdrawer rewards duplicated on genesis '%s'", rewards.Withdrawer)
+		}
+
+		if err := rewards.Validate(); err != nil {
+			return err
+		}
+
+		seenWithdrawer[rewards.Withdrawer] = true
+	}
+
 	return gs.Params.Validate()
 }
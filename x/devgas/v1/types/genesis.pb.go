@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -30,6 +31,9 @@ type GenesisState struct {
 	Params ModuleParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// FeeShare is a slice of active registered contracts for fee distribution
 	FeeShare []FeeShare `protobuf:"bytes,2,rep,name=fee_share,json=feeShare,proto3" json:"fee_share"`
+	// rewards are the transaction fees accrued by the withdrawers and not yet
+	// paid out
+	Rewards []DevGasRewards `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +83,13 @@ func (m *GenesisState) GetFeeShare() []FeeShare {
 	return nil
 }
 
+func (m *GenesisState) GetRewards() []DevGasRewards {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 // ModuleParams defines the params for the devgas module
 type ModuleParams struct {
 	// enable_feeshare defines a parameter to enable the feeshare module
@@ -91,6 +102,19 @@ type ModuleParams struct {
 	// will ONLY be sent to the community pool.
 	// If this list is empty, all denoms are allowed.
 	AllowedDenoms []string `protobuf:"bytes,3,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// even_split_payouts splits the developer shares evenly between the
+	// registered contracts executed by the messages of a transaction. Otherwise,
+	// the developer shares are split proportionally to the gas consumed by each
+	// registered contract.
+	EvenSplitPayouts bool `protobuf:"varint,4,opt,name=even_split_payouts,json=evenSplitPayouts,proto3" json:"even_split_payouts,omitempty"`
+	// auto_flush_threshold pays out the accrued rewards of a withdrawer as soon
+	// as any of its coins reaches the threshold. If empty, the rewards are only
+	// paid out when withdrawn or at the end of the flush epoch.
+	AutoFlushThreshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=auto_flush_threshold,json=autoFlushThreshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"auto_flush_threshold"`
+	// flush_epoch_identifier pays out the accrued rewards of all the withdrawers
+	// at the end of every epoch with this identifier. If empty, the rewards are
+	// not paid out at the end of epochs.
+	FlushEpochIdentifier string `protobuf:"bytes,6,opt,name=flush_epoch_identifier,json=flushEpochIdentifier,proto3" json:"flush_epoch_identifier,omitempty"`
 }
 
 func (m *ModuleParams) Reset()         { *m = ModuleParams{} }
@@ -140,6 +164,27 @@ func (m *ModuleParams) GetAllowedDenoms() []string {
 	return nil
 }
 
+func (m *ModuleParams) GetEvenSplitPayouts() bool {
+	if m != nil {
+		return m.EvenSplitPayouts
+	}
+	return false
+}
+
+func (m *ModuleParams) GetAutoFlushThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AutoFlushThreshold
+	}
+	return nil
+}
+
+func (m *ModuleParams) GetFlushEpochIdentifier() string {
+	if m != nil {
+		return m.FlushEpochIdentifier
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nibiru.devgas.v1.GenesisState")
 	proto.RegisterType((*ModuleParams)(nil), "nibiru.devgas.v1.ModuleParams")
@@ -148,30 +193,40 @@ func init() {
 func init() { proto.RegisterFile("nibiru/devgas/v1/genesis.proto", fileDescriptor_86a5066ce5bd7311) }
 
 var fileDescriptor_86a5066ce5bd7311 = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xb1, 0x6e, 0xe2, 0x40,
-	0x10, 0x86, 0xbd, 0x70, 0x42, 0xb0, 0x70, 0x1c, 0xb2, 0xae, 0xb0, 0x90, 0x6e, 0xb1, 0x90, 0xee,
-	0xe4, 0xe6, 0x76, 0x05, 0xd7, 0x5e, 0x1a, 0x82, 0x12, 0xa5, 0x48, 0x14, 0x99, 0x2a, 0x69, 0xd0,
-	0x1a, 0x0f, 0xc6, 0x8a, 0xed, 0xb5, 0xbc, 0xc6, 0x49, 0x9e, 0x21, 0x4d, 0x9e, 0x27, 0x4f, 0x40,
-	0x49, 0x19, 0xa5, 0x40, 0x11, 0xbc, 0x48, 0xe4, 0xb5, 0x93, 0xa0, 0x50, 0x79, 0xfc, 0xcf, 0xfc,
-	0xdf, 0x8c, 0xf6, 0xc7, 0x24, 0xf2, 0x1d, 0x3f, 0x59, 0x32, 0x17, 0x32, 0x8f, 0x4b, 0x96, 0x0d,
-	0x98, 0x07, 0x11, 0x48, 0x5f, 0xd2, 0x38, 0x11, 0xa9, 0xd0, 0x3b, 0x45, 0x9f, 0x16, 0x7d, 0x9a,
-	0x0d, 0xba, 0xbf, 0x0e, 0x1c, 0x65, 0x4f, 0x19, 0xba, 0x3f, 0x3d, 0xe1, 0x09, 0x55, 0xb2, 0xbc,
-	0x2a, 0xd4, 0xfe, 0x03, 0xc2, 0xad, 0xd3, 0x02, 0x3c, 0x49, 0x79, 0x0a, 0xfa, 0x7f, 0x5c, 0x8b,
-	0x79, 0xc2, 0x43, 0x69, 0x20, 0x13, 0x59, 0xcd, 0x21, 0xa1, 0x5f, 0x17, 0xd1, 0x73, 0xe1, 0x2e,
-	0x03, 0xb8, 0x54, 0x53, 0xa3, 0x6f, 0xab, 0x4d, 0x4f, 0xb3, 0x4b, 0x8f, 0x7e, 0x84, 0x1b, 0x73,
-	0x80, 0xa9, 0x5c, 0xf0, 0x04, 0x8c, 0x8a, 0x59, 0xb5, 0x9a, 0xc3, 0xee, 0x21, 0xe0, 0x04, 0x60,
-	0x92, 0x4f, 0x94, 0xe6, 0xfa, 0xbc, 0xfc, 0xef, 0x3f, 0x21, 0xdc, 0xda, 0xa7, 0xeb, 0x16, 0xee,
-	0x40, 0xc4, 0x9d, 0x00, 0xa6, 0x9f, 0xd8, 0xfc, 0xae, 0xba, 0xdd, 0x2e, 0xf4, 0x77, 0x94, 0x7e,
-	0x85, 0x3b, 0x2e, 0x64, 0x10, 0x88, 0x18, 0x92, 0x62, 0x50, 0x1a, 0x15, 0x13, 0x59, 0x8d, 0x11,
-	0xcd, 0x97, 0xbc, 0x6c, 0x7a, 0x7f, 0x3c, 0x3f, 0x5d, 0x2c, 0x1d, 0x3a, 0x13, 0x21, 0x9b, 0x09,
-	0x19, 0x0a, 0x59, 0x7e, 0xfe, 0x4a, 0xf7, 0x86, 0xa5, 0xf7, 0x31, 0x48, 0x3a, 0x86, 0x99, 0xfd,
-	0xe3, 0x83, 0xa3, 0xc8, 0x52, 0xff, 0x8d, 0xdb, 0x3c, 0x08, 0xc4, 0x2d, 0xb8, 0x53, 0x17, 0x22,
-	0x11, 0x4a, 0xa3, 0x6a, 0x56, 0xad, 0x86, 0xfd, 0xbd, 0x54, 0xc7, 0x4a, 0x1c, 0x9d, 0xad, 0xb6,
-	0x04, 0xad, 0xb7, 0x04, 0xbd, 0x6e, 0x09, 0x7a, 0xdc, 0x11, 0x6d, 0xbd, 0x23, 0xda, 0xf3, 0x8e,
-	0x68, 0xd7, 0x6c, 0x6f, 0xf3, 0x85, 0x7a, 0x8c, 0xe3, 0x05, 0xf7, 0x23, 0x56, 0x06, 0x76, 0xb7,
-	0x17, 0x99, 0x3a, 0xc3, 0xa9, 0xa9, 0x70, 0xfe, 0xbd, 0x05, 0x00, 0x00, 0xff, 0xff, 0x24, 0xf2,
-	0x7e, 0x8d, 0x05, 0x02, 0x00, 0x00,
+	// 514 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0x6e, 0xd3, 0x40,
+	0x14, 0x8e, 0x9b, 0x12, 0x9a, 0x69, 0x29, 0xd1, 0x28, 0x42, 0x26, 0x12, 0x4e, 0x54, 0x09, 0x94,
+	0x05, 0xcc, 0x90, 0xc2, 0x12, 0x84, 0x94, 0x86, 0x56, 0x5d, 0x80, 0x2a, 0x87, 0x0d, 0x6c, 0xac,
+	0x71, 0xfc, 0x12, 0x8f, 0x70, 0x3c, 0x96, 0x67, 0xec, 0xd2, 0x05, 0x77, 0xe0, 0x02, 0x5c, 0x80,
+	0x93, 0x74, 0xc1, 0xa2, 0x4b, 0xc4, 0xa2, 0xa0, 0xe4, 0x22, 0x68, 0x66, 0x9c, 0x12, 0x91, 0x0d,
+	0x2b, 0x8f, 0xdf, 0xf7, 0xbe, 0xf7, 0x7d, 0xef, 0x07, 0x79, 0x29, 0x0f, 0x79, 0x5e, 0xd0, 0x08,
+	0xca, 0x19, 0x93, 0xb4, 0x1c, 0xd0, 0x19, 0xa4, 0x20, 0xb9, 0x24, 0x59, 0x2e, 0x94, 0xc0, 0x2d,
+	0x8b, 0x13, 0x8b, 0x93, 0x72, 0xd0, 0x79, 0xb0, 0xc1, 0xa8, 0x30, 0x43, 0xe8, 0xb4, 0x67, 0x62,
+	0x26, 0xcc, 0x93, 0xea, 0x57, 0x15, 0xf5, 0x26, 0x42, 0xce, 0x85, 0xa4, 0x21, 0x93, 0x40, 0xcb,
+	0x41, 0x08, 0x8a, 0x0d, 0xe8, 0x44, 0xf0, 0xd4, 0xe2, 0x07, 0xdf, 0x1d, 0xb4, 0x77, 0x62, 0x85,
+	0xc7, 0x8a, 0x29, 0xc0, 0x2f, 0x50, 0x23, 0x63, 0x39, 0x9b, 0x4b, 0xd7, 0xe9, 0x39, 0xfd, 0xdd,
+	0x43, 0x8f, 0xfc, 0x6b, 0x84, 0xbc, 0x11, 0x51, 0x91, 0xc0, 0x99, 0xc9, 0x1a, 0x6e, 0x5f, 0x5e,
+	0x77, 0x6b, 0x7e, 0xc5, 0xc1, 0x2f, 0x51, 0x73, 0x0a, 0x10, 0xc8, 0x98, 0xe5, 0xe0, 0x6e, 0xf5,
+	0xea, 0xfd, 0xdd, 0xc3, 0xce, 0x66, 0x81, 0x63, 0x80, 0xb1, 0xce, 0xa8, 0xc8, 0x3b, 0xd3, 0xea,
+	0x1f, 0xbf, 0x42, 0xb7, 0x73, 0x38, 0x67, 0x79, 0x24, 0xdd, 0xba, 0x21, 0x77, 0x37, 0xc9, 0x23,
+	0x28, 0x4f, 0x98, 0xf4, 0x6d, 0x5a, 0x55, 0x61, 0xc5, 0x3a, 0xf8, 0x5a, 0x47, 0x7b, 0xeb, 0xf6,
+	0x70, 0x1f, 0xb5, 0x20, 0x65, 0x61, 0x02, 0xc1, 0x5f, 0x5f, 0xba, 0xb1, 0x1d, 0x7f, 0xdf, 0xc6,
+	0x57, 0x5e, 0xf0, 0x7b, 0xd4, 0x8a, 0xa0, 0x84, 0x44, 0x64, 0x90, 0xdb, 0x44, 0xe9, 0x6e, 0xf5,
+	0x9c, 0x7e, 0x73, 0x48, 0xb4, 0xc6, 0xcf, 0xeb, 0xee, 0xa3, 0x19, 0x57, 0x71, 0x11, 0x92, 0x89,
+	0x98, 0xd3, 0x6a, 0xac, 0xf6, 0xf3, 0x44, 0x46, 0x1f, 0xa9, 0xba, 0xc8, 0x40, 0x92, 0x11, 0x4c,
+	0xfc, 0xbb, 0x37, 0x75, 0x4c, 0x65, 0x89, 0x1f, 0xa2, 0x7d, 0x96, 0x24, 0xe2, 0x1c, 0xa2, 0x20,
+	0x82, 0x54, 0xcc, 0x6d, 0x77, 0x4d, 0xff, 0x4e, 0x15, 0x1d, 0x99, 0x20, 0x7e, 0x8c, 0x30, 0x94,
+	0x90, 0x06, 0x32, 0x4b, 0xb8, 0x0a, 0x32, 0x76, 0x21, 0x0a, 0x25, 0xdd, 0x6d, 0xe3, 0xb6, 0xa5,
+	0x91, 0xb1, 0x06, 0xce, 0x6c, 0x1c, 0x7f, 0x46, 0x6d, 0x56, 0x28, 0x11, 0x4c, 0x93, 0x42, 0xc6,
+	0x81, 0x8a, 0x73, 0x90, 0xb1, 0x48, 0x22, 0xf7, 0x96, 0x19, 0xdc, 0x7d, 0x62, 0xad, 0x11, 0xbd,
+	0x78, 0x52, 0x2d, 0x9e, 0x1c, 0x09, 0x9e, 0x0e, 0x9f, 0xea, 0x76, 0xbe, 0xfd, 0xea, 0xf6, 0xff,
+	0xa3, 0x1d, 0x4d, 0x90, 0x3e, 0xd6, 0x42, 0xc7, 0x5a, 0xe7, 0xdd, 0x4a, 0x06, 0x3f, 0x47, 0xf7,
+	0xac, 0x32, 0x64, 0x62, 0x12, 0x07, 0x3c, 0x82, 0x54, 0xf1, 0x29, 0x87, 0xdc, 0x6d, 0xe8, 0xa1,
+	0xf9, 0x6d, 0x83, 0xbe, 0xd6, 0xe0, 0xe9, 0x0d, 0x36, 0x3c, 0xbd, 0x5c, 0x78, 0xce, 0xd5, 0xc2,
+	0x73, 0x7e, 0x2f, 0x3c, 0xe7, 0xcb, 0xd2, 0xab, 0x5d, 0x2d, 0xbd, 0xda, 0x8f, 0xa5, 0x57, 0xfb,
+	0x40, 0xd7, 0xdc, 0xbc, 0x35, 0x3b, 0x3f, 0x8a, 0x19, 0x4f, 0x69, 0x75, 0xf4, 0x9f, 0xd6, 0xce,
+	0xde, 0x58, 0x0b, 0x1b, 0xe6, 0x80, 0x9f, 0xfd, 0x19, 0x00, 0x96, 0xe0, 0x8d, 0xf4, 0x49, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -194,6 +249,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.FeeShare) > 0 {
 		for iNdEx := len(m.FeeShare) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -241,6 +310,37 @@ func (m *ModuleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FlushEpochIdentifier) > 0 {
+		i -= len(m.FlushEpochIdentifier)
+		copy(dAtA[i:], m.FlushEpochIdentifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FlushEpochIdentifier)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AutoFlushThreshold) > 0 {
+		for iNdEx := len(m.AutoFlushThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoFlushThreshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.EvenSplitPayouts {
+		i--
+		if m.EvenSplitPayouts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.AllowedDenoms) > 0 {
 		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedDenoms[iNdEx])
@@ -298,6 +398,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -318,6 +424,19 @@ func (m *ModuleParams) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.EvenSplitPayouts {
+		n += 2
+	}
+	if len(m.AutoFlushThreshold) > 0 {
+		for _, e := range m.AutoFlushThreshold {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.FlushEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -423,6 +542,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, DevGasRewards{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -559,6 +712,92 @@ func (m *ModuleParams) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvenSplitPayouts", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EvenSplitPayouts = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoFlushThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoFlushThreshold = append(m.AutoFlushThreshold, types.Coin{})
+			if err := m.AutoFlushThreshold[len(m.AutoFlushThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlushEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlushEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -12,6 +12,10 @@ const (
 	// StoreKey to be used when creating the KVStore
 	StoreKey = ModuleName
 
+	// TStoreKey to be used when creating the transient store that records the
+	// gas consumed by the contracts executed in a transaction
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey to be used for message routing
 	RouterKey = ModuleName
 )
@@ -23,4 +27,12 @@ const (
 	KeyPrefixDeployer
 	KeyPrefixWithdrawer
 	KeyPrefixParams
+	KeyPrefixRewards
+	KeyPrefixFlushCursor
+)
+
+// prefix bytes for the fees transient store
+const (
+	KeyPrefixContractGas collections.Namespace = iota + 1
+	KeyPrefixContractGasTotal
 )
@@ -10,12 +10,15 @@ var (
 	_ sdk.Msg = &MsgRegisterFeeShare{}
 	_ sdk.Msg = &MsgCancelFeeShare{}
 	_ sdk.Msg = &MsgUpdateFeeShare{}
+	_ sdk.Msg = &MsgWithdrawDevGasRewards{}
 )
 
 const (
 	TypeMsgRegisterFeeShare = "register_feeshare"
 	TypeMsgCancelFeeShare   = "cancel_feeshare"
 	TypeMsgUpdateFeeShare   = "update_feeshare"
+
+	TypeMsgWithdrawDevGasRewards = "withdraw_devgas_rewards"
 )
 
 // NewMsgRegisterFeeShare creates new instance of MsgRegisterFeeShare
@@ -58,7 +61,7 @@ func (msg MsgRegisterFeeShare) ValidateBasic() error {
 		}
 	}
 
-	return nil
+	return ValidateRecipients(msg.Recipients)
 }
 
 // GetSignBytes encodes the message for signing
@@ -146,7 +149,7 @@ func (msg MsgUpdateFeeShare) ValidateBasic() error {
 		return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
 	}
 
-	return nil
+	return ValidateRecipients(msg.Recipients)
 }
 
 // GetSignBytes encodes the message for signing
@@ -160,6 +163,32 @@ func (msg MsgUpdateFeeShare) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+// Route returns the name of the module
+func (msg MsgWithdrawDevGasRewards) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgWithdrawDevGasRewards) Type() string { return TypeMsgWithdrawDevGasRewards }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgWithdrawDevGasRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgWithdrawDevGasRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgWithdrawDevGasRewards) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
+	return []sdk.AccAddress{from}
+}
+
 var _ sdk.Msg = &MsgUpdateParams{}
 
 // GetSignBytes implements the LegacyMsg interface.
@@ -234,6 +234,18 @@ func (suite *MsgsTestSuite) TestMsgUpdateFeeShareNew() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgWithdrawDevGasRewards() {
+	msg := MsgWithdrawDevGasRewards{WithdrawerAddress: suite.deployerStr}
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgWithdrawDevGasRewards, msg.Type())
+	suite.Require().NotNil(msg.GetSignBytes())
+	suite.Require().Equal([]sdk.AccAddress{sdk.AccAddress(suite.deployer.Bytes())}, msg.GetSigners())
+	suite.Require().NoError(msg.ValidateBasic())
+
+	msg.WithdrawerAddress = "withdrawer"
+	suite.Require().ErrorContains(msg.ValidateBasic(), "decoding bech32 failed")
+}
+
 func (s *MsgsTestSuite) TestQuery_ValidateBasic() {
 	validAddr := s.contract.String()
 	invalidAddr := "invalid-addr"
@@ -24,6 +24,8 @@ func DefaultParams() ModuleParams {
 		EnableFeeShare:  DefaultEnableFeeShare,
 		DeveloperShares: DefaultDeveloperShares,
 		AllowedDenoms:   DefaultAllowedDenoms,
+		// rewards are only paid out when withdrawn
+		AutoFlushThreshold: sdk.Coins{},
 	}
 }
 
@@ -80,8 +82,24 @@ func (p ModuleParams) Validate() error {
 	if err := validateShares(p.DeveloperShares); err != nil {
 		return err
 	}
-	err := validateArray(p.AllowedDenoms)
-	return err
+	if err := validateArray(p.AllowedDenoms); err != nil {
+		return err
+	}
+	if err := p.AutoFlushThreshold.Validate(); err != nil {
+		return fmt.Errorf("invalid auto flush threshold: %w", err)
+	}
+	return nil
+}
+
+// ShouldAutoFlush returns true if any of the accrued rewards reached the auto
+// flush threshold.
+func (p ModuleParams) ShouldAutoFlush(rewards sdk.Coins) bool {
+	for _, threshold := range p.AutoFlushThreshold {
+		if rewards.AmountOf(threshold.Denom).GTE(threshold.Amount) {
+			return true
+		}
+	}
+	return false
 }
 
 func (p ModuleParams) Sanitize() ModuleParams {
@@ -90,5 +108,8 @@ func (p ModuleParams) Sanitize() ModuleParams {
 	if len(newP.AllowedDenoms) == 0 {
 		newP.AllowedDenoms = DefaultAllowedDenoms
 	}
+	if len(newP.AutoFlushThreshold) == 0 {
+		newP.AutoFlushThreshold = sdk.Coins{}
+	}
 	return *newP
 }
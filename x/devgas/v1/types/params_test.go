@@ -41,7 +41,7 @@ func TestParamsValidate(t *testing.T) {
 		},
 		{
 			"valid: 100% devs",
-			ModuleParams{true, sdk.NewDecFromInt(sdk.NewInt(1)), acceptedDenoms},
+			ModuleParams{true, sdk.NewDecFromInt(sdk.NewInt(1)), acceptedDenoms, false, nil, ""},
 			false,
 		},
 		{
@@ -51,17 +51,17 @@ func TestParamsValidate(t *testing.T) {
 		},
 		{
 			"invalid: share > 1",
-			ModuleParams{true, sdk.NewDecFromInt(sdk.NewInt(2)), acceptedDenoms},
+			ModuleParams{true, sdk.NewDecFromInt(sdk.NewInt(2)), acceptedDenoms, false, nil, ""},
 			true,
 		},
 		{
 			"invalid: share < 0",
-			ModuleParams{true, sdk.NewDecFromInt(sdk.NewInt(-1)), acceptedDenoms},
+			ModuleParams{true, sdk.NewDecFromInt(sdk.NewInt(-1)), acceptedDenoms, false, nil, ""},
 			true,
 		},
 		{
 			"valid: all denoms allowed",
-			ModuleParams{true, sdk.NewDecFromInt(sdk.NewInt(-1)), []string{}},
+			ModuleParams{true, sdk.NewDecFromInt(sdk.NewInt(-1)), []string{}, false, nil, ""},
 			true,
 		},
 	}
@@ -29,3 +29,11 @@ func (q QueryFeeSharesByWithdrawerRequest) ValidateBasic() error {
 	}
 	return nil
 }
+
+// ValidateBasic runs stateless checks on the query requests
+func (q QueryRewardsRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(q.WithdrawerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdraw address %s", q.WithdrawerAddress)
+	}
+	return nil
+}
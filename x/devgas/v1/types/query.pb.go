@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -392,6 +394,98 @@ func (m *QueryFeeSharesByWithdrawerResponse) GetFeeshare() []FeeShare {
 	return nil
 }
 
+// QueryRewardsRequest is the request type for the Query/Rewards RPC method.
+type QueryRewardsRequest struct {
+	// withdrawer_address in bech32 format
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+}
+
+func (m *QueryRewardsRequest) Reset()         { *m = QueryRewardsRequest{} }
+func (m *QueryRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsRequest) ProtoMessage()    {}
+func (*QueryRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b68d3a02185e7c52, []int{8}
+}
+func (m *QueryRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsRequest.Merge(m, src)
+}
+func (m *QueryRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryRewardsRequest) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+// QueryRewardsResponse is the response type for the Query/Rewards RPC method.
+type QueryRewardsResponse struct {
+	// rewards accrued by the withdrawer and not yet paid out
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *QueryRewardsResponse) Reset()         { *m = QueryRewardsResponse{} }
+func (m *QueryRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsResponse) ProtoMessage()    {}
+func (*QueryRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b68d3a02185e7c52, []int{9}
+}
+func (m *QueryRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsResponse.Merge(m, src)
+}
+func (m *QueryRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryFeeSharesRequest)(nil), "nibiru.devgas.v1.QueryFeeSharesRequest")
 	proto.RegisterType((*QueryFeeSharesResponse)(nil), "nibiru.devgas.v1.QueryFeeSharesResponse")
@@ -401,48 +495,56 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "nibiru.devgas.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryFeeSharesByWithdrawerRequest)(nil), "nibiru.devgas.v1.QueryFeeSharesByWithdrawerRequest")
 	proto.RegisterType((*QueryFeeSharesByWithdrawerResponse)(nil), "nibiru.devgas.v1.QueryFeeSharesByWithdrawerResponse")
+	proto.RegisterType((*QueryRewardsRequest)(nil), "nibiru.devgas.v1.QueryRewardsRequest")
+	proto.RegisterType((*QueryRewardsResponse)(nil), "nibiru.devgas.v1.QueryRewardsResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/devgas/v1/query.proto", fileDescriptor_b68d3a02185e7c52) }
 
 var fileDescriptor_b68d3a02185e7c52 = []byte{
-	// 567 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x8b, 0xd3, 0x5c,
-	0x14, 0xc6, 0x9b, 0xf7, 0x1d, 0x4b, 0xe7, 0xba, 0x70, 0xbc, 0x76, 0xa4, 0x84, 0x31, 0xd6, 0xa0,
-	0xb6, 0x23, 0x98, 0x4b, 0xa6, 0x82, 0x08, 0x6e, 0xa6, 0x82, 0xe0, 0x42, 0xd1, 0x0e, 0x2a, 0xb8,
-	0x19, 0x6e, 0x9a, 0x33, 0x69, 0xa0, 0xcd, 0xcd, 0xe4, 0xa6, 0xad, 0x45, 0xba, 0xf1, 0x0b, 0x28,
-	0xe8, 0xd7, 0x71, 0x3f, 0xcb, 0x01, 0x37, 0xae, 0x44, 0x5a, 0x37, 0x7e, 0x0b, 0xe9, 0xfd, 0x53,
-	0x6d, 0xd3, 0x69, 0x18, 0x70, 0x97, 0x9e, 0x73, 0x9e, 0xf3, 0xfc, 0x7a, 0xf2, 0xb4, 0x68, 0x27,
-	0x0a, 0xbd, 0x30, 0xe9, 0x13, 0x1f, 0x06, 0x01, 0xe5, 0x64, 0xe0, 0x92, 0xe3, 0x3e, 0x24, 0x23,
-	0x27, 0x4e, 0x58, 0xca, 0xf0, 0x96, 0xec, 0x3a, 0xb2, 0xeb, 0x0c, 0x5c, 0xf3, 0x4e, 0x9b, 0xf1,
-	0x1e, 0xe3, 0xc4, 0xa3, 0x1c, 0xe4, 0x28, 0x19, 0xb8, 0x1e, 0xa4, 0xd4, 0x25, 0x31, 0x0d, 0xc2,
-	0x88, 0xa6, 0x21, 0x8b, 0xa4, 0xda, 0xb4, 0x32, 0xbb, 0x03, 0x88, 0x80, 0x87, 0x5c, 0xf5, 0xaf,
-	0x65, 0xfa, 0xca, 0x47, 0xb6, 0xcb, 0x01, 0x0b, 0x98, 0x78, 0x24, 0xb3, 0x27, 0x55, 0xdd, 0x09,
-	0x18, 0x0b, 0xba, 0x40, 0x68, 0x1c, 0x12, 0x1a, 0x45, 0x2c, 0x15, 0x8e, 0x4a, 0x63, 0x37, 0xd0,
-	0xf6, 0x8b, 0x19, 0xd4, 0x63, 0x80, 0x83, 0x0e, 0x4d, 0x80, 0xb7, 0xe0, 0xb8, 0x0f, 0x3c, 0xc5,
-	0x26, 0x2a, 0xf9, 0x10, 0x77, 0xd9, 0x08, 0x92, 0x8a, 0x51, 0x35, 0xea, 0x9b, 0xad, 0xf9, 0x67,
-	0xfb, 0x15, 0xba, 0xba, 0x2c, 0xe2, 0x31, 0x8b, 0x38, 0xe0, 0x87, 0xa8, 0x74, 0x04, 0xc0, 0x67,
-	0xc5, 0x8a, 0x51, 0xfd, 0xbf, 0x7e, 0x71, 0xcf, 0x74, 0x96, 0x4f, 0xe2, 0x68, 0x59, 0x73, 0xe3,
-	0xe4, 0xfb, 0xf5, 0x42, 0x6b, 0xae, 0xb0, 0xf7, 0x51, 0x79, 0x61, 0xaf, 0x66, 0xd9, 0x45, 0x5b,
-	0x6d, 0x16, 0xa5, 0x09, 0x6d, 0xa7, 0x87, 0xd4, 0xf7, 0x13, 0xe0, 0x5c, 0x31, 0x5d, 0xd2, 0xf5,
-	0x7d, 0x59, 0xb6, 0x5f, 0x2e, 0x7d, 0x9f, 0x33, 0xc8, 0x8c, 0x73, 0x92, 0x95, 0x11, 0x16, 0x6b,
-	0x9f, 0xd3, 0x84, 0xf6, 0xf4, 0x8d, 0xec, 0x03, 0x74, 0x65, 0xa1, 0x3a, 0xb7, 0x2a, 0xc6, 0xa2,
-	0xa2, 0x8c, 0xac, 0xac, 0xd1, 0x53, 0xe6, 0xf7, 0xbb, 0x20, 0x75, 0xca, 0x4c, 0x69, 0xec, 0x16,
-	0xba, 0xb1, 0x78, 0xdc, 0xe6, 0xe8, 0x75, 0x98, 0x76, 0xfc, 0x84, 0x0e, 0x21, 0xd1, 0x17, 0xb9,
-	0x8b, 0xf0, 0x70, 0x5e, 0x5c, 0xba, 0xc9, 0xe5, 0x3f, 0x1d, 0x7d, 0x15, 0x0f, 0xd9, 0xeb, 0x76,
-	0xfe, 0x8b, 0x97, 0xb7, 0xf7, 0x6b, 0x03, 0x5d, 0x10, 0x26, 0xf8, 0x83, 0x81, 0x36, 0xe7, 0x4e,
-	0xb8, 0x96, 0xdd, 0xb1, 0x32, 0x71, 0x66, 0x3d, 0x7f, 0x50, 0x82, 0xda, 0xe4, 0xfd, 0xd7, 0x9f,
-	0x9f, 0xfe, 0xdb, 0xc5, 0x35, 0x92, 0xf9, 0x41, 0x1c, 0x01, 0x1c, 0x0a, 0x1e, 0x4e, 0xde, 0xe9,
-	0xbc, 0x8e, 0xf1, 0x67, 0x03, 0x95, 0xf4, 0x1a, 0x7c, 0x3b, 0xc7, 0x47, 0xf3, 0xd4, 0x72, 0xe7,
-	0x14, 0xce, 0x7d, 0x81, 0xe3, 0x62, 0xb2, 0x1e, 0x67, 0x39, 0xc2, 0x63, 0x3c, 0x44, 0x45, 0x19,
-	0x01, 0x7c, 0xf3, 0x0c, 0xaf, 0x85, 0xbc, 0x99, 0xb7, 0x72, 0xa6, 0x14, 0x4f, 0x55, 0xf0, 0x98,
-	0xb8, 0x92, 0xe5, 0x91, 0x19, 0xc3, 0x5f, 0x0c, 0xb4, 0xbd, 0x32, 0x0b, 0xb8, 0x91, 0xf7, 0x12,
-	0x56, 0xa4, 0xd1, 0xbc, 0x77, 0x3e, 0x91, 0xc2, 0x7c, 0x20, 0x30, 0x1b, 0xd8, 0x5d, 0x7f, 0xb6,
-	0x6c, 0xce, 0xc7, 0xcd, 0x27, 0x27, 0x13, 0xcb, 0x38, 0x9d, 0x58, 0xc6, 0x8f, 0x89, 0x65, 0x7c,
-	0x9c, 0x5a, 0x85, 0xd3, 0xa9, 0x55, 0xf8, 0x36, 0xb5, 0x0a, 0x6f, 0x48, 0x10, 0xa6, 0x9d, 0xbe,
-	0xe7, 0xb4, 0x59, 0x8f, 0x3c, 0x13, 0x6b, 0x1f, 0x75, 0x68, 0x18, 0x69, 0x8b, 0xb7, 0x7f, 0x99,
-	0xa4, 0xa3, 0x18, 0xb8, 0x57, 0x14, 0xff, 0x83, 0x8d, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x72,
-	0x1e, 0xa0, 0x89, 0xd8, 0x05, 0x00, 0x00,
+	// 668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x4f, 0x13, 0x41,
+	0x18, 0xc6, 0xbb, 0x2a, 0xff, 0xc6, 0x83, 0x38, 0x16, 0x83, 0x1b, 0x5c, 0x70, 0xa3, 0x50, 0x4c,
+	0xd8, 0xa1, 0xd4, 0x68, 0x4c, 0xbc, 0x50, 0x8c, 0x89, 0x07, 0x8d, 0x96, 0xa8, 0x89, 0x17, 0x32,
+	0xed, 0xbe, 0x6c, 0x37, 0xc2, 0xce, 0x32, 0xb3, 0x6d, 0x6d, 0x4c, 0x2f, 0x7e, 0x01, 0x49, 0xf4,
+	0x53, 0xf8, 0x1d, 0xbc, 0x73, 0x24, 0xf1, 0xe2, 0x49, 0x0d, 0xf8, 0x31, 0x3c, 0x98, 0xce, 0x9f,
+	0x4a, 0xbb, 0xa5, 0x1b, 0x8c, 0x27, 0x96, 0x77, 0xe6, 0x7d, 0x9f, 0xdf, 0x3e, 0xfb, 0x3e, 0x29,
+	0x9a, 0x8b, 0xc2, 0x6a, 0xc8, 0x1b, 0xc4, 0x87, 0x66, 0x40, 0x05, 0x69, 0x16, 0xc9, 0x5e, 0x03,
+	0x78, 0xdb, 0x8b, 0x39, 0x4b, 0x18, 0x9e, 0x56, 0xa7, 0x9e, 0x3a, 0xf5, 0x9a, 0x45, 0xfb, 0x76,
+	0x8d, 0x89, 0x5d, 0x26, 0x48, 0x95, 0x0a, 0x50, 0x57, 0x49, 0xb3, 0x58, 0x85, 0x84, 0x16, 0x49,
+	0x4c, 0x83, 0x30, 0xa2, 0x49, 0xc8, 0x22, 0xd5, 0x6d, 0x3b, 0xa9, 0xd9, 0x01, 0x44, 0x20, 0x42,
+	0xa1, 0xcf, 0xaf, 0xa7, 0xce, 0xb5, 0x8e, 0x3a, 0xce, 0x07, 0x2c, 0x60, 0xf2, 0x91, 0x74, 0x9f,
+	0xcc, 0xd0, 0x93, 0x00, 0x46, 0xba, 0xc6, 0x42, 0x23, 0x3a, 0x17, 0x30, 0x16, 0xec, 0x00, 0xa1,
+	0x71, 0x48, 0x68, 0x14, 0xb1, 0x44, 0x12, 0xe9, 0x99, 0x6e, 0x09, 0xcd, 0x3c, 0xef, 0x42, 0x3f,
+	0x02, 0xd8, 0xac, 0x53, 0x0e, 0xa2, 0x02, 0x7b, 0x0d, 0x10, 0x09, 0xb6, 0xd1, 0xa4, 0x0f, 0xf1,
+	0x0e, 0x6b, 0x03, 0x9f, 0xb5, 0x16, 0xac, 0xc2, 0x54, 0xa5, 0xf7, 0xbf, 0xfb, 0x12, 0x5d, 0x1d,
+	0x6c, 0x12, 0x31, 0x8b, 0x04, 0xe0, 0x07, 0x68, 0x72, 0x1b, 0x40, 0x74, 0x8b, 0xb3, 0xd6, 0xc2,
+	0xf9, 0xc2, 0xc5, 0x35, 0xdb, 0x1b, 0xb4, 0xcc, 0x33, 0x6d, 0xe5, 0x0b, 0x07, 0xdf, 0xe7, 0x73,
+	0x95, 0x5e, 0x87, 0xbb, 0x8e, 0xf2, 0x7d, 0x73, 0x0d, 0xcb, 0x32, 0x9a, 0xae, 0xb1, 0x28, 0xe1,
+	0xb4, 0x96, 0x6c, 0x51, 0xdf, 0xe7, 0x20, 0x84, 0x66, 0xba, 0x64, 0xea, 0xeb, 0xaa, 0xec, 0xbe,
+	0x18, 0x78, 0x9f, 0x53, 0xc8, 0xac, 0x33, 0x92, 0xe5, 0x11, 0x96, 0x63, 0x9f, 0x51, 0x4e, 0x77,
+	0x8d, 0x47, 0xee, 0x26, 0xba, 0xd2, 0x57, 0xed, 0x49, 0x8d, 0xc7, 0xb2, 0xa2, 0x85, 0x9c, 0xb4,
+	0xd0, 0x13, 0xe6, 0x37, 0x76, 0x40, 0xf5, 0x69, 0x31, 0xdd, 0xe3, 0x56, 0xd0, 0x8d, 0x7e, 0x73,
+	0xcb, 0xed, 0x57, 0x61, 0x52, 0xf7, 0x39, 0x6d, 0x01, 0x37, 0x8e, 0xac, 0x20, 0xdc, 0xea, 0x15,
+	0x07, 0x3c, 0xb9, 0xfc, 0xf7, 0xc4, 0xb8, 0x52, 0x45, 0xee, 0xa8, 0x99, 0xff, 0xe5, 0xe3, 0x3d,
+	0xd4, 0x66, 0x54, 0xa0, 0x45, 0xb9, 0x2f, 0xfe, 0x91, 0xb4, 0x83, 0xf2, 0xfd, 0x53, 0x34, 0x1b,
+	0xa0, 0x09, 0xae, 0x4a, 0x1a, 0xed, 0x9a, 0xa7, 0xf6, 0xde, 0xeb, 0xee, 0xbd, 0xa7, 0xf7, 0xde,
+	0xdb, 0x60, 0x61, 0x54, 0x5e, 0xed, 0x92, 0x7d, 0xfe, 0x31, 0x5f, 0x08, 0xc2, 0xa4, 0xde, 0xa8,
+	0x7a, 0x35, 0xb6, 0x4b, 0x74, 0x48, 0xd4, 0x9f, 0x15, 0xe1, 0xbf, 0x21, 0x49, 0x3b, 0x06, 0x21,
+	0x1b, 0x44, 0xc5, 0xcc, 0x5e, 0xfb, 0x3d, 0x86, 0xc6, 0xa4, 0x3e, 0xfe, 0x60, 0xa1, 0xa9, 0x9e,
+	0x5d, 0x78, 0x29, 0x6d, 0xc4, 0xd0, 0xd8, 0xd8, 0x85, 0xec, 0x8b, 0xea, 0x8d, 0x5c, 0xf2, 0xfe,
+	0xeb, 0xaf, 0x8f, 0xe7, 0x96, 0xf1, 0x12, 0x49, 0xa5, 0x7e, 0x1b, 0x60, 0x4b, 0x9a, 0x2a, 0xc8,
+	0x3b, 0x13, 0xba, 0x0e, 0xfe, 0x64, 0xa1, 0x49, 0x33, 0x06, 0x2f, 0x66, 0xe8, 0x18, 0x9e, 0xa5,
+	0xcc, 0x7b, 0x1a, 0xe7, 0x9e, 0xc4, 0x29, 0x62, 0x32, 0x1a, 0x67, 0x30, 0x87, 0x1d, 0xdc, 0x42,
+	0xe3, 0x6a, 0x8f, 0xf1, 0xcd, 0x53, 0xb4, 0xfa, 0x42, 0x63, 0xdf, 0xca, 0xb8, 0xa5, 0x79, 0x16,
+	0x24, 0x8f, 0x8d, 0x67, 0xd3, 0x3c, 0x2a, 0x28, 0xf8, 0x8b, 0x85, 0x66, 0x86, 0x2e, 0x34, 0x2e,
+	0x65, 0x7d, 0x84, 0x21, 0x91, 0xb2, 0xef, 0x9c, 0xad, 0x49, 0x63, 0xde, 0x97, 0x98, 0x25, 0x5c,
+	0x1c, 0x6d, 0x5b, 0x3a, 0x02, 0x1d, 0xbc, 0x6f, 0xa1, 0x09, 0xbd, 0xe6, 0xf8, 0x34, 0x53, 0xfa,
+	0xc3, 0x64, 0x2f, 0x66, 0x5d, 0xd3, 0x54, 0x77, 0x25, 0xd5, 0x2a, 0xf6, 0xd2, 0x54, 0x7a, 0xd3,
+	0x87, 0x22, 0x95, 0x1f, 0x1f, 0x1c, 0x39, 0xd6, 0xe1, 0x91, 0x63, 0xfd, 0x3c, 0x72, 0xac, 0xfd,
+	0x63, 0x27, 0x77, 0x78, 0xec, 0xe4, 0xbe, 0x1d, 0x3b, 0xb9, 0xd7, 0xe4, 0x44, 0x96, 0x9e, 0xca,
+	0x99, 0x1b, 0x75, 0x1a, 0x46, 0x66, 0xfe, 0xdb, 0x13, 0x0a, 0x32, 0x58, 0xd5, 0x71, 0xf9, 0xfb,
+	0x52, 0xfa, 0x33, 0x00, 0x0a, 0xb3, 0x3f, 0x72, 0x50, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -467,6 +569,9 @@ type QueryClient interface {
 	// FeeSharesByWithdrawer retrieves all FeeShares with a given withdrawer
 	// address
 	FeeSharesByWithdrawer(ctx context.Context, in *QueryFeeSharesByWithdrawerRequest, opts ...grpc.CallOption) (*QueryFeeSharesByWithdrawerResponse, error)
+	// Rewards retrieves the transaction fees accrued by a withdrawer and not yet
+	// paid out
+	Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error)
 }
 
 type queryClient struct {
@@ -513,6 +618,15 @@ func (c *queryClient) FeeSharesByWithdrawer(ctx context.Context, in *QueryFeeSha
 	return out, nil
 }
 
+func (c *queryClient) Rewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error) {
+	out := new(QueryRewardsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.devgas.v1.Query/Rewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// FeeShares retrieves all FeeShares that a deployer has
@@ -525,6 +639,9 @@ type QueryServer interface {
 	// FeeSharesByWithdrawer retrieves all FeeShares with a given withdrawer
 	// address
 	FeeSharesByWithdrawer(context.Context, *QueryFeeSharesByWithdrawerRequest) (*QueryFeeSharesByWithdrawerResponse, error)
+	// Rewards retrieves the transaction fees accrued by a withdrawer and not yet
+	// paid out
+	Rewards(context.Context, *QueryRewardsRequest) (*QueryRewardsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -543,6 +660,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) FeeSharesByWithdrawer(ctx context.Context, req *QueryFeeSharesByWithdrawerRequest) (*QueryFeeSharesByWithdrawerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeeSharesByWithdrawer not implemented")
 }
+func (*UnimplementedQueryServer) Rewards(ctx context.Context, req *QueryRewardsRequest) (*QueryRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rewards not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -620,6 +740,24 @@ func _Query_FeeSharesByWithdrawer_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Rewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Rewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.devgas.v1.Query/Rewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Rewards(ctx, req.(*QueryRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.devgas.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -640,6 +778,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FeeSharesByWithdrawer",
 			Handler:    _Query_FeeSharesByWithdrawer_Handler,
 		},
+		{
+			MethodName: "Rewards",
+			Handler:    _Query_Rewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/devgas/v1/query.proto",
@@ -898,6 +1040,73 @@ func (m *QueryFeeSharesByWithdrawerResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1009,6 +1218,34 @@ func (m *QueryFeeSharesByWithdrawerResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1645,6 +1882,172 @@ func (m *QueryFeeSharesByWithdrawerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
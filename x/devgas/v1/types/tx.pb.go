@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -41,6 +43,9 @@ type MsgRegisterFeeShare struct {
 	// withdrawer_address is the bech32 address of account receiving the
 	// transaction fees
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// recipients split the transaction fees by weight. If empty, the withdrawer
+	// receives all of them.
+	Recipients []Recipient `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients"`
 }
 
 func (m *MsgRegisterFeeShare) Reset()         { *m = MsgRegisterFeeShare{} }
@@ -97,6 +102,13 @@ func (m *MsgRegisterFeeShare) GetWithdrawerAddress() string {
 	return ""
 }
 
+func (m *MsgRegisterFeeShare) GetRecipients() []Recipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
 // MsgRegisterFeeShareResponse defines the MsgRegisterFeeShare response type
 type MsgRegisterFeeShareResponse struct {
 }
@@ -145,6 +157,9 @@ type MsgUpdateFeeShare struct {
 	// withdrawer_address is the bech32 address of account receiving the
 	// transaction fees
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// recipients split the transaction fees by weight. If empty, the withdrawer
+	// receives all of them.
+	Recipients []Recipient `protobuf:"bytes,4,rep,name=recipients,proto3" json:"recipients"`
 }
 
 func (m *MsgUpdateFeeShare) Reset()         { *m = MsgUpdateFeeShare{} }
@@ -201,6 +216,13 @@ func (m *MsgUpdateFeeShare) GetWithdrawerAddress() string {
 	return ""
 }
 
+func (m *MsgUpdateFeeShare) GetRecipients() []Recipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
 // MsgUpdateFeeShareResponse defines the MsgUpdateFeeShare response type
 type MsgUpdateFeeShareResponse struct {
 }
@@ -431,6 +453,101 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgWithdrawDevGasRewards defines a message that pays out the transaction
+// fees accrued by a withdrawer
+type MsgWithdrawDevGasRewards struct {
+	// withdrawer_address is the bech32 address of the account that accrued the
+	// rewards
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+}
+
+func (m *MsgWithdrawDevGasRewards) Reset()         { *m = MsgWithdrawDevGasRewards{} }
+func (m *MsgWithdrawDevGasRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawDevGasRewards) ProtoMessage()    {}
+func (*MsgWithdrawDevGasRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72949c99a02cd615, []int{8}
+}
+func (m *MsgWithdrawDevGasRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawDevGasRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawDevGasRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawDevGasRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawDevGasRewards.Merge(m, src)
+}
+func (m *MsgWithdrawDevGasRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawDevGasRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawDevGasRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawDevGasRewards proto.InternalMessageInfo
+
+func (m *MsgWithdrawDevGasRewards) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+// MsgWithdrawDevGasRewardsResponse defines the MsgWithdrawDevGasRewards
+// response type
+type MsgWithdrawDevGasRewardsResponse struct {
+	// rewards paid out to the withdrawer
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+}
+
+func (m *MsgWithdrawDevGasRewardsResponse) Reset()         { *m = MsgWithdrawDevGasRewardsResponse{} }
+func (m *MsgWithdrawDevGasRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawDevGasRewardsResponse) ProtoMessage()    {}
+func (*MsgWithdrawDevGasRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72949c99a02cd615, []int{9}
+}
+func (m *MsgWithdrawDevGasRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawDevGasRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawDevGasRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawDevGasRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawDevGasRewardsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawDevGasRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawDevGasRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawDevGasRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawDevGasRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawDevGasRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgRegisterFeeShare)(nil), "nibiru.devgas.v1.MsgRegisterFeeShare")
 	proto.RegisterType((*MsgRegisterFeeShareResponse)(nil), "nibiru.devgas.v1.MsgRegisterFeeShareResponse")
@@ -440,48 +557,61 @@ func init() {
 	proto.RegisterType((*MsgCancelFeeShareResponse)(nil), "nibiru.devgas.v1.MsgCancelFeeShareResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "nibiru.devgas.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "nibiru.devgas.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgWithdrawDevGasRewards)(nil), "nibiru.devgas.v1.MsgWithdrawDevGasRewards")
+	proto.RegisterType((*MsgWithdrawDevGasRewardsResponse)(nil), "nibiru.devgas.v1.MsgWithdrawDevGasRewardsResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/devgas/v1/tx.proto", fileDescriptor_72949c99a02cd615) }
 
 var fileDescriptor_72949c99a02cd615 = []byte{
-	// 565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x41, 0x6b, 0x13, 0x4d,
-	0x18, 0xc7, 0xb3, 0x6d, 0x28, 0x74, 0xde, 0x97, 0x36, 0x5d, 0x0b, 0x6d, 0x52, 0xdd, 0xea, 0xaa,
-	0xa5, 0x55, 0xb3, 0x43, 0x2b, 0x78, 0x28, 0x5e, 0x4c, 0x41, 0xf0, 0x10, 0x91, 0x14, 0x2f, 0x22,
-	0x84, 0xc9, 0xee, 0x30, 0x19, 0x48, 0x76, 0x96, 0x99, 0xd9, 0xb4, 0xb9, 0xf6, 0x13, 0x14, 0x3c,
-	0xe8, 0x49, 0x7a, 0xf0, 0x03, 0x78, 0xf0, 0x43, 0xf4, 0x58, 0xf4, 0xe2, 0x49, 0x24, 0x11, 0xf4,
-	0x63, 0x48, 0x66, 0x66, 0x37, 0xdd, 0x64, 0x5b, 0x7b, 0x11, 0xbc, 0x25, 0xf3, 0xff, 0x3d, 0xcf,
-	0xf3, 0xdb, 0xe5, 0x99, 0x05, 0xe5, 0x90, 0xb6, 0x28, 0x8f, 0x61, 0x80, 0x7b, 0x04, 0x09, 0xd8,
-	0xdb, 0x86, 0xf2, 0xd0, 0x8b, 0x38, 0x93, 0xcc, 0x2e, 0xe9, 0xc8, 0xd3, 0x91, 0xd7, 0xdb, 0xae,
-	0x2c, 0x13, 0x46, 0x98, 0x0a, 0xe1, 0xe8, 0x97, 0xe6, 0x2a, 0xd7, 0x09, 0x63, 0xa4, 0x83, 0x21,
-	0x8a, 0x28, 0x44, 0x61, 0xc8, 0x24, 0x92, 0x94, 0x85, 0xc2, 0xa4, 0x2b, 0x3e, 0x13, 0x5d, 0x26,
-	0x60, 0x57, 0x90, 0x51, 0xf7, 0xae, 0x20, 0x26, 0x28, 0xeb, 0xa0, 0xa9, 0xfb, 0xe9, 0x3f, 0x26,
-	0x72, 0xa6, 0xa4, 0x08, 0x0e, 0xb1, 0xa0, 0x26, 0x77, 0x4f, 0x2c, 0x70, 0xad, 0x2e, 0x48, 0x03,
-	0x13, 0x2a, 0x24, 0xe6, 0x4f, 0x31, 0xde, 0x6f, 0x23, 0x8e, 0xed, 0x2d, 0x50, 0xf2, 0x59, 0x28,
-	0x39, 0xf2, 0x65, 0x13, 0x05, 0x01, 0xc7, 0x42, 0xac, 0x5a, 0x37, 0xad, 0xcd, 0xf9, 0xc6, 0x62,
-	0x72, 0xfe, 0x44, 0x1f, 0x8f, 0xd0, 0x00, 0x47, 0x1d, 0xd6, 0xc7, 0x3c, 0x45, 0x67, 0x34, 0x9a,
-	0x9c, 0x27, 0x68, 0x15, 0xd8, 0x07, 0x54, 0xb6, 0x03, 0x8e, 0x0e, 0xce, 0xc1, 0xb3, 0x0a, 0x5e,
-	0x1a, 0x27, 0x06, 0xdf, 0x2d, 0xfe, 0x3a, 0x59, 0x2f, 0xb8, 0x37, 0xc0, 0x5a, 0x8e, 0x61, 0x03,
-	0x8b, 0x88, 0x85, 0x02, 0xbb, 0xef, 0x2d, 0xb0, 0x54, 0x17, 0xe4, 0x65, 0x14, 0x20, 0x89, 0xff,
-	0x45, 0xff, 0x35, 0x50, 0x9e, 0xf2, 0x4b, 0xed, 0x99, 0x92, 0xdf, 0x43, 0xa1, 0x8f, 0x3b, 0x7f,
-	0x57, 0x3e, 0x63, 0x93, 0x1d, 0x98, 0xda, 0xbc, 0xb5, 0xc0, 0x62, 0xea, 0xfa, 0x02, 0x71, 0xd4,
-	0x15, 0xf6, 0x23, 0x30, 0x8f, 0x62, 0xd9, 0x66, 0x9c, 0xca, 0xbe, 0xb6, 0xa8, 0xad, 0x7e, 0xfe,
-	0x54, 0x5d, 0x36, 0x6b, 0x66, 0xba, 0xef, 0x4b, 0x4e, 0x43, 0xd2, 0x18, 0xa3, 0xf6, 0x63, 0x30,
-	0x17, 0xa9, 0x0e, 0xca, 0xe7, 0xbf, 0x1d, 0xc7, 0x9b, 0xbc, 0x04, 0x5e, 0x9d, 0x05, 0x71, 0xc7,
-	0xcc, 0xa9, 0x15, 0x4f, 0xbf, 0xad, 0x17, 0x1a, 0xa6, 0x66, 0x77, 0xe1, 0xe8, 0xe7, 0xc7, 0x7b,
-	0xe3, 0x6e, 0x6e, 0x19, 0xac, 0x4c, 0x88, 0x25, 0xd2, 0x3b, 0x1f, 0x8a, 0x60, 0xb6, 0x2e, 0x88,
-	0xfd, 0xce, 0x02, 0xa5, 0xa9, 0x3d, 0xbe, 0x9b, 0x33, 0x75, 0x7a, 0x99, 0x2a, 0xd5, 0x2b, 0x61,
-	0xe9, 0x7b, 0xf2, 0x8e, 0xbe, 0xfc, 0x78, 0x33, 0xb3, 0xe9, 0x6e, 0xc0, 0x9c, 0x3b, 0x0f, 0xb9,
-	0x29, 0x6b, 0xa6, 0x16, 0xc7, 0x16, 0x58, 0x98, 0x58, 0xd0, 0xdb, 0xb9, 0x13, 0xb3, 0x50, 0xe5,
-	0xfe, 0x15, 0xa0, 0x54, 0xea, 0x81, 0x92, 0xda, 0x70, 0xef, 0xe4, 0x4a, 0xc5, 0xaa, 0x28, 0xab,
-	0x34, 0xb1, 0x76, 0xf9, 0x4a, 0x59, 0xe8, 0x02, 0xa5, 0x0b, 0xf6, 0xe9, 0x72, 0x25, 0x5f, 0x15,
-	0x8d, 0x95, 0x5e, 0x83, 0xff, 0x33, 0x9b, 0x77, 0xeb, 0x92, 0xa7, 0xd7, 0x48, 0x65, 0xeb, 0x8f,
-	0x48, 0xe2, 0x52, 0x7b, 0x76, 0x3a, 0x70, 0xac, 0xb3, 0x81, 0x63, 0x7d, 0x1f, 0x38, 0xd6, 0xf1,
-	0xd0, 0x29, 0x9c, 0x0d, 0x9d, 0xc2, 0xd7, 0xa1, 0x53, 0x78, 0x05, 0x09, 0x95, 0xed, 0xb8, 0xe5,
-	0xf9, 0xac, 0x0b, 0x9f, 0xab, 0x76, 0x7b, 0x6d, 0x44, 0xc3, 0xc4, 0xf9, 0xf0, 0xbc, 0x75, 0x3f,
-	0xc2, 0xa2, 0x35, 0xa7, 0xbe, 0x9d, 0x0f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x24, 0xc1,
-	0x45, 0xf2, 0x05, 0x00, 0x00,
+	// 738 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xbd, 0x6f, 0xd3, 0x4c,
+	0x1c, 0x8e, 0xdb, 0xbe, 0x7d, 0xd5, 0xeb, 0xab, 0x7e, 0xe4, 0x2d, 0x6a, 0xe2, 0x50, 0xb7, 0x18,
+	0x5a, 0xd2, 0x96, 0xd8, 0x24, 0x48, 0x0c, 0x15, 0x4b, 0x53, 0x04, 0x62, 0x08, 0x42, 0xae, 0x10,
+	0x12, 0x42, 0x8a, 0x2e, 0xf6, 0x71, 0x39, 0x91, 0xf8, 0xac, 0xbb, 0x4b, 0xda, 0xae, 0xdd, 0xd8,
+	0x2a, 0x31, 0xc0, 0xc8, 0xc4, 0xc0, 0xc4, 0xc0, 0x1f, 0xd1, 0x05, 0xa9, 0x82, 0x85, 0x89, 0x8f,
+	0x06, 0x09, 0xfe, 0x0c, 0x64, 0xfb, 0xec, 0x7c, 0xb9, 0x90, 0x85, 0x85, 0x29, 0xc9, 0x3d, 0xcf,
+	0xef, 0xb9, 0xe7, 0xf7, 0xdc, 0xfd, 0x2e, 0x20, 0xeb, 0x92, 0x1a, 0x61, 0x2d, 0xd3, 0x41, 0x6d,
+	0x0c, 0xb9, 0xd9, 0x2e, 0x9a, 0x62, 0xdf, 0xf0, 0x18, 0x15, 0x34, 0x3d, 0x17, 0x42, 0x46, 0x08,
+	0x19, 0xed, 0xa2, 0xba, 0x80, 0x29, 0xa6, 0x01, 0x68, 0xfa, 0xdf, 0x42, 0x9e, 0x7a, 0x1e, 0x53,
+	0x8a, 0x1b, 0xc8, 0x84, 0x1e, 0x31, 0xa1, 0xeb, 0x52, 0x01, 0x05, 0xa1, 0x2e, 0x97, 0xe8, 0xa2,
+	0x4d, 0x79, 0x93, 0x72, 0xb3, 0xc9, 0xb1, 0xaf, 0xde, 0xe4, 0x58, 0x02, 0xd9, 0x10, 0xa8, 0x86,
+	0x7a, 0xe1, 0x0f, 0x09, 0x69, 0xb2, 0xa6, 0x06, 0x39, 0x32, 0xdb, 0xc5, 0x1a, 0x12, 0xb0, 0x68,
+	0xda, 0x94, 0xb8, 0x12, 0x5f, 0x1a, 0x32, 0x2d, 0x3d, 0xca, 0xf2, 0x21, 0x18, 0x23, 0x17, 0x71,
+	0x22, 0x71, 0xbd, 0xa3, 0x80, 0xff, 0x2b, 0x1c, 0x5b, 0x08, 0x13, 0x2e, 0x10, 0xbb, 0x85, 0xd0,
+	0x6e, 0x1d, 0x32, 0x94, 0x5e, 0x07, 0x73, 0x36, 0x75, 0x05, 0x83, 0xb6, 0xa8, 0x42, 0xc7, 0x61,
+	0x88, 0xf3, 0x8c, 0xb2, 0xa2, 0xe4, 0xa7, 0xac, 0xd9, 0x68, 0x7d, 0x3b, 0x5c, 0xf6, 0xa9, 0x0e,
+	0xf2, 0x1a, 0xf4, 0x00, 0xb1, 0x98, 0x3a, 0x16, 0x52, 0xa3, 0xf5, 0x88, 0x5a, 0x00, 0xe9, 0x3d,
+	0x22, 0xea, 0x0e, 0x83, 0x7b, 0x3d, 0xe4, 0xf1, 0x80, 0x3c, 0xdf, 0x45, 0x22, 0xfa, 0x36, 0x00,
+	0x0c, 0xd9, 0xc4, 0x23, 0xc8, 0x15, 0x3c, 0x33, 0xb1, 0x32, 0x9e, 0x9f, 0x2e, 0xe5, 0x8c, 0xc1,
+	0xa3, 0x30, 0xac, 0x88, 0x53, 0x9e, 0x38, 0xfe, 0xb4, 0x9c, 0xb2, 0x7a, 0x8a, 0xb6, 0x26, 0x7e,
+	0xbc, 0x5c, 0x4e, 0xe9, 0x4b, 0x20, 0x97, 0xd0, 0xa4, 0x85, 0xb8, 0x47, 0x5d, 0x8e, 0xf4, 0xaf,
+	0x0a, 0x98, 0xaf, 0x70, 0x7c, 0xdf, 0x73, 0xa0, 0x40, 0x7f, 0x69, 0x04, 0x39, 0x90, 0x1d, 0x6a,
+	0x31, 0x0e, 0x80, 0x06, 0xfd, 0xef, 0x40, 0xd7, 0x46, 0x8d, 0x3f, 0xdb, 0x7f, 0x9f, 0x9b, 0xfe,
+	0x0d, 0x63, 0x37, 0xcf, 0x15, 0x30, 0x1b, 0x7b, 0xbd, 0x07, 0x19, 0x6c, 0xf2, 0xf4, 0x75, 0x30,
+	0x05, 0x5b, 0xa2, 0x4e, 0x19, 0x11, 0x07, 0xa1, 0x8b, 0x72, 0xe6, 0xfd, 0xdb, 0xc2, 0x82, 0x9c,
+	0x15, 0xa9, 0xbe, 0x2b, 0x18, 0x71, 0xb1, 0xd5, 0xa5, 0xa6, 0x6f, 0x80, 0x49, 0x2f, 0x50, 0x08,
+	0xfc, 0x4c, 0x97, 0xb4, 0xe1, 0xec, 0x2a, 0xd4, 0x69, 0x35, 0xe4, 0x3e, 0x32, 0x3e, 0x59, 0xb3,
+	0x35, 0x73, 0xf8, 0xfd, 0xcd, 0x46, 0x57, 0x4d, 0xcf, 0x82, 0xc5, 0x01, 0x63, 0xb1, 0xe9, 0xc7,
+	0x20, 0x53, 0xe1, 0xf8, 0x81, 0x3c, 0xc0, 0x9b, 0xa8, 0x7d, 0x1b, 0x72, 0x0b, 0xed, 0x41, 0xe6,
+	0x9c, 0x75, 0xe6, 0xca, 0x19, 0x67, 0xbe, 0x95, 0xf3, 0x23, 0xf2, 0x77, 0x4e, 0xa8, 0xd2, 0x9f,
+	0x2a, 0x60, 0xe5, 0xac, 0x8d, 0x22, 0x33, 0x69, 0x04, 0xfe, 0x65, 0xe1, 0x52, 0x46, 0x09, 0xae,
+	0x4c, 0xd6, 0x90, 0x41, 0xf9, 0xcf, 0x88, 0x21, 0x9f, 0x11, 0x63, 0x87, 0x12, 0xb7, 0x7c, 0xd5,
+	0xef, 0xf8, 0xf5, 0xe7, 0xe5, 0x3c, 0x26, 0xa2, 0xde, 0xaa, 0x19, 0x36, 0x6d, 0xca, 0x17, 0x48,
+	0x7e, 0x14, 0xb8, 0xf3, 0xc4, 0x14, 0x07, 0x1e, 0xe2, 0x41, 0x01, 0xb7, 0x22, 0xed, 0xd2, 0xbb,
+	0x7f, 0xc0, 0x78, 0x85, 0xe3, 0xf4, 0x0b, 0x05, 0xcc, 0x0d, 0xbd, 0x20, 0xab, 0x09, 0x49, 0x0f,
+	0xcf, 0xa0, 0x5a, 0x18, 0x89, 0x16, 0xc7, 0x6c, 0x1c, 0x7e, 0xf8, 0xf6, 0x6c, 0x2c, 0xaf, 0xaf,
+	0x99, 0x09, 0x8f, 0xb5, 0xc9, 0x64, 0x59, 0x35, 0x76, 0x71, 0xa4, 0x80, 0x99, 0x81, 0xb9, 0xbe,
+	0x98, 0xb8, 0x63, 0x3f, 0x49, 0xdd, 0x1c, 0x81, 0x14, 0x9b, 0xba, 0x12, 0x98, 0x5a, 0xd3, 0x2f,
+	0x25, 0x9a, 0x6a, 0x05, 0x45, 0xfd, 0x96, 0x06, 0x46, 0x2d, 0xd9, 0x52, 0x3f, 0x49, 0xdd, 0x1c,
+	0x81, 0x34, 0xa2, 0x25, 0x3b, 0x28, 0xea, 0x5a, 0x7a, 0x04, 0xfe, 0xeb, 0x9b, 0xb6, 0x0b, 0xbf,
+	0xe8, 0x3e, 0xa4, 0xa8, 0xeb, 0xbf, 0xa5, 0xc4, 0xb7, 0xf1, 0x95, 0x02, 0xce, 0x25, 0x0f, 0xc6,
+	0x46, 0xa2, 0x48, 0x22, 0x57, 0x2d, 0x8d, 0xce, 0x8d, 0x53, 0x28, 0x04, 0x29, 0x5c, 0xd6, 0x57,
+	0x13, 0x53, 0x88, 0xa6, 0xab, 0x2a, 0xef, 0x73, 0xf9, 0xce, 0xf1, 0xa9, 0xa6, 0x9c, 0x9c, 0x6a,
+	0xca, 0x97, 0x53, 0x4d, 0x39, 0xea, 0x68, 0xa9, 0x93, 0x8e, 0x96, 0xfa, 0xd8, 0xd1, 0x52, 0x0f,
+	0xcd, 0x9e, 0xe1, 0xb8, 0x1b, 0x48, 0xed, 0xd4, 0x21, 0x71, 0x23, 0xd9, 0xfd, 0x5e, 0x61, 0x7f,
+	0x52, 0x6a, 0x93, 0xc1, 0xdf, 0xeb, 0xb5, 0x9f, 0x03, 0x00, 0x7c, 0x21, 0x81, 0xe9, 0x54, 0x08,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -505,6 +635,8 @@ type MsgClient interface {
 	CancelFeeShare(ctx context.Context, in *MsgCancelFeeShare, opts ...grpc.CallOption) (*MsgCancelFeeShareResponse, error)
 	// Update the params of the module through gov v1 type.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// WithdrawDevGasRewards pays out the transaction fees accrued by the sender
+	WithdrawDevGasRewards(ctx context.Context, in *MsgWithdrawDevGasRewards, opts ...grpc.CallOption) (*MsgWithdrawDevGasRewardsResponse, error)
 }
 
 type msgClient struct {
@@ -551,6 +683,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) WithdrawDevGasRewards(ctx context.Context, in *MsgWithdrawDevGasRewards, opts ...grpc.CallOption) (*MsgWithdrawDevGasRewardsResponse, error) {
+	out := new(MsgWithdrawDevGasRewardsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.devgas.v1.Msg/WithdrawDevGasRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterFeeShare registers a new contract for receiving transaction fees
@@ -562,6 +703,8 @@ type MsgServer interface {
 	CancelFeeShare(context.Context, *MsgCancelFeeShare) (*MsgCancelFeeShareResponse, error)
 	// Update the params of the module through gov v1 type.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// WithdrawDevGasRewards pays out the transaction fees accrued by the sender
+	WithdrawDevGasRewards(context.Context, *MsgWithdrawDevGasRewards) (*MsgWithdrawDevGasRewardsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -580,6 +723,9 @@ func (*UnimplementedMsgServer) CancelFeeShare(ctx context.Context, req *MsgCance
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawDevGasRewards(ctx context.Context, req *MsgWithdrawDevGasRewards) (*MsgWithdrawDevGasRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawDevGasRewards not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -657,6 +803,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawDevGasRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawDevGasRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawDevGasRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.devgas.v1.Msg/WithdrawDevGasRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawDevGasRewards(ctx, req.(*MsgWithdrawDevGasRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.devgas.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -677,6 +841,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "WithdrawDevGasRewards",
+			Handler:    _Msg_WithdrawDevGasRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/devgas/v1/tx.proto",
@@ -702,6 +870,20 @@ func (m *MsgRegisterFeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.WithdrawerAddress) > 0 {
 		i -= len(m.WithdrawerAddress)
 		copy(dAtA[i:], m.WithdrawerAddress)
@@ -769,6 +951,20 @@ func (m *MsgUpdateFeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.WithdrawerAddress) > 0 {
 		i -= len(m.WithdrawerAddress)
 		copy(dAtA[i:], m.WithdrawerAddress)
@@ -939,6 +1135,73 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawDevGasRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawDevGasRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawDevGasRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawDevGasRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawDevGasRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawDevGasRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -968,6 +1231,12 @@ func (m *MsgRegisterFeeShare) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -998,6 +1267,12 @@ func (m *MsgUpdateFeeShare) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1060,6 +1335,34 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawDevGasRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawDevGasRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1191,6 +1494,40 @@ func (m *MsgRegisterFeeShare) Unmarshal(dAtA []byte) error {
 			}
 			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, Recipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1387,6 +1724,40 @@ func (m *MsgUpdateFeeShare) Unmarshal(dAtA []byte) error {
 			}
 			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, Recipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1787,6 +2158,172 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawDevGasRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDevGasRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDevGasRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawDevGasRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDevGasRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDevGasRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13,38 +13,73 @@ import (
 // BeginBlocker of epochs module.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+	params := k.GetParams(ctx)
+
+	// Only the fire all policy ends several epochs of an identifier per block.
+	maxEpochs := uint32(1)
+	if params.CatchUpPolicy == types.CATCH_UP_FIRE_ALL {
+		maxEpochs = params.MaxEpochsPerBlock
+	}
+
 	k.IterateEpochInfo(ctx, func(index int64, epochInfo types.EpochInfo) (stop bool) {
 		if ctx.BlockTime().Before(epochInfo.StartTime) {
 			return false
 		}
 
-		if !shouldEpochStart(epochInfo, ctx) {
-			return false
+		for i := uint32(0); i < maxEpochs && shouldEpochStart(epochInfo, ctx); i++ {
+			epochInfo = startNextEpoch(ctx, k, epochInfo, nextEpochStartTime(epochInfo, ctx, params.CatchUpPolicy))
 		}
 
-		epochInfo.CurrentEpochStartHeight = ctx.BlockHeight()
-		epochInfo.CurrentEpochStartTime = ctx.BlockTime()
-
-		if !epochInfo.EpochCountingStarted {
-			epochInfo.EpochCountingStarted = true
-			epochInfo.CurrentEpoch = 1
-		} else {
-			_ = ctx.EventManager().EmitTypedEvent(&types.EventEpochEnd{EpochNumber: epochInfo.CurrentEpoch})
-			k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
-			epochInfo.CurrentEpoch += 1
-		}
+		return false
+	})
+}
 
-		// emit new epoch start event, set epoch info, and run BeforeEpochStart hook
-		_ = ctx.EventManager().EmitTypedEvent(&types.EventEpochStart{
-			EpochNumber:    epochInfo.CurrentEpoch,
-			EpochStartTime: epochInfo.CurrentEpochStartTime,
-		})
+// startNextEpoch ends the current epoch, if the epoch counting started, and
+// starts the next one at startTime, running the epoch hooks.
+func startNextEpoch(ctx sdk.Context, k keeper.Keeper, epochInfo types.EpochInfo, startTime time.Time) types.EpochInfo {
+	epochInfo.CurrentEpochStartHeight = ctx.BlockHeight()
+	epochInfo.CurrentEpochStartTime = startTime
 
-		k.Epochs.Insert(ctx, epochInfo.Identifier, epochInfo)
-		k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+	if !epochInfo.EpochCountingStarted {
+		epochInfo.EpochCountingStarted = true
+		epochInfo.CurrentEpoch = 1
+	} else {
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventEpochEnd{EpochNumber: epochInfo.CurrentEpoch})
+		k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+		epochInfo.CurrentEpoch += 1
+	}
 
-		return false
+	// emit new epoch start event, set epoch info, and run BeforeEpochStart hook
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventEpochStart{
+		EpochNumber:    epochInfo.CurrentEpoch,
+		EpochStartTime: epochInfo.CurrentEpochStartTime,
 	})
+
+	k.Epochs.Insert(ctx, epochInfo.Identifier, epochInfo)
+	k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+
+	return epochInfo
+}
+
+// nextEpochStartTime returns the start time of the epoch following the
+// current one under the catch-up policy:
+// - none: the block time.
+// - fire all: the end time of the current epoch.
+// - skip: the last start time of the schedule before the block time.
+func nextEpochStartTime(epochInfo types.EpochInfo, ctx sdk.Context, policy types.CatchUpPolicy) time.Time {
+	if !epochInfo.EpochCountingStarted {
+		return ctx.BlockTime()
+	}
+
+	switch policy {
+	case types.CATCH_UP_FIRE_ALL:
+		return epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+	case types.CATCH_UP_SKIP:
+		elapsed := ctx.BlockTime().Sub(epochInfo.CurrentEpochStartTime)
+		return epochInfo.CurrentEpochStartTime.Add(elapsed - elapsed%epochInfo.Duration)
+	default:
+		return ctx.BlockTime()
+	}
 }
 
 // shouldEpochStart checks if the epoch should start.
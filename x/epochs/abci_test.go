@@ -105,7 +105,7 @@ func TestEpochInfoChangesBeginBlockerAndInitGenesis(t *testing.T) {
 			// To check init genesis again, should make it fresh status
 			epochInfos := app.EpochsKeeper.AllEpochInfos(ctx)
 			for _, epochInfo := range epochInfos {
-				err := app.EpochsKeeper.DeleteEpochInfo(ctx, epochInfo.Identifier)
+				err := app.EpochsKeeper.Epochs.Delete(ctx, epochInfo.Identifier)
 				require.NoError(t, err)
 			}
 
@@ -175,7 +175,7 @@ func TestEpochStartingOneMonthAfterInitGenesis(t *testing.T) {
 	// To check init genesis again, should make it fresh status
 	epochInfos := app.EpochsKeeper.AllEpochInfos(ctx)
 	for _, epochInfo := range epochInfos {
-		err := app.EpochsKeeper.DeleteEpochInfo(ctx, epochInfo.Identifier)
+		err := app.EpochsKeeper.Epochs.Delete(ctx, epochInfo.Identifier)
 		require.NoError(t, err)
 	}
 
@@ -269,7 +269,7 @@ func TestLegacyEpochSerialization(t *testing.T) {
 	// To check init genesis again, should make it fresh status
 	epochInfos := app.EpochsKeeper.AllEpochInfos(ctx)
 	for _, epochInfo := range epochInfos {
-		err := app.EpochsKeeper.DeleteEpochInfo(ctx, epochInfo.Identifier)
+		err := app.EpochsKeeper.Epochs.Delete(ctx, epochInfo.Identifier)
 		require.NoError(t, err)
 	}
 
@@ -293,3 +293,90 @@ func TestLegacyEpochSerialization(t *testing.T) {
 
 	require.NotEqual(t, epochInfo.CurrentEpochStartHeight, int64(0))
 }
+
+func TestBeginBlockerCatchUpPolicies(t *testing.T) {
+	now := time.Now().UTC()
+	day := time.Hour * 24
+	// the chain halts for five and a half days
+	haltEnd := now.Add(day*5 + day/2)
+
+	tests := []struct {
+		name   string
+		params types.Params
+		// the epoch number and start time after each block following the halt
+		expectedEpochs     []uint64
+		expectedStartTimes []time.Time
+		// the number of epochs ended in the first block following the halt
+		expectedEnded int
+	}{
+		{
+			name:               "none",
+			params:             types.DefaultParams(),
+			expectedEpochs:     []uint64{2, 2},
+			expectedStartTimes: []time.Time{haltEnd, haltEnd},
+			expectedEnded:      1,
+		},
+		{
+			name:               "fire all",
+			params:             types.Params{CatchUpPolicy: types.CATCH_UP_FIRE_ALL, MaxEpochsPerBlock: 3},
+			expectedEpochs:     []uint64{4, 6, 6},
+			expectedStartTimes: []time.Time{now.Add(day * 3), now.Add(day * 5), now.Add(day * 5)},
+			expectedEnded:      3,
+		},
+		{
+			name:               "skip",
+			params:             types.Params{CatchUpPolicy: types.CATCH_UP_SKIP},
+			expectedEpochs:     []uint64{2, 2},
+			expectedStartTimes: []time.Time{now.Add(day * 5), now.Add(day * 5)},
+			expectedEnded:      1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			for _, epochInfo := range app.EpochsKeeper.AllEpochInfos(ctx) {
+				require.NoError(t, app.EpochsKeeper.Epochs.Delete(ctx, epochInfo.Identifier))
+			}
+
+			ctx = ctx.WithBlockHeight(1).WithBlockTime(now)
+			require.NoError(t, epochs.InitGenesis(ctx, app.EpochsKeeper, types.GenesisState{
+				Epochs: []types.EpochInfo{
+					{
+						Identifier:              "daily",
+						StartTime:               now,
+						Duration:                day,
+						CurrentEpoch:            1,
+						CurrentEpochStartHeight: 1,
+						CurrentEpochStartTime:   now,
+						EpochCountingStarted:    true,
+					},
+				},
+				Params: tc.params,
+			}))
+
+			for i := range tc.expectedEpochs {
+				ctx = ctx.
+					WithBlockHeight(int64(2 + i)).
+					WithBlockTime(haltEnd.Add(time.Duration(i) * 5 * time.Second)).
+					WithEventManager(sdk.NewEventManager())
+				epochs.BeginBlocker(ctx, app.EpochsKeeper)
+
+				if i == 0 {
+					ended := 0
+					for _, event := range ctx.EventManager().Events() {
+						if event.Type == "nibiru.epochs.v1.EventEpochEnd" {
+							ended++
+						}
+					}
+					require.Equal(t, tc.expectedEnded, ended)
+				}
+
+				epochInfo, err := app.EpochsKeeper.GetEpochInfo(ctx, "daily")
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedEpochs[i], epochInfo.CurrentEpoch)
+				require.Equal(t, tc.expectedStartTimes[i].String(), epochInfo.CurrentEpochStartTime.UTC().String())
+			}
+		})
+	}
+}
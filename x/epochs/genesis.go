@@ -13,6 +13,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err != nil {
 		return
 	}
+	k.Params.Set(ctx, genState.Params)
 	for _, epoch := range genState.Epochs {
 		if err = k.AddEpochInfo(ctx, epoch); err != nil {
 			return err
@@ -25,6 +26,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesisFromTime(ctx.BlockTime())
 	genesis.Epochs = k.AllEpochInfos(ctx)
+	genesis.Params = k.GetParams(ctx)
 
 	return genesis
 }
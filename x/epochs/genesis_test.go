@@ -60,7 +60,7 @@ func TestEpochsInitGenesis(t *testing.T) {
 	// To check init genesis again, should make it fresh status
 	epochInfos := app.EpochsKeeper.AllEpochInfos(ctx)
 	for _, epochInfo := range epochInfos {
-		err := app.EpochsKeeper.DeleteEpochInfo(ctx, epochInfo.Identifier)
+		err := app.EpochsKeeper.Epochs.Delete(ctx, epochInfo.Identifier)
 		require.NoError(t, err)
 	}
 
@@ -52,10 +52,16 @@ func (k Keeper) AddEpochInfo(ctx sdk.Context, epoch types.EpochInfo) error {
 	return nil
 }
 
-// DeleteEpochInfo delete epoch info.
-func (k Keeper) DeleteEpochInfo(ctx sdk.Context, identifier string) (err error) {
-	err = k.Epochs.Delete(ctx, identifier)
-	return
+// DeleteEpochInfo delete epoch info. The built-in epochs other modules hook
+// into cannot be deleted.
+func (k Keeper) DeleteEpochInfo(ctx sdk.Context, identifier string) error {
+	if types.IsBuiltinEpochID(identifier) {
+		return types.ErrInvalidEpoch.Wrapf("cannot delete built-in epoch %s", identifier)
+	}
+	if err := k.Epochs.Delete(ctx, identifier); err != nil {
+		return types.ErrEpochNotFound.Wrap(identifier)
+	}
+	return nil
 }
 
 // IterateEpochInfo iterate through epochs.
@@ -86,3 +92,20 @@ func (k Keeper) AllEpochInfos(ctx sdk.Context) []types.EpochInfo {
 	})
 	return epochs
 }
+
+// UpdateEpochDuration changes the duration of an epoch. The new duration
+// applies to the current epoch, whose end time moves accordingly.
+func (k Keeper) UpdateEpochDuration(ctx sdk.Context, identifier string, duration time.Duration) error {
+	epoch, err := k.Epochs.Get(ctx, identifier)
+	if err != nil {
+		return types.ErrEpochNotFound.Wrap(identifier)
+	}
+
+	epoch.Duration = duration
+	if err := epoch.Validate(); err != nil {
+		return types.ErrInvalidEpoch.Wrap(err.Error())
+	}
+
+	k.Epochs.Insert(ctx, identifier, epoch)
+	return nil
+}
@@ -42,3 +42,12 @@ func (q Querier) CurrentEpoch(c context.Context, req *types.QueryCurrentEpochReq
 		CurrentEpoch: info.CurrentEpoch,
 	}, nil
 }
+
+// Params provides the parameters of the module.
+func (q Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{
+		Params: q.Keeper.GetParams(ctx),
+	}, nil
+}
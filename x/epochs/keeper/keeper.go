@@ -6,6 +6,7 @@ import (
 	"github.com/NibiruChain/collections"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/x/epochs/types"
 )
@@ -15,15 +16,22 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 	hooks    types.EpochHooks
 
+	// the address capable of executing the epoch management messages.
+	// Typically, this should be the x/gov module account.
+	authority string
+
 	Epochs collections.Map[string, types.EpochInfo]
+	Params collections.Item[types.Params]
 }
 
-func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey) Keeper {
+func NewKeeper(cdc codec.Codec, storeKey storetypes.StoreKey, authority string) Keeper {
 	return Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
 
 		Epochs: collections.NewMap[string, types.EpochInfo](storeKey, 1, collections.StringKeyEncoder, collections.ProtoValueEncoder[types.EpochInfo](cdc)),
+		Params: collections.NewItem(storeKey, 2, collections.ProtoValueEncoder[types.Params](cdc)),
 	}
 }
 
@@ -33,3 +41,14 @@ func (k *Keeper) SetHooks(eh types.EpochHooks) *Keeper {
 
 	return k
 }
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the params of the module, or the default params if none
+// were set.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return k.Params.GetOr(ctx, types.DefaultParams())
+}
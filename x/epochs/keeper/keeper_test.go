@@ -75,3 +75,22 @@ func TestItFailsAddingEpochThatExists(t *testing.T) {
 	err = nibiruApp.EpochsKeeper.AddEpochInfo(ctx, epochInfo)
 	require.Error(t, err)
 }
+
+func TestDeleteEpochInfo(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+
+	require.NoError(t, nibiruApp.EpochsKeeper.AddEpochInfo(ctx, types.EpochInfo{
+		Identifier: "monthly",
+		Duration:   time.Hour * 24 * 30,
+	}))
+	require.NoError(t, nibiruApp.EpochsKeeper.DeleteEpochInfo(ctx, "monthly"))
+	require.False(t, nibiruApp.EpochsKeeper.EpochExists(ctx, "monthly"))
+	require.ErrorIs(t, nibiruApp.EpochsKeeper.DeleteEpochInfo(ctx, "monthly"), types.ErrEpochNotFound)
+
+	// built-in epochs other modules depend on cannot be deleted
+	for _, identifier := range []string{types.WeekEpochID, types.FifteenMinuteEpochID} {
+		err := nibiruApp.EpochsKeeper.DeleteEpochInfo(ctx, identifier)
+		require.ErrorIs(t, err, types.ErrInvalidEpoch)
+		require.True(t, nibiruApp.EpochsKeeper.EpochExists(ctx, identifier))
+	}
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/NibiruChain/nibiru/x/epochs/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the epochs MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// CreateEpoch adds an epoch.
+func (ms msgServer) CreateEpoch(
+	goCtx context.Context, msg *types.MsgCreateEpoch,
+) (*types.MsgCreateEpochResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.AddEpochInfo(ctx, types.EpochInfo{
+		Identifier:            msg.Identifier,
+		StartTime:             msg.StartTime,
+		Duration:              msg.Duration,
+		CurrentEpochStartTime: msg.StartTime,
+	}); err != nil {
+		return nil, types.ErrInvalidEpoch.Wrap(err.Error())
+	}
+
+	return &types.MsgCreateEpochResponse{}, nil
+}
+
+// DeleteEpoch removes an epoch.
+func (ms msgServer) DeleteEpoch(
+	goCtx context.Context, msg *types.MsgDeleteEpoch,
+) (*types.MsgDeleteEpochResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.DeleteEpochInfo(ctx, msg.Identifier); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteEpochResponse{}, nil
+}
+
+// UpdateEpochDuration changes the duration of an epoch.
+func (ms msgServer) UpdateEpochDuration(
+	goCtx context.Context, msg *types.MsgUpdateEpochDuration,
+) (*types.MsgUpdateEpochDurationResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.UpdateEpochDuration(ctx, msg.Identifier, msg.Duration); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateEpochDurationResponse{}, nil
+}
+
+// UpdateParams updates the params of the epochs module.
+func (ms msgServer) UpdateParams(
+	goCtx context.Context, msg *types.MsgUpdateParams,
+) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ms.Keeper.Params.Set(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/epochs/keeper"
+	"github.com/NibiruChain/nibiru/x/epochs/types"
+)
+
+func TestMsgCreateAndDeleteEpoch(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	msgServer := keeper.NewMsgServerImpl(nibiruApp.EpochsKeeper)
+	authority := nibiruApp.EpochsKeeper.GetAuthority()
+	goCtx := sdk.WrapSDKContext(ctx)
+	startTime := ctx.BlockTime().Add(time.Hour)
+
+	_, err := msgServer.CreateEpoch(goCtx, types.NewMsgCreateEpoch(
+		testutil.AccAddress().String(), "monthly", startTime, time.Hour*24*30))
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = msgServer.CreateEpoch(goCtx, types.NewMsgCreateEpoch(authority, "monthly", startTime, time.Hour*24*30))
+	require.NoError(t, err)
+
+	epochInfo, err := nibiruApp.EpochsKeeper.GetEpochInfo(ctx, "monthly")
+	require.NoError(t, err)
+	require.Equal(t, startTime, epochInfo.StartTime)
+	require.Equal(t, time.Hour*24*30, epochInfo.Duration)
+	require.False(t, epochInfo.EpochCountingStarted)
+
+	// identifiers are unique
+	_, err = msgServer.CreateEpoch(goCtx, types.NewMsgCreateEpoch(authority, "monthly", startTime, time.Hour))
+	require.ErrorIs(t, err, types.ErrInvalidEpoch)
+
+	_, err = msgServer.DeleteEpoch(goCtx, types.NewMsgDeleteEpoch(testutil.AccAddress().String(), "monthly"))
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = msgServer.DeleteEpoch(goCtx, types.NewMsgDeleteEpoch(authority, "monthly"))
+	require.NoError(t, err)
+	require.False(t, nibiruApp.EpochsKeeper.EpochExists(ctx, "monthly"))
+
+	_, err = msgServer.DeleteEpoch(goCtx, types.NewMsgDeleteEpoch(authority, "monthly"))
+	require.ErrorIs(t, err, types.ErrEpochNotFound)
+
+	// built-in epochs other modules depend on cannot be deleted
+	_, err = msgServer.DeleteEpoch(goCtx, types.NewMsgDeleteEpoch(authority, types.WeekEpochID))
+	require.ErrorIs(t, err, types.ErrInvalidEpoch)
+	require.True(t, nibiruApp.EpochsKeeper.EpochExists(ctx, types.WeekEpochID))
+}
+
+func TestMsgUpdateEpochDuration(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	msgServer := keeper.NewMsgServerImpl(nibiruApp.EpochsKeeper)
+	authority := nibiruApp.EpochsKeeper.GetAuthority()
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := msgServer.UpdateEpochDuration(goCtx, types.NewMsgUpdateEpochDuration(
+		testutil.AccAddress().String(), types.WeekEpochID, time.Hour))
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = msgServer.UpdateEpochDuration(goCtx, types.NewMsgUpdateEpochDuration(authority, "unexisting-epoch", time.Hour))
+	require.ErrorIs(t, err, types.ErrEpochNotFound)
+
+	_, err = msgServer.UpdateEpochDuration(goCtx, types.NewMsgUpdateEpochDuration(authority, types.WeekEpochID, time.Hour))
+	require.NoError(t, err)
+
+	epochInfo, err := nibiruApp.EpochsKeeper.GetEpochInfo(ctx, types.WeekEpochID)
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, epochInfo.Duration)
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	msgServer := keeper.NewMsgServerImpl(nibiruApp.EpochsKeeper)
+	authority := nibiruApp.EpochsKeeper.GetAuthority()
+	goCtx := sdk.WrapSDKContext(ctx)
+	params := types.Params{CatchUpPolicy: types.CATCH_UP_FIRE_ALL, MaxEpochsPerBlock: 5}
+
+	_, err := msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(testutil.AccAddress().String(), params))
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(
+		authority, types.Params{CatchUpPolicy: types.CATCH_UP_FIRE_ALL}))
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+	require.Equal(t, params, nibiruApp.EpochsKeeper.GetParams(ctx))
+}
@@ -8,5 +8,7 @@ import (
 
 // x/epochs module sentinel errors.
 var (
-	ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrSample        = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrEpochNotFound = sdkerrors.Register(ModuleName, 1101, "epoch not found")
+	ErrInvalidEpoch  = sdkerrors.Register(ModuleName, 1102, "invalid epoch")
 )
@@ -6,7 +6,7 @@ import (
 )
 
 func NewGenesisState(epochs []EpochInfo) *GenesisState {
-	return &GenesisState{Epochs: epochs}
+	return &GenesisState{Epochs: epochs, Params: DefaultParams()}
 }
 
 // DefaultGenesis returns the default Capability genesis state.
@@ -52,6 +52,10 @@ func DefaultGenesisFromTime(startTime time.Time) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	epochIdentifiers := map[string]bool{}
 	for _, epoch := range gs.Epochs {
 		if epochIdentifiers[epoch.Identifier] {
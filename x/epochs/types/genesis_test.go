@@ -83,6 +83,13 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			errString: "epoch duration should NOT be 0",
 		},
+		{
+			name: "invalid params",
+			genState: GenesisState{
+				Params: Params{CatchUpPolicy: CATCH_UP_FIRE_ALL},
+			},
+			errString: "max epochs per block must be positive",
+		},
 	}
 
 	for _, tc := range tests {
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,6 +16,8 @@ type EpochHooks interface {
 var _ EpochHooks = MultiEpochHooks{}
 
 // MultiEpochHooks combine multiple gamm hooks, all hook functions are run in array sequence.
+// Each hook runs in its own cached context, so that a hook that panics is
+// skipped and its state changes are discarded without affecting the others.
 type MultiEpochHooks []EpochHooks
 
 func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
@@ -23,13 +27,37 @@ func NewMultiEpochHooks(hooks ...EpochHooks) MultiEpochHooks {
 // AfterEpochEnd is called when epoch is going to be ended, epochNumber is the number of epoch that is ending.
 func (h MultiEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
 	for i := range h {
-		h[i].AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+		hook := h[i]
+		runHookSafely(ctx, hook, func(ctx sdk.Context) {
+			hook.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+		})
 	}
 }
 
 // BeforeEpochStart is called when epoch is going to be started, epochNumber is the number of epoch that is starting.
 func (h MultiEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
 	for i := range h {
-		h[i].BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+		hook := h[i]
+		runHookSafely(ctx, hook, func(ctx sdk.Context) {
+			hook.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+		})
 	}
 }
+
+// runHookSafely runs the hook in a cached context, written only if the hook
+// returns. A panic is logged and recovered so that it cannot halt the chain.
+func runHookSafely(ctx sdk.Context, hook EpochHooks, fn func(ctx sdk.Context)) {
+	defer func() {
+		if r := recover(); r != nil {
+			ctx.Logger().Error(
+				"epoch hook panicked",
+				"hook", fmt.Sprintf("%T", hook),
+				"panic", r,
+			)
+		}
+	}()
+
+	cacheCtx, commit := ctx.CacheContext()
+	fn(cacheCtx)
+	commit()
+}
@@ -3,7 +3,10 @@ package types_test
 import (
 	"testing"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/x/epochs/types"
 
@@ -28,12 +31,12 @@ func TestAfterEpochEnd(t *testing.T) {
 	hook2 := new(MockEpochHooks)
 	hooks := types.NewMultiEpochHooks(hook1, hook2)
 
-	ctx := sdk.Context{}
+	ctx := testutil.DefaultContext(sdk.NewKVStoreKey("test"), sdk.NewTransientStoreKey("transient_test"))
 	epochIdentifier := "testID"
 	epochNumber := uint64(10)
 
-	hook1.On("AfterEpochEnd", ctx, epochIdentifier, epochNumber)
-	hook2.On("AfterEpochEnd", ctx, epochIdentifier, epochNumber)
+	hook1.On("AfterEpochEnd", mock.Anything, epochIdentifier, epochNumber)
+	hook2.On("AfterEpochEnd", mock.Anything, epochIdentifier, epochNumber)
 
 	hooks.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
 
@@ -46,15 +49,61 @@ func TestBeforeEpochStart(t *testing.T) {
 	hook2 := new(MockEpochHooks)
 	hooks := types.NewMultiEpochHooks(hook1, hook2)
 
-	ctx := sdk.Context{}
+	ctx := testutil.DefaultContext(sdk.NewKVStoreKey("test"), sdk.NewTransientStoreKey("transient_test"))
 	epochIdentifier := "testID"
 	epochNumber := uint64(10)
 
-	hook1.On("BeforeEpochStart", ctx, epochIdentifier, epochNumber)
-	hook2.On("BeforeEpochStart", ctx, epochIdentifier, epochNumber)
+	hook1.On("BeforeEpochStart", mock.Anything, epochIdentifier, epochNumber)
+	hook2.On("BeforeEpochStart", mock.Anything, epochIdentifier, epochNumber)
 
 	hooks.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
 
 	hook1.AssertExpectations(t)
 	hook2.AssertExpectations(t)
 }
+
+// StoreEpochHooks writes the epoch number to a store, and panics after
+// writing it if panics is true.
+type StoreEpochHooks struct {
+	key    storetypes.StoreKey
+	prefix string
+	panics bool
+}
+
+func (h StoreEpochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
+	ctx.KVStore(h.key).Set([]byte(h.prefix+"/end"), sdk.Uint64ToBigEndian(epochNumber))
+	if h.panics {
+		panic("after epoch end")
+	}
+}
+
+func (h StoreEpochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
+	ctx.KVStore(h.key).Set([]byte(h.prefix+"/start"), sdk.Uint64ToBigEndian(epochNumber))
+	if h.panics {
+		panic("before epoch start")
+	}
+}
+
+func TestHookPanicIsolation(t *testing.T) {
+	key := sdk.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))
+	hooks := types.NewMultiEpochHooks(
+		StoreEpochHooks{key: key, prefix: "first"},
+		StoreEpochHooks{key: key, prefix: "bad", panics: true},
+		StoreEpochHooks{key: key, prefix: "last"},
+	)
+
+	require.NotPanics(t, func() {
+		hooks.AfterEpochEnd(ctx, "testID", 10)
+		hooks.BeforeEpochStart(ctx, "testID", 11)
+	})
+
+	store := ctx.KVStore(key)
+	for _, prefix := range []string{"first", "last"} {
+		require.Equal(t, sdk.Uint64ToBigEndian(10), store.Get([]byte(prefix+"/end")))
+		require.Equal(t, sdk.Uint64ToBigEndian(11), store.Get([]byte(prefix+"/start")))
+	}
+	// the writes of the panicking hook are discarded
+	require.Nil(t, store.Get([]byte("bad/end")))
+	require.Nil(t, store.Get([]byte("bad/start")))
+}
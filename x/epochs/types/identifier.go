@@ -18,6 +18,18 @@ const (
 	ThirtyMinuteEpochID = "30 min"
 )
 
+// IsBuiltinEpochID returns true for the epoch identifiers that other modules
+// hook into. These epochs cannot be deleted since re-creating one would
+// restart its epoch count.
+func IsBuiltinEpochID(identifier string) bool {
+	switch identifier {
+	case WeekEpochID, DayEpochID, HourEpochID, FifteenMinuteEpochID, ThirtyMinuteEpochID:
+		return true
+	default:
+		return false
+	}
+}
+
 // ValidateEpochIdentifierInterface performs a stateless
 // validation of the epoch ID interface.
 func ValidateEpochIdentifierInterface(i interface{}) error {
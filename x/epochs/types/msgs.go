@@ -0,0 +1,202 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constants
+const (
+	TypeMsgCreateEpoch         = "create_epoch"
+	TypeMsgDeleteEpoch         = "delete_epoch"
+	TypeMsgUpdateEpochDuration = "update_epoch_duration"
+	TypeMsgUpdateParams        = "update_params"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateEpoch{}
+	_ sdk.Msg = &MsgDeleteEpoch{}
+	_ sdk.Msg = &MsgUpdateEpochDuration{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgCreateEpoch creates a MsgCreateEpoch instance
+func NewMsgCreateEpoch(
+	authority, identifier string, startTime time.Time, duration time.Duration,
+) *MsgCreateEpoch {
+	return &MsgCreateEpoch{
+		Authority:  authority,
+		Identifier: identifier,
+		StartTime:  startTime,
+		Duration:   duration,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateEpoch) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCreateEpoch) Type() string { return TypeMsgCreateEpoch }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateEpoch) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCreateEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+	if err := ValidateEpochIdentifierString(msg.Identifier); err != nil {
+		return sdkerrors.Wrap(ErrInvalidEpoch, err.Error())
+	}
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrap(ErrInvalidEpoch, "epoch duration must be positive")
+	}
+	return nil
+}
+
+//-------------------------------------------------
+//-------------------------------------------------
+
+// NewMsgDeleteEpoch creates a MsgDeleteEpoch instance
+func NewMsgDeleteEpoch(authority, identifier string) *MsgDeleteEpoch {
+	return &MsgDeleteEpoch{
+		Authority:  authority,
+		Identifier: identifier,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgDeleteEpoch) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgDeleteEpoch) Type() string { return TypeMsgDeleteEpoch }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgDeleteEpoch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgDeleteEpoch) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgDeleteEpoch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+	if err := ValidateEpochIdentifierString(msg.Identifier); err != nil {
+		return sdkerrors.Wrap(ErrInvalidEpoch, err.Error())
+	}
+	if IsBuiltinEpochID(msg.Identifier) {
+		return sdkerrors.Wrapf(ErrInvalidEpoch, "cannot delete built-in epoch %s", msg.Identifier)
+	}
+	return nil
+}
+
+//-------------------------------------------------
+//-------------------------------------------------
+
+// NewMsgUpdateEpochDuration creates a MsgUpdateEpochDuration instance
+func NewMsgUpdateEpochDuration(
+	authority, identifier string, duration time.Duration,
+) *MsgUpdateEpochDuration {
+	return &MsgUpdateEpochDuration{
+		Authority:  authority,
+		Identifier: identifier,
+		Duration:   duration,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateEpochDuration) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUpdateEpochDuration) Type() string { return TypeMsgUpdateEpochDuration }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateEpochDuration) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateEpochDuration) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateEpochDuration) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+	if err := ValidateEpochIdentifierString(msg.Identifier); err != nil {
+		return sdkerrors.Wrap(ErrInvalidEpoch, err.Error())
+	}
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrap(ErrInvalidEpoch, "epoch duration must be positive")
+	}
+	return nil
+}
+
+//-------------------------------------------------
+//-------------------------------------------------
+
+// NewMsgUpdateParams creates a MsgUpdateParams instance
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+	return msg.Params.Validate()
+}
@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/epochs/types"
+)
+
+func TestMsgs_ValidateBasic(t *testing.T) {
+	authority := testutil.AccAddress().String()
+	start := time.Unix(1_000, 0)
+
+	for _, tc := range []struct {
+		name    string
+		msg     sdk.Msg
+		wantErr bool
+	}{
+		{"create valid", types.NewMsgCreateEpoch(authority, "monthly", start, time.Hour), false},
+		{"create without start time", types.NewMsgCreateEpoch(authority, "monthly", time.Time{}, time.Hour), false},
+		{"create invalid authority", types.NewMsgCreateEpoch("foo", "monthly", start, time.Hour), true},
+		{"create blank identifier", types.NewMsgCreateEpoch(authority, " ", start, time.Hour), true},
+		{"create zero duration", types.NewMsgCreateEpoch(authority, "monthly", start, 0), true},
+		{"delete valid", types.NewMsgDeleteEpoch(authority, "monthly"), false},
+		{"delete invalid authority", types.NewMsgDeleteEpoch("foo", "monthly"), true},
+		{"delete blank identifier", types.NewMsgDeleteEpoch(authority, ""), true},
+		{"delete built-in epoch", types.NewMsgDeleteEpoch(authority, types.DayEpochID), true},
+		{"update duration valid", types.NewMsgUpdateEpochDuration(authority, "monthly", time.Hour), false},
+		{"update duration invalid authority", types.NewMsgUpdateEpochDuration("foo", "monthly", time.Hour), true},
+		{"update duration negative", types.NewMsgUpdateEpochDuration(authority, "monthly", -time.Hour), true},
+		{"update params valid", types.NewMsgUpdateParams(authority, types.DefaultParams()), false},
+		{"update params invalid authority", types.NewMsgUpdateParams("foo", types.DefaultParams()), true},
+		{"update params unknown policy", types.NewMsgUpdateParams(
+			authority, types.Params{CatchUpPolicy: 3, MaxEpochsPerBlock: 1}), true},
+		{"update params fire all without cap", types.NewMsgUpdateParams(
+			authority, types.Params{CatchUpPolicy: types.CATCH_UP_FIRE_ALL}), true},
+		{"update params skip without cap", types.NewMsgUpdateParams(
+			authority, types.Params{CatchUpPolicy: types.CATCH_UP_SKIP}), false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
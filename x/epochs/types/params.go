@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultMaxEpochsPerBlock is the default maximum number of epochs of each
+// identifier ended in a block when catching up.
+const DefaultMaxEpochsPerBlock = 10
+
+// DefaultParams returns the default parameters of the epochs module. Epochs
+// advance at most once per block, as they did before the catch-up policies.
+func DefaultParams() Params {
+	return Params{
+		CatchUpPolicy:     CATCH_UP_NONE,
+		MaxEpochsPerBlock: DefaultMaxEpochsPerBlock,
+	}
+}
+
+// Validate checks the parameters are valid.
+func (p Params) Validate() error {
+	if _, ok := CatchUpPolicy_name[int32(p.CatchUpPolicy)]; !ok {
+		return fmt.Errorf("unknown catch up policy %d", p.CatchUpPolicy)
+	}
+	if p.CatchUpPolicy == CATCH_UP_FIRE_ALL && p.MaxEpochsPerBlock == 0 {
+		return fmt.Errorf("max epochs per block must be positive to fire missed epochs")
+	}
+	return nil
+}
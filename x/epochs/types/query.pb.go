@@ -198,43 +198,129 @@ func (m *QueryCurrentEpochResponse) GetCurrentEpoch() uint64 {
 	return 0
 }
 
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d273c3d69b40555, []int{4}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d273c3d69b40555, []int{5}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryEpochsInfoRequest)(nil), "nibiru.epochs.v1.QueryEpochsInfoRequest")
 	proto.RegisterType((*QueryEpochsInfoResponse)(nil), "nibiru.epochs.v1.QueryEpochsInfoResponse")
 	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "nibiru.epochs.v1.QueryCurrentEpochRequest")
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "nibiru.epochs.v1.QueryCurrentEpochResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "nibiru.epochs.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "nibiru.epochs.v1.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/epochs/v1/query.proto", fileDescriptor_2d273c3d69b40555) }
 
 var fileDescriptor_2d273c3d69b40555 = []byte{
-	// 413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0xcf, 0xd4, 0x5a, 0x70, 0xac, 0x20, 0x83, 0x68, 0x8c, 0x35, 0x2d, 0xf1, 0x0f, 0xb5, 0x96,
-	0x0c, 0xa9, 0x27, 0x3d, 0x49, 0x8b, 0x82, 0x17, 0xc1, 0xe0, 0xc9, 0x8b, 0x4c, 0xe2, 0x34, 0x1d,
-	0xb0, 0x33, 0x69, 0x66, 0x52, 0xec, 0xd5, 0xd3, 0x1e, 0x17, 0x96, 0xfd, 0x12, 0xfb, 0x49, 0x7a,
-	0x2c, 0xec, 0x65, 0x4f, 0xcb, 0xd2, 0xee, 0x07, 0x59, 0x3a, 0x93, 0x2e, 0xdd, 0xfe, 0x81, 0xde,
-	0x92, 0xf7, 0xfb, 0xf3, 0x7e, 0xef, 0xbd, 0x81, 0x35, 0xce, 0x22, 0x96, 0xe5, 0x98, 0xa6, 0x22,
-	0x1e, 0x48, 0x3c, 0x0e, 0xf0, 0x28, 0xa7, 0xd9, 0xc4, 0x4f, 0x33, 0xa1, 0x04, 0x7a, 0x6c, 0x50,
-	0xdf, 0xa0, 0xfe, 0x38, 0x70, 0x9e, 0x24, 0x22, 0x11, 0x1a, 0xc4, 0xcb, 0x2f, 0xc3, 0x73, 0x6a,
-	0x89, 0x10, 0xc9, 0x5f, 0x8a, 0x49, 0xca, 0x30, 0xe1, 0x5c, 0x28, 0xa2, 0x98, 0xe0, 0xb2, 0x40,
-	0x5b, 0xb1, 0x90, 0x43, 0x21, 0x71, 0x44, 0x24, 0x35, 0xf6, 0x78, 0x1c, 0x44, 0x54, 0x91, 0x00,
-	0xa7, 0x24, 0x61, 0x5c, 0x93, 0x57, 0x4e, 0x5b, 0x79, 0xa4, 0x22, 0x8a, 0x1a, 0xd4, 0xb3, 0xe1,
-	0xd3, 0x1f, 0x4b, 0xfd, 0x17, 0x8d, 0x7e, 0xe3, 0x7d, 0x11, 0xd2, 0x51, 0x4e, 0xa5, 0xf2, 0x7e,
-	0xc2, 0x67, 0x5b, 0x88, 0x4c, 0x05, 0x97, 0x14, 0x7d, 0x84, 0x15, 0xe3, 0x66, 0x83, 0xc6, 0xbd,
-	0xe6, 0xc3, 0xce, 0x0b, 0x7f, 0x73, 0x2a, 0x5f, 0xab, 0x96, 0xa2, 0x6e, 0x79, 0x7a, 0x59, 0xb7,
-	0xc2, 0x42, 0xe0, 0x7d, 0x82, 0xb6, 0x76, 0xed, 0xe5, 0x59, 0x46, 0xb9, 0xd2, 0xb4, 0xa2, 0x23,
-	0x72, 0x21, 0x64, 0x7f, 0x28, 0x57, 0xac, 0xcf, 0x68, 0x66, 0x83, 0x06, 0x68, 0x3e, 0x08, 0xd7,
-	0x2a, 0xde, 0x67, 0xf8, 0x7c, 0x87, 0xb6, 0xc8, 0xf4, 0x0a, 0x3e, 0x8a, 0x4d, 0xfd, 0xb7, 0x6e,
-	0xa5, 0xf5, 0xe5, 0xb0, 0x1a, 0xaf, 0x91, 0x3b, 0x67, 0x25, 0x78, 0x5f, 0x5b, 0xa0, 0x23, 0x00,
-	0xe1, 0x6d, 0x46, 0x89, 0x9a, 0xdb, 0x13, 0xec, 0x5e, 0x8b, 0xf3, 0xee, 0x00, 0xa6, 0x89, 0xe4,
-	0xbd, 0xf9, 0x7f, 0x7e, 0x7d, 0x52, 0xaa, 0xa3, 0x97, 0x78, 0xf3, 0x04, 0xe6, 0x54, 0xe6, 0x17,
-	0x9d, 0x02, 0x58, 0x5d, 0x1f, 0x09, 0xb5, 0xf6, 0xb4, 0xd8, 0xb1, 0x33, 0xe7, 0xfd, 0x41, 0xdc,
-	0x22, 0x50, 0x5b, 0x07, 0x7a, 0x8b, 0x5e, 0xef, 0x09, 0x74, 0x67, 0x81, 0xdd, 0xaf, 0xd3, 0xb9,
-	0x0b, 0x66, 0x73, 0x17, 0x5c, 0xcd, 0x5d, 0x70, 0xbc, 0x70, 0xad, 0xd9, 0xc2, 0xb5, 0x2e, 0x16,
-	0xae, 0xf5, 0xab, 0x9d, 0x30, 0x35, 0xc8, 0x23, 0x3f, 0x16, 0x43, 0xfc, 0x5d, 0x3b, 0xf5, 0x06,
-	0x84, 0xf1, 0x95, 0xeb, 0xbf, 0x95, 0xaf, 0x9a, 0xa4, 0x54, 0x46, 0x15, 0xfd, 0xd2, 0x3e, 0xdc,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x57, 0x11, 0x22, 0x69, 0x19, 0x03, 0x00, 0x00,
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6b, 0xd4, 0x40,
+	0x14, 0xcf, 0xd8, 0x1a, 0xf0, 0xb5, 0x82, 0x8c, 0x45, 0x63, 0xac, 0x69, 0x89, 0xad, 0xac, 0xb5,
+	0x64, 0xc8, 0x0a, 0x82, 0x9e, 0xa4, 0x45, 0xc1, 0x83, 0xa2, 0xc1, 0x93, 0x17, 0x99, 0xc4, 0x69,
+	0x76, 0xc0, 0x9d, 0x49, 0x33, 0x93, 0xc5, 0x82, 0x27, 0x4f, 0x1e, 0x05, 0xf1, 0x3b, 0xf8, 0x51,
+	0x7a, 0x5c, 0xf0, 0xe2, 0x49, 0x64, 0xd7, 0x0f, 0x22, 0x3b, 0x93, 0xc8, 0xee, 0x66, 0x17, 0xf7,
+	0x96, 0xbc, 0xdf, 0x9f, 0xf7, 0x7b, 0xef, 0x25, 0xb0, 0x2d, 0x78, 0xca, 0xcb, 0x8a, 0xb0, 0x42,
+	0x66, 0x3d, 0x45, 0x06, 0x31, 0x39, 0xad, 0x58, 0x79, 0x16, 0x15, 0xa5, 0xd4, 0x12, 0x5f, 0xb1,
+	0x68, 0x64, 0xd1, 0x68, 0x10, 0xfb, 0x5b, 0xb9, 0xcc, 0xa5, 0x01, 0xc9, 0xe4, 0xc9, 0xf2, 0xfc,
+	0xed, 0x5c, 0xca, 0xfc, 0x3d, 0x23, 0xb4, 0xe0, 0x84, 0x0a, 0x21, 0x35, 0xd5, 0x5c, 0x0a, 0x55,
+	0xa3, 0x07, 0x99, 0x54, 0x7d, 0xa9, 0x48, 0x4a, 0x15, 0xb3, 0xf6, 0x64, 0x10, 0xa7, 0x4c, 0xd3,
+	0x98, 0x14, 0x34, 0xe7, 0xc2, 0x90, 0x1b, 0xa7, 0x56, 0x1e, 0xa5, 0xa9, 0x66, 0x16, 0x0d, 0x3d,
+	0xb8, 0xf6, 0x6a, 0xa2, 0x7f, 0x62, 0xd0, 0x67, 0xe2, 0x44, 0x26, 0xec, 0xb4, 0x62, 0x4a, 0x87,
+	0xaf, 0xe1, 0x7a, 0x0b, 0x51, 0x85, 0x14, 0x8a, 0xe1, 0x87, 0xe0, 0x5a, 0x37, 0x0f, 0xed, 0xae,
+	0x75, 0x36, 0xba, 0x37, 0xa3, 0xf9, 0xa9, 0x22, 0xa3, 0x9a, 0x88, 0x8e, 0xd6, 0xcf, 0x7f, 0xed,
+	0x38, 0x49, 0x2d, 0x08, 0x1f, 0x81, 0x67, 0x5c, 0x8f, 0xab, 0xb2, 0x64, 0x42, 0x1b, 0x5a, 0xdd,
+	0x11, 0x07, 0x00, 0xfc, 0x1d, 0x13, 0x9a, 0x9f, 0x70, 0x56, 0x7a, 0x68, 0x17, 0x75, 0x2e, 0x25,
+	0x53, 0x95, 0xf0, 0x31, 0xdc, 0x58, 0xa0, 0xad, 0x33, 0xdd, 0x86, 0xcb, 0x99, 0xad, 0xbf, 0x35,
+	0xad, 0x8c, 0x7e, 0x3d, 0xd9, 0xcc, 0xa6, 0xc8, 0xe1, 0x16, 0x60, 0xe3, 0xf0, 0x92, 0x96, 0xb4,
+	0xaf, 0x9a, 0x49, 0x9f, 0xc3, 0xd5, 0x99, 0x6a, 0xed, 0xf8, 0x00, 0xdc, 0xc2, 0x54, 0x8c, 0xd5,
+	0x46, 0xd7, 0x6b, 0x4f, 0x69, 0x15, 0xcd, 0x88, 0x96, 0xdd, 0xfd, 0xbe, 0x06, 0x17, 0x8d, 0x1f,
+	0xfe, 0x8c, 0x00, 0xfe, 0x2d, 0x42, 0xe1, 0x4e, 0xdb, 0x60, 0xf1, 0xee, 0xfd, 0xbb, 0x2b, 0x30,
+	0x6d, 0xca, 0x70, 0xff, 0xd3, 0x8f, 0x3f, 0x5f, 0x2f, 0xec, 0xe0, 0x5b, 0x64, 0xfe, 0xce, 0xf6,
+	0x7b, 0xb0, 0xaf, 0xf8, 0x1b, 0x82, 0xcd, 0xe9, 0xbd, 0xe1, 0x83, 0x25, 0x2d, 0x16, 0x1c, 0xc6,
+	0xbf, 0xb7, 0x12, 0xb7, 0x0e, 0x74, 0x68, 0x02, 0xdd, 0xc1, 0x7b, 0x4b, 0x02, 0xcd, 0x5c, 0x09,
+	0x7f, 0x04, 0xd7, 0x2e, 0x11, 0xef, 0x2d, 0x69, 0x32, 0x73, 0x2b, 0x7f, 0xff, 0x3f, 0xac, 0x15,
+	0xb7, 0x62, 0x4f, 0x75, 0xf4, 0xf4, 0x7c, 0x14, 0xa0, 0xe1, 0x28, 0x40, 0xbf, 0x47, 0x01, 0xfa,
+	0x32, 0x0e, 0x9c, 0xe1, 0x38, 0x70, 0x7e, 0x8e, 0x03, 0xe7, 0xcd, 0x61, 0xce, 0x75, 0xaf, 0x4a,
+	0xa3, 0x4c, 0xf6, 0xc9, 0x0b, 0x63, 0x71, 0xdc, 0xa3, 0x5c, 0x34, 0x76, 0x1f, 0x1a, 0x43, 0x7d,
+	0x56, 0x30, 0x95, 0xba, 0xe6, 0x67, 0xba, 0xff, 0x77, 0x00, 0x21, 0x21, 0x84, 0x03, 0xfc, 0x03,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -253,6 +339,8 @@ type QueryClient interface {
 	EpochInfos(ctx context.Context, in *QueryEpochsInfoRequest, opts ...grpc.CallOption) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// Params provide the parameters of the module
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -281,12 +369,23 @@ func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochReq
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.epochs.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EpochInfos provide running epochInfos
 	EpochInfos(context.Context, *QueryEpochsInfoRequest) (*QueryEpochsInfoResponse, error)
 	// CurrentEpoch provide current epoch of specified identifier
 	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// Params provide the parameters of the module
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -299,6 +398,9 @@ func (*UnimplementedQueryServer) EpochInfos(ctx context.Context, req *QueryEpoch
 func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -340,6 +442,24 @@ func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.epochs.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.epochs.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -352,6 +472,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentEpoch",
 			Handler:    _Query_CurrentEpoch_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/epochs/v1/query.proto",
@@ -475,6 +599,62 @@ func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -535,6 +715,26 @@ func (m *QueryCurrentEpochResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -826,6 +1026,139 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
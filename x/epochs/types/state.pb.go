@@ -28,6 +28,43 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CatchUpPolicy defines how an epoch whose end time is more than one duration
+// in the past, e.g. after a chain halt, is brought up to date.
+type CatchUpPolicy int32
+
+const (
+	// CATCH_UP_NONE ends at most one epoch per block. The next epoch starts at
+	// the block time, so the schedule drifts by the time missed.
+	CATCH_UP_NONE CatchUpPolicy = 0
+	// CATCH_UP_FIRE_ALL ends every missed epoch in order, running their hooks,
+	// up to max_epochs_per_block epochs per block. Epochs start on the schedule
+	// defined by their start time and duration.
+	CATCH_UP_FIRE_ALL CatchUpPolicy = 1
+	// CATCH_UP_SKIP ends the current epoch once and starts the next one at the
+	// last scheduled start before the block time, skipping the missed epochs.
+	CATCH_UP_SKIP CatchUpPolicy = 2
+)
+
+var CatchUpPolicy_name = map[int32]string{
+	0: "CATCH_UP_NONE",
+	1: "CATCH_UP_FIRE_ALL",
+	2: "CATCH_UP_SKIP",
+}
+
+var CatchUpPolicy_value = map[string]int32{
+	"CATCH_UP_NONE":     0,
+	"CATCH_UP_FIRE_ALL": 1,
+	"CATCH_UP_SKIP":     2,
+}
+
+func (x CatchUpPolicy) String() string {
+	return proto.EnumName(CatchUpPolicy_name, int32(x))
+}
+
+func (CatchUpPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8bd50db1722dd5e6, []int{0}
+}
+
 type EpochInfo struct {
 	// A string identifier for the epoch. e.g. "15min" or "1hour"
 	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
@@ -128,44 +165,138 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 	return 0
 }
 
+// Params defines the parameters of the epochs module.
+type Params struct {
+	// How missed epochs are brought up to date.
+	CatchUpPolicy CatchUpPolicy `protobuf:"varint,1,opt,name=catch_up_policy,json=catchUpPolicy,proto3,enum=nibiru.epochs.v1.CatchUpPolicy" json:"catch_up_policy,omitempty" yaml:"catch_up_policy"`
+	// The maximum number of epochs of each identifier ended in a block under
+	// the CATCH_UP_FIRE_ALL policy.
+	MaxEpochsPerBlock uint32 `protobuf:"varint,2,opt,name=max_epochs_per_block,json=maxEpochsPerBlock,proto3" json:"max_epochs_per_block,omitempty" yaml:"max_epochs_per_block"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bd50db1722dd5e6, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetCatchUpPolicy() CatchUpPolicy {
+	if m != nil {
+		return m.CatchUpPolicy
+	}
+	return CATCH_UP_NONE
+}
+
+func (m *Params) GetMaxEpochsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxEpochsPerBlock
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("nibiru.epochs.v1.CatchUpPolicy", CatchUpPolicy_name, CatchUpPolicy_value)
 	proto.RegisterType((*EpochInfo)(nil), "nibiru.epochs.v1.EpochInfo")
+	proto.RegisterType((*Params)(nil), "nibiru.epochs.v1.Params")
 }
 
 func init() { proto.RegisterFile("nibiru/epochs/v1/state.proto", fileDescriptor_8bd50db1722dd5e6) }
 
 var fileDescriptor_8bd50db1722dd5e6 = []byte{
-	// 433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xb1, 0x6f, 0xd4, 0x30,
-	0x14, 0xc6, 0x63, 0x7a, 0x94, 0x9e, 0x01, 0x01, 0x56, 0x81, 0x10, 0x81, 0x13, 0x85, 0x25, 0x12,
-	0x55, 0xac, 0x03, 0x26, 0xd8, 0xae, 0x80, 0x60, 0x61, 0x48, 0x19, 0x10, 0xcb, 0x29, 0xc9, 0xf9,
-	0x12, 0x4b, 0x8d, 0x1d, 0x25, 0x2f, 0x15, 0xb7, 0x31, 0x33, 0x75, 0xe4, 0x4f, 0xea, 0xd8, 0x91,
-	0x29, 0xa0, 0xbb, 0x8d, 0xb1, 0x7f, 0x01, 0x8a, 0x9d, 0x94, 0x83, 0x82, 0xd8, 0x92, 0xf7, 0xfb,
-	0xde, 0xf7, 0xf9, 0x3d, 0x3d, 0x7c, 0x5f, 0x8a, 0x44, 0x54, 0x0d, 0xe3, 0xa5, 0x4a, 0xf3, 0x9a,
-	0x1d, 0x4d, 0x58, 0x0d, 0x31, 0xf0, 0xb0, 0xac, 0x14, 0x28, 0x72, 0xd3, 0xd0, 0xd0, 0xd0, 0xf0,
-	0x68, 0xe2, 0xec, 0x66, 0x2a, 0x53, 0x1a, 0xb2, 0xee, 0xcb, 0xe8, 0x1c, 0x9a, 0x29, 0x95, 0x1d,
-	0x72, 0xa6, 0xff, 0x92, 0x66, 0xc1, 0xe6, 0x4d, 0x15, 0x83, 0x50, 0xb2, 0xe7, 0xee, 0x9f, 0x1c,
-	0x44, 0xc1, 0x6b, 0x88, 0x8b, 0xd2, 0x08, 0xfc, 0xcf, 0x23, 0x3c, 0x7e, 0xd9, 0x85, 0xbc, 0x91,
-	0x0b, 0x45, 0x28, 0xc6, 0x62, 0xce, 0x25, 0x88, 0x85, 0xe0, 0x95, 0x8d, 0x3c, 0x14, 0x8c, 0xa3,
-	0x8d, 0x0a, 0x79, 0x8f, 0x71, 0x0d, 0x71, 0x05, 0xb3, 0xce, 0xc6, 0xbe, 0xe4, 0xa1, 0xe0, 0xea,
-	0x63, 0x27, 0x34, 0x19, 0xe1, 0x90, 0x11, 0xbe, 0x1b, 0x32, 0xa6, 0x0f, 0x4e, 0x5a, 0xd7, 0x3a,
-	0x6b, 0xdd, 0x5b, 0xcb, 0xb8, 0x38, 0x7c, 0xe6, 0xff, 0xea, 0xf5, 0x8f, 0xbf, 0xb9, 0x28, 0x1a,
-	0xeb, 0x42, 0x27, 0x27, 0x39, 0xde, 0x19, 0x9e, 0x6e, 0x6f, 0x69, 0xdf, 0x7b, 0x17, 0x7c, 0x5f,
-	0xf4, 0x82, 0xe9, 0xa4, 0xb3, 0xfd, 0xd1, 0xba, 0x64, 0x68, 0xd9, 0x53, 0x85, 0x00, 0x5e, 0x94,
-	0xb0, 0x3c, 0x6b, 0xdd, 0x1b, 0x26, 0x6c, 0x60, 0xfe, 0x97, 0x2e, 0xea, 0xdc, 0x9d, 0x3c, 0xc4,
-	0xd7, 0xd3, 0xa6, 0xaa, 0xb8, 0x84, 0x99, 0xde, 0xae, 0x3d, 0xf2, 0x50, 0x30, 0x8a, 0xae, 0xf5,
-	0x45, 0xbd, 0x0c, 0xf2, 0x09, 0x61, 0xfb, 0x37, 0xd5, 0x6c, 0x63, 0xee, 0xcb, 0xff, 0x9d, 0xfb,
-	0x51, 0x3f, 0xb7, 0x6b, 0x9e, 0xf2, 0x2f, 0x27, 0xb3, 0x85, 0xdb, 0x9b, 0xc9, 0x07, 0xe7, 0x1b,
-	0x79, 0x8a, 0xef, 0x18, 0x7d, 0xaa, 0x1a, 0x09, 0x42, 0x66, 0xa6, 0x91, 0xcf, 0xed, 0x6d, 0x0f,
-	0x05, 0x3b, 0xd1, 0xae, 0xa6, 0xfb, 0x3d, 0x3c, 0x30, 0x8c, 0x3c, 0xc7, 0xce, 0xdf, 0xd2, 0x72,
-	0x2e, 0xb2, 0x1c, 0xec, 0x2b, 0x1e, 0x0a, 0xb6, 0xa2, 0xbb, 0x17, 0x02, 0x5f, 0x6b, 0x3c, 0x7d,
-	0x75, 0xb2, 0xa2, 0xe8, 0x74, 0x45, 0xd1, 0xf7, 0x15, 0x45, 0xc7, 0x6b, 0x6a, 0x9d, 0xae, 0xa9,
-	0xf5, 0x75, 0x4d, 0xad, 0x0f, 0x7b, 0x99, 0x80, 0xbc, 0x49, 0xc2, 0x54, 0x15, 0xec, 0xad, 0x3e,
-	0xcd, 0xfd, 0x3c, 0x16, 0x92, 0xf5, 0x47, 0xfc, 0x71, 0x38, 0x63, 0x58, 0x96, 0xbc, 0x4e, 0xb6,
-	0xf5, 0x4a, 0x9e, 0xfc, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x02, 0xe6, 0x18, 0x2d, 0xe4, 0x02, 0x00,
-	0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x41, 0x4f, 0xd4, 0x40,
+	0x14, 0xde, 0x81, 0x15, 0x61, 0x74, 0x65, 0x77, 0x02, 0x58, 0x57, 0xed, 0x6c, 0xea, 0x65, 0xa3,
+	0xa4, 0x0d, 0xe8, 0x09, 0x4f, 0xec, 0x0a, 0x81, 0x48, 0xb0, 0x29, 0x90, 0x18, 0x2f, 0x4d, 0xb7,
+	0x0c, 0xed, 0xc4, 0x6d, 0xa7, 0x69, 0xa7, 0x84, 0xbd, 0x79, 0x34, 0x9e, 0x38, 0x7a, 0x34, 0xf1,
+	0xb7, 0x98, 0x70, 0xe4, 0xe8, 0xa9, 0x1a, 0xb8, 0x18, 0x8f, 0xfb, 0x0b, 0x4c, 0x67, 0xda, 0x75,
+	0x17, 0x30, 0xde, 0xda, 0xf7, 0x7d, 0xef, 0xfb, 0xde, 0xfb, 0xf2, 0x06, 0x3e, 0x0a, 0x69, 0x8f,
+	0xc6, 0xa9, 0x41, 0x22, 0xe6, 0xfa, 0x89, 0x71, 0xbc, 0x62, 0x24, 0xdc, 0xe1, 0x44, 0x8f, 0x62,
+	0xc6, 0x19, 0xaa, 0x4b, 0x54, 0x97, 0xa8, 0x7e, 0xbc, 0xd2, 0x5c, 0xf0, 0x98, 0xc7, 0x04, 0x68,
+	0xe4, 0x5f, 0x92, 0xd7, 0x54, 0x3d, 0xc6, 0xbc, 0x3e, 0x31, 0xc4, 0x5f, 0x2f, 0x3d, 0x32, 0x0e,
+	0xd3, 0xd8, 0xe1, 0x94, 0x85, 0x05, 0x8e, 0xaf, 0xe2, 0x9c, 0x06, 0x24, 0xe1, 0x4e, 0x10, 0x49,
+	0x82, 0xf6, 0xa9, 0x0a, 0xe7, 0x36, 0x72, 0x93, 0xed, 0xf0, 0x88, 0x21, 0x15, 0x42, 0x7a, 0x48,
+	0x42, 0x4e, 0x8f, 0x28, 0x89, 0x15, 0xd0, 0x02, 0xed, 0x39, 0x6b, 0xac, 0x82, 0xde, 0x42, 0x98,
+	0x70, 0x27, 0xe6, 0x76, 0x2e, 0xa3, 0x4c, 0xb5, 0x40, 0xfb, 0xce, 0x6a, 0x53, 0x97, 0x1e, 0x7a,
+	0xe9, 0xa1, 0xef, 0x97, 0x1e, 0x9d, 0xc7, 0x67, 0x19, 0xae, 0x0c, 0x33, 0xdc, 0x18, 0x38, 0x41,
+	0x7f, 0x4d, 0xfb, 0xdb, 0xab, 0x9d, 0xfe, 0xc0, 0xc0, 0x9a, 0x13, 0x85, 0x9c, 0x8e, 0x7c, 0x38,
+	0x5b, 0x8e, 0xae, 0x4c, 0x0b, 0xdd, 0x07, 0xd7, 0x74, 0x5f, 0x15, 0x84, 0xce, 0x4a, 0x2e, 0xfb,
+	0x3b, 0xc3, 0xa8, 0x6c, 0x59, 0x66, 0x01, 0xe5, 0x24, 0x88, 0xf8, 0x60, 0x98, 0xe1, 0x79, 0x69,
+	0x56, 0x62, 0xda, 0xe7, 0xdc, 0x6a, 0xa4, 0x8e, 0x9e, 0xc0, 0x9a, 0x9b, 0xc6, 0x31, 0x09, 0xb9,
+	0x2d, 0xd2, 0x55, 0xaa, 0x2d, 0xd0, 0xae, 0x5a, 0x77, 0x8b, 0xa2, 0x08, 0x03, 0x7d, 0x00, 0x50,
+	0x99, 0x60, 0xd9, 0x63, 0x7b, 0xdf, 0xfa, 0xef, 0xde, 0xcf, 0x8a, 0xbd, 0xb1, 0x1c, 0xe5, 0x5f,
+	0x4a, 0x32, 0x85, 0xc5, 0x71, 0xe7, 0xbd, 0x51, 0x22, 0x2f, 0xe0, 0x92, 0xe4, 0xbb, 0x2c, 0x0d,
+	0x39, 0x0d, 0x3d, 0xd9, 0x48, 0x0e, 0x95, 0x99, 0x16, 0x68, 0xcf, 0x5a, 0x0b, 0x02, 0xed, 0x16,
+	0xe0, 0x9e, 0xc4, 0xd0, 0x4b, 0xd8, 0xbc, 0xc9, 0xcd, 0x27, 0xd4, 0xf3, 0xb9, 0x72, 0xbb, 0x05,
+	0xda, 0xd3, 0xd6, 0xfd, 0x6b, 0x86, 0x5b, 0x02, 0xd6, 0xbe, 0x01, 0x38, 0x63, 0x3a, 0xb1, 0x13,
+	0x24, 0xc8, 0x85, 0xf3, 0xae, 0xc3, 0x5d, 0xdf, 0x4e, 0x23, 0x3b, 0x62, 0x7d, 0xea, 0x0e, 0xc4,
+	0x39, 0xdc, 0x5b, 0xc5, 0xfa, 0xd5, 0xd3, 0xd4, 0xbb, 0x39, 0xf1, 0x20, 0x32, 0x05, 0xad, 0xd3,
+	0x1c, 0x66, 0x78, 0xa9, 0xd8, 0x7b, 0x52, 0x41, 0xb3, 0x6a, 0xee, 0x38, 0x15, 0x99, 0x70, 0x21,
+	0x70, 0x4e, 0xe4, 0xa0, 0x89, 0x1d, 0x91, 0xd8, 0xee, 0xf5, 0x99, 0xfb, 0x5e, 0x1c, 0x56, 0xad,
+	0x83, 0x87, 0x19, 0x7e, 0x28, 0x85, 0x6e, 0x62, 0x69, 0x56, 0x23, 0x70, 0x4e, 0xc4, 0x0e, 0x89,
+	0x49, 0xe2, 0x4e, 0x5e, 0x5b, 0xab, 0xfe, 0xfa, 0x82, 0xc1, 0x53, 0x0b, 0xd6, 0x26, 0x66, 0x42,
+	0x0d, 0x58, 0xeb, 0xae, 0xef, 0x77, 0xb7, 0xec, 0x03, 0xd3, 0xde, 0x7d, 0xb3, 0xbb, 0x51, 0xaf,
+	0xa0, 0x45, 0xd8, 0x18, 0x95, 0x36, 0xb7, 0xad, 0x0d, 0x7b, 0x7d, 0x67, 0xa7, 0x0e, 0x26, 0x98,
+	0x7b, 0xaf, 0xb7, 0xcd, 0xfa, 0x54, 0xb3, 0xfa, 0xf1, 0xab, 0x5a, 0xe9, 0x6c, 0x9e, 0x5d, 0xa8,
+	0xe0, 0xfc, 0x42, 0x05, 0x3f, 0x2f, 0x54, 0x70, 0x7a, 0xa9, 0x56, 0xce, 0x2f, 0xd5, 0xca, 0xf7,
+	0x4b, 0xb5, 0xf2, 0x6e, 0xd9, 0xa3, 0xdc, 0x4f, 0x7b, 0xba, 0xcb, 0x02, 0x63, 0x57, 0x64, 0xd3,
+	0xf5, 0x1d, 0x1a, 0x1a, 0xc5, 0x03, 0x3f, 0x29, 0x9f, 0x38, 0x1f, 0x44, 0x24, 0xe9, 0xcd, 0x88,
+	0x73, 0x79, 0xfe, 0x67, 0x00, 0x9e, 0x71, 0x6c, 0xfa, 0x00, 0x04, 0x00, 0x00,
 }
 
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CatchUpPolicy != that1.CatchUpPolicy {
+		return false
+	}
+	if this.MaxEpochsPerBlock != that1.MaxEpochsPerBlock {
+		return false
+	}
+	return true
+}
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -240,6 +371,39 @@ func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxEpochsPerBlock != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MaxEpochsPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CatchUpPolicy != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.CatchUpPolicy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintState(dAtA []byte, offset int, v uint64) int {
 	offset -= sovState(v)
 	base := offset
@@ -279,6 +443,21 @@ func (m *EpochInfo) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CatchUpPolicy != 0 {
+		n += 1 + sovState(uint64(m.CatchUpPolicy))
+	}
+	if m.MaxEpochsPerBlock != 0 {
+		n += 1 + sovState(uint64(m.MaxEpochsPerBlock))
+	}
+	return n
+}
+
 func sovState(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -524,6 +703,94 @@ func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CatchUpPolicy", wireType)
+			}
+			m.CatchUpPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CatchUpPolicy |= CatchUpPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochsPerBlock", wireType)
+			}
+			m.MaxEpochsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEpochsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipState(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,244 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/NibiruChain/nibiru/x/incentives/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	incentivesQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the incentives module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	incentivesQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryLock(),
+		GetCmdQueryAccountLocks(),
+		GetCmdQueryGauge(),
+		GetCmdQueryGauges(),
+		GetCmdQueryClaimableRewards(),
+		GetCmdQueryApr(),
+	)
+
+	return incentivesQueryCmd
+}
+
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current incentives params",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryLock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock [lock-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a lock of pool shares",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lockId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given lock id {%s} is not a valid number: %w", args[0], err)
+			}
+
+			res, err := queryClient.Lock(context.Background(), &types.QueryLockRequest{LockId: lockId})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryAccountLocks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-locks [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the locks of an account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountLocks(context.Background(), &types.QueryAccountLocksRequest{Owner: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryGauge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gauge [gauge-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a gauge",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given gauge id {%s} is not a valid number: %w", args[0], err)
+			}
+
+			res, err := queryClient.Gauge(context.Background(), &types.QueryGaugeRequest{GaugeId: gaugeId})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryGauges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gauges [pool-id]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the gauges of a pool, or all the gauges",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var poolId uint64
+			if len(args) == 1 {
+				poolId, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("given pool id {%s} is not a valid number: %w", args[0], err)
+				}
+			}
+
+			res, err := queryClient.Gauges(context.Background(), &types.QueryGaugesRequest{PoolId: poolId})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryClaimableRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the unclaimed rewards of the locks of an account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClaimableRewards(
+				context.Background(), &types.QueryClaimableRewardsRequest{Owner: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryApr() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apr [pool-id] [duration]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the yearly rewards of pool shares locked for a duration, e.g. 168h",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given pool id {%s} is not a valid number: %w", args[0], err)
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Apr(context.Background(), &types.QueryAprRequest{
+				PoolId:   poolId,
+				Duration: duration,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,232 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/NibiruChain/nibiru/x/incentives/types"
+)
+
+const FlagStartTime = "start-time"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	incentivesTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Incentives transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	incentivesTxCmd.AddCommand(
+		GetCmdLockShares(),
+		GetCmdUnlock(),
+		GetCmdClaimRewards(),
+		GetCmdCreateGauge(),
+		GetCmdAddToGauge(),
+	)
+
+	return incentivesTxCmd
+}
+
+func GetCmdLockShares() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock [pool-shares] [duration]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Lock pool shares for a duration to earn the rewards of the pool gauges",
+		Long: strings.TrimSpace(`
+Lock pool shares for one of the lockable durations. The longer the duration,
+the larger the share of the rewards. The shares can be unlocked once the
+duration has elapsed.
+
+$ nibid tx incentives lock 100000000nibiru/pool/1 168h
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLockShares(clientCtx.GetFromAddress(), coin, duration)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdUnlock() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unlock [lock-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Withdraw the shares and unclaimed rewards of an expired lock",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			lockId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given lock id {%s} is not a valid number: %w", args[0], err)
+			}
+
+			msg := types.NewMsgUnlock(clientCtx.GetFromAddress(), lockId)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdClaimRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-rewards",
+		Args:  cobra.NoArgs,
+		Short: "Withdraw the rewards earned by all the locks of the sender",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimRewards(clientCtx.GetFromAddress())
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdCreateGauge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-gauge [pool-id] [coins] [num-epochs]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Fund rewards for the share locks of a pool over a number of epochs",
+		Long: strings.TrimSpace(`
+Fund rewards for the share locks of a pool. The coins are distributed evenly
+over the given number of distribution epochs, pro rata the locked shares times
+the lock durations. The distribution starts at the block time, or at the time
+given with --start-time (RFC3339).
+
+$ nibid tx incentives create-gauge 1 1000000unibi 30
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given pool id {%s} is not a valid number: %w", args[0], err)
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			numEpochs, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given number of epochs {%s} is not a valid number: %w", args[2], err)
+			}
+
+			var startTime time.Time
+			startTimeStr, err := cmd.Flags().GetString(FlagStartTime)
+			if err != nil {
+				return err
+			}
+			if startTimeStr != "" {
+				startTime, err = time.Parse(time.RFC3339, startTimeStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgCreateGauge(clientCtx.GetFromAddress(), poolId, coins, startTime, numEpochs)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagStartTime, "", "time from which the gauge distributes its rewards (RFC3339)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func GetCmdAddToGauge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-to-gauge [gauge-id] [coins]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Add rewards to a gauge",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			gaugeId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given gauge id {%s} is not a valid number: %w", args[0], err)
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddToGauge(clientCtx.GetFromAddress(), gaugeId, coins)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,49 @@
+package incentives
+
+import (
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/incentives/keeper"
+	"github.com/NibiruChain/nibiru/x/incentives/types"
+)
+
+// InitGenesis initializes the incentives module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.Params.Set(ctx, genState.Params)
+
+	for _, lock := range genState.Locks {
+		k.Locks.Insert(ctx, lock.Id, lock)
+		if lock.Id >= k.NextLockId.Peek(ctx) {
+			k.NextLockId.Set(ctx, lock.Id+1)
+		}
+	}
+
+	for _, gauge := range genState.Gauges {
+		k.Gauges.Insert(ctx, gauge.Id, gauge)
+		if !gauge.IsFinished() {
+			k.ActiveGauges.Insert(ctx, gauge.Id)
+		}
+		if gauge.Id >= k.NextGaugeId.Peek(ctx) {
+			k.NextGaugeId.Set(ctx, gauge.Id+1)
+		}
+	}
+}
+
+// ExportGenesis returns the incentives module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Params: params,
+		Locks:  k.Locks.Iterate(ctx, collections.Range[uint64]{}).Values(),
+		Gauges: k.Gauges.Iterate(ctx, collections.Range[uint64]{}).Values(),
+	}
+}
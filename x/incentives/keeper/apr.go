@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/incentives/types"
+	spottypes "github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+const year = 365 * 24 * time.Hour
+
+/*
+Apr Computes the yearly rewards of one pool share locked for a duration, at the
+current distribution rate of the active gauges of the pool.
+
+args:
+  - ctx: the cosmos-sdk context
+  - poolId: the pool's numeric id
+  - duration: the lock duration, one of the lockable durations
+
+ret:
+  - yearlyRewardsPerShare: the rewards earned in a year by one pool share
+  - apr: the yearly rewards divided by the value of one pool share, counting
+    only the rewards in the assets of the pool, priced with the pool spot prices
+  - err: error if any
+*/
+func (k Keeper) Apr(
+	ctx sdk.Context, poolId uint64, duration time.Duration,
+) (yearlyRewardsPerShare sdk.DecCoins, apr sdk.Dec, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, sdk.Dec{}, err
+	}
+	if !params.IsLockableDuration(duration) {
+		return nil, sdk.Dec{}, types.ErrInvalidLock.Wrapf(
+			"duration %s is not one of the lockable durations %v", duration, params.LockableDurations)
+	}
+	pool, err := k.spotKeeper.FetchPool(ctx, poolId)
+	if err != nil {
+		return nil, sdk.Dec{}, err
+	}
+	epochInfo, err := k.epochKeeper.GetEpochInfo(ctx, params.DistrEpochIdentifier)
+	if err != nil {
+		return nil, sdk.Dec{}, err
+	}
+
+	yearlyRewardsPerShare = sdk.NewDecCoins()
+	apr = sdk.ZeroDec()
+
+	totalWeight := sdkmath.ZeroInt()
+	for _, lock := range k.activeLocks(ctx, poolId) {
+		totalWeight = totalWeight.Add(lock.Weight())
+	}
+	if !totalWeight.IsPositive() || epochInfo.Duration <= 0 {
+		return yearlyRewardsPerShare, apr, nil
+	}
+
+	// the weight of one share locked for the duration over the total weight,
+	// times the number of distributions in a year
+	yearlyShareRatio := sdk.NewDec(int64(duration / time.Second)).
+		MulInt64(int64(year / epochInfo.Duration)).
+		QuoInt(totalWeight)
+	for _, gauge := range k.PoolGauges(ctx, poolId) {
+		if !gauge.IsActive(ctx.BlockTime()) {
+			continue
+		}
+		yearlyRewardsPerShare = yearlyRewardsPerShare.Add(
+			sdk.NewDecCoinsFromCoins(gauge.EpochCoins()...).MulDec(yearlyShareRatio)...)
+	}
+
+	for _, reward := range yearlyRewardsPerShare {
+		shareValue, ok := poolShareValue(pool, reward.Denom)
+		if !ok || !shareValue.IsPositive() {
+			continue
+		}
+		apr = apr.Add(reward.Amount.Quo(shareValue))
+	}
+
+	return yearlyRewardsPerShare, apr, nil
+}
+
+// poolShareValue returns the value of one share of a pool in denom, and false
+// if denom is not an asset of the pool.
+func poolShareValue(pool spottypes.Pool, denom string) (sdk.Dec, bool) {
+	balances := pool.PoolBalances()
+	if !balances.AmountOf(denom).IsPositive() || !pool.TotalShares.Amount.IsPositive() {
+		return sdk.Dec{}, false
+	}
+
+	value := sdk.ZeroDec()
+	for _, balance := range balances {
+		price := sdk.OneDec()
+		if balance.Denom != denom {
+			spotPrice, err := pool.CalcSpotPrice(denom, balance.Denom)
+			if err != nil {
+				return sdk.Dec{}, false
+			}
+			price = spotPrice
+		}
+		value = value.Add(sdk.NewDecFromInt(balance.Amount).Mul(price))
+	}
+	return value.QuoInt(pool.TotalShares.Amount), true
+}
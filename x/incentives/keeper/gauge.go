@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/incentives/types"
+	spottypes "github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+// CreateGauge funds rewards from the sender account for the share locks of a
+// pool, spread evenly over numEpochs distribution epochs starting at startTime.
+// A zero start time starts the distribution at the block time. The sender also
+// pays the gauge creation fee to the community pool.
+func (k Keeper) CreateGauge(
+	ctx sdk.Context, sender sdk.AccAddress, poolId uint64, coins sdk.Coins, startTime time.Time, numEpochs uint64,
+) (uint64, error) {
+	if err := k.validateGauge(ctx, poolId, numEpochs); err != nil {
+		return 0, err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !params.GaugeCreationFee.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, params.GaugeCreationFee, sender); err != nil {
+			return 0, err
+		}
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return 0, err
+	}
+	return k.insertGauge(ctx, poolId, coins, startTime, numEpochs, sender.String())
+}
+
+// CreateGaugeFromModule funds a gauge with coins of another module account,
+// e.g. the strategic reserve of the inflation module.
+func (k Keeper) CreateGaugeFromModule(
+	ctx sdk.Context, funderModule string, poolId uint64, coins sdk.Coins, startTime time.Time, numEpochs uint64,
+) (uint64, error) {
+	if err := k.validateGauge(ctx, poolId, numEpochs); err != nil {
+		return 0, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, funderModule, types.ModuleName, coins); err != nil {
+		return 0, err
+	}
+	return k.insertGauge(ctx, poolId, coins, startTime, numEpochs, funderModule)
+}
+
+// AddToGauge adds rewards from the sender account to a gauge that has not
+// finished distributing its rewards.
+func (k Keeper) AddToGauge(ctx sdk.Context, sender sdk.AccAddress, gaugeId uint64, coins sdk.Coins) error {
+	gauge, err := k.Gauges.Get(ctx, gaugeId)
+	if err != nil {
+		return types.ErrGaugeNotFound.Wrapf("gauge %d", gaugeId)
+	}
+	if gauge.IsFinished() {
+		return types.ErrGaugeFinished.Wrapf("gauge %d", gaugeId)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return err
+	}
+	gauge.Coins = gauge.Coins.Add(coins...)
+	k.Gauges.Insert(ctx, gaugeId, gauge)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventGaugeFunded{
+		GaugeId: gaugeId,
+		Funder:  sender.String(),
+		Coins:   coins,
+	})
+}
+
+// PoolGauges returns the gauges of a pool, sorted by id.
+func (k Keeper) PoolGauges(ctx sdk.Context, poolId uint64) []types.Gauge {
+	return k.Gauges.Collect(ctx, k.Gauges.Indexes.Pool.ExactMatch(ctx, poolId))
+}
+
+func (k Keeper) validateGauge(ctx sdk.Context, poolId uint64, numEpochs uint64) error {
+	if err := types.ValidateNumEpochs(numEpochs); err != nil {
+		return err
+	}
+	return k.validateIncentivizedPool(ctx, poolId)
+}
+
+// insertGauge stores a new gauge distributing coins over numEpochs.
+func (k Keeper) insertGauge(
+	ctx sdk.Context, poolId uint64, coins sdk.Coins, startTime time.Time, numEpochs uint64, funder string,
+) (uint64, error) {
+	if startTime.IsZero() {
+		startTime = ctx.BlockTime()
+	}
+
+	gauge := types.Gauge{
+		Id:               k.NextGaugeId.Next(ctx),
+		PoolId:           poolId,
+		Coins:            coins,
+		DistributedCoins: sdk.NewCoins(),
+		StartTime:        startTime,
+		NumEpochs:        numEpochs,
+	}
+	k.Gauges.Insert(ctx, gauge.Id, gauge)
+	k.ActiveGauges.Insert(ctx, gauge.Id)
+
+	return gauge.Id, ctx.EventManager().EmitTypedEvent(&types.EventGaugeCreated{
+		Gauge:  gauge,
+		Funder: funder,
+	})
+}
+
+// DistributeRewards distributes the rewards of the active gauges for an epoch
+// to the active locks of their pool shares, pro rata the lock weights. A gauge
+// with no active lock to reward keeps its rewards for a later epoch. At its
+// last epoch, a gauge gives the rounding dust of the pro rata split to its
+// heaviest lock, so that it distributes all its rewards. Finished gauges are
+// removed from the active gauges.
+func (k Keeper) DistributeRewards(ctx sdk.Context, epochNumber uint64) {
+	// the locks are fetched once per pool and written once, however many
+	// gauges reward them.
+	poolLocks := make(map[uint64][]types.Lock)
+	rewardedLocks := make(map[uint64]types.Lock)
+
+	for _, gaugeId := range k.ActiveGauges.Iterate(ctx, collections.Range[uint64]{}).Keys() {
+		gauge, err := k.Gauges.Get(ctx, gaugeId)
+		if err != nil || gauge.IsFinished() {
+			k.ActiveGauges.Delete(ctx, gaugeId)
+			continue
+		}
+		if !gauge.IsActive(ctx.BlockTime()) {
+			continue
+		}
+
+		locks, ok := poolLocks[gauge.PoolId]
+		if !ok {
+			locks = k.activeLocks(ctx, gauge.PoolId)
+			poolLocks[gauge.PoolId] = locks
+		}
+		totalWeight := sdkmath.ZeroInt()
+		heaviest := 0
+		for i, lock := range locks {
+			totalWeight = totalWeight.Add(lock.Weight())
+			if lock.Weight().GT(locks[heaviest].Weight()) {
+				heaviest = i
+			}
+		}
+		if !totalWeight.IsPositive() {
+			continue
+		}
+
+		epochCoins := gauge.EpochCoins()
+		lockRewards := make([]sdk.Coins, len(locks))
+		distributed := sdk.NewCoins()
+		for i, lock := range locks {
+			rewards := sdk.NewCoins()
+			for _, coin := range epochCoins {
+				rewards = rewards.Add(sdk.NewCoin(
+					coin.Denom, coin.Amount.Mul(lock.Weight()).Quo(totalWeight)))
+			}
+			lockRewards[i] = rewards
+			distributed = distributed.Add(rewards...)
+		}
+		if gauge.FilledEpochs+1 == gauge.NumEpochs {
+			dust := epochCoins.Sub(distributed...)
+			lockRewards[heaviest] = lockRewards[heaviest].Add(dust...)
+			distributed = distributed.Add(dust...)
+		}
+
+		for i, lock := range locks {
+			if lockRewards[i].IsZero() {
+				continue
+			}
+			if rewarded, ok := rewardedLocks[lock.Id]; ok {
+				lock = rewarded
+			}
+			lock.Rewards = lock.Rewards.Add(lockRewards[i]...)
+			rewardedLocks[lock.Id] = lock
+		}
+
+		gauge.DistributedCoins = gauge.DistributedCoins.Add(distributed...)
+		gauge.FilledEpochs++
+		k.Gauges.Insert(ctx, gauge.Id, gauge)
+		if gauge.IsFinished() {
+			k.ActiveGauges.Delete(ctx, gauge.Id)
+		}
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventGaugeDistribution{
+			GaugeId:     gauge.Id,
+			EpochNumber: epochNumber,
+			Coins:       distributed,
+		}); err != nil {
+			k.Logger(ctx).Error("failed to emit gauge distribution event", "error", err)
+		}
+	}
+
+	lockIds := make([]uint64, 0, len(rewardedLocks))
+	for lockId := range rewardedLocks {
+		lockIds = append(lockIds, lockId)
+	}
+	sort.Slice(lockIds, func(i, j int) bool { return lockIds[i] < lockIds[j] })
+	for _, lockId := range lockIds {
+		k.Locks.Insert(ctx, lockId, rewardedLocks[lockId])
+	}
+}
+
+// activeLocks returns the locks of the shares of a pool that earn rewards at
+// the block time, sorted by id. Only the locks ending after the block time are
+// visited, not the expired locks waiting to be unlocked.
+func (k Keeper) activeLocks(ctx sdk.Context, poolId uint64) []types.Lock {
+	denom := spottypes.GetPoolShareBaseDenom(poolId)
+	// the range is relative to the denom prefix, and starts after every lock
+	// ending at the block time
+	rng := collections.Range[collections.Pair[collections.Pair[string, time.Time], uint64]]{}.
+		Prefix(collections.PairPrefix[collections.Pair[string, time.Time], uint64](
+			collections.PairPrefix[string, time.Time](denom))).
+		StartExclusive(collections.Join(
+			collections.PairSuffix[string](ctx.BlockTime()), uint64(math.MaxUint64)))
+	locks := k.Locks.Collect(ctx, k.Locks.Indexes.DenomEndTime.Iterate(ctx, rng))
+	sort.Slice(locks, func(i, j int) bool { return locks[i].Id < locks[j].Id })
+	return locks
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
+)
+
+// BeforeEpochStart: noop, We don't need to do anything here
+func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ uint64) {}
+
+// AfterEpochEnd distributes the rewards of the gauges at the end of each
+// distribution epoch.
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("failed to get params", "error", err)
+		return
+	}
+	if epochIdentifier != params.DistrEpochIdentifier {
+		return
+	}
+	k.DistributeRewards(ctx, epochNumber)
+}
+
+// ___________________________________________________________________________________________________
+
+// Hooks wrapper struct for incentives keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Return the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// epochs hooks
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
+	h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+}
+
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
+	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/NibiruChain/collections"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/incentives/types"
+)
+
+// Keeper of the incentives store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistrKeeper
+	spotKeeper    types.SpotKeeper
+	epochKeeper   types.EpochKeeper
+
+	// the address capable of executing a MsgCreateReserveGauge message.
+	// Typically, this should be the x/gov module account.
+	authority string
+
+	Params collections.Item[types.Params]
+	// Locks maps the lock ids to the locks, indexed by owner, locked denom,
+	// and locked denom and end time.
+	Locks collections.IndexedMap[uint64, types.Lock, LockIndexes]
+	// Gauges maps the gauge ids to the gauges, indexed by pool.
+	Gauges      collections.IndexedMap[uint64, types.Gauge, GaugeIndexes]
+	NextLockId  collections.Sequence
+	NextGaugeId collections.Sequence
+	// ActiveGauges holds the ids of the gauges that have not finished
+	// distributing their rewards, the only gauges visited at an epoch end.
+	ActiveGauges collections.KeySet[uint64]
+}
+
+// NewKeeper constructs a new keeper for incentives
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
+	spotKeeper types.SpotKeeper,
+	epochKeeper types.EpochKeeper,
+	authority string,
+) Keeper {
+	// ensure incentives module account is set
+	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		spotKeeper:    spotKeeper,
+		epochKeeper:   epochKeeper,
+		authority:     authority,
+		Params:        collections.NewItem(storeKey, 0, collections.ProtoValueEncoder[types.Params](cdc)),
+		Locks:         NewLockStore(storeKey, cdc),
+		Gauges:        NewGaugeStore(storeKey, cdc),
+		NextLockId:    collections.NewSequence(storeKey, 5),
+		NextGaugeId:   collections.NewSequence(storeKey, 6),
+		ActiveGauges:  collections.NewKeySet(storeKey, 7, collections.Uint64KeyEncoder),
+	}
+}
+
+// GetAuthority returns the x/incentives module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
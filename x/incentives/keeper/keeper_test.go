@@ -0,0 +1,322 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
+	"github.com/NibiruChain/nibiru/x/incentives/keeper"
+	"github.com/NibiruChain/nibiru/x/incentives/types"
+	inflationtypes "github.com/NibiruChain/nibiru/x/inflation/types"
+	spottypes "github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+const week = 7 * 24 * time.Hour
+
+var shareDenom = spottypes.GetPoolShareBaseDenom(1)
+
+func setupPool(startTime time.Time) (*app.NibiruApp, sdk.Context) {
+	app, ctx := testapp.NewNibiruTestAppAndContextAtTime(startTime)
+	app.SpotKeeper.SetPool(ctx, mock.SpotPool(1, sdk.NewCoins(
+		sdk.NewInt64Coin("uatom", 1_000),
+		sdk.NewInt64Coin(denoms.NIBI, 1_000),
+	), 100))
+	params, _ := app.IncentivesKeeper.Params.Get(ctx)
+	params.MinLockAmount = sdk.NewInt(100)
+	app.IncentivesKeeper.Params.Set(ctx, params)
+	return app, ctx
+}
+
+// fundGaugeCreator funds an account with coins and the gauge creation fee.
+func fundGaugeCreator(t *testing.T, app *app.NibiruApp, ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	params, err := app.IncentivesKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, addr, coins.Add(params.GaugeCreationFee...)))
+}
+
+func TestLockAndDistribute(t *testing.T) {
+	startTime := time.Unix(1_690_000_000, 0).UTC()
+	app, ctx := setupPool(startTime)
+	k := app.IncentivesKeeper
+
+	alice, bob, funder := testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress()
+	for _, addr := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(
+			sdk.NewInt64Coin(shareDenom, 100), sdk.NewInt64Coin("uatom", 100))))
+	}
+	fundGaugeCreator(t, app, ctx, funder, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 10_000)))
+
+	_, err := k.LockShares(ctx, alice, sdk.NewInt64Coin(shareDenom, 100), 2*time.Hour)
+	require.ErrorIs(t, err, types.ErrInvalidLock)
+	_, err = k.LockShares(ctx, alice, sdk.NewInt64Coin("uatom", 100), week)
+	require.ErrorIs(t, err, types.ErrNotLockableShare)
+	_, err = k.LockShares(ctx, alice, sdk.NewInt64Coin(spottypes.GetPoolShareBaseDenom(2), 100), week)
+	require.ErrorIs(t, err, spottypes.ErrPoolNotFound)
+	_, err = k.LockShares(ctx, alice, sdk.NewInt64Coin(shareDenom, 99), week)
+	require.ErrorIs(t, err, types.ErrInvalidLock)
+
+	// bob locks for twice as long, so earns twice as much
+	aliceLock, err := k.LockShares(ctx, alice, sdk.NewInt64Coin(shareDenom, 100), week)
+	require.NoError(t, err)
+	require.Equal(t, startTime.Add(week), aliceLock.EndTime)
+	bobLock, err := k.LockShares(ctx, bob, sdk.NewInt64Coin(shareDenom, 100), 2*week)
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetBalance(ctx, alice, shareDenom).IsZero())
+
+	_, err = k.CreateGauge(ctx, funder, 2, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 3_000)), time.Time{}, 2)
+	require.ErrorIs(t, err, spottypes.ErrPoolNotFound)
+	gaugeId, err := k.CreateGauge(ctx, funder, 1, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 3_000)), time.Time{}, 2)
+	require.NoError(t, err)
+
+	// only the distribution epoch distributes rewards
+	k.AfterEpochEnd(ctx, epochstypes.DayEpochID, 1)
+	require.True(t, k.ClaimableRewards(ctx, alice).IsZero())
+
+	k.AfterEpochEnd(ctx, epochstypes.WeekEpochID, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 500)), k.ClaimableRewards(ctx, alice))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1_000)), k.ClaimableRewards(ctx, bob))
+	testutil.RequireContainsTypedEvent(t, ctx, &types.EventGaugeDistribution{
+		GaugeId:     gaugeId,
+		EpochNumber: 1,
+		Coins:       sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1_500)),
+	})
+
+	_, err = k.Unlock(ctx, alice, aliceLock.Id)
+	require.ErrorIs(t, err, types.ErrLockNotExpired)
+	_, err = k.Unlock(ctx, alice, bobLock.Id)
+	require.ErrorIs(t, err, types.ErrInvalidLock)
+
+	rewards, err := k.ClaimRewards(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 500)), rewards)
+	require.Equal(t, sdk.NewInt64Coin(denoms.NIBI, 500), app.BankKeeper.GetBalance(ctx, alice, denoms.NIBI))
+	require.True(t, k.ClaimableRewards(ctx, alice).IsZero())
+
+	// alice's lock has expired and no longer earns rewards
+	ctx = ctx.WithBlockTime(startTime.Add(week + time.Hour))
+	k.AfterEpochEnd(ctx, epochstypes.WeekEpochID, 2)
+	require.True(t, k.ClaimableRewards(ctx, alice).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 2_500)), k.ClaimableRewards(ctx, bob))
+
+	gauge, err := k.Gauges.Get(ctx, gaugeId)
+	require.NoError(t, err)
+	require.True(t, gauge.IsFinished())
+	require.Equal(t, gauge.Coins, gauge.DistributedCoins)
+	require.False(t, k.ActiveGauges.Has(ctx, gaugeId))
+	require.ErrorIs(t,
+		k.AddToGauge(ctx, funder, gaugeId, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1_000))),
+		types.ErrGaugeFinished)
+
+	coins, err := k.Unlock(ctx, alice, aliceLock.Id)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 100)), coins)
+	require.Equal(t, sdk.NewInt64Coin(shareDenom, 100), app.BankKeeper.GetBalance(ctx, alice, shareDenom))
+	_, err = k.Locks.Get(ctx, aliceLock.Id)
+	require.Error(t, err)
+	require.Empty(t, k.AccountLocks(ctx, alice))
+}
+
+func TestDistributeRoundingDust(t *testing.T) {
+	startTime := time.Unix(1_690_000_000, 0).UTC()
+	app, ctx := setupPool(startTime)
+	k := app.IncentivesKeeper
+
+	alice, bob, carol, funder := testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress()
+	for _, addr := range []sdk.AccAddress{alice, bob, carol} {
+		require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 100))))
+	}
+	fundGaugeCreator(t, app, ctx, funder, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1_001)))
+	communityPool := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	// bob locks for twice as long, so his lock is the heaviest
+	for i, addr := range []sdk.AccAddress{alice, bob, carol} {
+		duration := week
+		if i == 1 {
+			duration = 2 * week
+		}
+		_, err := k.LockShares(ctx, addr, sdk.NewInt64Coin(shareDenom, 100), duration)
+		require.NoError(t, err)
+	}
+
+	_, err := k.CreateGauge(ctx, funder, 1,
+		sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1_001)), time.Time{}, types.MaxGaugeEpochs+1)
+	require.ErrorIs(t, err, types.ErrInvalidGauge)
+	gaugeId, err := k.CreateGauge(ctx, funder, 1, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1_001)), time.Time{}, 1)
+	require.NoError(t, err)
+	require.True(t, k.ActiveGauges.Has(ctx, gaugeId))
+
+	// the creation fee goes to the community pool
+	params, err := k.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t,
+		communityPool.Add(sdk.NewDecCoinsFromCoins(params.GaugeCreationFee...)...),
+		app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, funder).IsZero())
+
+	// the last epoch gives the rounding dust to the heaviest lock
+	k.AfterEpochEnd(ctx, epochstypes.WeekEpochID, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 250)), k.ClaimableRewards(ctx, alice))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 501)), k.ClaimableRewards(ctx, bob))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 250)), k.ClaimableRewards(ctx, carol))
+
+	gauge, err := k.Gauges.Get(ctx, gaugeId)
+	require.NoError(t, err)
+	require.True(t, gauge.IsFinished())
+	require.Equal(t, gauge.Coins, gauge.DistributedCoins)
+	require.False(t, k.ActiveGauges.Has(ctx, gaugeId))
+}
+
+func TestGaugeWithoutLocks(t *testing.T) {
+	startTime := time.Unix(1_690_000_000, 0).UTC()
+	app, ctx := setupPool(startTime)
+	k := app.IncentivesKeeper
+
+	alice, funder := testutil.AccAddress(), testutil.AccAddress()
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 100))))
+	fundGaugeCreator(t, app, ctx, funder, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 10_000)))
+
+	gaugeId, err := k.CreateGauge(ctx, funder, 1,
+		sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1_000)), startTime.Add(week), 1)
+	require.NoError(t, err)
+	require.NoError(t, k.AddToGauge(ctx, funder, gaugeId, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1_000))))
+
+	_, err = k.LockShares(ctx, alice, sdk.NewInt64Coin(shareDenom, 100), 2*week)
+	require.NoError(t, err)
+
+	// the gauge has not started yet
+	k.AfterEpochEnd(ctx, epochstypes.WeekEpochID, 1)
+	require.True(t, k.ClaimableRewards(ctx, alice).IsZero())
+
+	// a started gauge with no active locks keeps its rewards
+	ctx = ctx.WithBlockTime(startTime.Add(3 * week))
+	k.AfterEpochEnd(ctx, epochstypes.WeekEpochID, 3)
+	gauge, err := k.Gauges.Get(ctx, gaugeId)
+	require.NoError(t, err)
+	require.Zero(t, gauge.FilledEpochs)
+	require.True(t, gauge.DistributedCoins.IsZero())
+}
+
+func TestDistributeToActiveLocksOfPool(t *testing.T) {
+	startTime := time.Unix(1_690_000_000, 0).UTC()
+	app, ctx := setupPool(startTime)
+	k := app.IncentivesKeeper
+	app.SpotKeeper.SetPool(ctx, mock.SpotPool(2, sdk.NewCoins(
+		sdk.NewInt64Coin("uatom", 1_000),
+		sdk.NewInt64Coin(denoms.NIBI, 1_000),
+	), 100))
+	otherShareDenom := spottypes.GetPoolShareBaseDenom(2)
+
+	alice, bob, carol, funder := testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress()
+	for _, addr := range []sdk.AccAddress{alice, bob, carol} {
+		require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(
+			sdk.NewInt64Coin(shareDenom, 100), sdk.NewInt64Coin(otherShareDenom, 100))))
+	}
+	fundGaugeCreator(t, app, ctx, funder, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 2_000)))
+	fundGaugeCreator(t, app, ctx, funder, sdk.NewCoins())
+
+	// alice's lock ends at the distribution, carol locks the shares of
+	// another pool
+	_, err := k.LockShares(ctx, alice, sdk.NewInt64Coin(shareDenom, 100), week)
+	require.NoError(t, err)
+	_, err = k.LockShares(ctx, bob, sdk.NewInt64Coin(shareDenom, 100), 2*week)
+	require.NoError(t, err)
+	_, err = k.LockShares(ctx, carol, sdk.NewInt64Coin(otherShareDenom, 100), 2*week)
+	require.NoError(t, err)
+
+	gaugeId, err := k.CreateGauge(ctx, funder, 1, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1_000)), time.Time{}, 1)
+	require.NoError(t, err)
+	otherGaugeId, err := k.CreateGauge(ctx, funder, 2, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1_000)), startTime.Add(4*week), 1)
+	require.NoError(t, err)
+
+	gauges := k.PoolGauges(ctx, 1)
+	require.Len(t, gauges, 1)
+	require.Equal(t, gaugeId, gauges[0].Id)
+	gauges = k.PoolGauges(ctx, 2)
+	require.Len(t, gauges, 1)
+	require.Equal(t, otherGaugeId, gauges[0].Id)
+
+	ctx = ctx.WithBlockTime(startTime.Add(week))
+	k.AfterEpochEnd(ctx, epochstypes.WeekEpochID, 1)
+	require.True(t, k.ClaimableRewards(ctx, alice).IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1_000)), k.ClaimableRewards(ctx, bob))
+	require.True(t, k.ClaimableRewards(ctx, carol).IsZero())
+}
+
+func TestCreateReserveGauge(t *testing.T) {
+	app, ctx := setupPool(time.Unix(1_690_000_000, 0).UTC())
+	msgServer := keeper.NewMsgServerImpl(app.IncentivesKeeper)
+	reserve := sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 10_000))
+	require.NoError(t, testapp.FundModuleAccount(app.BankKeeper, ctx, inflationtypes.ModuleName, reserve))
+	inflationAddr := app.AccountKeeper.GetModuleAddress(inflationtypes.ModuleName)
+	balanceBefore := app.BankKeeper.GetBalance(ctx, inflationAddr, denoms.NIBI)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 4_000))
+	_, err := msgServer.CreateReserveGauge(sdk.WrapSDKContext(ctx), types.NewMsgCreateReserveGauge(
+		testutil.AccAddress().String(), 1, coins, time.Time{}, 4))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	resp, err := msgServer.CreateReserveGauge(sdk.WrapSDKContext(ctx), types.NewMsgCreateReserveGauge(
+		app.IncentivesKeeper.GetAuthority(), 1, coins, time.Time{}, 4))
+	require.NoError(t, err)
+
+	gauge, err := app.IncentivesKeeper.Gauges.Get(ctx, resp.GaugeId)
+	require.NoError(t, err)
+	require.Equal(t, coins, gauge.Coins)
+	require.Equal(t, ctx.BlockTime(), gauge.StartTime)
+	require.Equal(t,
+		balanceBefore.SubAmount(sdk.NewInt(4_000)),
+		app.BankKeeper.GetBalance(ctx, inflationAddr, denoms.NIBI))
+}
+
+func TestApr(t *testing.T) {
+	app, ctx := setupPool(time.Unix(1_690_000_000, 0).UTC())
+	k := app.IncentivesKeeper
+
+	alice, funder := testutil.AccAddress(), testutil.AccAddress()
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, alice, sdk.NewCoins(sdk.NewInt64Coin(shareDenom, 100))))
+	fundGaugeCreator(t, app, ctx, funder, sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.NIBI, 5_200), sdk.NewInt64Coin("uusdc", 5_200)))
+
+	_, _, err := k.Apr(ctx, 1, time.Hour)
+	require.ErrorIs(t, err, types.ErrInvalidLock)
+
+	// no locks
+	yearlyRewards, apr, err := k.Apr(ctx, 1, week)
+	require.NoError(t, err)
+	require.True(t, yearlyRewards.IsZero())
+	require.True(t, apr.IsZero())
+
+	_, err = k.LockShares(ctx, alice, sdk.NewInt64Coin(shareDenom, 100), week)
+	require.NoError(t, err)
+	// 100unibi and 100uusdc per weekly epoch, 52 epochs a year
+	_, err = k.CreateGauge(ctx, funder, 1, sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.NIBI, 5_200), sdk.NewInt64Coin("uusdc", 5_200)), time.Time{}, 52)
+	require.NoError(t, err)
+
+	yearlyRewards, apr, err = k.Apr(ctx, 1, week)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewInt64DecCoin(denoms.NIBI, 52), sdk.NewInt64DecCoin("uusdc", 52)), yearlyRewards)
+	// one share is worth (1000 unibi + 1000 uatom) / 100 = 20 unibi, the uusdc
+	// rewards are not priced by the pool
+	require.Equal(t, sdk.MustNewDecFromStr("2.6"), apr)
+
+	yearlyRewards, apr, err = k.Apr(ctx, 1, 2*week)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewInt64DecCoin(denoms.NIBI, 104), sdk.NewInt64DecCoin("uusdc", 104)), yearlyRewards)
+	require.Equal(t, sdk.MustNewDecFromStr("5.2"), apr)
+
+	resp, err := keeper.NewQuerier(k).Apr(sdk.WrapSDKContext(ctx), &types.QueryAprRequest{PoolId: 1, Duration: week})
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("2.6"), resp.Apr)
+}
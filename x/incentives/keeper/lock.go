@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/incentives/types"
+	spottypes "github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+// LockShares locks pool shares of the owner for a lockable duration. The lock
+// earns the rewards of the gauges of the pool until the duration elapses.
+func (k Keeper) LockShares(
+	ctx sdk.Context, owner sdk.AccAddress, coin sdk.Coin, duration time.Duration,
+) (types.Lock, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.Lock{}, err
+	}
+	if !params.IsLockableDuration(duration) {
+		return types.Lock{}, types.ErrInvalidLock.Wrapf(
+			"duration %s is not one of the lockable durations %v", duration, params.LockableDurations)
+	}
+
+	if coin.Amount.LT(params.MinLockAmount) {
+		return types.Lock{}, types.ErrInvalidLock.Wrapf(
+			"locked amount %s is below the minimum lock amount %s", coin.Amount, params.MinLockAmount)
+	}
+
+	poolId, ok := types.PoolIdFromShareDenom(coin.Denom)
+	if !ok {
+		return types.Lock{}, types.ErrNotLockableShare.Wrap(coin.Denom)
+	}
+	if err := k.validateIncentivizedPool(ctx, poolId); err != nil {
+		return types.Lock{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return types.Lock{}, err
+	}
+
+	lock := types.Lock{
+		Id:       k.NextLockId.Next(ctx),
+		Owner:    owner.String(),
+		Coin:     coin,
+		Duration: duration,
+		EndTime:  ctx.BlockTime().Add(duration),
+		Rewards:  sdk.NewCoins(),
+	}
+	k.Locks.Insert(ctx, lock.Id, lock)
+
+	return lock, ctx.EventManager().EmitTypedEvent(&types.EventLockCreated{Lock: lock})
+}
+
+// Unlock withdraws the shares of an expired lock along with its unclaimed
+// rewards, and deletes the lock.
+func (k Keeper) Unlock(ctx sdk.Context, owner sdk.AccAddress, lockId uint64) (sdk.Coins, error) {
+	lock, err := k.getOwnedLock(ctx, owner, lockId)
+	if err != nil {
+		return nil, err
+	}
+	if lock.IsActive(ctx.BlockTime()) {
+		return nil, types.ErrLockNotExpired.Wrapf("lock %d ends at %s", lockId, lock.EndTime)
+	}
+
+	coins := lock.Rewards.Add(lock.Coin)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, coins); err != nil {
+		return nil, err
+	}
+	if err := k.Locks.Delete(ctx, lockId); err != nil {
+		return nil, err
+	}
+
+	return coins, ctx.EventManager().EmitTypedEvent(&types.EventUnlocked{
+		LockId: lockId,
+		Owner:  lock.Owner,
+		Coins:  coins,
+	})
+}
+
+// ClaimRewards withdraws the rewards earned by all the locks of the owner.
+func (k Keeper) ClaimRewards(ctx sdk.Context, owner sdk.AccAddress) (sdk.Coins, error) {
+	rewards := sdk.NewCoins()
+	for _, lock := range k.AccountLocks(ctx, owner) {
+		if lock.Rewards.IsZero() {
+			continue
+		}
+		rewards = rewards.Add(lock.Rewards...)
+		lock.Rewards = sdk.NewCoins()
+		k.Locks.Insert(ctx, lock.Id, lock)
+	}
+
+	if !rewards.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, rewards); err != nil {
+			return nil, err
+		}
+	}
+
+	return rewards, ctx.EventManager().EmitTypedEvent(&types.EventRewardsClaimed{
+		Owner:   owner.String(),
+		Rewards: rewards,
+	})
+}
+
+// ClaimableRewards returns the rewards earned by the locks of the owner and not
+// claimed yet.
+func (k Keeper) ClaimableRewards(ctx sdk.Context, owner sdk.AccAddress) sdk.Coins {
+	rewards := sdk.NewCoins()
+	for _, lock := range k.AccountLocks(ctx, owner) {
+		rewards = rewards.Add(lock.Rewards...)
+	}
+	return rewards
+}
+
+// AccountLocks returns the locks of the owner, sorted by id.
+func (k Keeper) AccountLocks(ctx sdk.Context, owner sdk.AccAddress) []types.Lock {
+	return k.Locks.Collect(ctx, k.Locks.Indexes.Owner.ExactMatch(ctx, owner.String()))
+}
+
+func (k Keeper) getOwnedLock(ctx sdk.Context, owner sdk.AccAddress, lockId uint64) (types.Lock, error) {
+	lock, err := k.Locks.Get(ctx, lockId)
+	if err != nil {
+		return types.Lock{}, types.ErrLockNotFound.Wrapf("lock %d", lockId)
+	}
+	if lock.Owner != owner.String() {
+		return types.Lock{}, types.ErrInvalidLock.Wrapf("lock %d is not owned by %s", lockId, owner)
+	}
+	return lock, nil
+}
+
+// validateIncentivizedPool checks that the pool exists and has share tokens:
+// concentrated liquidity pools track liquidity with positions instead.
+func (k Keeper) validateIncentivizedPool(ctx sdk.Context, poolId uint64) error {
+	pool, err := k.spotKeeper.FetchPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	if pool.PoolParams.PoolType == spottypes.PoolType_CONCENTRATED {
+		return types.ErrNotLockableShare.Wrapf(
+			"pool %d is a concentrated liquidity pool which has no shares", poolId)
+	}
+	return nil
+}
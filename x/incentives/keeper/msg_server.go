@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/NibiruChain/nibiru/x/incentives/types"
+	inflationtypes "github.com/NibiruChain/nibiru/x/inflation/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the incentives MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (ms msgServer) LockShares(
+	goCtx context.Context, msg *types.MsgLockShares,
+) (*types.MsgLockSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := ms.Keeper.LockShares(ctx, owner, msg.Coin, msg.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLockSharesResponse{LockId: lock.Id}, nil
+}
+
+func (ms msgServer) Unlock(
+	goCtx context.Context, msg *types.MsgUnlock,
+) (*types.MsgUnlockResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	coins, err := ms.Keeper.Unlock(ctx, owner, msg.LockId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnlockResponse{Coins: coins}, nil
+}
+
+func (ms msgServer) ClaimRewards(
+	goCtx context.Context, msg *types.MsgClaimRewards,
+) (*types.MsgClaimRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards, err := ms.Keeper.ClaimRewards(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimRewardsResponse{Rewards: rewards}, nil
+}
+
+func (ms msgServer) CreateGauge(
+	goCtx context.Context, msg *types.MsgCreateGauge,
+) (*types.MsgCreateGaugeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := ms.Keeper.CreateGauge(ctx, sender, msg.PoolId, msg.Coins, msg.StartTime, msg.NumEpochs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateGaugeResponse{GaugeId: id}, nil
+}
+
+func (ms msgServer) AddToGauge(
+	goCtx context.Context, msg *types.MsgAddToGauge,
+) (*types.MsgAddToGaugeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.AddToGauge(ctx, sender, msg.GaugeId, msg.Coins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddToGaugeResponse{}, nil
+}
+
+// CreateReserveGauge creates a gauge funded by the strategic reserve, the
+// balance of the inflation module account.
+func (ms msgServer) CreateReserveGauge(
+	goCtx context.Context, msg *types.MsgCreateReserveGauge,
+) (*types.MsgCreateReserveGaugeResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := ms.Keeper.CreateGaugeFromModule(
+		ctx, inflationtypes.ModuleName, msg.PoolId, msg.Coins, msg.StartTime, msg.NumEpochs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateReserveGaugeResponse{GaugeId: id}, nil
+}
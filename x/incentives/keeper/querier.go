@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NibiruChain/nibiru/x/incentives/types"
+)
+
+// querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over q
+type querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the incentives QueryServer interface
+// for the provided Keeper.
+func NewQuerier(keeper Keeper) types.QueryServer {
+	return &querier{Keeper: keeper}
+}
+
+var _ types.QueryServer = querier{}
+
+func (q querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	params, err := q.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+func (q querier) Lock(c context.Context, req *types.QueryLockRequest) (*types.QueryLockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	lock, err := q.Keeper.Locks.Get(ctx, req.LockId)
+	if err != nil {
+		return nil, types.ErrLockNotFound.Wrapf("lock %d", req.LockId)
+	}
+
+	return &types.QueryLockResponse{Lock: lock}, nil
+}
+
+func (q querier) AccountLocks(c context.Context, req *types.QueryAccountLocksRequest) (*types.QueryAccountLocksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryAccountLocksResponse{Locks: q.Keeper.AccountLocks(ctx, owner)}, nil
+}
+
+func (q querier) Gauge(c context.Context, req *types.QueryGaugeRequest) (*types.QueryGaugeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	gauge, err := q.Keeper.Gauges.Get(ctx, req.GaugeId)
+	if err != nil {
+		return nil, types.ErrGaugeNotFound.Wrapf("gauge %d", req.GaugeId)
+	}
+
+	return &types.QueryGaugeResponse{Gauge: gauge}, nil
+}
+
+func (q querier) Gauges(c context.Context, req *types.QueryGaugesRequest) (*types.QueryGaugesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.PoolId != 0 {
+		return &types.QueryGaugesResponse{Gauges: q.Keeper.PoolGauges(ctx, req.PoolId)}, nil
+	}
+
+	return &types.QueryGaugesResponse{
+		Gauges: q.Keeper.Gauges.Iterate(ctx, collections.Range[uint64]{}).Values(),
+	}, nil
+}
+
+func (q querier) ClaimableRewards(
+	c context.Context, req *types.QueryClaimableRewardsRequest,
+) (*types.QueryClaimableRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryClaimableRewardsResponse{Rewards: q.Keeper.ClaimableRewards(ctx, owner)}, nil
+}
+
+func (q querier) Apr(c context.Context, req *types.QueryAprRequest) (*types.QueryAprResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	yearlyRewards, apr, err := q.Keeper.Apr(ctx, req.PoolId, req.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAprResponse{
+		YearlyRewardsPerShare: yearlyRewards,
+		Apr:                   apr,
+	}, nil
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdkcodec "github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/incentives/types"
+)
+
+type LockIndexes struct {
+	// Owner MultiIndex:
+	//  - indexing key (IK): owner address
+	//  - primary key (PK): lock id
+	//  - value (V): lock
+	Owner collections.MultiIndex[string, uint64, types.Lock]
+
+	// Denom MultiIndex:
+	//  - indexing key (IK): locked pool share denom
+	//  - primary key (PK): lock id
+	//  - value (V): lock
+	Denom collections.MultiIndex[string, uint64, types.Lock]
+
+	// DenomEndTime MultiIndex:
+	//  - indexing key (IK): locked pool share denom and lock end time
+	//  - primary key (PK): lock id
+	//  - value (V): lock
+	DenomEndTime collections.MultiIndex[collections.Pair[string, time.Time], uint64, types.Lock]
+}
+
+func (idxs LockIndexes) IndexerList() []collections.Indexer[uint64, types.Lock] {
+	return []collections.Indexer[uint64, types.Lock]{
+		idxs.Owner, idxs.Denom, idxs.DenomEndTime,
+	}
+}
+
+func NewLockStore(
+	storeKey storetypes.StoreKey, cdc sdkcodec.BinaryCodec,
+) collections.IndexedMap[uint64, types.Lock, LockIndexes] {
+	return collections.NewIndexedMap[uint64, types.Lock](
+		storeKey, 1, collections.Uint64KeyEncoder, collections.ProtoValueEncoder[types.Lock](cdc),
+		LockIndexes{
+			Owner: collections.NewMultiIndex[string, uint64, types.Lock](
+				storeKey, 2,
+				collections.StringKeyEncoder, // index key (IK)
+				collections.Uint64KeyEncoder, // primary key (PK)
+				func(v types.Lock) string { return v.Owner },
+			),
+			Denom: collections.NewMultiIndex[string, uint64, types.Lock](
+				storeKey, 3,
+				collections.StringKeyEncoder, // index key (IK)
+				collections.Uint64KeyEncoder, // primary key (PK)
+				func(v types.Lock) string { return v.Coin.Denom },
+			),
+			DenomEndTime: collections.NewMultiIndex[collections.Pair[string, time.Time], uint64, types.Lock](
+				storeKey, 8,
+				collections.PairKeyEncoder(collections.StringKeyEncoder, endTimeKeyEncoder), // index key (IK)
+				collections.Uint64KeyEncoder, // primary key (PK)
+				func(v types.Lock) collections.Pair[string, time.Time] {
+					return collections.Join(v.Coin.Denom, v.EndTime)
+				},
+			),
+		},
+	)
+}
+
+type GaugeIndexes struct {
+	// Pool MultiIndex:
+	//  - indexing key (IK): incentivized pool id
+	//  - primary key (PK): gauge id
+	//  - value (V): gauge
+	Pool collections.MultiIndex[uint64, uint64, types.Gauge]
+}
+
+func (idxs GaugeIndexes) IndexerList() []collections.Indexer[uint64, types.Gauge] {
+	return []collections.Indexer[uint64, types.Gauge]{idxs.Pool}
+}
+
+func NewGaugeStore(
+	storeKey storetypes.StoreKey, cdc sdkcodec.BinaryCodec,
+) collections.IndexedMap[uint64, types.Gauge, GaugeIndexes] {
+	return collections.NewIndexedMap[uint64, types.Gauge](
+		storeKey, 4, collections.Uint64KeyEncoder, collections.ProtoValueEncoder[types.Gauge](cdc),
+		GaugeIndexes{
+			Pool: collections.NewMultiIndex[uint64, uint64, types.Gauge](
+				storeKey, 9,
+				collections.Uint64KeyEncoder, // index key (IK)
+				collections.Uint64KeyEncoder, // primary key (PK)
+				func(v types.Gauge) uint64 { return v.PoolId },
+			),
+		},
+	)
+}
+
+// endTimeKeyEncoder encodes times like collections.TimeKeyEncoder, but decodes
+// only the fixed length of the encoded time, so that a key can follow it.
+var endTimeKeyEncoder collections.KeyEncoder[time.Time] = timeKeyEncoder{}
+
+type timeKeyEncoder struct{}
+
+func (timeKeyEncoder) Stringify(t time.Time) string { return collections.TimeKeyEncoder.Stringify(t) }
+func (timeKeyEncoder) Encode(t time.Time) []byte    { return collections.TimeKeyEncoder.Encode(t) }
+func (timeKeyEncoder) Decode(b []byte) (int, time.Time) {
+	return collections.TimeKeyEncoder.Decode(b[:len(sdk.SortableTimeFormat)])
+}
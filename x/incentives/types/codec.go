@@ -0,0 +1,49 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/incentives interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgLockShares{}, "incentives/MsgLockShares", nil)
+	cdc.RegisterConcrete(&MsgUnlock{}, "incentives/MsgUnlock", nil)
+	cdc.RegisterConcrete(&MsgClaimRewards{}, "incentives/MsgClaimRewards", nil)
+	cdc.RegisterConcrete(&MsgCreateGauge{}, "incentives/MsgCreateGauge", nil)
+	cdc.RegisterConcrete(&MsgAddToGauge{}, "incentives/MsgAddToGauge", nil)
+	cdc.RegisterConcrete(&MsgCreateReserveGauge{}, "incentives/MsgCreateReserveGauge", nil)
+}
+
+// RegisterInterfaces registers the x/incentives interfaces types with the interface registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgLockShares{},
+		&MsgUnlock{},
+		&MsgClaimRewards{},
+		&MsgCreateGauge{},
+		&MsgAddToGauge{},
+		&MsgCreateReserveGauge{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/incentives module codec. Note, the
+	// codec should ONLY be used in certain instances of tests and for JSON
+	// encoding as Amino is still used for that purpose.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
@@ -0,0 +1,13 @@
+package types
+
+import sdkerrors "cosmossdk.io/errors"
+
+var (
+	ErrInvalidLock      = sdkerrors.Register(ModuleName, 2, "invalid lock")
+	ErrLockNotFound     = sdkerrors.Register(ModuleName, 3, "lock not found")
+	ErrLockNotExpired   = sdkerrors.Register(ModuleName, 4, "lock has not expired yet")
+	ErrInvalidGauge     = sdkerrors.Register(ModuleName, 5, "invalid gauge")
+	ErrGaugeNotFound    = sdkerrors.Register(ModuleName, 6, "gauge not found")
+	ErrGaugeFinished    = sdkerrors.Register(ModuleName, 7, "gauge has finished distributing its rewards")
+	ErrNotLockableShare = sdkerrors.Register(ModuleName, 8, "coin is not a lockable pool share")
+)
@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
+	spottypes "github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+// AccountKeeper defines the expected account keeper used by the incentives
+// module.
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
+}
+
+// BankKeeper defines the expected bank keeper used by the incentives module.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+// DistrKeeper defines the expected distribution keeper used by the incentives
+// module.
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// SpotKeeper defines the expected spot keeper used by the incentives module.
+type SpotKeeper interface {
+	FetchPool(ctx sdk.Context, poolId uint64) (spottypes.Pool, error)
+}
+
+// EpochKeeper defines the expected epochs keeper used by the incentives
+// module.
+type EpochKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epoch epochstypes.EpochInfo, err error)
+}
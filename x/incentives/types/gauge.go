@@ -0,0 +1,75 @@
+package types
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxGaugeEpochs is the maximum number of epochs a gauge distributes its
+// rewards over: ten years of daily distribution epochs.
+const MaxGaugeEpochs uint64 = 10 * 365
+
+// ValidateNumEpochs checks a gauge distributes its rewards over a positive
+// number of epochs, bounded by MaxGaugeEpochs.
+func ValidateNumEpochs(numEpochs uint64) error {
+	if numEpochs == 0 {
+		return ErrInvalidGauge.Wrap("number of epochs must be positive")
+	}
+	if numEpochs > MaxGaugeEpochs {
+		return ErrInvalidGauge.Wrapf(
+			"number of epochs %d exceeds the maximum of %d", numEpochs, MaxGaugeEpochs)
+	}
+	return nil
+}
+
+// IsActive returns whether the gauge distributes rewards at time t.
+func (g Gauge) IsActive(t time.Time) bool {
+	return !t.Before(g.StartTime) && !g.IsFinished()
+}
+
+// IsFinished returns whether the gauge distributed rewards at all its epochs.
+func (g Gauge) IsFinished() bool {
+	return g.FilledEpochs >= g.NumEpochs
+}
+
+// EpochCoins returns the rewards the gauge distributes at its next epoch: the
+// undistributed rewards spread evenly over the remaining epochs.
+func (g Gauge) EpochCoins() sdk.Coins {
+	if g.IsFinished() {
+		return sdk.NewCoins()
+	}
+	remainingEpochs := sdkmath.NewIntFromUint64(g.NumEpochs - g.FilledEpochs)
+	remaining := g.Coins.Sub(g.DistributedCoins...)
+	epochCoins := sdk.NewCoins()
+	for _, coin := range remaining {
+		epochCoins = epochCoins.Add(sdk.NewCoin(coin.Denom, coin.Amount.Quo(remainingEpochs)))
+	}
+	return epochCoins
+}
+
+// Validate checks the gauge is valid.
+func (g Gauge) Validate() error {
+	if g.PoolId == 0 {
+		return ErrInvalidGauge.Wrap("pool id must be positive")
+	}
+	if err := g.Coins.Validate(); err != nil {
+		return ErrInvalidGauge.Wrapf("invalid coins: %s", err)
+	}
+	if err := g.DistributedCoins.Validate(); err != nil {
+		return ErrInvalidGauge.Wrapf("invalid distributed coins: %s", err)
+	}
+	if !g.Coins.IsAllGTE(g.DistributedCoins) {
+		return ErrInvalidGauge.Wrapf(
+			"distributed coins %s exceed the gauge coins %s", g.DistributedCoins, g.Coins)
+	}
+	if err := ValidateNumEpochs(g.NumEpochs); err != nil {
+		return err
+	}
+	if g.FilledEpochs > g.NumEpochs {
+		return ErrInvalidGauge.Wrapf(
+			"filled epochs %d exceed the number of epochs %d", g.FilledEpochs, g.NumEpochs)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package types
+
+import "fmt"
+
+// DefaultGenesis returns the default genesis state of the incentives module.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		Locks:  []Lock{},
+		Gauges: []Gauge{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	lockIds := make(map[uint64]bool)
+	for _, lock := range gs.Locks {
+		if lockIds[lock.Id] {
+			return fmt.Errorf("duplicate lock id %d", lock.Id)
+		}
+		lockIds[lock.Id] = true
+		if err := lock.Validate(); err != nil {
+			return err
+		}
+	}
+
+	gaugeIds := make(map[uint64]bool)
+	for _, gauge := range gs.Gauges {
+		if gaugeIds[gauge.Id] {
+			return fmt.Errorf("duplicate gauge id %d", gauge.Id)
+		}
+		gaugeIds[gauge.Id] = true
+		if err := gauge.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
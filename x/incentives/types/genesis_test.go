@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/incentives/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	lock := types.Lock{
+		Id:       1,
+		Owner:    testutil.AccAddress().String(),
+		Coin:     sdk.NewInt64Coin("nibiru/pool/1", 100),
+		Duration: time.Hour,
+		EndTime:  time.Unix(1_690_000_000, 0),
+	}
+	gauge := types.Gauge{
+		Id:               1,
+		PoolId:           1,
+		Coins:            sdk.NewCoins(sdk.NewInt64Coin("unibi", 100)),
+		DistributedCoins: sdk.NewCoins(sdk.NewInt64Coin("unibi", 50)),
+		NumEpochs:        2,
+		FilledEpochs:     1,
+	}
+
+	for _, tc := range []struct {
+		name    string
+		modify  func(gs *types.GenesisState)
+		wantErr bool
+	}{
+		{"default", func(gs *types.GenesisState) {}, false},
+		{"valid state", func(gs *types.GenesisState) {
+			gs.Locks = []types.Lock{lock}
+			gs.Gauges = []types.Gauge{gauge}
+		}, false},
+		{"no lockable durations", func(gs *types.GenesisState) { gs.Params.LockableDurations = nil }, true},
+		{"duplicate lockable durations", func(gs *types.GenesisState) {
+			gs.Params.LockableDurations = []time.Duration{time.Hour, time.Hour}
+		}, true},
+		{"empty epoch identifier", func(gs *types.GenesisState) { gs.Params.DistrEpochIdentifier = "" }, true},
+		{"negative min lock amount", func(gs *types.GenesisState) { gs.Params.MinLockAmount = sdk.NewInt(-1) }, true},
+		{"duplicate lock id", func(gs *types.GenesisState) { gs.Locks = []types.Lock{lock, lock} }, true},
+		{"lock of non share denom", func(gs *types.GenesisState) {
+			invalid := lock
+			invalid.Coin = sdk.NewInt64Coin("unibi", 100)
+			gs.Locks = []types.Lock{invalid}
+		}, true},
+		{"duplicate gauge id", func(gs *types.GenesisState) { gs.Gauges = []types.Gauge{gauge, gauge} }, true},
+		{"gauge distributed too much", func(gs *types.GenesisState) {
+			invalid := gauge
+			invalid.DistributedCoins = sdk.NewCoins(sdk.NewInt64Coin("unibi", 150))
+			gs.Gauges = []types.Gauge{invalid}
+		}, true},
+		{"gauge filled too many epochs", func(gs *types.GenesisState) {
+			invalid := gauge
+			invalid.FilledEpochs = 3
+			gs.Gauges = []types.Gauge{invalid}
+		}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := types.DefaultGenesis()
+			tc.modify(gs)
+			err := gs.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGauge_EpochCoins(t *testing.T) {
+	gauge := types.Gauge{
+		Coins:            sdk.NewCoins(sdk.NewInt64Coin("unibi", 1_000), sdk.NewInt64Coin("uusdc", 10)),
+		DistributedCoins: sdk.NewCoins(sdk.NewInt64Coin("unibi", 400)),
+		NumEpochs:        5,
+		FilledEpochs:     2,
+	}
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin("unibi", 200), sdk.NewInt64Coin("uusdc", 3)),
+		gauge.EpochCoins())
+
+	gauge.FilledEpochs = 5
+	require.True(t, gauge.EpochCoins().IsZero())
+
+	// more remaining epochs than an int64 holds must not overflow
+	gauge = types.Gauge{PoolId: 1, Coins: gauge.Coins, NumEpochs: math.MaxUint64}
+	require.NotPanics(t, func() { require.True(t, gauge.EpochCoins().IsZero()) })
+	require.ErrorIs(t, gauge.Validate(), types.ErrInvalidGauge)
+}
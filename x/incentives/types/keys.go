@@ -0,0 +1,7 @@
+package types
+
+const (
+	ModuleName = "incentives"
+	StoreKey   = ModuleName
+	RouterKey  = ModuleName
+)
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	spottypes "github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+// PoolIdFromShareDenom returns the id of the pool whose share denom is denom,
+// and false if denom is not a pool share denom.
+func PoolIdFromShareDenom(denom string) (uint64, bool) {
+	poolId, err := strconv.ParseUint(strings.TrimPrefix(denom, "nibiru/pool/"), 10, 64)
+	if err != nil || poolId == 0 || spottypes.GetPoolShareBaseDenom(poolId) != denom {
+		return 0, false
+	}
+	return poolId, true
+}
+
+// Weight returns the weight of the lock in the distribution of the rewards:
+// the amount of locked shares times the lock duration in seconds.
+func (l Lock) Weight() sdkmath.Int {
+	return l.Coin.Amount.MulRaw(int64(l.Duration / time.Second))
+}
+
+// IsActive returns whether the lock earns rewards at time t.
+func (l Lock) IsActive(t time.Time) bool {
+	return t.Before(l.EndTime)
+}
+
+// Validate checks the lock is valid.
+func (l Lock) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(l.Owner); err != nil {
+		return ErrInvalidLock.Wrapf("invalid owner address: %s", err)
+	}
+	if err := l.Coin.Validate(); err != nil || !l.Coin.IsPositive() {
+		return ErrInvalidLock.Wrapf("invalid locked coin %s", l.Coin)
+	}
+	if _, ok := PoolIdFromShareDenom(l.Coin.Denom); !ok {
+		return ErrNotLockableShare.Wrap(l.Coin.Denom)
+	}
+	if l.Duration < time.Second {
+		return ErrInvalidLock.Wrapf("lock duration must be at least one second, got %s", l.Duration)
+	}
+	if err := l.Rewards.Validate(); err != nil {
+		return ErrInvalidLock.Wrapf("invalid rewards: %s", err)
+	}
+	return nil
+}
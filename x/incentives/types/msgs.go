@@ -0,0 +1,302 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = &MsgLockShares{}
+	_ sdk.Msg = &MsgUnlock{}
+	_ sdk.Msg = &MsgClaimRewards{}
+	_ sdk.Msg = &MsgCreateGauge{}
+	_ sdk.Msg = &MsgAddToGauge{}
+	_ sdk.Msg = &MsgCreateReserveGauge{}
+)
+
+// incentives message types
+const (
+	TypeMsgLockShares         = "lock_shares"
+	TypeMsgUnlock             = "unlock"
+	TypeMsgClaimRewards       = "claim_rewards"
+	TypeMsgCreateGauge        = "create_gauge"
+	TypeMsgAddToGauge         = "add_to_gauge"
+	TypeMsgCreateReserveGauge = "create_reserve_gauge"
+)
+
+//-------------------------------------------------
+//-------------------------------------------------
+
+// NewMsgLockShares creates a MsgLockShares instance
+func NewMsgLockShares(owner sdk.AccAddress, coin sdk.Coin, duration time.Duration) *MsgLockShares {
+	return &MsgLockShares{
+		Owner:    owner.String(),
+		Coin:     coin,
+		Duration: duration,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgLockShares) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgLockShares) Type() string { return TypeMsgLockShares }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgLockShares) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgLockShares) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgLockShares) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+
+	if err := msg.Coin.Validate(); err != nil || !msg.Coin.IsPositive() {
+		return sdkerrors.Wrapf(errors.ErrInvalidCoins, "Invalid locked coin (%s)", msg.Coin)
+	}
+
+	if _, ok := PoolIdFromShareDenom(msg.Coin.Denom); !ok {
+		return sdkerrors.Wrap(ErrNotLockableShare, msg.Coin.Denom)
+	}
+
+	if msg.Duration <= 0 {
+		return sdkerrors.Wrap(ErrInvalidLock, "lock duration must be positive")
+	}
+
+	return nil
+}
+
+//-------------------------------------------------
+//-------------------------------------------------
+
+// NewMsgUnlock creates a MsgUnlock instance
+func NewMsgUnlock(owner sdk.AccAddress, lockId uint64) *MsgUnlock {
+	return &MsgUnlock{
+		Owner:  owner.String(),
+		LockId: lockId,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUnlock) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUnlock) Type() string { return TypeMsgUnlock }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUnlock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUnlock) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUnlock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	return nil
+}
+
+//-------------------------------------------------
+//-------------------------------------------------
+
+// NewMsgClaimRewards creates a MsgClaimRewards instance
+func NewMsgClaimRewards(owner sdk.AccAddress) *MsgClaimRewards {
+	return &MsgClaimRewards{Owner: owner.String()}
+}
+
+// Route implements sdk.Msg
+func (msg MsgClaimRewards) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgClaimRewards) Type() string { return TypeMsgClaimRewards }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgClaimRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgClaimRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	return nil
+}
+
+//-------------------------------------------------
+//-------------------------------------------------
+
+// NewMsgCreateGauge creates a MsgCreateGauge instance
+func NewMsgCreateGauge(
+	sender sdk.AccAddress, poolId uint64, coins sdk.Coins, startTime time.Time, numEpochs uint64,
+) *MsgCreateGauge {
+	return &MsgCreateGauge{
+		Sender:    sender.String(),
+		PoolId:    poolId,
+		Coins:     coins,
+		StartTime: startTime,
+		NumEpochs: numEpochs,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateGauge) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCreateGauge) Type() string { return TypeMsgCreateGauge }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateGauge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateGauge) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCreateGauge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	return validateGaugeCreation(msg.PoolId, msg.Coins, msg.NumEpochs)
+}
+
+//-------------------------------------------------
+//-------------------------------------------------
+
+// NewMsgAddToGauge creates a MsgAddToGauge instance
+func NewMsgAddToGauge(sender sdk.AccAddress, gaugeId uint64, coins sdk.Coins) *MsgAddToGauge {
+	return &MsgAddToGauge{
+		Sender:  sender.String(),
+		GaugeId: gaugeId,
+		Coins:   coins,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgAddToGauge) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAddToGauge) Type() string { return TypeMsgAddToGauge }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAddToGauge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAddToGauge) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAddToGauge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.Coins.IsValid() || msg.Coins.IsZero() {
+		return sdkerrors.Wrapf(errors.ErrInvalidCoins, "Invalid reward coins (%s)", msg.Coins)
+	}
+
+	return nil
+}
+
+//-------------------------------------------------
+//-------------------------------------------------
+
+// NewMsgCreateReserveGauge creates a MsgCreateReserveGauge instance
+func NewMsgCreateReserveGauge(
+	authority string, poolId uint64, coins sdk.Coins, startTime time.Time, numEpochs uint64,
+) *MsgCreateReserveGauge {
+	return &MsgCreateReserveGauge{
+		Authority: authority,
+		PoolId:    poolId,
+		Coins:     coins,
+		StartTime: startTime,
+		NumEpochs: numEpochs,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateReserveGauge) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCreateReserveGauge) Type() string { return TypeMsgCreateReserveGauge }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateReserveGauge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateReserveGauge) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCreateReserveGauge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+	return validateGaugeCreation(msg.PoolId, msg.Coins, msg.NumEpochs)
+}
+
+func validateGaugeCreation(poolId uint64, coins sdk.Coins, numEpochs uint64) error {
+	if poolId == 0 {
+		return sdkerrors.Wrap(ErrInvalidGauge, "pool id must be positive")
+	}
+
+	if !coins.IsValid() || coins.IsZero() {
+		return sdkerrors.Wrapf(errors.ErrInvalidCoins, "Invalid reward coins (%s)", coins)
+	}
+
+	return ValidateNumEpochs(numEpochs)
+}
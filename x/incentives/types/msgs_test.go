@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/incentives/types"
+)
+
+func TestMsgLockShares_ValidateBasic(t *testing.T) {
+	owner := testutil.AccAddress()
+	shares := sdk.NewInt64Coin("nibiru/pool/1", 100)
+
+	for _, tc := range []struct {
+		name    string
+		msg     *types.MsgLockShares
+		wantErr bool
+	}{
+		{"valid", types.NewMsgLockShares(owner, shares, time.Hour), false},
+		{"invalid owner", &types.MsgLockShares{Owner: "foo", Coin: shares, Duration: time.Hour}, true},
+		{"zero shares", types.NewMsgLockShares(owner, sdk.NewInt64Coin("nibiru/pool/1", 0), time.Hour), true},
+		{"not a pool share", types.NewMsgLockShares(owner, sdk.NewInt64Coin("unibi", 100), time.Hour), true},
+		{"pool zero", types.NewMsgLockShares(owner, sdk.NewInt64Coin("nibiru/pool/0", 100), time.Hour), true},
+		{"zero duration", types.NewMsgLockShares(owner, shares, 0), true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCreateGauge_ValidateBasic(t *testing.T) {
+	sender := testutil.AccAddress()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("unibi", 100))
+
+	for _, tc := range []struct {
+		name    string
+		msg     sdk.Msg
+		wantErr bool
+	}{
+		{"valid", types.NewMsgCreateGauge(sender, 1, coins, time.Time{}, 10), false},
+		{"invalid sender", &types.MsgCreateGauge{Sender: "foo", PoolId: 1, Coins: coins, NumEpochs: 10}, true},
+		{"zero pool id", types.NewMsgCreateGauge(sender, 0, coins, time.Time{}, 10), true},
+		{"no coins", types.NewMsgCreateGauge(sender, 1, sdk.NewCoins(), time.Time{}, 10), true},
+		{"zero epochs", types.NewMsgCreateGauge(sender, 1, coins, time.Time{}, 0), true},
+		{"too many epochs", types.NewMsgCreateGauge(sender, 1, coins, time.Time{}, types.MaxGaugeEpochs+1), true},
+		{"max uint64 epochs", types.NewMsgCreateGauge(sender, 1, coins, time.Time{}, math.MaxUint64), true},
+		{"reserve gauge too many epochs", types.NewMsgCreateReserveGauge(sender.String(), 1, coins, time.Time{}, types.MaxGaugeEpochs+1), true},
+		{"valid reserve gauge", types.NewMsgCreateReserveGauge(sender.String(), 1, coins, time.Time{}, 10), false},
+		{"invalid authority", types.NewMsgCreateReserveGauge("foo", 1, coins, time.Time{}, 10), true},
+		{"valid add to gauge", types.NewMsgAddToGauge(sender, 1, coins), false},
+		{"add no coins to gauge", types.NewMsgAddToGauge(sender, 1, sdk.NewCoins()), true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
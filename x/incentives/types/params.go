@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
+	spottypes "github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+// DefaultParams returns the default parameters of the incentives module.
+func DefaultParams() Params {
+	return Params{
+		LockableDurations: []time.Duration{
+			24 * time.Hour,
+			7 * 24 * time.Hour,
+			14 * 24 * time.Hour,
+		},
+		DistrEpochIdentifier: epochstypes.WeekEpochID,
+		GaugeCreationFee:     sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 10*common.TO_MICRO)), // 10 NIBI
+		MinLockAmount:        spottypes.OneDisplayPoolShare.QuoRaw(1_000),                     // 0.001 pool share
+	}
+}
+
+// Validate checks the parameters are valid.
+func (p Params) Validate() error {
+	if len(p.LockableDurations) == 0 {
+		return fmt.Errorf("lockable durations must not be empty")
+	}
+	seen := make(map[time.Duration]bool)
+	for _, duration := range p.LockableDurations {
+		if duration <= 0 {
+			return fmt.Errorf("lockable duration must be positive, got %s", duration)
+		}
+		if seen[duration] {
+			return fmt.Errorf("duplicate lockable duration %s", duration)
+		}
+		seen[duration] = true
+	}
+	if err := epochstypes.ValidateEpochIdentifierString(p.DistrEpochIdentifier); err != nil {
+		return err
+	}
+	if err := p.GaugeCreationFee.Validate(); err != nil {
+		return fmt.Errorf("invalid gauge creation fee: %w", err)
+	}
+	if p.MinLockAmount.IsNil() || p.MinLockAmount.IsNegative() {
+		return fmt.Errorf("min lock amount must be non-negative, got %s", p.MinLockAmount)
+	}
+	return nil
+}
+
+// IsLockableDuration returns whether pool shares can be locked for the
+// duration.
+func (p Params) IsLockableDuration(duration time.Duration) bool {
+	for _, lockable := range p.LockableDurations {
+		if lockable == duration {
+			return true
+		}
+	}
+	return false
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -28,6 +29,9 @@ func GetQueryCmd() *cobra.Command {
 		GetSkippedEpochs(),
 		GetCirculatingSupply(),
 		GetInflationRate(),
+		GetMintSchedule(),
+		GetStrategicReserve(),
+		GetVestingSchedules(),
 		GetParams(),
 	)
 
@@ -124,11 +128,12 @@ func GetSkippedEpochs() *cobra.Command {
 	return cmd
 }
 
-// GetCirculatingSupply implements a command to return the current circulating supply
+// GetCirculatingSupply implements a command to return the current circulating
+// supply and the amounts excluded from it
 func GetCirculatingSupply() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "circulating-supply",
-		Short: "Query the current supply of tokens in circulation",
+		Short: "Query the current supply of tokens in circulation and the amounts excluded from it",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -144,7 +149,7 @@ func GetCirculatingSupply() *cobra.Command {
 				return err
 			}
 
-			return clientCtx.PrintString(fmt.Sprintf("%s\n", res.CirculatingSupply))
+			return clientCtx.PrintProto(res)
 		},
 	}
 
@@ -182,6 +187,109 @@ func GetInflationRate() *cobra.Command {
 	return cmd
 }
 
+// GetMintSchedule implements a command to return the projected mint
+// provisions of the next epochs and periods
+func GetMintSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-schedule [num-epochs] [num-periods]",
+		Short: "Query the projected mint provisions of the next epochs and periods",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			numEpochs, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid num epochs %s: %w", args[0], err)
+			}
+
+			numPeriods, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid num periods %s: %w", args[1], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryMintScheduleRequest{
+				NumEpochs:  numEpochs,
+				NumPeriods: numPeriods,
+			}
+			res, err := queryClient.MintSchedule(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetStrategicReserve implements a command to return the balance of the
+// strategic reserve
+func GetStrategicReserve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "strategic-reserve",
+		Short: "Query the balance of the strategic reserve and the coins held by its vesting schedules",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryStrategicReserveRequest{}
+			res, err := queryClient.StrategicReserve(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetVestingSchedules implements a command to return the active vesting
+// schedules of the strategic reserve
+func GetVestingSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-schedules",
+		Short: "Query the active vesting schedules of the strategic reserve",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryVestingSchedulesRequest{}
+			res, err := queryClient.VestingSchedules(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetParams implements a command to return the current inflation
 // parameters.
 func GetParams() *cobra.Command {
@@ -1,6 +1,7 @@
 package inflation
 
 import (
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/x/inflation/keeper"
@@ -28,6 +29,17 @@ func InitGenesis(
 
 	skippedEpochs := data.SkippedEpochs
 	k.NumSkippedEpochs.Set(ctx, skippedEpochs)
+
+	// Ensure the vesting module account is set and restore its schedules
+	if acc := ak.GetModuleAccount(ctx, types.VestingModuleAccount); acc == nil {
+		panic("the inflation vesting module account has not been set")
+	}
+	for _, schedule := range data.VestingSchedules {
+		k.VestingStore.Insert(ctx, schedule.Id, schedule)
+		if schedule.Id >= k.NextVestingScheduleId.Peek(ctx) {
+			k.NextVestingScheduleId.Set(ctx, schedule.Id+1)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -36,5 +48,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		Params:        k.GetParams(ctx),
 		Period:        k.CurrentPeriod.Peek(ctx),
 		SkippedEpochs: k.NumSkippedEpochs.Peek(ctx),
+		VestingSchedules: k.VestingStore.Iterate(
+			ctx, collections.Range[uint64]{},
+		).Values(),
 	}
 }
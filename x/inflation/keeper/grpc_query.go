@@ -3,7 +3,10 @@ package keeper
 import (
 	"context"
 
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/inflation/types"
@@ -48,23 +51,75 @@ func (k Keeper) InflationRate(
 	_ *types.QueryInflationRateRequest,
 ) (*types.QueryInflationRateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	inflationRate := k.GetInflationRate(ctx, denoms.NIBI)
-	return &types.QueryInflationRateResponse{InflationRate: inflationRate}, nil
+	// the supply breakdown is computed once per request
+	epochMintProvision := k.GetEpochMintProvision(ctx)
+	circulatingSupply := k.GetCirculatingSupply(ctx, denoms.NIBI)
+	inflationRate := k.inflationRate(ctx, epochMintProvision, circulatingSupply)
+	periodMintProvision := epochMintProvision.MulInt64(int64(k.EpochsPerPeriod(ctx)))
+	return &types.QueryInflationRateResponse{
+		InflationRate:       inflationRate,
+		CirculatingSupply:   sdk.NewCoin(denoms.NIBI, circulatingSupply),
+		PeriodMintProvision: sdk.NewDecCoinFromDec(denoms.NIBI, periodMintProvision),
+	}, nil
 }
 
-// CirculatingSupply returns the total supply in circulation excluding the team
-// allocation in the first year
+// CirculatingSupply returns the total supply in circulation excluding the
+// supply exclusions, with a breakdown of the excluded amounts
 func (k Keeper) CirculatingSupply(
 	c context.Context,
 	_ *types.QueryCirculatingSupplyRequest,
 ) (*types.QueryCirculatingSupplyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	circulatingSupply := k.GetCirculatingSupply(ctx, denoms.NIBI)
+	circulatingSupply, totalSupply, excluded := k.GetSupplyBreakdown(ctx, denoms.NIBI)
 	circulatingToDec := sdk.NewDecFromInt(circulatingSupply)
 	coin := sdk.NewDecCoinFromDec(denoms.NIBI, circulatingToDec)
 
-	return &types.QueryCirculatingSupplyResponse{CirculatingSupply: coin}, nil
+	return &types.QueryCirculatingSupplyResponse{
+		CirculatingSupply: coin,
+		TotalSupply:       totalSupply,
+		Excluded:          excluded,
+	}, nil
+}
+
+// MintSchedule returns the projected mint provisions of the next epochs and
+// periods.
+func (k Keeper) MintSchedule(
+	c context.Context,
+	req *types.QueryMintScheduleRequest,
+) (*types.QueryMintScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	epochs, periods := k.GetMintSchedule(ctx, req.NumEpochs, req.NumPeriods)
+	return &types.QueryMintScheduleResponse{Epochs: epochs, Periods: periods}, nil
+}
+
+// StrategicReserve returns the balance of the strategic reserve and the coins
+// held by its vesting schedules.
+func (k Keeper) StrategicReserve(
+	c context.Context,
+	_ *types.QueryStrategicReserveRequest,
+) (*types.QueryStrategicReserveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	balance, vesting := k.GetStrategicReserve(ctx)
+	return &types.QueryStrategicReserveResponse{Balance: balance, Vesting: vesting}, nil
+}
+
+// VestingSchedules returns the active vesting schedules of the strategic
+// reserve.
+func (k Keeper) VestingSchedules(
+	c context.Context,
+	_ *types.QueryVestingSchedulesRequest,
+) (*types.QueryVestingSchedulesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	schedules := k.VestingStore.Iterate(ctx, collections.Range[uint64]{}).Values()
+	return &types.QueryVestingSchedulesResponse{Schedules: schedules}, nil
 }
 
 // Params returns params of the mint module.
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/NibiruChain/nibiru/app"
@@ -101,3 +102,51 @@ func (s *QueryServerSuite) TestQueryParams() {
 	s.NoError(err)
 	s.NotNil(resp)
 }
+
+func TestQueryMintSchedule(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	goCtx := sdk.WrapSDKContext(ctx)
+	params := nibiruApp.InflationKeeper.GetParams(ctx)
+	epochsPerPeriod := params.EpochsPerPeriod
+
+	resp, err := nibiruApp.InflationKeeper.MintSchedule(goCtx, &inflationtypes.QueryMintScheduleRequest{
+		NumEpochs:  epochsPerPeriod + 2,
+		NumPeriods: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Epochs, int(epochsPerPeriod+2))
+	require.Len(t, resp.Periods, 2)
+
+	period0 := inflationtypes.CalculateEpochMintProvision(params, 0)
+	period1 := inflationtypes.CalculateEpochMintProvision(params, 1)
+
+	// the first period mints until its epoch count exceeds the epochs per period
+	require.Equal(t, uint64(1), resp.Epochs[0].EpochNumber)
+	require.Equal(t, period0, resp.Epochs[0].EpochMintProvision.Amount)
+	last := resp.Epochs[epochsPerPeriod+1]
+	require.Equal(t, epochsPerPeriod+2, last.EpochNumber)
+	require.Equal(t, uint64(1), last.Period)
+	require.Equal(t, period1, last.EpochMintProvision.Amount)
+	require.Equal(t, uint64(0), resp.Epochs[epochsPerPeriod].Period)
+
+	require.Equal(t, uint64(1), resp.Periods[1].Period)
+	require.Equal(t, period1, resp.Periods[1].EpochMintProvision.Amount)
+	require.Equal(t, period1.MulInt64(int64(epochsPerPeriod)), resp.Periods[1].PeriodMintProvision.Amount)
+
+	// disabled inflation mints nothing and stays in the current period
+	require.NoError(t, nibiruApp.InflationKeeper.ToggleInflation(ctx, false))
+	resp, err = nibiruApp.InflationKeeper.MintSchedule(goCtx, &inflationtypes.QueryMintScheduleRequest{
+		NumEpochs: epochsPerPeriod + 2,
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Periods)
+	for _, epoch := range resp.Epochs {
+		require.True(t, epoch.EpochMintProvision.IsZero())
+		require.Equal(t, uint64(0), epoch.Period)
+	}
+
+	_, err = nibiruApp.InflationKeeper.MintSchedule(goCtx, &inflationtypes.QueryMintScheduleRequest{
+		NumEpochs: inflationtypes.MaxScheduleEpochs + 1,
+	})
+	require.ErrorContains(t, err, "exceeds the maximum")
+}
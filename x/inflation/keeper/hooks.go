@@ -15,12 +15,15 @@ import (
 // BeforeEpochStart: noop, We don't need to do anything here
 func (k Keeper) BeforeEpochStart(_ sdk.Context, _ string, _ uint64) {}
 
-// AfterEpochEnd mints and allocates coins at the end of each epoch end
+// AfterEpochEnd releases the vested coins of the strategic reserve, and mints
+// and allocates coins at the end of each epoch end
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
 	if epochIdentifier != epochstypes.DayEpochID {
 		return
 	}
 
+	k.ReleaseVestedCoins(ctx)
+
 	params := k.GetParams(ctx)
 
 	// Skip inflation if it is disabled and increment number of skipped epochs
@@ -3,6 +3,8 @@ package keeper
 import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 
 	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/inflation/types"
@@ -42,7 +44,8 @@ func (k Keeper) MintCoins(ctx sdk.Context, coin sdk.Coin) error {
 // AllocateExponentialInflation allocates coins from the inflation to external
 // modules according to allocation proportions:
 //   - staking rewards -> sdk `auth` module fee collector
-//   - usage incentives -> `x/incentives` module
+//   - usage incentives -> strategic reserve, kept in the module account to
+//     fund `x/incentives` gauges, minus the shares of the reserve routes
 //   - community pool -> `sdk `distr` module community pool
 func (k Keeper) AllocateExponentialInflation(
 	ctx sdk.Context,
@@ -77,6 +80,10 @@ func (k Keeper) AllocateExponentialInflation(
 		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	// Remaining minted coins are the strategic reserve allocation, of which
+	// the reserve routes take their shares
+	k.RouteStrategicReserve(ctx, mintedCoin.Sub(staking).Sub(community), params.ReserveRoutes)
+
 	// Remaining balance is strategic reserve allocation
 	strategic = k.bankKeeper.GetBalance(ctx, moduleAddr, denoms.NIBI)
 	return staking, strategic, community, nil
@@ -96,9 +103,75 @@ func (k Keeper) GetProportions(
 }
 
 // GetCirculatingSupply returns the bank supply of the mintDenom excluding the
-// team allocation in the first year
+// supply exclusions param
 func (k Keeper) GetCirculatingSupply(ctx sdk.Context, mintDenom string) sdkmath.Int {
-	return k.bankKeeper.GetSupply(ctx, mintDenom).Amount
+	circulating, _, _ := k.GetSupplyBreakdown(ctx, mintDenom)
+	return circulating
+}
+
+// GetSupplyBreakdown returns the circulating supply of the mintDenom, its bank
+// supply and the amounts excluded from it by the supply exclusions param. The
+// circulating supply is never negative.
+func (k Keeper) GetSupplyBreakdown(
+	ctx sdk.Context, mintDenom string,
+) (circulating sdkmath.Int, total sdk.Coin, excluded []types.ExcludedSupply) {
+	exclusions := k.GetParams(ctx).SupplyExclusions
+	total = k.bankKeeper.GetSupply(ctx, mintDenom)
+	excluded = []types.ExcludedSupply{}
+
+	excludedAddrs := make(map[string]bool)
+	addExcluded := func(name string, addr sdk.AccAddress, amount sdkmath.Int) {
+		address := ""
+		if addr != nil {
+			address = addr.String()
+			excludedAddrs[address] = true
+		}
+		excluded = append(excluded, types.ExcludedSupply{
+			Name:    name,
+			Address: address,
+			Amount:  sdk.NewCoin(mintDenom, amount),
+		})
+	}
+
+	for _, name := range exclusions.ModuleAccounts {
+		addr := k.accountKeeper.GetModuleAddress(name)
+		if addr == nil {
+			continue
+		}
+		addExcluded(name, addr, k.bankKeeper.GetBalance(ctx, addr, mintDenom).Amount)
+	}
+
+	for _, named := range exclusions.Addresses {
+		addr := sdk.MustAccAddressFromBech32(named.Address)
+		addExcluded(named.Name, addr, k.bankKeeper.GetBalance(ctx, addr, mintDenom).Amount)
+	}
+
+	if exclusions.ExcludeCommunityPool {
+		communityPool := k.distrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(mintDenom).TruncateInt()
+		addExcluded(types.ExcludedCommunityPool, nil, communityPool)
+	}
+
+	if exclusions.ExcludeVestingLocked {
+		locked := sdk.ZeroInt()
+		k.accountKeeper.IterateAccounts(ctx, func(account authtypes.AccountI) (stop bool) {
+			vestingAccount, ok := account.(vestexported.VestingAccount)
+			if !ok || excludedAddrs[account.GetAddress().String()] {
+				return false
+			}
+			locked = locked.Add(vestingAccount.GetVestingCoins(ctx.BlockTime()).AmountOf(mintDenom))
+			return false
+		})
+		addExcluded(types.ExcludedVestingLocked, nil, locked)
+	}
+
+	circulating = total.Amount
+	for _, e := range excluded {
+		circulating = circulating.Sub(e.Amount.Amount)
+	}
+	if circulating.IsNegative() {
+		circulating = sdk.ZeroInt()
+	}
+	return circulating, total, excluded
 }
 
 // GetInflationRate returns the inflation rate for the current period.
@@ -107,9 +180,15 @@ func (k Keeper) GetInflationRate(ctx sdk.Context, mintDenom string) sdk.Dec {
 	if epochMintProvision.IsZero() {
 		return sdk.ZeroDec()
 	}
+	return k.inflationRate(ctx, epochMintProvision, k.GetCirculatingSupply(ctx, mintDenom))
+}
 
-	circulatingSupply := k.GetCirculatingSupply(ctx, mintDenom)
-	if circulatingSupply.IsZero() {
+// inflationRate returns the inflation rate of the epoch mint provision over a
+// period, in percent of the circulating supply.
+func (k Keeper) inflationRate(
+	ctx sdk.Context, epochMintProvision sdk.Dec, circulatingSupply sdkmath.Int,
+) sdk.Dec {
+	if epochMintProvision.IsZero() || circulatingSupply.IsZero() {
 		return sdk.ZeroDec()
 	}
 
@@ -128,3 +207,60 @@ func (k Keeper) GetEpochMintProvision(ctx sdk.Context) sdk.Dec {
 		peek,
 	)
 }
+
+// GetMintSchedule projects the mint provisions of the next numEpochs day
+// epochs and of the next numPeriods periods, starting from the current one,
+// assuming the params stay unchanged. Epochs follow the same period
+// transitions as AfterEpochEnd and mint nothing while inflation is disabled.
+func (k Keeper) GetMintSchedule(
+	ctx sdk.Context, numEpochs, numPeriods uint64,
+) (epochs []types.EpochMintSchedule, periods []types.PeriodMintSchedule) {
+	params := k.GetParams(ctx)
+	period := k.CurrentPeriod.Peek(ctx)
+	skippedEpochs := k.NumSkippedEpochs.Peek(ctx)
+
+	epochs = []types.EpochMintSchedule{}
+	if numEpochs > 0 {
+		lastEpoch := k.LastEpochNumber(ctx)
+		epochMintProvision := types.CalculateEpochMintProvision(params, period)
+		for epochNumber := lastEpoch + 1; epochNumber <= lastEpoch+numEpochs; epochNumber++ {
+			if !params.InflationEnabled {
+				skippedEpochs++
+				epochs = append(epochs, types.EpochMintSchedule{
+					EpochNumber:        epochNumber,
+					Period:             period,
+					EpochMintProvision: sdk.NewDecCoin(denoms.NIBI, sdk.ZeroInt()),
+				})
+				continue
+			}
+
+			epochs = append(epochs, types.EpochMintSchedule{
+				EpochNumber:        epochNumber,
+				Period:             period,
+				EpochMintProvision: sdk.NewDecCoinFromDec(denoms.NIBI, epochMintProvision),
+			})
+
+			if int64(epochNumber)-
+				int64(params.EpochsPerPeriod*period)-
+				int64(skippedEpochs) > int64(params.EpochsPerPeriod) {
+				period++
+				epochMintProvision = types.CalculateEpochMintProvision(params, period)
+			}
+		}
+	}
+
+	periods = []types.PeriodMintSchedule{}
+	currentPeriod := k.CurrentPeriod.Peek(ctx)
+	for p := currentPeriod; p < currentPeriod+numPeriods; p++ {
+		epochMintProvision := types.CalculateEpochMintProvision(params, p)
+		periods = append(periods, types.PeriodMintSchedule{
+			Period:             p,
+			EpochMintProvision: sdk.NewDecCoinFromDec(denoms.NIBI, epochMintProvision),
+			PeriodMintProvision: sdk.NewDecCoinFromDec(
+				denoms.NIBI, epochMintProvision.MulInt64(int64(params.EpochsPerPeriod)),
+			),
+		})
+	}
+
+	return epochs, periods
+}
@@ -8,11 +8,13 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/app"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
 	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	types "github.com/NibiruChain/nibiru/x/inflation/types"
 )
@@ -109,12 +111,13 @@ func TestGetCirculatingSupplyAndInflationRate(t *testing.T) {
 
 			tc.malleate(nibiruApp, ctx)
 
-			// Mint coins to increase supply
+			// Mint coins to increase supply, outside of the excluded module
+			// accounts
 			coin := sdk.NewCoin(
 				denoms.NIBI,
 				tc.supply,
 			)
-			err := nibiruApp.InflationKeeper.MintCoins(ctx, coin)
+			err := testapp.FundAccount(nibiruApp.BankKeeper, ctx, testutil.AccAddress(), sdk.NewCoins(coin))
 			require.NoError(t, err)
 
 			circulatingSupply := nibiruApp.InflationKeeper.GetCirculatingSupply(ctx, denoms.NIBI)
@@ -136,3 +139,68 @@ func TestGetters(t *testing.T) {
 		_ = k.EpochsPerPeriod(ctx)
 	})
 }
+
+func TestGetSupplyBreakdown(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	k := nibiruApp.InflationKeeper
+	unibi := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, amount))
+	}
+
+	holder := testutil.AccAddress()
+	foundation := testutil.AccAddress()
+	require.NoError(t, testapp.FundAccount(nibiruApp.BankKeeper, ctx, holder, unibi(10_000)))
+	require.NoError(t, testapp.FundAccount(nibiruApp.BankKeeper, ctx, foundation, unibi(2_000)))
+	require.NoError(t, testapp.FundModuleAccount(nibiruApp.BankKeeper, ctx, types.ModuleName, unibi(1_000)))
+	require.NoError(t, k.MintCoins(ctx, sdk.NewInt64Coin(denoms.NIBI, 500)))
+	require.NoError(t, nibiruApp.DistrKeeper.FundCommunityPool(ctx, unibi(300), holder))
+
+	// a vesting account with half of its coins vested
+	vestingAddr := testutil.AccAddress()
+	require.NoError(t, testapp.FundAccount(nibiruApp.BankKeeper, ctx, vestingAddr, unibi(800)))
+	baseAccount := nibiruApp.AccountKeeper.GetAccount(ctx, vestingAddr).(*authtypes.BaseAccount)
+	nibiruApp.AccountKeeper.SetAccount(ctx, vestingtypes.NewContinuousVestingAccount(
+		baseAccount, unibi(800), ctx.BlockTime().Unix()-50, ctx.BlockTime().Unix()+50,
+	))
+
+	total := nibiruApp.BankKeeper.GetSupply(ctx, denoms.NIBI).Amount
+
+	params := k.GetParams(ctx)
+	params.SupplyExclusions.Addresses = []types.NamedAddress{
+		{Name: "foundation", Address: foundation.String()},
+	}
+	require.NoError(t, k.UpdateParams(ctx, params))
+
+	// the locked coins of the vesting accounts are only excluded on demand
+	_, _, excluded := k.GetSupplyBreakdown(ctx, denoms.NIBI)
+	for _, e := range excluded {
+		require.NotEqual(t, types.ExcludedVestingLocked, e.Name)
+	}
+	params.SupplyExclusions.ExcludeVestingLocked = true
+	require.NoError(t, k.UpdateParams(ctx, params))
+
+	circulating, totalSupply, excluded := k.GetSupplyBreakdown(ctx, denoms.NIBI)
+	require.Equal(t, total, totalSupply.Amount)
+
+	amounts := make(map[string]int64)
+	for _, e := range excluded {
+		amounts[e.Name] = e.Amount.Amount.Int64()
+	}
+	require.Equal(t, map[string]int64{
+		types.ModuleName:            1_500,
+		types.VestingModuleAccount:  0,
+		"foundation":                2_000,
+		types.ExcludedCommunityPool: 300,
+		types.ExcludedVestingLocked: 400,
+	}, amounts)
+	require.Equal(t, total.SubRaw(1_500+2_000+300+400), circulating)
+	require.Equal(t, circulating, k.GetCirculatingSupply(ctx, denoms.NIBI))
+
+	// without exclusions the circulating supply is the bank supply
+	params.SupplyExclusions = types.SupplyExclusions{}
+	require.NoError(t, k.UpdateParams(ctx, params))
+	require.Equal(t, total, k.GetCirculatingSupply(ctx, denoms.NIBI))
+
+	params.SupplyExclusions.ModuleAccounts = []string{"foo"}
+	require.ErrorContains(t, k.UpdateParams(ctx, params), "module account foo does not exist")
+}
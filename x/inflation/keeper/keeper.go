@@ -17,15 +17,22 @@ type Keeper struct {
 	storeKey   storetypes.StoreKey
 	paramSpace paramstypes.Subspace
 
-	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
 	accountKeeper    types.AccountKeeper
 	bankKeeper       types.BankKeeper
 	distrKeeper      types.DistrKeeper
 	stakingKeeper    types.StakingKeeper
+	oracleKeeper     types.OracleKeeper
+	epochKeeper      types.EpochKeeper
 	feeCollectorName string
 
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority string
+
 	CurrentPeriod    collections.Sequence
 	NumSkippedEpochs collections.Sequence
+
+	VestingStore          collections.Map[uint64, types.VestingSchedule]
+	NextVestingScheduleId collections.Sequence
 }
 
 // NewKeeper creates a new mint Keeper instance
@@ -37,12 +44,18 @@ func NewKeeper(
 	bk types.BankKeeper,
 	dk types.DistrKeeper,
 	sk types.StakingKeeper,
+	ok types.OracleKeeper,
+	ek types.EpochKeeper,
 	feeCollectorName string,
+	authority string,
 ) Keeper {
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
 		panic("the inflation module account has not been set")
 	}
+	if addr := ak.GetModuleAddress(types.VestingModuleAccount); addr == nil {
+		panic("the inflation vesting module account has not been set")
+	}
 
 	// set KeyTable if it has not already been set
 	if !paramspace.HasKeyTable() {
@@ -57,9 +70,18 @@ func NewKeeper(
 		bankKeeper:       bk,
 		distrKeeper:      dk,
 		stakingKeeper:    sk,
+		oracleKeeper:     ok,
+		epochKeeper:      ek,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
 		CurrentPeriod:    collections.NewSequence(storeKey, 0),
 		NumSkippedEpochs: collections.NewSequence(storeKey, 1),
+		VestingStore: collections.NewMap(
+			storeKey, 2,
+			collections.Uint64KeyEncoder,
+			collections.ProtoValueEncoder[types.VestingSchedule](cdc),
+		),
+		NextVestingScheduleId: collections.NewSequence(storeKey, 3),
 	}
 }
 
@@ -67,3 +89,8 @@ func NewKeeper(
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
+
+// GetAuthority returns the x/inflation module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
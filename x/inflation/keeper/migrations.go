@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/inflation/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate3to4 sets the reserve routes param, without any route, as the param
+// set cannot be read while one of its keys is missing.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyReserveRoutes, []types.ReserveRoute{})
+	return nil
+}
+
+// Migrate4to5 sets the supply exclusions param to its default value.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeySupplyExclusions, types.DefaultSupplyExclusions)
+	return nil
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/NibiruChain/nibiru/x/inflation/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the inflation MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// ToggleInflation enables or disables inflation.
+func (ms msgServer) ToggleInflation(
+	goCtx context.Context, msg *types.MsgToggleInflation,
+) (*types.MsgToggleInflationResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.ToggleInflation(ctx, msg.Enable); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgToggleInflationResponse{}, nil
+}
+
+// UpdateParams updates the params of the inflation module.
+func (ms msgServer) UpdateParams(
+	goCtx context.Context, msg *types.MsgUpdateParams,
+) (*types.MsgUpdateParamsResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.Keeper.UpdateParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// ReleaseStrategicReserve sends coins of the strategic reserve to a recipient.
+func (ms msgServer) ReleaseStrategicReserve(
+	goCtx context.Context, msg *types.MsgReleaseStrategicReserve,
+) (*types.MsgReleaseStrategicReserveResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf(
+			"invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := ms.Keeper.ReleaseStrategicReserve(
+		ctx, recipient, msg.Amount, msg.VestingType, msg.StartTime, msg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReleaseStrategicReserveResponse{ScheduleId: id}, nil
+}
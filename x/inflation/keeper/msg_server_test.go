@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
+	"github.com/NibiruChain/nibiru/x/inflation/keeper"
+	"github.com/NibiruChain/nibiru/x/inflation/types"
+)
+
+func TestMsgToggleInflation(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	msgServer := keeper.NewMsgServerImpl(nibiruApp.InflationKeeper)
+	authority := nibiruApp.InflationKeeper.GetAuthority()
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	_, err := msgServer.ToggleInflation(goCtx, types.NewMsgToggleInflation(testutil.AccAddress().String(), false))
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = msgServer.ToggleInflation(goCtx, types.NewMsgToggleInflation(authority, true))
+	require.ErrorIs(t, err, types.ErrInflationToggle)
+
+	_, err = msgServer.ToggleInflation(goCtx, types.NewMsgToggleInflation(authority, false))
+	require.NoError(t, err)
+	require.False(t, nibiruApp.InflationKeeper.InflationEnabled(ctx))
+
+	// epochs that end while inflation is disabled are skipped
+	nibiruApp.InflationKeeper.AfterEpochEnd(ctx, epochstypes.DayEpochID, 1)
+	require.Equal(t, uint64(1), nibiruApp.InflationKeeper.NumSkippedEpochs.Peek(ctx))
+
+	_, err = msgServer.ToggleInflation(goCtx, types.NewMsgToggleInflation(authority, true))
+	require.NoError(t, err)
+	require.True(t, nibiruApp.InflationKeeper.InflationEnabled(ctx))
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	for _, tc := range []struct {
+		name                  string
+		epochsPerPeriod       uint64
+		expectedSkippedEpochs uint64
+		periodChanges         bool
+	}{
+		{
+			// 24 epochs were minted in period 1: 399 - 365 - 10
+			name:                  "progress in the period is kept",
+			epochsPerPeriod:       30,
+			expectedSkippedEpochs: 399 - 30 - 24,
+			periodChanges:         false,
+		},
+		{
+			name:                  "progress is capped to the new epochs per period",
+			epochsPerPeriod:       20,
+			expectedSkippedEpochs: 399 - 20 - 20,
+			periodChanges:         true,
+		},
+		{
+			name:                  "unchanged epochs per period",
+			epochsPerPeriod:       365,
+			expectedSkippedEpochs: 10,
+			periodChanges:         false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+			msgServer := keeper.NewMsgServerImpl(nibiruApp.InflationKeeper)
+			authority := nibiruApp.InflationKeeper.GetAuthority()
+			goCtx := sdk.WrapSDKContext(ctx)
+
+			require.NoError(t, nibiruApp.EpochsKeeper.AddEpochInfo(ctx, epochstypes.EpochInfo{
+				Identifier:           epochstypes.DayEpochID,
+				Duration:             24 * time.Hour,
+				CurrentEpoch:         400,
+				EpochCountingStarted: true,
+			}))
+
+			nibiruApp.InflationKeeper.CurrentPeriod.Set(ctx, 1)
+			nibiruApp.InflationKeeper.NumSkippedEpochs.Set(ctx, 10)
+
+			params := nibiruApp.InflationKeeper.GetParams(ctx)
+			params.EpochsPerPeriod = tc.epochsPerPeriod
+
+			_, err := msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(testutil.AccAddress().String(), params))
+			require.ErrorContains(t, err, "invalid authority")
+
+			_, err = msgServer.UpdateParams(goCtx, types.NewMsgUpdateParams(authority, params))
+			require.NoError(t, err)
+			require.Equal(t, params, nibiruApp.InflationKeeper.GetParams(ctx))
+			require.Equal(t, tc.expectedSkippedEpochs, nibiruApp.InflationKeeper.NumSkippedEpochs.Peek(ctx))
+
+			nibiruApp.InflationKeeper.AfterEpochEnd(ctx, epochstypes.DayEpochID, 400)
+			if tc.periodChanges {
+				require.Equal(t, uint64(2), nibiruApp.InflationKeeper.CurrentPeriod.Peek(ctx))
+			} else {
+				require.Equal(t, uint64(1), nibiruApp.InflationKeeper.CurrentPeriod.Peek(ctx))
+			}
+		})
+	}
+
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	params := nibiruApp.InflationKeeper.GetParams(ctx)
+	params.EpochsPerPeriod = 0
+	err := nibiruApp.InflationKeeper.UpdateParams(ctx, params)
+	require.ErrorContains(t, err, "epochs per period must be positive")
+}
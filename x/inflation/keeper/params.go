@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
 	"github.com/NibiruChain/nibiru/x/inflation/types"
 )
 
@@ -39,3 +42,76 @@ func (k Keeper) EpochsPerPeriod(ctx sdk.Context) (res uint64) {
 	k.paramSpace.Get(ctx, types.KeyEpochsPerPeriod, &res)
 	return
 }
+
+// ToggleInflation enables or disables inflation. Epochs that end while
+// inflation is disabled are counted in NumSkippedEpochs by the epoch hook, so
+// the current period resumes where it stopped once inflation is enabled again.
+func (k Keeper) ToggleInflation(ctx sdk.Context, enable bool) error {
+	params := k.GetParams(ctx)
+	if params.InflationEnabled == enable {
+		return types.ErrInflationToggle.Wrapf("inflation enabled: %t", enable)
+	}
+
+	params.InflationEnabled = enable
+	k.SetParams(ctx, params)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeToggleInflation,
+			sdk.NewAttribute(types.AttributeKeyInflationEnabled, fmt.Sprintf("%t", enable)),
+		),
+	)
+	return nil
+}
+
+// UpdateParams validates and sets the inflation params. When the epochs per
+// period change, NumSkippedEpochs is adjusted so that the epochs already
+// minted in the current period are kept, up to the new epochs per period.
+func (k Keeper) UpdateParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if err := k.validateRouteModules(params.ReserveRoutes); err != nil {
+		return err
+	}
+	for _, name := range params.SupplyExclusions.ModuleAccounts {
+		if k.accountKeeper.GetModuleAddress(name) == nil {
+			return types.ErrInvalidSupply.Wrapf("module account %s does not exist", name)
+		}
+	}
+
+	oldEpochsPerPeriod := k.EpochsPerPeriod(ctx)
+	if params.EpochsPerPeriod != oldEpochsPerPeriod {
+		lastEpoch := k.LastEpochNumber(ctx)
+		period := k.CurrentPeriod.Peek(ctx)
+		skippedEpochs := k.NumSkippedEpochs.Peek(ctx)
+
+		// number of epochs minted in the current period, see AfterEpochEnd
+		progress := int64(lastEpoch) - int64(oldEpochsPerPeriod*period) - int64(skippedEpochs)
+		if progress < 0 {
+			progress = 0
+		}
+		if progress > int64(params.EpochsPerPeriod) {
+			progress = int64(params.EpochsPerPeriod)
+		}
+
+		newSkippedEpochs := int64(lastEpoch) - int64(params.EpochsPerPeriod*period) - progress
+		if newSkippedEpochs < 0 {
+			newSkippedEpochs = 0
+		}
+		k.NumSkippedEpochs.Set(ctx, uint64(newSkippedEpochs))
+	}
+
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// LastEpochNumber returns the number of the last day epoch that ended, or
+// zero if none has.
+func (k Keeper) LastEpochNumber(ctx sdk.Context) uint64 {
+	epochInfo, err := k.epochKeeper.GetEpochInfo(ctx, epochstypes.DayEpochID)
+	if err != nil || !epochInfo.EpochCountingStarted || epochInfo.CurrentEpoch == 0 {
+		return 0
+	}
+	return epochInfo.CurrentEpoch - 1
+}
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/inflation/types"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// GetStrategicReserve returns the balance of the strategic reserve, kept in
+// the inflation module account, and the coins held by the vesting schedules.
+func (k Keeper) GetStrategicReserve(ctx sdk.Context) (balance, vesting sdk.Coins) {
+	balance = k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+	vesting = k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.VestingModuleAccount))
+	return balance, vesting
+}
+
+// ReleaseStrategicReserve sends coins of the strategic reserve to the
+// recipient. Immediate releases are sent at once and return a zero schedule
+// id. Otherwise, the coins move to the vesting module account and are
+// released by a new vesting schedule, whose start time defaults to the block
+// time.
+func (k Keeper) ReleaseStrategicReserve(
+	ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins, vestingType types.VestingType,
+	startTime, endTime time.Time,
+) (scheduleId uint64, err error) {
+	if vestingType == types.VestingType_IMMEDIATE {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+			return 0, err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReleaseReserve,
+				sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyScheduleID, "0"),
+			),
+		)
+		return 0, nil
+	}
+
+	if startTime.IsZero() {
+		startTime = ctx.BlockTime()
+	}
+
+	schedule := types.VestingSchedule{
+		Recipient:   recipient.String(),
+		Total:       amount,
+		Released:    sdk.NewCoins(),
+		VestingType: vestingType,
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+	if err := schedule.Validate(); err != nil {
+		return 0, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(
+		ctx, types.ModuleName, types.VestingModuleAccount, amount,
+	); err != nil {
+		return 0, err
+	}
+
+	schedule.Id = k.NextVestingScheduleId.Next(ctx)
+	k.VestingStore.Insert(ctx, schedule.Id, schedule)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReleaseReserve,
+			sdk.NewAttribute(types.AttributeKeyRecipient, schedule.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", schedule.Id)),
+		),
+	)
+	return schedule.Id, nil
+}
+
+// ReleaseVestedCoins sends the coins vested since the last release to the
+// recipients of the vesting schedules, and removes the schedules that are
+// fully released. A failed payout is logged and retried on the next call.
+func (k Keeper) ReleaseVestedCoins(ctx sdk.Context) {
+	for _, schedule := range k.VestingStore.Iterate(ctx, collections.Range[uint64]{}).Values() {
+		payout := schedule.VestedCoins(ctx.BlockTime()).Sub(schedule.Released...)
+		if payout.IsZero() {
+			continue
+		}
+
+		cacheCtx, commit := ctx.CacheContext()
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			cacheCtx, types.VestingModuleAccount, sdk.MustAccAddressFromBech32(schedule.Recipient), payout,
+		); err != nil {
+			k.Logger(ctx).Error(
+				"failed to release vested coins",
+				"schedule-id", schedule.Id,
+				"error", err,
+			)
+			continue
+		}
+		commit()
+
+		schedule.Released = schedule.Released.Add(payout...)
+		if schedule.Unreleased().IsZero() {
+			_ = k.VestingStore.Delete(ctx, schedule.Id)
+		} else {
+			k.VestingStore.Insert(ctx, schedule.Id, schedule)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeVestingPayout,
+				sdk.NewAttribute(types.AttributeKeyScheduleID, fmt.Sprintf("%d", schedule.Id)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, schedule.Recipient),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, payout.String()),
+			),
+		)
+	}
+}
+
+// RouteStrategicReserve sends the shares of the reserve routes of the minted
+// strategic reserve to their module accounts. Coins routed to the oracle
+// module fund the oracle rewards. A failed route is logged and its coins stay
+// in the strategic reserve.
+func (k Keeper) RouteStrategicReserve(ctx sdk.Context, strategic sdk.Coin, routes []types.ReserveRoute) {
+	for _, route := range routes {
+		coin := k.GetProportions(ctx, strategic, route.Share)
+		if !coin.IsPositive() {
+			continue
+		}
+		coins := sdk.NewCoins(coin)
+
+		cacheCtx, commit := ctx.CacheContext()
+		if err := k.routeCoins(cacheCtx, route, coins); err != nil {
+			k.Logger(ctx).Error(
+				"failed to route strategic reserve",
+				"module", route.ModuleName,
+				"error", err,
+			)
+			continue
+		}
+		commit()
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRouteReserve,
+				sdk.NewAttribute(types.AttributeKeyModuleName, route.ModuleName),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			),
+		)
+	}
+}
+
+func (k Keeper) routeCoins(ctx sdk.Context, route types.ReserveRoute, coins sdk.Coins) error {
+	if err := k.validateRouteModules([]types.ReserveRoute{route}); err != nil {
+		return err
+	}
+	if route.ModuleName == oracletypes.ModuleName {
+		return k.oracleKeeper.AllocateRewards(ctx, types.ModuleName, coins, route.VotePeriods)
+	}
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, route.ModuleName, coins)
+}
+
+// validateRouteModules checks the module accounts of the reserve routes
+// exist, as sending coins to a missing module account panics.
+func (k Keeper) validateRouteModules(routes []types.ReserveRoute) error {
+	for _, route := range routes {
+		if k.accountKeeper.GetModuleAddress(route.ModuleName) == nil {
+			return types.ErrInvalidRoute.Wrapf("module account %s does not exist", route.ModuleName)
+		}
+	}
+	return nil
+}
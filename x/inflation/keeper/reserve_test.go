@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
+	"github.com/NibiruChain/nibiru/x/inflation/keeper"
+	"github.com/NibiruChain/nibiru/x/inflation/types"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+	perptypes "github.com/NibiruChain/nibiru/x/perp/v2/types"
+)
+
+func TestReleaseStrategicReserve(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	msgServer := keeper.NewMsgServerImpl(nibiruApp.InflationKeeper)
+	authority := nibiruApp.InflationKeeper.GetAuthority()
+	recipient := testutil.AccAddress()
+	now := ctx.BlockTime()
+
+	unibi := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, amount))
+	}
+	release := func(ctx sdk.Context, amount int64, vestingType types.VestingType, end time.Time) (uint64, error) {
+		resp, err := msgServer.ReleaseStrategicReserve(sdk.WrapSDKContext(ctx), types.NewMsgReleaseStrategicReserve(
+			authority, recipient.String(), unibi(amount), vestingType, time.Time{}, end))
+		if err != nil {
+			return 0, err
+		}
+		return resp.ScheduleId, nil
+	}
+	requireReceived := func(ctx sdk.Context, amount int64) {
+		require.Equal(t, amount, nibiruApp.BankKeeper.GetBalance(ctx, recipient, denoms.NIBI).Amount.Int64())
+	}
+
+	balance, _ := nibiruApp.InflationKeeper.GetStrategicReserve(ctx)
+	require.NoError(t, nibiruApp.BankKeeper.MintCoins(ctx, types.ModuleName, unibi(1_000)))
+
+	_, err := msgServer.ReleaseStrategicReserve(sdk.WrapSDKContext(ctx), types.NewMsgReleaseStrategicReserve(
+		testutil.AccAddress().String(), recipient.String(), unibi(100), types.VestingType_IMMEDIATE, time.Time{}, time.Time{}))
+	require.ErrorContains(t, err, "invalid authority")
+
+	_, err = release(ctx, balance.AmountOf(denoms.NIBI).Int64()+1_001, types.VestingType_IMMEDIATE, time.Time{})
+	require.ErrorContains(t, err, "insufficient funds")
+
+	id, err := release(ctx, 100, types.VestingType_IMMEDIATE, time.Time{})
+	require.NoError(t, err)
+	require.Zero(t, id)
+	requireReceived(ctx, 100)
+
+	id, err = release(ctx, 400, types.VestingType_LINEAR, now.Add(100*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+
+	id, err = release(ctx, 200, types.VestingType_CLIFF, now.Add(50*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), id)
+
+	reserve, err := nibiruApp.InflationKeeper.StrategicReserve(sdk.WrapSDKContext(ctx), &types.QueryStrategicReserveRequest{})
+	require.NoError(t, err)
+	require.Equal(t, balance.Add(unibi(300)...), reserve.Balance)
+	require.Equal(t, unibi(600), reserve.Vesting)
+
+	schedules, err := nibiruApp.InflationKeeper.VestingSchedules(sdk.WrapSDKContext(ctx), &types.QueryVestingSchedulesRequest{})
+	require.NoError(t, err)
+	require.Len(t, schedules.Schedules, 2)
+	require.Equal(t, now, schedules.Schedules[0].StartTime)
+
+	// a quarter of the linear schedule is vested, the cliff is not reached
+	ctx = ctx.WithBlockTime(now.Add(25 * time.Second))
+	nibiruApp.InflationKeeper.ReleaseVestedCoins(ctx)
+	requireReceived(ctx, 100+100)
+
+	// the cliff schedule is fully released and removed
+	ctx = ctx.WithBlockTime(now.Add(60 * time.Second))
+	nibiruApp.InflationKeeper.ReleaseVestedCoins(ctx)
+	requireReceived(ctx, 100+240+200)
+	schedules, err = nibiruApp.InflationKeeper.VestingSchedules(sdk.WrapSDKContext(ctx), &types.QueryVestingSchedulesRequest{})
+	require.NoError(t, err)
+	require.Len(t, schedules.Schedules, 1)
+	require.Equal(t, unibi(240), schedules.Schedules[0].Released)
+
+	// the day epoch releases the rest
+	ctx = ctx.WithBlockTime(now.Add(200 * time.Second))
+	nibiruApp.InflationKeeper.AfterEpochEnd(ctx, epochstypes.DayEpochID, 1)
+	requireReceived(ctx, 100+400+200)
+	schedules, err = nibiruApp.InflationKeeper.VestingSchedules(sdk.WrapSDKContext(ctx), &types.QueryVestingSchedulesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, schedules.Schedules)
+	_, vesting := nibiruApp.InflationKeeper.GetStrategicReserve(ctx)
+	require.True(t, vesting.IsZero())
+}
+
+func TestRouteStrategicReserve(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	ak := nibiruApp.AccountKeeper
+
+	params := nibiruApp.InflationKeeper.GetParams(ctx)
+	params.ReserveRoutes = []types.ReserveRoute{
+		{ModuleName: "foo", Share: sdk.NewDecWithPrec(5, 1)},
+	}
+	require.ErrorContains(t, nibiruApp.InflationKeeper.UpdateParams(ctx, params), "module account foo does not exist")
+
+	params.ReserveRoutes = []types.ReserveRoute{
+		{ModuleName: perptypes.PerpEFModuleAccount, Share: sdk.NewDecWithPrec(5, 1)},
+		{ModuleName: oracletypes.ModuleName, Share: sdk.NewDecWithPrec(25, 2), VotePeriods: 10},
+	}
+	require.NoError(t, nibiruApp.InflationKeeper.UpdateParams(ctx, params))
+
+	balanceOf := func(module string) sdk.Int {
+		return nibiruApp.BankKeeper.GetBalance(ctx, ak.GetModuleAddress(module), denoms.NIBI).Amount
+	}
+	reserveBefore := balanceOf(types.ModuleName)
+	efBefore := balanceOf(perptypes.PerpEFModuleAccount)
+	oracleBefore := balanceOf(oracletypes.ModuleName)
+
+	// 10% of the minted coins go to the strategic reserve
+	_, _, _, err := nibiruApp.InflationKeeper.MintAndAllocateInflation(
+		ctx, sdk.NewInt64Coin(denoms.NIBI, 1_000_000), nibiruApp.InflationKeeper.GetParams(ctx))
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.NewInt(50_000), balanceOf(perptypes.PerpEFModuleAccount).Sub(efBefore))
+	require.Equal(t, sdk.NewInt(25_000), balanceOf(oracletypes.ModuleName).Sub(oracleBefore))
+	require.Equal(t, sdk.NewInt(25_000), balanceOf(types.ModuleName).Sub(reserveBefore))
+}
@@ -0,0 +1,11 @@
+package types
+
+import sdkerrors "cosmossdk.io/errors"
+
+var (
+	ErrInflationToggle = sdkerrors.Register(ModuleName, 2, "inflation is already in the requested state")
+	ErrInvalidSchedule = sdkerrors.Register(ModuleName, 3, "invalid mint schedule request")
+	ErrInvalidVesting  = sdkerrors.Register(ModuleName, 4, "invalid vesting schedule")
+	ErrInvalidRoute    = sdkerrors.Register(ModuleName, 5, "invalid reserve route")
+	ErrInvalidSupply   = sdkerrors.Register(ModuleName, 6, "invalid supply exclusions")
+)
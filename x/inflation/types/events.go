@@ -2,8 +2,16 @@ package types
 
 // Minting module event types
 const (
-	EventTypeMint = ModuleName
+	EventTypeMint            = ModuleName
+	EventTypeToggleInflation = "toggle_inflation"
+	EventTypeReleaseReserve  = "release_strategic_reserve"
+	EventTypeVestingPayout   = "vesting_payout"
+	EventTypeRouteReserve    = "route_strategic_reserve"
 
-	AttributeKeyEpochProvisions = "epoch_provisions"
-	AttributeEpochNumber        = "epoch_number"
+	AttributeKeyEpochProvisions  = "epoch_provisions"
+	AttributeEpochNumber         = "epoch_number"
+	AttributeKeyInflationEnabled = "inflation_enabled"
+	AttributeKeyRecipient        = "recipient"
+	AttributeKeyScheduleID       = "schedule_id"
+	AttributeKeyModuleName       = "module_name"
 )
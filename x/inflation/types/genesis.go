@@ -33,5 +33,17 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	seen := make(map[uint64]bool)
+	for _, schedule := range gs.VestingSchedules {
+		if seen[schedule.Id] {
+			return ErrInvalidVesting.Wrapf("duplicate vesting schedule id %d", schedule.Id)
+		}
+		seen[schedule.Id] = true
+
+		if err := schedule.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return gs.Params.Validate()
 }
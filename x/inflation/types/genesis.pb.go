@@ -32,6 +32,9 @@ type GenesisState struct {
 	// skipped_epochs is the number of epochs that have passed while inflation is
 	// disabled
 	SkippedEpochs uint64 `protobuf:"varint,3,opt,name=skipped_epochs,json=skippedEpochs,proto3" json:"skipped_epochs,omitempty"`
+	// vesting_schedules are the active vesting schedules of the strategic
+	// reserve
+	VestingSchedules []VestingSchedule `protobuf:"bytes,4,rep,name=vesting_schedules,json=vestingSchedules,proto3" json:"vesting_schedules"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -88,6 +91,13 @@ func (m *GenesisState) GetSkippedEpochs() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetVestingSchedules() []VestingSchedule {
+	if m != nil {
+		return m.VestingSchedules
+	}
+	return nil
+}
+
 // Params holds parameters for the inflation module.
 type Params struct {
 	// inflation_enabled is the parameter that enables inflation and halts
@@ -101,6 +111,11 @@ type Params struct {
 	// epochs_per_period is the number of epochs that must pass before a new
 	// period is created
 	EpochsPerPeriod uint64 `protobuf:"varint,4,opt,name=epochs_per_period,json=epochsPerPeriod,proto3" json:"epochs_per_period,omitempty"`
+	// reserve_routes automatically send shares of the minted strategic reserve
+	// to module accounts
+	ReserveRoutes []ReserveRoute `protobuf:"bytes,5,rep,name=reserve_routes,json=reserveRoutes,proto3" json:"reserve_routes"`
+	// supply_exclusions are the balances excluded from the circulating supply
+	SupplyExclusions SupplyExclusions `protobuf:"bytes,6,opt,name=supply_exclusions,json=supplyExclusions,proto3" json:"supply_exclusions"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -164,6 +179,20 @@ func (m *Params) GetEpochsPerPeriod() uint64 {
 	return 0
 }
 
+func (m *Params) GetReserveRoutes() []ReserveRoute {
+	if m != nil {
+		return m.ReserveRoutes
+	}
+	return nil
+}
+
+func (m *Params) GetSupplyExclusions() SupplyExclusions {
+	if m != nil {
+		return m.SupplyExclusions
+	}
+	return SupplyExclusions{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nibiru.inflation.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "nibiru.inflation.v1.Params")
@@ -172,31 +201,37 @@ func init() {
 func init() { proto.RegisterFile("nibiru/inflation/v1/genesis.proto", fileDescriptor_2d00e2bb98c08f74) }
 
 var fileDescriptor_2d00e2bb98c08f74 = []byte{
-	// 375 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4d, 0x4f, 0xfa, 0x30,
-	0x1c, 0xde, 0x80, 0x2c, 0xff, 0x94, 0xbf, 0x2f, 0x54, 0x45, 0x82, 0xc9, 0x44, 0x8c, 0x09, 0x81,
-	0x64, 0x0b, 0x78, 0xf2, 0x0a, 0x12, 0xc3, 0xc5, 0x10, 0xbc, 0x79, 0x59, 0xba, 0xad, 0x8e, 0xea,
-	0x68, 0x9b, 0xad, 0x23, 0xf8, 0x0d, 0x3c, 0xfa, 0x69, 0xfc, 0x0c, 0x1c, 0x39, 0x7a, 0x32, 0x06,
-	0xbe, 0x88, 0xb1, 0x9d, 0x8c, 0xc3, 0x6e, 0xed, 0xf3, 0xd2, 0xe7, 0xe9, 0x2f, 0x3f, 0x70, 0x41,
-	0x89, 0x4b, 0xa2, 0xc4, 0x26, 0xf4, 0x29, 0x44, 0x82, 0x30, 0x6a, 0xcf, 0xbb, 0x76, 0x80, 0x29,
-	0x8e, 0x49, 0x6c, 0xf1, 0x88, 0x09, 0x06, 0x8f, 0x94, 0xc4, 0xda, 0x4a, 0xac, 0x79, 0xb7, 0x7e,
-	0x1c, 0xb0, 0x80, 0x49, 0xde, 0xfe, 0x3d, 0x29, 0x69, 0xfd, 0x32, 0xef, 0xb5, 0xcc, 0x27, 0x45,
-	0xcd, 0x37, 0x1d, 0xfc, 0xbf, 0x53, 0x09, 0x0f, 0x02, 0x09, 0x0c, 0x6f, 0x80, 0xc1, 0x51, 0x84,
-	0x66, 0x71, 0x4d, 0x6f, 0xe8, 0xad, 0x72, 0xef, 0xcc, 0xca, 0x49, 0xb4, 0xc6, 0x52, 0xd2, 0x2f,
-	0x2d, 0xbf, 0xce, 0xb5, 0x49, 0x6a, 0x80, 0x55, 0x60, 0x70, 0x1c, 0x11, 0xe6, 0xd7, 0x0a, 0x0d,
-	0xbd, 0x55, 0x9a, 0xa4, 0x37, 0x78, 0x05, 0xf6, 0xe3, 0x17, 0xc2, 0x39, 0xf6, 0x1d, 0xcc, 0x99,
-	0x37, 0x8d, 0x6b, 0x45, 0xc9, 0xef, 0xa5, 0xe8, 0x50, 0x82, 0xcd, 0x8f, 0x02, 0x30, 0xd4, 0xbb,
-	0xb0, 0x03, 0x2a, 0xdb, 0x38, 0x07, 0x53, 0xe4, 0x86, 0xd8, 0x97, 0x7d, 0xfe, 0x4d, 0x0e, 0xb7,
-	0xc4, 0x50, 0xe1, 0xf0, 0x19, 0x9c, 0xe2, 0x05, 0x67, 0x14, 0x53, 0x41, 0x50, 0xe8, 0x78, 0x28,
-	0xf4, 0x12, 0xa5, 0x90, 0x3d, 0xca, 0xbd, 0x4e, 0xee, 0x17, 0x86, 0x99, 0x67, 0x90, 0x59, 0xd2,
-	0x2f, 0x55, 0x71, 0x2e, 0x0b, 0x03, 0x50, 0xcd, 0x8a, 0xf9, 0x24, 0x16, 0x11, 0x71, 0x13, 0x19,
-	0x55, 0x94, 0x51, 0xed, 0xdc, 0xa8, 0xd1, 0xdf, 0xe5, 0x76, 0xc7, 0x91, 0x26, 0x9d, 0x90, 0x3c,
-	0x12, 0xb6, 0x41, 0x45, 0xcd, 0xca, 0xe1, 0x38, 0x72, 0xd2, 0xb1, 0x96, 0xe4, 0xd8, 0x0e, 0x14,
-	0x31, 0xc6, 0xd1, 0x58, 0xc2, 0xfd, 0xd1, 0x72, 0x6d, 0xea, 0xab, 0xb5, 0xa9, 0x7f, 0xaf, 0x4d,
-	0xfd, 0x7d, 0x63, 0x6a, 0xab, 0x8d, 0xa9, 0x7d, 0x6e, 0x4c, 0xed, 0xd1, 0x0e, 0x88, 0x98, 0x26,
-	0xae, 0xe5, 0xb1, 0x99, 0x7d, 0x2f, 0x8b, 0x0d, 0xa6, 0x88, 0x50, 0x3b, 0xdd, 0x8c, 0xc5, 0xce,
-	0x6e, 0x88, 0x57, 0x8e, 0x63, 0xd7, 0x90, 0x5b, 0x71, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0xb4,
-	0xdb, 0xd9, 0x20, 0x8a, 0x02, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x5a, 0x2a, 0xe4, 0xb2, 0xb1, 0x1a, 0x28, 0xd1, 0x90, 0x42, 0x37, 0x98, 0x54,
+	0x6d, 0x52, 0xa2, 0x95, 0x13, 0x57, 0x46, 0x85, 0x76, 0x99, 0xaa, 0x54, 0x02, 0xc4, 0x25, 0xca,
+	0x9f, 0x97, 0xd4, 0x90, 0xd9, 0x96, 0xed, 0x44, 0xdd, 0xb7, 0xe0, 0x63, 0xed, 0xb8, 0x23, 0x27,
+	0x34, 0xda, 0x2f, 0x82, 0x66, 0x7b, 0x6d, 0x41, 0xbe, 0x25, 0xef, 0xf3, 0x7b, 0xfd, 0x3c, 0xd6,
+	0x23, 0xa3, 0x03, 0x4a, 0x32, 0x22, 0xea, 0x88, 0xd0, 0x6f, 0x55, 0xaa, 0x08, 0xa3, 0x51, 0x73,
+	0x1a, 0x95, 0x40, 0x41, 0x12, 0x19, 0x72, 0xc1, 0x14, 0xc3, 0x4f, 0x0d, 0x12, 0xae, 0x91, 0xb0,
+	0x39, 0xdd, 0x7f, 0x56, 0xb2, 0x92, 0x69, 0x3d, 0xba, 0xfb, 0x32, 0xe8, 0xfe, 0x6b, 0xd7, 0x69,
+	0x9b, 0x3d, 0x0d, 0x1d, 0xfe, 0xf1, 0xd0, 0xe3, 0x8f, 0xc6, 0x61, 0xa6, 0x52, 0x05, 0xf8, 0x1d,
+	0xea, 0xf2, 0x54, 0xa4, 0x97, 0xd2, 0xf7, 0x86, 0xde, 0xa8, 0x37, 0x7e, 0x19, 0x3a, 0x1c, 0xc3,
+	0xa9, 0x46, 0xde, 0x77, 0xae, 0x7f, 0xbf, 0x6a, 0xc5, 0x76, 0x01, 0x0f, 0x50, 0x97, 0x83, 0x20,
+	0xac, 0xf0, 0x1f, 0x0c, 0xbd, 0x51, 0x27, 0xb6, 0x7f, 0xf8, 0x08, 0xed, 0xca, 0x1f, 0x84, 0x73,
+	0x28, 0x12, 0xe0, 0x2c, 0x9f, 0x4b, 0xbf, 0xad, 0xf5, 0x1d, 0x3b, 0x9d, 0xe8, 0x21, 0xfe, 0x8c,
+	0xfa, 0x0d, 0x48, 0x45, 0x68, 0x99, 0xc8, 0x7c, 0x0e, 0x45, 0x5d, 0x81, 0xf4, 0x3b, 0xc3, 0xf6,
+	0xa8, 0x37, 0x7e, 0xe3, 0x0c, 0xf1, 0xc9, 0xd0, 0x33, 0x0b, 0xdb, 0x34, 0x7b, 0xcd, 0xbf, 0x63,
+	0x79, 0x78, 0xdb, 0x46, 0x5d, 0x13, 0x18, 0x9f, 0xa0, 0xfe, 0xfa, 0x88, 0x04, 0x68, 0x9a, 0x55,
+	0x50, 0xe8, 0x8b, 0x3e, 0x8a, 0xf7, 0xd6, 0xc2, 0xc4, 0xcc, 0xf1, 0x77, 0xf4, 0x02, 0x16, 0x9c,
+	0x51, 0xa0, 0x8a, 0xa4, 0x55, 0x92, 0xa7, 0x55, 0x5e, 0x1b, 0x42, 0x5f, 0xb0, 0x37, 0x3e, 0x71,
+	0xc6, 0x9a, 0x6c, 0x76, 0xce, 0x36, 0x2b, 0x36, 0xdd, 0x00, 0x9c, 0x2a, 0x2e, 0xd1, 0x60, 0x13,
+	0xac, 0x20, 0x52, 0x09, 0x92, 0xd5, 0xda, 0xaa, 0xad, 0xad, 0x8e, 0x9d, 0x56, 0xe7, 0xf7, 0x3f,
+	0x1f, 0xb6, 0x36, 0xac, 0xd3, 0x73, 0xe2, 0x12, 0xf1, 0x31, 0xea, 0x9b, 0x12, 0x12, 0x0e, 0x22,
+	0xb1, 0x7d, 0x75, 0x74, 0x1f, 0x4f, 0x8c, 0x30, 0x05, 0x31, 0x35, 0xc5, 0x5d, 0xa0, 0x5d, 0x01,
+	0x12, 0x44, 0x03, 0x89, 0x60, 0xb5, 0x02, 0xe9, 0x3f, 0xd4, 0x75, 0x1c, 0x38, 0xc3, 0xc4, 0x06,
+	0x8d, 0xef, 0x48, 0x9b, 0x61, 0x47, 0x6c, 0xcd, 0x24, 0xfe, 0x82, 0xfa, 0xb2, 0xe6, 0xbc, 0xba,
+	0x4a, 0x60, 0x91, 0x57, 0xb5, 0x24, 0x8c, 0x4a, 0xbf, 0xab, 0xef, 0x77, 0xe4, 0x3c, 0x72, 0xa6,
+	0xe9, 0xc9, 0x1a, 0xbe, 0xaf, 0x58, 0xfe, 0x3f, 0x3f, 0xbf, 0x5e, 0x06, 0xde, 0xcd, 0x32, 0xf0,
+	0x6e, 0x97, 0x81, 0xf7, 0x73, 0x15, 0xb4, 0x6e, 0x56, 0x41, 0xeb, 0xd7, 0x2a, 0x68, 0x7d, 0x8d,
+	0x4a, 0xa2, 0xe6, 0x75, 0x16, 0xe6, 0xec, 0x32, 0xba, 0xd0, 0x16, 0x67, 0xf3, 0x94, 0xd0, 0xc8,
+	0x3e, 0x8e, 0xc5, 0xd6, 0xf3, 0x50, 0x57, 0x1c, 0x64, 0xd6, 0xd5, 0x0f, 0xe3, 0xed, 0xdf, 0x01,
+	0x00, 0xa7, 0x8a, 0xb2, 0x79, 0x8d, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -219,6 +254,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VestingSchedules) > 0 {
+		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.SkippedEpochs != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.SkippedEpochs))
 		i--
@@ -262,6 +311,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.SupplyExclusions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ReserveRoutes) > 0 {
+		for iNdEx := len(m.ReserveRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.EpochsPerPeriod != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochsPerPeriod))
 		i--
@@ -325,6 +398,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.SkippedEpochs != 0 {
 		n += 1 + sovGenesis(uint64(m.SkippedEpochs))
 	}
+	if len(m.VestingSchedules) > 0 {
+		for _, e := range m.VestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -344,6 +423,14 @@ func (m *Params) Size() (n int) {
 	if m.EpochsPerPeriod != 0 {
 		n += 1 + sovGenesis(uint64(m.EpochsPerPeriod))
 	}
+	if len(m.ReserveRoutes) > 0 {
+		for _, e := range m.ReserveRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.SupplyExclusions.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -453,6 +540,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
+			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -608,6 +729,73 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveRoutes = append(m.ReserveRoutes, ReserveRoute{})
+			if err := m.ReserveRoutes[len(m.ReserveRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyExclusions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyExclusions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
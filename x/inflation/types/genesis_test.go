@@ -2,8 +2,12 @@ package types
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
 )
 
 func TestValidateGenesis(t *testing.T) {
@@ -12,6 +16,14 @@ func TestValidateGenesis(t *testing.T) {
 
 	newGen := NewGenesisState(validParams, 0, 0)
 
+	schedule := VestingSchedule{
+		Id:          1,
+		Recipient:   testutil.AccAddress().String(),
+		Total:       sdk.NewCoins(sdk.NewInt64Coin("unibi", 100)),
+		VestingType: VestingType_CLIFF,
+		EndTime:     time.Unix(1_000, 0),
+	}
+
 	testCases := []struct {
 		name     string
 		genState *GenesisState
@@ -41,6 +53,22 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid genesis with vesting schedules",
+			&GenesisState{
+				Params:           validParams,
+				VestingSchedules: []VestingSchedule{schedule},
+			},
+			true,
+		},
+		{
+			"duplicate vesting schedule",
+			&GenesisState{
+				Params:           validParams,
+				VestingSchedules: []VestingSchedule{schedule, schedule},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
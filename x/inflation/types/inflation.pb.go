@@ -6,17 +6,22 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,6 +29,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// VestingType defines how the coins of a vesting schedule are released.
+type VestingType int32
+
+const (
+	// the coins are released at once, without a vesting schedule
+	VestingType_IMMEDIATE VestingType = 0
+	// the coins are released linearly from the start time to the end time
+	VestingType_LINEAR VestingType = 1
+	// all of the coins are released at the end time
+	VestingType_CLIFF VestingType = 2
+)
+
+var VestingType_name = map[int32]string{
+	0: "IMMEDIATE",
+	1: "LINEAR",
+	2: "CLIFF",
+}
+
+var VestingType_value = map[string]int32{
+	"IMMEDIATE": 0,
+	"LINEAR":    1,
+	"CLIFF":     2,
+}
+
+func (x VestingType) String() string {
+	return proto.EnumName(VestingType_name, int32(x))
+}
+
+func (VestingType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_37da805e9a324a97, []int{0}
+}
+
 // InflationDistribution defines the distribution in which inflation is
 // allocated through minting on each epoch (staking, community, strategic). It
 // excludes the team vesting distribution.
@@ -118,9 +155,376 @@ func (m *ExponentialCalculation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExponentialCalculation proto.InternalMessageInfo
 
+// VestingSchedule releases coins of the strategic reserve to a recipient over
+// time. The coins not released yet are held by the vesting module account.
+type VestingSchedule struct {
+	// id is the numeric id of the schedule.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient is the bech32 address receiving the coins.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// total is the amount of coins vested by the schedule.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	// released is the amount of coins already sent to the recipient.
+	Released github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=released,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"released"`
+	// vesting_type is how the coins are released.
+	VestingType VestingType `protobuf:"varint,5,opt,name=vesting_type,json=vestingType,proto3,enum=nibiru.inflation.v1.VestingType" json:"vesting_type,omitempty"`
+	// start_time is when linear vesting starts.
+	StartTime time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is when all of the coins are vested.
+	EndTime time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
+func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*VestingSchedule) ProtoMessage()    {}
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37da805e9a324a97, []int{2}
+}
+func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingSchedule.Merge(m, src)
+}
+func (m *VestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingSchedule proto.InternalMessageInfo
+
+func (m *VestingSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *VestingSchedule) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *VestingSchedule) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *VestingSchedule) GetReleased() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Released
+	}
+	return nil
+}
+
+func (m *VestingSchedule) GetVestingType() VestingType {
+	if m != nil {
+		return m.VestingType
+	}
+	return VestingType_IMMEDIATE
+}
+
+func (m *VestingSchedule) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *VestingSchedule) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+// ReserveRoute automatically sends a share of the strategic reserve minted on
+// every epoch to a module account.
+type ReserveRoute struct {
+	// module_name is the name of the module account receiving the coins. If it
+	// is the oracle module, the coins fund the oracle rewards.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// share is the proportion of the minted strategic reserve routed.
+	Share github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=share,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"share"`
+	// vote_periods is the number of vote periods the oracle rewards are spread
+	// over. It is only used for the oracle module.
+	VotePeriods uint64 `protobuf:"varint,3,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty"`
+}
+
+func (m *ReserveRoute) Reset()         { *m = ReserveRoute{} }
+func (m *ReserveRoute) String() string { return proto.CompactTextString(m) }
+func (*ReserveRoute) ProtoMessage()    {}
+func (*ReserveRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37da805e9a324a97, []int{3}
+}
+func (m *ReserveRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReserveRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReserveRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveRoute.Merge(m, src)
+}
+func (m *ReserveRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReserveRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveRoute proto.InternalMessageInfo
+
+func (m *ReserveRoute) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *ReserveRoute) GetVotePeriods() uint64 {
+	if m != nil {
+		return m.VotePeriods
+	}
+	return 0
+}
+
+// SupplyExclusions lists the balances that are not part of the circulating
+// supply.
+type SupplyExclusions struct {
+	// module_accounts are the names of the module accounts whose balances are
+	// excluded, e.g. the inflation module holding the strategic reserve.
+	ModuleAccounts []string `protobuf:"bytes,1,rep,name=module_accounts,json=moduleAccounts,proto3" json:"module_accounts,omitempty"`
+	// addresses are the named accounts whose balances are excluded, e.g. the
+	// foundation addresses.
+	Addresses []NamedAddress `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses"`
+	// exclude_community_pool excludes the community pool of the distribution
+	// module.
+	ExcludeCommunityPool bool `protobuf:"varint,3,opt,name=exclude_community_pool,json=excludeCommunityPool,proto3" json:"exclude_community_pool,omitempty"`
+	// exclude_vesting_locked excludes the coins of vesting accounts that are not
+	// vested yet. Finding them iterates over all the accounts, so it is off by
+	// default.
+	ExcludeVestingLocked bool `protobuf:"varint,4,opt,name=exclude_vesting_locked,json=excludeVestingLocked,proto3" json:"exclude_vesting_locked,omitempty"`
+}
+
+func (m *SupplyExclusions) Reset()         { *m = SupplyExclusions{} }
+func (m *SupplyExclusions) String() string { return proto.CompactTextString(m) }
+func (*SupplyExclusions) ProtoMessage()    {}
+func (*SupplyExclusions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37da805e9a324a97, []int{4}
+}
+func (m *SupplyExclusions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyExclusions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyExclusions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyExclusions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyExclusions.Merge(m, src)
+}
+func (m *SupplyExclusions) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyExclusions) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyExclusions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyExclusions proto.InternalMessageInfo
+
+func (m *SupplyExclusions) GetModuleAccounts() []string {
+	if m != nil {
+		return m.ModuleAccounts
+	}
+	return nil
+}
+
+func (m *SupplyExclusions) GetAddresses() []NamedAddress {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *SupplyExclusions) GetExcludeCommunityPool() bool {
+	if m != nil {
+		return m.ExcludeCommunityPool
+	}
+	return false
+}
+
+func (m *SupplyExclusions) GetExcludeVestingLocked() bool {
+	if m != nil {
+		return m.ExcludeVestingLocked
+	}
+	return false
+}
+
+// NamedAddress is an account address with a human readable name.
+type NamedAddress struct {
+	// name describes the account, e.g. "foundation".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the bech32 address of the account.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *NamedAddress) Reset()         { *m = NamedAddress{} }
+func (m *NamedAddress) String() string { return proto.CompactTextString(m) }
+func (*NamedAddress) ProtoMessage()    {}
+func (*NamedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37da805e9a324a97, []int{5}
+}
+func (m *NamedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamedAddress.Merge(m, src)
+}
+func (m *NamedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamedAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamedAddress proto.InternalMessageInfo
+
+func (m *NamedAddress) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NamedAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// ExcludedSupply is an amount excluded from the circulating supply.
+type ExcludedSupply struct {
+	// name is the module name, the name of the address, "community_pool" or
+	// "vesting_locked".
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address is the bech32 address holding the amount, empty for the locked
+	// coins of vesting accounts.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the excluded amount.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *ExcludedSupply) Reset()         { *m = ExcludedSupply{} }
+func (m *ExcludedSupply) String() string { return proto.CompactTextString(m) }
+func (*ExcludedSupply) ProtoMessage()    {}
+func (*ExcludedSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37da805e9a324a97, []int{6}
+}
+func (m *ExcludedSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExcludedSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExcludedSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExcludedSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExcludedSupply.Merge(m, src)
+}
+func (m *ExcludedSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExcludedSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExcludedSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExcludedSupply proto.InternalMessageInfo
+
+func (m *ExcludedSupply) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExcludedSupply) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExcludedSupply) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 func init() {
+	proto.RegisterEnum("nibiru.inflation.v1.VestingType", VestingType_name, VestingType_value)
 	proto.RegisterType((*InflationDistribution)(nil), "nibiru.inflation.v1.InflationDistribution")
 	proto.RegisterType((*ExponentialCalculation)(nil), "nibiru.inflation.v1.ExponentialCalculation")
+	proto.RegisterType((*VestingSchedule)(nil), "nibiru.inflation.v1.VestingSchedule")
+	proto.RegisterType((*ReserveRoute)(nil), "nibiru.inflation.v1.ReserveRoute")
+	proto.RegisterType((*SupplyExclusions)(nil), "nibiru.inflation.v1.SupplyExclusions")
+	proto.RegisterType((*NamedAddress)(nil), "nibiru.inflation.v1.NamedAddress")
+	proto.RegisterType((*ExcludedSupply)(nil), "nibiru.inflation.v1.ExcludedSupply")
 }
 
 func init() {
@@ -128,28 +532,59 @@ func init() {
 }
 
 var fileDescriptor_37da805e9a324a97 = []byte{
-	// 329 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcd, 0x4a, 0x3b, 0x31,
-	0x14, 0xc5, 0x27, 0xfd, 0xc3, 0x1f, 0x0c, 0x58, 0x71, 0xfc, 0xa0, 0xb8, 0x48, 0xa5, 0x82, 0xb8,
-	0x71, 0x42, 0x71, 0xeb, 0xaa, 0xad, 0x8b, 0x6e, 0x44, 0x0a, 0x22, 0x08, 0x52, 0x32, 0x69, 0x9c,
-	0x86, 0xce, 0xe4, 0x0e, 0x49, 0xa6, 0xb6, 0x6f, 0xe1, 0x53, 0xf8, 0x2c, 0x5d, 0x16, 0x57, 0xe2,
-	0xa2, 0x48, 0xe7, 0x45, 0x64, 0x3e, 0x6c, 0xbb, 0x9e, 0x55, 0x6e, 0x92, 0x73, 0x7e, 0x70, 0x0f,
-	0x07, 0x5f, 0x28, 0xe9, 0x4b, 0x9d, 0x50, 0xa9, 0x5e, 0x43, 0x66, 0x25, 0x28, 0x3a, 0x6d, 0x6f,
-	0x2f, 0x5e, 0xac, 0xc1, 0x82, 0x7b, 0x54, 0x88, 0xbc, 0xed, 0xfb, 0xb4, 0x7d, 0x76, 0x1c, 0x40,
-	0x00, 0xf9, 0x3f, 0xcd, 0xa6, 0x42, 0xda, 0xfa, 0xa8, 0xe1, 0x93, 0xfe, 0x9f, 0xac, 0x27, 0x8d,
-	0xd5, 0xd2, 0x4f, 0xb2, 0xd9, 0x7d, 0xc2, 0x07, 0xc6, 0xb2, 0x89, 0x54, 0xc1, 0x50, 0x8b, 0x37,
-	0xa6, 0x47, 0xa6, 0x81, 0xce, 0xd1, 0xd5, 0x5e, 0xc7, 0x5b, 0xac, 0x9a, 0xce, 0xf7, 0xaa, 0x79,
-	0x19, 0x48, 0x3b, 0x4e, 0x7c, 0x8f, 0x43, 0x44, 0x39, 0x98, 0x08, 0x4c, 0x79, 0x5c, 0x9b, 0xd1,
-	0x84, 0xda, 0x79, 0x2c, 0x8c, 0xd7, 0x13, 0x7c, 0x50, 0x2f, 0x31, 0x83, 0x82, 0xe2, 0x3e, 0xe2,
-	0x3a, 0x87, 0x28, 0x4a, 0x94, 0xb4, 0xf3, 0x61, 0x0c, 0x10, 0x36, 0x6a, 0x95, 0xb8, 0xfb, 0x1b,
-	0xca, 0x03, 0x40, 0xe8, 0xbe, 0x60, 0xd7, 0x58, 0xcd, 0xac, 0x08, 0x24, 0x1f, 0x6a, 0x61, 0x84,
-	0x9e, 0x0a, 0xd3, 0xf8, 0x57, 0x09, 0x7d, 0xb8, 0x21, 0x0d, 0x4a, 0x50, 0xeb, 0x13, 0xe1, 0xd3,
-	0xbb, 0x59, 0x0c, 0x4a, 0x28, 0x2b, 0x59, 0xd8, 0x65, 0x21, 0x4f, 0x8a, 0xd4, 0xdc, 0x5b, 0x8c,
-	0x58, 0xc5, 0x6c, 0x10, 0xcb, 0xdc, 0xba, 0x62, 0x02, 0x48, 0x67, 0x6e, 0x5e, 0x71, 0x49, 0xc4,
-	0x3b, 0xfd, 0xc5, 0x9a, 0xa0, 0xe5, 0x9a, 0xa0, 0x9f, 0x35, 0x41, 0xef, 0x29, 0x71, 0x96, 0x29,
-	0x71, 0xbe, 0x52, 0xe2, 0x3c, 0xd3, 0x1d, 0xc8, 0x7d, 0xde, 0xa6, 0xee, 0x98, 0x49, 0x45, 0xcb,
-	0xfa, 0xcd, 0x76, 0x0a, 0x98, 0x13, 0xfd, 0xff, 0x79, 0x9f, 0x6e, 0x7e, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xe0, 0x01, 0x1c, 0xb7, 0xa1, 0x02, 0x00, 0x00,
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6a, 0x1b, 0x47,
+	0x14, 0xd6, 0x4a, 0xf2, 0x8f, 0x8e, 0x1c, 0xd9, 0x9d, 0xa6, 0x61, 0x6b, 0x8a, 0xa4, 0xa8, 0xd0,
+	0x8a, 0x42, 0x77, 0x6b, 0xa7, 0xd0, 0x9b, 0x40, 0xb1, 0x64, 0x05, 0x04, 0x8e, 0x09, 0x1b, 0xb7,
+	0x85, 0x42, 0x59, 0x46, 0xbb, 0x27, 0xeb, 0xc1, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0x62, 0xbf, 0x45,
+	0x2e, 0xfb, 0x04, 0xbd, 0xe8, 0x93, 0xe4, 0x32, 0xf4, 0xa2, 0x94, 0x5e, 0x24, 0xc5, 0xbe, 0xef,
+	0x33, 0x94, 0xd9, 0x59, 0x59, 0x6a, 0x09, 0xa5, 0x11, 0xbd, 0xda, 0x99, 0x33, 0xe7, 0xfb, 0xe6,
+	0x9b, 0x33, 0xdf, 0x99, 0x85, 0x8f, 0x39, 0x9b, 0x31, 0x59, 0xf8, 0x8c, 0x3f, 0x4b, 0xa9, 0x66,
+	0x82, 0xfb, 0xf3, 0x83, 0xe5, 0xc4, 0xcb, 0xa5, 0xd0, 0x82, 0xbc, 0x6f, 0x93, 0xbc, 0x65, 0x7c,
+	0x7e, 0xb0, 0x7f, 0x37, 0x11, 0x89, 0x28, 0xd7, 0x7d, 0x33, 0xb2, 0xa9, 0xfb, 0xdd, 0x48, 0xa8,
+	0x4c, 0x28, 0x7f, 0x46, 0x15, 0xfa, 0xf3, 0x83, 0x19, 0x6a, 0x7a, 0xe0, 0x47, 0x82, 0x55, 0x54,
+	0xfb, 0xbd, 0x44, 0x88, 0x24, 0x45, 0xbf, 0x9c, 0xcd, 0x8a, 0x67, 0xbe, 0x66, 0x19, 0x2a, 0x4d,
+	0xb3, 0xdc, 0x26, 0x0c, 0x7e, 0xaa, 0xc3, 0x07, 0xd3, 0xc5, 0x3e, 0xc7, 0x4c, 0x69, 0xc9, 0x66,
+	0x85, 0x19, 0x93, 0xef, 0x60, 0x57, 0x69, 0x7a, 0xc1, 0x78, 0x12, 0x4a, 0x7c, 0x4e, 0x65, 0xac,
+	0x5c, 0xa7, 0xef, 0x0c, 0x5b, 0x23, 0xef, 0xe5, 0xeb, 0x5e, 0xed, 0xf7, 0xd7, 0xbd, 0x4f, 0x12,
+	0xa6, 0xcf, 0x8b, 0x99, 0x17, 0x89, 0xcc, 0xaf, 0x64, 0xd8, 0xcf, 0xe7, 0x2a, 0xbe, 0xf0, 0xf5,
+	0x55, 0x8e, 0xca, 0x3b, 0xc6, 0x28, 0xe8, 0x54, 0x34, 0x81, 0x65, 0x21, 0xdf, 0x40, 0x27, 0x12,
+	0x59, 0x56, 0x70, 0xa6, 0xaf, 0xc2, 0x5c, 0x88, 0xd4, 0xad, 0xaf, 0xc5, 0x7b, 0xe7, 0x96, 0xe5,
+	0x89, 0x10, 0x29, 0xf9, 0x01, 0x88, 0xd2, 0x92, 0x6a, 0x4c, 0x58, 0x14, 0x4a, 0x54, 0x28, 0xe7,
+	0xa8, 0xdc, 0xc6, 0x5a, 0xd4, 0xef, 0xdd, 0x32, 0x05, 0x15, 0xd1, 0xe0, 0x17, 0x07, 0xee, 0x4d,
+	0x2e, 0x73, 0xc1, 0x91, 0x6b, 0x46, 0xd3, 0x31, 0x4d, 0xa3, 0xc2, 0x56, 0x8d, 0x3c, 0x04, 0x87,
+	0xae, 0x59, 0x1b, 0x87, 0x1a, 0xb4, 0x5c, 0xb3, 0x02, 0x8e, 0x34, 0xe8, 0x68, 0xcd, 0x43, 0x3a,
+	0xd1, 0xe0, 0xd7, 0x06, 0xec, 0x7e, 0x8b, 0x4a, 0x33, 0x9e, 0x3c, 0x8d, 0xce, 0x31, 0x2e, 0x52,
+	0x24, 0x1d, 0xa8, 0xb3, 0xb8, 0x3c, 0x4e, 0x33, 0xa8, 0xb3, 0x98, 0x7c, 0x04, 0x2d, 0x89, 0x11,
+	0xcb, 0x19, 0x72, 0x6d, 0x75, 0x06, 0xcb, 0x00, 0xa1, 0xb0, 0xa1, 0x85, 0xa6, 0xa9, 0xdb, 0xe8,
+	0x37, 0x86, 0xed, 0xc3, 0x0f, 0x3d, 0xbb, 0x95, 0x67, 0x0c, 0xe9, 0x55, 0x86, 0xf4, 0xc6, 0x82,
+	0xf1, 0xd1, 0x17, 0x46, 0xde, 0xcf, 0x6f, 0x7a, 0xc3, 0xff, 0x20, 0xcf, 0x00, 0x54, 0x60, 0x99,
+	0x49, 0x02, 0xdb, 0x12, 0x53, 0xa4, 0x0a, 0x63, 0xb7, 0xf9, 0xff, 0xef, 0x72, 0x4b, 0x4e, 0xc6,
+	0xb0, 0x33, 0xb7, 0xc5, 0x08, 0x4d, 0x82, 0xbb, 0xd1, 0x77, 0x86, 0x9d, 0xc3, 0xbe, 0xf7, 0x96,
+	0x76, 0xf4, 0xaa, 0xaa, 0x9d, 0x5d, 0xe5, 0x18, 0xb4, 0xe7, 0xcb, 0x09, 0x19, 0x03, 0x28, 0x4d,
+	0xa5, 0x0e, 0x4d, 0xa7, 0xb9, 0x9b, 0x7d, 0x67, 0xd8, 0x3e, 0xdc, 0xf7, 0x6c, 0x1b, 0x7a, 0x8b,
+	0x36, 0xf4, 0xce, 0x16, 0x6d, 0x38, 0xda, 0x36, 0x82, 0x5f, 0xbc, 0xe9, 0x39, 0x41, 0xab, 0xc4,
+	0x99, 0x15, 0xf2, 0x35, 0x6c, 0x23, 0x8f, 0x2d, 0xc5, 0xd6, 0x3b, 0x50, 0x6c, 0x21, 0x8f, 0x4d,
+	0x7c, 0xf0, 0xa3, 0x03, 0x3b, 0x95, 0x75, 0x03, 0x51, 0x68, 0x24, 0x3d, 0x68, 0x67, 0xc2, 0xdc,
+	0x6f, 0xc8, 0x69, 0x86, 0xd6, 0xad, 0x01, 0xd8, 0xd0, 0x29, 0xcd, 0x90, 0x1c, 0xc3, 0x86, 0x3a,
+	0xa7, 0x12, 0xd7, 0xb4, 0xa2, 0x05, 0x93, 0xfb, 0xb0, 0x33, 0x17, 0x1a, 0xc3, 0x1c, 0x25, 0x13,
+	0xb1, 0x6d, 0xbf, 0x66, 0xd0, 0x36, 0xb1, 0x27, 0x36, 0x34, 0xf8, 0xd3, 0x81, 0xbd, 0xa7, 0x45,
+	0x9e, 0xa7, 0x57, 0x93, 0xcb, 0x28, 0x2d, 0x14, 0x13, 0x5c, 0x91, 0x4f, 0x61, 0xb7, 0x92, 0x47,
+	0xa3, 0x48, 0x14, 0x5c, 0x9b, 0xc7, 0xa6, 0x31, 0x6c, 0x05, 0x1d, 0x1b, 0x3e, 0xaa, 0xa2, 0x64,
+	0x02, 0x2d, 0x1a, 0xc7, 0x12, 0x95, 0x42, 0xe5, 0xd6, 0x4b, 0x37, 0xdc, 0x7f, 0xeb, 0x05, 0x99,
+	0x43, 0xc5, 0x47, 0x36, 0x75, 0xd4, 0x34, 0xa7, 0x09, 0x96, 0x48, 0xf2, 0x25, 0xdc, 0x43, 0xb3,
+	0x7b, 0x8c, 0xe1, 0x3f, 0xde, 0x22, 0xa3, 0x78, 0x3b, 0xb8, 0x5b, 0xad, 0x8e, 0xff, 0xf6, 0xc4,
+	0xac, 0xa0, 0x16, 0x46, 0x49, 0x45, 0x74, 0x51, 0xfa, 0x72, 0x15, 0x55, 0x99, 0xe3, 0xa4, 0x5c,
+	0x1b, 0x3c, 0x84, 0x9d, 0x55, 0x31, 0x84, 0x40, 0x73, 0xe5, 0x0e, 0xca, 0x31, 0x71, 0x61, 0xab,
+	0x12, 0x57, 0xb5, 0xd8, 0x62, 0x3a, 0x78, 0x0e, 0x9d, 0x89, 0x65, 0x8d, 0x6d, 0xd5, 0xde, 0x0d,
+	0x4f, 0xbe, 0x82, 0x4d, 0x9a, 0x99, 0xda, 0x95, 0x27, 0xfb, 0xd7, 0xde, 0xb1, 0x55, 0xaa, 0xd2,
+	0x3f, 0x7b, 0x00, 0xed, 0x15, 0x93, 0x93, 0x3b, 0xd0, 0x9a, 0x3e, 0x7e, 0x3c, 0x39, 0x9e, 0x1e,
+	0x9d, 0x4d, 0xf6, 0x6a, 0x04, 0x60, 0xf3, 0x64, 0x7a, 0x3a, 0x39, 0x0a, 0xf6, 0x1c, 0xd2, 0x82,
+	0x8d, 0xf1, 0xc9, 0xf4, 0xd1, 0xa3, 0xbd, 0xfa, 0x68, 0xfa, 0xf2, 0xba, 0xeb, 0xbc, 0xba, 0xee,
+	0x3a, 0x7f, 0x5c, 0x77, 0x9d, 0x17, 0x37, 0xdd, 0xda, 0xab, 0x9b, 0x6e, 0xed, 0xb7, 0x9b, 0x6e,
+	0xed, 0x7b, 0x7f, 0xc5, 0x48, 0xa7, 0xe5, 0x7d, 0x8d, 0xcf, 0x29, 0xe3, 0x7e, 0xf5, 0x43, 0xbc,
+	0x5c, 0xf9, 0x25, 0x96, 0xae, 0x9a, 0x6d, 0x96, 0x4e, 0x7f, 0xf0, 0xd7, 0x00, 0x65, 0xd9, 0x47,
+	0x3e, 0x33, 0x07, 0x00, 0x00,
 }
 
 func (m *InflationDistribution) Marshal() (dAtA []byte, err error) {
@@ -258,54 +693,1007 @@ func (m *ExponentialCalculation) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
-	offset -= sovInflation(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *VestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *InflationDistribution) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *VestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.StakingRewards.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.CommunityPool.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.StrategicReserves.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	return n
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintInflation(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintInflation(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.VestingType != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.VestingType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Released) > 0 {
+		for iNdEx := len(m.Released) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Released[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ExponentialCalculation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReserveRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.A.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.R.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	l = m.C.Size()
-	n += 1 + l + sovInflation(uint64(l))
-	return n
-}
+	if m.VotePeriods != 0 {
+		i = encodeVarintInflation(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyExclusions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyExclusions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyExclusions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExcludeVestingLocked {
+		i--
+		if m.ExcludeVestingLocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExcludeCommunityPool {
+		i--
+		if m.ExcludeCommunityPool {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Addresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintInflation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ModuleAccounts) > 0 {
+		for iNdEx := len(m.ModuleAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ModuleAccounts[iNdEx])
+			copy(dAtA[i:], m.ModuleAccounts[iNdEx])
+			i = encodeVarintInflation(dAtA, i, uint64(len(m.ModuleAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NamedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExcludedSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExcludedSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExcludedSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintInflation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintInflation(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInflation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInflation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InflationDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakingRewards.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.StrategicReserves.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *ExponentialCalculation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.A.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.R.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	l = m.C.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *VestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovInflation(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	if len(m.Released) > 0 {
+		for _, e := range m.Released {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	if m.VestingType != 0 {
+		n += 1 + sovInflation(uint64(m.VestingType))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovInflation(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func (m *ReserveRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	if m.VotePeriods != 0 {
+		n += 1 + sovInflation(uint64(m.VotePeriods))
+	}
+	return n
+}
+
+func (m *SupplyExclusions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModuleAccounts) > 0 {
+		for _, s := range m.ModuleAccounts {
+			l = len(s)
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	if len(m.Addresses) > 0 {
+		for _, e := range m.Addresses {
+			l = e.Size()
+			n += 1 + l + sovInflation(uint64(l))
+		}
+	}
+	if m.ExcludeCommunityPool {
+		n += 2
+	}
+	if m.ExcludeVestingLocked {
+		n += 2
+	}
+	return n
+}
+
+func (m *NamedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	return n
+}
+
+func (m *ExcludedSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovInflation(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovInflation(uint64(l))
+	return n
+}
+
+func sovInflation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInflation(x uint64) (n int) {
+	return sovInflation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReserves", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StrategicReserves.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExponentialCalculation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExponentialCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.A.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.R.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Released = append(m.Released, types.Coin{})
+			if err := m.Released[len(m.Released)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingType", wireType)
+			}
+			m.VestingType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VestingType |= VestingType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovInflation(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozInflation(x uint64) (n int) {
-	return sovInflation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
+func (m *ReserveRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -328,15 +1716,15 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InflationDistribution: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReserveRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InflationDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReserveRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -364,13 +1752,11 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -398,13 +1784,82 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
+			}
+			m.VotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyExclusions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyExclusions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyExclusions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrategicReserves", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccounts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -432,10 +1887,82 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StrategicReserves.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ModuleAccounts = append(m.ModuleAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, NamedAddress{})
+			if err := m.Addresses[len(m.Addresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeCommunityPool", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeCommunityPool = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeVestingLocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeVestingLocked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInflation(dAtA[iNdEx:])
@@ -457,7 +1984,7 @@ func (m *InflationDistribution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
+func (m *NamedAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -480,15 +2007,15 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExponentialCalculation: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamedAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExponentialCalculation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -516,13 +2043,11 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.A.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -550,13 +2075,93 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.R.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInflation(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExcludedSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInflation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExcludedSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExcludedSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -584,7 +2189,38 @@ func (m *ExponentialCalculation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInflation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthInflation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthInflation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
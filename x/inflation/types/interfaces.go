@@ -4,6 +4,8 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
 )
 
 // AccountKeeper defines the contract required for account APIs.
@@ -12,6 +14,7 @@ type AccountKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) types.ModuleAccountI
 	GetAccount(sdk.Context, sdk.AccAddress) types.AccountI
 	SetAccount(sdk.Context, types.AccountI)
+	IterateAccounts(ctx sdk.Context, cb func(account types.AccountI) (stop bool))
 }
 
 // BankKeeper defines the contract needed to be fulfilled for banking and supply
@@ -30,6 +33,7 @@ type BankKeeper interface {
 // DistrKeeper defines the contract needed to be fulfilled for distribution keeper
 type DistrKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	GetFeePoolCommunityCoins(ctx sdk.Context) sdk.DecCoins
 }
 
 // StakingKeeper expected staking keeper
@@ -39,3 +43,13 @@ type StakingKeeper interface {
 	StakingTokenSupply(ctx sdk.Context) sdkmath.Int
 	TotalBondedTokens(ctx sdk.Context) sdkmath.Int
 }
+
+// EpochKeeper defines the contract needed to read the epochs inflation mints on
+type EpochKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epoch epochstypes.EpochInfo, err error)
+}
+
+// OracleKeeper defines the contract needed to fund the oracle rewards
+type OracleKeeper interface {
+	AllocateRewards(ctx sdk.Context, funderModule string, totalCoins sdk.Coins, votePeriods uint64) error
+}
@@ -10,4 +10,8 @@ const (
 
 	// RouterKey to be used for message routing
 	RouterKey = ModuleName
+
+	// VestingModuleAccount holds the coins of the strategic reserve that are
+	// not released yet by the vesting schedules
+	VestingModuleAccount = ModuleName + "_vesting"
 )
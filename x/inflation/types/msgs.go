@@ -0,0 +1,153 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constants
+const (
+	TypeMsgToggleInflation = "toggle_inflation"
+	TypeMsgUpdateParams    = "update_params"
+	TypeMsgReleaseReserve  = "release_strategic_reserve"
+)
+
+var (
+	_ sdk.Msg = &MsgToggleInflation{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgReleaseStrategicReserve{}
+)
+
+// NewMsgToggleInflation creates a MsgToggleInflation instance
+func NewMsgToggleInflation(authority string, enable bool) *MsgToggleInflation {
+	return &MsgToggleInflation{
+		Authority: authority,
+		Enable:    enable,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgToggleInflation) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgToggleInflation) Type() string { return TypeMsgToggleInflation }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgToggleInflation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgToggleInflation) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgToggleInflation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+	return nil
+}
+
+//-------------------------------------------------
+//-------------------------------------------------
+
+// NewMsgUpdateParams creates a MsgUpdateParams instance
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+	return msg.Params.Validate()
+}
+
+//-------------------------------------------------
+//-------------------------------------------------
+
+// NewMsgReleaseStrategicReserve creates a MsgReleaseStrategicReserve instance
+func NewMsgReleaseStrategicReserve(
+	authority string, recipient string, amount sdk.Coins,
+	vestingType VestingType, startTime, endTime time.Time,
+) *MsgReleaseStrategicReserve {
+	return &MsgReleaseStrategicReserve{
+		Authority:   authority,
+		Recipient:   recipient,
+		Amount:      amount,
+		VestingType: vestingType,
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgReleaseStrategicReserve) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgReleaseStrategicReserve) Type() string { return TypeMsgReleaseReserve }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgReleaseStrategicReserve) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgReleaseStrategicReserve) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgReleaseStrategicReserve) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(errors.ErrInvalidCoins, "Invalid amount (%s)", msg.Amount)
+	}
+	if msg.StartTime.IsZero() {
+		// the start time defaults to the block time, only the end time is known
+		return ValidateVesting(msg.VestingType, time.Time{}, msg.EndTime)
+	}
+	return ValidateVesting(msg.VestingType, msg.StartTime, msg.EndTime)
+}
@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/inflation/types"
+)
+
+func TestMsgs_ValidateBasic(t *testing.T) {
+	authority := testutil.AccAddress().String()
+	invalidParams := types.DefaultParams()
+	invalidParams.EpochsPerPeriod = 0
+	recipient := testutil.AccAddress().String()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("unibi", 100))
+	end := time.Unix(1_000, 0)
+
+	for _, tc := range []struct {
+		name    string
+		msg     sdk.Msg
+		wantErr bool
+	}{
+		{"toggle valid", types.NewMsgToggleInflation(authority, false), false},
+		{"toggle invalid authority", types.NewMsgToggleInflation("foo", true), true},
+		{"update params valid", types.NewMsgUpdateParams(authority, types.DefaultParams()), false},
+		{"update params invalid authority", types.NewMsgUpdateParams("foo", types.DefaultParams()), true},
+		{"update params invalid params", types.NewMsgUpdateParams(authority, invalidParams), true},
+		{"release immediate", types.NewMsgReleaseStrategicReserve(
+			authority, recipient, coins, types.VestingType_IMMEDIATE, time.Time{}, time.Time{}), false},
+		{"release linear", types.NewMsgReleaseStrategicReserve(
+			authority, recipient, coins, types.VestingType_LINEAR, time.Time{}, end), false},
+		{"release linear ends before start", types.NewMsgReleaseStrategicReserve(
+			authority, recipient, coins, types.VestingType_LINEAR, end, end.Add(-time.Hour)), true},
+		{"release cliff without end", types.NewMsgReleaseStrategicReserve(
+			authority, recipient, coins, types.VestingType_CLIFF, time.Time{}, time.Time{}), true},
+		{"release invalid recipient", types.NewMsgReleaseStrategicReserve(
+			authority, "foo", coins, types.VestingType_IMMEDIATE, time.Time{}, time.Time{}), true},
+		{"release zero amount", types.NewMsgReleaseStrategicReserve(
+			authority, recipient, sdk.NewCoins(), types.VestingType_IMMEDIATE, time.Time{}, time.Time{}), true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -13,6 +13,8 @@ var (
 	KeyExponentialCalculation = []byte("ExponentialCalculation")
 	KeyInflationDistribution  = []byte("InflationDistribution")
 	KeyEpochsPerPeriod        = []byte("EpochsPerPeriod")
+	KeyReserveRoutes          = []byte("ReserveRoutes")
+	KeySupplyExclusions       = []byte("SupplyExclusions")
 )
 
 var (
@@ -27,7 +29,12 @@ var (
 		CommunityPool:     sdk.NewDecWithPrec(62_20, 4), // 62.20%
 		StrategicReserves: sdk.NewDecWithPrec(10, 2),    // 10%
 	}
-	DefaultEpochsPerPeriod = uint64(365)
+	DefaultEpochsPerPeriod  = uint64(365)
+	DefaultSupplyExclusions = SupplyExclusions{
+		ModuleAccounts:       []string{ModuleName, VestingModuleAccount},
+		ExcludeCommunityPool: true,
+		ExcludeVestingLocked: false,
+	}
 )
 
 func NewParams(
@@ -51,6 +58,7 @@ func DefaultParams() Params {
 		InflationDistribution:  DefaultInflationDistribution,
 		InflationEnabled:       DefaultInflation,
 		EpochsPerPeriod:        DefaultEpochsPerPeriod,
+		SupplyExclusions:       DefaultSupplyExclusions,
 	}
 }
 
@@ -69,6 +77,8 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyExponentialCalculation, &p.ExponentialCalculation, validateExponentialCalculation),
 		paramstypes.NewParamSetPair(KeyInflationDistribution, &p.InflationDistribution, validateInflationDistribution),
 		paramstypes.NewParamSetPair(KeyEpochsPerPeriod, &p.EpochsPerPeriod, validateUint64),
+		paramstypes.NewParamSetPair(KeyReserveRoutes, &p.ReserveRoutes, validateReserveRoutes),
+		paramstypes.NewParamSetPair(KeySupplyExclusions, &p.SupplyExclusions, validateSupplyExclusions),
 	}
 }
 
@@ -126,6 +136,40 @@ func validateInflationDistribution(i interface{}) error {
 	return nil
 }
 
+func validateReserveRoutes(i interface{}) error {
+	v, ok := i.([]ReserveRoute)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	totalShares := sdk.ZeroDec()
+	seen := make(map[string]bool)
+	for _, route := range v {
+		if err := route.Validate(); err != nil {
+			return err
+		}
+		if seen[route.ModuleName] {
+			return ErrInvalidRoute.Wrapf("duplicate route to module %s", route.ModuleName)
+		}
+		seen[route.ModuleName] = true
+		totalShares = totalShares.Add(route.Share)
+	}
+
+	if totalShares.GT(sdk.OneDec()) {
+		return ErrInvalidRoute.Wrapf("total route shares %s exceed 1", totalShares)
+	}
+
+	return nil
+}
+
+func validateSupplyExclusions(i interface{}) error {
+	v, ok := i.(SupplyExclusions)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
+
 func validateBool(i interface{}) error {
 	_, ok := i.(bool)
 	if !ok {
@@ -166,6 +210,12 @@ func (p Params) Validate() error {
 	if err := validateInflationDistribution(p.InflationDistribution); err != nil {
 		return err
 	}
+	if err := validateReserveRoutes(p.ReserveRoutes); err != nil {
+		return err
+	}
+	if err := validateSupplyExclusions(p.SupplyExclusions); err != nil {
+		return err
+	}
 
 	return validateBool(p.InflationEnabled)
 }
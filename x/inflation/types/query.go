@@ -0,0 +1,21 @@
+package types
+
+const (
+	// MaxScheduleEpochs is the maximum number of epochs a mint schedule query
+	// projects.
+	MaxScheduleEpochs = 3650
+	// MaxSchedulePeriods is the maximum number of periods a mint schedule
+	// query projects.
+	MaxSchedulePeriods = 100
+)
+
+// ValidateBasic checks that the projection stays within the query limits.
+func (q QueryMintScheduleRequest) ValidateBasic() error {
+	if q.NumEpochs > MaxScheduleEpochs {
+		return ErrInvalidSchedule.Wrapf("num epochs %d exceeds the maximum of %d", q.NumEpochs, MaxScheduleEpochs)
+	}
+	if q.NumPeriods > MaxSchedulePeriods {
+		return ErrInvalidSchedule.Wrapf("num periods %d exceeds the maximum of %d", q.NumPeriods, MaxSchedulePeriods)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// Validate checks the route sends a valid share of the strategic reserve to
+// another module.
+func (r ReserveRoute) Validate() error {
+	if r.ModuleName == "" {
+		return ErrInvalidRoute.Wrap("empty module name")
+	}
+	if r.ModuleName == ModuleName || r.ModuleName == VestingModuleAccount {
+		return ErrInvalidRoute.Wrapf("cannot route to module %s", r.ModuleName)
+	}
+	if r.Share.IsNil() || !r.Share.IsPositive() || r.Share.GT(sdk.OneDec()) {
+		return ErrInvalidRoute.Wrapf("share must be in (0, 1]: %s", r.Share)
+	}
+	if r.ModuleName == oracletypes.ModuleName && r.VotePeriods == 0 {
+		return ErrInvalidRoute.Wrap("vote periods must be positive for oracle rewards")
+	}
+	return nil
+}
+
+// ValidateVesting checks the times of a vesting schedule of the given type.
+func ValidateVesting(vestingType VestingType, startTime, endTime time.Time) error {
+	switch vestingType {
+	case VestingType_IMMEDIATE:
+		return nil
+	case VestingType_LINEAR:
+		if !endTime.After(startTime) {
+			return ErrInvalidVesting.Wrapf("end time %s must be after start time %s", endTime, startTime)
+		}
+	case VestingType_CLIFF:
+		if endTime.IsZero() {
+			return ErrInvalidVesting.Wrap("end time must be set")
+		}
+	default:
+		return ErrInvalidVesting.Wrapf("unknown vesting type %d", vestingType)
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of the vesting schedule.
+func (v VestingSchedule) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(v.Recipient); err != nil {
+		return ErrInvalidVesting.Wrapf("invalid recipient %s: %s", v.Recipient, err)
+	}
+	if v.VestingType == VestingType_IMMEDIATE {
+		return ErrInvalidVesting.Wrap("vesting schedules cannot be immediate")
+	}
+	if err := ValidateVesting(v.VestingType, v.StartTime, v.EndTime); err != nil {
+		return err
+	}
+	if !v.Total.IsValid() || v.Total.IsZero() {
+		return ErrInvalidVesting.Wrapf("invalid total %s", v.Total)
+	}
+	if !v.Released.IsValid() || !v.Total.IsAllGTE(v.Released) {
+		return ErrInvalidVesting.Wrapf("invalid released %s for total %s", v.Released, v.Total)
+	}
+	return nil
+}
+
+// VestedCoins returns the amount of coins vested by the schedule at the given
+// time, including the ones already released.
+func (v VestingSchedule) VestedCoins(blockTime time.Time) sdk.Coins {
+	if !blockTime.Before(v.EndTime) {
+		return v.Total
+	}
+	if v.VestingType != VestingType_LINEAR || !blockTime.After(v.StartTime) {
+		return sdk.NewCoins()
+	}
+
+	elapsed := blockTime.Sub(v.StartTime).Nanoseconds()
+	duration := v.EndTime.Sub(v.StartTime).Nanoseconds()
+
+	vested := sdk.NewCoins()
+	for _, coin := range v.Total {
+		amount := coin.Amount.MulRaw(elapsed).QuoRaw(duration)
+		vested = vested.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return vested
+}
+
+// Unreleased returns the amount of coins still held for the schedule.
+func (v VestingSchedule) Unreleased() sdk.Coins {
+	return v.Total.Sub(v.Released...)
+}
@@ -0,0 +1,117 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/inflation/types"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+func TestVestingSchedule_VestedCoins(t *testing.T) {
+	start := time.Unix(1_000, 0)
+	end := start.Add(100 * time.Second)
+	total := sdk.NewCoins(sdk.NewInt64Coin("unibi", 1_000))
+
+	linear := types.VestingSchedule{
+		Total: total, VestingType: types.VestingType_LINEAR, StartTime: start, EndTime: end,
+	}
+	cliff := types.VestingSchedule{
+		Total: total, VestingType: types.VestingType_CLIFF, StartTime: start, EndTime: end,
+	}
+
+	for _, tc := range []struct {
+		name      string
+		schedule  types.VestingSchedule
+		blockTime time.Time
+		expected  sdk.Coins
+	}{
+		{"linear before start", linear, start.Add(-time.Second), sdk.NewCoins()},
+		{"linear at start", linear, start, sdk.NewCoins()},
+		{"linear midway", linear, start.Add(25 * time.Second), sdk.NewCoins(sdk.NewInt64Coin("unibi", 250))},
+		{"linear at end", linear, end, total},
+		{"linear after end", linear, end.Add(time.Hour), total},
+		{"cliff before end", cliff, end.Add(-time.Second), sdk.NewCoins()},
+		{"cliff at end", cliff, end, total},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.schedule.VestedCoins(tc.blockTime))
+		})
+	}
+}
+
+func TestVestingSchedule_Validate(t *testing.T) {
+	start := time.Unix(1_000, 0)
+	valid := types.VestingSchedule{
+		Id:          1,
+		Recipient:   testutil.AccAddress().String(),
+		Total:       sdk.NewCoins(sdk.NewInt64Coin("unibi", 1_000)),
+		Released:    sdk.NewCoins(sdk.NewInt64Coin("unibi", 100)),
+		VestingType: types.VestingType_LINEAR,
+		StartTime:   start,
+		EndTime:     start.Add(time.Hour),
+	}
+	require.NoError(t, valid.Validate())
+
+	for _, tc := range []struct {
+		name   string
+		modify func(v *types.VestingSchedule)
+	}{
+		{"invalid recipient", func(v *types.VestingSchedule) { v.Recipient = "foo" }},
+		{"immediate", func(v *types.VestingSchedule) { v.VestingType = types.VestingType_IMMEDIATE }},
+		{"end before start", func(v *types.VestingSchedule) { v.EndTime = start.Add(-time.Hour) }},
+		{"zero total", func(v *types.VestingSchedule) { v.Total = sdk.NewCoins() }},
+		{"released exceeds total", func(v *types.VestingSchedule) {
+			v.Released = sdk.NewCoins(sdk.NewInt64Coin("unibi", 1_001))
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule := valid
+			tc.modify(&schedule)
+			require.ErrorIs(t, schedule.Validate(), types.ErrInvalidVesting)
+		})
+	}
+}
+
+func TestReserveRoutesValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		routes  []types.ReserveRoute
+		wantErr bool
+	}{
+		{"no routes", nil, false},
+		{"valid", []types.ReserveRoute{
+			{ModuleName: "perp_ef", Share: sdk.NewDecWithPrec(5, 1)},
+			{ModuleName: oracletypes.ModuleName, Share: sdk.NewDecWithPrec(5, 1), VotePeriods: 10},
+		}, false},
+		{"shares exceed one", []types.ReserveRoute{
+			{ModuleName: "perp_ef", Share: sdk.NewDecWithPrec(6, 1)},
+			{ModuleName: "treasury", Share: sdk.NewDecWithPrec(5, 1)},
+		}, true},
+		{"duplicate module", []types.ReserveRoute{
+			{ModuleName: "perp_ef", Share: sdk.NewDecWithPrec(1, 1)},
+			{ModuleName: "perp_ef", Share: sdk.NewDecWithPrec(1, 1)},
+		}, true},
+		{"zero share", []types.ReserveRoute{{ModuleName: "perp_ef", Share: sdk.ZeroDec()}}, true},
+		{"empty module", []types.ReserveRoute{{Share: sdk.NewDecWithPrec(1, 1)}}, true},
+		{"inflation module", []types.ReserveRoute{{ModuleName: types.ModuleName, Share: sdk.NewDecWithPrec(1, 1)}}, true},
+		{"oracle without vote periods", []types.ReserveRoute{
+			{ModuleName: oracletypes.ModuleName, Share: sdk.NewDecWithPrec(1, 1)},
+		}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.ReserveRoutes = tc.routes
+			err := params.Validate()
+			if tc.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidRoute)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+const (
+	// ExcludedCommunityPool names the community pool in the excluded supply.
+	ExcludedCommunityPool = "community_pool"
+	// ExcludedVestingLocked names the locked coins of vesting accounts in the
+	// excluded supply.
+	ExcludedVestingLocked = "vesting_locked"
+)
+
+// Validate checks the exclusions do not count a balance twice.
+func (e SupplyExclusions) Validate() error {
+	seenModules := make(map[string]bool)
+	for _, name := range e.ModuleAccounts {
+		if name == "" {
+			return ErrInvalidSupply.Wrap("empty module account name")
+		}
+		if seenModules[name] {
+			return ErrInvalidSupply.Wrapf("duplicate module account %s", name)
+		}
+		seenModules[name] = true
+	}
+
+	// the community pool is held by the distribution module account
+	if e.ExcludeCommunityPool && seenModules[distrtypes.ModuleName] {
+		return ErrInvalidSupply.Wrapf(
+			"the %s module account already holds the community pool", distrtypes.ModuleName)
+	}
+
+	seenAddresses := make(map[string]bool)
+	for _, named := range e.Addresses {
+		if named.Name == "" {
+			return ErrInvalidSupply.Wrapf("empty name for address %s", named.Address)
+		}
+		if _, err := sdk.AccAddressFromBech32(named.Address); err != nil {
+			return ErrInvalidSupply.Wrapf("invalid address %s: %s", named.Address, err)
+		}
+		if seenAddresses[named.Address] {
+			return ErrInvalidSupply.Wrapf("duplicate address %s", named.Address)
+		}
+		seenAddresses[named.Address] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/inflation/types"
+)
+
+func TestSupplyExclusionsValidate(t *testing.T) {
+	addr := testutil.AccAddress().String()
+
+	for _, tc := range []struct {
+		name       string
+		exclusions types.SupplyExclusions
+		wantErr    bool
+	}{
+		{"default", types.DefaultSupplyExclusions, false},
+		{"empty", types.SupplyExclusions{}, false},
+		{"named address", types.SupplyExclusions{
+			Addresses: []types.NamedAddress{{Name: "foundation", Address: addr}},
+		}, false},
+		{"empty module name", types.SupplyExclusions{ModuleAccounts: []string{""}}, true},
+		{"duplicate module", types.SupplyExclusions{ModuleAccounts: []string{"perp_ef", "perp_ef"}}, true},
+		{"community pool counted twice", types.SupplyExclusions{
+			ModuleAccounts: []string{distrtypes.ModuleName}, ExcludeCommunityPool: true,
+		}, true},
+		{"invalid address", types.SupplyExclusions{
+			Addresses: []types.NamedAddress{{Name: "foundation", Address: "foo"}},
+		}, true},
+		{"empty name", types.SupplyExclusions{
+			Addresses: []types.NamedAddress{{Address: addr}},
+		}, true},
+		{"duplicate address", types.SupplyExclusions{
+			Addresses: []types.NamedAddress{{Name: "a", Address: addr}, {Name: "b", Address: addr}},
+		}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.exclusions.Validate()
+			if tc.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidSupply)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
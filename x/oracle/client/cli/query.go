@@ -26,6 +26,7 @@ func GetQueryCmd() *cobra.Command {
 
 	oracleQueryCmd.AddCommand(
 		GetCmdQueryExchangeRates(),
+		GetCmdQueryExchangeRatesEma(),
 		GetCmdQueryActives(),
 		GetCmdQueryParams(),
 		GetCmdQueryFeederDelegation(),
@@ -33,6 +34,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryAggregatePrevote(),
 		GetCmdQueryAggregateVote(),
 		GetCmdQueryVoteTargets(),
+		GetCmdQueryPerformance(),
+		GetCmdQueryFeederRewards(),
 	)
 
 	return oracleQueryCmd
@@ -91,6 +94,58 @@ $ nibid query oracle exchange-rates nibi:usd
 	return cmd
 }
 
+// GetCmdQueryExchangeRatesEma implements the query exchange-rates-ema command.
+func GetCmdQueryExchangeRatesEma() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rates-ema [pair]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the exponential moving average exchange rate w.r.t a pair",
+		Long: strings.TrimSpace(`
+Query the exponential moving average exchange rate of a pair.
+
+$ nibid query oracle exchange-rates-ema
+
+Or, can filter with pair
+
+$ nibid query oracle exchange-rates-ema nibi:usd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.ExchangeRatesEma(context.Background(), &types.QueryExchangeRatesRequest{})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			assetPair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExchangeRateEma(
+				context.Background(),
+				&types.QueryExchangeRateRequest{Pair: assetPair},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryActives implements the query actives command.
 func GetCmdQueryActives() *cobra.Command {
 	cmd := &cobra.Command{
@@ -368,3 +423,107 @@ func GetCmdQueryVoteTargets() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryPerformance implements the query validator performance command.
+func GetCmdQueryPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "performance [validator]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the rolling oracle performance of validators",
+		Long: strings.TrimSpace(`
+Query the oracle performance leaderboard of all validators, ordered from best
+to worst score.
+
+$ nibid query oracle performance
+
+Or, query the rolling statistics and recorded history of a single validator
+
+$ nibid query oracle performance nibivaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				pageReq, err := client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+
+				res, err := queryClient.PerformanceLeaderboard(
+					context.Background(),
+					&types.QueryPerformanceLeaderboardRequest{Pagination: pageReq},
+				)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			valString := args[0]
+			validator, err := sdk.ValAddressFromBech32(valString)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorPerformance(
+				context.Background(),
+				&types.QueryValidatorPerformanceRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "performance")
+	return cmd
+}
+
+// GetCmdQueryFeederRewards implements the query active reward programs command.
+func GetCmdQueryFeederRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards [pair]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the active oracle reward programs",
+		Long: strings.TrimSpace(`
+Query the active oracle reward programs along with their remaining vote periods.
+The coins of a reward program are the amount distributed in every vote period.
+
+$ nibid query oracle rewards
+
+Or, can filter with pair
+
+$ nibid query oracle rewards ubtc:unusd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFeederRewardsRequest{}
+			if len(args) == 1 {
+				req.Pair = args[0]
+			}
+
+			res, err := queryClient.FeederRewards(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
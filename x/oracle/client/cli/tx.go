@@ -2,10 +2,12 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
 
+	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -30,6 +32,8 @@ func GetTxCmd() *cobra.Command {
 		GetCmdDelegateFeederPermission(),
 		GetCmdAggregateExchangeRatePrevote(),
 		GetCmdAggregateExchangeRateVote(),
+		GetCmdAnnounceMaintenance(),
+		GetCmdCreateFeederReward(),
 	)
 
 	return oracleTxCmd
@@ -205,3 +209,103 @@ $ nibid tx oracle aggregate-vote 1234 (40000.0,BTC:USD)|(1.243,NIBI:USD) nibival
 
 	return cmd
 }
+
+// GetCmdAnnounceMaintenance will create a maintenance announcement tx and sign it with the given key.
+func GetCmdAnnounceMaintenance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "announce-maintenance [start-height] [end-height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Announce a maintenance window during which missed oracle votes are not counted",
+		Long: strings.TrimSpace(`
+Announce a maintenance window for your validator. Oracle votes missed between
+the start and end heights (inclusive) do not count towards the miss counter.
+
+The window must start in the future, must not be longer than the max_maintenance_blocks
+param and must start at least one slash window after the previous window ended.
+
+$ nibid tx oracle announce-maintenance 1000 1600 --from validator
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given start height {%s} is not a valid height: %w", args[0], err)
+			}
+
+			endHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given end height {%s} is not a valid height: %w", args[1], err)
+			}
+
+			operator := sdk.ValAddress(clientCtx.GetFromAddress())
+
+			msg := types.NewMsgAnnounceMaintenance(operator, startHeight, endHeight)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdCreateFeederReward will create a feeder reward tx and sign it with the given key.
+func GetCmdCreateFeederReward() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-feeder-reward [coins] [vote-periods] [pair]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Fund rewards for the oracle voters over a number of vote periods",
+		Long: strings.TrimSpace(`
+Fund rewards for the oracle voters. The coins are distributed evenly over the
+given number of vote periods among the validators that voted faithfully.
+
+$ nibid tx oracle create-feeder-reward 1000000unibi 100
+
+If a pair is given, only the validators that voted faithfully for the pair are rewarded:
+$ nibid tx oracle create-feeder-reward 1000000unibi 100 ubtc:unusd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			votePeriods, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("given vote periods {%s} is not a valid number: %w", args[1], err)
+			}
+
+			var pair asset.Pair
+			if len(args) == 3 {
+				pair, err = asset.TryNewPair(args[2])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgCreateFeederReward(clientCtx.GetFromAddress(), coins, votePeriods, pair)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -33,6 +33,14 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetPrice(ctx, ex.Pair, ex.ExchangeRate)
 	}
 
+	// the EMA prices are restored as exported rather than seeded by SetPrice
+	for _, pair := range keeper.EmaPrices.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
+		_ = keeper.EmaPrices.Delete(ctx, pair)
+	}
+	for _, ema := range data.EmaPrices {
+		keeper.EmaPrices.Insert(ctx, ema.Pair, types.DatedPrice{ExchangeRate: ema.ExchangeRate, CreatedBlock: uint64(ctx.BlockHeight())})
+	}
+
 	for _, missCounter := range data.MissCounters {
 		operator, err := sdk.ValAddressFromBech32(missCounter.ValidatorAddress)
 		if err != nil {
@@ -71,6 +79,7 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 	}
 
 	for _, pr := range data.Rewards {
+		pr.EndVotePeriod = keeper.VotePeriodCount.Peek(ctx) + pr.VotePeriods
 		keeper.Rewards.Insert(ctx, pr.Id, pr)
 	}
 
@@ -78,6 +87,44 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 	if len(data.Rewards) != 0 {
 		keeper.RewardsID.Set(ctx, data.Rewards[len(data.Rewards)-1].Id)
 	}
+
+	for _, record := range data.PerformanceRecords {
+		valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.PerformanceHistory.Insert(ctx, collections.Join(valAddr, record.BlockHeight), record)
+		summary := keeper.PerformanceSummaries.GetOr(ctx, valAddr, types.NewValidatorPerformanceSummary(valAddr))
+		keeper.PerformanceSummaries.Insert(ctx, valAddr, summary.Add(record))
+	}
+
+	for _, penaltyState := range data.PenaltyStates {
+		valAddr, err := sdk.ValAddressFromBech32(penaltyState.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.PenaltyStates.Insert(ctx, valAddr, penaltyState)
+	}
+
+	for _, window := range data.MaintenanceWindows {
+		valAddr, err := sdk.ValAddressFromBech32(window.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.MaintenanceWindows.Insert(ctx, valAddr, window)
+	}
+
+	for _, bondedSince := range data.BondedSince {
+		valAddr, err := sdk.ValAddressFromBech32(bondedSince.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.BondedSince.Insert(ctx, valAddr, bondedSince.Height)
+	}
 	keeper.Params.Set(ctx, data.Params)
 
 	// check if the module account exists
@@ -109,6 +156,11 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		exchangeRates = append(exchangeRates, types.ExchangeRateTuple{Pair: er.Key, ExchangeRate: er.Value.ExchangeRate})
 	}
 
+	emaPrices := []types.ExchangeRateTuple{}
+	for _, er := range keeper.EmaPrices.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
+		emaPrices = append(emaPrices, types.ExchangeRateTuple{Pair: er.Key, ExchangeRate: er.Value.ExchangeRate})
+	}
+
 	missCounters := []types.MissCounter{}
 	for _, mc := range keeper.MissCounters.Iterate(ctx, collections.Range[sdk.ValAddress]{}).KeyValues() {
 		missCounters = append(missCounters, types.MissCounter{
@@ -117,9 +169,24 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		})
 	}
 
+	bondedSince := []types.ValidatorBondedSince{}
+	for _, kv := range keeper.BondedSince.Iterate(ctx, collections.Range[sdk.ValAddress]{}).KeyValues() {
+		bondedSince = append(bondedSince, types.ValidatorBondedSince{
+			ValidatorAddress: kv.Key.String(),
+			Height:           kv.Value,
+		})
+	}
+
 	var pairs []asset.Pair
 	pairs = append(pairs, keeper.WhitelistedPairs.Iterate(ctx, collections.Range[asset.Pair]{}).Keys()...)
 
+	var rewards []types.Rewards
+	for _, pr := range keeper.Rewards.Iterate(ctx, collections.Range[uint64]{}).Values() {
+		pr.VotePeriods = keeper.RemainingVotePeriods(ctx, pr)
+		pr.EndVotePeriod = 0
+		rewards = append(rewards, pr)
+	}
+
 	return types.NewGenesisState(
 		params,
 		exchangeRates,
@@ -128,6 +195,11 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		keeper.Prevotes.Iterate(ctx, collections.Range[sdk.ValAddress]{}).Values(),
 		keeper.Votes.Iterate(ctx, collections.Range[sdk.ValAddress]{}).Values(),
 		pairs,
-		keeper.Rewards.Iterate(ctx, collections.Range[uint64]{}).Values(),
+		rewards,
+		keeper.PerformanceHistory.Iterate(ctx, collections.PairRange[sdk.ValAddress, uint64]{}).Values(),
+		keeper.PenaltyStates.Iterate(ctx, collections.Range[sdk.ValAddress]{}).Values(),
+		keeper.MaintenanceWindows.Iterate(ctx, collections.Range[sdk.ValAddress]{}).Values(),
+		bondedSince,
+		emaPrices,
 	)
 }
@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/collections"
+
 	"github.com/NibiruChain/nibiru/x/oracle"
 	"github.com/NibiruChain/nibiru/x/oracle/keeper"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
@@ -17,6 +19,7 @@ func TestExportInitGenesis(t *testing.T) {
 	input.OracleKeeper.Params.Set(input.Ctx, types.DefaultParams())
 	input.OracleKeeper.FeederDelegations.Insert(input.Ctx, keeper.ValAddrs[0], keeper.Addrs[1])
 	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, "pair1:pair2", types.DatedPrice{ExchangeRate: sdk.NewDec(123), CreatedBlock: 0})
+	input.OracleKeeper.EmaPrices.Insert(input.Ctx, "pair1:pair2", types.DatedPrice{ExchangeRate: sdk.NewDec(120), CreatedBlock: 0})
 	input.OracleKeeper.Prevotes.Insert(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{123}, keeper.ValAddrs[0], uint64(2)))
 	input.OracleKeeper.Votes.Insert(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{{Pair: "foo", ExchangeRate: sdk.NewDec(123)}}, keeper.ValAddrs[0]))
 	input.OracleKeeper.WhitelistedPairs.Insert(input.Ctx, "pair1:pair1")
@@ -27,7 +30,17 @@ func TestExportInitGenesis(t *testing.T) {
 		VotePeriods: 100,
 		Coins:       sdk.NewCoins(sdk.NewInt64Coin("test", 1000)),
 	})
+	input.OracleKeeper.PerformanceHistory.Insert(input.Ctx, collections.Join(keeper.ValAddrs[0], uint64(30)), types.ValidatorPerformanceRecord{
+		ValidatorAddress: keeper.ValAddrs[0].String(),
+		BlockHeight:      30,
+		PairsExpected:    2,
+		VotesCast:        1,
+		Misses:           1,
+		DeviationSum:     sdk.NewDecWithPrec(1, 2),
+	})
 	genesis := oracle.ExportGenesis(input.Ctx, input.OracleKeeper)
+	require.Len(t, genesis.PerformanceRecords, 1)
+	require.Len(t, genesis.EmaPrices, 1)
 
 	newInput := keeper.CreateTestFixture(t)
 	oracle.InitGenesis(newInput.Ctx, newInput.OracleKeeper, genesis)
@@ -38,14 +38,20 @@ func (k Keeper) groupBallotsByPair(
 					power = 0
 				}
 
-				pairBallotsMap[exchangeRateTuple.Pair] = append(pairBallotsMap[exchangeRateTuple.Pair],
-					types.NewExchangeRateBallot(
-						exchangeRateTuple.ExchangeRate,
-						exchangeRateTuple.Pair,
-						voterAddr,
-						power,
-					),
+				ballot := types.NewExchangeRateBallot(
+					exchangeRateTuple.ExchangeRate,
+					exchangeRateTuple.Pair,
+					voterAddr,
+					power,
 				)
+				if exchangeRateTuple.Volume != nil {
+					ballot.Volume = *exchangeRateTuple.Volume
+				}
+				if exchangeRateTuple.Twap != nil {
+					ballot.Twap = *exchangeRateTuple.Twap
+				}
+
+				pairBallotsMap[exchangeRateTuple.Pair] = append(pairBallotsMap[exchangeRateTuple.Pair], ballot)
 			}
 		}
 	}
@@ -137,14 +143,19 @@ func (k Keeper) removeInvalidBallots(
 	return pairBallotsMap, whitelistedPairs
 }
 
-// Tally calculates the median and returns it. Sets the set of voters to be rewarded, i.e. voted within
-// a reasonable spread from the weighted median to the store
+// Tally aggregates the ballots with the given method and returns the result. Sets the set of voters to be
+// rewarded, i.e. voted within a reasonable spread from the aggregated exchange rate to the store
 //
 // ALERT: This function mutates validatorPerformances slice based on the votes made by the validators.
-func Tally(ballots types.ExchangeRateBallots, rewardBand sdk.Dec, validatorPerformances types.ValidatorPerformances) sdk.Dec {
-	weightedMedian := ballots.WeightedMedianWithAssertion()
-	standardDeviation := ballots.StandardDeviation(weightedMedian)
-	rewardSpread := weightedMedian.Mul(rewardBand.QuoInt64(2))
+func Tally(
+	ballots types.ExchangeRateBallots,
+	rewardBand sdk.Dec,
+	validatorPerformances types.ValidatorPerformances,
+	method types.AggregationMethod,
+) sdk.Dec {
+	exchangeRate := ballots.Aggregate(method)
+	standardDeviation := ballots.StandardDeviation(exchangeRate)
+	rewardSpread := exchangeRate.Mul(rewardBand.QuoInt64(2))
 
 	if standardDeviation.GT(rewardSpread) {
 		rewardSpread = standardDeviation
@@ -152,8 +163,8 @@ func Tally(ballots types.ExchangeRateBallots, rewardBand sdk.Dec, validatorPerfo
 
 	for _, ballot := range ballots {
 		// Filter ballot winners & abstain voters
-		voteInsideSpread := ballot.ExchangeRate.GTE(weightedMedian.Sub(rewardSpread)) &&
-			ballot.ExchangeRate.LTE(weightedMedian.Add(rewardSpread))
+		voteInsideSpread := ballot.ExchangeRate.GTE(exchangeRate.Sub(rewardSpread)) &&
+			ballot.ExchangeRate.LTE(exchangeRate.Add(rewardSpread))
 		isAbstainVote := !ballot.ExchangeRate.IsPositive()
 
 		if voteInsideSpread || isAbstainVote {
@@ -166,5 +177,5 @@ func Tally(ballots types.ExchangeRateBallots, rewardBand sdk.Dec, validatorPerfo
 		}
 	}
 
-	return weightedMedian
+	return exchangeRate
 }
@@ -194,9 +194,11 @@ func TestFuzzTally(t *testing.T) {
 	var rewardBand sdk.Dec
 	f.Fuzz(&rewardBand)
 
-	require.NotPanics(t, func() {
-		Tally(ballot, rewardBand, claimMap)
-	})
+	for method := range types.AggregationMethod_name {
+		require.NotPanics(t, func() {
+			Tally(ballot, rewardBand, claimMap, types.AggregationMethod(method))
+		})
+	}
 }
 
 type VoteMap = map[asset.Pair]types.ExchangeRateBallots
@@ -15,6 +15,7 @@ import (
 	"github.com/NibiruChain/collections"
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/ewma"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
@@ -40,8 +41,25 @@ type Keeper struct {
 	// PriceSnapshots maps types.PriceSnapshot to the asset.Pair of the snapshot and the creation timestamp as keys.Uint64Key.
 	PriceSnapshots   collections.Map[collections.Pair[asset.Pair, time.Time], types.PriceSnapshot]
 	WhitelistedPairs collections.KeySet[asset.Pair]
-	Rewards          collections.Map[uint64, types.Rewards]
+	Rewards          collections.IndexedMap[uint64, types.Rewards, RewardsIndexes]
 	RewardsID        collections.Sequence
+	// VotePeriodCount counts the vote periods in which rewards were
+	// distributed, which rewards end at.
+	VotePeriodCount collections.Sequence
+
+	// PerformanceHistory maps the validator address and the block height at
+	// which a vote period was tallied to the validator's performance record.
+	PerformanceHistory   collections.Map[collections.Pair[sdk.ValAddress, uint64], types.ValidatorPerformanceRecord]
+	PerformanceSummaries collections.Map[sdk.ValAddress, types.ValidatorPerformanceSummary]
+
+	PenaltyStates      collections.Map[sdk.ValAddress, types.ValidatorPenaltyState]
+	MaintenanceWindows collections.Map[sdk.ValAddress, types.MaintenanceWindow]
+	// BondedSince maps a validator to the height at which the oracle first saw
+	// it in the bonded set.
+	BondedSince collections.Map[sdk.ValAddress, uint64]
+	// EmaPrices maps a pair to its exponential moving average price, updated
+	// on every SetPrice.
+	EmaPrices collections.Map[asset.Pair, types.DatedPrice]
 }
 
 // NewKeeper constructs a new keeper for oracle
@@ -71,10 +89,24 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey,
 		Prevotes:          collections.NewMap(storeKey, 4, collections.ValAddressKeyEncoder, collections.ProtoValueEncoder[types.AggregateExchangeRatePrevote](cdc)),
 		Votes:             collections.NewMap(storeKey, 5, collections.ValAddressKeyEncoder, collections.ProtoValueEncoder[types.AggregateExchangeRateVote](cdc)),
 		WhitelistedPairs:  collections.NewKeySet(storeKey, 6, asset.PairKeyEncoder),
-		Rewards: collections.NewMap(
-			storeKey, 7,
-			collections.Uint64KeyEncoder, collections.ProtoValueEncoder[types.Rewards](cdc)),
-		RewardsID: collections.NewSequence(storeKey, 9),
+		Rewards:           NewRewardsStore(storeKey, cdc),
+		RewardsID:         collections.NewSequence(storeKey, 9),
+		VotePeriodCount:   collections.NewSequence(storeKey, 20),
+		PerformanceHistory: collections.NewMap(
+			storeKey, 12,
+			collections.PairKeyEncoder(collections.ValAddressKeyEncoder, collections.Uint64KeyEncoder),
+			collections.ProtoValueEncoder[types.ValidatorPerformanceRecord](cdc)),
+		PerformanceSummaries: collections.NewMap(
+			storeKey, 13,
+			collections.ValAddressKeyEncoder, collections.ProtoValueEncoder[types.ValidatorPerformanceSummary](cdc)),
+		PenaltyStates: collections.NewMap(
+			storeKey, 14,
+			collections.ValAddressKeyEncoder, collections.ProtoValueEncoder[types.ValidatorPenaltyState](cdc)),
+		MaintenanceWindows: collections.NewMap(
+			storeKey, 15,
+			collections.ValAddressKeyEncoder, collections.ProtoValueEncoder[types.MaintenanceWindow](cdc)),
+		BondedSince: collections.NewMap(storeKey, 16, collections.ValAddressKeyEncoder, collections.Uint64ValueEncoder),
+		EmaPrices:   collections.NewMap(storeKey, 17, asset.PairKeyEncoder, collections.ProtoValueEncoder[types.DatedPrice](cdc)),
 	}
 }
 
@@ -170,9 +202,21 @@ func (k Keeper) GetExchangeRate(ctx sdk.Context, pair asset.Pair) (price sdk.Dec
 	return
 }
 
-// SetPrice sets the price for a pair as well as the price snapshot.
+// GetExchangeRateEma returns the exponential moving average price of a pair.
+// Returns -1 if there's no EMA price.
+func (k Keeper) GetExchangeRateEma(ctx sdk.Context, pair asset.Pair) (price sdk.Dec, err error) {
+	ema, err := k.EmaPrices.Get(ctx, pair)
+	if err != nil {
+		return sdk.OneDec().Neg(), types.ErrNoValidEMA.Wrapf("no EMA price for pair %s", pair.String())
+	}
+	return ema.ExchangeRate, nil
+}
+
+// SetPrice sets the price for a pair as well as the price snapshot and the
+// exponential moving average price.
 func (k Keeper) SetPrice(ctx sdk.Context, pair asset.Pair, price sdk.Dec) {
 	k.ExchangeRates.Insert(ctx, pair, types.DatedPrice{ExchangeRate: price, CreatedBlock: uint64(ctx.BlockHeight())})
+	k.updateEmaPrice(ctx, pair, price)
 
 	key := collections.Join(pair, ctx.BlockTime())
 	timestampMs := ctx.BlockTime().UnixMilli()
@@ -189,3 +233,27 @@ func (k Keeper) SetPrice(ctx sdk.Context, pair asset.Pair, price sdk.Dec) {
 		ctx.Logger().Error("failed to emit OraclePriceUpdate", "pair", pair, "error", err)
 	}
 }
+
+// updateEmaPrice folds the price into the exponential moving average of the
+// pair. The first price of a pair seeds its EMA. The EMA of a pair whose span
+// is zero is removed.
+func (k Keeper) updateEmaPrice(ctx sdk.Context, pair asset.Pair, price sdk.Dec) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return
+	}
+
+	span := params.EmaSpanForPair(pair)
+	if span == 0 {
+		_ = k.EmaPrices.Delete(ctx, pair)
+		return
+	}
+
+	ema := ewma.NewMovingAverage(sdk.NewDec(int64(span)))
+	if prev, err := k.EmaPrices.Get(ctx, pair); err == nil {
+		ema.Set(prev.ExchangeRate)
+	}
+	ema.Add(price)
+
+	k.EmaPrices.Insert(ctx, pair, types.DatedPrice{ExchangeRate: ema.Value(), CreatedBlock: uint64(ctx.BlockHeight())})
+}
@@ -9,6 +9,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
 func TestValidateFeeder(t *testing.T) {
@@ -53,3 +57,43 @@ func TestValidateFeeder(t *testing.T) {
 	input.StakingKeeper.SetValidator(input.Ctx, validator)
 	require.Error(t, input.OracleKeeper.ValidateFeeder(input.Ctx, sdk.AccAddress(addr1), addr))
 }
+
+func TestEmaPrice(t *testing.T) {
+	input := CreateTestFixture(t)
+	btc := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	eth := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.EmaSpan = 3
+	params.PairEmaSpans = []types.PairEmaSpan{{Pair: eth, Span: 0}}
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	// the first price seeds the EMA, then every price is weighted by 2/(span+1)
+	for _, tc := range []struct {
+		price    int64
+		expected string
+	}{
+		{price: 100, expected: "100"},
+		{price: 200, expected: "150"},
+		{price: 50, expected: "100"},
+	} {
+		input.OracleKeeper.SetPrice(input.Ctx, btc, sdk.NewDec(tc.price))
+		ema, err := input.OracleKeeper.GetExchangeRateEma(input.Ctx, btc)
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr(tc.expected), ema)
+	}
+
+	// disabled for the pair
+	input.OracleKeeper.SetPrice(input.Ctx, eth, sdk.NewDec(100))
+	ema, err := input.OracleKeeper.GetExchangeRateEma(input.Ctx, eth)
+	require.ErrorIs(t, err, types.ErrNoValidEMA)
+	require.Equal(t, sdk.OneDec().Neg(), ema)
+
+	// disabling the EMA removes the stale price
+	params.EmaSpan = 0
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+	input.OracleKeeper.SetPrice(input.Ctx, btc, sdk.NewDec(100))
+	_, err = input.OracleKeeper.GetExchangeRateEma(input.Ctx, btc)
+	require.ErrorIs(t, err, types.ErrNoValidEMA)
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// AnnounceMaintenance registers a maintenance window for the validator during
+// which its missed votes are not counted. Windows must be announced ahead of
+// time, last at most MaxMaintenanceBlocks and start at least one slash window
+// after the previous window of the validator ended.
+func (k Keeper) AnnounceMaintenance(ctx sdk.Context, valAddr sdk.ValAddress, startHeight, endHeight uint64) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.MaxMaintenanceBlocks == 0 {
+		return types.ErrInvalidMaintenance.Wrap("maintenance announcements are disabled")
+	}
+
+	if endHeight <= startHeight {
+		return types.ErrInvalidMaintenance.Wrapf("end height %d must be after start height %d", endHeight, startHeight)
+	}
+
+	if startHeight <= uint64(ctx.BlockHeight()) {
+		return types.ErrInvalidMaintenance.Wrapf(
+			"start height %d must be after the current height %d", startHeight, ctx.BlockHeight())
+	}
+
+	if endHeight-startHeight > params.MaxMaintenanceBlocks {
+		return types.ErrInvalidMaintenance.Wrapf(
+			"window of %d blocks exceeds the maximum of %d", endHeight-startHeight, params.MaxMaintenanceBlocks)
+	}
+
+	if previous, err := k.MaintenanceWindows.Get(ctx, valAddr); err == nil &&
+		startHeight < previous.EndHeight+params.SlashWindow {
+		return types.ErrInvalidMaintenance.Wrapf(
+			"window must start at or after height %d", previous.EndHeight+params.SlashWindow)
+	}
+
+	k.MaintenanceWindows.Insert(ctx, valAddr, types.MaintenanceWindow{
+		ValidatorAddress: valAddr.String(),
+		StartHeight:      startHeight,
+		EndHeight:        endHeight,
+	})
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventMaintenanceAnnounced{
+		Validator:   valAddr.String(),
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	})
+}
+
+// IsUnderMaintenance returns true if the current block height is within the
+// announced maintenance window of the validator.
+func (k Keeper) IsUnderMaintenance(ctx sdk.Context, valAddr sdk.ValAddress) bool {
+	window, err := k.MaintenanceWindows.Get(ctx, valAddr)
+	if err != nil {
+		return false
+	}
+
+	height := uint64(ctx.BlockHeight())
+	return window.StartHeight <= height && height <= window.EndHeight
+}
+
+// pruneMaintenanceWindows removes the maintenance windows that ended more than
+// one slash window ago, since they can no longer restrict a new announcement.
+func (k Keeper) pruneMaintenanceWindows(ctx sdk.Context) {
+	params, _ := k.Params.Get(ctx)
+	for _, kv := range k.MaintenanceWindows.Iterate(ctx, collections.Range[sdk.ValAddress]{}).KeyValues() {
+		if kv.Value.EndHeight+params.SlashWindow > uint64(ctx.BlockHeight()) {
+			continue
+		}
+		if err := k.MaintenanceWindows.Delete(ctx, kv.Key); err != nil {
+			k.Logger(ctx).Error("failed to delete maintenance window", "validator", kv.Key.String(), "error", err)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+func TestAnnounceMaintenance(t *testing.T) {
+	input, msgServer := Setup(t)
+	input.Ctx = input.Ctx.WithBlockHeight(10)
+	goCtx := sdk.WrapSDKContext(input.Ctx)
+
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.MaxMaintenanceBlocks = 50
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	for _, tc := range []struct {
+		name        string
+		startHeight uint64
+		endHeight   uint64
+	}{
+		{name: "end before start", startHeight: 20, endHeight: 15},
+		{name: "starts in the past", startHeight: 10, endHeight: 20},
+		{name: "window too long", startHeight: 20, endHeight: 71},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.AnnounceMaintenance(goCtx, types.NewMsgAnnounceMaintenance(ValAddrs[0], tc.startHeight, tc.endHeight))
+			require.ErrorIs(t, err, types.ErrInvalidMaintenance)
+		})
+	}
+
+	// unknown validator
+	_, err = msgServer.AnnounceMaintenance(goCtx, types.NewMsgAnnounceMaintenance(sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), 20, 30))
+	require.Error(t, err)
+
+	_, err = msgServer.AnnounceMaintenance(goCtx, types.NewMsgAnnounceMaintenance(ValAddrs[0], 20, 70))
+	require.NoError(t, err)
+	window, err := input.OracleKeeper.MaintenanceWindows.Get(input.Ctx, ValAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, types.MaintenanceWindow{ValidatorAddress: ValAddrs[0].String(), StartHeight: 20, EndHeight: 70}, window)
+
+	// the next window must start at least one slash window after the previous one ended
+	_, err = msgServer.AnnounceMaintenance(goCtx, types.NewMsgAnnounceMaintenance(ValAddrs[0], 169, 170))
+	require.ErrorIs(t, err, types.ErrInvalidMaintenance)
+	_, err = msgServer.AnnounceMaintenance(goCtx, types.NewMsgAnnounceMaintenance(ValAddrs[0], 170, 171))
+	require.NoError(t, err)
+
+	// disabled
+	params.MaxMaintenanceBlocks = 0
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+	_, err = msgServer.AnnounceMaintenance(goCtx, types.NewMsgAnnounceMaintenance(ValAddrs[1], 20, 30))
+	require.ErrorIs(t, err, types.ErrInvalidMaintenance)
+}
+
+func TestMaintenanceExcusesMissedVotes(t *testing.T) {
+	input, msgServer := Setup(t)
+	pair := asset.Registry.Pair(denoms.NIBI, denoms.NUSD)
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.Whitelist = []asset.Pair{pair}
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+	input.OracleKeeper.WhitelistedPairs.Insert(input.Ctx, pair)
+
+	_, err = msgServer.AnnounceMaintenance(sdk.WrapSDKContext(input.Ctx), types.NewMsgAnnounceMaintenance(ValAddrs[0], 2, 3))
+	require.NoError(t, err)
+
+	vote := func() {
+		for valIdx := 1; valIdx < 5; valIdx++ {
+			MakeAggregatePrevoteAndVote(t, input, msgServer, 0, types.ExchangeRateTuples{
+				{Pair: pair, ExchangeRate: randomExchangeRate},
+			}, valIdx)
+		}
+		input.OracleKeeper.UpdateExchangeRates(input.Ctx)
+	}
+
+	// ValAddrs[0] misses its votes during the maintenance window
+	for height := int64(2); height <= 3; height++ {
+		input.Ctx = input.Ctx.WithBlockHeight(height)
+		vote()
+	}
+	require.Equal(t, uint64(0), input.OracleKeeper.MissCounters.GetOr(input.Ctx, ValAddrs[0], 0))
+	require.True(t, input.OracleKeeper.IsUnderMaintenance(input.Ctx, ValAddrs[0]))
+
+	// after the window ends misses are counted again
+	input.Ctx = input.Ctx.WithBlockHeight(4)
+	require.False(t, input.OracleKeeper.IsUnderMaintenance(input.Ctx, ValAddrs[0]))
+	vote()
+	require.Equal(t, uint64(1), input.OracleKeeper.MissCounters.GetOr(input.Ctx, ValAddrs[0], 0))
+}
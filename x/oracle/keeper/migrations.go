@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the default values of the params added for the
+// performance history, the graduated penalties, the vote aggregation methods,
+// the EMA prices and the feeder reward programs, which read back as zero
+// values from the stored params. It also sets the end of the stored rewards,
+// which indexes them.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	defaults := types.DefaultParams()
+	params.PerformanceHistoryLength = defaults.PerformanceHistoryLength
+	params.PenaltyLadder = defaults.PenaltyLadder
+	params.RewardReductionFraction = defaults.RewardReductionFraction
+	params.GracePeriodBlocks = defaults.GracePeriodBlocks
+	params.MaxMaintenanceBlocks = defaults.MaxMaintenanceBlocks
+	params.AggregationMethods = defaults.AggregationMethods
+	params.EmaSpan = defaults.EmaSpan
+	params.PairEmaSpans = defaults.PairEmaSpans
+	params.FeederRewardCreationFee = defaults.FeederRewardCreationFee
+	params.MaxFeederRewardsPerPair = defaults.MaxFeederRewardsPerPair
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	m.keeper.Params.Set(ctx, params)
+
+	// the stored rewards hold the vote periods left
+	for _, rewards := range m.keeper.Rewards.Iterate(ctx, collections.Range[uint64]{}).Values() {
+		rewards.EndVotePeriod = m.keeper.VotePeriodCount.Peek(ctx) + rewards.VotePeriods
+		m.keeper.Rewards.Insert(ctx, rewards.Id, rewards)
+	}
+	return nil
+}
@@ -167,3 +167,44 @@ func (ms msgServer) DelegateFeedConsent(
 
 	return &types.MsgDelegateFeedConsentResponse{}, err
 }
+
+func (ms msgServer) AnnounceMaintenance(
+	goCtx context.Context, msg *types.MsgAnnounceMaintenance,
+) (*types.MsgAnnounceMaintenanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the operator is a validator
+	val := ms.StakingKeeper.Validator(ctx, operatorAddr)
+	if val == nil {
+		return nil, sdkerrors.Wrap(stakingtypes.ErrNoValidatorFound, msg.Operator)
+	}
+
+	if err := ms.Keeper.AnnounceMaintenance(ctx, operatorAddr, msg.StartHeight, msg.EndHeight); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAnnounceMaintenanceResponse{}, nil
+}
+
+func (ms msgServer) CreateFeederReward(
+	goCtx context.Context, msg *types.MsgCreateFeederReward,
+) (*types.MsgCreateFeederRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := ms.Keeper.CreateFeederReward(ctx, sender, msg.Coins, msg.VotePeriods, msg.Pair)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateFeederRewardResponse{Id: id}, nil
+}
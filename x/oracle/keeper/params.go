@@ -71,3 +71,10 @@ func (k Keeper) MinValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
 	params, _ := k.Params.Get(ctx)
 	return params.MinValidPerWindow
 }
+
+// PerformanceHistoryLength returns the number of vote periods of validator
+// performance records kept in state.
+func (k Keeper) PerformanceHistoryLength(ctx sdk.Context) (res uint64) {
+	params, _ := k.Params.Get(ctx)
+	return params.PerformanceHistoryLength
+}
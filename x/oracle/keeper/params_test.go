@@ -45,6 +45,8 @@ func TestParams(t *testing.T) {
 		SlashWindow:       slashWindow,
 		MinValidPerWindow: minValidPerWindow,
 		ValidatorFeeRatio: minFeeRatio,
+
+		RewardReductionFraction: sdk.NewDecWithPrec(5, 1),
 	}
 	input.OracleKeeper.Params.Set(input.Ctx, newParams)
 
@@ -53,3 +55,37 @@ func TestParams(t *testing.T) {
 	require.NotNil(t, storedParams)
 	require.Equal(t, storedParams, newParams)
 }
+
+func TestMigrate1to2(t *testing.T) {
+	input := CreateTestFixture(t)
+
+	// params stored before the new fields were added
+	params := types.DefaultParams()
+	params.PerformanceHistoryLength = 0
+	params.PenaltyLadder = nil
+	params.RewardReductionFraction = sdk.Dec{}
+	params.GracePeriodBlocks = 0
+	params.MaxMaintenanceBlocks = 0
+	params.EmaSpan = 0
+	params.FeederRewardCreationFee = nil
+	params.MaxFeederRewardsPerPair = 0
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+	// rewards stored with the vote periods left
+	input.OracleKeeper.Rewards.Insert(input.Ctx, 1, types.Rewards{
+		Id:          1,
+		VotePeriods: 5,
+		Coins:       sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 100)),
+	})
+
+	require.NoError(t, NewMigrator(input.OracleKeeper).Migrate1to2(input.Ctx))
+
+	migrated, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), migrated)
+
+	rewards, err := input.OracleKeeper.Rewards.Get(input.Ctx, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, input.OracleKeeper.RemainingVotePeriods(input.Ctx, rewards))
+	require.Equal(t, []uint64{1}, input.OracleKeeper.Rewards.Indexes.End.ExactMatch(
+		input.Ctx, rewards.EndVotePeriod).PrimaryKeys())
+}
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/omap"
+	"github.com/NibiruChain/nibiru/x/common/set"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// recordValidatorPerformances stores a performance record for every bonded
+// validator of the vote period that was just tallied and prunes the records
+// that fell out of the history window.
+//
+// CONTRACT: must be called after the exchange rates of the vote period have
+// been set, since deviations are measured against them.
+func (k Keeper) recordValidatorPerformances(
+	ctx sdk.Context,
+	pairBallotsMap map[asset.Pair]types.ExchangeRateBallots,
+	whitelistedPairs set.Set[asset.Pair],
+	validatorPerformances types.ValidatorPerformances,
+) {
+	historyLength := k.PerformanceHistoryLength(ctx)
+	if historyLength == 0 {
+		return
+	}
+
+	pairsExpected := uint64(len(whitelistedPairs))
+	records := make(map[string]types.ValidatorPerformanceRecord, len(validatorPerformances))
+	for valAddr, validatorPerformance := range validatorPerformances {
+		var misses uint64
+		if winCount := uint64(validatorPerformance.WinCount); winCount < pairsExpected {
+			misses = pairsExpected - winCount
+		}
+
+		records[valAddr] = types.ValidatorPerformanceRecord{
+			ValidatorAddress: valAddr,
+			BlockHeight:      uint64(ctx.BlockHeight()),
+			PairsExpected:    pairsExpected,
+			Misses:           misses,
+			DeviationSum:     sdk.ZeroDec(),
+		}
+	}
+
+	orderedBallotsMap := omap.OrderedMap_Pair[types.ExchangeRateBallots](pairBallotsMap)
+	for pair := range orderedBallotsMap.Range() {
+		median, err := k.GetExchangeRate(ctx, pair)
+		if err != nil {
+			continue
+		}
+
+		for _, ballot := range pairBallotsMap[pair] {
+			record, exists := records[ballot.Voter.String()]
+			if !exists {
+				continue
+			}
+
+			if !ballot.ExchangeRate.IsPositive() {
+				record.Abstains++
+			} else {
+				record.VotesCast++
+				if median.IsPositive() {
+					record.DeviationSum = record.DeviationSum.Add(
+						ballot.ExchangeRate.Sub(median).Abs().Quo(median))
+				}
+			}
+			records[ballot.Voter.String()] = record
+		}
+	}
+
+	orderedRecords := omap.OrderedMap_String[types.ValidatorPerformanceRecord](records)
+	for valAddrStr := range orderedRecords.Range() {
+		valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+		if err != nil {
+			k.Logger(ctx).Error("invalid validator address", "validator", valAddrStr, "error", err)
+			continue
+		}
+		k.insertPerformanceRecord(ctx, valAddr, records[valAddrStr], historyLength)
+	}
+
+	k.pruneStalePerformances(ctx, records, historyLength*k.VotePeriod(ctx))
+}
+
+// insertPerformanceRecord adds the record to the history and the rolling
+// summary of the validator, evicting the oldest records when the history
+// holds more than historyLength entries. The summary counts the records of
+// the history, so only the evicted records are read.
+func (k Keeper) insertPerformanceRecord(
+	ctx sdk.Context, valAddr sdk.ValAddress, record types.ValidatorPerformanceRecord, historyLength uint64,
+) {
+	summary := k.PerformanceSummaries.GetOr(ctx, valAddr, types.NewValidatorPerformanceSummary(valAddr))
+
+	k.PerformanceHistory.Insert(ctx, collections.Join(valAddr, record.BlockHeight), record)
+	summary = summary.Add(record)
+
+	summary = k.evictPerformanceRecords(ctx, valAddr, summary, func(
+		summary types.ValidatorPerformanceSummary, _ types.ValidatorPerformanceRecord,
+	) bool {
+		return summary.VotePeriods > historyLength
+	})
+
+	k.PerformanceSummaries.Insert(ctx, valAddr, summary)
+}
+
+// pruneStalePerformances evicts the records older than windowBlocks of the
+// validators without a record in the current vote period, e.g. validators
+// that left the bonded set, and deletes their summaries once their history
+// is empty. This bounds the leaderboard to the validators active within the
+// history window.
+func (k Keeper) pruneStalePerformances(
+	ctx sdk.Context, records map[string]types.ValidatorPerformanceRecord, windowBlocks uint64,
+) {
+	blockHeight := uint64(ctx.BlockHeight())
+	for _, summary := range k.PerformanceSummaries.Iterate(ctx, collections.Range[sdk.ValAddress]{}).Values() {
+		if _, active := records[summary.ValidatorAddress]; active {
+			continue
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(summary.ValidatorAddress)
+		if err != nil {
+			k.Logger(ctx).Error("invalid validator address", "validator", summary.ValidatorAddress, "error", err)
+			continue
+		}
+
+		votePeriods := summary.VotePeriods
+		summary = k.evictPerformanceRecords(ctx, valAddr, summary, func(
+			_ types.ValidatorPerformanceSummary, oldest types.ValidatorPerformanceRecord,
+		) bool {
+			return oldest.BlockHeight+windowBlocks <= blockHeight
+		})
+
+		switch {
+		case summary.VotePeriods == 0:
+			_ = k.PerformanceSummaries.Delete(ctx, valAddr)
+		case summary.VotePeriods != votePeriods:
+			k.PerformanceSummaries.Insert(ctx, valAddr, summary)
+		}
+	}
+}
+
+// evictPerformanceRecords deletes the oldest records of the validator for as
+// long as shouldEvict returns true, and returns the summary without them.
+func (k Keeper) evictPerformanceRecords(
+	ctx sdk.Context,
+	valAddr sdk.ValAddress,
+	summary types.ValidatorPerformanceSummary,
+	shouldEvict func(summary types.ValidatorPerformanceSummary, oldest types.ValidatorPerformanceRecord) bool,
+) types.ValidatorPerformanceSummary {
+	for summary.VotePeriods > 0 {
+		iter := k.PerformanceHistory.Iterate(
+			ctx, collections.PairRange[sdk.ValAddress, uint64]{}.Prefix(valAddr),
+		)
+		if !iter.Valid() {
+			iter.Close()
+			break
+		}
+		oldest := iter.KeyValue()
+		iter.Close()
+
+		if !shouldEvict(summary, oldest.Value) {
+			break
+		}
+		if err := k.PerformanceHistory.Delete(ctx, oldest.Key); err != nil {
+			k.Logger(ctx).Error("failed to delete performance record", "validator", valAddr.String(), "error", err)
+			break
+		}
+		summary = summary.Sub(oldest.Value)
+	}
+	return summary
+}
+
+// GetPerformanceHistory returns the recorded performance history of the
+// validator, oldest first.
+func (k Keeper) GetPerformanceHistory(ctx sdk.Context, valAddr sdk.ValAddress) []types.ValidatorPerformanceRecord {
+	return k.PerformanceHistory.Iterate(
+		ctx, collections.PairRange[sdk.ValAddress, uint64]{}.Prefix(valAddr),
+	).Values()
+}
+
+// GetPerformanceLeaderboard returns the rolling performance summaries of the
+// validators with recorded history, ordered from best to worst. Summaries of
+// validators inactive for longer than the history window are pruned, so the
+// leaderboard is bounded by the validators active within that window.
+func (k Keeper) GetPerformanceLeaderboard(ctx sdk.Context) []types.ValidatorPerformanceSummary {
+	summaries := k.PerformanceSummaries.Iterate(ctx, collections.Range[sdk.ValAddress]{}).Values()
+	sort.SliceStable(summaries, func(i, j int) bool {
+		return summaries[i].IsBetterThan(summaries[j])
+	})
+	return summaries
+}
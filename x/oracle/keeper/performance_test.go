@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+func TestRecordValidatorPerformances(t *testing.T) {
+	input, h := Setup(t)
+	pair := asset.Registry.Pair(denoms.NIBI, denoms.NUSD)
+
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.Whitelist = []asset.Pair{pair}
+	params.PerformanceHistoryLength = 2
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+	input.OracleKeeper.WhitelistedPairs.Insert(input.Ctx, pair)
+
+	outlierRate := randomExchangeRate.Add(randomExchangeRate.QuoInt64(10))
+	for height := int64(1); height <= 3; height++ {
+		input.Ctx = input.Ctx.WithBlockHeight(height)
+
+		MakeAggregatePrevoteAndVote(t, input, h, 0, types.ExchangeRateTuples{{Pair: pair, ExchangeRate: randomExchangeRate}}, 0)
+		// outside of the reward band
+		MakeAggregatePrevoteAndVote(t, input, h, 0, types.ExchangeRateTuples{{Pair: pair, ExchangeRate: outlierRate}}, 1)
+		// abstain
+		MakeAggregatePrevoteAndVote(t, input, h, 0, types.ExchangeRateTuples{{Pair: pair, ExchangeRate: sdk.ZeroDec()}}, 2)
+		MakeAggregatePrevoteAndVote(t, input, h, 0, types.ExchangeRateTuples{{Pair: pair, ExchangeRate: randomExchangeRate}}, 3)
+		MakeAggregatePrevoteAndVote(t, input, h, 0, types.ExchangeRateTuples{{Pair: pair, ExchangeRate: randomExchangeRate}}, 4)
+
+		input.OracleKeeper.UpdateExchangeRates(input.Ctx)
+	}
+
+	// history is bounded by the history length
+	history := input.OracleKeeper.GetPerformanceHistory(input.Ctx, ValAddrs[1])
+	require.Len(t, history, 2)
+	require.EqualValues(t, 2, history[0].BlockHeight)
+	require.EqualValues(t, 3, history[1].BlockHeight)
+	require.Equal(t, types.ValidatorPerformanceRecord{
+		ValidatorAddress: ValAddrs[1].String(),
+		BlockHeight:      3,
+		PairsExpected:    1,
+		VotesCast:        1,
+		Abstains:         0,
+		Misses:           1,
+		DeviationSum:     sdk.NewDecWithPrec(1, 1),
+	}, history[1])
+
+	summary, err := input.OracleKeeper.PerformanceSummaries.Get(input.Ctx, ValAddrs[1])
+	require.NoError(t, err)
+	require.EqualValues(t, 2, summary.VotePeriods)
+	require.EqualValues(t, 2, summary.VotesCast)
+	require.EqualValues(t, 2, summary.Misses)
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), summary.DeviationSum)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), summary.AverageDeviation)
+	require.Equal(t, sdk.ZeroDec(), summary.Score)
+
+	summary, err = input.OracleKeeper.PerformanceSummaries.Get(input.Ctx, ValAddrs[2])
+	require.NoError(t, err)
+	require.EqualValues(t, 2, summary.Abstains)
+	require.EqualValues(t, 0, summary.VotesCast)
+	require.EqualValues(t, 0, summary.Misses)
+	require.Equal(t, sdk.OneDec(), summary.Score)
+
+	// the outlier ranks last in the leaderboard
+	leaderboard := input.OracleKeeper.GetPerformanceLeaderboard(input.Ctx)
+	require.Len(t, leaderboard, 5)
+	require.Equal(t, ValAddrs[1].String(), leaderboard[4].ValidatorAddress)
+	for _, s := range leaderboard[:4] {
+		require.Equal(t, sdk.OneDec(), s.Score)
+	}
+
+	querier := NewQuerier(input.OracleKeeper)
+	res, err := querier.ValidatorPerformance(sdk.WrapSDKContext(input.Ctx), &types.QueryValidatorPerformanceRequest{
+		ValidatorAddr: ValAddrs[1].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, history, res.History)
+	require.Equal(t, leaderboard[4], res.Summary)
+
+	pageRes, err := querier.PerformanceLeaderboard(sdk.WrapSDKContext(input.Ctx), &types.QueryPerformanceLeaderboardRequest{
+		Pagination: &query.PageRequest{Offset: 3, Limit: 5, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, leaderboard[3:], pageRes.Summaries)
+	require.EqualValues(t, 5, pageRes.Pagination.Total)
+}
+
+func TestRecordValidatorPerformancesDisabled(t *testing.T) {
+	input, h := Setup(t)
+	pair := asset.Registry.Pair(denoms.NIBI, denoms.NUSD)
+
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.Whitelist = []asset.Pair{pair}
+	params.PerformanceHistoryLength = 0
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+	input.OracleKeeper.WhitelistedPairs.Insert(input.Ctx, pair)
+
+	for i := 0; i < 4; i++ {
+		MakeAggregatePrevoteAndVote(t, input, h, 0, types.ExchangeRateTuples{{Pair: pair, ExchangeRate: randomExchangeRate}}, i)
+	}
+	input.OracleKeeper.UpdateExchangeRates(input.Ctx)
+
+	require.Empty(t, input.OracleKeeper.GetPerformanceHistory(input.Ctx, ValAddrs[0]))
+	require.Empty(t, input.OracleKeeper.GetPerformanceLeaderboard(input.Ctx))
+}
+
+func TestPruneStalePerformances(t *testing.T) {
+	input, h := Setup(t)
+	pair := asset.Registry.Pair(denoms.NIBI, denoms.NUSD)
+
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.Whitelist = []asset.Pair{pair}
+	params.PerformanceHistoryLength = 2
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+	input.OracleKeeper.WhitelistedPairs.Insert(input.Ctx, pair)
+	window := params.PerformanceHistoryLength * params.VotePeriod
+
+	// a validator that left the bonded set after two vote periods
+	staleVal := sdk.ValAddress([]byte("stale_validator_____"))
+	for _, height := range []uint64{1, 1 + params.VotePeriod} {
+		input.OracleKeeper.insertPerformanceRecord(input.Ctx, staleVal, types.ValidatorPerformanceRecord{
+			ValidatorAddress: staleVal.String(),
+			BlockHeight:      height,
+			PairsExpected:    1,
+			DeviationSum:     sdk.ZeroDec(),
+		}, params.PerformanceHistoryLength)
+	}
+
+	tally := func(height uint64) {
+		input.Ctx = input.Ctx.WithBlockHeight(int64(height))
+		for i := 0; i < 4; i++ {
+			MakeAggregatePrevoteAndVote(t, input, h, 0, types.ExchangeRateTuples{{Pair: pair, ExchangeRate: randomExchangeRate}}, i)
+		}
+		input.OracleKeeper.UpdateExchangeRates(input.Ctx)
+	}
+
+	// the oldest record falls out of the window
+	tally(1 + window)
+	summary, err := input.OracleKeeper.PerformanceSummaries.Get(input.Ctx, staleVal)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, summary.VotePeriods)
+	require.Len(t, input.OracleKeeper.GetPerformanceHistory(input.Ctx, staleVal), 1)
+
+	// the summary is deleted with the last record
+	tally(1 + params.VotePeriod + window)
+	_, err = input.OracleKeeper.PerformanceSummaries.Get(input.Ctx, staleVal)
+	require.Error(t, err)
+	require.Empty(t, input.OracleKeeper.GetPerformanceHistory(input.Ctx, staleVal))
+	require.Len(t, input.OracleKeeper.GetPerformanceLeaderboard(input.Ctx), 5)
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/NibiruChain/collections"
 
+	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
@@ -79,6 +81,26 @@ func (q querier) ExchangeRateTwap(c context.Context, req *types.QueryExchangeRat
 	return &types.QueryExchangeRateResponse{ExchangeRate: twap}, nil
 }
 
+// ExchangeRateEma queries the exponential moving average exchange rate of a
+// pair.
+func (q querier) ExchangeRateEma(c context.Context, req *types.QueryExchangeRateRequest) (*types.QueryExchangeRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Pair) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty pair")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ema, err := q.Keeper.GetExchangeRateEma(ctx, req.Pair)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExchangeRateResponse{ExchangeRate: ema}, nil
+}
+
 // ExchangeRates queries exchange rates of all pairs
 func (q querier) ExchangeRates(c context.Context, _ *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -94,6 +116,22 @@ func (q querier) ExchangeRates(c context.Context, _ *types.QueryExchangeRatesReq
 	return &types.QueryExchangeRatesResponse{ExchangeRates: exchangeRates}, nil
 }
 
+// ExchangeRatesEma queries the exponential moving average exchange rates of
+// all pairs
+func (q querier) ExchangeRatesEma(c context.Context, _ *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var exchangeRates types.ExchangeRateTuples
+	for _, er := range q.Keeper.EmaPrices.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
+		exchangeRates = append(exchangeRates, types.ExchangeRateTuple{
+			Pair:         er.Key,
+			ExchangeRate: er.Value.ExchangeRate,
+		})
+	}
+
+	return &types.QueryExchangeRatesResponse{ExchangeRates: exchangeRates}, nil
+}
+
 // Actives queries all pairs for which exchange rates exist
 func (q querier) Actives(c context.Context, _ *types.QueryActivesRequest) (*types.QueryActivesResponse, error) {
 	return &types.QueryActivesResponse{Actives: q.Keeper.ExchangeRates.Iterate(sdk.UnwrapSDKContext(c), collections.Range[asset.Pair]{}).Keys()}, nil
@@ -192,3 +230,93 @@ func (q querier) AggregateVote(c context.Context, req *types.QueryAggregateVoteR
 func (q querier) AggregateVotes(c context.Context, _ *types.QueryAggregateVotesRequest) (*types.QueryAggregateVotesResponse, error) {
 	return &types.QueryAggregateVotesResponse{AggregateVotes: q.Keeper.Votes.Iterate(sdk.UnwrapSDKContext(c), collections.Range[sdk.ValAddress]{}).Values()}, nil
 }
+
+// ValidatorPerformance queries the rolling oracle performance statistics and
+// the recorded history of a validator
+func (q querier) ValidatorPerformance(c context.Context, req *types.QueryValidatorPerformanceRequest) (*types.QueryValidatorPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryValidatorPerformanceResponse{
+		Summary: q.Keeper.PerformanceSummaries.GetOr(ctx, valAddr, types.NewValidatorPerformanceSummary(valAddr)),
+		History: q.Keeper.GetPerformanceHistory(ctx, valAddr),
+	}, nil
+}
+
+// PerformanceLeaderboard queries the rolling oracle performance statistics of
+// all validators, ordered from best to worst
+func (q querier) PerformanceLeaderboard(c context.Context, req *types.QueryPerformanceLeaderboardRequest) (*types.QueryPerformanceLeaderboardResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pagination, _, err := common.ParsePagination(req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if pagination.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "key based pagination is not supported, use offset")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	summaries := q.Keeper.GetPerformanceLeaderboard(ctx)
+
+	total := uint64(len(summaries))
+	start := pagination.Offset
+	if start > total {
+		start = total
+	}
+	end := start + pagination.Limit
+	if end > total {
+		end = total
+	}
+
+	pageRes := &query.PageResponse{}
+	if pagination.CountTotal {
+		pageRes.Total = total
+	}
+
+	return &types.QueryPerformanceLeaderboardResponse{
+		Summaries:  summaries[start:end],
+		Pagination: pageRes,
+	}, nil
+}
+
+// FeederRewards returns the active reward programs, optionally filtered by pair
+func (q querier) FeederRewards(c context.Context, req *types.QueryFeederRewardsRequest) (*types.QueryFeederRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var pair asset.Pair
+	if req.Pair != "" {
+		var err error
+		pair, err = asset.TryNewPair(req.Pair)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var found []types.Rewards
+	if pair != "" {
+		found = q.Keeper.Rewards.Collect(ctx, q.Keeper.Rewards.Indexes.Pair.ExactMatch(ctx, pair.String()))
+	} else {
+		found = q.Keeper.Rewards.Iterate(ctx, collections.Range[uint64]{}).Values()
+	}
+
+	rewards := []types.Rewards{}
+	for _, reward := range found {
+		reward.VotePeriods = q.Keeper.RemainingVotePeriods(ctx, reward)
+		rewards = append(rewards, reward)
+	}
+
+	return &types.QueryFeederRewardsResponse{Rewards: rewards}, nil
+}
@@ -117,6 +117,31 @@ func TestQueryExchangeRateTwap(t *testing.T) {
 	require.Equal(t, sdk.MustNewDecFromStr("1700"), res.ExchangeRate)
 }
 
+func TestQueryExchangeRateEma(t *testing.T) {
+	input := CreateTestFixture(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	input.OracleKeeper.SetPrice(input.Ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD), sdk.NewDec(1700))
+
+	// empty request
+	_, err := querier.ExchangeRateEma(ctx, nil)
+	require.Error(t, err)
+
+	_, err = querier.ExchangeRateEma(ctx, &types.QueryExchangeRateRequest{Pair: asset.Registry.Pair(denoms.ETH, denoms.NUSD)})
+	require.ErrorIs(t, err, types.ErrNoValidEMA)
+
+	res, err := querier.ExchangeRateEma(ctx, &types.QueryExchangeRateRequest{Pair: asset.Registry.Pair(denoms.BTC, denoms.NUSD)})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1700), res.ExchangeRate)
+
+	resAll, err := querier.ExchangeRatesEma(ctx, &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.ExchangeRateTuples{
+		{Pair: asset.Registry.Pair(denoms.BTC, denoms.NUSD), ExchangeRate: sdk.NewDec(1700)},
+	}, resAll.ExchangeRates)
+}
+
 func TestCalcTwap(t *testing.T) {
 	tests := []struct {
 		name               string
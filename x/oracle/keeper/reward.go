@@ -5,42 +5,185 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/omap"
+	"github.com/NibiruChain/nibiru/x/common/set"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
+// AllocateRewards funds rewards from the funder module which are spread
+// evenly over votePeriods vote periods. The remainder of the division stays
+// in the funder module.
 func (k Keeper) AllocateRewards(ctx sdk.Context, funderModule string, totalCoins sdk.Coins, votePeriods uint64) error {
-	votePeriodCoins := make(sdk.Coins, len(totalCoins))
-	for i, coin := range totalCoins {
-		newCoin := sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(int64(votePeriods)))
-		votePeriodCoins[i] = newCoin
+	if err := validateRewardVotePeriods(votePeriods); err != nil {
+		return err
 	}
 
-	id := k.RewardsID.Next(ctx)
-	k.Rewards.Insert(ctx, id, types.Rewards{
-		Id:          id,
+	votePeriodCoins, distributedCoins := splitRewards(totalCoins, votePeriods)
+	if distributedCoins.IsZero() {
+		return nil
+	}
+
+	k.insertRewards(ctx, votePeriodCoins, votePeriods, "", "")
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, funderModule, types.ModuleName, distributedCoins)
+}
+
+// CreateFeederReward funds rewards from the sender account which are spread
+// evenly over votePeriods vote periods. If pair is not empty, only the
+// validators that voted faithfully for the pair are rewarded. Only the coins
+// that can be split evenly are charged to the sender, along with the creation
+// fee which goes to the community pool.
+func (k Keeper) CreateFeederReward(
+	ctx sdk.Context, sender sdk.AccAddress, totalCoins sdk.Coins, votePeriods uint64, pair asset.Pair,
+) (uint64, error) {
+	if err := validateRewardVotePeriods(votePeriods); err != nil {
+		return 0, err
+	}
+
+	if pair != "" && !k.WhitelistedPairs.Has(ctx, pair) {
+		return 0, types.ErrInvalidFeederReward.Wrapf("pair %s is not whitelisted", pair)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if k.countRewards(ctx, pair, params.MaxFeederRewardsPerPair) >= params.MaxFeederRewardsPerPair {
+		return 0, types.ErrInvalidFeederReward.Wrapf(
+			"pair %q already has %d active reward programs", pair, params.MaxFeederRewardsPerPair)
+	}
+
+	votePeriodCoins, distributedCoins := splitRewards(totalCoins, votePeriods)
+	for _, coin := range totalCoins {
+		if !votePeriodCoins.AmountOf(coin.Denom).IsPositive() {
+			return 0, types.ErrInvalidFeederReward.Wrapf(
+				"%s is too small to be distributed over %d vote periods", coin, votePeriods)
+		}
+	}
+
+	if !params.FeederRewardCreationFee.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, params.FeederRewardCreationFee, sender); err != nil {
+			return 0, err
+		}
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, distributedCoins); err != nil {
+		return 0, err
+	}
+
+	rewards := k.insertRewards(ctx, votePeriodCoins, votePeriods, pair, sender.String())
+
+	return rewards.Id, ctx.EventManager().EmitTypedEvent(&types.EventFeederRewardCreated{
+		Id:          rewards.Id,
+		Funder:      sender.String(),
+		Pair:        pair.String(),
+		Coins:       distributedCoins,
 		VotePeriods: votePeriods,
-		Coins:       votePeriodCoins,
 	})
+}
+
+// countRewards counts the active rewards limited to the pair, or to all pairs
+// if the pair is empty, up to limit.
+func (k Keeper) countRewards(ctx sdk.Context, pair asset.Pair, limit uint64) (count uint64) {
+	iter := k.Rewards.Indexes.Pair.ExactMatch(ctx, pair.String())
+	defer iter.Close()
+	for ; iter.Valid() && count < limit; iter.Next() {
+		count++
+	}
+	return count
+}
 
-	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, funderModule, types.ModuleName, totalCoins)
+func validateRewardVotePeriods(votePeriods uint64) error {
+	if votePeriods == 0 || votePeriods > types.MaxRewardVotePeriods {
+		return types.ErrInvalidFeederReward.Wrapf(
+			"vote periods must be between 1 and %d, got %d", types.MaxRewardVotePeriods, votePeriods)
+	}
+	return nil
+}
+
+// splitRewards returns the coins distributed in each of the votePeriods vote
+// periods and the total coins distributed, which leaves out the remainder of
+// the division.
+func splitRewards(totalCoins sdk.Coins, votePeriods uint64) (votePeriodCoins, distributedCoins sdk.Coins) {
+	periods := sdk.NewIntFromUint64(votePeriods)
+	votePeriodCoins = sdk.NewCoins()
+	distributedCoins = sdk.NewCoins()
+	for _, coin := range totalCoins {
+		amount := coin.Amount.Quo(periods)
+		votePeriodCoins = votePeriodCoins.Add(sdk.NewCoin(coin.Denom, amount))
+		distributedCoins = distributedCoins.Add(sdk.NewCoin(coin.Denom, amount.Mul(periods)))
+	}
+	return votePeriodCoins, distributedCoins
+}
+
+// insertRewards stores new rewards distributing votePeriodCoins in each of
+// the votePeriods vote periods.
+func (k Keeper) insertRewards(
+	ctx sdk.Context, votePeriodCoins sdk.Coins, votePeriods uint64, pair asset.Pair, funder string,
+) types.Rewards {
+	id := k.RewardsID.Next(ctx)
+	rewards := types.Rewards{
+		Id:            id,
+		VotePeriods:   votePeriods,
+		Coins:         votePeriodCoins,
+		Pair:          pair,
+		Funder:        funder,
+		EndVotePeriod: k.VotePeriodCount.Peek(ctx) + votePeriods,
+	}
+	k.Rewards.Insert(ctx, id, rewards)
+
+	return rewards
 }
 
 // rewardBallotWinners gives out a portion of spread fees collected in the
-// oracle reward pool to the oracle voters that voted faithfully.
+// oracle reward pool to the oracle voters that voted faithfully. Rewards
+// limited to a pair only go to the voters that voted faithfully for the pair.
 func (k Keeper) rewardBallotWinners(
 	ctx sdk.Context,
 	validatorPerformances types.ValidatorPerformances,
+	pairPerformances map[asset.Pair]types.ValidatorPerformances,
 ) {
 	totalRewardWeight := validatorPerformances.GetTotalRewardWeight()
 	if totalRewardWeight == 0 {
 		return
 	}
 
-	var totalRewards sdk.DecCoins
-	rewards := k.GatherRewardsForVotePeriod(ctx)
-	totalRewards = totalRewards.Add(sdk.NewDecCoinsFromCoins(rewards...)...)
+	rewardedPairs := set.New[asset.Pair]()
+	for pair, performances := range pairPerformances {
+		if performances.GetTotalRewardWeight() != 0 {
+			rewardedPairs.Add(pair)
+		}
+	}
+
+	rewards, pairRewards := k.GatherRewardsForVotePeriod(ctx, rewardedPairs)
+
+	distributedRewards := k.allocateRewardsToValidators(ctx, validatorPerformances, rewards)
+	orderedPairRewards := omap.OrderedMap_Pair[sdk.Coins](pairRewards)
+	for pair := range orderedPairRewards.Range() {
+		distributedRewards = distributedRewards.Add(
+			k.allocateRewardsToValidators(ctx, pairPerformances[pair], pairRewards[pair])...,
+		)
+	}
+
+	// Move distributed reward to distribution module
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distrModuleName, distributedRewards)
+	if err != nil {
+		k.Logger(ctx).Error("Failed to send coins to distribution module", "err", err)
+	}
+}
 
-	var distributedRewards sdk.Coins
+// allocateRewardsToValidators splits the rewards among the validators pro rata
+// their reward weight and returns the coins that were allocated.
+func (k Keeper) allocateRewardsToValidators(
+	ctx sdk.Context,
+	validatorPerformances types.ValidatorPerformances,
+	rewards sdk.Coins,
+) (distributedRewards sdk.Coins) {
+	totalRewardWeight := validatorPerformances.GetTotalRewardWeight()
+	if totalRewardWeight == 0 || rewards.IsZero() {
+		return distributedRewards
+	}
+
+	totalRewards := sdk.NewDecCoinsFromCoins(rewards...)
 	for _, validatorPerformance := range validatorPerformances {
 		validator := k.StakingKeeper.Validator(ctx, validatorPerformance.ValAddress)
 		if validator == nil {
@@ -52,37 +195,82 @@ func (k Keeper) rewardBallotWinners(
 		distributedRewards = distributedRewards.Add(rewardPortion...)
 	}
 
-	// Move distributed reward to distribution module
-	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distrModuleName, distributedRewards)
-	if err != nil {
-		k.Logger(ctx).Error("Failed to send coins to distribution module", "err", err)
-	}
+	return distributedRewards
 }
 
-// GatherRewardsForVotePeriod retrieves the pair rewards for the provided pair and current vote period.
-func (k Keeper) GatherRewardsForVotePeriod(ctx sdk.Context) sdk.Coins {
-	coins := sdk.NewCoins()
-	// iterate over
-	for _, rewardId := range k.Rewards.Iterate(ctx, collections.Range[uint64]{}).Keys() {
-		pairReward, err := k.Rewards.Get(ctx, rewardId)
-		if err != nil {
-			k.Logger(ctx).Error("Failed to get reward", "err", err)
-			continue
-		}
-		coins = coins.Add(pairReward.Coins...)
-
-		// Decrease the remaining vote periods of the PairReward.
-		pairReward.VotePeriods -= 1
-		if pairReward.VotePeriods == 0 {
-			// If the distribution period count drops to 0: the reward instance is removed.
-			err := k.Rewards.Delete(ctx, rewardId)
-			if err != nil {
+// GatherRewardsForVotePeriod retrieves the rewards for the current vote period
+// and removes the rewards that end with it. Rewards limited to a pair are
+// returned by pair if the pair is part of rewardedPairs. Otherwise, the coins
+// of the vote period are refunded to the funder, along with the coins of the
+// remaining vote periods if the pair is no longer whitelisted.
+func (k Keeper) GatherRewardsForVotePeriod(
+	ctx sdk.Context, rewardedPairs set.Set[asset.Pair],
+) (coins sdk.Coins, pairCoins map[asset.Pair]sdk.Coins) {
+	coins = sdk.NewCoins()
+	pairCoins = make(map[asset.Pair]sdk.Coins)
+	votePeriod := k.VotePeriodCount.Next(ctx)
+
+	// the rewards that ended were removed, so all of them are active
+	for _, pairReward := range k.Rewards.Iterate(ctx, collections.Range[uint64]{}).Values() {
+		switch {
+		case pairReward.Pair == "":
+			coins = coins.Add(pairReward.Coins...)
+		case rewardedPairs.Has(pairReward.Pair):
+			pairCoins[pairReward.Pair] = pairCoins[pairReward.Pair].Add(pairReward.Coins...)
+		case !k.WhitelistedPairs.Has(ctx, pairReward.Pair):
+			k.refundRewards(ctx, pairReward, pairReward.EndVotePeriod-votePeriod)
+			if err := k.Rewards.Delete(ctx, pairReward.Id); err != nil {
 				k.Logger(ctx).Error("Failed to delete pair reward", "err", err)
 			}
-		} else {
-			k.Rewards.Insert(ctx, rewardId, pairReward)
+		default:
+			k.refundRewards(ctx, pairReward, 1)
+		}
+	}
+
+	// remove the rewards whose last vote period was this one
+	ended := k.Rewards.Indexes.End.Iterate(ctx, collections.Range[collections.Pair[uint64, uint64]]{}.
+		EndExclusive(collections.Join(votePeriod+2, uint64(0)))).PrimaryKeys()
+	for _, rewardId := range ended {
+		if err := k.Rewards.Delete(ctx, rewardId); err != nil {
+			k.Logger(ctx).Error("Failed to delete pair reward", "err", err)
 		}
 	}
 
-	return coins
+	return coins, pairCoins
+}
+
+// RemainingVotePeriods returns the vote periods left in which the rewards will
+// be distributed.
+func (k Keeper) RemainingVotePeriods(ctx sdk.Context, rewards types.Rewards) uint64 {
+	votePeriod := k.VotePeriodCount.Peek(ctx)
+	if rewards.EndVotePeriod <= votePeriod {
+		return 0
+	}
+	return rewards.EndVotePeriod - votePeriod
+}
+
+// refundRewards sends the coins of votePeriods vote periods of the rewards
+// back to their funder. Rewards funded by a module are not refunded.
+func (k Keeper) refundRewards(ctx sdk.Context, rewards types.Rewards, votePeriods uint64) {
+	funder, err := sdk.AccAddressFromBech32(rewards.Funder)
+	if err != nil {
+		k.Logger(ctx).Error("Failed to refund rewards", "id", rewards.Id, "err", err)
+		return
+	}
+
+	refund := sdk.NewCoins()
+	for _, coin := range rewards.Coins {
+		refund = refund.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(sdk.NewIntFromUint64(votePeriods))))
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, funder, refund); err != nil {
+		k.Logger(ctx).Error("Failed to refund rewards", "id", rewards.Id, "err", err)
+		return
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventFeederRewardRefunded{
+		Id:     rewards.Id,
+		Funder: rewards.Funder,
+		Pair:   rewards.Pair.String(),
+		Coins:  refund,
+	})
 }
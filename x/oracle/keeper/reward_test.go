@@ -11,6 +11,7 @@ import (
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/set"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
@@ -62,8 +63,178 @@ func TestKeeperRewardsDistributionMultiVotePeriods(t *testing.T) {
 	}
 
 	// assert there are no rewards
-	require.True(t, fixture.OracleKeeper.GatherRewardsForVotePeriod(fixture.Ctx).IsZero())
+	gathered, pairRewards := fixture.OracleKeeper.GatherRewardsForVotePeriod(fixture.Ctx, set.New(asset.Registry.Pair(denoms.NIBI, denoms.NUSD)))
+	require.True(t, gathered.IsZero())
+	require.Empty(t, pairRewards)
 
 	// assert that there are no rewards instances
 	require.Empty(t, fixture.OracleKeeper.Rewards.Iterate(fixture.Ctx, collections.Range[uint64]{}).Keys())
 }
+
+func TestCreateFeederReward(t *testing.T) {
+	fixture, msgServer := Setup(t)
+	goCtx := sdk.WrapSDKContext(fixture.Ctx)
+	nibiPair := asset.Registry.Pair(denoms.NIBI, denoms.NUSD)
+	btcPair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	params, err := fixture.OracleKeeper.Params.Get(fixture.Ctx)
+	require.NoError(t, err)
+	params.Whitelist = []asset.Pair{nibiPair, btcPair}
+	fixture.OracleKeeper.Params.Set(fixture.Ctx, params)
+	for _, p := range fixture.OracleKeeper.WhitelistedPairs.Iterate(fixture.Ctx, collections.Range[asset.Pair]{}).Keys() {
+		fixture.OracleKeeper.WhitelistedPairs.Delete(fixture.Ctx, p)
+	}
+	fixture.OracleKeeper.WhitelistedPairs.Insert(fixture.Ctx, nibiPair)
+	fixture.OracleKeeper.WhitelistedPairs.Insert(fixture.Ctx, btcPair)
+
+	funder := Addrs[0]
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("reward", 801))
+	require.NoError(t, FundAccount(fixture, funder, rewards))
+
+	// pair is not whitelisted
+	_, err = msgServer.CreateFeederReward(goCtx, types.NewMsgCreateFeederReward(
+		funder, rewards, 2, asset.Registry.Pair(denoms.ETH, denoms.NUSD)))
+	require.ErrorIs(t, err, types.ErrInvalidFeederReward)
+
+	// too small to be split over the vote periods
+	_, err = msgServer.CreateFeederReward(goCtx, types.NewMsgCreateFeederReward(
+		funder, sdk.NewCoins(sdk.NewInt64Coin("reward", 1)), 2, nibiPair))
+	require.ErrorIs(t, err, types.ErrInvalidFeederReward)
+
+	// insufficient funds
+	_, err = msgServer.CreateFeederReward(goCtx, types.NewMsgCreateFeederReward(
+		funder, rewards.Add(rewards...), 2, nibiPair))
+	require.Error(t, err)
+
+	resp, err := msgServer.CreateFeederReward(goCtx, types.NewMsgCreateFeederReward(funder, rewards, 2, nibiPair))
+	require.NoError(t, err)
+	// the remainder of the split is not charged
+	require.Equal(t, sdk.OneInt(), fixture.BankKeeper.GetAllBalances(fixture.Ctx, funder).AmountOf("reward"))
+
+	querier := NewQuerier(fixture.OracleKeeper)
+	queryResp, err := querier.FeederRewards(goCtx, &types.QueryFeederRewardsRequest{Pair: nibiPair.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.Rewards{{
+		Id:            resp.Id,
+		VotePeriods:   2,
+		Coins:         sdk.NewCoins(sdk.NewInt64Coin("reward", 400)),
+		Pair:          nibiPair,
+		Funder:        funder.String(),
+		EndVotePeriod: fixture.OracleKeeper.VotePeriodCount.Peek(fixture.Ctx) + 2,
+	}}, queryResp.Rewards)
+
+	queryResp, err = querier.FeederRewards(goCtx, &types.QueryFeederRewardsRequest{Pair: btcPair.String()})
+	require.NoError(t, err)
+	require.Empty(t, queryResp.Rewards)
+
+	// validators 0 to 3 vote for both pairs, validator 4 only for btc
+	for valIndex := 0; valIndex < 5; valIndex++ {
+		rates := types.ExchangeRateTuples{{Pair: btcPair, ExchangeRate: randomExchangeRate}}
+		if valIndex < 4 {
+			rates = append(rates, types.ExchangeRateTuple{Pair: nibiPair, ExchangeRate: randomExchangeRate})
+		}
+		MakeAggregatePrevoteAndVote(t, fixture, msgServer, fixture.Ctx.BlockHeight(), rates, valIndex)
+	}
+	fixture.OracleKeeper.UpdateExchangeRates(fixture.Ctx)
+
+	for valIndex := 0; valIndex < 4; valIndex++ {
+		outstanding := fixture.DistrKeeper.GetValidatorOutstandingRewards(fixture.Ctx, ValAddrs[valIndex])
+		require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("reward", 100)), outstanding.Rewards)
+	}
+	outstanding := fixture.DistrKeeper.GetValidatorOutstandingRewards(fixture.Ctx, ValAddrs[4])
+	require.True(t, outstanding.Rewards.IsZero())
+
+	queryResp, err = querier.FeederRewards(goCtx, &types.QueryFeederRewardsRequest{})
+	require.NoError(t, err)
+	require.Len(t, queryResp.Rewards, 1)
+	require.Equal(t, uint64(1), queryResp.Rewards[0].VotePeriods)
+}
+
+func TestPairRewardsRefundedWithoutWinners(t *testing.T) {
+	fixture, msgServer := Setup(t)
+	nibiPair := asset.Registry.Pair(denoms.NIBI, denoms.NUSD)
+
+	funder := Addrs[0]
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("reward", 300))
+	require.NoError(t, FundAccount(fixture, funder, rewards))
+	_, err := msgServer.CreateFeederReward(sdk.WrapSDKContext(fixture.Ctx), types.NewMsgCreateFeederReward(funder, rewards, 3, nibiPair))
+	require.NoError(t, err)
+
+	// no winners for the pair: the coins of the vote period are refunded
+	gathered, pairRewards := fixture.OracleKeeper.GatherRewardsForVotePeriod(fixture.Ctx, set.New[asset.Pair]())
+	require.True(t, gathered.IsZero())
+	require.Empty(t, pairRewards)
+	require.Equal(t, sdk.NewInt(100), fixture.BankKeeper.GetBalance(fixture.Ctx, funder, "reward").Amount)
+	remaining := fixture.OracleKeeper.Rewards.Iterate(fixture.Ctx, collections.Range[uint64]{}).Values()
+	require.Len(t, remaining, 1)
+	require.EqualValues(t, 2, fixture.OracleKeeper.RemainingVotePeriods(fixture.Ctx, remaining[0]))
+
+	gathered, pairRewards = fixture.OracleKeeper.GatherRewardsForVotePeriod(fixture.Ctx, set.New(nibiPair))
+	require.True(t, gathered.IsZero())
+	require.Equal(t, map[asset.Pair]sdk.Coins{nibiPair: sdk.NewCoins(sdk.NewInt64Coin("reward", 100))}, pairRewards)
+
+	// the pair is removed from the whitelist: the remaining coins are refunded
+	fixture.OracleKeeper.WhitelistedPairs.Delete(fixture.Ctx, nibiPair)
+	gathered, pairRewards = fixture.OracleKeeper.GatherRewardsForVotePeriod(fixture.Ctx, set.New[asset.Pair]())
+	require.True(t, gathered.IsZero())
+	require.Empty(t, pairRewards)
+	require.Equal(t, sdk.NewInt(200), fixture.BankKeeper.GetBalance(fixture.Ctx, funder, "reward").Amount)
+	require.Empty(t, fixture.OracleKeeper.Rewards.Iterate(fixture.Ctx, collections.Range[uint64]{}).Keys())
+}
+
+func TestAllocateRewardsBounds(t *testing.T) {
+	fixture, _ := Setup(t)
+
+	// vote periods that would overflow an int64 are rejected
+	err := fixture.OracleKeeper.AllocateRewards(fixture.Ctx, faucetAccountName, sdk.NewCoins(sdk.NewInt64Coin("reward", 100)), 1<<63)
+	require.ErrorIs(t, err, types.ErrInvalidFeederReward)
+
+	// only the coins that can be split evenly leave the funder module, which
+	// keeps the remainder of the minted rewards
+	faucetBalance := fixture.BankKeeper.GetAllBalances(fixture.Ctx, fixture.AccountKeeper.GetModuleAddress(faucetAccountName))
+	AllocateRewards(t, fixture, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 101)), 10)
+	require.Equal(t,
+		faucetBalance.AmountOf(denoms.NIBI).AddRaw(1),
+		fixture.BankKeeper.GetAllBalances(fixture.Ctx, fixture.AccountKeeper.GetModuleAddress(faucetAccountName)).AmountOf(denoms.NIBI))
+}
+
+func TestFeederRewardCreationFeeAndCap(t *testing.T) {
+	fixture, msgServer := Setup(t)
+	goCtx := sdk.WrapSDKContext(fixture.Ctx)
+	nibiPair := asset.Registry.Pair(denoms.NIBI, denoms.NUSD)
+
+	params, err := fixture.OracleKeeper.Params.Get(fixture.Ctx)
+	require.NoError(t, err)
+	params.FeederRewardCreationFee = sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1_000))
+	params.MaxFeederRewardsPerPair = 2
+	fixture.OracleKeeper.Params.Set(fixture.Ctx, params)
+
+	funder := Addrs[0]
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("reward", 100))
+	require.NoError(t, FundAccount(fixture, funder, rewards.Add(rewards...).Add(rewards...)))
+	communityPool := fixture.DistrKeeper.GetFeePoolCommunityCoins(fixture.Ctx)
+
+	// the creation fee goes to the community pool
+	_, err = msgServer.CreateFeederReward(goCtx, types.NewMsgCreateFeederReward(funder, rewards, 1, nibiPair))
+	require.NoError(t, err)
+	_, err = msgServer.CreateFeederReward(goCtx, types.NewMsgCreateFeederReward(funder, rewards, 2, nibiPair))
+	require.NoError(t, err)
+	require.Equal(t,
+		communityPool.Add(sdk.NewDecCoinsFromCoins(params.FeederRewardCreationFee.Add(params.FeederRewardCreationFee...)...)...),
+		fixture.DistrKeeper.GetFeePoolCommunityCoins(fixture.Ctx))
+
+	// the pair already has the maximum active programs, which does not limit
+	// the programs for all pairs
+	_, err = msgServer.CreateFeederReward(goCtx, types.NewMsgCreateFeederReward(funder, rewards, 1, nibiPair))
+	require.ErrorIs(t, err, types.ErrInvalidFeederReward)
+	_, err = msgServer.CreateFeederReward(goCtx, types.NewMsgCreateFeederReward(funder, rewards, 1, ""))
+	require.NoError(t, err)
+
+	// the programs are removed at their end vote period, which frees the pair
+	fixture.OracleKeeper.GatherRewardsForVotePeriod(fixture.Ctx, set.New(nibiPair))
+	require.Len(t, fixture.OracleKeeper.Rewards.Iterate(fixture.Ctx, collections.Range[uint64]{}).Keys(), 1)
+	fixture.OracleKeeper.GatherRewardsForVotePeriod(fixture.Ctx, set.New(nibiPair))
+	require.Empty(t, fixture.OracleKeeper.Rewards.Iterate(fixture.Ctx, collections.Range[uint64]{}).Keys())
+	require.Empty(t, fixture.OracleKeeper.Rewards.Indexes.End.Iterate(
+		fixture.Ctx, collections.Range[collections.Pair[uint64, uint64]]{}).PrimaryKeys())
+}
@@ -4,23 +4,30 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/set"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
-// SlashAndResetMissCounters do slash any operator who over criteria & clear all operators miss counter to zero
+// SlashAndResetMissCounters penalizes any operator who over criteria & clear all operators miss counter to zero.
+// The penalty escalates through the PenaltyLadder for every consecutive slash window the operator fails.
 func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	height := ctx.BlockHeight()
 	distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
+	params, _ := k.Params.Get(ctx)
+
 	// slash_window / vote_period
 	votePeriodsPerWindow := uint64(
-		sdk.NewDec(int64(k.SlashWindow(ctx))).
-			QuoInt64(int64(k.VotePeriod(ctx))).
+		sdk.NewDec(int64(params.SlashWindow)).
+			QuoInt64(int64(params.VotePeriod)).
 			TruncateInt64(),
 	)
-	minValidPerWindow := k.MinValidPerWindow(ctx)
-	slashFraction := k.SlashFraction(ctx)
+	minValidPerWindow := params.MinValidPerWindow
+	slashFraction := params.SlashFraction
 	powerReduction := k.StakingKeeper.PowerReduction(ctx)
 
+	penalized := set.New[string]()
 	for _, mc := range k.MissCounters.Iterate(ctx, collections.Range[sdk.ValAddress]{}).KeyValues() {
 		operator := mc.Key
 		missCounter := mc.Value
@@ -32,19 +39,42 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 		// Penalize the validator whose the valid vote rate is smaller than min threshold
 		if validVoteRate.LT(minValidPerWindow) {
 			validator := k.StakingKeeper.Validator(ctx, operator)
-			if validator.IsBonded() && !validator.IsJailed() {
-				consAddr, err := validator.GetConsAddr()
-				if err != nil {
-					k.Logger(ctx).Error("fail to get consensus address", "validator", validator.GetOperator().String())
-					continue
+			if validator.IsBonded() && !validator.IsJailed() && !k.isInGracePeriod(ctx, operator, params) {
+				penalty := k.PenaltyStates.GetOr(ctx, operator, types.ValidatorPenaltyState{ValidatorAddress: operator.String()})
+				penalty.Strikes++
+				penalty.LastAction = params.PenaltyForStrikes(penalty.Strikes)
+
+				switch penalty.LastAction {
+				case types.OraclePenaltyAction_SLASH, types.OraclePenaltyAction_SLASH_AND_JAIL:
+					consAddr, err := validator.GetConsAddr()
+					if err != nil {
+						k.Logger(ctx).Error("fail to get consensus address", "validator", validator.GetOperator().String())
+						continue
+					}
+
+					k.StakingKeeper.Slash(
+						ctx, consAddr,
+						distributionHeight, validator.GetConsensusPower(powerReduction), slashFraction,
+					)
+					k.Logger(ctx).Info("slash", "validator", consAddr.String(), "fraction", slashFraction.String())
+					if penalty.LastAction == types.OraclePenaltyAction_SLASH_AND_JAIL {
+						k.StakingKeeper.Jail(ctx, consAddr)
+					}
+				default:
+					k.Logger(ctx).Info("oracle penalty", "validator", operator.String(), "action", penalty.LastAction.String())
 				}
 
-				k.StakingKeeper.Slash(
-					ctx, consAddr,
-					distributionHeight, validator.GetConsensusPower(powerReduction), slashFraction,
-				)
-				k.Logger(ctx).Info("slash", "validator", consAddr.String(), "fraction", slashFraction.String())
-				k.StakingKeeper.Jail(ctx, consAddr)
+				k.PenaltyStates.Insert(ctx, operator, penalty)
+				penalized.Add(operator.String())
+
+				if err := ctx.EventManager().EmitTypedEvent(&types.EventOraclePenalty{
+					Validator:     operator.String(),
+					Action:        penalty.LastAction,
+					Strikes:       penalty.Strikes,
+					ValidVoteRate: validVoteRate,
+				}); err != nil {
+					k.Logger(ctx).Error("failed to emit EventOraclePenalty", "validator", operator.String(), "error", err)
+				}
 			}
 		}
 
@@ -53,4 +83,75 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 			k.Logger(ctx).Error("fail to delete miss counter", "operator", operator.String(), "error", err)
 		}
 	}
+
+	// Operators that were not penalized in this window start over from the
+	// bottom of the ladder.
+	for _, operator := range k.PenaltyStates.Iterate(ctx, collections.Range[sdk.ValAddress]{}).Keys() {
+		if penalized.Has(operator.String()) {
+			continue
+		}
+		if err := k.PenaltyStates.Delete(ctx, operator); err != nil {
+			k.Logger(ctx).Error("fail to delete penalty state", "operator", operator.String(), "error", err)
+		}
+	}
+
+	k.pruneMaintenanceWindows(ctx)
+}
+
+// isInGracePeriod returns true if the validator joined the bonded set less
+// than GracePeriodBlocks ago.
+func (k Keeper) isInGracePeriod(ctx sdk.Context, valAddr sdk.ValAddress, params types.Params) bool {
+	bondedSince, err := k.BondedSince.Get(ctx, valAddr)
+	if err != nil {
+		return false
+	}
+	return uint64(ctx.BlockHeight()) < bondedSince+params.GracePeriodBlocks
+}
+
+// updateBondedSince records the height at which validators joined the bonded
+// set and forgets the validators that left it, so that a validator rejoining
+// the set gets a new grace period.
+func (k Keeper) updateBondedSince(ctx sdk.Context, validatorPerformances types.ValidatorPerformances) {
+	for _, valAddr := range k.BondedSince.Iterate(ctx, collections.Range[sdk.ValAddress]{}).Keys() {
+		if _, bonded := validatorPerformances[valAddr.String()]; bonded {
+			continue
+		}
+		if err := k.BondedSince.Delete(ctx, valAddr); err != nil {
+			k.Logger(ctx).Error("failed to delete bonded since", "validator", valAddr.String(), "error", err)
+		}
+	}
+
+	for _, validatorPerformance := range validatorPerformances {
+		if _, err := k.BondedSince.Get(ctx, validatorPerformance.ValAddress); err == nil {
+			continue
+		}
+		k.BondedSince.Insert(ctx, validatorPerformance.ValAddress, uint64(ctx.BlockHeight()))
+	}
+}
+
+// applyRewardReductions reduces the reward weight of the validators that are
+// penalized with REDUCE_REWARDS or a harsher action. The reduced share is
+// distributed among the other ballot winners.
+//
+// ALERT: This function mutates validatorPerformances.
+func (k Keeper) applyRewardReductions(ctx sdk.Context, validatorPerformances types.ValidatorPerformances) {
+	params, _ := k.Params.Get(ctx)
+	if params.RewardReductionFraction.IsNil() || params.RewardReductionFraction.IsZero() {
+		return
+	}
+
+	for _, penalty := range k.PenaltyStates.Iterate(ctx, collections.Range[sdk.ValAddress]{}).Values() {
+		if penalty.LastAction < types.OraclePenaltyAction_REDUCE_REWARDS {
+			continue
+		}
+
+		validatorPerformance, exists := validatorPerformances[penalty.ValidatorAddress]
+		if !exists {
+			continue
+		}
+
+		validatorPerformance.RewardWeight = sdk.OneDec().Sub(params.RewardReductionFraction).
+			MulInt64(validatorPerformance.RewardWeight).TruncateInt64()
+		validatorPerformances[penalty.ValidatorAddress] = validatorPerformance
+	}
 }
@@ -26,8 +26,13 @@ func TestSlashAndResetMissCounters(t *testing.T) {
 	sh := stakingkeeper.NewMsgServerImpl(&input.StakingKeeper)
 	ctx := input.Ctx
 
+	params, err := input.OracleKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.PenaltyLadder = []types.OraclePenaltyAction{types.OraclePenaltyAction_SLASH_AND_JAIL}
+	input.OracleKeeper.Params.Set(ctx, params)
+
 	// Validator created
-	_, err := sh.CreateValidator(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	_, err = sh.CreateValidator(ctx, NewTestMsgCreateValidator(addr, val, amt))
 	require.NoError(t, err)
 	_, err = sh.CreateValidator(ctx, NewTestMsgCreateValidator(addr1, val1, amt))
 	require.NoError(t, err)
@@ -93,6 +98,8 @@ func TestInvalidVotesSlashing(t *testing.T) {
 	params, err := input.OracleKeeper.Params.Get(input.Ctx)
 	require.NoError(t, err)
 	params.Whitelist = []asset.Pair{asset.Registry.Pair(denoms.NIBI, denoms.NUSD)}
+	params.PenaltyLadder = []types.OraclePenaltyAction{types.OraclePenaltyAction_SLASH_AND_JAIL}
+	params.GracePeriodBlocks = 0
 	input.OracleKeeper.Params.Set(input.Ctx, params)
 	input.OracleKeeper.WhitelistedPairs.Insert(input.Ctx, asset.Registry.Pair(denoms.NIBI, denoms.NUSD))
 
@@ -165,6 +172,11 @@ func TestInvalidVotesSlashing(t *testing.T) {
 
 func TestWhitelistSlashing(t *testing.T) {
 	input, h := Setup(t)
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.PenaltyLadder = []types.OraclePenaltyAction{types.OraclePenaltyAction_SLASH_AND_JAIL}
+	params.GracePeriodBlocks = 0
+	input.OracleKeeper.Params.Set(input.Ctx, params)
 
 	votePeriodsPerWindow := sdk.NewDec(int64(input.OracleKeeper.SlashWindow(input.Ctx))).QuoInt64(int64(input.OracleKeeper.VotePeriod(input.Ctx))).TruncateInt64()
 	slashFraction := input.OracleKeeper.SlashFraction(input.Ctx)
@@ -265,3 +277,135 @@ func TestAbstainSlashing(t *testing.T) {
 	validator := input.StakingKeeper.Validator(input.Ctx, ValAddrs[1])
 	require.Equal(t, stakingAmt, validator.GetBondedTokens())
 }
+
+func TestGraduatedPenalties(t *testing.T) {
+	input := CreateTestFixture(t)
+	addr, val := ValAddrs[0], ValPubKeys[0]
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := stakingkeeper.NewMsgServerImpl(&input.StakingKeeper)
+
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.GracePeriodBlocks = 0
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	_, err = sh.CreateValidator(input.Ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Ctx, &input.StakingKeeper)
+
+	votePeriodsPerWindow := sdk.NewDec(int64(params.SlashWindow)).QuoInt64(int64(params.VotePeriod)).TruncateInt64()
+	slashFraction := params.SlashFraction
+	missWindow := func() {
+		input.OracleKeeper.MissCounters.Insert(input.Ctx, addr, uint64(votePeriodsPerWindow))
+		input.OracleKeeper.SlashAndResetMissCounters(input.Ctx)
+	}
+
+	// Strike 1: warning only
+	missWindow()
+	penalty, err := input.OracleKeeper.PenaltyStates.Get(input.Ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), penalty.Strikes)
+	require.Equal(t, types.OraclePenaltyAction_WARN, penalty.LastAction)
+	require.Equal(t, amt, input.StakingKeeper.Validator(input.Ctx, addr).GetBondedTokens())
+
+	// Strike 2: reduced rewards, no slashing
+	missWindow()
+	penalty, err = input.OracleKeeper.PenaltyStates.Get(input.Ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, types.OraclePenaltyAction_REDUCE_REWARDS, penalty.LastAction)
+	require.Equal(t, amt, input.StakingKeeper.Validator(input.Ctx, addr).GetBondedTokens())
+
+	performances := types.ValidatorPerformances{
+		addr.String(): {Power: 100, RewardWeight: 100, ValAddress: addr},
+	}
+	input.OracleKeeper.applyRewardReductions(input.Ctx, performances)
+	require.Equal(t, int64(50), performances[addr.String()].RewardWeight)
+
+	// Strike 3: slash without jailing
+	missWindow()
+	validator := input.StakingKeeper.Validator(input.Ctx, addr)
+	slashedAmt := amt.Sub(slashFraction.MulInt(amt).TruncateInt())
+	require.Equal(t, slashedAmt, validator.GetBondedTokens())
+	require.False(t, validator.IsJailed())
+
+	// Strike 4: slash and jail
+	missWindow()
+	validator = input.StakingKeeper.Validator(input.Ctx, addr)
+	require.True(t, validator.IsJailed())
+	penalty, err = input.OracleKeeper.PenaltyStates.Get(input.Ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), penalty.Strikes)
+	require.Equal(t, types.OraclePenaltyAction_SLASH_AND_JAIL, penalty.LastAction)
+}
+
+func TestGraduatedPenaltiesReset(t *testing.T) {
+	input := CreateTestFixture(t)
+	addr, val := ValAddrs[0], ValPubKeys[0]
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := stakingkeeper.NewMsgServerImpl(&input.StakingKeeper)
+
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.GracePeriodBlocks = 0
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	_, err = sh.CreateValidator(input.Ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Ctx, &input.StakingKeeper)
+
+	votePeriodsPerWindow := sdk.NewDec(int64(params.SlashWindow)).QuoInt64(int64(params.VotePeriod)).TruncateInt64()
+
+	input.OracleKeeper.MissCounters.Insert(input.Ctx, addr, uint64(votePeriodsPerWindow))
+	input.OracleKeeper.SlashAndResetMissCounters(input.Ctx)
+	_, err = input.OracleKeeper.PenaltyStates.Get(input.Ctx, addr)
+	require.NoError(t, err)
+
+	// a clean window resets the ladder
+	input.OracleKeeper.SlashAndResetMissCounters(input.Ctx)
+	_, err = input.OracleKeeper.PenaltyStates.Get(input.Ctx, addr)
+	require.Error(t, err)
+}
+
+func TestGracePeriod(t *testing.T) {
+	input := CreateTestFixture(t)
+	addr, val := ValAddrs[0], ValPubKeys[0]
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+	sh := stakingkeeper.NewMsgServerImpl(&input.StakingKeeper)
+
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.GracePeriodBlocks = 100
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	_, err = sh.CreateValidator(input.Ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.NoError(t, err)
+	staking.EndBlocker(input.Ctx, &input.StakingKeeper)
+
+	input.Ctx = input.Ctx.WithBlockHeight(10)
+	input.OracleKeeper.updateBondedSince(input.Ctx, types.ValidatorPerformances{
+		addr.String(): types.NewValidatorPerformance(100, addr),
+	})
+
+	votePeriodsPerWindow := sdk.NewDec(int64(params.SlashWindow)).QuoInt64(int64(params.VotePeriod)).TruncateInt64()
+
+	// within the grace period misses are not penalized
+	input.Ctx = input.Ctx.WithBlockHeight(109)
+	input.OracleKeeper.MissCounters.Insert(input.Ctx, addr, uint64(votePeriodsPerWindow))
+	input.OracleKeeper.SlashAndResetMissCounters(input.Ctx)
+	_, err = input.OracleKeeper.PenaltyStates.Get(input.Ctx, addr)
+	require.Error(t, err)
+	_, err = input.OracleKeeper.MissCounters.Get(input.Ctx, addr)
+	require.Error(t, err)
+
+	// once the grace period is over the ladder applies
+	input.Ctx = input.Ctx.WithBlockHeight(110)
+	input.OracleKeeper.MissCounters.Insert(input.Ctx, addr, uint64(votePeriodsPerWindow))
+	input.OracleKeeper.SlashAndResetMissCounters(input.Ctx)
+	_, err = input.OracleKeeper.PenaltyStates.Get(input.Ctx, addr)
+	require.NoError(t, err)
+
+	// leaving the bonded set forgets the bonded height
+	input.OracleKeeper.updateBondedSince(input.Ctx, types.ValidatorPerformances{})
+	_, err = input.OracleKeeper.BondedSince.Get(input.Ctx, addr)
+	require.Error(t, err)
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"github.com/NibiruChain/collections"
+	sdkcodec "github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+type RewardsIndexes struct {
+	// Pair MultiIndex:
+	//  - indexing key (IK): pair the rewards are limited to, empty for all pairs
+	//  - primary key (PK): rewards id
+	//  - value (V): rewards
+	Pair collections.MultiIndex[string, uint64, types.Rewards]
+
+	// End MultiIndex:
+	//  - indexing key (IK): vote period count at which the rewards end
+	//  - primary key (PK): rewards id
+	//  - value (V): rewards
+	End collections.MultiIndex[uint64, uint64, types.Rewards]
+}
+
+func (idxs RewardsIndexes) IndexerList() []collections.Indexer[uint64, types.Rewards] {
+	return []collections.Indexer[uint64, types.Rewards]{
+		idxs.Pair, idxs.End,
+	}
+}
+
+func NewRewardsStore(
+	storeKey storetypes.StoreKey, cdc sdkcodec.BinaryCodec,
+) collections.IndexedMap[uint64, types.Rewards, RewardsIndexes] {
+	return collections.NewIndexedMap[uint64, types.Rewards](
+		storeKey, 7, collections.Uint64KeyEncoder, collections.ProtoValueEncoder[types.Rewards](cdc),
+		RewardsIndexes{
+			Pair: collections.NewMultiIndex[string, uint64, types.Rewards](
+				storeKey, 18,
+				collections.StringKeyEncoder, // index key (IK)
+				collections.Uint64KeyEncoder, // primary key (PK)
+				func(v types.Rewards) string { return v.Pair.String() },
+			),
+			End: collections.NewMultiIndex[uint64, uint64, types.Rewards](
+				storeKey, 19,
+				collections.Uint64KeyEncoder, // index key (IK)
+				collections.Uint64KeyEncoder, // primary key (PK)
+				func(v types.Rewards) uint64 { return v.EndVotePeriod },
+			),
+		},
+	)
+}
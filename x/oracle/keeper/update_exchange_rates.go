@@ -16,13 +16,19 @@ func (k Keeper) UpdateExchangeRates(ctx sdk.Context) {
 	k.Logger(ctx).Info("processing validator price votes")
 
 	validatorPerformances := k.newValidatorPerformances(ctx)
+	k.updateBondedSince(ctx, validatorPerformances)
 	pairBallotsMap, whitelistedPairs := k.getPairBallotsMapAndWhitelistedPairs(ctx, validatorPerformances)
 
 	k.resetExchangeRates(ctx, pairBallotsMap)
-	k.countVotesAndUpdateExchangeRates(ctx, pairBallotsMap, validatorPerformances)
+	pairPerformances := k.countVotesAndUpdateExchangeRates(ctx, pairBallotsMap, validatorPerformances)
 
 	k.registerMissedVotes(ctx, whitelistedPairs, validatorPerformances)
-	k.rewardBallotWinners(ctx, validatorPerformances)
+	k.recordValidatorPerformances(ctx, pairBallotsMap, whitelistedPairs, validatorPerformances)
+	k.applyRewardReductions(ctx, validatorPerformances)
+	for _, performances := range pairPerformances {
+		k.applyRewardReductions(ctx, performances)
+	}
+	k.rewardBallotWinners(ctx, validatorPerformances, pairPerformances)
 
 	params, _ := k.Params.Get(ctx)
 	k.clearVotesAndPreVotes(ctx, params.VotePeriod)
@@ -30,30 +36,53 @@ func (k Keeper) UpdateExchangeRates(ctx sdk.Context) {
 }
 
 // registerMissedVotes it parses all validators performance and increases the missed vote of those that did not vote.
+// Misses of validators in an announced maintenance window are not counted.
 func (k Keeper) registerMissedVotes(ctx sdk.Context, whitelistedPairs set.Set[asset.Pair], validatorPerformances types.ValidatorPerformances) {
 	for _, validatorPerformance := range validatorPerformances {
 		if int(validatorPerformance.WinCount) == len(whitelistedPairs) {
 			continue
 		}
 
+		if k.IsUnderMaintenance(ctx, validatorPerformance.ValAddress) {
+			k.Logger(ctx).Info("vote miss excused by maintenance", "validator", validatorPerformance.ValAddress.String())
+			continue
+		}
+
 		k.MissCounters.Insert(ctx, validatorPerformance.ValAddress, k.MissCounters.GetOr(ctx, validatorPerformance.ValAddress, 0)+1)
 		k.Logger(ctx).Info("vote miss", "validator", validatorPerformance.ValAddress.String())
 	}
 }
 
 // countVotesAndUpdateExchangeRates processes the votes and updates the ExchangeRates based on the results.
+// It returns the performances of the validators for every pair, used to distribute the rewards limited to a pair.
 func (k Keeper) countVotesAndUpdateExchangeRates(
 	ctx sdk.Context,
 	pairBallotsMap map[asset.Pair]types.ExchangeRateBallots,
 	validatorPerformances types.ValidatorPerformances,
-) {
-	rewardBand := k.RewardBand(ctx)
+) (pairPerformances map[asset.Pair]types.ValidatorPerformances) {
+	params, _ := k.Params.Get(ctx)
+	pairPerformances = make(map[asset.Pair]types.ValidatorPerformances, len(pairBallotsMap))
 
 	// Iterate through sorted keys for deterministic ordering.
 	orderedBallotsMap := omap.OrderedMap_Pair[types.ExchangeRateBallots](pairBallotsMap)
 	for pair := range orderedBallotsMap.Range() {
 		ballots := pairBallotsMap[pair]
-		exchangeRate := Tally(ballots, rewardBand, validatorPerformances)
+
+		performances := make(types.ValidatorPerformances, len(ballots))
+		for _, ballot := range ballots {
+			performances[ballot.Voter.String()] = types.NewValidatorPerformance(
+				validatorPerformances[ballot.Voter.String()].Power, ballot.Voter,
+			)
+		}
+		exchangeRate := Tally(ballots, params.RewardBand, performances, params.AggregationMethodForPair(pair))
+		pairPerformances[pair] = performances
+
+		for voterAddr, performance := range performances {
+			validatorPerformance := validatorPerformances[voterAddr]
+			validatorPerformance.RewardWeight += performance.RewardWeight
+			validatorPerformance.WinCount += performance.WinCount
+			validatorPerformances[voterAddr] = validatorPerformance
+		}
 
 		k.SetPrice(ctx, pair, exchangeRate)
 
@@ -63,6 +92,8 @@ func (k Keeper) countVotesAndUpdateExchangeRates(
 			TimestampMs: ctx.BlockTime().UnixMilli(),
 		})
 	}
+
+	return pairPerformances
 }
 
 // getPairBallotsMapAndWhitelistedPairs returns a map of pairs and ballots excluding invalid Ballots
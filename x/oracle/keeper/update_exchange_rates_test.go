@@ -208,7 +208,7 @@ func TestOracleTally(t *testing.T) {
 		}
 	}
 
-	tallyMedian := Tally(ballot, fixture.OracleKeeper.RewardBand(fixture.Ctx), validatorClaimMap)
+	tallyMedian := Tally(ballot, fixture.OracleKeeper.RewardBand(fixture.Ctx), validatorClaimMap, types.AggregationMethod_WEIGHTED_MEDIAN)
 
 	assert.Equal(t, expectedValidatorClaimMap, validatorClaimMap)
 	assert.Equal(t, tallyMedian.MulInt64(100).TruncateInt(), weightedMedian.MulInt64(100).TruncateInt())
@@ -462,3 +462,30 @@ func TestWhitelistedPairs(t *testing.T) {
 	assert.Equal(t, uint64(1), fixture.OracleKeeper.MissCounters.GetOr(fixture.Ctx, ValAddrs[2], 0))
 	assert.Equal(t, uint64(1), fixture.OracleKeeper.MissCounters.GetOr(fixture.Ctx, ValAddrs[2], 0))
 }
+
+func TestOracleAggregationMethod(t *testing.T) {
+	fixture, msgServer := Setup(t)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	params, err := fixture.OracleKeeper.Params.Get(fixture.Ctx)
+	require.NoError(t, err)
+	params.AggregationMethods = []types.PairAggregationMethod{
+		{Pair: pair, Method: types.AggregationMethod_TRIMMED_MEAN},
+	}
+	fixture.OracleKeeper.Params.Set(fixture.Ctx, params)
+
+	// all validators have the same power, so the trimmed mean drops the lowest
+	// and highest vote and a quarter of the second lowest and second highest
+	rates := []int64{100, 110, 120, 130, 1000}
+	for valIdx, rate := range rates {
+		MakeAggregatePrevoteAndVote(t, fixture, msgServer, 0, types.ExchangeRateTuples{
+			{Pair: pair, ExchangeRate: sdk.NewDec(rate)},
+		}, valIdx)
+	}
+	fixture.OracleKeeper.UpdateExchangeRates(fixture.Ctx)
+
+	rate, err := fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, pair)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(120), rate.ExchangeRate)
+	assert.Equal(t, types.AggregationMethod_WEIGHTED_MEDIAN, params.AggregationMethodForPair(asset.Registry.Pair(denoms.ETH, denoms.NUSD)))
+}
@@ -116,6 +116,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 		[]types.AggregateExchangeRateVote{},
 		[]asset.Pair{},
 		[]types.Rewards{},
+		[]types.ValidatorPerformanceRecord{},
+		[]types.ValidatorPenaltyState{},
+		[]types.MaintenanceWindow{},
+		[]types.ValidatorBondedSince{},
+		[]types.ExchangeRateTuple{},
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis, "", " ")
@@ -0,0 +1,160 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TrimmedMeanFraction is the proportion of the voting power trimmed from each
+// end of the sorted ballots by the TRIMMED_MEAN aggregation.
+var TrimmedMeanFraction = sdk.NewDecWithPrec(25, 2)
+
+// BallotAggregator computes the exchange rate of a pair from its ballots.
+type BallotAggregator interface {
+	Aggregate(ballots ExchangeRateBallots) sdk.Dec
+}
+
+var (
+	_ BallotAggregator = WeightedMedianAggregator{}
+	_ BallotAggregator = TrimmedMeanAggregator{}
+	_ BallotAggregator = VolumeWeightedMedianAggregator{}
+	_ BallotAggregator = TwapMedianAggregator{}
+)
+
+// Aggregator returns the BallotAggregator implementing the method. Unknown
+// methods fall back to the weighted median.
+func (m AggregationMethod) Aggregator() BallotAggregator {
+	switch m {
+	case AggregationMethod_TRIMMED_MEAN:
+		return TrimmedMeanAggregator{TrimFraction: TrimmedMeanFraction}
+	case AggregationMethod_VOLUME_WEIGHTED_MEDIAN:
+		return VolumeWeightedMedianAggregator{}
+	case AggregationMethod_TWAP_MEDIAN:
+		return TwapMedianAggregator{}
+	default:
+		return WeightedMedianAggregator{}
+	}
+}
+
+// Aggregate computes the exchange rate of the ballots with the given method.
+func (pb ExchangeRateBallots) Aggregate(method AggregationMethod) sdk.Dec {
+	return method.Aggregator().Aggregate(pb)
+}
+
+// WeightedMedianAggregator aggregates ballots into their power weighted median.
+type WeightedMedianAggregator struct{}
+
+func (WeightedMedianAggregator) Aggregate(ballots ExchangeRateBallots) sdk.Dec {
+	return ballots.WeightedMedianWithAssertion()
+}
+
+// TrimmedMeanAggregator aggregates ballots into their power weighted mean after
+// discarding TrimFraction of the voting power from each end of the sorted
+// ballots. A ballot straddling a cut-off only counts with its remaining power.
+type TrimmedMeanAggregator struct {
+	TrimFraction sdk.Dec
+}
+
+func (a TrimmedMeanAggregator) Aggregate(ballots ExchangeRateBallots) sdk.Dec {
+	sort.Sort(ballots)
+
+	totalPower := sdk.NewDec(ballots.Power())
+	if !totalPower.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	lower := totalPower.Mul(a.TrimFraction)
+	upper := totalPower.Sub(lower)
+
+	sum, weight := sdk.ZeroDec(), sdk.ZeroDec()
+	cumulative := sdk.ZeroDec()
+	for _, ballot := range ballots {
+		start := cumulative
+		end := cumulative.Add(sdk.NewDec(ballot.Power))
+		cumulative = end
+
+		overlap := sdk.MinDec(end, upper).Sub(sdk.MaxDec(start, lower))
+		if !overlap.IsPositive() {
+			continue
+		}
+
+		sum = sum.Add(ballot.ExchangeRate.Mul(overlap))
+		weight = weight.Add(overlap)
+	}
+
+	if !weight.IsPositive() {
+		return ballots.WeightedMedian()
+	}
+
+	return sum.Quo(weight)
+}
+
+// VolumeWeightedMedianAggregator aggregates ballots into their median weighted
+// by the voting power times the volume reported with the vote. Volumes are
+// capped at the power weighted median volume, so that a validator cannot gain
+// weight by inflating its reported volume. If no volume is reported it falls
+// back to the power weighted median.
+type VolumeWeightedMedianAggregator struct{}
+
+func (VolumeWeightedMedianAggregator) Aggregate(ballots ExchangeRateBallots) sdk.Dec {
+	volumes := make(ExchangeRateBallots, 0, len(ballots))
+	for _, ballot := range ballots {
+		if ballot.Power <= 0 {
+			continue
+		}
+		volume := ballot.Volume
+		if volume.IsNil() {
+			volume = sdk.ZeroDec()
+		}
+		// reuse the ballot sorting to compute the weighted median volume
+		volumes = append(volumes, ExchangeRateBallot{ExchangeRate: volume, Power: ballot.Power})
+	}
+	volumeCap := volumes.WeightedMedianWithAssertion()
+	if !volumeCap.IsPositive() {
+		return ballots.WeightedMedianWithAssertion()
+	}
+
+	sort.Sort(ballots)
+
+	weights := make([]sdk.Dec, len(ballots))
+	totalWeight := sdk.ZeroDec()
+	for i, ballot := range ballots {
+		weights[i] = sdk.ZeroDec()
+		if ballot.Power <= 0 || ballot.Volume.IsNil() {
+			continue
+		}
+		weights[i] = sdk.MinDec(ballot.Volume, volumeCap).MulInt64(ballot.Power)
+		totalWeight = totalWeight.Add(weights[i])
+	}
+
+	half := totalWeight.QuoInt64(2)
+	pivot := sdk.ZeroDec()
+	for i, ballot := range ballots {
+		pivot = pivot.Add(weights[i])
+		if pivot.IsPositive() && pivot.GTE(half) {
+			return ballot.ExchangeRate
+		}
+	}
+
+	return sdk.ZeroDec()
+}
+
+// TwapMedianAggregator aggregates ballots into the power weighted median of
+// the time-weighted average prices reported with the votes. It is meant for
+// pairs whose price feeders submit TWAPs, which are already smoothed over time.
+// A vote without a TWAP counts with its exchange rate, so that a validator
+// cannot take over the pair by being the only one reporting a TWAP.
+type TwapMedianAggregator struct{}
+
+func (TwapMedianAggregator) Aggregate(ballots ExchangeRateBallots) sdk.Dec {
+	twaps := make(ExchangeRateBallots, 0, len(ballots))
+	for _, ballot := range ballots {
+		if !ballot.Twap.IsNil() && ballot.Twap.IsPositive() {
+			ballot.ExchangeRate = ballot.Twap
+		}
+		twaps = append(twaps, ballot)
+	}
+
+	return twaps.WeightedMedianWithAssertion()
+}
@@ -0,0 +1,222 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+type testVote struct {
+	rate   string
+	power  int64
+	volume string // empty if not reported
+	twap   string // empty if not reported
+}
+
+func makeBallots(votes ...testVote) types.ExchangeRateBallots {
+	ballots := types.ExchangeRateBallots{}
+	for _, vote := range votes {
+		ballot := types.NewExchangeRateBallot(
+			sdk.MustNewDecFromStr(vote.rate),
+			asset.Registry.Pair(denoms.ETH, denoms.NUSD),
+			sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()),
+			vote.power,
+		)
+		if vote.volume != "" {
+			ballot.Volume = sdk.MustNewDecFromStr(vote.volume)
+		}
+		if vote.twap != "" {
+			ballot.Twap = sdk.MustNewDecFromStr(vote.twap)
+		}
+		ballots = append(ballots, ballot)
+	}
+	return ballots
+}
+
+func TestAggregate(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   types.AggregationMethod
+		votes    []testVote
+		expected sdk.Dec
+	}{
+		{
+			name:   "weighted median",
+			method: types.AggregationMethod_WEIGHTED_MEDIAN,
+			votes: []testVote{
+				{rate: "1", power: 1}, {rate: "2", power: 1}, {rate: "10", power: 100}, {rate: "100000", power: 1},
+			},
+			expected: sdk.NewDec(10),
+		},
+		{
+			name:   "trimmed mean drops the outer quarters",
+			method: types.AggregationMethod_TRIMMED_MEAN,
+			votes: []testVote{
+				{rate: "1", power: 1}, {rate: "2", power: 1}, {rate: "3", power: 1}, {rate: "4", power: 1},
+			},
+			expected: sdk.MustNewDecFromStr("2.5"),
+		},
+		{
+			name:   "trimmed mean counts the remaining power of straddling votes",
+			method: types.AggregationMethod_TRIMMED_MEAN,
+			votes: []testVote{
+				{rate: "1", power: 2}, {rate: "3", power: 2},
+			},
+			expected: sdk.NewDec(2),
+		},
+		{
+			name:   "trimmed mean ignores abstain votes",
+			method: types.AggregationMethod_TRIMMED_MEAN,
+			votes: []testVote{
+				{rate: "0", power: 0}, {rate: "2", power: 1}, {rate: "2", power: 1},
+			},
+			expected: sdk.NewDec(2),
+		},
+		{
+			name:   "volume weighted median",
+			method: types.AggregationMethod_VOLUME_WEIGHTED_MEDIAN,
+			votes: []testVote{
+				{rate: "1", power: 10, volume: "0.1"}, {rate: "2", power: 10, volume: "0.1"},
+				{rate: "3", power: 10, volume: "1"}, {rate: "4", power: 10, volume: "1"}, {rate: "5", power: 10, volume: "1"},
+			},
+			expected: sdk.NewDec(4),
+		},
+		{
+			name:   "volume weighted median without volumes",
+			method: types.AggregationMethod_VOLUME_WEIGHTED_MEDIAN,
+			votes: []testVote{
+				{rate: "1", power: 1}, {rate: "2", power: 1}, {rate: "10", power: 100},
+			},
+			expected: sdk.NewDec(10),
+		},
+		{
+			name:   "twap median weighted by power",
+			method: types.AggregationMethod_TWAP_MEDIAN,
+			votes: []testVote{
+				{rate: "3", power: 1, twap: "3"}, {rate: "1.1", power: 100, twap: "1"}, {rate: "2", power: 1, twap: "2"},
+			},
+			expected: sdk.NewDec(1),
+		},
+		{
+			name:   "twap median uses the twaps over the exchange rates",
+			method: types.AggregationMethod_TWAP_MEDIAN,
+			votes: []testVote{
+				{rate: "10", power: 10, twap: "4"}, {rate: "10", power: 10, twap: "5"}, {rate: "10", power: 10, twap: "6"},
+			},
+			expected: sdk.NewDec(5),
+		},
+		{
+			name:   "twap median counts votes without twap with their exchange rate",
+			method: types.AggregationMethod_TWAP_MEDIAN,
+			votes: []testVote{
+				{rate: "1000", power: 1, twap: "1000"}, {rate: "4", power: 10}, {rate: "5", power: 10}, {rate: "0", power: 0, twap: "2000"},
+			},
+			expected: sdk.NewDec(4),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, makeBallots(tc.votes...).Aggregate(tc.method))
+		})
+	}
+
+	for method := range types.AggregationMethod_name {
+		require.Equal(t, sdk.ZeroDec(), types.ExchangeRateBallots{}.Aggregate(types.AggregationMethod(method)))
+	}
+}
+
+// TestAggregateManipulationResistance checks that a minority of voters
+// submitting extreme prices cannot move the result outside of the range of the
+// honest votes.
+func TestAggregateManipulationResistance(t *testing.T) {
+	honest := []testVote{
+		{rate: "100", power: 10, volume: "1000"},
+		{rate: "101", power: 10, volume: "1200"},
+		{rate: "102", power: 10, volume: "900"},
+		{rate: "103", power: 10, volume: "1100"},
+		{rate: "104", power: 10, volume: "1000"},
+	}
+	honestMin, honestMax := sdk.NewDec(100), sdk.NewDec(104)
+
+	tests := []struct {
+		name     string
+		method   types.AggregationMethod
+		attacker []testVote
+	}{
+		{
+			name:     "weighted median, high price with 44% of the power",
+			method:   types.AggregationMethod_WEIGHTED_MEDIAN,
+			attacker: []testVote{{rate: "1000000", power: 40}},
+		},
+		{
+			name:     "weighted median, low price with 44% of the power",
+			method:   types.AggregationMethod_WEIGHTED_MEDIAN,
+			attacker: []testVote{{rate: "0.000001", power: 40}},
+		},
+		{
+			name:     "trimmed mean, high price with 20% of the power",
+			method:   types.AggregationMethod_TRIMMED_MEAN,
+			attacker: []testVote{{rate: "1000000", power: 12}},
+		},
+		{
+			name:     "trimmed mean, prices on both ends with 20% of the power each",
+			method:   types.AggregationMethod_TRIMMED_MEAN,
+			attacker: []testVote{{rate: "1000000", power: 16}, {rate: "0.000001", power: 16}},
+		},
+		{
+			name:     "volume weighted median, inflated volume",
+			method:   types.AggregationMethod_VOLUME_WEIGHTED_MEDIAN,
+			attacker: []testVote{{rate: "1000000", power: 10, volume: "1000000000"}},
+		},
+		{
+			name:   "volume weighted median, inflated volume with 44% of the power",
+			method: types.AggregationMethod_VOLUME_WEIGHTED_MEDIAN,
+			attacker: []testVote{
+				{rate: "1000000", power: 20, volume: "1000000000"},
+				{rate: "1000000", power: 20, volume: "1000000000"},
+			},
+		},
+		{
+			name:     "twap median, high twap with 44% of the power",
+			method:   types.AggregationMethod_TWAP_MEDIAN,
+			attacker: []testVote{{rate: "100", power: 40, twap: "1000000"}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ballots := makeBallots(append(append([]testVote{}, honest...), tc.attacker...)...)
+			result := ballots.Aggregate(tc.method)
+			require.Truef(t, result.GTE(honestMin) && result.LTE(honestMax),
+				"result %s outside of the honest range [%s, %s]", result, honestMin, honestMax)
+		})
+	}
+}
+
+// TestTwapMedianSybilResistance checks that splitting a minimal stake over many
+// validators does not give them any weight in the TWAP median.
+func TestTwapMedianSybilResistance(t *testing.T) {
+	honest := []testVote{
+		{rate: "100", power: 100, twap: "100"},
+		{rate: "101", power: 100, twap: "101"},
+		{rate: "102", power: 100, twap: "102"},
+	}
+
+	for _, numSybils := range []int{1, 10, 100, 250} {
+		votes := append([]testVote{}, honest...)
+		for i := 0; i < numSybils; i++ {
+			votes = append(votes, testVote{rate: "1000000", power: 1, twap: "1000000"})
+		}
+
+		result := makeBallots(votes...).Aggregate(types.AggregationMethod_TWAP_MEDIAN)
+		require.Truef(t, result.GTE(sdk.NewDec(100)) && result.LTE(sdk.NewDec(102)),
+			"%d sybils moved the result to %s", numSybils, result)
+	}
+}
@@ -19,7 +19,9 @@ type ExchangeRateBallot struct {
 	Pair         asset.Pair
 	ExchangeRate sdk.Dec // aka price
 	Voter        sdk.ValAddress
-	Power        int64 // how much tendermint consensus power this vote should have
+	Power        int64   // how much tendermint consensus power this vote should have
+	Volume       sdk.Dec // trading volume reported with the vote, nil if not reported
+	Twap         sdk.Dec // time-weighted average price reported with the vote, nil if not reported
 }
 
 // NewExchangeRateBallot returns a new ExchangeRateBallot instance
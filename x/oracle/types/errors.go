@@ -23,4 +23,7 @@ var (
 	ErrNoAggregateVote       = sdkerrors.Register(ModuleName, 12, "no aggregate vote")
 	ErrUnknownPair           = sdkerrors.Register(ModuleName, 13, "unknown pair")
 	ErrNoValidTWAP           = sdkerrors.Register(ModuleName, 14, "TWA price not found")
+	ErrInvalidMaintenance    = sdkerrors.Register(ModuleName, 15, "invalid maintenance window")
+	ErrInvalidFeederReward   = sdkerrors.Register(ModuleName, 16, "invalid feeder reward")
+	ErrNoValidEMA            = sdkerrors.Register(ModuleName, 17, "EMA price not found")
 )
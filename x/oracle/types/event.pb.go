@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -256,43 +257,337 @@ func (m *EventAggregatePrevote) GetFeeder() string {
 	return ""
 }
 
+// Emitted at the end of a slash window for every validator whose valid vote
+// rate fell below MinValidPerWindow. A WARN action is the on-chain warning.
+type EventOraclePenalty struct {
+	Validator string              `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Action    OraclePenaltyAction `protobuf:"varint,2,opt,name=action,proto3,enum=nibiru.oracle.v1.OraclePenaltyAction" json:"action,omitempty"`
+	// Strikes is the number of consecutive slash windows failed.
+	Strikes       uint64                                 `protobuf:"varint,3,opt,name=strikes,proto3" json:"strikes,omitempty"`
+	ValidVoteRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=valid_vote_rate,json=validVoteRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valid_vote_rate"`
+}
+
+func (m *EventOraclePenalty) Reset()         { *m = EventOraclePenalty{} }
+func (m *EventOraclePenalty) String() string { return proto.CompactTextString(m) }
+func (*EventOraclePenalty) ProtoMessage()    {}
+func (*EventOraclePenalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94ec441b793fc0ea, []int{4}
+}
+func (m *EventOraclePenalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOraclePenalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOraclePenalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOraclePenalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOraclePenalty.Merge(m, src)
+}
+func (m *EventOraclePenalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOraclePenalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOraclePenalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOraclePenalty proto.InternalMessageInfo
+
+func (m *EventOraclePenalty) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EventOraclePenalty) GetAction() OraclePenaltyAction {
+	if m != nil {
+		return m.Action
+	}
+	return OraclePenaltyAction_ORACLE_PENALTY_ACTION_UNSPECIFIED
+}
+
+func (m *EventOraclePenalty) GetStrikes() uint64 {
+	if m != nil {
+		return m.Strikes
+	}
+	return 0
+}
+
+// Emitted by MsgAnnounceMaintenance when a validator pre-announces downtime.
+type EventMaintenanceAnnounced struct {
+	Validator   string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *EventMaintenanceAnnounced) Reset()         { *m = EventMaintenanceAnnounced{} }
+func (m *EventMaintenanceAnnounced) String() string { return proto.CompactTextString(m) }
+func (*EventMaintenanceAnnounced) ProtoMessage()    {}
+func (*EventMaintenanceAnnounced) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94ec441b793fc0ea, []int{5}
+}
+func (m *EventMaintenanceAnnounced) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMaintenanceAnnounced) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMaintenanceAnnounced.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMaintenanceAnnounced) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMaintenanceAnnounced.Merge(m, src)
+}
+func (m *EventMaintenanceAnnounced) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMaintenanceAnnounced) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMaintenanceAnnounced.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMaintenanceAnnounced proto.InternalMessageInfo
+
+func (m *EventMaintenanceAnnounced) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EventMaintenanceAnnounced) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *EventMaintenanceAnnounced) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// Emitted by MsgCreateFeederReward when a reward program is funded.
+type EventFeederRewardCreated struct {
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Funder string `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty"`
+	// Pair is empty if the rewards go to all ballot winners.
+	Pair        string                                   `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Coins       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	VotePeriods uint64                                   `protobuf:"varint,5,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty"`
+}
+
+func (m *EventFeederRewardCreated) Reset()         { *m = EventFeederRewardCreated{} }
+func (m *EventFeederRewardCreated) String() string { return proto.CompactTextString(m) }
+func (*EventFeederRewardCreated) ProtoMessage()    {}
+func (*EventFeederRewardCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94ec441b793fc0ea, []int{6}
+}
+func (m *EventFeederRewardCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeederRewardCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeederRewardCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeederRewardCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeederRewardCreated.Merge(m, src)
+}
+func (m *EventFeederRewardCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeederRewardCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeederRewardCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeederRewardCreated proto.InternalMessageInfo
+
+func (m *EventFeederRewardCreated) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventFeederRewardCreated) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *EventFeederRewardCreated) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *EventFeederRewardCreated) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *EventFeederRewardCreated) GetVotePeriods() uint64 {
+	if m != nil {
+		return m.VotePeriods
+	}
+	return 0
+}
+
+// Emitted when coins of a pair-limited reward program are returned to its
+// funder, because the pair had no ballot winners in a vote period or was
+// removed from the whitelist.
+type EventFeederRewardRefunded struct {
+	Id     uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Funder string                                   `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty"`
+	Pair   string                                   `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Coins  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *EventFeederRewardRefunded) Reset()         { *m = EventFeederRewardRefunded{} }
+func (m *EventFeederRewardRefunded) String() string { return proto.CompactTextString(m) }
+func (*EventFeederRewardRefunded) ProtoMessage()    {}
+func (*EventFeederRewardRefunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94ec441b793fc0ea, []int{7}
+}
+func (m *EventFeederRewardRefunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeederRewardRefunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeederRewardRefunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeederRewardRefunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeederRewardRefunded.Merge(m, src)
+}
+func (m *EventFeederRewardRefunded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeederRewardRefunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeederRewardRefunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeederRewardRefunded proto.InternalMessageInfo
+
+func (m *EventFeederRewardRefunded) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventFeederRewardRefunded) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *EventFeederRewardRefunded) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *EventFeederRewardRefunded) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventPriceUpdate)(nil), "nibiru.oracle.v1.EventPriceUpdate")
 	proto.RegisterType((*EventDelegateFeederConsent)(nil), "nibiru.oracle.v1.EventDelegateFeederConsent")
 	proto.RegisterType((*EventAggregateVote)(nil), "nibiru.oracle.v1.EventAggregateVote")
 	proto.RegisterType((*EventAggregatePrevote)(nil), "nibiru.oracle.v1.EventAggregatePrevote")
+	proto.RegisterType((*EventOraclePenalty)(nil), "nibiru.oracle.v1.EventOraclePenalty")
+	proto.RegisterType((*EventMaintenanceAnnounced)(nil), "nibiru.oracle.v1.EventMaintenanceAnnounced")
+	proto.RegisterType((*EventFeederRewardCreated)(nil), "nibiru.oracle.v1.EventFeederRewardCreated")
+	proto.RegisterType((*EventFeederRewardRefunded)(nil), "nibiru.oracle.v1.EventFeederRewardRefunded")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/event.proto", fileDescriptor_94ec441b793fc0ea) }
 
 var fileDescriptor_94ec441b793fc0ea = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcd, 0x8e, 0xd3, 0x30,
-	0x14, 0x85, 0x63, 0x0a, 0x95, 0xc6, 0xc3, 0x62, 0x64, 0x01, 0xaa, 0xa2, 0x92, 0x0e, 0x41, 0x42,
-	0x5d, 0x80, 0xad, 0x81, 0x27, 0xa0, 0xd3, 0x99, 0xdd, 0xa0, 0x51, 0xc4, 0x8f, 0xc4, 0x06, 0xb9,
-	0xc9, 0xc5, 0xb5, 0x48, 0x6c, 0xcb, 0x76, 0xa3, 0xe1, 0x29, 0xe0, 0x1d, 0xd8, 0xf1, 0x24, 0xb3,
-	0xec, 0x12, 0xb1, 0x28, 0xa8, 0x7d, 0x11, 0x14, 0x27, 0xe5, 0xaf, 0xbb, 0xae, 0x72, 0x73, 0xce,
-	0xf5, 0xc9, 0x17, 0xdf, 0x8b, 0x87, 0x4a, 0xce, 0xa4, 0x5d, 0x30, 0x6d, 0x79, 0x5e, 0x02, 0xab,
-	0x4f, 0x18, 0xd4, 0xa0, 0x3c, 0x35, 0x56, 0x7b, 0x4d, 0x8e, 0x5a, 0x97, 0xb6, 0x2e, 0xad, 0x4f,
-	0xe2, 0xfb, 0x3b, 0xfd, 0x9d, 0x17, 0x0e, 0xc4, 0x77, 0x84, 0x16, 0x3a, 0x94, 0xac, 0xa9, 0x3a,
-	0x75, 0x28, 0xb4, 0x16, 0x25, 0x30, 0x6e, 0x24, 0xe3, 0x4a, 0x69, 0xcf, 0xbd, 0xd4, 0xca, 0xb5,
-	0x6e, 0xfa, 0x09, 0xe1, 0xa3, 0xb3, 0xe6, 0xa3, 0x97, 0x56, 0xe6, 0xf0, 0xca, 0x14, 0xdc, 0x03,
-	0x21, 0xf8, 0xa6, 0xe1, 0xd2, 0x0e, 0xd0, 0x31, 0x1a, 0x1f, 0x64, 0xa1, 0x26, 0x53, 0x7c, 0xcb,
-	0x34, 0x2d, 0x83, 0x1b, 0x8d, 0x38, 0xa1, 0xd7, 0xab, 0x51, 0xf4, 0x7d, 0x35, 0x7a, 0x24, 0xa4,
-	0x9f, 0x2f, 0x66, 0x34, 0xd7, 0x15, 0xcb, 0xb5, 0xab, 0xb4, 0xeb, 0x1e, 0x4f, 0x5c, 0xf1, 0x81,
-	0xf9, 0x8f, 0x06, 0x1c, 0x9d, 0x42, 0x9e, 0xb5, 0x87, 0xc9, 0x03, 0x7c, 0xdb, 0xcb, 0x0a, 0x9c,
-	0xe7, 0x95, 0x79, 0x57, 0xb9, 0x41, 0xef, 0x18, 0x8d, 0x7b, 0xd9, 0xe1, 0x6f, 0xed, 0xc2, 0xa5,
-	0x19, 0x8e, 0x03, 0xd0, 0x14, 0x4a, 0x10, 0xdc, 0xc3, 0x39, 0x40, 0x01, 0xf6, 0x54, 0x2b, 0x07,
-	0xca, 0x93, 0x21, 0x3e, 0xa8, 0x79, 0x29, 0x0b, 0xee, 0xf5, 0x96, 0xef, 0x8f, 0x40, 0xee, 0xe1,
-	0xfe, 0xfb, 0xd0, 0xde, 0x52, 0x66, 0xdd, 0x5b, 0xfa, 0x05, 0x61, 0x12, 0x42, 0x9f, 0x0b, 0x61,
-	0x43, 0xea, 0x6b, 0xed, 0x61, 0xbf, 0x30, 0xf2, 0x06, 0xf7, 0xc3, 0xcf, 0x34, 0xf4, 0xbd, 0xf1,
-	0xe1, 0xd3, 0x87, 0xf4, 0xff, 0x41, 0xd1, 0xb3, 0xab, 0x7c, 0xce, 0x95, 0x80, 0x8c, 0x7b, 0x78,
-	0xb9, 0x30, 0x25, 0x4c, 0xe2, 0xe6, 0xbe, 0xbe, 0xfe, 0x18, 0x91, 0x1d, 0xcb, 0x65, 0x5d, 0x5c,
-	0x7a, 0x81, 0xef, 0xfe, 0x0b, 0x79, 0x69, 0xa1, 0xde, 0x9b, 0x73, 0x72, 0x7e, 0xbd, 0x4e, 0xd0,
-	0x72, 0x9d, 0xa0, 0x9f, 0xeb, 0x04, 0x7d, 0xde, 0x24, 0xd1, 0x72, 0x93, 0x44, 0xdf, 0x36, 0x49,
-	0xf4, 0xf6, 0xf1, 0x5f, 0x43, 0x7b, 0x11, 0xd8, 0x4f, 0xe7, 0x5c, 0x2a, 0xd6, 0xad, 0xd7, 0xd5,
-	0x76, 0xc1, 0xc2, 0xf8, 0x66, 0xfd, 0xb0, 0x29, 0xcf, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x3b,
-	0xea, 0x2b, 0x3c, 0xae, 0x02, 0x00, 0x00,
+	// 659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xc1, 0x6e, 0x13, 0x3d,
+	0x10, 0xce, 0x36, 0x69, 0x7e, 0xd5, 0xed, 0x5f, 0xaa, 0x15, 0xa0, 0x6d, 0xd4, 0xa6, 0xed, 0x22,
+	0x50, 0x0e, 0xb0, 0x4b, 0xcb, 0x99, 0x43, 0x93, 0xb6, 0xe2, 0x52, 0x88, 0x2c, 0x28, 0x12, 0x97,
+	0xc8, 0xd9, 0x1d, 0x36, 0x56, 0x13, 0x7b, 0x65, 0x3b, 0xa1, 0x15, 0x0f, 0x01, 0xef, 0xc0, 0x8d,
+	0x47, 0xe0, 0x09, 0x7a, 0xec, 0x11, 0x21, 0x54, 0x50, 0xf3, 0x22, 0xc8, 0xe3, 0x4d, 0x69, 0xc9,
+	0xa1, 0xa8, 0x27, 0x4e, 0xbb, 0xfe, 0x66, 0xfc, 0xf9, 0xfb, 0x3c, 0x33, 0x26, 0x2b, 0x82, 0x77,
+	0xb9, 0x1a, 0xc6, 0x52, 0xb1, 0xa4, 0x0f, 0xf1, 0x68, 0x33, 0x86, 0x11, 0x08, 0x13, 0xe5, 0x4a,
+	0x1a, 0xe9, 0x2f, 0xb9, 0x68, 0xe4, 0xa2, 0xd1, 0x68, 0xb3, 0xb6, 0x3a, 0x95, 0x5f, 0xc4, 0x70,
+	0x43, 0xed, 0x76, 0x26, 0x33, 0x89, 0xbf, 0xb1, 0xfd, 0x2b, 0xd0, 0x95, 0x4c, 0xca, 0xac, 0x0f,
+	0x31, 0xcb, 0x79, 0xcc, 0x84, 0x90, 0x86, 0x19, 0x2e, 0x85, 0x2e, 0xa2, 0xf5, 0x44, 0xea, 0x81,
+	0xd4, 0x71, 0x97, 0x69, 0x4b, 0xd8, 0x05, 0xc3, 0x36, 0xe3, 0x44, 0x72, 0xe1, 0xe2, 0xe1, 0x07,
+	0x8f, 0x2c, 0xed, 0x5a, 0x51, 0x6d, 0xc5, 0x13, 0x78, 0x95, 0xa7, 0xcc, 0x80, 0xef, 0x93, 0x4a,
+	0xce, 0xb8, 0x0a, 0xbc, 0x75, 0xaf, 0x31, 0x47, 0xf1, 0xdf, 0xdf, 0x21, 0xb3, 0xb9, 0x4d, 0x09,
+	0x66, 0x2c, 0xd8, 0x8c, 0x4e, 0xce, 0xd6, 0x4a, 0xdf, 0xce, 0xd6, 0x1e, 0x64, 0xdc, 0xf4, 0x86,
+	0xdd, 0x28, 0x91, 0x83, 0xb8, 0x38, 0xca, 0x7d, 0x1e, 0xe9, 0xf4, 0x30, 0x36, 0xc7, 0x39, 0xe8,
+	0x68, 0x07, 0x12, 0xea, 0x36, 0xfb, 0x1b, 0x64, 0xc1, 0xf0, 0x01, 0x68, 0xc3, 0x06, 0x79, 0x67,
+	0xa0, 0x83, 0xf2, 0xba, 0xd7, 0x28, 0xd3, 0xf9, 0x0b, 0x6c, 0x5f, 0x87, 0x94, 0xd4, 0x50, 0xd0,
+	0x0e, 0xf4, 0x21, 0x63, 0x06, 0xf6, 0x00, 0x52, 0x50, 0x2d, 0x29, 0x34, 0x08, 0xe3, 0xaf, 0x90,
+	0xb9, 0x11, 0xeb, 0xf3, 0x94, 0x19, 0x39, 0xd1, 0xf7, 0x1b, 0xf0, 0xef, 0x92, 0xea, 0x5b, 0x4c,
+	0x77, 0x2a, 0x69, 0xb1, 0x0a, 0x3f, 0x79, 0xc4, 0x47, 0xd2, 0xed, 0x2c, 0x53, 0xc8, 0x7a, 0x20,
+	0x0d, 0xdc, 0x8c, 0xcc, 0x7f, 0x4d, 0xaa, 0x68, 0xc6, 0xaa, 0x2f, 0x37, 0xe6, 0xb7, 0xee, 0x45,
+	0x7f, 0x16, 0x32, 0xda, 0x3d, 0x4a, 0x7a, 0x4c, 0x64, 0x40, 0x99, 0x81, 0x97, 0xc3, 0xbc, 0x0f,
+	0xcd, 0x9a, 0xbd, 0xaf, 0xcf, 0x3f, 0xd6, 0xfc, 0xa9, 0x90, 0xa6, 0x05, 0x5d, 0xb8, 0x4f, 0xee,
+	0x5c, 0x15, 0xd9, 0x56, 0x30, 0xba, 0xb1, 0xce, 0x70, 0x3c, 0x31, 0xfd, 0x02, 0x75, 0xb5, 0x41,
+	0xb0, 0xbe, 0x39, 0xbe, 0x86, 0xec, 0x29, 0xa9, 0xb2, 0xc4, 0x36, 0x10, 0x92, 0x2d, 0x6e, 0xdd,
+	0x9f, 0x36, 0x77, 0x85, 0x6e, 0x1b, 0x93, 0x69, 0xb1, 0xc9, 0x0f, 0xc8, 0x7f, 0xda, 0x28, 0x7e,
+	0x08, 0xae, 0xb4, 0x15, 0x3a, 0x59, 0xfa, 0x07, 0xe4, 0x16, 0x9e, 0xd2, 0xb1, 0x8e, 0x3a, 0x8a,
+	0x19, 0x08, 0x2a, 0x37, 0xea, 0xa4, 0xff, 0x91, 0xc6, 0xd6, 0xcf, 0x5e, 0x61, 0xf8, 0x9e, 0x2c,
+	0xa3, 0xc9, 0x7d, 0xc6, 0x85, 0x01, 0xc1, 0x44, 0x02, 0xdb, 0x42, 0xc8, 0xa1, 0x48, 0x20, 0xbd,
+	0xc6, 0xeb, 0x06, 0x59, 0xd0, 0x86, 0x29, 0xd3, 0xe9, 0x01, 0xcf, 0x7a, 0x06, 0x1d, 0x57, 0xe8,
+	0x3c, 0x62, 0xcf, 0x10, 0xf2, 0x57, 0x09, 0x01, 0x91, 0x4e, 0x12, 0x9c, 0xa5, 0x39, 0x10, 0xa9,
+	0x0b, 0x87, 0xdf, 0x3d, 0x12, 0xe0, 0xe9, 0xae, 0x49, 0x29, 0xbc, 0x63, 0x2a, 0x6d, 0x29, 0x60,
+	0x06, 0x52, 0x7f, 0x91, 0xcc, 0xf0, 0x14, 0x4f, 0xad, 0xd0, 0x19, 0x9e, 0x62, 0x9d, 0x86, 0xe2,
+	0x72, 0x9d, 0x70, 0x75, 0x31, 0x6d, 0xe5, 0x4b, 0xd3, 0xc6, 0xc8, 0xac, 0x1d, 0x52, 0x1d, 0x54,
+	0xb0, 0xc5, 0x96, 0x23, 0x77, 0x15, 0x91, 0x1d, 0xe3, 0xa8, 0x18, 0xe3, 0xa8, 0x25, 0xb9, 0x68,
+	0x3e, 0x2e, 0x1a, 0xab, 0xf1, 0x17, 0xd7, 0x67, 0x37, 0x68, 0xea, 0x98, 0xad, 0x7b, 0x2c, 0x45,
+	0x0e, 0x8a, 0xcb, 0x54, 0x07, 0xb3, 0xce, 0xbd, 0xc5, 0xda, 0x0e, 0x0a, 0xbf, 0x78, 0x64, 0x79,
+	0xca, 0x1e, 0x05, 0xd4, 0xfd, 0xaf, 0xfb, 0x6b, 0xee, 0x9d, 0x9c, 0xd7, 0xbd, 0xd3, 0xf3, 0xba,
+	0xf7, 0xf3, 0xbc, 0xee, 0x7d, 0x1c, 0xd7, 0x4b, 0xa7, 0xe3, 0x7a, 0xe9, 0xeb, 0xb8, 0x5e, 0x7a,
+	0xf3, 0xf0, 0x12, 0xd5, 0x73, 0xec, 0xee, 0x56, 0x8f, 0x71, 0x11, 0x17, 0xaf, 0xef, 0xd1, 0xe4,
+	0xfd, 0x45, 0xd2, 0x6e, 0x15, 0x1f, 0xca, 0x27, 0xbf, 0x06, 0x00, 0x8a, 0x1b, 0xdf, 0x9b, 0xcd,
+	0x05, 0x00, 0x00,
 }
 
 func (m *EventPriceUpdate) Marshal() (dAtA []byte, err error) {
@@ -465,99 +760,912 @@ func (m *EventAggregatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventOraclePenalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventPriceUpdate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Pair)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = m.Price.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.TimestampMs != 0 {
-		n += 1 + sovEvent(uint64(m.TimestampMs))
-	}
-	return n
+
+func (m *EventOraclePenalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventDelegateFeederConsent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventOraclePenalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	{
+		size := m.ValidVoteRate.Size()
+		i -= size
+		if _, err := m.ValidVoteRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = len(m.Feeder)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if m.Strikes != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Strikes))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.Action != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAggregateVote) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMaintenanceAnnounced) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMaintenanceAnnounced) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMaintenanceAnnounced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.EndHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Feeder)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.StartHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Prices) > 0 {
-		for _, e := range m.Prices {
-			l = e.Size()
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeederRewardCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeederRewardCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeederRewardCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotePeriods != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeederRewardRefunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeederRewardRefunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeederRewardRefunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventPriceUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.TimestampMs != 0 {
+		n += 1 + sovEvent(uint64(m.TimestampMs))
+	}
+	return n
+}
+
+func (m *EventDelegateFeederConsent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAggregateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventAggregatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOraclePenalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovEvent(uint64(m.Action))
+	}
+	if m.Strikes != 0 {
+		n += 1 + sovEvent(uint64(m.Strikes))
+	}
+	l = m.ValidVoteRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventMaintenanceAnnounced) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovEvent(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovEvent(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *EventFeederRewardCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvent(uint64(m.Id))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.VotePeriods != 0 {
+		n += 1 + sovEvent(uint64(m.VotePeriods))
+	}
+	return n
+}
+
+func (m *EventFeederRewardRefunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvent(uint64(m.Id))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
 			n += 1 + l + sovEvent(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventPriceUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPriceUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPriceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+			}
+			m.TimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDelegateFeederConsent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDelegateFeederConsent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDelegateFeederConsent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAggregateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAggregateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAggregateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, ExchangeRateTuple{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAggregatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAggregatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAggregatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventAggregatePrevote) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Feeder)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventPriceUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventOraclePenalty) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -580,15 +1688,15 @@ func (m *EventPriceUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPriceUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOraclePenalty: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPriceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOraclePenalty: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -616,11 +1724,49 @@ func (m *EventPriceUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pair = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= OraclePenaltyAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strikes", wireType)
+			}
+			m.Strikes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strikes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidVoteRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -648,29 +1794,10 @@ func (m *EventPriceUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ValidVoteRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
-			}
-			m.TimestampMs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimestampMs |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -692,7 +1819,7 @@ func (m *EventPriceUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDelegateFeederConsent) Unmarshal(dAtA []byte) error {
+func (m *EventMaintenanceAnnounced) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -715,10 +1842,10 @@ func (m *EventDelegateFeederConsent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDelegateFeederConsent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMaintenanceAnnounced: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDelegateFeederConsent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMaintenanceAnnounced: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -754,10 +1881,10 @@ func (m *EventDelegateFeederConsent) Unmarshal(dAtA []byte) error {
 			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var stringLen uint64
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -767,24 +1894,30 @@ func (m *EventDelegateFeederConsent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Feeder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -806,7 +1939,7 @@ func (m *EventDelegateFeederConsent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAggregateVote) Unmarshal(dAtA []byte) error {
+func (m *EventFeederRewardCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -829,15 +1962,34 @@ func (m *EventAggregateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAggregateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFeederRewardCreated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAggregateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFeederRewardCreated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -865,11 +2017,11 @@ func (m *EventAggregateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Funder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -897,11 +2049,11 @@ func (m *EventAggregateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Feeder = string(dAtA[iNdEx:postIndex])
+			m.Pair = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -928,11 +2080,30 @@ func (m *EventAggregateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, ExchangeRateTuple{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
+			}
+			m.VotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -954,7 +2125,7 @@ func (m *EventAggregateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAggregatePrevote) Unmarshal(dAtA []byte) error {
+func (m *EventFeederRewardRefunded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -977,15 +2148,34 @@ func (m *EventAggregatePrevote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAggregatePrevote: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFeederRewardRefunded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAggregatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFeederRewardRefunded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1013,11 +2203,11 @@ func (m *EventAggregatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Funder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1045,7 +2235,41 @@ func (m *EventAggregatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Feeder = string(dAtA[iNdEx:postIndex])
+			m.Pair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
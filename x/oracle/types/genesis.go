@@ -16,6 +16,11 @@ func NewGenesisState(
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
 	pairs []asset.Pair,
 	rewards []Rewards,
+	performanceRecords []ValidatorPerformanceRecord,
+	penaltyStates []ValidatorPenaltyState,
+	maintenanceWindows []MaintenanceWindow,
+	bondedSince []ValidatorBondedSince,
+	emaPrices []ExchangeRateTuple,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                        params,
@@ -26,6 +31,11 @@ func NewGenesisState(
 		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
 		Pairs:                         pairs,
 		Rewards:                       rewards,
+		PerformanceRecords:            performanceRecords,
+		PenaltyStates:                 penaltyStates,
+		MaintenanceWindows:            maintenanceWindows,
+		BondedSince:                   bondedSince,
+		EmaPrices:                     emaPrices,
 	}
 }
 
@@ -39,7 +49,12 @@ func DefaultGenesisState() *GenesisState {
 		[]AggregateExchangeRatePrevote{},
 		[]AggregateExchangeRateVote{},
 		[]asset.Pair{},
-		[]Rewards{})
+		[]Rewards{},
+		[]ValidatorPerformanceRecord{},
+		[]ValidatorPenaltyState{},
+		[]MaintenanceWindow{},
+		[]ValidatorBondedSince{},
+		[]ExchangeRateTuple{})
 }
 
 // ValidateGenesis validates the oracle genesis state
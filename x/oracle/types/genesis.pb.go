@@ -35,6 +35,11 @@ type GenesisState struct {
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote                         `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
 	Pairs                         []github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,7,rep,name=pairs,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pairs"`
 	Rewards                       []Rewards                                           `protobuf:"bytes,8,rep,name=rewards,proto3" json:"rewards"`
+	PerformanceRecords            []ValidatorPerformanceRecord                        `protobuf:"bytes,9,rep,name=performance_records,json=performanceRecords,proto3" json:"performance_records"`
+	PenaltyStates                 []ValidatorPenaltyState                             `protobuf:"bytes,10,rep,name=penalty_states,json=penaltyStates,proto3" json:"penalty_states"`
+	MaintenanceWindows            []MaintenanceWindow                                 `protobuf:"bytes,11,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows"`
+	BondedSince                   []ValidatorBondedSince                              `protobuf:"bytes,12,rep,name=bonded_since,json=bondedSince,proto3" json:"bonded_since"`
+	EmaPrices                     ExchangeRateTuples                                  `protobuf:"bytes,13,rep,name=ema_prices,json=emaPrices,proto3,castrepeated=ExchangeRateTuples" json:"ema_prices"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -119,6 +124,41 @@ func (m *GenesisState) GetRewards() []Rewards {
 	return nil
 }
 
+func (m *GenesisState) GetPerformanceRecords() []ValidatorPerformanceRecord {
+	if m != nil {
+		return m.PerformanceRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPenaltyStates() []ValidatorPenaltyState {
+	if m != nil {
+		return m.PenaltyStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMaintenanceWindows() []MaintenanceWindow {
+	if m != nil {
+		return m.MaintenanceWindows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBondedSince() []ValidatorBondedSince {
+	if m != nil {
+		return m.BondedSince
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEmaPrices() ExchangeRateTuples {
+	if m != nil {
+		return m.EmaPrices
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -237,42 +277,51 @@ func init() {
 func init() { proto.RegisterFile("nibiru/oracle/v1/genesis.proto", fileDescriptor_d88ebb2fa2659942) }
 
 var fileDescriptor_d88ebb2fa2659942 = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x5f, 0x6b, 0x13, 0x4d,
-	0x14, 0xc6, 0xb3, 0xfd, 0xfb, 0x76, 0xd2, 0x94, 0x74, 0x78, 0x2f, 0xd6, 0x40, 0x36, 0x31, 0x22,
-	0x04, 0x2a, 0xbb, 0xa4, 0x82, 0xd0, 0xcb, 0xa6, 0x5a, 0xbd, 0x51, 0xc3, 0x2a, 0x0a, 0x82, 0x84,
-	0xc9, 0xee, 0xc9, 0x66, 0x20, 0xbb, 0xb3, 0xcc, 0x99, 0xc4, 0x7a, 0xe1, 0x77, 0xf0, 0x73, 0xf8,
-	0x49, 0x7a, 0x59, 0xbc, 0x12, 0x2f, 0xaa, 0x24, 0x5f, 0x44, 0x76, 0x66, 0xdb, 0xc4, 0x6c, 0xab,
-	0xde, 0x85, 0xf3, 0xfc, 0xce, 0xf3, 0x9c, 0x09, 0x4f, 0x42, 0x9c, 0x84, 0x0f, 0xb8, 0x9c, 0x78,
-	0x42, 0xb2, 0x60, 0x0c, 0xde, 0xb4, 0xe3, 0x45, 0x90, 0x00, 0x72, 0x74, 0x53, 0x29, 0x94, 0xa0,
-	0x55, 0xa3, 0xbb, 0x46, 0x77, 0xa7, 0x9d, 0xda, 0xff, 0x91, 0x88, 0x84, 0x16, 0xbd, 0xec, 0x93,
-	0xe1, 0x6a, 0xf5, 0x82, 0x4f, 0xbe, 0x61, 0x64, 0x27, 0x10, 0x18, 0x0b, 0xf4, 0x06, 0x0c, 0x33,
-	0x71, 0x00, 0x8a, 0x75, 0xbc, 0x40, 0xf0, 0xc4, 0xe8, 0xad, 0xaf, 0x9b, 0x64, 0xf7, 0xa9, 0x09,
-	0x7e, 0xa5, 0x98, 0x02, 0xfa, 0x88, 0x6c, 0xa5, 0x4c, 0xb2, 0x18, 0x6d, 0xab, 0x69, 0xb5, 0xcb,
-	0x87, 0xb6, 0xbb, 0x7a, 0x88, 0xdb, 0xd3, 0x7a, 0x77, 0xe3, 0xfc, 0xb2, 0x51, 0xf2, 0x73, 0x9a,
-	0xbe, 0x25, 0x74, 0x08, 0x10, 0x82, 0xec, 0x87, 0x30, 0x86, 0x88, 0x29, 0x2e, 0x12, 0xb4, 0xd7,
-	0x9a, 0xeb, 0xed, 0xf2, 0x61, 0xab, 0xe8, 0x71, 0xaa, 0xd9, 0xc7, 0xd7, 0x68, 0xee, 0xb6, 0x3f,
-	0x5c, 0x99, 0x23, 0x1d, 0x92, 0x3d, 0x38, 0x0b, 0x46, 0x2c, 0x89, 0xa0, 0x2f, 0x99, 0x02, 0xb4,
-	0xd7, 0xb5, 0xe9, 0xbd, 0xa2, 0xe9, 0x93, 0x9c, 0xf3, 0x99, 0x82, 0xd7, 0x93, 0x74, 0x0c, 0xdd,
-	0x5a, 0xe6, 0xfa, 0xe5, 0x47, 0x83, 0x16, 0x24, 0xf4, 0x2b, 0xb0, 0x34, 0x43, 0xfa, 0x8c, 0x54,
-	0x62, 0x8e, 0xd8, 0x0f, 0xc4, 0x24, 0x51, 0x20, 0xd1, 0xde, 0xd0, 0x31, 0xf5, 0x62, 0xcc, 0x73,
-	0x8e, 0x78, 0x62, 0xa8, 0xfc, 0xec, 0xdd, 0x78, 0x31, 0x42, 0xfa, 0x89, 0x34, 0x59, 0x14, 0xc9,
-	0xec, 0x05, 0xd0, 0xff, 0xed, 0xf6, 0x7e, 0x2a, 0x61, 0x2a, 0xb2, 0x37, 0x6c, 0x6a, 0x73, 0xb7,
-	0x68, 0x7e, 0x7c, 0xb5, 0xb9, 0x7c, 0x71, 0xcf, 0xac, 0xe5, 0x69, 0x75, 0xf6, 0x07, 0x06, 0xa9,
-	0x22, 0xf5, 0xdb, 0xe2, 0x4d, 0xf6, 0x96, 0xce, 0x3e, 0xf8, 0xc7, 0xec, 0x37, 0x8b, 0xe0, 0x1a,
-	0xbb, 0x0d, 0x40, 0xfa, 0x92, 0x6c, 0xa6, 0x8c, 0x4b, 0xb4, 0xb7, 0x9b, 0xeb, 0xed, 0x9d, 0xee,
-	0x51, 0xb6, 0xf0, 0xfd, 0xb2, 0xd1, 0x89, 0xb8, 0x1a, 0x4d, 0x06, 0x6e, 0x20, 0x62, 0xef, 0x85,
-	0xce, 0x3b, 0x19, 0x31, 0x9e, 0x78, 0x79, 0x6b, 0xcf, 0xbc, 0x40, 0xc4, 0xb1, 0x48, 0x3c, 0x86,
-	0x08, 0xca, 0xed, 0x31, 0x2e, 0x7d, 0xe3, 0x43, 0x8f, 0xc8, 0xb6, 0x84, 0x0f, 0x4c, 0x86, 0x68,
-	0xff, 0xa7, 0x0f, 0xbe, 0x53, 0x3c, 0xd8, 0x37, 0x40, 0x7e, 0xde, 0x15, 0xdf, 0x1a, 0x92, 0xea,
-	0x6a, 0xbf, 0xe8, 0x7d, 0xb2, 0x97, 0xf7, 0x93, 0x85, 0xa1, 0x04, 0x34, 0xfd, 0xde, 0xf1, 0x2b,
-	0x66, 0x7a, 0x6c, 0x86, 0xf4, 0x80, 0xec, 0x4f, 0xd9, 0x98, 0x87, 0x4c, 0x89, 0x05, 0xb9, 0xa6,
-	0xc9, 0xea, 0xb5, 0x90, 0xc3, 0xad, 0xf7, 0xa4, 0xbc, 0xd4, 0x85, 0x9b, 0x77, 0xad, 0x9b, 0x77,
-	0xe9, 0x5d, 0xb2, 0xbb, 0x5c, 0x37, 0x9d, 0xb1, 0xe1, 0x97, 0x97, 0x8a, 0xd4, 0x3d, 0x3d, 0x9f,
-	0x39, 0xd6, 0xc5, 0xcc, 0xb1, 0x7e, 0xce, 0x1c, 0xeb, 0xf3, 0xdc, 0x29, 0x5d, 0xcc, 0x9d, 0xd2,
-	0xb7, 0xb9, 0x53, 0x7a, 0xf7, 0xe0, 0x6f, 0xdf, 0x6a, 0xfe, 0x6f, 0xa0, 0x3e, 0xa6, 0x80, 0x83,
-	0x2d, 0xfd, 0x53, 0x7f, 0xf8, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x56, 0x94, 0xe7, 0x7b, 0x73, 0x04,
-	0x00, 0x00,
+	// 693 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0xdb, 0x48,
+	0x14, 0x4f, 0xf8, 0xbb, 0x99, 0x24, 0x08, 0x86, 0x3d, 0x78, 0xa3, 0x8d, 0xc9, 0x66, 0xb5, 0x5b,
+	0x24, 0x90, 0xad, 0x50, 0xa9, 0x12, 0x47, 0x42, 0x4b, 0x7b, 0xa1, 0x8d, 0x0c, 0x02, 0x09, 0xa9,
+	0xb5, 0x26, 0xf6, 0x8b, 0x19, 0x29, 0xf6, 0x58, 0xf3, 0x26, 0x01, 0x0e, 0xfd, 0x0e, 0x3d, 0xf7,
+	0x23, 0xf4, 0x93, 0x70, 0xe4, 0x58, 0xf5, 0x40, 0x2b, 0xf8, 0x22, 0x95, 0xc7, 0x0e, 0x49, 0x63,
+	0xa0, 0x3d, 0xf4, 0x16, 0xbd, 0xdf, 0xdf, 0x51, 0xfc, 0x1e, 0x31, 0x23, 0xde, 0xe5, 0x72, 0x60,
+	0x0b, 0xc9, 0xbc, 0x3e, 0xd8, 0xc3, 0x96, 0x1d, 0x40, 0x04, 0xc8, 0xd1, 0x8a, 0xa5, 0x50, 0x82,
+	0x2e, 0xa7, 0xb8, 0x95, 0xe2, 0xd6, 0xb0, 0x55, 0xfb, 0x33, 0x10, 0x81, 0xd0, 0xa0, 0x9d, 0xfc,
+	0x4a, 0x79, 0xb5, 0x7a, 0xce, 0x27, 0x53, 0xa4, 0xf0, 0xdf, 0x39, 0x18, 0x15, 0x53, 0x23, 0xd4,
+	0xf4, 0x04, 0x86, 0x02, 0xed, 0x2e, 0xc3, 0x04, 0xeb, 0x82, 0x62, 0x2d, 0xdb, 0x13, 0x3c, 0x4a,
+	0xf1, 0xe6, 0xc7, 0x12, 0xa9, 0xbc, 0x4c, 0x6b, 0x1d, 0x24, 0x32, 0xfa, 0x8c, 0x2c, 0xc4, 0x4c,
+	0xb2, 0x10, 0x8d, 0x62, 0xa3, 0xb8, 0x5e, 0xde, 0x32, 0xac, 0xe9, 0x9a, 0x56, 0x47, 0xe3, 0xed,
+	0xb9, 0xcb, 0xeb, 0xb5, 0x82, 0x93, 0xb1, 0xe9, 0x31, 0xa1, 0x3d, 0x00, 0x1f, 0xa4, 0xeb, 0x43,
+	0x1f, 0x02, 0xa6, 0xb8, 0x88, 0xd0, 0x98, 0x69, 0xcc, 0xae, 0x97, 0xb7, 0x9a, 0x79, 0x8f, 0x3d,
+	0xcd, 0x7d, 0x7e, 0x47, 0xcd, 0xdc, 0x56, 0x7a, 0x53, 0x73, 0xa4, 0x3d, 0xb2, 0x04, 0xe7, 0xde,
+	0x29, 0x8b, 0x02, 0x70, 0x25, 0x53, 0x80, 0xc6, 0xac, 0x36, 0xfd, 0x37, 0x6f, 0xfa, 0x22, 0xe3,
+	0x39, 0x4c, 0xc1, 0xe1, 0x20, 0xee, 0x43, 0xbb, 0x96, 0xb8, 0x7e, 0xfa, 0xba, 0x46, 0x73, 0x10,
+	0x3a, 0x55, 0x98, 0x98, 0x21, 0x7d, 0x45, 0xaa, 0x21, 0x47, 0x74, 0x3d, 0x31, 0x88, 0x14, 0x48,
+	0x34, 0xe6, 0x74, 0x4c, 0x3d, 0x1f, 0xb3, 0xcf, 0x11, 0x77, 0x53, 0x56, 0x56, 0xbb, 0x12, 0x8e,
+	0x47, 0x48, 0xdf, 0x93, 0x06, 0x0b, 0x02, 0x99, 0xbc, 0x00, 0xdc, 0x1f, 0xba, 0xbb, 0xb1, 0x84,
+	0xa1, 0x48, 0xde, 0x30, 0xaf, 0xcd, 0xad, 0xbc, 0xf9, 0xce, 0x48, 0x39, 0xd9, 0xb8, 0x93, 0xca,
+	0xb2, 0xb4, 0x3a, 0x7b, 0x84, 0x83, 0x54, 0x91, 0xfa, 0x43, 0xf1, 0x69, 0xf6, 0x82, 0xce, 0xde,
+	0xf8, 0xc5, 0xec, 0xa3, 0x71, 0x70, 0x8d, 0x3d, 0x44, 0x40, 0xfa, 0x86, 0xcc, 0xc7, 0x8c, 0x4b,
+	0x34, 0x16, 0x1b, 0xb3, 0xeb, 0xa5, 0xf6, 0x76, 0x22, 0xf8, 0x72, 0xbd, 0xd6, 0x0a, 0xb8, 0x3a,
+	0x1d, 0x74, 0x2d, 0x4f, 0x84, 0xf6, 0x6b, 0x9d, 0xb7, 0x7b, 0xca, 0x78, 0x64, 0x67, 0x1f, 0xed,
+	0xb9, 0xed, 0x89, 0x30, 0x14, 0x91, 0xcd, 0x10, 0x41, 0x59, 0x1d, 0xc6, 0xa5, 0x93, 0xfa, 0xd0,
+	0x6d, 0xb2, 0x28, 0xe1, 0x8c, 0x49, 0x1f, 0x8d, 0x3f, 0x74, 0xe1, 0xbf, 0xf2, 0x85, 0x9d, 0x94,
+	0x90, 0xd5, 0x1b, 0xf1, 0xa9, 0x47, 0x56, 0x63, 0x90, 0x3d, 0x21, 0x43, 0x16, 0x79, 0xe0, 0x4a,
+	0xf0, 0x44, 0x62, 0x53, 0xd2, 0x36, 0x9b, 0x79, 0x9b, 0x23, 0xd6, 0xe7, 0x3e, 0x53, 0x42, 0x76,
+	0xc6, 0x2a, 0x47, 0x8b, 0x32, 0x67, 0x1a, 0x4f, 0x03, 0x48, 0x0f, 0xc9, 0x52, 0x0c, 0x11, 0xeb,
+	0xab, 0x0b, 0x57, 0x2f, 0x1c, 0x1a, 0x44, 0xfb, 0x3f, 0x79, 0xd4, 0x5f, 0x0b, 0xf4, 0xa6, 0x65,
+	0xd6, 0xd5, 0x78, 0x62, 0x86, 0xf4, 0x84, 0xac, 0x86, 0x8c, 0x47, 0x0a, 0x22, 0x5d, 0xfd, 0x8c,
+	0x47, 0xbe, 0x38, 0x43, 0xa3, 0xfc, 0xd0, 0x27, 0xbf, 0x3f, 0x26, 0x1f, 0x6b, 0xee, 0xa8, 0x71,
+	0x38, 0x0d, 0x24, 0x7f, 0x51, 0xa5, 0x2b, 0x22, 0x1f, 0x7c, 0x17, 0x79, 0xe4, 0x81, 0x51, 0xd1,
+	0xa6, 0xff, 0x3f, 0xd2, 0xb7, 0xad, 0xe9, 0x07, 0x09, 0x3b, 0xf3, 0x2d, 0x77, 0xc7, 0x23, 0xfa,
+	0x8e, 0x10, 0x08, 0x99, 0x1b, 0x4b, 0xee, 0x01, 0x1a, 0xd5, 0xdf, 0xb3, 0x96, 0x25, 0x08, 0x59,
+	0x47, 0x3b, 0x36, 0x7b, 0x64, 0x79, 0xfa, 0x4e, 0xd0, 0xff, 0xc8, 0x52, 0x76, 0x67, 0x98, 0xef,
+	0x4b, 0xc0, 0xf4, 0x4e, 0x95, 0x9c, 0x6a, 0x3a, 0xdd, 0x49, 0x87, 0x74, 0x83, 0xac, 0x0c, 0x47,
+	0xaf, 0xb8, 0x63, 0xce, 0x68, 0xe6, 0xf2, 0x1d, 0x90, 0x91, 0x9b, 0x6f, 0x49, 0x79, 0x62, 0xa7,
+	0xef, 0xd7, 0x16, 0xef, 0xd7, 0xd2, 0x7f, 0x48, 0x65, 0xf2, 0x6c, 0xe8, 0x8c, 0x39, 0xa7, 0x3c,
+	0x71, 0x10, 0xda, 0x7b, 0x97, 0x37, 0x66, 0xf1, 0xea, 0xc6, 0x2c, 0x7e, 0xbb, 0x31, 0x8b, 0x1f,
+	0x6e, 0xcd, 0xc2, 0xd5, 0xad, 0x59, 0xf8, 0x7c, 0x6b, 0x16, 0x4e, 0x36, 0x7f, 0xb6, 0x1d, 0xd9,
+	0x51, 0x57, 0x17, 0x31, 0x60, 0x77, 0x41, 0x9f, 0xec, 0xa7, 0xdf, 0x07, 0x00, 0xca, 0xe2, 0x18,
+	0xbc, 0x59, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -295,6 +344,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EmaPrices) > 0 {
+		for iNdEx := len(m.EmaPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EmaPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.BondedSince) > 0 {
+		for iNdEx := len(m.BondedSince) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BondedSince[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.MaintenanceWindows) > 0 {
+		for iNdEx := len(m.MaintenanceWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaintenanceWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PenaltyStates) > 0 {
+		for iNdEx := len(m.PenaltyStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PenaltyStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PerformanceRecords) > 0 {
+		for iNdEx := len(m.PerformanceRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerformanceRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Rewards) > 0 {
 		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -539,6 +658,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PerformanceRecords) > 0 {
+		for _, e := range m.PerformanceRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PenaltyStates) > 0 {
+		for _, e := range m.PenaltyStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MaintenanceWindows) > 0 {
+		for _, e := range m.MaintenanceWindows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BondedSince) > 0 {
+		for _, e := range m.BondedSince {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EmaPrices) > 0 {
+		for _, e := range m.EmaPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -883,6 +1032,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerformanceRecords = append(m.PerformanceRecords, ValidatorPerformanceRecord{})
+			if err := m.PerformanceRecords[len(m.PerformanceRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PenaltyStates = append(m.PenaltyStates, ValidatorPenaltyState{})
+			if err := m.PenaltyStates[len(m.PenaltyStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceWindows = append(m.MaintenanceWindows, MaintenanceWindow{})
+			if err := m.MaintenanceWindows[len(m.MaintenanceWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedSince", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondedSince = append(m.BondedSince, ValidatorBondedSince{})
+			if err := m.BondedSince[len(m.BondedSince)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmaPrices = append(m.EmaPrices, ExchangeRateTuple{})
+			if err := m.EmaPrices[len(m.EmaPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -24,5 +24,8 @@ func TestGetGenesisStateFromAppState(t *testing.T) {
 
 	defaultGenesisState := types.DefaultGenesisState()
 	appState[types.ModuleName] = cdc.MustMarshalJSON(defaultGenesisState)
-	require.Equal(t, *defaultGenesisState, *types.GetGenesisStateFromAppState(cdc, appState))
+	// empty repeated fields decode as empty slices from json and as nil from
+	// the store, so compare the encoded genesis
+	genesisState := types.GetGenesisStateFromAppState(cdc, appState)
+	require.JSONEq(t, string(appState[types.ModuleName]), string(cdc.MustMarshalJSON(genesisState)))
 }
@@ -6,6 +6,8 @@ import (
 
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
 )
 
 // ensure Msg interface compliance at compile time
@@ -13,6 +15,8 @@ var (
 	_ sdk.Msg = &MsgDelegateFeedConsent{}
 	_ sdk.Msg = &MsgAggregateExchangeRatePrevote{}
 	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
+	_ sdk.Msg = &MsgAnnounceMaintenance{}
+	_ sdk.Msg = &MsgCreateFeederReward{}
 )
 
 // oracle message types
@@ -20,8 +24,14 @@ const (
 	TypeMsgDelegateFeedConsent          = "delegate_feeder"
 	TypeMsgAggregateExchangeRatePrevote = "aggregate_exchange_rate_prevote"
 	TypeMsgAggregateExchangeRateVote    = "aggregate_exchange_rate_vote"
+	TypeMsgAnnounceMaintenance          = "announce_maintenance"
+	TypeMsgCreateFeederReward           = "create_feeder_reward"
 )
 
+// MaxRewardVotePeriods is the maximum number of vote periods rewards are
+// spread over: ten years of one minute vote periods.
+const MaxRewardVotePeriods = 10 * 365 * 24 * 60
+
 //-------------------------------------------------
 //-------------------------------------------------
 
@@ -192,3 +202,103 @@ func (msg MsgDelegateFeedConsent) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgAnnounceMaintenance creates a MsgAnnounceMaintenance instance
+func NewMsgAnnounceMaintenance(operatorAddress sdk.ValAddress, startHeight, endHeight uint64) *MsgAnnounceMaintenance {
+	return &MsgAnnounceMaintenance{
+		Operator:    operatorAddress.String(),
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgAnnounceMaintenance) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAnnounceMaintenance) Type() string { return TypeMsgAnnounceMaintenance }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAnnounceMaintenance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgAnnounceMaintenance) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sdk.AccAddress(operator)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAnnounceMaintenance) ValidateBasic() error {
+	_, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid operator address (%s)", err)
+	}
+
+	if msg.EndHeight <= msg.StartHeight {
+		return sdkerrors.Wrapf(ErrInvalidMaintenance, "end height %d must be after start height %d", msg.EndHeight, msg.StartHeight)
+	}
+
+	return nil
+}
+
+// NewMsgCreateFeederReward creates a MsgCreateFeederReward instance
+func NewMsgCreateFeederReward(sender sdk.AccAddress, coins sdk.Coins, votePeriods uint64, pair asset.Pair) *MsgCreateFeederReward {
+	return &MsgCreateFeederReward{
+		Sender:      sender.String(),
+		Coins:       coins,
+		VotePeriods: votePeriods,
+		Pair:        pair,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateFeederReward) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCreateFeederReward) Type() string { return TypeMsgCreateFeederReward }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateFeederReward) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateFeederReward) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sender}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCreateFeederReward) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !msg.Coins.IsValid() || msg.Coins.IsZero() {
+		return sdkerrors.Wrapf(errors.ErrInvalidCoins, "Invalid reward coins (%s)", msg.Coins)
+	}
+
+	if msg.VotePeriods == 0 || msg.VotePeriods > MaxRewardVotePeriods {
+		return sdkerrors.Wrapf(ErrInvalidFeederReward,
+			"vote periods must be between 1 and %d", MaxRewardVotePeriods)
+	}
+
+	if msg.Pair != "" {
+		if err := msg.Pair.Validate(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidFeederReward, "Invalid pair (%s)", err)
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 
@@ -121,3 +122,60 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgAnnounceMaintenance(t *testing.T) {
+	operator := sdk.ValAddress("addr1_______________")
+
+	tests := []struct {
+		operator    sdk.ValAddress
+		startHeight uint64
+		endHeight   uint64
+		expectPass  bool
+	}{
+		{operator, 10, 20, true},
+		{sdk.ValAddress{}, 10, 20, false},
+		{operator, 20, 20, false},
+		{operator, 20, 10, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgAnnounceMaintenance(tc.operator, tc.startHeight, tc.endHeight)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgCreateFeederReward(t *testing.T) {
+	sender := sdk.AccAddress("addr1_______________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("unibi", 100))
+
+	tests := []struct {
+		sender      sdk.AccAddress
+		coins       sdk.Coins
+		votePeriods uint64
+		pair        asset.Pair
+		expectPass  bool
+	}{
+		{sender, coins, 10, "", true},
+		{sender, coins, 10, "unibi:unusd", true},
+		{sdk.AccAddress{}, coins, 10, "", false},
+		{sender, sdk.Coins{}, 10, "", false},
+		{sender, coins, 0, "", false},
+		{sender, coins, types.MaxRewardVotePeriods, "", true},
+		{sender, coins, types.MaxRewardVotePeriods + 1, "", false},
+		{sender, coins, 1 << 63, "", false},
+		{sender, coins, 10, "unibi", false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgCreateFeederReward(tc.sender, tc.coins, tc.votePeriods, tc.pair)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
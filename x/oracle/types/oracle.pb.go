@@ -30,6 +30,88 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AggregationMethod defines how the votes of a pair are aggregated into its
+// exchange rate.
+type AggregationMethod int32
+
+const (
+	// The median of the votes weighted by the voting power of the validators.
+	AggregationMethod_WEIGHTED_MEDIAN AggregationMethod = 0
+	// The power weighted mean of the votes, excluding the highest and lowest
+	// quarter of the voting power.
+	AggregationMethod_TRIMMED_MEAN AggregationMethod = 1
+	// The median of the votes weighted by the voting power of the validators
+	// times the trading volume they report. Reported volumes are capped at the
+	// power weighted median volume.
+	AggregationMethod_VOLUME_WEIGHTED_MEDIAN AggregationMethod = 2
+	// The median of the votes where every validator counts once, for pairs whose
+	// price feeders submit time-weighted average prices.
+	AggregationMethod_TWAP_MEDIAN AggregationMethod = 3
+)
+
+var AggregationMethod_name = map[int32]string{
+	0: "WEIGHTED_MEDIAN",
+	1: "TRIMMED_MEAN",
+	2: "VOLUME_WEIGHTED_MEDIAN",
+	3: "TWAP_MEDIAN",
+}
+
+var AggregationMethod_value = map[string]int32{
+	"WEIGHTED_MEDIAN":        0,
+	"TRIMMED_MEAN":           1,
+	"VOLUME_WEIGHTED_MEDIAN": 2,
+	"TWAP_MEDIAN":            3,
+}
+
+func (x AggregationMethod) String() string {
+	return proto.EnumName(AggregationMethod_name, int32(x))
+}
+
+func (AggregationMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{0}
+}
+
+// OraclePenaltyAction enumerates the penalties applied to a validator whose
+// valid vote rate falls below MinValidPerWindow in a slash window.
+type OraclePenaltyAction int32
+
+const (
+	OraclePenaltyAction_ORACLE_PENALTY_ACTION_UNSPECIFIED OraclePenaltyAction = 0
+	// Emit a warning event only.
+	OraclePenaltyAction_WARN OraclePenaltyAction = 1
+	// Reduce the validator's oracle reward share by RewardReductionFraction
+	// until it completes a slash window above MinValidPerWindow.
+	OraclePenaltyAction_REDUCE_REWARDS OraclePenaltyAction = 2
+	// Slash the validator by SlashFraction.
+	OraclePenaltyAction_SLASH OraclePenaltyAction = 3
+	// Slash the validator by SlashFraction and jail it.
+	OraclePenaltyAction_SLASH_AND_JAIL OraclePenaltyAction = 4
+)
+
+var OraclePenaltyAction_name = map[int32]string{
+	0: "ORACLE_PENALTY_ACTION_UNSPECIFIED",
+	1: "WARN",
+	2: "REDUCE_REWARDS",
+	3: "SLASH",
+	4: "SLASH_AND_JAIL",
+}
+
+var OraclePenaltyAction_value = map[string]int32{
+	"ORACLE_PENALTY_ACTION_UNSPECIFIED": 0,
+	"WARN":                              1,
+	"REDUCE_REWARDS":                    2,
+	"SLASH":                             3,
+	"SLASH_AND_JAIL":                    4,
+}
+
+func (x OraclePenaltyAction) String() string {
+	return proto.EnumName(OraclePenaltyAction_name, int32(x))
+}
+
+func (OraclePenaltyAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{1}
+}
+
 // Params defines the module parameters for the x/oracle module.
 type Params struct {
 	// VotePeriod defines the number of blocks during which voting takes place.
@@ -67,6 +149,40 @@ type Params struct {
 	// The validator fee ratio that is given to validators every epoch.
 	ValidatorFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=validator_fee_ratio,json=validatorFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_fee_ratio" yaml:"validator_fee_ratio"`
 	ExpirationBlocks  uint64                                 `protobuf:"varint,11,opt,name=expiration_blocks,json=expirationBlocks,proto3" json:"expiration_blocks,omitempty" yaml:"expiration_blocks"`
+	// PerformanceHistoryLength is the number of vote periods of per-validator
+	// performance records kept in state. Older records are pruned and removed
+	// from the rolling statistics. A value of zero disables the tracking.
+	PerformanceHistoryLength uint64 `protobuf:"varint,12,opt,name=performance_history_length,json=performanceHistoryLength,proto3" json:"performance_history_length,omitempty" yaml:"performance_history_length"`
+	// PenaltyLadder is the escalation ladder of penalties applied to a validator
+	// that fails consecutive slash windows. The n-th consecutive failure applies
+	// the n-th action, and failures past the end of the ladder apply the last
+	// action. An empty ladder slashes and jails on the first failure.
+	PenaltyLadder []OraclePenaltyAction `protobuf:"varint,13,rep,packed,name=penalty_ladder,json=penaltyLadder,proto3,enum=nibiru.oracle.v1.OraclePenaltyAction" json:"penalty_ladder,omitempty" yaml:"penalty_ladder"`
+	// RewardReductionFraction is the proportion of its oracle reward share that
+	// a validator loses while it is penalized with REDUCE_REWARDS or harsher.
+	RewardReductionFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=reward_reduction_fraction,json=rewardReductionFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_reduction_fraction" yaml:"reward_reduction_fraction"`
+	// GracePeriodBlocks is the number of blocks after joining the bonded set
+	// during which a validator is not penalized for missing votes.
+	GracePeriodBlocks uint64 `protobuf:"varint,15,opt,name=grace_period_blocks,json=gracePeriodBlocks,proto3" json:"grace_period_blocks,omitempty" yaml:"grace_period_blocks"`
+	// MaxMaintenanceBlocks is the maximum length of a maintenance window that a
+	// validator can announce. Zero disables maintenance announcements.
+	MaxMaintenanceBlocks uint64 `protobuf:"varint,16,opt,name=max_maintenance_blocks,json=maxMaintenanceBlocks,proto3" json:"max_maintenance_blocks,omitempty" yaml:"max_maintenance_blocks"`
+	// AggregationMethods overrides the method used to aggregate the votes of a
+	// pair into its exchange rate. Pairs that are not listed use the power
+	// weighted median.
+	AggregationMethods []PairAggregationMethod `protobuf:"bytes,17,rep,name=aggregation_methods,json=aggregationMethods,proto3" json:"aggregation_methods" yaml:"aggregation_methods"`
+	// EmaSpan is the span, in price updates, of the exponential moving average
+	// price kept for every pair. Zero disables the EMA.
+	EmaSpan uint64 `protobuf:"varint,18,opt,name=ema_span,json=emaSpan,proto3" json:"ema_span,omitempty" yaml:"ema_span"`
+	// PairEmaSpans overrides the EmaSpan of specific pairs.
+	PairEmaSpans []PairEmaSpan `protobuf:"bytes,19,rep,name=pair_ema_spans,json=pairEmaSpans,proto3" json:"pair_ema_spans" yaml:"pair_ema_spans"`
+	// FeederRewardCreationFee is charged to the creator of a feeder reward
+	// program and sent to the community pool.
+	FeederRewardCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,20,rep,name=feeder_reward_creation_fee,json=feederRewardCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"feeder_reward_creation_fee" yaml:"feeder_reward_creation_fee"`
+	// MaxFeederRewardsPerPair is the maximum number of active reward programs
+	// limited to a pair, or to all pairs for programs without a pair, that can
+	// be created with MsgCreateFeederReward. Zero disables their creation.
+	MaxFeederRewardsPerPair uint64 `protobuf:"varint,21,opt,name=max_feeder_rewards_per_pair,json=maxFeederRewardsPerPair,proto3" json:"max_feeder_rewards_per_pair,omitempty" yaml:"max_feeder_rewards_per_pair"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -136,6 +252,161 @@ func (m *Params) GetExpirationBlocks() uint64 {
 	return 0
 }
 
+func (m *Params) GetPerformanceHistoryLength() uint64 {
+	if m != nil {
+		return m.PerformanceHistoryLength
+	}
+	return 0
+}
+
+func (m *Params) GetPenaltyLadder() []OraclePenaltyAction {
+	if m != nil {
+		return m.PenaltyLadder
+	}
+	return nil
+}
+
+func (m *Params) GetGracePeriodBlocks() uint64 {
+	if m != nil {
+		return m.GracePeriodBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMaintenanceBlocks() uint64 {
+	if m != nil {
+		return m.MaxMaintenanceBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAggregationMethods() []PairAggregationMethod {
+	if m != nil {
+		return m.AggregationMethods
+	}
+	return nil
+}
+
+func (m *Params) GetEmaSpan() uint64 {
+	if m != nil {
+		return m.EmaSpan
+	}
+	return 0
+}
+
+func (m *Params) GetPairEmaSpans() []PairEmaSpan {
+	if m != nil {
+		return m.PairEmaSpans
+	}
+	return nil
+}
+
+func (m *Params) GetFeederRewardCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeederRewardCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetMaxFeederRewardsPerPair() uint64 {
+	if m != nil {
+		return m.MaxFeederRewardsPerPair
+	}
+	return 0
+}
+
+// PairAggregationMethod sets the aggregation method of a pair.
+type PairAggregationMethod struct {
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	Method AggregationMethod                                 `protobuf:"varint,2,opt,name=method,proto3,enum=nibiru.oracle.v1.AggregationMethod" json:"method,omitempty" yaml:"method"`
+}
+
+func (m *PairAggregationMethod) Reset()         { *m = PairAggregationMethod{} }
+func (m *PairAggregationMethod) String() string { return proto.CompactTextString(m) }
+func (*PairAggregationMethod) ProtoMessage()    {}
+func (*PairAggregationMethod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{1}
+}
+func (m *PairAggregationMethod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairAggregationMethod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairAggregationMethod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairAggregationMethod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairAggregationMethod.Merge(m, src)
+}
+func (m *PairAggregationMethod) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairAggregationMethod) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairAggregationMethod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairAggregationMethod proto.InternalMessageInfo
+
+func (m *PairAggregationMethod) GetMethod() AggregationMethod {
+	if m != nil {
+		return m.Method
+	}
+	return AggregationMethod_WEIGHTED_MEDIAN
+}
+
+// PairEmaSpan sets the span of the exponential moving average price of a pair.
+type PairEmaSpan struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	Span uint64                                            `protobuf:"varint,2,opt,name=span,proto3" json:"span,omitempty" yaml:"span"`
+}
+
+func (m *PairEmaSpan) Reset()         { *m = PairEmaSpan{} }
+func (m *PairEmaSpan) String() string { return proto.CompactTextString(m) }
+func (*PairEmaSpan) ProtoMessage()    {}
+func (*PairEmaSpan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43d45df86ea09ed4, []int{2}
+}
+func (m *PairEmaSpan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairEmaSpan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairEmaSpan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairEmaSpan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairEmaSpan.Merge(m, src)
+}
+func (m *PairEmaSpan) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairEmaSpan) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairEmaSpan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairEmaSpan proto.InternalMessageInfo
+
+func (m *PairEmaSpan) GetSpan() uint64 {
+	if m != nil {
+		return m.Span
+	}
+	return 0
+}
+
 // Struct for aggregate prevoting on the ExchangeRateVote.
 // The purpose of aggregate prevote is to hide vote exchange rates with hash
 // which is formatted as hex string in
@@ -149,7 +420,7 @@ type AggregateExchangeRatePrevote struct {
 func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
 func (*AggregateExchangeRatePrevote) ProtoMessage() {}
 func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{1}
+	return fileDescriptor_43d45df86ea09ed4, []int{3}
 }
 func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -188,7 +459,7 @@ type AggregateExchangeRateVote struct {
 func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
 func (*AggregateExchangeRateVote) ProtoMessage() {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{2}
+	return fileDescriptor_43d45df86ea09ed4, []int{4}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -221,12 +492,18 @@ var xxx_messageInfo_AggregateExchangeRateVote proto.InternalMessageInfo
 type ExchangeRateTuple struct {
 	Pair         github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair" yaml:"pair"`
 	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate" yaml:"exchange_rate"`
+	// volume is the trading volume of the pair reported by the price feeder.
+	// It is optional and only used by the VOLUME_WEIGHTED_MEDIAN aggregation.
+	Volume *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volume,omitempty" yaml:"volume,omitempty"`
+	// twap is the time-weighted average price of the pair reported by the price
+	// feeder. It is optional and only used by the TWAP_MEDIAN aggregation.
+	Twap *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap,omitempty" yaml:"twap,omitempty"`
 }
 
 func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
 func (*ExchangeRateTuple) ProtoMessage() {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{3}
+	return fileDescriptor_43d45df86ea09ed4, []int{5}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,7 +541,7 @@ func (m *DatedPrice) Reset()         { *m = DatedPrice{} }
 func (m *DatedPrice) String() string { return proto.CompactTextString(m) }
 func (*DatedPrice) ProtoMessage()    {}
 func (*DatedPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{4}
+	return fileDescriptor_43d45df86ea09ed4, []int{6}
 }
 func (m *DatedPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -306,17 +583,28 @@ type Rewards struct {
 	// id uniquely identifies the rewards instance of the pair
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// vote_periods defines the vote periods left in which rewards will be
-	// distributed.
+	// distributed. The keeper stores the vote periods at creation, the vote
+	// periods left are given by end_vote_period.
 	VotePeriods uint64 `protobuf:"varint,2,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty"`
 	// Coins defines the amount of coins to distribute in a single vote period.
 	Coins []types.Coin `protobuf:"bytes,3,rep,name=coins,proto3" json:"coins"`
+	// pair, if set, limits the rewards to the validators that voted faithfully
+	// for the pair. An empty pair rewards all ballot winners.
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,4,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// funder is the address of the account that funded the rewards. It is empty
+	// for rewards funded by a module.
+	Funder string `protobuf:"bytes,5,opt,name=funder,proto3" json:"funder,omitempty"`
+	// end_vote_period is the count of vote periods with distributed rewards at
+	// which the rewards end. It is set by the keeper, and recomputed from
+	// vote_periods in the genesis state.
+	EndVotePeriod uint64 `protobuf:"varint,6,opt,name=end_vote_period,json=endVotePeriod,proto3" json:"end_vote_period,omitempty"`
 }
 
 func (m *Rewards) Reset()         { *m = Rewards{} }
 func (m *Rewards) String() string { return proto.CompactTextString(m) }
 func (*Rewards) ProtoMessage()    {}
 func (*Rewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_43d45df86ea09ed4, []int{5}
+	return fileDescriptor_43d45df86ea09ed4, []int{7}
 }
 func (m *Rewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,8 +654,26 @@ func (m *Rewards) GetCoins() []types.Coin {
 	return nil
 }
 
+func (m *Rewards) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *Rewards) GetEndVotePeriod() uint64 {
+	if m != nil {
+		return m.EndVotePeriod
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("nibiru.oracle.v1.AggregationMethod", AggregationMethod_name, AggregationMethod_value)
+	proto.RegisterEnum("nibiru.oracle.v1.OraclePenaltyAction", OraclePenaltyAction_name, OraclePenaltyAction_value)
 	proto.RegisterType((*Params)(nil), "nibiru.oracle.v1.Params")
+	proto.RegisterType((*PairAggregationMethod)(nil), "nibiru.oracle.v1.PairAggregationMethod")
+	proto.RegisterType((*PairEmaSpan)(nil), "nibiru.oracle.v1.PairEmaSpan")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "nibiru.oracle.v1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "nibiru.oracle.v1.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "nibiru.oracle.v1.ExchangeRateTuple")
@@ -378,69 +684,111 @@ func init() {
 func init() { proto.RegisterFile("nibiru/oracle/v1/oracle.proto", fileDescriptor_43d45df86ea09ed4) }
 
 var fileDescriptor_43d45df86ea09ed4 = []byte{
-	// 979 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe6, 0x57, 0xe3, 0xb1, 0x53, 0x92, 0x69, 0x0a, 0x9b, 0x50, 0xbc, 0x61, 0x2a, 0x55,
-	0x39, 0x94, 0x5d, 0xa5, 0x80, 0x10, 0x91, 0x38, 0xb0, 0x0d, 0x81, 0x48, 0x05, 0x59, 0xa3, 0x0a,
-	0x24, 0x84, 0x64, 0xcd, 0xee, 0x4e, 0xd6, 0x23, 0xef, 0xee, 0x58, 0x33, 0xeb, 0x38, 0x91, 0x10,
-	0x67, 0x8e, 0x3d, 0xa1, 0x1e, 0x73, 0xe6, 0x8e, 0xc4, 0x9f, 0xd0, 0x1b, 0x3d, 0xa2, 0x1e, 0xb6,
-	0x28, 0xe1, 0x80, 0x10, 0x27, 0xff, 0x05, 0x68, 0x66, 0xc7, 0xf1, 0x26, 0xb6, 0x04, 0x01, 0xf5,
-	0x64, 0xbf, 0xf7, 0xcd, 0x7c, 0xef, 0x7b, 0x3f, 0x66, 0x66, 0xc1, 0x5b, 0x19, 0x0b, 0x98, 0x18,
-	0x78, 0x5c, 0x90, 0x30, 0xa1, 0xde, 0xd1, 0x8e, 0xf9, 0xe7, 0xf6, 0x05, 0xcf, 0x39, 0x5c, 0x2d,
-	0x61, 0xd7, 0x38, 0x8f, 0x76, 0x36, 0xd7, 0x63, 0x1e, 0x73, 0x0d, 0x7a, 0xea, 0x5f, 0xb9, 0x6e,
-	0xb3, 0x15, 0x73, 0x1e, 0x27, 0xd4, 0xd3, 0x56, 0x30, 0x38, 0xf4, 0xa2, 0x81, 0x20, 0x39, 0xe3,
-	0xd9, 0x18, 0x0f, 0xb9, 0x4c, 0xb9, 0xf4, 0x02, 0x22, 0x55, 0x90, 0x80, 0xe6, 0x64, 0xc7, 0x0b,
-	0x39, 0x33, 0x38, 0xfa, 0x65, 0x19, 0x2c, 0xb5, 0x89, 0x20, 0xa9, 0x84, 0x1f, 0x80, 0xc6, 0x11,
-	0xcf, 0x69, 0xa7, 0x4f, 0x05, 0xe3, 0x91, 0x6d, 0x6d, 0x59, 0xdb, 0x0b, 0xfe, 0xeb, 0xa3, 0xc2,
-	0x81, 0x27, 0x24, 0x4d, 0x76, 0x51, 0x05, 0x44, 0x18, 0x28, 0xab, 0xad, 0x0d, 0x98, 0x81, 0x9b,
-	0x1a, 0xcb, 0xbb, 0x82, 0xca, 0x2e, 0x4f, 0x22, 0x7b, 0x6e, 0xcb, 0xda, 0xae, 0xfb, 0x9f, 0x3e,
-	0x2b, 0x9c, 0xda, 0x8b, 0xc2, 0xb9, 0x17, 0xb3, 0xbc, 0x3b, 0x08, 0xdc, 0x90, 0xa7, 0x9e, 0x91,
-	0x53, 0xfe, 0xbc, 0x23, 0xa3, 0x9e, 0x97, 0x9f, 0xf4, 0xa9, 0x74, 0xf7, 0x68, 0x38, 0x2a, 0x9c,
-	0xdb, 0x95, 0x48, 0x17, 0x6c, 0x08, 0xaf, 0x28, 0xc7, 0xe3, 0xb1, 0x0d, 0x29, 0x68, 0x08, 0x3a,
-	0x24, 0x22, 0xea, 0x04, 0x24, 0x8b, 0xec, 0x79, 0x1d, 0x6c, 0xef, 0xda, 0xc1, 0x4c, 0x5a, 0x15,
-	0x2a, 0x84, 0x41, 0x69, 0xf9, 0x24, 0x8b, 0x60, 0x0c, 0xea, 0xc3, 0x2e, 0xcb, 0x69, 0xc2, 0x64,
-	0x6e, 0x2f, 0x6c, 0xcd, 0x6f, 0xd7, 0xfd, 0x83, 0x17, 0x85, 0xb3, 0x53, 0x09, 0xf0, 0x85, 0x6e,
-	0xd2, 0xc3, 0x2e, 0x61, 0x99, 0x67, 0xfa, 0x79, 0xec, 0x85, 0x3c, 0x4d, 0x79, 0xe6, 0x11, 0x29,
-	0x69, 0xee, 0xb6, 0x09, 0x13, 0xa3, 0xc2, 0x59, 0x2d, 0x63, 0x5d, 0xf0, 0x21, 0x3c, 0xe1, 0x56,
-	0xf5, 0x93, 0x09, 0x91, 0xdd, 0xce, 0xa1, 0x20, 0xa1, 0xea, 0x9d, 0xbd, 0xf8, 0xff, 0xea, 0x77,
-	0x99, 0x0d, 0xe1, 0x15, 0xed, 0xd8, 0x37, 0x36, 0xdc, 0x05, 0xcd, 0x72, 0xc5, 0x90, 0x65, 0x11,
-	0x1f, 0xda, 0x4b, 0xba, 0xd3, 0x6f, 0x8c, 0x0a, 0xe7, 0x56, 0x75, 0x7f, 0x89, 0x22, 0xdc, 0xd0,
-	0xe6, 0x57, 0xda, 0x82, 0xdf, 0x81, 0xf5, 0x94, 0x65, 0x9d, 0x23, 0x92, 0xb0, 0x48, 0x0d, 0xc3,
-	0x98, 0xe3, 0x86, 0x56, 0xfc, 0xf9, 0xb5, 0x15, 0xbf, 0x59, 0x46, 0x9c, 0xc5, 0x89, 0xf0, 0x5a,
-	0xca, 0xb2, 0x2f, 0x95, 0xb7, 0x4d, 0x85, 0x89, 0xff, 0x83, 0x05, 0xd6, 0xf3, 0x21, 0xe9, 0x77,
-	0x12, 0xce, 0x7b, 0x01, 0x09, 0x7b, 0x63, 0x01, 0xcb, 0x5b, 0xd6, 0x76, 0xe3, 0xc1, 0x86, 0x5b,
-	0x9e, 0x07, 0x77, 0x7c, 0x1e, 0xdc, 0x3d, 0x73, 0x1e, 0xfc, 0x03, 0xa5, 0xed, 0xcf, 0xc2, 0x69,
-	0xcd, 0xda, 0x7e, 0x9f, 0xa7, 0x2c, 0xa7, 0x69, 0x3f, 0x3f, 0x99, 0x68, 0x9a, 0xb5, 0x0e, 0x3d,
-	0x7d, 0xe9, 0x58, 0x18, 0x2a, 0xe8, 0x91, 0x41, 0x8c, 0xb0, 0xf7, 0x00, 0xd0, 0x49, 0xf0, 0x9c,
-	0x0a, 0x69, 0xd7, 0x75, 0x49, 0x6f, 0x8f, 0x0a, 0x67, 0xad, 0x92, 0xa0, 0xc6, 0x10, 0xae, 0xab,
-	0xb4, 0xf4, 0x7f, 0xf8, 0x2d, 0xb8, 0xa5, 0xd3, 0x26, 0x39, 0x17, 0x9d, 0x43, 0x4a, 0x3b, 0x5a,
-	0xac, 0x0d, 0x74, 0x35, 0x1f, 0x5d, 0xbb, 0x9a, 0x9b, 0xe6, 0xfc, 0x4c, 0x53, 0x22, 0xbc, 0x76,
-	0xe1, 0xdd, 0xa7, 0x14, 0x2b, 0x1f, 0x3c, 0x00, 0x6b, 0xf4, 0xb8, 0xcf, 0xca, 0x02, 0x75, 0x82,
-	0x84, 0x87, 0x3d, 0x69, 0x37, 0xb4, 0xf4, 0x3b, 0xa3, 0xc2, 0xb1, 0x4b, 0xb6, 0xa9, 0x25, 0x08,
-	0xaf, 0x4e, 0x7c, 0xbe, 0x76, 0xed, 0x2e, 0x3f, 0x3d, 0x75, 0x6a, 0x7f, 0x9c, 0x3a, 0x16, 0xfa,
-	0xc9, 0x02, 0x77, 0x3e, 0x8e, 0x63, 0x41, 0x63, 0x92, 0xd3, 0x4f, 0x8e, 0xc3, 0x2e, 0xc9, 0x62,
-	0x15, 0x8f, 0xb6, 0x05, 0x55, 0x15, 0x80, 0x77, 0xc1, 0x42, 0x97, 0xc8, 0xae, 0xbe, 0x60, 0xea,
-	0xfe, 0x6b, 0xa3, 0xc2, 0x69, 0x94, 0x81, 0x94, 0x17, 0x61, 0x0d, 0xc2, 0x7b, 0x60, 0x51, 0x97,
-	0xcb, 0x5c, 0x25, 0xab, 0xa3, 0xc2, 0x69, 0x4e, 0x2e, 0x07, 0x81, 0x70, 0x09, 0xeb, 0x59, 0x1e,
-	0x04, 0x29, 0xcb, 0x4b, 0x6d, 0xfa, 0x32, 0xb8, 0x3c, 0xcb, 0x15, 0x54, 0xcd, 0xb2, 0x36, 0xb5,
-	0xe8, 0xdd, 0xe6, 0xf7, 0xa7, 0x4e, 0xcd, 0xe8, 0xae, 0xa1, 0xdf, 0x2d, 0xb0, 0x31, 0x53, 0xb7,
-	0x6a, 0x15, 0x7c, 0x62, 0x81, 0x75, 0x6a, 0x9c, 0xaa, 0xa2, 0xb4, 0x93, 0x0f, 0xfa, 0x09, 0x95,
-	0xb6, 0xb5, 0x35, 0xbf, 0xdd, 0x78, 0x70, 0xd7, 0xbd, 0x7a, 0x5f, 0xbb, 0x55, 0x8a, 0xc7, 0x6a,
-	0xad, 0xff, 0xa1, 0xea, 0xe7, 0x64, 0xbe, 0x66, 0xd1, 0xa1, 0x1f, 0x5f, 0x3a, 0x70, 0x6a, 0xa7,
-	0xc4, 0x90, 0x4e, 0xf9, 0xfe, 0x6d, 0x89, 0xae, 0xa4, 0xf9, 0x97, 0x05, 0xd6, 0xa6, 0x02, 0xc0,
-	0x6f, 0xc0, 0x42, 0x9f, 0x30, 0x61, 0x7a, 0xf2, 0x99, 0x19, 0xbc, 0xff, 0x74, 0xd5, 0x99, 0x66,
-	0x2a, 0x3a, 0x84, 0x35, 0x2b, 0xec, 0x81, 0x95, 0x4b, 0xc9, 0x1a, 0xc5, 0xfb, 0xd7, 0x9e, 0xef,
-	0xf5, 0x19, 0x95, 0x43, 0xb8, 0x59, 0x2d, 0xce, 0x95, 0x74, 0x7f, 0xb6, 0x00, 0xd8, 0x23, 0x39,
-	0x8d, 0xda, 0x82, 0x85, 0x74, 0x5a, 0x89, 0xf5, 0xea, 0x94, 0xc0, 0x8f, 0xc0, 0x4a, 0x28, 0xa8,
-	0x0a, 0x6e, 0x86, 0x73, 0x4e, 0x0f, 0xa7, 0x3d, 0xd9, 0x7e, 0x09, 0x46, 0xb8, 0x69, 0x6c, 0x3d,
-	0x9e, 0x48, 0x82, 0x1b, 0x58, 0xbf, 0x46, 0x12, 0xde, 0x04, 0x73, 0xcc, 0xbc, 0xc8, 0x78, 0x8e,
-	0x45, 0xf0, 0x6d, 0xd0, 0xac, 0xbc, 0xc6, 0xb2, 0x24, 0xc6, 0x8d, 0xc9, 0x9b, 0x2c, 0xe1, 0xfb,
-	0x60, 0x51, 0x3d, 0xf3, 0xd2, 0x9e, 0xd7, 0x03, 0xba, 0xe1, 0x96, 0x89, 0xb8, 0xea, 0x43, 0xc0,
-	0x35, 0x1f, 0x02, 0xee, 0x43, 0xce, 0x32, 0x7f, 0x41, 0x25, 0x8f, 0xcb, 0xd5, 0xfe, 0xfe, 0xb3,
-	0xb3, 0x96, 0xf5, 0xfc, 0xac, 0x65, 0xfd, 0x76, 0xd6, 0xb2, 0x9e, 0x9c, 0xb7, 0x6a, 0xcf, 0xcf,
-	0x5b, 0xb5, 0x5f, 0xcf, 0x5b, 0xb5, 0xaf, 0xef, 0xff, 0xd3, 0x30, 0x98, 0x2f, 0x19, 0x5d, 0xa5,
-	0x60, 0x49, 0x5f, 0xc0, 0xef, 0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x73, 0x48, 0x19, 0x08, 0xe7,
-	0x08, 0x00, 0x00,
+	// 1659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x6f, 0xdb, 0xca,
+	0x15, 0x16, 0x2d, 0xd9, 0xb1, 0x47, 0x0f, 0x4b, 0x23, 0x3f, 0x68, 0xe7, 0x5a, 0xb4, 0xe7, 0x22,
+	0xae, 0x71, 0x91, 0x4a, 0xb0, 0xdb, 0xa2, 0x88, 0x81, 0x2e, 0x24, 0x4b, 0x8e, 0x55, 0x48, 0xb2,
+	0x30, 0xb6, 0x63, 0xf4, 0x01, 0x10, 0x23, 0x71, 0x2c, 0x11, 0x16, 0x49, 0x81, 0xa4, 0xfc, 0x00,
+	0x82, 0x2e, 0xba, 0xea, 0xaa, 0xc8, 0x2a, 0xc8, 0x32, 0xeb, 0xa2, 0x5d, 0x16, 0xe8, 0x4f, 0x08,
+	0xd0, 0x4d, 0x96, 0x45, 0x16, 0x4c, 0x91, 0x74, 0x51, 0x74, 0xa9, 0x3f, 0xd0, 0x62, 0x86, 0x23,
+	0x93, 0xb6, 0x14, 0x24, 0x4e, 0x91, 0x95, 0x38, 0xe7, 0x9c, 0xf9, 0xce, 0x7b, 0xe6, 0x8c, 0xc0,
+	0x9a, 0xa9, 0xb7, 0x74, 0x7b, 0x50, 0xb0, 0x6c, 0xd2, 0xee, 0xd1, 0xc2, 0xc5, 0xb6, 0xf8, 0xca,
+	0xf7, 0x6d, 0xcb, 0xb5, 0x60, 0xda, 0x67, 0xe7, 0x05, 0xf1, 0x62, 0x7b, 0x75, 0xa1, 0x63, 0x75,
+	0x2c, 0xce, 0x2c, 0xb0, 0x2f, 0x5f, 0x6e, 0x35, 0xd7, 0xb1, 0xac, 0x4e, 0x8f, 0x16, 0xf8, 0xaa,
+	0x35, 0x38, 0x2b, 0x68, 0x03, 0x9b, 0xb8, 0xba, 0x65, 0x8e, 0xf8, 0x6d, 0xcb, 0x31, 0x2c, 0xa7,
+	0xd0, 0x22, 0x0e, 0x53, 0xd2, 0xa2, 0x2e, 0xd9, 0x2e, 0xb4, 0x2d, 0x5d, 0xf0, 0xd1, 0x7f, 0xd3,
+	0x60, 0xa6, 0x49, 0x6c, 0x62, 0x38, 0xf0, 0xe7, 0x20, 0x7e, 0x61, 0xb9, 0x54, 0xed, 0x53, 0x5b,
+	0xb7, 0x34, 0x59, 0x5a, 0x97, 0xb6, 0x62, 0xa5, 0xa5, 0xa1, 0xa7, 0xc0, 0x6b, 0x62, 0xf4, 0x76,
+	0x51, 0x88, 0x89, 0x30, 0x60, 0xab, 0x26, 0x5f, 0x40, 0x13, 0xa4, 0x38, 0xcf, 0xed, 0xda, 0xd4,
+	0xe9, 0x5a, 0x3d, 0x4d, 0x9e, 0x5a, 0x97, 0xb6, 0xe6, 0x4a, 0x4f, 0xdf, 0x78, 0x4a, 0xe4, 0x9d,
+	0xa7, 0x6c, 0x76, 0x74, 0xb7, 0x3b, 0x68, 0xe5, 0xdb, 0x96, 0x51, 0x10, 0xe6, 0xf8, 0x3f, 0x3f,
+	0x76, 0xb4, 0xf3, 0x82, 0x7b, 0xdd, 0xa7, 0x4e, 0xbe, 0x4c, 0xdb, 0x43, 0x4f, 0x59, 0x0c, 0x69,
+	0xba, 0x41, 0x43, 0x38, 0xc9, 0x08, 0xc7, 0xa3, 0x35, 0xa4, 0x20, 0x6e, 0xd3, 0x4b, 0x62, 0x6b,
+	0x6a, 0x8b, 0x98, 0x9a, 0x1c, 0xe5, 0xca, 0xca, 0xf7, 0x56, 0x26, 0xdc, 0x0a, 0x41, 0x21, 0x0c,
+	0xfc, 0x55, 0x89, 0x98, 0x1a, 0xec, 0x80, 0xb9, 0xcb, 0xae, 0xee, 0xd2, 0x9e, 0xee, 0xb8, 0x72,
+	0x6c, 0x3d, 0xba, 0x35, 0x57, 0xaa, 0xbe, 0xf3, 0x94, 0xed, 0x90, 0x82, 0x06, 0x4f, 0xd2, 0x5e,
+	0x97, 0xe8, 0x66, 0x41, 0xe4, 0xf3, 0xaa, 0xd0, 0xb6, 0x0c, 0xc3, 0x32, 0x0b, 0xc4, 0x71, 0xa8,
+	0x9b, 0x6f, 0x12, 0xdd, 0x1e, 0x7a, 0x4a, 0xda, 0xd7, 0x75, 0x83, 0x87, 0x70, 0x80, 0xcd, 0xe2,
+	0xe7, 0xf4, 0x88, 0xd3, 0x55, 0xcf, 0x6c, 0xd2, 0x66, 0xb9, 0x93, 0xa7, 0xff, 0xbf, 0xf8, 0xdd,
+	0x46, 0x43, 0x38, 0xc9, 0x09, 0xfb, 0x62, 0x0d, 0x77, 0x41, 0xc2, 0x97, 0xb8, 0xd4, 0x4d, 0xcd,
+	0xba, 0x94, 0x67, 0x78, 0xa6, 0x97, 0x87, 0x9e, 0x92, 0x0d, 0xef, 0xf7, 0xb9, 0x08, 0xc7, 0xf9,
+	0xf2, 0x94, 0xaf, 0xe0, 0xef, 0xc0, 0x82, 0xa1, 0x9b, 0xea, 0x05, 0xe9, 0xe9, 0x1a, 0x2b, 0x86,
+	0x11, 0xc6, 0x03, 0x6e, 0x71, 0xfd, 0xde, 0x16, 0x3f, 0xf4, 0x35, 0x4e, 0xc2, 0x44, 0x38, 0x63,
+	0xe8, 0xe6, 0x33, 0x46, 0x6d, 0x52, 0x5b, 0xe8, 0x7f, 0x29, 0x81, 0x05, 0xf7, 0x92, 0xf4, 0xd5,
+	0x9e, 0x65, 0x9d, 0xb7, 0x48, 0xfb, 0x7c, 0x64, 0xc0, 0xec, 0xba, 0xb4, 0x15, 0xdf, 0x59, 0xc9,
+	0xfb, 0xfd, 0x90, 0x1f, 0xf5, 0x43, 0xbe, 0x2c, 0xfa, 0xa1, 0x54, 0x65, 0xb6, 0xfd, 0xc7, 0x53,
+	0x72, 0x93, 0xb6, 0x3f, 0xb6, 0x0c, 0xdd, 0xa5, 0x46, 0xdf, 0xbd, 0x0e, 0x6c, 0x9a, 0x24, 0x87,
+	0x5e, 0xbd, 0x57, 0x24, 0x0c, 0x19, 0xab, 0x26, 0x38, 0xc2, 0xb0, 0x9f, 0x02, 0xc0, 0x9d, 0xb0,
+	0x5c, 0x6a, 0x3b, 0xf2, 0x1c, 0x0f, 0xe9, 0xe2, 0xd0, 0x53, 0x32, 0x21, 0x07, 0x39, 0x0f, 0xe1,
+	0x39, 0xe6, 0x16, 0xff, 0x86, 0xcf, 0x41, 0x96, 0xbb, 0x4d, 0x5c, 0xcb, 0x56, 0xcf, 0x28, 0x55,
+	0xb9, 0xb1, 0x32, 0xe0, 0xd1, 0xac, 0xdd, 0x3b, 0x9a, 0xab, 0xa2, 0x7f, 0xc6, 0x21, 0x11, 0xce,
+	0xdc, 0x50, 0xf7, 0x29, 0xc5, 0x8c, 0x06, 0xab, 0x20, 0x43, 0xaf, 0xfa, 0xba, 0x1f, 0x20, 0xb5,
+	0xd5, 0xb3, 0xda, 0xe7, 0x8e, 0x1c, 0xe7, 0xa6, 0x7f, 0x37, 0xf4, 0x14, 0xd9, 0x47, 0x1b, 0x13,
+	0x41, 0x38, 0x1d, 0xd0, 0x4a, 0x9c, 0x04, 0xdb, 0x60, 0xb5, 0x4f, 0xed, 0x33, 0xcb, 0x36, 0x88,
+	0xd9, 0xa6, 0x6a, 0x57, 0x77, 0x5c, 0xcb, 0xbe, 0x56, 0x7b, 0xd4, 0xec, 0xb8, 0x5d, 0x39, 0xc1,
+	0x31, 0x1f, 0x0d, 0x3d, 0x65, 0xc3, 0xc7, 0xfc, 0xb4, 0x2c, 0xc2, 0x72, 0x88, 0x79, 0xe0, 0xf3,
+	0x6a, 0x9c, 0x05, 0x3b, 0x20, 0xd5, 0xa7, 0x26, 0xe9, 0xb9, 0xd7, 0x6a, 0x8f, 0x68, 0x1a, 0xb5,
+	0xe5, 0xe4, 0x7a, 0x74, 0x2b, 0xb5, 0xf3, 0x28, 0x7f, 0xf7, 0xb4, 0xcc, 0x1f, 0xf2, 0xaf, 0xa6,
+	0x2f, 0x5d, 0xe4, 0x75, 0x5f, 0x5a, 0x09, 0x3a, 0xe4, 0x36, 0x0c, 0xc2, 0x49, 0x41, 0xa8, 0xf1,
+	0x35, 0xfc, 0xa3, 0x04, 0x56, 0xc4, 0xb9, 0x60, 0x53, 0x6d, 0xc0, 0xb7, 0x07, 0xdd, 0x99, 0xe2,
+	0xd9, 0xc1, 0xf7, 0xce, 0xce, 0xfa, 0xad, 0x03, 0x67, 0x1c, 0x18, 0xe1, 0x65, 0x9f, 0x87, 0x47,
+	0xac, 0x9b, 0x96, 0x6d, 0x80, 0x6c, 0xc7, 0x26, 0xed, 0xd1, 0xf9, 0x3b, 0xca, 0xd5, 0x3c, 0x8f,
+	0x6b, 0x2e, 0xc8, 0xfc, 0x04, 0x21, 0x84, 0x33, 0x9c, 0xea, 0x1f, 0xd6, 0x22, 0x5d, 0xa7, 0x60,
+	0xc9, 0x20, 0x57, 0xaa, 0x41, 0x74, 0xd3, 0xa5, 0x26, 0x4f, 0x83, 0x80, 0x4c, 0x73, 0xc8, 0x8d,
+	0xa1, 0xa7, 0xac, 0x89, 0xca, 0x9d, 0x28, 0x87, 0xf0, 0x82, 0x41, 0xae, 0xea, 0x01, 0x5d, 0x00,
+	0x3f, 0x07, 0x59, 0xd2, 0xe9, 0xd8, 0xb4, 0xe3, 0x17, 0x8c, 0x41, 0xdd, 0xae, 0xa5, 0x39, 0x72,
+	0x66, 0x3d, 0xba, 0x15, 0xdf, 0xf9, 0xd1, 0x78, 0x9e, 0xd8, 0xf9, 0x58, 0x0c, 0x36, 0xd4, 0xb9,
+	0x7c, 0x09, 0xb1, 0xd8, 0x06, 0x5e, 0x4d, 0x40, 0x44, 0x18, 0x92, 0xbb, 0xdb, 0x1c, 0x98, 0x07,
+	0xb3, 0xd4, 0x20, 0xaa, 0xd3, 0x27, 0xa6, 0x0c, 0xb9, 0x23, 0xd9, 0xa1, 0xa7, 0xcc, 0x8b, 0x3a,
+	0x16, 0x1c, 0x84, 0x1f, 0x50, 0x83, 0x1c, 0xf5, 0x89, 0x09, 0x5b, 0x20, 0xd5, 0x27, 0xba, 0xad,
+	0x8e, 0x58, 0x8e, 0x9c, 0xe5, 0x86, 0xae, 0x4d, 0x36, 0xb4, 0xe2, 0x6f, 0x2b, 0xad, 0x09, 0xf3,
+	0x46, 0xc5, 0x74, 0x0b, 0x02, 0xe1, 0x44, 0x3f, 0x90, 0x75, 0xe0, 0x5f, 0x24, 0xb0, 0x7a, 0x46,
+	0xa9, 0x46, 0x6d, 0x55, 0x64, 0xbe, 0x6d, 0x53, 0xdf, 0x97, 0x33, 0x4a, 0xe5, 0x05, 0xae, 0x70,
+	0x25, 0xef, 0xd7, 0x4c, 0x9e, 0xdd, 0xd3, 0x79, 0x71, 0x4f, 0xe7, 0xf7, 0x2c, 0xdd, 0x2c, 0x9d,
+	0x08, 0x65, 0xa2, 0x73, 0x3e, 0x0d, 0x85, 0xfe, 0xf4, 0x5e, 0xd9, 0xfa, 0x82, 0x62, 0x64, 0xa8,
+	0x0e, 0x5e, 0xf6, 0x81, 0x30, 0xc7, 0xd9, 0x13, 0x30, 0xfb, 0x94, 0x42, 0x0d, 0x3c, 0x64, 0x29,
+	0xbf, 0xa5, 0xc7, 0xe1, 0xc7, 0x32, 0x73, 0x4b, 0x5e, 0xe4, 0x61, 0xdd, 0x1c, 0x7a, 0x0a, 0x0a,
+	0xea, 0xe3, 0x13, 0xc2, 0x08, 0x2f, 0x1b, 0xe4, 0x6a, 0x3f, 0xa4, 0xc8, 0x69, 0x52, 0x9b, 0x45,
+	0x72, 0x77, 0xf6, 0xd5, 0x6b, 0x25, 0xf2, 0xef, 0xd7, 0x8a, 0x84, 0xfe, 0x2e, 0x81, 0xc5, 0x89,
+	0x55, 0x00, 0x7f, 0x0b, 0x62, 0x5c, 0xa5, 0xc4, 0xfb, 0xed, 0x40, 0xf4, 0xdb, 0x57, 0xdd, 0xbf,
+	0xf1, 0x20, 0x53, 0x08, 0x73, 0x54, 0xd8, 0x00, 0x33, 0x7e, 0x2d, 0xf1, 0x69, 0x25, 0xb5, 0xf3,
+	0xfd, 0x78, 0xce, 0xc7, 0x0b, 0x33, 0x33, 0xf4, 0x94, 0xa4, 0xf0, 0x9b, 0x53, 0x10, 0x16, 0x28,
+	0xbb, 0x31, 0xee, 0xcd, 0x4b, 0x09, 0xc4, 0x43, 0xa5, 0xf2, 0x8d, 0x7d, 0xf8, 0x1e, 0xc4, 0x78,
+	0xad, 0x4f, 0xf1, 0xa4, 0xcc, 0x07, 0x42, 0x7e, 0x9d, 0x73, 0xa6, 0x30, 0xec, 0xaf, 0x12, 0xf8,
+	0x6e, 0xe4, 0x0f, 0xad, 0x5c, 0xb5, 0xbb, 0xc4, 0xec, 0xb0, 0x6b, 0x80, 0x36, 0x6d, 0xca, 0x2e,
+	0x26, 0x86, 0xd5, 0x25, 0x4e, 0x57, 0x58, 0x1a, 0xc2, 0x62, 0x54, 0x84, 0x39, 0x13, 0x6e, 0x82,
+	0x69, 0x26, 0x6c, 0x8b, 0x09, 0x2f, 0x3d, 0xf4, 0x94, 0x44, 0x30, 0xb3, 0xd9, 0x08, 0xfb, 0x6c,
+	0x3e, 0x62, 0x0c, 0x5a, 0x86, 0xee, 0xfa, 0xc7, 0x85, 0x1c, 0x1d, 0x1b, 0x31, 0x42, 0x5c, 0x36,
+	0x62, 0xf0, 0x25, 0x3f, 0x43, 0x76, 0x13, 0x7f, 0x78, 0xad, 0x44, 0x44, 0x79, 0x44, 0xd0, 0xbf,
+	0x24, 0xb0, 0x32, 0xd1, 0x6e, 0x76, 0x83, 0xc2, 0x17, 0x12, 0x58, 0xa0, 0x82, 0xc8, 0x2e, 0x3a,
+	0xaa, 0xba, 0x83, 0x7e, 0x8f, 0x3a, 0xb2, 0xc4, 0xdb, 0x6a, 0x42, 0x4e, 0xc3, 0x10, 0xc7, 0x4c,
+	0xb6, 0xf4, 0x44, 0x34, 0xd8, 0xc3, 0xd1, 0x75, 0x37, 0x0e, 0xc7, 0x5a, 0x0b, 0x8e, 0xed, 0x74,
+	0x30, 0xa4, 0x63, 0xb4, 0x2f, 0x0d, 0xd1, 0x1d, 0x37, 0xff, 0x1c, 0x05, 0x99, 0x31, 0x05, 0xdf,
+	0xb8, 0x7a, 0xce, 0x41, 0xf2, 0x96, 0xb3, 0xc2, 0xe2, 0xfd, 0x7b, 0x5f, 0x6c, 0x0b, 0x13, 0x22,
+	0x87, 0x70, 0x22, 0x1c, 0x1c, 0x48, 0xc0, 0xcc, 0x85, 0xd5, 0x1b, 0x18, 0x54, 0xcc, 0xeb, 0x6c,
+	0x1c, 0x93, 0xee, 0xa5, 0x65, 0x79, 0x14, 0x45, 0x86, 0x12, 0x0c, 0x6c, 0x08, 0x0b, 0x60, 0xf8,
+	0x1b, 0x10, 0x63, 0x83, 0x99, 0x1c, 0xbb, 0x99, 0x9e, 0xa5, 0xaf, 0x99, 0x9e, 0x19, 0x46, 0x18,
+	0x9e, 0x83, 0xde, 0x49, 0xd7, 0xdf, 0x24, 0x00, 0xca, 0xc4, 0xa5, 0x5a, 0xd3, 0xd6, 0xdb, 0x74,
+	0x3c, 0x92, 0xd2, 0x37, 0x8c, 0xe4, 0x2f, 0x40, 0x92, 0x1f, 0xfb, 0x54, 0xdc, 0xf0, 0xa2, 0xfb,
+	0xe5, 0x60, 0xfb, 0x2d, 0x36, 0xc2, 0x09, 0xb1, 0xe6, 0xed, 0x85, 0x7e, 0x3f, 0x05, 0x1e, 0x88,
+	0xc3, 0x18, 0xa6, 0xc0, 0x94, 0x2e, 0x5e, 0x7a, 0x78, 0x4a, 0xd7, 0xe0, 0x06, 0x48, 0x84, 0x5e,
+	0x79, 0x8e, 0x8f, 0x8c, 0xe3, 0xc1, 0x5b, 0xcf, 0x81, 0x3f, 0x03, 0xd3, 0xec, 0xf9, 0xe8, 0xc8,
+	0xd1, 0xcf, 0x5d, 0x5c, 0x31, 0xe6, 0x3d, 0xf6, 0xa5, 0x61, 0x5d, 0x54, 0xb2, 0x9f, 0x9b, 0x27,
+	0x5f, 0x5d, 0xc9, 0xa2, 0x74, 0x97, 0xc0, 0xcc, 0xd9, 0xc0, 0x64, 0x13, 0x20, 0x7f, 0x2a, 0x61,
+	0xb1, 0x82, 0x9b, 0x60, 0x9e, 0x9a, 0x9a, 0x1a, 0x7e, 0xc7, 0xf2, 0xd7, 0x0d, 0x4e, 0x52, 0x53,
+	0x7b, 0x76, 0xe3, 0xc6, 0x0f, 0x1d, 0x90, 0x19, 0xbf, 0x6f, 0xb2, 0x60, 0xfe, 0xb4, 0x52, 0x7d,
+	0x7a, 0x70, 0x5c, 0x29, 0xab, 0xf5, 0x4a, 0xb9, 0x5a, 0x6c, 0xa4, 0x23, 0x30, 0x0d, 0x12, 0xc7,
+	0xb8, 0x5a, 0xaf, 0x73, 0x5a, 0xb1, 0x91, 0x96, 0xe0, 0x2a, 0x58, 0x7a, 0x76, 0x58, 0x3b, 0xa9,
+	0x57, 0xd4, 0xbb, 0xd2, 0x53, 0x70, 0x1e, 0xc4, 0x8f, 0x4f, 0x8b, 0xcd, 0x11, 0x21, 0xfa, 0xc3,
+	0x35, 0xc8, 0x4e, 0x18, 0x45, 0xe1, 0x23, 0xb0, 0x71, 0x88, 0x8b, 0x7b, 0xb5, 0x8a, 0xda, 0xac,
+	0x34, 0x8a, 0xb5, 0xe3, 0x5f, 0xa9, 0xc5, 0xbd, 0xe3, 0xea, 0x61, 0x43, 0x3d, 0x69, 0x1c, 0x35,
+	0x2b, 0x7b, 0xd5, 0xfd, 0x6a, 0xa5, 0x9c, 0x8e, 0xc0, 0x59, 0x10, 0x3b, 0x2d, 0x62, 0xa6, 0x14,
+	0x82, 0x14, 0xae, 0x94, 0x4f, 0xf6, 0x2a, 0x2a, 0xae, 0x9c, 0x16, 0x71, 0xf9, 0x28, 0x3d, 0x05,
+	0xe7, 0xc0, 0xf4, 0x51, 0xad, 0x78, 0x74, 0x90, 0x8e, 0x32, 0x36, 0xff, 0x54, 0x8b, 0x8d, 0xb2,
+	0xfa, 0xcb, 0x62, 0xb5, 0x96, 0x8e, 0x95, 0xf6, 0xdf, 0x7c, 0xc8, 0x49, 0x6f, 0x3f, 0xe4, 0xa4,
+	0x7f, 0x7e, 0xc8, 0x49, 0x2f, 0x3e, 0xe6, 0x22, 0x6f, 0x3f, 0xe6, 0x22, 0xff, 0xf8, 0x98, 0x8b,
+	0xfc, 0xfa, 0xf1, 0xe7, 0xc2, 0x2e, 0xfe, 0x94, 0xe0, 0x95, 0xd9, 0x9a, 0xe1, 0x6f, 0xa9, 0x9f,
+	0xfc, 0x6f, 0x00, 0x0c, 0x5a, 0xec, 0x24, 0xb2, 0x10, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -500,6 +848,110 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.ExpirationBlocks != that1.ExpirationBlocks {
 		return false
 	}
+	if this.PerformanceHistoryLength != that1.PerformanceHistoryLength {
+		return false
+	}
+	if len(this.PenaltyLadder) != len(that1.PenaltyLadder) {
+		return false
+	}
+	for i := range this.PenaltyLadder {
+		if this.PenaltyLadder[i] != that1.PenaltyLadder[i] {
+			return false
+		}
+	}
+	if !this.RewardReductionFraction.Equal(that1.RewardReductionFraction) {
+		return false
+	}
+	if this.GracePeriodBlocks != that1.GracePeriodBlocks {
+		return false
+	}
+	if this.MaxMaintenanceBlocks != that1.MaxMaintenanceBlocks {
+		return false
+	}
+	if len(this.AggregationMethods) != len(that1.AggregationMethods) {
+		return false
+	}
+	for i := range this.AggregationMethods {
+		if !this.AggregationMethods[i].Equal(&that1.AggregationMethods[i]) {
+			return false
+		}
+	}
+	if this.EmaSpan != that1.EmaSpan {
+		return false
+	}
+	if len(this.PairEmaSpans) != len(that1.PairEmaSpans) {
+		return false
+	}
+	for i := range this.PairEmaSpans {
+		if !this.PairEmaSpans[i].Equal(&that1.PairEmaSpans[i]) {
+			return false
+		}
+	}
+	if len(this.FeederRewardCreationFee) != len(that1.FeederRewardCreationFee) {
+		return false
+	}
+	for i := range this.FeederRewardCreationFee {
+		if !this.FeederRewardCreationFee[i].Equal(&that1.FeederRewardCreationFee[i]) {
+			return false
+		}
+	}
+	if this.MaxFeederRewardsPerPair != that1.MaxFeederRewardsPerPair {
+		return false
+	}
+	return true
+}
+func (this *PairAggregationMethod) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PairAggregationMethod)
+	if !ok {
+		that2, ok := that.(PairAggregationMethod)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pair.Equal(that1.Pair) {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	return true
+}
+func (this *PairEmaSpan) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PairEmaSpan)
+	if !ok {
+		that2, ok := that.(PairEmaSpan)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pair.Equal(that1.Pair) {
+		return false
+	}
+	if this.Span != that1.Span {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -522,38 +974,145 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.ExpirationBlocks != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.ExpirationBlocks))
+	if m.MaxFeederRewardsPerPair != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxFeederRewardsPerPair))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	{
-		size := m.ValidatorFeeRatio.Size()
-		i -= size
-		if _, err := m.ValidatorFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.FeederRewardCreationFee) > 0 {
+		for iNdEx := len(m.FeederRewardCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeederRewardCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x52
-	if m.MinVoters != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
-		i--
-		dAtA[i] = 0x48
+	if len(m.PairEmaSpans) > 0 {
+		for iNdEx := len(m.PairEmaSpans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairEmaSpans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapLookbackWindow):])
-	if err1 != nil {
-		return 0, err1
+	if m.EmaSpan != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EmaSpan))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	i -= n1
-	i = encodeVarintOracle(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.MinValidPerWindow.Size()
-		i -= size
-		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+	if len(m.AggregationMethods) > 0 {
+		for iNdEx := len(m.AggregationMethods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregationMethods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.MaxMaintenanceBlocks != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxMaintenanceBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.GracePeriodBlocks != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.GracePeriodBlocks))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.RewardReductionFraction.Size()
+		i -= size
+		if _, err := m.RewardReductionFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.PenaltyLadder) > 0 {
+		dAtA2 := make([]byte, len(m.PenaltyLadder)*10)
+		var j1 int
+		for _, num := range m.PenaltyLadder {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintOracle(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.PerformanceHistoryLength != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PerformanceHistoryLength))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ExpirationBlocks != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExpirationBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.ValidatorFeeRatio.Size()
+		i -= size
+		if _, err := m.ValidatorFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x48
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapLookbackWindow):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintOracle(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MinValidPerWindow.Size()
+		i -= size
+		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintOracle(dAtA, i, uint64(size))
@@ -617,6 +1176,82 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PairAggregationMethod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairAggregationMethod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairAggregationMethod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Method != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Method))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PairEmaSpan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairEmaSpan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairEmaSpan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Span != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Span))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -723,6 +1358,30 @@ func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Twap != nil {
+		{
+			size := m.Twap.Size()
+			i -= size
+			if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Volume != nil {
+		{
+			size := m.Volume.Size()
+			i -= size
+			if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOracle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.ExchangeRate.Size()
 		i -= size
@@ -804,6 +1463,28 @@ func (m *Rewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EndVotePeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.EndVotePeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -878,6 +1559,76 @@ func (m *Params) Size() (n int) {
 	if m.ExpirationBlocks != 0 {
 		n += 1 + sovOracle(uint64(m.ExpirationBlocks))
 	}
+	if m.PerformanceHistoryLength != 0 {
+		n += 1 + sovOracle(uint64(m.PerformanceHistoryLength))
+	}
+	if len(m.PenaltyLadder) > 0 {
+		l = 0
+		for _, e := range m.PenaltyLadder {
+			l += sovOracle(uint64(e))
+		}
+		n += 1 + sovOracle(uint64(l)) + l
+	}
+	l = m.RewardReductionFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.GracePeriodBlocks != 0 {
+		n += 1 + sovOracle(uint64(m.GracePeriodBlocks))
+	}
+	if m.MaxMaintenanceBlocks != 0 {
+		n += 2 + sovOracle(uint64(m.MaxMaintenanceBlocks))
+	}
+	if len(m.AggregationMethods) > 0 {
+		for _, e := range m.AggregationMethods {
+			l = e.Size()
+			n += 2 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.EmaSpan != 0 {
+		n += 2 + sovOracle(uint64(m.EmaSpan))
+	}
+	if len(m.PairEmaSpans) > 0 {
+		for _, e := range m.PairEmaSpans {
+			l = e.Size()
+			n += 2 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.FeederRewardCreationFee) > 0 {
+		for _, e := range m.FeederRewardCreationFee {
+			l = e.Size()
+			n += 2 + l + sovOracle(uint64(l))
+		}
+	}
+	if m.MaxFeederRewardsPerPair != 0 {
+		n += 2 + sovOracle(uint64(m.MaxFeederRewardsPerPair))
+	}
+	return n
+}
+
+func (m *PairAggregationMethod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Method != 0 {
+		n += 1 + sovOracle(uint64(m.Method))
+	}
+	return n
+}
+
+func (m *PairEmaSpan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Span != 0 {
+		n += 1 + sovOracle(uint64(m.Span))
+	}
 	return n
 }
 
@@ -930,6 +1681,14 @@ func (m *ExchangeRateTuple) Size() (n int) {
 	n += 1 + l + sovOracle(uint64(l))
 	l = m.ExchangeRate.Size()
 	n += 1 + l + sovOracle(uint64(l))
+	if m.Volume != nil {
+		l = m.Volume.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Twap != nil {
+		l = m.Twap.Size()
+		n += 1 + l + sovOracle(uint64(l))
+	}
 	return n
 }
 
@@ -965,6 +1724,15 @@ func (m *Rewards) Size() (n int) {
 			n += 1 + l + sovOracle(uint64(l))
 		}
 	}
+	l = m.Pair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.EndVotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.EndVotePeriod))
+	}
 	return n
 }
 
@@ -1179,9 +1947,498 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+			}
+			m.MinVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlocks", wireType)
+			}
+			m.ExpirationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceHistoryLength", wireType)
+			}
+			m.PerformanceHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerformanceHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType == 0 {
+				var v OraclePenaltyAction
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= OraclePenaltyAction(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PenaltyLadder = append(m.PenaltyLadder, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOracle
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthOracle
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOracle
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.PenaltyLadder) == 0 {
+					m.PenaltyLadder = make([]OraclePenaltyAction, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v OraclePenaltyAction
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOracle
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= OraclePenaltyAction(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PenaltyLadder = append(m.PenaltyLadder, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyLadder", wireType)
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardReductionFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardReductionFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodBlocks", wireType)
+			}
+			m.GracePeriodBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriodBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMaintenanceBlocks", wireType)
+			}
+			m.MaxMaintenanceBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMaintenanceBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationMethods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregationMethods = append(m.AggregationMethods, PairAggregationMethod{})
+			if err := m.AggregationMethods[len(m.AggregationMethods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmaSpan", wireType)
+			}
+			m.EmaSpan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmaSpan |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairEmaSpans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairEmaSpans = append(m.PairEmaSpans, PairEmaSpan{})
+			if err := m.PairEmaSpans[len(m.PairEmaSpans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederRewardCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederRewardCreationFee = append(m.FeederRewardCreationFee, types.Coin{})
+			if err := m.FeederRewardCreationFee[len(m.FeederRewardCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeederRewardsPerPair", wireType)
+			}
+			m.MaxFeederRewardsPerPair = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFeederRewardsPerPair |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairAggregationMethod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairAggregationMethod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairAggregationMethod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1209,15 +2466,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var msglen int
+			m.Method = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1227,47 +2484,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Method |= AggregationMethod(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOracle
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairEmaSpan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
 			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MinVoters = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinVoters |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 10:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairEmaSpan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairEmaSpan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1295,15 +2569,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValidatorFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Span", wireType)
 			}
-			m.ExpirationBlocks = 0
+			m.Span = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1313,7 +2587,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpirationBlocks |= uint64(b&0x7F) << shift
+				m.Span |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1685,6 +2959,78 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Volume = &v
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Twap = &v
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1910,6 +3256,91 @@ func (m *Rewards) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndVotePeriod", wireType)
+			}
+			m.EndVotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndVotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
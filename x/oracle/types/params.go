@@ -8,31 +8,47 @@ import (
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/set"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Parameter keys
 var (
-	KeyVotePeriod         = []byte("VotePeriod")
-	KeyVoteThreshold      = []byte("VoteThreshold")
-	KeyMinVoters          = []byte("MinVoters")
-	KeyRewardBand         = []byte("RewardBand")
-	KeyWhitelist          = []byte("Whitelist")
-	KeySlashFraction      = []byte("SlashFraction")
-	KeySlashWindow        = []byte("SlashWindow")
-	KeyMinValidPerWindow  = []byte("MinValidPerWindow")
-	KeyTwapLookbackWindow = []byte("TwapLookbackWindow")
-	KeyValidatorFeeRatio  = []byte("ValidatorFeeRatio")
+	KeyVotePeriod               = []byte("VotePeriod")
+	KeyVoteThreshold            = []byte("VoteThreshold")
+	KeyMinVoters                = []byte("MinVoters")
+	KeyRewardBand               = []byte("RewardBand")
+	KeyWhitelist                = []byte("Whitelist")
+	KeySlashFraction            = []byte("SlashFraction")
+	KeySlashWindow              = []byte("SlashWindow")
+	KeyMinValidPerWindow        = []byte("MinValidPerWindow")
+	KeyTwapLookbackWindow       = []byte("TwapLookbackWindow")
+	KeyValidatorFeeRatio        = []byte("ValidatorFeeRatio")
+	KeyPerformanceHistoryLength = []byte("PerformanceHistoryLength")
+	KeyPenaltyLadder            = []byte("PenaltyLadder")
+	KeyRewardReductionFraction  = []byte("RewardReductionFraction")
+	KeyGracePeriodBlocks        = []byte("GracePeriodBlocks")
+	KeyMaxMaintenanceBlocks     = []byte("MaxMaintenanceBlocks")
+	KeyAggregationMethods       = []byte("AggregationMethods")
+	KeyEmaSpan                  = []byte("EmaSpan")
+	KeyPairEmaSpans             = []byte("PairEmaSpans")
+	KeyFeederRewardCreationFee  = []byte("FeederRewardCreationFee")
+	KeyMaxFeederRewardsPerPair  = []byte("MaxFeederRewardsPerPair")
 )
 
 // Default parameter values
 // Assumes block times are 2s
 const (
-	DefaultVotePeriod       = 30   // vote every 1 minute
-	DefaultSlashWindow      = 3600 // 2 hours
-	DefaultMinVoters        = 4    // minimum of 4 voters for a pair to become valid
-	DefaultExpirationBlocks = 900  // 30 minutes
+	DefaultVotePeriod               = 30                                     // vote every 1 minute
+	DefaultSlashWindow              = 3600                                   // 2 hours
+	DefaultMinVoters                = 4                                      // minimum of 4 voters for a pair to become valid
+	DefaultExpirationBlocks         = 900                                    // 30 minutes
+	DefaultPerformanceHistoryLength = DefaultSlashWindow / DefaultVotePeriod // one slash window
+	DefaultGracePeriodBlocks        = DefaultSlashWindow                     // one slash window
+	DefaultMaxMaintenanceBlocks     = 1800                                   // 1 hour
+	DefaultEmaSpan                  = 15                                     // 15 vote periods, 15 minutes
+	DefaultMaxFeederRewardsPerPair  = 10                                     // active reward programs per pair
 )
 
 // Default parameter values
@@ -70,6 +86,18 @@ var (
 	DefaultMinValidPerWindow  = sdk.NewDecWithPrec(69, 2)       // 69%
 	DefaultTwapLookbackWindow = time.Duration(15 * time.Minute) // 15 minutes
 	DefaultValidatorFeeRatio  = sdk.NewDecWithPrec(5, 2)        // 0.05%
+	DefaultPenaltyLadder      = []OraclePenaltyAction{
+		OraclePenaltyAction_WARN,
+		OraclePenaltyAction_REDUCE_REWARDS,
+		OraclePenaltyAction_SLASH,
+		OraclePenaltyAction_SLASH_AND_JAIL,
+	}
+	DefaultRewardReductionFraction = sdk.NewDecWithPrec(5, 1) // 50%
+	// no pair overrides by default, nil so that the defaults round-trip
+	// unchanged through the store
+	DefaultAggregationMethods      []PairAggregationMethod = nil
+	DefaultPairEmaSpans            []PairEmaSpan           = nil
+	DefaultFeederRewardCreationFee                         = sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 10_000_000)) // 10 NIBI
 )
 
 // DefaultParams creates default oracle module parameters
@@ -86,6 +114,17 @@ func DefaultParams() Params {
 		MinValidPerWindow:  DefaultMinValidPerWindow,
 		TwapLookbackWindow: DefaultTwapLookbackWindow,
 		ValidatorFeeRatio:  DefaultValidatorFeeRatio,
+
+		PerformanceHistoryLength: DefaultPerformanceHistoryLength,
+		PenaltyLadder:            DefaultPenaltyLadder,
+		RewardReductionFraction:  DefaultRewardReductionFraction,
+		GracePeriodBlocks:        DefaultGracePeriodBlocks,
+		MaxMaintenanceBlocks:     DefaultMaxMaintenanceBlocks,
+		AggregationMethods:       DefaultAggregationMethods,
+		EmaSpan:                  DefaultEmaSpan,
+		PairEmaSpans:             DefaultPairEmaSpans,
+		FeederRewardCreationFee:  DefaultFeederRewardCreationFee,
+		MaxFeederRewardsPerPair:  DefaultMaxFeederRewardsPerPair,
 	}
 }
 
@@ -129,10 +168,89 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter ValidatorFeeRatio must be between [0, 1]")
 	}
 
+	if p.RewardReductionFraction.IsNil() ||
+		p.RewardReductionFraction.GT(sdk.OneDec()) || p.RewardReductionFraction.IsNegative() {
+		return fmt.Errorf("oracle parameter RewardReductionFraction must be between [0, 1]")
+	}
+
+	for _, action := range p.PenaltyLadder {
+		if _, known := OraclePenaltyAction_name[int32(action)]; !known ||
+			action == OraclePenaltyAction_ORACLE_PENALTY_ACTION_UNSPECIFIED {
+			return fmt.Errorf("oracle parameter PenaltyLadder has an invalid action: %s", action)
+		}
+	}
+
 	for _, pair := range p.Whitelist {
 		if err := pair.Validate(); err != nil {
 			return fmt.Errorf("oracle parameter Whitelist Pair invalid format: %w", err)
 		}
 	}
+
+	aggregationPairs := set.New[asset.Pair]()
+	for _, pairMethod := range p.AggregationMethods {
+		if err := pairMethod.Pair.Validate(); err != nil {
+			return fmt.Errorf("oracle parameter AggregationMethods Pair invalid format: %w", err)
+		}
+		if aggregationPairs.Has(pairMethod.Pair) {
+			return fmt.Errorf("oracle parameter AggregationMethods has a duplicate pair: %s", pairMethod.Pair)
+		}
+		aggregationPairs.Add(pairMethod.Pair)
+		if _, known := AggregationMethod_name[int32(pairMethod.Method)]; !known {
+			return fmt.Errorf("oracle parameter AggregationMethods has an invalid method for %s: %s", pairMethod.Pair, pairMethod.Method)
+		}
+	}
+
+	emaPairs := set.New[asset.Pair]()
+	for _, pairSpan := range p.PairEmaSpans {
+		if err := pairSpan.Pair.Validate(); err != nil {
+			return fmt.Errorf("oracle parameter PairEmaSpans Pair invalid format: %w", err)
+		}
+		if emaPairs.Has(pairSpan.Pair) {
+			return fmt.Errorf("oracle parameter PairEmaSpans has a duplicate pair: %s", pairSpan.Pair)
+		}
+		emaPairs.Add(pairSpan.Pair)
+	}
+
+	if !p.FeederRewardCreationFee.IsValid() {
+		return fmt.Errorf("oracle parameter FeederRewardCreationFee is invalid: %s", p.FeederRewardCreationFee)
+	}
 	return nil
 }
+
+// EmaSpanForPair returns the span of the exponential moving average price of
+// the pair, EmaSpan unless overridden in PairEmaSpans. Zero means the EMA is
+// disabled for the pair.
+func (p Params) EmaSpanForPair(pair asset.Pair) uint64 {
+	for _, pairSpan := range p.PairEmaSpans {
+		if pairSpan.Pair == pair {
+			return pairSpan.Span
+		}
+	}
+	return p.EmaSpan
+}
+
+// AggregationMethodForPair returns the method used to aggregate the votes of
+// the pair, the weighted median unless overridden in AggregationMethods.
+func (p Params) AggregationMethodForPair(pair asset.Pair) AggregationMethod {
+	for _, pairMethod := range p.AggregationMethods {
+		if pairMethod.Pair == pair {
+			return pairMethod.Method
+		}
+	}
+	return AggregationMethod_WEIGHTED_MEDIAN
+}
+
+// PenaltyForStrikes returns the penalty for a validator that failed `strikes`
+// consecutive slash windows, escalating through the PenaltyLadder.
+func (p Params) PenaltyForStrikes(strikes uint64) OraclePenaltyAction {
+	if len(p.PenaltyLadder) == 0 {
+		return OraclePenaltyAction_SLASH_AND_JAIL
+	}
+	if strikes == 0 {
+		return OraclePenaltyAction_ORACLE_PENALTY_ACTION_UNSPECIFIED
+	}
+	if strikes > uint64(len(p.PenaltyLadder)) {
+		return p.PenaltyLadder[len(p.PenaltyLadder)-1]
+	}
+	return p.PenaltyLadder[strikes-1]
+}
@@ -71,6 +71,73 @@ func TestParamsEqual(t *testing.T) {
 	err = p13.Validate()
 	require.Error(t, err)
 
+	// reward reduction fraction > 1
+	p14 := types.DefaultParams()
+	p14.RewardReductionFraction = sdk.NewDec(2)
+	err = p14.Validate()
+	require.Error(t, err)
+
+	// nil reward reduction fraction
+	p14.RewardReductionFraction = sdk.Dec{}
+	err = p14.Validate()
+	require.Error(t, err)
+
+	// unspecified penalty action
+	p15 := types.DefaultParams()
+	p15.PenaltyLadder = append(p15.PenaltyLadder, types.OraclePenaltyAction_ORACLE_PENALTY_ACTION_UNSPECIFIED)
+	err = p15.Validate()
+	require.Error(t, err)
+
+	// duplicate aggregation method
+	p16 := types.DefaultParams()
+	p16.AggregationMethods = []types.PairAggregationMethod{
+		{Pair: "ubtc:unusd", Method: types.AggregationMethod_TRIMMED_MEAN},
+		{Pair: "ubtc:unusd", Method: types.AggregationMethod_TWAP_MEDIAN},
+	}
+	err = p16.Validate()
+	require.Error(t, err)
+
+	// unknown aggregation method
+	p17 := types.DefaultParams()
+	p17.AggregationMethods = []types.PairAggregationMethod{
+		{Pair: "ubtc:unusd", Method: types.AggregationMethod(100)},
+	}
+	err = p17.Validate()
+	require.Error(t, err)
+
+	// duplicate ema span
+	p18 := types.DefaultParams()
+	p18.PairEmaSpans = []types.PairEmaSpan{
+		{Pair: "ubtc:unusd", Span: 10},
+		{Pair: "ubtc:unusd", Span: 0},
+	}
+	err = p18.Validate()
+	require.Error(t, err)
+
 	p11 := types.DefaultParams()
 	require.NotNil(t, p11.String())
 }
+
+func TestPenaltyForStrikes(t *testing.T) {
+	params := types.DefaultParams()
+	require.Equal(t, types.OraclePenaltyAction_WARN, params.PenaltyForStrikes(1))
+	require.Equal(t, types.OraclePenaltyAction_REDUCE_REWARDS, params.PenaltyForStrikes(2))
+	require.Equal(t, types.OraclePenaltyAction_SLASH, params.PenaltyForStrikes(3))
+	require.Equal(t, types.OraclePenaltyAction_SLASH_AND_JAIL, params.PenaltyForStrikes(4))
+	require.Equal(t, types.OraclePenaltyAction_SLASH_AND_JAIL, params.PenaltyForStrikes(10))
+
+	params.PenaltyLadder = nil
+	require.Equal(t, types.OraclePenaltyAction_SLASH_AND_JAIL, params.PenaltyForStrikes(1))
+}
+
+func TestEmaSpanForPair(t *testing.T) {
+	p := types.DefaultParams()
+	p.PairEmaSpans = []types.PairEmaSpan{
+		{Pair: "ubtc:unusd", Span: 60},
+		{Pair: "ueth:unusd", Span: 0},
+	}
+
+	require.EqualValues(t, 60, p.EmaSpanForPair("ubtc:unusd"))
+	require.EqualValues(t, 0, p.EmaSpanForPair("ueth:unusd"))
+	require.EqualValues(t, types.DefaultEmaSpan, p.EmaSpanForPair("unibi:unusd"))
+}
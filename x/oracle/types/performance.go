@@ -0,0 +1,77 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorPerformanceSummary returns an empty summary for the validator.
+func NewValidatorPerformanceSummary(valAddr sdk.ValAddress) ValidatorPerformanceSummary {
+	return ValidatorPerformanceSummary{
+		ValidatorAddress: valAddr.String(),
+		DeviationSum:     sdk.ZeroDec(),
+		AverageDeviation: sdk.ZeroDec(),
+		Score:            sdk.ZeroDec(),
+	}
+}
+
+// Add returns the summary with the record added to the rolling statistics.
+func (s ValidatorPerformanceSummary) Add(record ValidatorPerformanceRecord) ValidatorPerformanceSummary {
+	s.VotePeriods++
+	s.PairsExpected += record.PairsExpected
+	s.VotesCast += record.VotesCast
+	s.Abstains += record.Abstains
+	s.Misses += record.Misses
+	s.DeviationSum = s.DeviationSum.Add(record.DeviationSum)
+	return s.withDerivedStats()
+}
+
+// Sub returns the summary with the record removed from the rolling statistics.
+// It is used when a record falls out of the history window.
+func (s ValidatorPerformanceSummary) Sub(record ValidatorPerformanceRecord) ValidatorPerformanceSummary {
+	s.VotePeriods = safeSub(s.VotePeriods, 1)
+	s.PairsExpected = safeSub(s.PairsExpected, record.PairsExpected)
+	s.VotesCast = safeSub(s.VotesCast, record.VotesCast)
+	s.Abstains = safeSub(s.Abstains, record.Abstains)
+	s.Misses = safeSub(s.Misses, record.Misses)
+	s.DeviationSum = s.DeviationSum.Sub(record.DeviationSum)
+	if s.DeviationSum.IsNegative() {
+		s.DeviationSum = sdk.ZeroDec()
+	}
+	return s.withDerivedStats()
+}
+
+// withDerivedStats recomputes AverageDeviation and Score from the totals.
+func (s ValidatorPerformanceSummary) withDerivedStats() ValidatorPerformanceSummary {
+	s.AverageDeviation = sdk.ZeroDec()
+	if s.VotesCast > 0 {
+		s.AverageDeviation = s.DeviationSum.QuoInt64(int64(s.VotesCast))
+	}
+
+	// A validator that was not expected to vote on anything did not miss
+	// anything either.
+	s.Score = sdk.OneDec()
+	if s.PairsExpected > 0 {
+		s.Score = sdk.NewDec(int64(safeSub(s.PairsExpected, s.Misses))).QuoInt64(int64(s.PairsExpected))
+	}
+	return s
+}
+
+// IsBetterThan reports whether the summary ranks above the other one in the
+// performance leaderboard: a higher score wins, then a lower average
+// deviation, then the validator address for determinism.
+func (s ValidatorPerformanceSummary) IsBetterThan(other ValidatorPerformanceSummary) bool {
+	if !s.Score.Equal(other.Score) {
+		return s.Score.GT(other.Score)
+	}
+	if !s.AverageDeviation.Equal(other.AverageDeviation) {
+		return s.AverageDeviation.LT(other.AverageDeviation)
+	}
+	return s.ValidatorAddress < other.ValidatorAddress
+}
+
+func safeSub(a, b uint64) uint64 {
+	if b > a {
+		return 0
+	}
+	return a - b
+}
@@ -75,35 +75,431 @@ func (m *PriceSnapshot) GetTimestampMs() int64 {
 	return 0
 }
 
+// ValidatorPerformanceRecord records how a validator performed during a
+// single vote period.
+type ValidatorPerformanceRecord struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// block_height is the last block of the vote period that was tallied.
+	BlockHeight uint64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// pairs_expected is the number of pairs with a passing ballot, i.e. the
+	// number of pairs the validator was expected to vote on.
+	PairsExpected uint64 `protobuf:"varint,3,opt,name=pairs_expected,json=pairsExpected,proto3" json:"pairs_expected,omitempty"`
+	// votes_cast is the number of positive exchange rate votes.
+	VotesCast uint64 `protobuf:"varint,4,opt,name=votes_cast,json=votesCast,proto3" json:"votes_cast,omitempty"`
+	// abstains is the number of non-positive exchange rate votes.
+	Abstains uint64 `protobuf:"varint,5,opt,name=abstains,proto3" json:"abstains,omitempty"`
+	// misses is the number of expected pairs that the validator did not vote
+	// on, or voted on outside of the reward band.
+	Misses uint64 `protobuf:"varint,6,opt,name=misses,proto3" json:"misses,omitempty"`
+	// deviation_sum is the sum of the relative deviations, |vote - median| /
+	// median, of every vote cast.
+	DeviationSum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=deviation_sum,json=deviationSum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deviation_sum"`
+}
+
+func (m *ValidatorPerformanceRecord) Reset()         { *m = ValidatorPerformanceRecord{} }
+func (m *ValidatorPerformanceRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceRecord) ProtoMessage()    {}
+func (*ValidatorPerformanceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_125e6c5a6e45c0d0, []int{1}
+}
+func (m *ValidatorPerformanceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformanceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformanceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformanceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceRecord.Merge(m, src)
+}
+func (m *ValidatorPerformanceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformanceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformanceRecord proto.InternalMessageInfo
+
+func (m *ValidatorPerformanceRecord) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorPerformanceRecord) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceRecord) GetPairsExpected() uint64 {
+	if m != nil {
+		return m.PairsExpected
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceRecord) GetVotesCast() uint64 {
+	if m != nil {
+		return m.VotesCast
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceRecord) GetAbstains() uint64 {
+	if m != nil {
+		return m.Abstains
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceRecord) GetMisses() uint64 {
+	if m != nil {
+		return m.Misses
+	}
+	return 0
+}
+
+// ValidatorPerformanceSummary aggregates the performance records of a
+// validator that are still within the history window.
+type ValidatorPerformanceSummary struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// vote_periods is the number of records aggregated in the summary.
+	VotePeriods   uint64                                 `protobuf:"varint,2,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty"`
+	PairsExpected uint64                                 `protobuf:"varint,3,opt,name=pairs_expected,json=pairsExpected,proto3" json:"pairs_expected,omitempty"`
+	VotesCast     uint64                                 `protobuf:"varint,4,opt,name=votes_cast,json=votesCast,proto3" json:"votes_cast,omitempty"`
+	Abstains      uint64                                 `protobuf:"varint,5,opt,name=abstains,proto3" json:"abstains,omitempty"`
+	Misses        uint64                                 `protobuf:"varint,6,opt,name=misses,proto3" json:"misses,omitempty"`
+	DeviationSum  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=deviation_sum,json=deviationSum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"deviation_sum"`
+	// average_deviation is deviation_sum / votes_cast.
+	AverageDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=average_deviation,json=averageDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_deviation"`
+	// score is the share of expected pairs that were not missed, i.e.
+	// (pairs_expected - misses) / pairs_expected.
+	Score github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=score,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"score"`
+}
+
+func (m *ValidatorPerformanceSummary) Reset()         { *m = ValidatorPerformanceSummary{} }
+func (m *ValidatorPerformanceSummary) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceSummary) ProtoMessage()    {}
+func (*ValidatorPerformanceSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_125e6c5a6e45c0d0, []int{2}
+}
+func (m *ValidatorPerformanceSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPerformanceSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPerformanceSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPerformanceSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceSummary.Merge(m, src)
+}
+func (m *ValidatorPerformanceSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPerformanceSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformanceSummary proto.InternalMessageInfo
+
+func (m *ValidatorPerformanceSummary) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorPerformanceSummary) GetVotePeriods() uint64 {
+	if m != nil {
+		return m.VotePeriods
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceSummary) GetPairsExpected() uint64 {
+	if m != nil {
+		return m.PairsExpected
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceSummary) GetVotesCast() uint64 {
+	if m != nil {
+		return m.VotesCast
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceSummary) GetAbstains() uint64 {
+	if m != nil {
+		return m.Abstains
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceSummary) GetMisses() uint64 {
+	if m != nil {
+		return m.Misses
+	}
+	return 0
+}
+
+// ValidatorPenaltyState tracks the escalation of oracle penalties of a
+// validator that failed consecutive slash windows.
+type ValidatorPenaltyState struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// strikes is the number of consecutive slash windows failed.
+	Strikes uint64 `protobuf:"varint,2,opt,name=strikes,proto3" json:"strikes,omitempty"`
+	// last_action is the penalty applied at the last failed slash window.
+	LastAction OraclePenaltyAction `protobuf:"varint,3,opt,name=last_action,json=lastAction,proto3,enum=nibiru.oracle.v1.OraclePenaltyAction" json:"last_action,omitempty"`
+}
+
+func (m *ValidatorPenaltyState) Reset()         { *m = ValidatorPenaltyState{} }
+func (m *ValidatorPenaltyState) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPenaltyState) ProtoMessage()    {}
+func (*ValidatorPenaltyState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_125e6c5a6e45c0d0, []int{3}
+}
+func (m *ValidatorPenaltyState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPenaltyState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPenaltyState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPenaltyState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPenaltyState.Merge(m, src)
+}
+func (m *ValidatorPenaltyState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPenaltyState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPenaltyState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPenaltyState proto.InternalMessageInfo
+
+func (m *ValidatorPenaltyState) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorPenaltyState) GetStrikes() uint64 {
+	if m != nil {
+		return m.Strikes
+	}
+	return 0
+}
+
+func (m *ValidatorPenaltyState) GetLastAction() OraclePenaltyAction {
+	if m != nil {
+		return m.LastAction
+	}
+	return OraclePenaltyAction_ORACLE_PENALTY_ACTION_UNSPECIFIED
+}
+
+// MaintenanceWindow is a range of blocks, announced ahead of time by a
+// validator, during which its missed votes are not counted.
+type MaintenanceWindow struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	StartHeight      uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight        uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *MaintenanceWindow) Reset()         { *m = MaintenanceWindow{} }
+func (m *MaintenanceWindow) String() string { return proto.CompactTextString(m) }
+func (*MaintenanceWindow) ProtoMessage()    {}
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_125e6c5a6e45c0d0, []int{4}
+}
+func (m *MaintenanceWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaintenanceWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaintenanceWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceWindow.Merge(m, src)
+}
+func (m *MaintenanceWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceWindow proto.InternalMessageInfo
+
+func (m *MaintenanceWindow) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MaintenanceWindow) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MaintenanceWindow) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// ValidatorBondedSince records the height at which the oracle first saw a
+// validator in the bonded set, used to apply the grace period.
+type ValidatorBondedSince struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Height           uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *ValidatorBondedSince) Reset()         { *m = ValidatorBondedSince{} }
+func (m *ValidatorBondedSince) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBondedSince) ProtoMessage()    {}
+func (*ValidatorBondedSince) Descriptor() ([]byte, []int) {
+	return fileDescriptor_125e6c5a6e45c0d0, []int{5}
+}
+func (m *ValidatorBondedSince) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorBondedSince) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorBondedSince.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorBondedSince) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBondedSince.Merge(m, src)
+}
+func (m *ValidatorBondedSince) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorBondedSince) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBondedSince.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBondedSince proto.InternalMessageInfo
+
+func (m *ValidatorBondedSince) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorBondedSince) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PriceSnapshot)(nil), "nibiru.oracle.v1.PriceSnapshot")
+	proto.RegisterType((*ValidatorPerformanceRecord)(nil), "nibiru.oracle.v1.ValidatorPerformanceRecord")
+	proto.RegisterType((*ValidatorPerformanceSummary)(nil), "nibiru.oracle.v1.ValidatorPerformanceSummary")
+	proto.RegisterType((*ValidatorPenaltyState)(nil), "nibiru.oracle.v1.ValidatorPenaltyState")
+	proto.RegisterType((*MaintenanceWindow)(nil), "nibiru.oracle.v1.MaintenanceWindow")
+	proto.RegisterType((*ValidatorBondedSince)(nil), "nibiru.oracle.v1.ValidatorBondedSince")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/state.proto", fileDescriptor_125e6c5a6e45c0d0) }
 
 var fileDescriptor_125e6c5a6e45c0d0 = []byte{
-	// 329 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0x41, 0x4b, 0xf3, 0x30,
-	0x18, 0x80, 0xdb, 0x6f, 0x9f, 0x82, 0x9d, 0x82, 0x14, 0x0f, 0x63, 0xcc, 0x6c, 0xee, 0x20, 0x3b,
-	0x68, 0x42, 0xf1, 0xe6, 0x71, 0x0e, 0xf1, 0xa2, 0x8c, 0x79, 0x13, 0x41, 0xde, 0xc6, 0xd0, 0x05,
-	0x97, 0xbc, 0xa1, 0xc9, 0x86, 0xfb, 0x17, 0xfe, 0xac, 0x1d, 0x77, 0x12, 0xf1, 0x30, 0x64, 0xfb,
-	0x07, 0xfe, 0x02, 0x69, 0x53, 0x44, 0xf0, 0xe0, 0xa9, 0x25, 0x4f, 0xf2, 0x3c, 0xc9, 0x1b, 0xb5,
-	0xb4, 0x4c, 0x65, 0x3e, 0x65, 0x98, 0x03, 0x9f, 0x08, 0x36, 0x4b, 0x98, 0x75, 0xe0, 0x04, 0x35,
-	0x39, 0x3a, 0x8c, 0xf7, 0x3d, 0xa5, 0x9e, 0xd2, 0x59, 0xd2, 0x3c, 0xc8, 0x30, 0xc3, 0x12, 0xb2,
-	0xe2, 0xcf, 0xef, 0x6b, 0xb6, 0x32, 0xc4, 0x6c, 0x22, 0x18, 0x18, 0xc9, 0x40, 0x6b, 0x74, 0xe0,
-	0x24, 0x6a, 0x5b, 0xd1, 0xc3, 0x5f, 0x8d, 0xca, 0xe7, 0x31, 0xe1, 0x68, 0x15, 0x5a, 0x96, 0x82,
-	0x2d, 0x60, 0x2a, 0x1c, 0x24, 0x8c, 0xa3, 0xd4, 0x9e, 0x77, 0x5f, 0xc3, 0x68, 0x6f, 0x98, 0x4b,
-	0x2e, 0x6e, 0x35, 0x18, 0x3b, 0x46, 0x17, 0xdf, 0x47, 0xff, 0x0d, 0xc8, 0xbc, 0x11, 0x76, 0xc2,
-	0xde, 0x4e, 0xff, 0x6a, 0xb1, 0x6a, 0x07, 0xef, 0xab, 0x76, 0x92, 0x49, 0x37, 0x9e, 0xa6, 0x94,
-	0xa3, 0x62, 0x37, 0x65, 0xf1, 0x62, 0x0c, 0x52, 0xb3, 0xaa, 0xfe, 0xcc, 0x38, 0x2a, 0x85, 0x9a,
-	0x81, 0xb5, 0xc2, 0xd1, 0x21, 0xc8, 0xfc, 0x73, 0xd5, 0xae, 0xcf, 0x41, 0x4d, 0xce, 0xbb, 0x85,
-	0xae, 0x3b, 0x2a, 0xad, 0xf1, 0x20, 0xda, 0x32, 0x45, 0xae, 0xf1, 0xaf, 0xd4, 0xd3, 0x4a, 0x7f,
-	0xfc, 0x43, 0x5f, 0xdd, 0xd8, 0x7f, 0x4e, 0xed, 0xe3, 0x13, 0x73, 0x73, 0x23, 0x2c, 0x1d, 0x08,
-	0x3e, 0xf2, 0x87, 0xe3, 0xa3, 0x68, 0xd7, 0x49, 0x25, 0xac, 0x03, 0x65, 0x1e, 0x94, 0x6d, 0xd4,
-	0x3a, 0x61, 0xaf, 0x36, 0xaa, 0x7f, 0xaf, 0x5d, 0xdb, 0xfe, 0xe5, 0x62, 0x4d, 0xc2, 0xe5, 0x9a,
-	0x84, 0x1f, 0x6b, 0x12, 0xbe, 0x6c, 0x48, 0xb0, 0xdc, 0x90, 0xe0, 0x6d, 0x43, 0x82, 0xbb, 0x93,
-	0xbf, 0x9e, 0x52, 0x8d, 0xb2, 0xac, 0xa6, 0xdb, 0xe5, 0x9c, 0xce, 0xbe, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x21, 0xeb, 0xd1, 0x23, 0xcc, 0x01, 0x00, 0x00,
+	// 683 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x54, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x21, 0x04, 0x32, 0x01, 0x04, 0x16, 0x17, 0x59, 0xb9, 0x10, 0xb8, 0x96, 0xb8, 0x42,
+	0xba, 0xb7, 0xb6, 0xd2, 0xee, 0xba, 0xe3, 0xa7, 0x88, 0x0d, 0x6d, 0xe4, 0x48, 0xad, 0x54, 0x2a,
+	0x59, 0x27, 0xf6, 0x69, 0x32, 0x22, 0x9e, 0xb1, 0xe6, 0x4c, 0x52, 0xb2, 0xed, 0x13, 0xf4, 0x29,
+	0xfa, 0x12, 0x7d, 0x01, 0x96, 0xac, 0xaa, 0xaa, 0x0b, 0x5a, 0xc1, 0x1b, 0xf4, 0x09, 0x2a, 0x8f,
+	0x9d, 0x88, 0xd2, 0x4a, 0x55, 0x58, 0x76, 0x65, 0xcf, 0xf7, 0xcd, 0x7c, 0xe7, 0xff, 0xb0, 0x0d,
+	0xc1, 0x3b, 0x5c, 0x0d, 0x7c, 0xa9, 0x20, 0xea, 0xa3, 0x3f, 0x6c, 0xfa, 0xa4, 0x41, 0xa3, 0x97,
+	0x2a, 0xa9, 0xa5, 0xbd, 0x92, 0xb3, 0x5e, 0xce, 0x7a, 0xc3, 0x66, 0x7d, 0xad, 0x2b, 0xbb, 0xd2,
+	0x90, 0x7e, 0xf6, 0x97, 0xdf, 0xab, 0x6f, 0x74, 0xa5, 0xec, 0xf6, 0xd1, 0x87, 0x94, 0xfb, 0x20,
+	0x84, 0xd4, 0xa0, 0xb9, 0x14, 0x54, 0xb0, 0x9b, 0x3f, 0xd9, 0x28, 0xf4, 0x72, 0xba, 0x11, 0x49,
+	0x4a, 0x24, 0xf9, 0x1d, 0xa0, 0x8c, 0xec, 0xa0, 0x86, 0xa6, 0x1f, 0x49, 0x2e, 0x72, 0xde, 0xfd,
+	0x68, 0xb1, 0xa5, 0x96, 0xe2, 0x11, 0xb6, 0x05, 0xa4, 0xd4, 0x93, 0xda, 0x7e, 0xc5, 0xca, 0x29,
+	0x70, 0xe5, 0x58, 0xdb, 0xd6, 0x6e, 0x75, 0xff, 0xf8, 0xe2, 0x6a, 0xab, 0xf4, 0xf9, 0x6a, 0xab,
+	0xd9, 0xe5, 0xba, 0x37, 0xe8, 0x78, 0x91, 0x4c, 0xfc, 0xa7, 0xc6, 0xe2, 0x41, 0x0f, 0xb8, 0xf0,
+	0x0b, 0xeb, 0xe7, 0x7e, 0x24, 0x93, 0x44, 0x0a, 0x1f, 0x88, 0x50, 0x7b, 0x2d, 0xe0, 0xea, 0xdb,
+	0xd5, 0x56, 0x6d, 0x04, 0x49, 0xff, 0xb1, 0x9b, 0xc9, 0xb9, 0x81, 0x51, 0xb5, 0x0f, 0xd9, 0x5c,
+	0x9a, 0x99, 0x73, 0x66, 0x8c, 0xbc, 0x57, 0xc8, 0xff, 0x7b, 0x4b, 0xbe, 0xf0, 0x38, 0xff, 0x3c,
+	0xa0, 0xf8, 0xcc, 0xd7, 0xa3, 0x14, 0xc9, 0x3b, 0xc4, 0x28, 0xc8, 0x1f, 0xdb, 0xff, 0xb0, 0x45,
+	0xcd, 0x13, 0x24, 0x0d, 0x49, 0x1a, 0x26, 0xe4, 0xcc, 0x6e, 0x5b, 0xbb, 0xb3, 0x41, 0x6d, 0x82,
+	0x9d, 0x90, 0xfb, 0x61, 0x86, 0xd5, 0x9f, 0x43, 0x9f, 0xc7, 0xa0, 0xa5, 0x6a, 0xa1, 0x7a, 0x2d,
+	0x55, 0x02, 0x22, 0xc2, 0x00, 0x23, 0xa9, 0x62, 0xfb, 0x3f, 0xb6, 0x3a, 0x1c, 0xb3, 0x21, 0xc4,
+	0xb1, 0x42, 0xa2, 0x3c, 0xe4, 0x60, 0x65, 0x42, 0xec, 0xe5, 0x78, 0x66, 0xae, 0xd3, 0x97, 0xd1,
+	0x59, 0xd8, 0x43, 0xde, 0xed, 0x69, 0xe3, 0x7b, 0x39, 0xa8, 0x19, 0xec, 0xd8, 0x40, 0xf6, 0x0e,
+	0x5b, 0xce, 0xe2, 0xa3, 0x10, 0xcf, 0x53, 0x8c, 0x34, 0xc6, 0xc6, 0xa7, 0x72, 0xb0, 0x64, 0xd0,
+	0x27, 0x05, 0x68, 0x6f, 0x32, 0x36, 0x94, 0x1a, 0x29, 0x8c, 0x80, 0xb4, 0x53, 0x36, 0x57, 0xaa,
+	0x06, 0x39, 0x00, 0xd2, 0x76, 0x9d, 0x2d, 0x40, 0x87, 0x34, 0x70, 0x41, 0xce, 0x9c, 0x21, 0x27,
+	0x67, 0x7b, 0x9d, 0x55, 0x12, 0x4e, 0x84, 0xe4, 0x54, 0x0c, 0x53, 0x9c, 0xec, 0x36, 0x5b, 0x8a,
+	0x71, 0xc8, 0x4d, 0x53, 0x84, 0x34, 0x48, 0x9c, 0xf9, 0x7b, 0x65, 0x76, 0x71, 0x22, 0xd2, 0x1e,
+	0x24, 0xee, 0x97, 0x59, 0xf6, 0xf7, 0xaf, 0xb2, 0xd7, 0x1e, 0x24, 0x09, 0xa8, 0xd1, 0xd4, 0xe9,
+	0xcb, 0x42, 0x0c, 0x53, 0x54, 0x5c, 0xc6, 0x34, 0x4e, 0x5f, 0x86, 0xb5, 0x72, 0xe8, 0x0f, 0x4b,
+	0x9f, 0x7d, 0xca, 0x56, 0x61, 0x88, 0x0a, 0xba, 0x18, 0x4e, 0x70, 0x67, 0xe1, 0x5e, 0xc2, 0x2b,
+	0x85, 0xd0, 0xe1, 0x58, 0x27, 0x1b, 0x21, 0x8a, 0xa4, 0x42, 0xa7, 0x7a, 0xbf, 0x11, 0x32, 0x8f,
+	0xdd, 0xf7, 0x16, 0xfb, 0xeb, 0x56, 0x85, 0x05, 0xf4, 0xf5, 0xa8, 0xad, 0x41, 0xe3, 0x74, 0xb5,
+	0x75, 0xd8, 0x3c, 0x69, 0xc5, 0xcf, 0x70, 0x5c, 0xd6, 0xf1, 0xd1, 0x3e, 0x62, 0xb5, 0x3e, 0x90,
+	0x0e, 0x21, 0x32, 0xd1, 0x67, 0xf5, 0x5c, 0x7e, 0xb8, 0xe3, 0xdd, 0x5d, 0x7a, 0xde, 0x33, 0xf3,
+	0x57, 0x78, 0xb0, 0x67, 0x2e, 0x07, 0x2c, 0x7b, 0x99, 0xff, 0xbb, 0x6f, 0x2d, 0xb6, 0x7a, 0x02,
+	0x5c, 0x68, 0x14, 0x59, 0x07, 0xbe, 0xe0, 0x22, 0x96, 0x6f, 0xa6, 0x6e, 0x40, 0xd2, 0xa0, 0xf4,
+	0x9d, 0xf9, 0x35, 0x58, 0x31, 0xbf, 0x9b, 0x8c, 0xa1, 0x88, 0xc7, 0x17, 0xf2, 0xe6, 0xab, 0xa2,
+	0x88, 0x73, 0xda, 0x3d, 0x65, 0x6b, 0x93, 0x64, 0xed, 0x4b, 0x11, 0x63, 0xdc, 0xe6, 0x22, 0x9a,
+	0x32, 0x57, 0xeb, 0xac, 0xf2, 0x83, 0x03, 0xc5, 0x69, 0xff, 0xe8, 0xe2, 0xba, 0x61, 0x5d, 0x5e,
+	0x37, 0xac, 0xaf, 0xd7, 0x0d, 0xeb, 0xdd, 0x4d, 0xa3, 0x74, 0x79, 0xd3, 0x28, 0x7d, 0xba, 0x69,
+	0x94, 0x5e, 0xfe, 0xff, 0xbb, 0xad, 0x5b, 0x6c, 0x7d, 0x53, 0xdd, 0x4e, 0xc5, 0xac, 0xf4, 0x47,
+	0xdf, 0x07, 0x00, 0x43, 0x59, 0x1b, 0x44, 0x77, 0x06, 0x00, 0x00,
 }
 
 func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
@@ -147,47 +543,1177 @@ func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformanceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DeviationSum.Size()
+		i -= size
+		if _, err := m.DeviationSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Misses != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Misses))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Abstains != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Abstains))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VotesCast != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.VotesCast))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PairsExpected != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.PairsExpected))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPerformanceSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPerformanceSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPerformanceSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Score.Size()
+		i -= size
+		if _, err := m.Score.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.AverageDeviation.Size()
+		i -= size
+		if _, err := m.AverageDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.DeviationSum.Size()
+		i -= size
+		if _, err := m.DeviationSum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Misses != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Misses))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Abstains != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Abstains))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VotesCast != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.VotesCast))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PairsExpected != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.PairsExpected))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VotePeriods != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPenaltyState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPenaltyState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPenaltyState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastAction != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.LastAction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Strikes != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Strikes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorBondedSince) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBondedSince) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorBondedSince) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.TimestampMs != 0 {
+		n += 1 + sovState(uint64(m.TimestampMs))
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	if m.PairsExpected != 0 {
+		n += 1 + sovState(uint64(m.PairsExpected))
+	}
+	if m.VotesCast != 0 {
+		n += 1 + sovState(uint64(m.VotesCast))
+	}
+	if m.Abstains != 0 {
+		n += 1 + sovState(uint64(m.Abstains))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovState(uint64(m.Misses))
+	}
+	l = m.DeviationSum.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *ValidatorPerformanceSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.VotePeriods != 0 {
+		n += 1 + sovState(uint64(m.VotePeriods))
+	}
+	if m.PairsExpected != 0 {
+		n += 1 + sovState(uint64(m.PairsExpected))
+	}
+	if m.VotesCast != 0 {
+		n += 1 + sovState(uint64(m.VotesCast))
+	}
+	if m.Abstains != 0 {
+		n += 1 + sovState(uint64(m.Abstains))
+	}
+	if m.Misses != 0 {
+		n += 1 + sovState(uint64(m.Misses))
+	}
+	l = m.DeviationSum.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.AverageDeviation.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Score.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *ValidatorPenaltyState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Strikes != 0 {
+		n += 1 + sovState(uint64(m.Strikes))
+	}
+	if m.LastAction != 0 {
+		n += 1 + sovState(uint64(m.LastAction))
+	}
+	return n
+}
+
+func (m *MaintenanceWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovState(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovState(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *ValidatorBondedSince) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovState(uint64(m.Height))
+	}
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+			}
+			m.TimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformanceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairsExpected", wireType)
+			}
+			m.PairsExpected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairsExpected |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesCast", wireType)
+			}
+			m.VotesCast = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotesCast |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstains", wireType)
+			}
+			m.Abstains = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Abstains |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
+			}
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationSum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeviationSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformanceSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
+			}
+			m.VotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairsExpected", wireType)
+			}
+			m.PairsExpected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PairsExpected |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotesCast", wireType)
+			}
+			m.VotesCast = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotesCast |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstains", wireType)
+			}
+			m.Abstains = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Abstains |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Misses", wireType)
+			}
+			m.Misses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Misses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationSum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeviationSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Score.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *PriceSnapshot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.Price.Size()
-	n += 1 + l + sovState(uint64(l))
-	if m.TimestampMs != 0 {
-		n += 1 + sovState(uint64(m.TimestampMs))
+func (m *ValidatorPenaltyState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPenaltyState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPenaltyState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strikes", wireType)
+			}
+			m.Strikes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strikes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAction", wireType)
+			}
+			m.LastAction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAction |= OraclePenaltyAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozState(x uint64) (n int) {
-	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -210,15 +1736,15 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -246,13 +1772,99 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBondedSince) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBondedSince: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBondedSince: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -280,15 +1892,13 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.TimestampMs = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -298,7 +1908,7 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimestampMs |= int64(b&0x7F) << shift
+				m.Height |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_NibiruChain_nibiru_x_common_asset "github.com/NibiruChain/nibiru/x/common/asset"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -276,6 +279,176 @@ func (m *MsgDelegateFeedConsentResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDelegateFeedConsentResponse proto.InternalMessageInfo
 
+// MsgAnnounceMaintenance represents a message to pre-announce an oracle
+// maintenance window for a validator.
+type MsgAnnounceMaintenance struct {
+	Operator    string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	EndHeight   uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty" yaml:"end_height"`
+}
+
+func (m *MsgAnnounceMaintenance) Reset()         { *m = MsgAnnounceMaintenance{} }
+func (m *MsgAnnounceMaintenance) String() string { return proto.CompactTextString(m) }
+func (*MsgAnnounceMaintenance) ProtoMessage()    {}
+func (*MsgAnnounceMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{6}
+}
+func (m *MsgAnnounceMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAnnounceMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAnnounceMaintenance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAnnounceMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAnnounceMaintenance.Merge(m, src)
+}
+func (m *MsgAnnounceMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAnnounceMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAnnounceMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAnnounceMaintenance proto.InternalMessageInfo
+
+// MsgAnnounceMaintenanceResponse defines the Msg/AnnounceMaintenance response
+// type.
+type MsgAnnounceMaintenanceResponse struct {
+}
+
+func (m *MsgAnnounceMaintenanceResponse) Reset()         { *m = MsgAnnounceMaintenanceResponse{} }
+func (m *MsgAnnounceMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAnnounceMaintenanceResponse) ProtoMessage()    {}
+func (*MsgAnnounceMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{7}
+}
+func (m *MsgAnnounceMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAnnounceMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAnnounceMaintenanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAnnounceMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAnnounceMaintenanceResponse.Merge(m, src)
+}
+func (m *MsgAnnounceMaintenanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAnnounceMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAnnounceMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAnnounceMaintenanceResponse proto.InternalMessageInfo
+
+// MsgCreateFeederReward represents a message to fund rewards for the oracle
+// voters. The coins are distributed evenly over vote_periods vote periods.
+type MsgCreateFeederReward struct {
+	Sender      string                                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Coins       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins" yaml:"coins"`
+	VotePeriods uint64                                   `protobuf:"varint,3,opt,name=vote_periods,json=votePeriods,proto3" json:"vote_periods,omitempty" yaml:"vote_periods"`
+	// pair, if set, limits the rewards to the validators that voted faithfully
+	// for the pair.
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,4,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair" yaml:"pair"`
+}
+
+func (m *MsgCreateFeederReward) Reset()         { *m = MsgCreateFeederReward{} }
+func (m *MsgCreateFeederReward) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateFeederReward) ProtoMessage()    {}
+func (*MsgCreateFeederReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{8}
+}
+func (m *MsgCreateFeederReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateFeederReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateFeederReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateFeederReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateFeederReward.Merge(m, src)
+}
+func (m *MsgCreateFeederReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateFeederReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateFeederReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateFeederReward proto.InternalMessageInfo
+
+// MsgCreateFeederRewardResponse defines the Msg/CreateFeederReward response
+// type.
+type MsgCreateFeederRewardResponse struct {
+	// id is the identifier of the created rewards.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCreateFeederRewardResponse) Reset()         { *m = MsgCreateFeederRewardResponse{} }
+func (m *MsgCreateFeederRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateFeederRewardResponse) ProtoMessage()    {}
+func (*MsgCreateFeederRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11e362c65eb610f4, []int{9}
+}
+func (m *MsgCreateFeederRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateFeederRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateFeederRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateFeederRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateFeederRewardResponse.Merge(m, src)
+}
+func (m *MsgCreateFeederRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateFeederRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateFeederRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateFeederRewardResponse proto.InternalMessageInfo
+
+func (m *MsgCreateFeederRewardResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgAggregateExchangeRatePrevote)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRatePrevote")
 	proto.RegisterType((*MsgAggregateExchangeRatePrevoteResponse)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRatePrevoteResponse")
@@ -283,47 +456,72 @@ func init() {
 	proto.RegisterType((*MsgAggregateExchangeRateVoteResponse)(nil), "nibiru.oracle.v1.MsgAggregateExchangeRateVoteResponse")
 	proto.RegisterType((*MsgDelegateFeedConsent)(nil), "nibiru.oracle.v1.MsgDelegateFeedConsent")
 	proto.RegisterType((*MsgDelegateFeedConsentResponse)(nil), "nibiru.oracle.v1.MsgDelegateFeedConsentResponse")
+	proto.RegisterType((*MsgAnnounceMaintenance)(nil), "nibiru.oracle.v1.MsgAnnounceMaintenance")
+	proto.RegisterType((*MsgAnnounceMaintenanceResponse)(nil), "nibiru.oracle.v1.MsgAnnounceMaintenanceResponse")
+	proto.RegisterType((*MsgCreateFeederReward)(nil), "nibiru.oracle.v1.MsgCreateFeederReward")
+	proto.RegisterType((*MsgCreateFeederRewardResponse)(nil), "nibiru.oracle.v1.MsgCreateFeederRewardResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/oracle/v1/tx.proto", fileDescriptor_11e362c65eb610f4) }
 
 var fileDescriptor_11e362c65eb610f4 = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0x4d, 0x55, 0xb5, 0x87, 0x4a, 0x8b, 0xfb, 0x47, 0x49, 0x88, 0xec, 0xe8, 0x40,
-	0xa5, 0x95, 0xc0, 0x26, 0x45, 0x42, 0xa2, 0x13, 0xb4, 0xd0, 0x2d, 0x08, 0x79, 0x60, 0x60, 0x41,
-	0x97, 0xe4, 0xe5, 0x62, 0xc9, 0xf5, 0x59, 0xbe, 0x6b, 0x94, 0xae, 0x88, 0x81, 0x11, 0x89, 0x89,
-	0x2d, 0x1f, 0x00, 0x89, 0xaf, 0xc1, 0x58, 0x89, 0x85, 0x29, 0x42, 0x09, 0x03, 0x13, 0x83, 0xf9,
-	0x02, 0xc8, 0x77, 0xb6, 0x49, 0x43, 0xfa, 0x27, 0x5b, 0x74, 0xcf, 0xef, 0xde, 0xf7, 0x79, 0x9f,
-	0xbc, 0x67, 0x5c, 0x0e, 0xbc, 0xa6, 0x17, 0x1d, 0x3b, 0x3c, 0xa2, 0x2d, 0x1f, 0x9c, 0x6e, 0xdd,
-	0x91, 0x3d, 0x3b, 0x8c, 0xb8, 0xe4, 0xc6, 0xaa, 0x96, 0x6c, 0x2d, 0xd9, 0xdd, 0x7a, 0x65, 0x9d,
-	0x71, 0xc6, 0x95, 0xe8, 0x24, 0xbf, 0x34, 0x57, 0xa9, 0x32, 0xce, 0x99, 0x0f, 0x0e, 0x0d, 0x3d,
-	0x87, 0x06, 0x01, 0x97, 0x54, 0x7a, 0x3c, 0x10, 0x5a, 0x25, 0x5f, 0x10, 0xb6, 0x1a, 0x82, 0x3d,
-	0x61, 0x2c, 0x02, 0x46, 0x25, 0x3c, 0xeb, 0xb5, 0x3a, 0x34, 0x60, 0xe0, 0x52, 0x09, 0x2f, 0x22,
-	0xe8, 0x72, 0x09, 0xc6, 0x2d, 0x3c, 0xdf, 0xa1, 0xa2, 0x53, 0x42, 0x35, 0xb4, 0xbd, 0xb4, 0xbf,
-	0x12, 0x0f, 0xac, 0x6b, 0x27, 0xf4, 0xc8, 0xdf, 0x23, 0xc9, 0x29, 0x71, 0x95, 0x68, 0xec, 0xe0,
-	0x85, 0x37, 0x00, 0x6d, 0x88, 0x4a, 0x73, 0x0a, 0xbb, 0x11, 0x0f, 0xac, 0x65, 0x8d, 0xe9, 0x73,
-	0xe2, 0xa6, 0x80, 0xb1, 0x8b, 0x97, 0xba, 0xd4, 0xf7, 0xda, 0x54, 0xf2, 0xa8, 0x54, 0x54, 0xf4,
-	0x7a, 0x3c, 0xb0, 0x56, 0x35, 0x9d, 0x4b, 0xc4, 0xfd, 0x87, 0xed, 0x2d, 0xbe, 0xef, 0x5b, 0x85,
-	0x5f, 0x7d, 0xab, 0x40, 0x76, 0xf0, 0x9d, 0x4b, 0x0c, 0xbb, 0x20, 0x42, 0x1e, 0x08, 0x20, 0xbf,
-	0x11, 0xae, 0x9e, 0xc7, 0xbe, 0x4c, 0x27, 0x13, 0xd4, 0x97, 0xff, 0x4f, 0x96, 0x9c, 0x12, 0x57,
-	0x89, 0xc6, 0x63, 0x7c, 0x1d, 0xd2, 0x8b, 0xaf, 0x23, 0x2a, 0x41, 0xa4, 0x13, 0x96, 0xe3, 0x81,
-	0xb5, 0xa1, 0xf1, 0xb3, 0x3a, 0x71, 0x97, 0x61, 0xac, 0x93, 0x18, 0xcb, 0xa6, 0x38, 0x53, 0x36,
-	0xf3, 0xb3, 0x66, 0xb3, 0x85, 0x6f, 0x5f, 0x34, 0x6f, 0x1e, 0xcc, 0x3b, 0x84, 0x37, 0x1b, 0x82,
-	0x3d, 0x05, 0x5f, 0x71, 0x87, 0x00, 0xed, 0x83, 0x44, 0x08, 0xa4, 0xe1, 0xe0, 0x45, 0x1e, 0x42,
-	0xa4, 0xfa, 0xeb, 0x58, 0xd6, 0xe2, 0x81, 0xb5, 0xa2, 0xfb, 0x67, 0x0a, 0x71, 0x73, 0x28, 0xb9,
-	0xd0, 0x4e, 0xeb, 0xa4, 0xc1, 0x8c, 0x5d, 0xc8, 0x14, 0xe2, 0xe6, 0xd0, 0x98, 0xdd, 0x1a, 0x36,
-	0xa7, 0xbb, 0xc8, 0x8c, 0xee, 0xfe, 0x29, 0xe2, 0x62, 0x43, 0x30, 0xe3, 0x33, 0xc2, 0xd5, 0x0b,
-	0x77, 0xb4, 0x6e, 0x4f, 0x3e, 0x07, 0xfb, 0x92, 0x2d, 0xa9, 0x3c, 0x9a, 0xf9, 0x4a, 0x9e, 0x9f,
-	0xf9, 0xf6, 0xdb, 0xcf, 0x8f, 0x73, 0x25, 0xb2, 0xe9, 0x9c, 0x7d, 0x9f, 0x61, 0xea, 0xa6, 0x8f,
-	0x70, 0xf9, 0xfc, 0xad, 0xb3, 0xaf, 0xde, 0x38, 0xe1, 0x2b, 0x0f, 0x67, 0xe3, 0x73, 0x97, 0x37,
-	0x95, 0xcb, 0x0d, 0xb2, 0x36, 0xe1, 0x52, 0x59, 0xfc, 0x84, 0xf0, 0xda, 0xb4, 0xff, 0x7f, 0x7b,
-	0x6a, 0xb3, 0x29, 0x64, 0xe5, 0xfe, 0x55, 0xc9, 0xdc, 0xd0, 0x96, 0x32, 0x54, 0x23, 0xe6, 0x84,
-	0x21, 0xbd, 0xfb, 0xf7, 0xb2, 0x0d, 0xd9, 0x3f, 0xfc, 0x3a, 0x34, 0xd1, 0xe9, 0xd0, 0x44, 0x3f,
-	0x86, 0x26, 0xfa, 0x30, 0x32, 0x0b, 0xa7, 0x23, 0xb3, 0xf0, 0x7d, 0x64, 0x16, 0x5e, 0xdd, 0x65,
-	0x9e, 0xec, 0x1c, 0x37, 0xed, 0x16, 0x3f, 0x72, 0x9e, 0xab, 0x1a, 0x07, 0x1d, 0xea, 0x05, 0x59,
-	0xbd, 0x5e, 0x56, 0x51, 0x9e, 0x84, 0x20, 0x9a, 0x0b, 0xea, 0x1b, 0xf7, 0xe0, 0x6f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xfc, 0x7f, 0x51, 0x06, 0x46, 0x05, 0x00, 0x00,
+	// 894 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x26, 0x6a, 0x26, 0xfd, 0xb9, 0x6e, 0x8a, 0x63, 0xc2, 0x6e, 0x34, 0x85, 0x36,
+	0x15, 0x64, 0xa7, 0x0e, 0x08, 0x89, 0x9c, 0xda, 0x04, 0xaa, 0x5e, 0x8c, 0xa2, 0x39, 0x70, 0x40,
+	0x48, 0xd1, 0x78, 0xf7, 0xb1, 0x5e, 0x61, 0xcf, 0x98, 0x9d, 0x49, 0x48, 0xaf, 0x88, 0x03, 0x47,
+	0x24, 0x4e, 0x08, 0x09, 0xe5, 0x8c, 0x90, 0x90, 0xf8, 0x1f, 0x90, 0x7a, 0xac, 0xc4, 0x05, 0x38,
+	0x2c, 0x28, 0xe1, 0xc0, 0x89, 0xc3, 0xfe, 0x05, 0xd5, 0xce, 0xac, 0x37, 0x5b, 0x67, 0x9b, 0xd8,
+	0xa7, 0x64, 0xe6, 0xfb, 0xde, 0x7b, 0xdf, 0x7b, 0x9e, 0xf7, 0xd9, 0x68, 0x85, 0x47, 0xfd, 0x28,
+	0xde, 0x27, 0x22, 0x66, 0xfe, 0x10, 0xc8, 0x41, 0x97, 0xa8, 0x43, 0x6f, 0x1c, 0x0b, 0x25, 0xec,
+	0xeb, 0x06, 0xf2, 0x0c, 0xe4, 0x1d, 0x74, 0x3b, 0x37, 0x43, 0x11, 0x0a, 0x0d, 0x92, 0xec, 0x3f,
+	0xc3, 0xeb, 0xac, 0x86, 0x42, 0x84, 0x43, 0x20, 0x6c, 0x1c, 0x11, 0xc6, 0xb9, 0x50, 0x4c, 0x45,
+	0x82, 0xcb, 0x1c, 0x75, 0x7c, 0x21, 0x47, 0x42, 0x92, 0x3e, 0x93, 0x59, 0xfa, 0x3e, 0x28, 0xd6,
+	0x25, 0xbe, 0x88, 0xb8, 0xc1, 0xf1, 0x2f, 0x16, 0x72, 0x7b, 0x32, 0x7c, 0x18, 0x86, 0x31, 0x84,
+	0x4c, 0xc1, 0x87, 0x87, 0xfe, 0x80, 0xf1, 0x10, 0x28, 0x53, 0xb0, 0x1b, 0xc3, 0x81, 0x50, 0x60,
+	0xdf, 0x46, 0xcd, 0x01, 0x93, 0x83, 0xb6, 0xb5, 0x66, 0xad, 0x2f, 0x6e, 0x5f, 0x4b, 0x13, 0x77,
+	0xe9, 0x09, 0x1b, 0x0d, 0xb7, 0x70, 0x76, 0x8b, 0xa9, 0x06, 0xed, 0x7b, 0x68, 0xe1, 0x33, 0x80,
+	0x00, 0xe2, 0x76, 0x5d, 0xd3, 0x6e, 0xa4, 0x89, 0x7b, 0xc5, 0xd0, 0xcc, 0x3d, 0xa6, 0x39, 0xc1,
+	0xde, 0x44, 0x8b, 0x07, 0x6c, 0x18, 0x05, 0x4c, 0x89, 0xb8, 0xdd, 0xd0, 0xec, 0x9b, 0x69, 0xe2,
+	0x5e, 0x37, 0xec, 0x02, 0xc2, 0xf4, 0x94, 0xb6, 0x75, 0xe9, 0x9b, 0x23, 0xb7, 0xf6, 0xdf, 0x91,
+	0x5b, 0xc3, 0xf7, 0xd0, 0xdd, 0x0b, 0x04, 0x53, 0x90, 0x63, 0xc1, 0x25, 0xe0, 0xff, 0x2d, 0xb4,
+	0xfa, 0x32, 0xee, 0xc7, 0x79, 0x67, 0x92, 0x0d, 0xd5, 0xd9, 0xce, 0xb2, 0x5b, 0x4c, 0x35, 0x68,
+	0x3f, 0x40, 0x57, 0x21, 0x0f, 0xdc, 0x8b, 0x99, 0x02, 0x99, 0x77, 0xb8, 0x92, 0x26, 0xee, 0xb2,
+	0xa1, 0xbf, 0x88, 0x63, 0x7a, 0x05, 0x4a, 0x95, 0x64, 0x69, 0x36, 0x8d, 0xb9, 0x66, 0xd3, 0x9c,
+	0x77, 0x36, 0x77, 0xd0, 0x1b, 0xe7, 0xf5, 0x5b, 0x0c, 0xe6, 0x6b, 0x0b, 0xdd, 0xea, 0xc9, 0xf0,
+	0x03, 0x18, 0x6a, 0xde, 0x23, 0x80, 0x60, 0x27, 0x03, 0xb8, 0xb2, 0x09, 0xba, 0x24, 0xc6, 0x10,
+	0xeb, 0xfa, 0x66, 0x2c, 0xad, 0x34, 0x71, 0xaf, 0x99, 0xfa, 0x13, 0x04, 0xd3, 0x82, 0x94, 0x05,
+	0x04, 0x79, 0x9e, 0x76, 0x7d, 0x3a, 0x60, 0x82, 0x60, 0x5a, 0x90, 0x4a, 0x72, 0xd7, 0x90, 0x53,
+	0xad, 0xa2, 0x10, 0xfa, 0x9b, 0x11, 0xfa, 0x90, 0x73, 0xb1, 0xcf, 0x7d, 0xe8, 0xb1, 0x88, 0x2b,
+	0xe0, 0x8c, 0xfb, 0x30, 0xbf, 0xd0, 0x2d, 0x74, 0x59, 0x2a, 0x16, 0xab, 0xbd, 0x01, 0x44, 0xe1,
+	0x40, 0x69, 0xb1, 0xcd, 0xed, 0x57, 0xd3, 0xc4, 0x6d, 0xe5, 0x1f, 0x7a, 0x09, 0xc5, 0x74, 0x49,
+	0x1f, 0x1f, 0xeb, 0x93, 0xfd, 0x2e, 0x42, 0xc0, 0x83, 0x49, 0x64, 0x43, 0x47, 0x2e, 0xa7, 0x89,
+	0x7b, 0xc3, 0x44, 0x9e, 0x62, 0x98, 0x2e, 0x02, 0x0f, 0x4c, 0xd4, 0x99, 0x4e, 0x2b, 0xda, 0x28,
+	0x3a, 0xfd, 0xb3, 0x8e, 0x96, 0x7b, 0x32, 0xdc, 0x89, 0x21, 0x1f, 0x05, 0xc4, 0x14, 0xbe, 0x64,
+	0x71, 0x90, 0xbd, 0x1e, 0x09, 0x3c, 0x80, 0x49, 0x9b, 0xa5, 0xd7, 0x63, 0xee, 0x31, 0xcd, 0x09,
+	0xf6, 0x17, 0xe8, 0x95, 0x6c, 0xb7, 0xb3, 0x17, 0xda, 0x58, 0x5f, 0xda, 0x5c, 0xf1, 0xcc, 0xf6,
+	0x7b, 0xd9, 0xf6, 0x7b, 0xf9, 0xf6, 0x7b, 0x3b, 0x22, 0xe2, 0xdb, 0x0f, 0x9e, 0x26, 0x6e, 0x2d,
+	0x4d, 0xdc, 0xcb, 0x26, 0x91, 0x8e, 0xc2, 0x3f, 0xfd, 0xed, 0xae, 0x87, 0x91, 0x1a, 0xec, 0xf7,
+	0x3d, 0x5f, 0x8c, 0x48, 0x6e, 0x1d, 0xe6, 0xcf, 0x86, 0x0c, 0x3e, 0x27, 0xea, 0xc9, 0x18, 0xa4,
+	0x4e, 0x20, 0xa9, 0xa9, 0x94, 0x4d, 0x35, 0xdb, 0xb9, 0xbd, 0x31, 0xc4, 0x91, 0x08, 0x64, 0xbb,
+	0x31, 0x3d, 0xd5, 0x32, 0x8a, 0xe9, 0x52, 0x76, 0xdc, 0x35, 0x27, 0xfb, 0x53, 0xd4, 0x1c, 0xb3,
+	0x68, 0xf2, 0xce, 0x1f, 0x67, 0x92, 0xfe, 0x4a, 0xdc, 0x6e, 0x49, 0xc2, 0x47, 0xda, 0x03, 0x77,
+	0x06, 0x2c, 0xe2, 0x24, 0xb7, 0xca, 0x43, 0xe2, 0x8b, 0xd1, 0x48, 0x70, 0xc2, 0xa4, 0x04, 0xe5,
+	0xed, 0xb2, 0x28, 0x3e, 0xdd, 0xdb, 0x2c, 0x1d, 0xa6, 0x3a, 0x6b, 0x69, 0xfa, 0x04, 0xbd, 0x5e,
+	0x39, 0xda, 0xc9, 0xf0, 0xed, 0xab, 0xa8, 0x1e, 0x05, 0x7a, 0xbc, 0x4d, 0x5a, 0x8f, 0x82, 0xcd,
+	0x5f, 0x17, 0x50, 0xa3, 0x27, 0x43, 0xfb, 0x67, 0x0b, 0xad, 0x9e, 0x6b, 0x8d, 0x5d, 0x6f, 0xda,
+	0xa5, 0xbd, 0x0b, 0xcc, 0xa9, 0xf3, 0xfe, 0xdc, 0x21, 0xc5, 0x1b, 0x71, 0xbe, 0xfa, 0xfd, 0xdf,
+	0xef, 0xea, 0x6d, 0x7c, 0x8b, 0xbc, 0xf8, 0xb5, 0x31, 0xce, 0xd5, 0x1c, 0x59, 0x68, 0xe5, 0xe5,
+	0x66, 0xe7, 0xcd, 0x5e, 0x38, 0xe3, 0x77, 0xde, 0x9b, 0x8f, 0x5f, 0xa8, 0x7c, 0x4d, 0xab, 0x5c,
+	0xc6, 0xad, 0x29, 0x95, 0x5a, 0xe2, 0xf7, 0x16, 0x6a, 0x55, 0xd9, 0xce, 0x7a, 0x65, 0xb1, 0x0a,
+	0x66, 0xe7, 0xfe, 0xac, 0xcc, 0x42, 0xd0, 0x1d, 0x2d, 0x68, 0x0d, 0x3b, 0x53, 0x82, 0x8c, 0xe5,
+	0x6e, 0x4c, 0x8c, 0xc9, 0xfe, 0xd1, 0x42, 0xad, 0x2a, 0xa7, 0xa9, 0xd6, 0x56, 0xc1, 0xec, 0xdc,
+	0x9f, 0x95, 0x59, 0x68, 0x7b, 0x4b, 0x6b, 0x7b, 0x13, 0xdf, 0x9e, 0xd2, 0xc6, 0xf2, 0x98, 0x8d,
+	0x51, 0x49, 0xc8, 0x0f, 0x16, 0xb2, 0x2b, 0x0c, 0xe2, 0x6e, 0x65, 0xd5, 0xb3, 0xc4, 0x0e, 0x99,
+	0x91, 0x78, 0xa1, 0x3a, 0x5f, 0x87, 0x6c, 0xe4, 0x03, 0x8c, 0x75, 0xd0, 0xf6, 0xa3, 0xa7, 0xc7,
+	0x8e, 0xf5, 0xec, 0xd8, 0xb1, 0xfe, 0x39, 0x76, 0xac, 0x6f, 0x4f, 0x9c, 0xda, 0xb3, 0x13, 0xa7,
+	0xf6, 0xc7, 0x89, 0x53, 0xfb, 0xe4, 0xed, 0x8b, 0x36, 0x3a, 0x4f, 0xab, 0xed, 0xa5, 0xbf, 0xa0,
+	0x7f, 0x99, 0xbc, 0xf3, 0x7c, 0x00, 0x78, 0x4f, 0x31, 0xf1, 0x1c, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -348,6 +546,12 @@ type MsgClient interface {
 	// to another address known as a price feeder.
 	// See https://github.com/NibiruChain/pricefeeder.
 	DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error)
+	// AnnounceMaintenance defines a method for a validator to pre-announce a
+	// window of blocks during which its missed votes are not counted.
+	AnnounceMaintenance(ctx context.Context, in *MsgAnnounceMaintenance, opts ...grpc.CallOption) (*MsgAnnounceMaintenanceResponse, error)
+	// CreateFeederReward defines a method for funding rewards for the oracle
+	// voters, spread over a number of vote periods.
+	CreateFeederReward(ctx context.Context, in *MsgCreateFeederReward, opts ...grpc.CallOption) (*MsgCreateFeederRewardResponse, error)
 }
 
 type msgClient struct {
@@ -385,6 +589,24 @@ func (c *msgClient) DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeed
 	return out, nil
 }
 
+func (c *msgClient) AnnounceMaintenance(ctx context.Context, in *MsgAnnounceMaintenance, opts ...grpc.CallOption) (*MsgAnnounceMaintenanceResponse, error) {
+	out := new(MsgAnnounceMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Msg/AnnounceMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateFeederReward(ctx context.Context, in *MsgCreateFeederReward, opts ...grpc.CallOption) (*MsgCreateFeederRewardResponse, error) {
+	out := new(MsgCreateFeederRewardResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Msg/CreateFeederReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AggregateExchangeRatePrevote defines a method for submitting
@@ -397,6 +619,12 @@ type MsgServer interface {
 	// to another address known as a price feeder.
 	// See https://github.com/NibiruChain/pricefeeder.
 	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
+	// AnnounceMaintenance defines a method for a validator to pre-announce a
+	// window of blocks during which its missed votes are not counted.
+	AnnounceMaintenance(context.Context, *MsgAnnounceMaintenance) (*MsgAnnounceMaintenanceResponse, error)
+	// CreateFeederReward defines a method for funding rewards for the oracle
+	// voters, spread over a number of vote periods.
+	CreateFeederReward(context.Context, *MsgCreateFeederReward) (*MsgCreateFeederRewardResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -412,6 +640,12 @@ func (*UnimplementedMsgServer) AggregateExchangeRateVote(ctx context.Context, re
 func (*UnimplementedMsgServer) DelegateFeedConsent(ctx context.Context, req *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegateFeedConsent not implemented")
 }
+func (*UnimplementedMsgServer) AnnounceMaintenance(ctx context.Context, req *MsgAnnounceMaintenance) (*MsgAnnounceMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnounceMaintenance not implemented")
+}
+func (*UnimplementedMsgServer) CreateFeederReward(ctx context.Context, req *MsgCreateFeederReward) (*MsgCreateFeederRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFeederReward not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -471,6 +705,42 @@ func _Msg_DelegateFeedConsent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AnnounceMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAnnounceMaintenance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AnnounceMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Msg/AnnounceMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AnnounceMaintenance(ctx, req.(*MsgAnnounceMaintenance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateFeederReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateFeederReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateFeederReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Msg/CreateFeederReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateFeederReward(ctx, req.(*MsgCreateFeederReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.oracle.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -487,6 +757,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegateFeedConsent",
 			Handler:    _Msg_DelegateFeedConsent_Handler,
 		},
+		{
+			MethodName: "AnnounceMaintenance",
+			Handler:    _Msg_AnnounceMaintenance_Handler,
+		},
+		{
+			MethodName: "CreateFeederReward",
+			Handler:    _Msg_CreateFeederReward_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/oracle/v1/tx.proto",
@@ -693,6 +971,156 @@ func (m *MsgDelegateFeedConsentResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgAnnounceMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnnounceMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnnounceMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAnnounceMaintenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnnounceMaintenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnnounceMaintenanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFeederReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFeederReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFeederReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.VotePeriods != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VotePeriods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateFeederRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateFeederRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateFeederRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -794,15 +1222,225 @@ func (m *MsgDelegateFeedConsentResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgAnnounceMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTx(uint64(m.EndHeight))
+	}
+	return n
 }
-func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *MsgAnnounceMaintenanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateFeederReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.VotePeriods != 0 {
+		n += 1 + sovTx(uint64(m.VotePeriods))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateFeederRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -810,28 +1448,110 @@ func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -859,9 +1579,9 @@ func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.ExchangeRates = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
 			}
@@ -893,7 +1613,7 @@ func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			}
 			m.Feeder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
@@ -946,7 +1666,7 @@ func (m *MsgAggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -969,10 +1689,10 @@ func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -996,7 +1716,7 @@ func (m *MsgAggregateExchangeRatePrevoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1019,15 +1739,15 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateFeedConsent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateFeedConsent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1055,11 +1775,11 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1087,11 +1807,111 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = string(dAtA[iNdEx:postIndex])
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAnnounceMaintenance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnnounceMaintenance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnnounceMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1119,13 +1939,13 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Feeder = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var stringLen uint64
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1135,24 +1955,30 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1174,7 +2000,7 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAnnounceMaintenanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1197,10 +2023,10 @@ func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAnnounceMaintenanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAggregateExchangeRateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAnnounceMaintenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1224,7 +2050,7 @@ func (m *MsgAggregateExchangeRateVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateFeederReward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1247,15 +2073,15 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateFeederReward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateFeederReward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1283,11 +2109,64 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriods", wireType)
+			}
+			m.VotePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1315,7 +2194,9 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegate = string(dAtA[iNdEx:postIndex])
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1338,7 +2219,7 @@ func (m *MsgDelegateFeedConsent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateFeederRewardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1361,12 +2242,31 @@ func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateFeederRewardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateFeedConsentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateFeederRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
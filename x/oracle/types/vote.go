@@ -51,8 +51,8 @@ func (v AggregateExchangeRateVote) String() string {
 // NewExchangeRateTuple creates a ExchangeRateTuple instance
 func NewExchangeRateTuple(pair asset.Pair, exchangeRate sdk.Dec) ExchangeRateTuple {
 	return ExchangeRateTuple{
-		pair,
-		exchangeRate,
+		Pair:         pair,
+		ExchangeRate: exchangeRate,
 	}
 }
 
@@ -69,12 +69,27 @@ func (m ExchangeRateTuple) ToString() (string, error) {
 		return "", err
 	}
 
+	// the volume and the twap are optional and only appended when reported, so
+	// that votes without them keep their format. A twap without a volume leaves
+	// the volume empty.
+	volume := ""
+	if m.Volume != nil {
+		volume = ExchangeRateTuplePairRateSeparator + m.Volume.String()
+	}
+	if m.Twap != nil {
+		if m.Volume == nil {
+			volume = ExchangeRateTuplePairRateSeparator
+		}
+		volume += ExchangeRateTuplePairRateSeparator + m.Twap.String()
+	}
+
 	return fmt.Sprintf(
-		"%c%s%s%s%c",
+		"%c%s%s%s%s%c",
 		ExchangeRateTupleStringPrefix,
 		m.Pair,
 		ExchangeRateTuplePairRateSeparator,
 		m.ExchangeRate.String(),
+		volume,
 		ExchangeRateTupleStringSuffix,
 	), nil
 }
@@ -92,7 +107,7 @@ func NewExchangeRateTupleFromString(s string) (ExchangeRateTuple, error) {
 
 	stripParentheses := s[1 : len(s)-1]
 	split := strings.Split(stripParentheses, ExchangeRateTuplePairRateSeparator)
-	if len(split) != 2 {
+	if len(split) < 2 || len(split) > 4 {
 		return ExchangeRateTuple{}, fmt.Errorf("invalid ExchangeRateTuple format")
 	}
 
@@ -106,10 +121,35 @@ func NewExchangeRateTupleFromString(s string) (ExchangeRateTuple, error) {
 		return ExchangeRateTuple{}, fmt.Errorf("invalid decimal %s: %w", split[1], err)
 	}
 
-	return ExchangeRateTuple{
+	tuple := ExchangeRateTuple{
 		Pair:         pair,
 		ExchangeRate: dec,
-	}, nil
+	}
+
+	// the volume may only be left empty when it is followed by a twap
+	if len(split) >= 3 && (len(split) == 3 || split[2] != "") {
+		volume, err := sdk.NewDecFromStr(split[2])
+		if err != nil {
+			return ExchangeRateTuple{}, fmt.Errorf("invalid volume %s: %w", split[2], err)
+		}
+		if volume.IsNegative() {
+			return ExchangeRateTuple{}, fmt.Errorf("invalid volume %s: must not be negative", split[2])
+		}
+		tuple.Volume = &volume
+	}
+
+	if len(split) == 4 {
+		twap, err := sdk.NewDecFromStr(split[3])
+		if err != nil {
+			return ExchangeRateTuple{}, fmt.Errorf("invalid twap %s: %w", split[3], err)
+		}
+		if twap.IsNegative() {
+			return ExchangeRateTuple{}, fmt.Errorf("invalid twap %s: must not be negative", split[3])
+		}
+		tuple.Twap = &twap
+	}
+
+	return tuple, nil
 }
 
 // ExchangeRateTuples - array of ExchangeRateTuple
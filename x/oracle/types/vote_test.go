@@ -80,7 +80,63 @@ func TestExchangeRateTuple(t *testing.T) {
 	})
 
 	t.Run("invalid format", func(t *testing.T) {
-		_, err := types.NewExchangeRateTupleFromString("(1000.0,nibi:usd,1000.0)")
+		_, err := types.NewExchangeRateTupleFromString("(1000.0,nibi:usd,1000.0,1000.0,1000.0)")
 		require.ErrorContains(t, err, "invalid ExchangeRateTuple format")
 	})
+
+	t.Run("with volume", func(t *testing.T) {
+		volume := sdk.MustNewDecFromStr("12.5")
+		exchangeRate := types.ExchangeRateTuple{
+			Pair:         "BTC:USD",
+			ExchangeRate: sdk.MustNewDecFromStr("40000.00"),
+			Volume:       &volume,
+		}
+		exchangeRateStr, err := exchangeRate.ToString()
+		require.NoError(t, err)
+		require.Equal(t, "(BTC:USD,40000.000000000000000000,12.500000000000000000)", exchangeRateStr)
+
+		parsedExchangeRate, err := types.NewExchangeRateTupleFromString(exchangeRateStr)
+		require.NoError(t, err)
+		require.Equal(t, exchangeRate, parsedExchangeRate)
+	})
+
+	t.Run("with twap", func(t *testing.T) {
+		volume, twap := sdk.MustNewDecFromStr("12.5"), sdk.MustNewDecFromStr("39950")
+		for _, tc := range []struct {
+			tuple    types.ExchangeRateTuple
+			expected string
+		}{
+			{
+				tuple:    types.ExchangeRateTuple{Pair: "BTC:USD", ExchangeRate: sdk.MustNewDecFromStr("40000.00"), Twap: &twap},
+				expected: "(BTC:USD,40000.000000000000000000,,39950.000000000000000000)",
+			},
+			{
+				tuple:    types.ExchangeRateTuple{Pair: "BTC:USD", ExchangeRate: sdk.MustNewDecFromStr("40000.00"), Volume: &volume, Twap: &twap},
+				expected: "(BTC:USD,40000.000000000000000000,12.500000000000000000,39950.000000000000000000)",
+			},
+		} {
+			exchangeRateStr, err := tc.tuple.ToString()
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, exchangeRateStr)
+
+			parsedExchangeRate, err := types.NewExchangeRateTupleFromString(exchangeRateStr)
+			require.NoError(t, err)
+			require.Equal(t, tc.tuple, parsedExchangeRate)
+		}
+	})
+
+	t.Run("empty volume without twap", func(t *testing.T) {
+		_, err := types.NewExchangeRateTupleFromString("(nibi:usd,1000.0,)")
+		require.ErrorContains(t, err, "invalid volume")
+	})
+
+	t.Run("negative twap", func(t *testing.T) {
+		_, err := types.NewExchangeRateTupleFromString("(nibi:usd,1000.0,,-1.0)")
+		require.ErrorContains(t, err, "must not be negative")
+	})
+
+	t.Run("negative volume", func(t *testing.T) {
+		_, err := types.NewExchangeRateTupleFromString("(nibi:usd,1000.0,-1.0)")
+		require.ErrorContains(t, err, "must not be negative")
+	})
 }
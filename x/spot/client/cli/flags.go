@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -41,6 +43,7 @@ type createPoolInputs struct {
 	ExitFee        string `json:"exit-fee"`
 	PoolType       string `json:"pool-type"`
 	Amplification  string `json:"amplification"`
+	TickSpacing    uint64 `json:"tick-spacing"`
 }
 
 func FlagSetCreatePool() *flag.FlagSet {
@@ -84,3 +87,26 @@ func (cpi createPoolInputs) AmplificationInt() (sdkmath.Int, error) {
 	}
 	return amplificationInt, nil
 }
+
+// ParseSwapRoutes parses routes of the form "pool-id:token-out-denom,..." such
+// as "1:unusd,2:uusdc".
+func ParseSwapRoutes(routesStr string) (routes []types.SwapRoute, err error) {
+	for _, hop := range strings.Split(routesStr, ",") {
+		poolIdStr, denom, found := strings.Cut(strings.TrimSpace(hop), ":")
+		if !found {
+			return nil, types.ErrInvalidSwapRoute.Wrapf(
+				"hop %q must be of the form pool-id:token-out-denom", hop)
+		}
+
+		poolId, err := strconv.ParseUint(poolIdStr, 10, 64)
+		if err != nil {
+			return nil, types.ErrInvalidPoolId.Wrapf("%s: %s", poolIdStr, err)
+		}
+
+		routes = append(routes, types.SwapRoute{
+			PoolId:        poolId,
+			TokenOutDenom: denom,
+		})
+	}
+	return routes, nil
+}
@@ -36,6 +36,14 @@ func GetQueryCmd() *cobra.Command {
 		CmdGetPool(),
 		CmdTotalLiquidity(),
 		CmdTotalPoolLiquidity(),
+		CmdEstimateSwapRoute(),
+		CmdBestSwapRoute(),
+		CmdEstimateJoinExactAmountOut(),
+		CmdEstimateExitExactAmountOut(),
+		CmdPosition(),
+		CmdPositions(),
+		CmdTwap(),
+		CmdPoolFees(),
 	)
 
 	return spotQueryCmd
@@ -192,3 +200,376 @@ $ %s query spot pool-liquidity 1
 
 	return cmd
 }
+
+func CmdEstimateSwapRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-swap-route [routes] [token-in]",
+		Short: "Estimates the tokens obtained by swapping token-in through a route of pools",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Every hop of the routes is given as pool-id:token-out-denom.
+
+Example:
+$ %s query spot estimate-swap-route 1:unusd,2:uusdc 100unibi
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			routes, err := ParseSwapRoutes(args[0])
+			if err != nil {
+				return err
+			}
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateSwapRoute(cmd.Context(), &types.QueryEstimateSwapRouteRequest{
+				TokenIn: tokenIn,
+				Routes:  routes,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBestSwapRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "best-swap-route [token-in] [token-out-denom] [max-hops]",
+		Short: "Finds the route of pools returning the most tokens of token-out-denom for token-in",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Routes go through at most max-hops pools, %d if not given.
+
+Example:
+$ %s query spot best-swap-route 100unibi uusdc 2
+`,
+				types.MaxSwapRouteHops,
+				version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			var maxHops uint64
+			if len(args) == 3 {
+				maxHops, err = strconv.ParseUint(args[2], 10, 32)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BestSwapRoute(cmd.Context(), &types.QueryBestSwapRouteRequest{
+				TokenIn:       tokenIn,
+				TokenOutDenom: args[1],
+				MaxHops:       uint32(maxHops),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEstimateJoinExactAmountOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-join-exact-amount-out [pool-id] [pool-shares-out] [token-in-denom]",
+		Short: "Estimates the tokens of token-in-denom required to join a pool for pool-shares-out shares",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s query spot estimate-join-exact-amount-out 1 1000 unibi
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			poolSharesOut, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid pool-shares-out: %s", args[1])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateJoinExactAmountOut(cmd.Context(), &types.QueryJoinExactAmountOutRequest{
+				PoolId:        poolId,
+				PoolSharesOut: poolSharesOut,
+				TokenInDenom:  args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEstimateExitExactAmountOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-exit-exact-amount-out [pool-id] [token-out]",
+		Short: "Estimates the pool shares required to exit a pool into token-out",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s query spot estimate-exit-exact-amount-out 1 100unibi
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			tokenOut, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EstimateExitExactAmountOut(cmd.Context(), &types.QueryExitExactAmountOutRequest{
+				PoolId:   poolId,
+				TokenOut: tokenOut,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "position [position-id]",
+		Short: "Get a concentrated liquidity position by its ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Position(cmd.Context(), &types.QueryPositionRequest{
+				PositionId: positionId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "positions [owner]",
+		Short: "Get the concentrated liquidity positions of an owner",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Positions(cmd.Context(), &types.QueryPositionsRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [pool-id] [base-denom] [quote-denom] [start-time-ms] [end-time-ms]",
+		Short: "Get the time weighted average price of an asset of a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Returns the time weighted average price of the base asset in the quote asset of
+a pool, between two block times in milliseconds. The end time defaults to the
+current block time.
+
+Example:
+$ %s query spot twap 1 uatom uusdc 1690000000000
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(4, 5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			startTimeMs, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var endTimeMs int64
+			if len(args) == 5 {
+				endTimeMs, err = strconv.ParseInt(args[4], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Twap(cmd.Context(), &types.QueryTwapRequest{
+				PoolId:      poolId,
+				BaseDenom:   args[1],
+				QuoteDenom:  args[2],
+				StartTimeMs: startTimeMs,
+				EndTimeMs:   endTimeMs,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPoolFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-fees [pool-id]",
+		Short: "Get the cumulative swap fees collected by a pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Returns the swap fees collected by a pool since its creation, per denom, and the
+part of them taken by the protocol.
+
+Example:
+$ %s query spot pool-fees 1
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PoolFees(cmd.Context(), &types.QueryPoolFeesRequest{
+				PoolId: poolId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	sdkmath "cosmossdk.io/math"
@@ -33,6 +34,12 @@ func GetTxCmd() *cobra.Command {
 		CmdJoinPool(),
 		CmdExitPool(),
 		CmdSwapAssets(),
+		CmdSwapExactAmountInRoute(),
+		CmdSwapExactAmountOutRoute(),
+		CmdJoinSwapExternAmountIn(),
+		CmdExitSwapShareAmountIn(),
+		CmdCreatePosition(),
+		CmdWithdrawPosition(),
 	)
 
 	return cmd
@@ -102,6 +109,121 @@ $ %s tx spot swap-assets --pool-id 1 --tokens-in 100stake --token-out-denom vali
 	return cmd
 }
 
+func CmdSwapExactAmountInRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-amount-in-route [routes] [token-in] [token-out-min-amount]",
+		Short: "swap an exact amount of tokens in through a route of pools",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Swaps token-in through the pools of the routes, in order. Every hop of the
+routes is given as pool-id:token-out-denom. Fails if less than
+token-out-min-amount tokens are obtained from the last pool.
+
+Example:
+$ %s tx spot swap-exact-amount-in-route 1:unusd,2:uusdc 100unibi 95 --from validator
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			routes, err := ParseSwapRoutes(args[0])
+			if err != nil {
+				return err
+			}
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			tokenOutMinAmount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid token-out-min-amount: %s", args[2])
+			}
+
+			msg := types.NewMsgSwapExactAmountInRoute(
+				clientCtx.GetFromAddress().String(),
+				routes,
+				tokenIn,
+				tokenOutMinAmount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSwapExactAmountOutRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-amount-out-route [routes] [token-in-denom] [token-in-max-amount] [token-out]",
+		Short: "swap tokens through a route of pools for an exact amount of tokens out",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Swaps tokens of token-in-denom through the pools of the routes, in order, for
+exactly token-out. Every hop of the routes is given as pool-id:token-out-denom.
+Fails if more than token-in-max-amount tokens would be swapped in.
+
+Example:
+$ %s tx spot swap-exact-amount-out-route 1:unusd,2:uusdc unibi 105 100uusdc --from validator
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			routes, err := ParseSwapRoutes(args[0])
+			if err != nil {
+				return err
+			}
+
+			tokenInMaxAmount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid token-in-max-amount: %s", args[2])
+			}
+
+			tokenOut, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSwapExactAmountOutRoute(
+				clientCtx.GetFromAddress().String(),
+				routes,
+				args[1],
+				tokenInMaxAmount,
+				tokenOut,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdJoinPool() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "join-pool",
@@ -217,6 +339,119 @@ $ %s tx spot exit-pool --pool-id 1 --pool-shares-out 100nibiru/pool/1 --from val
 	return cmd
 }
 
+func CmdJoinSwapExternAmountIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "join-swap-extern-amount-in [pool-id] [token-in] [share-out-min-amount]",
+		Short: "join a pool with a single token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Joins the pool with token-in only. Fails if less than share-out-min-amount
+pool shares are minted.
+
+Example:
+$ %s tx spot join-swap-extern-amount-in 1 100unibi 1000 --from validator
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			shareOutMinAmount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid share-out-min-amount: %s", args[2])
+			}
+
+			msg := types.NewMsgJoinSwapExternAmountIn(
+				clientCtx.GetFromAddress().String(),
+				poolId,
+				tokenIn,
+				shareOutMinAmount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdExitSwapShareAmountIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exit-swap-share-amount-in [pool-id] [pool-shares] [token-out-denom] [token-out-min-amount]",
+		Short: "exit a pool into a single token by burning pool share tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Exits the pool into tokens of token-out-denom only. Fails if less than
+token-out-min-amount tokens are returned.
+
+Example:
+$ %s tx spot exit-swap-share-amount-in 1 100nibiru/pool/1 unibi 10 --from validator
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			poolShares, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			tokenOutMinAmount, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid token-out-min-amount: %s", args[3])
+			}
+
+			msg := types.NewMsgExitSwapShareAmountIn(
+				clientCtx.GetFromAddress().String(),
+				poolId,
+				poolShares,
+				args[2],
+				tokenOutMinAmount,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdCreatePool() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-pool [flags]",
@@ -234,8 +469,9 @@ Where pool.json contains:
 	"initial-deposit": "100unusd,100uusdc",
 	"swap-fee": "0.01",
 	"exit-fee": "0.01",
-	"pool-type": "balancer", // 'balancer' or 'stableswap'
-	"amplification": "10" // Amplification parameter for the stableswap pool
+	"pool-type": "balancer", // 'balancer', 'weighted', 'stableswap' or 'concentrated'
+	"amplification": "10", // Amplification parameter for the stableswap pool
+	"tick-spacing": 10 // Tick spacing for the concentrated pool
 }
 `,
 				version.AppName,
@@ -298,6 +534,10 @@ Where pool.json contains:
 				poolType = types.PoolType_BALANCER
 			} else if pool.PoolType == "stableswap" {
 				poolType = types.PoolType_STABLESWAP
+			} else if pool.PoolType == "concentrated" {
+				poolType = types.PoolType_CONCENTRATED
+			} else if pool.PoolType == "weighted" {
+				poolType = types.PoolType_WEIGHTED
 			} else {
 				return types.ErrInvalidCreatePoolArgs
 			}
@@ -314,10 +554,11 @@ Where pool.json contains:
 				/*sender=*/ clientCtx.GetFromAddress().String(),
 				poolAssets,
 				&types.PoolParams{
-					SwapFee:  sdk.MustNewDecFromStr(pool.SwapFee),
-					ExitFee:  sdk.MustNewDecFromStr(pool.ExitFee),
-					PoolType: poolType,
-					A:        amplification,
+					SwapFee:     sdk.MustNewDecFromStr(pool.SwapFee),
+					ExitFee:     sdk.MustNewDecFromStr(pool.ExitFee),
+					PoolType:    poolType,
+					A:           amplification,
+					TickSpacing: pool.TickSpacing,
 				},
 			)
 
@@ -332,3 +573,115 @@ Where pool.json contains:
 
 	return cmd
 }
+
+func CmdCreatePosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-position [pool-id] [lower-tick] [upper-tick] [tokens-desired]",
+		Short: "provide liquidity to a concentrated liquidity pool between two ticks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Provides the largest liquidity possible with at most tokens-desired between
+lower-tick and upper-tick, which must be multiples of the tick spacing of the pool.
+
+Example:
+$ %s tx spot create-position --from validator -- 1 -1000 1000 100unibi,100unusd
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			lowerTick, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			upperTick, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			tokensDesired, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreatePosition(
+				clientCtx.GetFromAddress().String(),
+				poolId,
+				lowerTick,
+				upperTick,
+				tokensDesired,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdWithdrawPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-position [position-id] [liquidity]",
+		Short: "withdraw liquidity from a concentrated liquidity position and collect its fees",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Withdraws liquidity from the position and collects the swap fees it earned.
+A liquidity of 0 only collects the fees.
+
+Example:
+$ %s tx spot withdraw-position 1 1000.5 --from validator
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			positionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			liquidity, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawPosition(
+				clientCtx.GetFromAddress().String(),
+				positionId,
+				liquidity,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -15,6 +15,21 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, pool := range genState.Pools {
 		k.SetPool(ctx, pool)
 	}
+
+	for _, position := range genState.Positions {
+		k.SetPosition(ctx, position)
+		if position.Id >= k.GetNextPositionNumber(ctx) {
+			k.SetNextPositionNumber(ctx, position.Id+1)
+		}
+	}
+
+	for _, record := range genState.TwapRecords {
+		k.SetTwapRecord(ctx, record)
+	}
+
+	for _, poolFees := range genState.PoolFees {
+		k.SetPoolFees(ctx, poolFees)
+	}
 }
 
 // ExportGenesis returns the spot module's exported genesis.
@@ -22,6 +37,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 	genesis.Pools = k.FetchAllPools(ctx)
+	genesis.Positions = k.FetchAllPositions(ctx)
+	genesis.TwapRecords = k.FetchAllTwapRecords(ctx)
+	genesis.PoolFees = k.FetchAllPoolFees(ctx)
 
 	return genesis
 }
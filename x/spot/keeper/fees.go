@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+/*
+SetPoolFees Writes the cumulative swap fees of a pool to the state.
+*/
+func (k Keeper) SetPoolFees(ctx sdk.Context, poolFees types.PoolFees) {
+	ctx.KVStore(k.storeKey).Set(types.GetKeyPrefixPoolFees(poolFees.PoolId), k.cdc.MustMarshal(&poolFees))
+}
+
+/*
+FetchPoolFees Fetches the cumulative swap fees of a pool, which are empty if the
+pool has not collected any fee yet.
+*/
+func (k Keeper) FetchPoolFees(ctx sdk.Context, poolId uint64) (poolFees types.PoolFees) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetKeyPrefixPoolFees(poolId))
+	if bz == nil {
+		return types.PoolFees{PoolId: poolId, SwapFees: sdk.NewCoins(), ProtocolFees: sdk.NewCoins()}
+	}
+	k.cdc.MustUnmarshal(bz, &poolFees)
+	return poolFees
+}
+
+/*
+FetchAllPoolFees Fetches the cumulative swap fees of all the pools, sorted by pool id.
+*/
+func (k Keeper) FetchAllPoolFees(ctx sdk.Context) (allPoolFees []types.PoolFees) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPoolFees)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var poolFees types.PoolFees
+		k.cdc.MustUnmarshal(iterator.Value(), &poolFees)
+		allPoolFees = append(allPoolFees, poolFees)
+	}
+	return allPoolFees
+}
+
+/*
+recordSwapFees Adds the fees of a swap to the cumulative swap fees of a pool.
+
+args:
+  - ctx: the cosmos-sdk context
+  - poolId: the pool id number
+  - fee: the swap fee paid by the swapper, protocol fee included
+  - protocolFee: the part of the fee taken by the protocol
+*/
+func (k Keeper) recordSwapFees(ctx sdk.Context, poolId uint64, fee sdk.Coin, protocolFee sdk.Coin) {
+	poolFees := k.FetchPoolFees(ctx, poolId)
+	poolFees.SwapFees = poolFees.SwapFees.Add(fee)
+	poolFees.ProtocolFees = poolFees.ProtocolFees.Add(protocolFee)
+	k.SetPoolFees(ctx, poolFees)
+}
+
+/*
+protocolFeeOf Computes the part of a swap fee taken by the protocol.
+
+args:
+  - ctx: the cosmos-sdk context
+  - fee: the swap fee paid by the swapper
+
+ret:
+  - protocolFee: the protocol share of the fee
+*/
+func (k Keeper) protocolFeeOf(ctx sdk.Context, fee sdk.Coin) (protocolFee sdk.Coin) {
+	share := k.GetParams(ctx).ProtocolFeeShare
+	return sdk.NewCoin(fee.Denom, sdk.NewDecFromInt(fee.Amount).Mul(share).TruncateInt())
+}
+
+/*
+payProtocolFee Sends the protocol fee of a swap from the swapper to the protocol
+fee recipient.
+
+args:
+  - ctx: the cosmos-sdk context
+  - sender: the address performing the swap
+  - protocolFee: the protocol share of the swap fee
+
+ret:
+  - err: error if any
+*/
+func (k Keeper) payProtocolFee(ctx sdk.Context, sender sdk.AccAddress, protocolFee sdk.Coin) error {
+	if !protocolFee.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(protocolFee)
+	switch k.GetParams(ctx).ProtocolFeeRecipient {
+	case types.ProtocolFeeRecipient_COMMUNITY_POOL:
+		return k.distrKeeper.FundCommunityPool(ctx, coins, sender)
+	default:
+		return k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, common.TreasuryPoolModuleAccount, coins)
+	}
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/spot/keeper"
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+func TestSwapProtocolFees(t *testing.T) {
+	tests := []struct {
+		name             string
+		protocolFeeShare sdk.Dec
+		recipient        types.ProtocolFeeRecipient
+
+		expectedTokenOut    sdk.Coin
+		expectedPoolBalance sdk.Coins
+		expectedProtocolFee sdk.Coin
+	}{
+		{
+			name:                "no protocol fee",
+			protocolFeeShare:    sdk.ZeroDec(),
+			recipient:           types.ProtocolFeeRecipient_TREASURY,
+			expectedTokenOut:    sdk.NewInt64Coin(denoms.NUSD, 82),
+			expectedPoolBalance: sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1100), sdk.NewInt64Coin(denoms.NUSD, 918)),
+			expectedProtocolFee: sdk.NewInt64Coin(denoms.NIBI, 0),
+		},
+		{
+			name:                "half of the fees to the treasury",
+			protocolFeeShare:    sdk.MustNewDecFromStr("0.5"),
+			recipient:           types.ProtocolFeeRecipient_TREASURY,
+			expectedTokenOut:    sdk.NewInt64Coin(denoms.NUSD, 82),
+			expectedPoolBalance: sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1095), sdk.NewInt64Coin(denoms.NUSD, 918)),
+			expectedProtocolFee: sdk.NewInt64Coin(denoms.NIBI, 5),
+		},
+		{
+			name:                "all of the fees to the community pool",
+			protocolFeeShare:    sdk.OneDec(),
+			recipient:           types.ProtocolFeeRecipient_COMMUNITY_POOL,
+			expectedTokenOut:    sdk.NewInt64Coin(denoms.NUSD, 82),
+			expectedPoolBalance: sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1090), sdk.NewInt64Coin(denoms.NUSD, 918)),
+			expectedProtocolFee: sdk.NewInt64Coin(denoms.NIBI, 10),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+
+			params := types.DefaultParams()
+			params.ProtocolFeeShare = tc.protocolFeeShare
+			params.ProtocolFeeRecipient = tc.recipient
+			app.SpotKeeper.SetParams(ctx, params)
+
+			pool := mock.SpotPool(
+				/*poolId=*/ 1,
+				/*assets=*/ sdk.NewCoins(
+					sdk.NewInt64Coin(denoms.NIBI, 1000),
+					sdk.NewInt64Coin(denoms.NUSD, 1000),
+				),
+				/*shares=*/ 100,
+			)
+			pool.PoolParams.SwapFee = sdk.MustNewDecFromStr("0.1")
+			poolAddr := testutil.AccAddress()
+			pool.Address = poolAddr.String()
+			require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, poolAddr, pool.PoolBalances()))
+			app.SpotKeeper.SetPool(ctx, pool)
+
+			sender := testutil.AccAddress()
+			tokenIn := sdk.NewInt64Coin(denoms.NIBI, 100)
+			require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, sender, sdk.NewCoins(tokenIn)))
+
+			treasuryBefore := app.BankKeeper.GetAllBalances(ctx, app.AccountKeeper.GetModuleAddress(common.TreasuryPoolModuleAccount))
+			communityPoolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+			tokenOut, err := app.SpotKeeper.SwapExactAmountIn(ctx, sender, pool.Id, tokenIn, denoms.NUSD)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTokenOut, tokenOut)
+
+			// the pool assets match the pool account balances
+			finalPool, err := app.SpotKeeper.FetchPool(ctx, pool.Id)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPoolBalance, finalPool.PoolBalances())
+			require.Equal(t, tc.expectedPoolBalance, app.BankKeeper.GetAllBalances(ctx, poolAddr))
+
+			// the protocol fee is paid to its recipient
+			treasuryGain := app.BankKeeper.GetAllBalances(ctx, app.AccountKeeper.GetModuleAddress(common.TreasuryPoolModuleAccount)).Sub(treasuryBefore...)
+			communityPoolGain := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).Sub(communityPoolBefore)
+			switch {
+			case tc.expectedProtocolFee.IsZero():
+				require.True(t, treasuryGain.IsZero())
+				require.True(t, communityPoolGain.IsZero())
+			case tc.recipient == types.ProtocolFeeRecipient_TREASURY:
+				require.Equal(t, sdk.NewCoins(tc.expectedProtocolFee), treasuryGain)
+			default:
+				require.Equal(t, sdk.NewDecCoinsFromCoins(tc.expectedProtocolFee), communityPoolGain)
+			}
+
+			// the fees are recorded and queryable
+			resp, err := keeper.NewQuerier(app.SpotKeeper).PoolFees(
+				sdk.WrapSDKContext(ctx), &types.QueryPoolFeesRequest{PoolId: pool.Id})
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 10)), resp.PoolFees.SwapFees)
+			require.True(t, sdk.NewCoins(tc.expectedProtocolFee).IsEqual(resp.PoolFees.ProtocolFees))
+
+			testutil.RequireContainsTypedEvent(t, ctx, &types.EventAssetsSwapped{
+				Address:     sender.String(),
+				TokenIn:     tokenIn,
+				TokenOut:    tokenOut,
+				Fee:         sdk.NewInt64Coin(denoms.NIBI, 10),
+				FinalPool:   finalPool,
+				ProtocolFee: tc.expectedProtocolFee,
+			})
+		})
+	}
+}
+
+func TestQueryPoolFeesUnknownPool(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+
+	_, err := keeper.NewQuerier(app.SpotKeeper).PoolFees(
+		sdk.WrapSDKContext(ctx), &types.QueryPoolFeesRequest{PoolId: 1})
+	require.ErrorIs(t, err, types.ErrPoolNotFound)
+}
+
+func TestConcentratedSwapProtocolFees(t *testing.T) {
+	for _, protocolFeeShare := range []sdk.Dec{sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")} {
+		app, ctx := testapp.NewNibiruTestAppAndContext()
+		poolCreationFee := sdk.NewInt64Coin(denoms.NIBI, 1_000)
+		params := types.NewParams(
+			/*startingPoolNumber=*/ 1,
+			/*poolCreationFee=*/ sdk.NewCoins(poolCreationFee),
+			/*whitelistedAssets*/ []string{"uatom", "uosmo"},
+		)
+		params.ProtocolFeeShare = protocolFeeShare
+		params.ProtocolFeeRecipient = types.ProtocolFeeRecipient_TREASURY
+		app.SpotKeeper.SetParams(ctx, params)
+
+		creator := testutil.AccAddress()
+		require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, creator, sdk.NewCoins(
+			sdk.NewInt64Coin("uatom", 1_000_000),
+			sdk.NewInt64Coin("uosmo", 1_000_000),
+			poolCreationFee,
+		)))
+		poolId, err := app.SpotKeeper.NewPool(ctx, creator,
+			types.PoolParams{
+				SwapFee:     sdk.NewDecWithPrec(1, 2),
+				ExitFee:     sdk.ZeroDec(),
+				PoolType:    types.PoolType_CONCENTRATED,
+				A:           sdk.ZeroInt(),
+				TickSpacing: 10,
+			},
+			[]types.PoolAsset{
+				{Token: sdk.NewInt64Coin("uatom", 1_000_000), Weight: sdk.OneInt()},
+				{Token: sdk.NewInt64Coin("uosmo", 1_000_000), Weight: sdk.OneInt()},
+			})
+		require.NoError(t, err)
+
+		trader := testutil.AccAddress()
+		tokenIn := sdk.NewInt64Coin("uatom", 10_000)
+		require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, trader, sdk.NewCoins(tokenIn)))
+		treasuryBefore := app.BankKeeper.GetAllBalances(ctx, app.AccountKeeper.GetModuleAddress(common.TreasuryPoolModuleAccount))
+		_, err = app.SpotKeeper.SwapExactAmountIn(ctx, trader, poolId, tokenIn, "uosmo")
+		require.NoError(t, err)
+
+		// the protocol takes its share of the 100uatom fee
+		expectedProtocolFee := sdk.NewDecFromInt(sdk.NewInt(100)).Mul(protocolFeeShare).TruncateInt()
+		treasuryGain := app.BankKeeper.GetAllBalances(ctx, app.AccountKeeper.GetModuleAddress(common.TreasuryPoolModuleAccount)).Sub(treasuryBefore...)
+		require.Equal(t, expectedProtocolFee, treasuryGain.AmountOf("uatom"))
+		poolFees := app.SpotKeeper.FetchPoolFees(ctx, poolId)
+		require.Equal(t, expectedProtocolFee, poolFees.ProtocolFees.AmountOf("uatom"))
+
+		// the pool assets match the pool account balances
+		pool, err := app.SpotKeeper.FetchPool(ctx, poolId)
+		require.NoError(t, err)
+		require.Equal(t, pool.PoolBalances(), app.BankKeeper.GetAllBalances(ctx, pool.GetAddress()))
+
+		// the position only earns the rest of the fee, rounded down
+		fullRange, err := app.SpotKeeper.FetchPosition(ctx, 1)
+		require.NoError(t, err)
+		_, fees, err := app.SpotKeeper.WithdrawPosition(ctx, creator, fullRange.Id, fullRange.Liquidity)
+		require.NoError(t, err)
+		lpFee := sdk.NewInt(100).Sub(expectedProtocolFee)
+		require.True(t, fees.AmountOf("uatom").LTE(lpFee), "fees %s", fees)
+		require.True(t, fees.AmountOf("uatom").GTE(lpFee.SubRaw(1)), "fees %s", fees)
+	}
+}
@@ -304,8 +304,24 @@ func (k queryServer) EstimateJoinExactAmountIn(
 
 // Estimates the amount of tokens required to obtain an exact amount of pool
 // shares.
-func (k queryServer) EstimateJoinExactAmountOut(context.Context, *types.QueryJoinExactAmountOutRequest) (*types.QueryJoinExactAmountOutResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "Not Implemented")
+func (k queryServer) EstimateJoinExactAmountOut(
+	ctx context.Context, req *types.QueryJoinExactAmountOutRequest,
+) (*types.QueryJoinExactAmountOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pool, err := k.FetchPool(sdk.UnwrapSDKContext(ctx), req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	tokenIn, err := pool.TokenInForExactSharesOut(req.PoolSharesOut, req.TokenInDenom)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryJoinExactAmountOutResponse{
+		TokenIn: tokenIn,
+	}, nil
 }
 
 // Estimates the amount of tokens returned to the user given an exact amount
@@ -329,6 +345,152 @@ func (k queryServer) EstimateExitExactAmountIn(
 
 // Estimates the amount of pool shares required to extract an exact amount of
 // tokens from the pool.
-func (k queryServer) EstimateExitExactAmountOut(context.Context, *types.QueryExitExactAmountOutRequest) (*types.QueryExitExactAmountOutResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "Not Implemented")
+func (k queryServer) EstimateExitExactAmountOut(
+	ctx context.Context, req *types.QueryExitExactAmountOutRequest,
+) (*types.QueryExitExactAmountOutResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pool, err := k.FetchPool(sdk.UnwrapSDKContext(ctx), req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	poolSharesIn, err := pool.SharesInForExactTokenOut(req.TokenOut)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryExitExactAmountOutResponse{
+		PoolSharesIn: poolSharesIn,
+	}, nil
+}
+
+// Estimates the amount of tokens returned by swapping an exact amount of tokens
+// through a route of pools.
+func (k queryServer) EstimateSwapRoute(
+	ctx context.Context, req *types.QueryEstimateSwapRouteRequest,
+) (*types.QueryEstimateSwapRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tokenOut, fees, err := k.EstimateSwapRouteExactAmountIn(sdk.UnwrapSDKContext(ctx), req.Routes, req.TokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEstimateSwapRouteResponse{
+		TokenOut: tokenOut,
+		Fees:     fees,
+	}, nil
+}
+
+// Finds the route of pools returning the most tokens of a denom for an exact
+// amount of tokens in.
+func (k queryServer) BestSwapRoute(
+	ctx context.Context, req *types.QueryBestSwapRouteRequest,
+) (*types.QueryBestSwapRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.MaxHops > types.MaxSwapRouteHops {
+		return nil, status.Error(codes.InvalidArgument,
+			fmt.Sprintf("max hops cannot be higher than %d", types.MaxSwapRouteHops))
+	}
+
+	maxHops := int(req.MaxHops)
+	if maxHops == 0 {
+		maxHops = types.MaxSwapRouteHops
+	}
+
+	routes, tokenOut, err := k.FindBestSwapRoute(sdk.UnwrapSDKContext(ctx), req.TokenIn, req.TokenOutDenom, maxHops)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBestSwapRouteResponse{
+		Routes:   routes,
+		TokenOut: tokenOut,
+	}, nil
+}
+
+func (k queryServer) Position(
+	ctx context.Context, req *types.QueryPositionRequest,
+) (*types.QueryPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	position, err := k.FetchPosition(sdk.UnwrapSDKContext(ctx), req.PositionId)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPositionResponse{
+		Position: position,
+	}, nil
+}
+
+func (k queryServer) Positions(
+	ctx context.Context, req *types.QueryPositionsRequest,
+) (*types.QueryPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	positions := []types.Position{}
+	for _, position := range k.FetchAllPositions(sdk.UnwrapSDKContext(ctx)) {
+		if position.Owner == req.Owner {
+			positions = append(positions, position)
+		}
+	}
+	return &types.QueryPositionsResponse{
+		Positions: positions,
+	}, nil
+}
+
+// Twap returns the time weighted average price of a base asset in a quote
+// asset of a pool.
+func (k queryServer) Twap(
+	ctx context.Context, req *types.QueryTwapRequest,
+) (*types.QueryTwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	twap, err := k.GetTwap(
+		sdk.UnwrapSDKContext(ctx),
+		req.PoolId,
+		req.BaseDenom,
+		req.QuoteDenom,
+		req.StartTimeMs,
+		req.EndTimeMs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTwapResponse{
+		Twap: twap,
+	}, nil
+}
+
+// PoolFees returns the cumulative swap fees collected by a pool.
+func (k queryServer) PoolFees(
+	goCtx context.Context, req *types.QueryPoolFeesRequest,
+) (*types.QueryPoolFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.FetchPool(ctx, req.PoolId); err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPoolFeesResponse{
+		PoolFees: k.FetchPoolFees(ctx, req.PoolId),
+	}, nil
 }
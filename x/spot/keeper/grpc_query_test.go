@@ -805,3 +805,132 @@ func TestQueryEstimateExitExactAmountIn(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryEstimateJoinExactAmountOut(t *testing.T) {
+	tests := []struct {
+		name            string
+		existingPool    types.Pool
+		poolSharesOut   sdkmath.Int
+		tokenInDenom    string
+		expectedErr     error
+		expectedTokenIn sdk.Coin
+	}{
+		{
+			name: "balancer",
+			existingPool: mock.SpotPool(1, sdk.NewCoins(
+				sdk.NewInt64Coin("unibi", 1_000),
+				sdk.NewInt64Coin(denoms.NUSD, 1_000),
+			), 1_000),
+			poolSharesOut:   sdk.NewInt(48),
+			tokenInDenom:    "unibi",
+			expectedTokenIn: sdk.NewInt64Coin("unibi", 99),
+		},
+		{
+			name: "stableswap",
+			existingPool: mock.SpotStablePool(1, sdk.NewCoins(
+				sdk.NewInt64Coin("unibi", 1_000),
+				sdk.NewInt64Coin(denoms.NUSD, 1_000),
+			), 1_000),
+			poolSharesOut:   sdk.NewInt(49),
+			tokenInDenom:    "unibi",
+			expectedTokenIn: sdk.NewInt64Coin("unibi", 99),
+		},
+		{
+			name: "denom not in pool",
+			existingPool: mock.SpotPool(1, sdk.NewCoins(
+				sdk.NewInt64Coin("unibi", 1_000),
+				sdk.NewInt64Coin(denoms.NUSD, 1_000),
+			), 1_000),
+			poolSharesOut: sdk.NewInt(48),
+			tokenInDenom:  denoms.USDC,
+			expectedErr:   types.ErrTokenDenomNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			app.SpotKeeper.SetPool(ctx, tc.existingPool)
+			queryServer := keeper.NewQuerier(app.SpotKeeper)
+
+			resp, err := queryServer.EstimateJoinExactAmountOut(
+				sdk.WrapSDKContext(ctx),
+				&types.QueryJoinExactAmountOutRequest{
+					PoolId:        1,
+					PoolSharesOut: tc.poolSharesOut,
+					TokenInDenom:  tc.tokenInDenom,
+				},
+			)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTokenIn, resp.TokenIn)
+		})
+	}
+}
+
+func TestQueryEstimateExitExactAmountOut(t *testing.T) {
+	tests := []struct {
+		name                 string
+		existingPool         types.Pool
+		tokenOut             sdk.Coin
+		expectedErr          error
+		expectedPoolSharesIn sdkmath.Int
+	}{
+		{
+			name: "balancer",
+			existingPool: mock.SpotPool(1, sdk.NewCoins(
+				sdk.NewInt64Coin("unibi", 1_000),
+				sdk.NewInt64Coin(denoms.NUSD, 1_000),
+			), 1_000),
+			tokenOut:             sdk.NewInt64Coin("unibi", 188),
+			expectedPoolSharesIn: sdk.NewInt(100),
+		},
+		{
+			name: "stableswap",
+			existingPool: mock.SpotStablePool(1, sdk.NewCoins(
+				sdk.NewInt64Coin("unibi", 1_000),
+				sdk.NewInt64Coin(denoms.NUSD, 1_000),
+			), 1_000),
+			tokenOut:             sdk.NewInt64Coin("unibi", 200),
+			expectedPoolSharesIn: sdk.NewInt(100),
+		},
+		{
+			name: "not enough liquidity",
+			existingPool: mock.SpotPool(1, sdk.NewCoins(
+				sdk.NewInt64Coin("unibi", 1_000),
+				sdk.NewInt64Coin(denoms.NUSD, 1_000),
+			), 1_000),
+			tokenOut:    sdk.NewInt64Coin("unibi", 1_000),
+			expectedErr: types.ErrNotEnoughLiquidity,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+			app.SpotKeeper.SetPool(ctx, tc.existingPool)
+			queryServer := keeper.NewQuerier(app.SpotKeeper)
+
+			resp, err := queryServer.EstimateExitExactAmountOut(
+				sdk.WrapSDKContext(ctx),
+				&types.QueryExitExactAmountOutRequest{
+					PoolId:   1,
+					TokenOut: tc.tokenOut,
+				},
+			)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedPoolSharesIn, resp.PoolSharesIn)
+		})
+	}
+}
@@ -28,6 +28,10 @@ type (
 		accountKeeper types.AccountKeeper
 		bankKeeper    types.BankKeeper
 		distrKeeper   types.DistrKeeper
+
+		// the address capable of executing MsgUpdateParams and
+		// MsgUpdatePoolParams. Typically, this should be the gov module address.
+		authority string
 	}
 )
 
@@ -41,6 +45,8 @@ args
 	ps: the param subspace for this keeper
 	accountKeeper: the auth module\'s keeper for accounts
 	bankKeeper: the bank module\'s keeper for bank transfers
+	distrKeeper: the distribution module\'s keeper
+	authority: the address allowed to update the params of the module and pools
 
 ret
 
@@ -53,6 +59,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	distrKeeper types.DistrKeeper,
+	authority string,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -66,9 +73,15 @@ func NewKeeper(
 		accountKeeper: accountKeeper,
 		bankKeeper:    bankKeeper,
 		distrKeeper:   distrKeeper,
+		authority:     authority,
 	}
 }
 
+// GetAuthority returns the x/spot module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -152,6 +165,8 @@ func (k Keeper) FetchPool(ctx sdk.Context, poolId uint64) (pool types.Pool, err
 	if len(pool.PoolAssets) == 0 {
 		return pool, types.ErrPoolNotFound.Wrapf("could not find pool with id %d", poolId)
 	}
+	pool.UpdateAmplification(ctx.BlockTime().UnixMilli())
+	k.loadTicks(ctx, &pool)
 	return pool, nil
 }
 
@@ -190,6 +205,8 @@ func (k Keeper) FetchAllPools(ctx sdk.Context) (pools []types.Pool) {
 	for ; iterator.Valid(); iterator.Next() {
 		var pool types.Pool
 		k.cdc.MustUnmarshal(iterator.Value(), &pool)
+		pool.UpdateAmplification(ctx.BlockTime().UnixMilli())
+		k.loadTicks(ctx, &pool)
 		pools = append(pools, pool)
 	}
 
@@ -197,7 +214,8 @@ func (k Keeper) FetchAllPools(ctx sdk.Context) (pools []types.Pool) {
 }
 
 /*
-SetPool Writes a pool to the state.
+SetPool Writes a pool to the state. The ticks of a concentrated liquidity pool
+are written to their own store, so that only the ticks which changed are written.
 Panics if the pool proto could not be marshaled.
 
 args:
@@ -205,6 +223,13 @@ args:
   - pool: the Pool proto object
 */
 func (k Keeper) SetPool(ctx sdk.Context, pool types.Pool) {
+	if pool.ConcentratedLiquidity != nil {
+		k.setTicks(ctx, pool.Id, pool.ConcentratedLiquidity.Ticks)
+		cl := *pool.ConcentratedLiquidity
+		cl.Ticks = nil
+		pool.ConcentratedLiquidity = &cl
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetKeyPrefixPools(pool.Id), k.cdc.MustMarshal(&pool))
 
@@ -367,6 +392,10 @@ func (k Keeper) NewPool(
 	}
 	coins = sdk.NewCoins(coins...)
 
+	if pool.PoolParams.PoolType == types.PoolType_CONCENTRATED {
+		return k.newConcentratedPool(ctx, sender, pool, coins, params.PoolCreationFee)
+	}
+
 	if err = k.bankKeeper.SendCoins(ctx, sender, poolAccount.GetAddress(), coins); err != nil {
 		return 0, err
 	}
@@ -378,27 +407,10 @@ func (k Keeper) NewPool(
 	}
 
 	// Finally, add the share token's meta data to the bank keeper.
-	poolShareBaseDenom := types.GetPoolShareBaseDenom(pool.Id)
-	poolShareDisplayDenom := types.GetPoolShareDisplayDenom(pool.Id)
-	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
-		Description: fmt.Sprintf("The share token of the nibiru spot amm pool %d", pool.Id),
-		DenomUnits: []*banktypes.DenomUnit{
-			{
-				Denom:    poolShareBaseDenom,
-				Exponent: 0,
-			},
-			{
-				Denom:    poolShareDisplayDenom,
-				Exponent: 18,
-			},
-		},
-		Base:    poolShareBaseDenom,
-		Display: poolShareDisplayDenom,
-		Name:    fmt.Sprintf("Nibiru Pool %d Share Token", pool.Id),
-		Symbol:  poolShareDisplayDenom,
-	})
+	k.setPoolShareDenomMetadata(ctx, pool.Id)
 
 	k.SetPool(ctx, pool)
+	k.updateTwapRecord(ctx, pool)
 	if err = k.RecordTotalLiquidityIncrease(ctx, coins); err != nil {
 		return poolId, err
 	}
@@ -416,6 +428,62 @@ func (k Keeper) NewPool(
 	return poolId, nil
 }
 
+/*
+newConcentratedPool Stores a new concentrated liquidity pool, whose initial assets
+are provided by a full range position of the creator instead of pool shares.
+*/
+func (k Keeper) newConcentratedPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool types.Pool,
+	coins sdk.Coins,
+	creationFee sdk.Coins,
+) (poolId uint64, err error) {
+	lowerTick, upperTick := pool.FullRangeTicks()
+	if _, _, err = k.createPosition(ctx, sender, &pool, lowerTick, upperTick, coins); err != nil {
+		return 0, err
+	}
+	k.setPoolShareDenomMetadata(ctx, pool.Id)
+	k.SetPool(ctx, pool)
+	k.updateTwapRecord(ctx, pool)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventPoolCreated{
+		Creator:             sender.String(),
+		Fees:                creationFee,
+		FinalPool:           pool,
+		FinalUserPoolShares: pool.TotalShares,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return pool.Id, nil
+}
+
+// setPoolShareDenomMetadata registers the metadata of the share token of a pool
+// in the bank keeper.
+func (k Keeper) setPoolShareDenomMetadata(ctx sdk.Context, poolId uint64) {
+	poolShareBaseDenom := types.GetPoolShareBaseDenom(poolId)
+	poolShareDisplayDenom := types.GetPoolShareDisplayDenom(poolId)
+	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Description: fmt.Sprintf("The share token of the nibiru spot amm pool %d", poolId),
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    poolShareBaseDenom,
+				Exponent: 0,
+			},
+			{
+				Denom:    poolShareDisplayDenom,
+				Exponent: 18,
+			},
+		},
+		Base:    poolShareBaseDenom,
+		Display: poolShareDisplayDenom,
+		Name:    fmt.Sprintf("Nibiru Pool %d Share Token", poolId),
+		Symbol:  poolShareDisplayDenom,
+	})
+}
+
 // areAllAssetsWhitelisted checks if all assets are in whitelist
 func (k Keeper) areAllAssetsWhitelisted(ctx sdk.Context, assets []types.PoolAsset) bool {
 	whitelistedAssets := k.GetParams(ctx).GetWhitelistedAssetsAsMap()
@@ -505,6 +573,7 @@ func (k Keeper) JoinPool(
 
 	// record changes to store
 	k.SetPool(ctx, pool)
+	k.updateTwapRecord(ctx, pool)
 	if err = k.RecordTotalLiquidityIncrease(ctx, tokensConsumed); err != nil {
 		return pool, numSharesOut, remCoins, err
 	}
@@ -591,6 +660,7 @@ func (k Keeper) ExitPool(
 
 	// record state changes
 	k.SetPool(ctx, pool)
+	k.updateTwapRecord(ctx, pool)
 	if err = k.RecordTotalLiquidityDecrease(ctx, tokensOut); err != nil {
 		return sdk.Coins{}, err
 	}
@@ -609,3 +679,177 @@ func (k Keeper) ExitPool(
 
 	return tokensOut, nil
 }
+
+/*
+JoinSwapExternAmountIn Joins a pool with a single token, minting pool shares to the joiner.
+Fails if less than shareOutMinAmount pool shares would be minted.
+
+args:
+  - ctx: the cosmos-sdk context
+  - joinerAddr: the user who wishes to provide liquidity
+  - poolId: the pool's numeric id
+  - tokenIn: the token to provide
+  - shareOutMinAmount: the minimum amount of pool shares to mint
+
+ret:
+  - pool: the updated pool after joining
+  - numSharesOut: the pool shares minted and returned to the user
+  - err: error if any
+*/
+func (k Keeper) JoinSwapExternAmountIn(
+	ctx sdk.Context,
+	joinerAddr sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	shareOutMinAmount sdkmath.Int,
+) (pool types.Pool, numSharesOut sdk.Coin, err error) {
+	pool, err = k.FetchPool(ctx, poolId)
+	if err != nil {
+		return types.Pool{}, sdk.Coin{}, err
+	}
+
+	numShares, err := pool.JoinSwapExternAmountIn(tokenIn)
+	if err != nil {
+		return types.Pool{}, sdk.Coin{}, err
+	}
+	if numShares.LT(shareOutMinAmount) {
+		return types.Pool{}, sdk.Coin{}, types.ErrPoolSharesOutBelowMin.Wrapf(
+			"got %s, minimum %s", numShares, shareOutMinAmount)
+	}
+
+	tokensIn := sdk.NewCoins(tokenIn)
+
+	// take coins from joiner to pool
+	if err = k.bankKeeper.SendCoins(
+		ctx,
+		/*from=*/ joinerAddr,
+		/*to=*/ pool.GetAddress(),
+		/*amount=*/ tokensIn,
+	); err != nil {
+		return types.Pool{}, sdk.Coin{}, err
+	}
+
+	// give joiner LP shares
+	newPoolShares, err := k.mintPoolShareToAccount(
+		ctx,
+		/*from=*/ pool.Id,
+		/*to=*/ joinerAddr,
+		/*amount=*/ numShares,
+	)
+	if err != nil {
+		return types.Pool{}, sdk.Coin{}, err
+	}
+
+	// record changes to store
+	k.SetPool(ctx, pool)
+	k.updateTwapRecord(ctx, pool)
+	if err = k.RecordTotalLiquidityIncrease(ctx, tokensIn); err != nil {
+		return types.Pool{}, sdk.Coin{}, err
+	}
+
+	existingPoolShares := k.bankKeeper.GetBalance(ctx, joinerAddr, newPoolShares.Denom)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventPoolJoined{
+		Address:             joinerAddr.String(),
+		TokensIn:            tokensIn,
+		PoolSharesOut:       newPoolShares,
+		RemCoins:            sdk.NewCoins(),
+		FinalPool:           pool,
+		FinalUserPoolShares: existingPoolShares.Add(newPoolShares),
+	})
+	if err != nil {
+		return types.Pool{}, sdk.Coin{}, err
+	}
+
+	return pool, newPoolShares, nil
+}
+
+/*
+ExitSwapShareAmountIn Exits a pool into a single token by burning pool shares.
+The pool shares are exited into all the assets of the pool, and the assets other
+than tokenOutDenom are swapped into tokenOutDenom. Fails if less than tokenOutMinAmount
+tokens would be returned.
+
+args:
+  - ctx: the cosmos-sdk context
+  - sender: the user who wishes to withdraw tokens
+  - poolId: the pool's numeric id
+  - poolSharesIn: the amount of pool shares to burn
+  - tokenOutDenom: the denom of the token to withdraw
+  - tokenOutMinAmount: the minimum amount of tokens to withdraw
+
+ret:
+  - tokenOut: the tokens withdrawn from the pool
+  - err: error if any
+*/
+func (k Keeper) ExitSwapShareAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	poolSharesIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdkmath.Int,
+) (tokenOut sdk.Coin, err error) {
+	pool, err := k.FetchPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// sanity checks
+	if poolSharesIn.Denom != pool.TotalShares.Denom {
+		return sdk.Coin{},
+			fmt.Errorf("invalid pool share denom. expected %s, got %s",
+				pool.TotalShares.Denom,
+				poolSharesIn.Denom,
+			)
+	}
+
+	if !poolSharesIn.Amount.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf(
+			"invalid number of pool shares %s must be positive", poolSharesIn.Amount)
+	}
+
+	existingPoolShares := k.bankKeeper.GetBalance(ctx, sender, poolSharesIn.Denom)
+
+	// calculate withdrawn liquidity
+	tokenOut, fees, err := pool.ExitSwapShareAmountIn(poolSharesIn.Amount, tokenOutDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if tokenOut.Amount.LT(tokenOutMinAmount) {
+		return sdk.Coin{}, types.ErrTokenOutBelowMin.Wrapf(
+			"got %s, minimum %s%s", tokenOut, tokenOutMinAmount, tokenOut.Denom)
+	}
+
+	tokensOut := sdk.NewCoins(tokenOut)
+
+	// apply exchange of pool shares for tokens
+	if err = k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, tokensOut); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err = k.burnPoolShareFromAccount(ctx, sender, poolSharesIn); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// record state changes
+	k.SetPool(ctx, pool)
+	k.updateTwapRecord(ctx, pool)
+	if err = k.RecordTotalLiquidityDecrease(ctx, tokensOut); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventPoolExited{
+		Address:             sender.String(),
+		PoolSharesIn:        poolSharesIn,
+		TokensOut:           tokensOut,
+		Fees:                fees,
+		FinalPool:           pool,
+		FinalUserPoolShares: existingPoolShares.Sub(poolSharesIn),
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return tokenOut, nil
+}
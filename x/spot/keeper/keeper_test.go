@@ -871,3 +871,190 @@ func TestExitPool(t *testing.T) {
 		})
 	}
 }
+
+func TestJoinSwapExternAmountIn(t *testing.T) {
+	const shareDenom = "nibiru/pool/1"
+
+	tests := []struct {
+		name              string
+		initialPool       types.Pool
+		tokenIn           sdk.Coin
+		shareOutMinAmount int64
+
+		expectedErr          error
+		expectedNumSharesOut sdk.Coin
+	}{
+		{
+			name: "balancer",
+			initialPool: mock.SpotPool(1, sdk.NewCoins(
+				sdk.NewInt64Coin("bar", 1_000),
+				sdk.NewInt64Coin("foo", 1_000),
+			), 1_000),
+			tokenIn:              sdk.NewInt64Coin("foo", 100),
+			shareOutMinAmount:    48,
+			expectedNumSharesOut: sdk.NewInt64Coin(shareDenom, 48),
+		},
+		{
+			name: "stableswap",
+			initialPool: mock.SpotStablePool(1, sdk.NewCoins(
+				sdk.NewInt64Coin("bar", 1_000),
+				sdk.NewInt64Coin("foo", 1_000),
+			), 1_000),
+			tokenIn:              sdk.NewInt64Coin("foo", 100),
+			shareOutMinAmount:    49,
+			expectedNumSharesOut: sdk.NewInt64Coin(shareDenom, 49),
+		},
+		{
+			name: "slippage too high",
+			initialPool: mock.SpotPool(1, sdk.NewCoins(
+				sdk.NewInt64Coin("bar", 1_000),
+				sdk.NewInt64Coin("foo", 1_000),
+			), 1_000),
+			tokenIn:           sdk.NewInt64Coin("foo", 100),
+			shareOutMinAmount: 49,
+			expectedErr:       types.ErrPoolSharesOutBelowMin,
+		},
+		{
+			name: "denom not in pool",
+			initialPool: mock.SpotPool(1, sdk.NewCoins(
+				sdk.NewInt64Coin("bar", 1_000),
+				sdk.NewInt64Coin("foo", 1_000),
+			), 1_000),
+			tokenIn:     sdk.NewInt64Coin("baz", 100),
+			expectedErr: types.ErrTokenDenomNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+
+			poolAddr := testutil.AccAddress()
+			tc.initialPool.Address = poolAddr.String()
+			app.SpotKeeper.SetPool(ctx, tc.initialPool)
+			require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, poolAddr, tc.initialPool.PoolBalances()))
+
+			joiner := testutil.AccAddress()
+			require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, joiner, sdk.NewCoins(tc.tokenIn)))
+
+			pool, numSharesOut, err := app.SpotKeeper.JoinSwapExternAmountIn(
+				ctx, joiner, 1, tc.tokenIn, sdk.NewInt(tc.shareOutMinAmount))
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Equal(t, sdk.NewCoins(tc.tokenIn), app.BankKeeper.GetAllBalances(ctx, joiner))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedNumSharesOut, numSharesOut)
+			require.Equal(t, sdk.NewCoins(tc.expectedNumSharesOut), app.BankKeeper.GetAllBalances(ctx, joiner))
+			require.Equal(t,
+				tc.initialPool.PoolBalances().Add(tc.tokenIn),
+				app.BankKeeper.GetAllBalances(ctx, poolAddr),
+			)
+
+			storedPool, err := app.SpotKeeper.FetchPool(ctx, 1)
+			require.NoError(t, err)
+			require.Equal(t, pool, storedPool)
+			require.Equal(t, tc.initialPool.PoolBalances().Add(tc.tokenIn), storedPool.PoolBalances())
+			require.Equal(t, tc.initialPool.TotalShares.Add(tc.expectedNumSharesOut), storedPool.TotalShares)
+		})
+	}
+}
+
+func TestExitSwapShareAmountIn(t *testing.T) {
+	const shareDenom = "nibiru/pool/1"
+
+	tests := []struct {
+		name              string
+		initialPool       types.Pool
+		poolSharesIn      sdk.Coin
+		tokenOutDenom     string
+		tokenOutMinAmount int64
+
+		expectedErr      error
+		expectedTokenOut sdk.Coin
+	}{
+		{
+			name: "balancer",
+			initialPool: mock.SpotPool(1, sdk.NewCoins(
+				sdk.NewInt64Coin("bar", 1_000),
+				sdk.NewInt64Coin("foo", 1_000),
+			), 1_000),
+			poolSharesIn:      sdk.NewInt64Coin(shareDenom, 100),
+			tokenOutDenom:     "foo",
+			tokenOutMinAmount: 188,
+			// 99foo exited, 99bar exited then swapped into 89foo
+			expectedTokenOut: sdk.NewInt64Coin("foo", 188),
+		},
+		{
+			name: "stableswap",
+			initialPool: mock.SpotStablePool(1, sdk.NewCoins(
+				sdk.NewInt64Coin("bar", 1_000),
+				sdk.NewInt64Coin("foo", 1_000),
+			), 1_000),
+			poolSharesIn:      sdk.NewInt64Coin(shareDenom, 100),
+			tokenOutDenom:     "foo",
+			tokenOutMinAmount: 200,
+			expectedTokenOut:  sdk.NewInt64Coin("foo", 200),
+		},
+		{
+			name: "slippage too high",
+			initialPool: mock.SpotPool(1, sdk.NewCoins(
+				sdk.NewInt64Coin("bar", 1_000),
+				sdk.NewInt64Coin("foo", 1_000),
+			), 1_000),
+			poolSharesIn:      sdk.NewInt64Coin(shareDenom, 100),
+			tokenOutDenom:     "foo",
+			tokenOutMinAmount: 189,
+			expectedErr:       types.ErrTokenOutBelowMin,
+		},
+		{
+			name: "all pool shares",
+			initialPool: mock.SpotPool(1, sdk.NewCoins(
+				sdk.NewInt64Coin("bar", 1_000),
+				sdk.NewInt64Coin("foo", 1_000),
+			), 1_000),
+			poolSharesIn:  sdk.NewInt64Coin(shareDenom, 1_000),
+			tokenOutDenom: "foo",
+			expectedErr:   types.ErrNotEnoughLiquidity,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			app, ctx := testapp.NewNibiruTestAppAndContext()
+
+			poolAddr := testutil.AccAddress()
+			tc.initialPool.Address = poolAddr.String()
+			app.SpotKeeper.SetPool(ctx, tc.initialPool)
+			require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, poolAddr, tc.initialPool.PoolBalances()))
+
+			sender := testutil.AccAddress()
+			require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, sender, sdk.NewCoins(tc.poolSharesIn)))
+
+			tokenOut, err := app.SpotKeeper.ExitSwapShareAmountIn(
+				ctx, sender, 1, tc.poolSharesIn, tc.tokenOutDenom, sdk.NewInt(tc.tokenOutMinAmount))
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Equal(t, sdk.NewCoins(tc.poolSharesIn), app.BankKeeper.GetAllBalances(ctx, sender))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTokenOut, tokenOut)
+			require.Equal(t, sdk.NewCoins(tc.expectedTokenOut), app.BankKeeper.GetAllBalances(ctx, sender))
+			require.Equal(t,
+				tc.initialPool.PoolBalances().Sub(tc.expectedTokenOut),
+				app.BankKeeper.GetAllBalances(ctx, poolAddr),
+			)
+
+			pool, err := app.SpotKeeper.FetchPool(ctx, 1)
+			require.NoError(t, err)
+			require.Equal(t, tc.initialPool.PoolBalances().Sub(tc.expectedTokenOut), pool.PoolBalances())
+			require.Equal(t, tc.initialPool.TotalShares.Sub(tc.poolSharesIn), pool.TotalShares)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 sets the protocol fee params, without any protocol fee and paid
+// to the treasury, as the param set cannot be read while one of its keys is
+// missing.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.paramstore.Set(ctx, types.KeyProtocolFeeShare, sdk.ZeroDec())
+	m.keeper.paramstore.Set(ctx, types.KeyProtocolFeeRecipient, types.ProtocolFeeRecipient_TREASURY)
+	return nil
+}
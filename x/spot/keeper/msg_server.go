@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/NibiruChain/nibiru/x/spot/types"
 )
@@ -164,3 +165,301 @@ func (k msgServer) SwapAssets(ctx context.Context, msg *types.MsgSwapAssets) (
 		TokenOut: tokenOut,
 	}, nil
 }
+
+/*
+SwapExactAmountInRoute swaps an exact amount of tokens through a route of pools.
+
+args
+
+	ctx: the cosmos-sdk context
+	msg: a MsgSwapExactAmountInRoute proto object
+
+ret
+
+	MsgSwapExactAmountInRouteResponse: the tokens taken out of the last pool
+	error: an error if any occurred
+*/
+func (k msgServer) SwapExactAmountInRoute(ctx context.Context, msg *types.MsgSwapExactAmountInRoute) (
+	*types.MsgSwapExactAmountInRouteResponse, error,
+) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOut, err := k.Keeper.SwapExactAmountInRoute(
+		sdkContext,
+		sender,
+		msg.Routes,
+		msg.TokenIn,
+		msg.TokenOutMinAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSwapExactAmountInRouteResponse{
+		TokenOut: tokenOut,
+	}, nil
+}
+
+/*
+SwapExactAmountOutRoute swaps tokens through a route of pools for an exact amount of tokens out.
+
+args
+
+	ctx: the cosmos-sdk context
+	msg: a MsgSwapExactAmountOutRoute proto object
+
+ret
+
+	MsgSwapExactAmountOutRouteResponse: the tokens given to the first pool
+	error: an error if any occurred
+*/
+func (k msgServer) SwapExactAmountOutRoute(ctx context.Context, msg *types.MsgSwapExactAmountOutRoute) (
+	*types.MsgSwapExactAmountOutRouteResponse, error,
+) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIn, err := k.Keeper.SwapExactAmountOutRoute(
+		sdkContext,
+		sender,
+		msg.Routes,
+		msg.TokenInDenom,
+		msg.TokenInMaxAmount,
+		msg.TokenOut,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSwapExactAmountOutRouteResponse{
+		TokenIn: tokenIn,
+	}, nil
+}
+
+/*
+JoinSwapExternAmountIn joins a pool with a single token.
+
+args
+
+	ctx: the cosmos-sdk context
+	msg: a MsgJoinSwapExternAmountIn proto object
+
+ret
+
+	MsgJoinSwapExternAmountInResponse: the pool shares minted to the user
+	error: an error if any occurred
+*/
+func (k msgServer) JoinSwapExternAmountIn(ctx context.Context, msg *types.MsgJoinSwapExternAmountIn) (
+	*types.MsgJoinSwapExternAmountInResponse, error,
+) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	_, numSharesOut, err := k.Keeper.JoinSwapExternAmountIn(
+		sdkContext,
+		sender,
+		msg.PoolId,
+		msg.TokenIn,
+		msg.ShareOutMinAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgJoinSwapExternAmountInResponse{
+		PoolSharesOut: numSharesOut,
+	}, nil
+}
+
+/*
+ExitSwapShareAmountIn exits a pool position into a single token.
+
+args
+
+	ctx: the cosmos-sdk context
+	msg: a MsgExitSwapShareAmountIn proto object
+
+ret
+
+	MsgExitSwapShareAmountInResponse: the tokens returned to the user
+	error: an error if any occurred
+*/
+func (k msgServer) ExitSwapShareAmountIn(ctx context.Context, msg *types.MsgExitSwapShareAmountIn) (
+	*types.MsgExitSwapShareAmountInResponse, error,
+) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenOut, err := k.Keeper.ExitSwapShareAmountIn(
+		sdkContext,
+		sender,
+		msg.PoolId,
+		msg.PoolShares,
+		msg.TokenOutDenom,
+		msg.TokenOutMinAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExitSwapShareAmountInResponse{
+		TokenOut: tokenOut,
+	}, nil
+}
+
+/*
+CreatePosition provides liquidity to a concentrated liquidity pool between two ticks.
+
+args
+
+	ctx: the cosmos-sdk context
+	msg: a MsgCreatePosition proto object
+
+ret
+
+	MsgCreatePositionResponse: the position created and the tokens deposited
+	error: an error if any occurred
+*/
+func (k msgServer) CreatePosition(ctx context.Context, msg *types.MsgCreatePosition) (
+	*types.MsgCreatePositionResponse, error,
+) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	position, tokensIn, err := k.Keeper.CreatePosition(
+		sdkContext,
+		sender,
+		msg.PoolId,
+		msg.LowerTick,
+		msg.UpperTick,
+		msg.TokensDesired,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePositionResponse{
+		PositionId: position.Id,
+		Liquidity:  position.Liquidity,
+		TokensIn:   tokensIn,
+	}, nil
+}
+
+/*
+WithdrawPosition withdraws liquidity from a concentrated liquidity position and collects its fees.
+
+args
+
+	ctx: the cosmos-sdk context
+	msg: a MsgWithdrawPosition proto object
+
+ret
+
+	MsgWithdrawPositionResponse: the tokens withdrawn and the fees collected
+	error: an error if any occurred
+*/
+func (k msgServer) WithdrawPosition(ctx context.Context, msg *types.MsgWithdrawPosition) (
+	*types.MsgWithdrawPositionResponse, error,
+) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensOut, fees, err := k.Keeper.WithdrawPosition(sdkContext, sender, msg.PositionId, msg.Liquidity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawPositionResponse{
+		TokensOut: tokensOut,
+		Fees:      fees,
+	}, nil
+}
+
+/*
+UpdateParams updates the params of the module. Only the module authority can
+execute it.
+
+args
+
+	ctx: the cosmos-sdk context
+	msg: a MsgUpdateParams proto object
+
+ret
+
+	MsgUpdateParamsResponse: an empty response
+	error: an error if any occurred
+*/
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (
+	*types.MsgUpdateParamsResponse, error,
+) {
+	if k.authority != msg.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := k.Keeper.UpdateParams(sdk.UnwrapSDKContext(ctx), msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+/*
+UpdatePoolParams updates the fees and ramps the amplification parameter of a pool.
+Only the module authority can execute it.
+
+args
+
+	ctx: the cosmos-sdk context
+	msg: a MsgUpdatePoolParams proto object
+
+ret
+
+	MsgUpdatePoolParamsResponse: an empty response
+	error: an error if any occurred
+*/
+func (k msgServer) UpdatePoolParams(ctx context.Context, msg *types.MsgUpdatePoolParams) (
+	*types.MsgUpdatePoolParamsResponse, error,
+) {
+	if k.authority != msg.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	_, err := k.Keeper.UpdatePoolParams(
+		sdk.UnwrapSDKContext(ctx),
+		msg.PoolId,
+		msg.SwapFee,
+		msg.ExitFee,
+		msg.FutureA,
+		msg.ARampDuration,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdatePoolParamsResponse{}, nil
+}
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/x/spot/types"
@@ -16,3 +19,69 @@ func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// UpdateParams validates and sets the params of the module.
+func (k Keeper) UpdateParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	k.SetParams(ctx, params)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventParamsUpdated{
+		Params: params,
+	})
+}
+
+/*
+UpdatePoolParams Updates the fees of a pool and, for a stableswap pool, ramps its
+amplification parameter to futureA over aRampDuration.
+
+args:
+  - ctx: the cosmos-sdk context
+  - poolId: the pool's numeric id
+  - swapFee: the new swap fee of the pool
+  - exitFee: the new exit fee of the pool
+  - futureA: the amplification parameter to ramp to, unchanged if nil or zero
+  - aRampDuration: the duration of the ramp of the amplification parameter
+
+ret:
+  - pool: the updated pool
+  - err: error if any
+*/
+func (k Keeper) UpdatePoolParams(
+	ctx sdk.Context,
+	poolId uint64,
+	swapFee sdk.Dec,
+	exitFee sdk.Dec,
+	futureA sdkmath.Int,
+	aRampDuration time.Duration,
+) (pool types.Pool, err error) {
+	pool, err = k.FetchPool(ctx, poolId)
+	if err != nil {
+		return types.Pool{}, err
+	}
+
+	if pool.PoolParams.PoolType == types.PoolType_CONCENTRATED && swapFee.Equal(sdk.OneDec()) {
+		return types.Pool{}, types.ErrInvalidSwapFee.Wrapf("invalid swap fee: %s", swapFee)
+	}
+	pool.PoolParams.SwapFee = swapFee
+	pool.PoolParams.ExitFee = exitFee
+
+	if !futureA.IsNil() && !futureA.IsZero() {
+		if err = pool.RampAmplification(futureA, aRampDuration, ctx.BlockTime().UnixMilli()); err != nil {
+			return types.Pool{}, err
+		}
+	}
+
+	k.SetPool(ctx, pool)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventPoolParamsUpdated{
+		PoolId:          pool.Id,
+		FinalPoolParams: pool.PoolParams,
+	})
+	if err != nil {
+		return types.Pool{}, err
+	}
+
+	return pool, nil
+}
@@ -2,10 +2,19 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
 	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/spot/keeper"
 	"github.com/NibiruChain/nibiru/x/spot/types"
 )
 
@@ -17,3 +26,111 @@ func TestGetParams(t *testing.T) {
 
 	require.EqualValues(t, params, app.SpotKeeper.GetParams(ctx))
 }
+
+func TestMigrate2to3(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+
+	// a store of version 2 has no protocol fee params
+	params := types.DefaultParams()
+	params.ProtocolFeeShare = sdk.MustNewDecFromStr("0.1")
+	params.ProtocolFeeRecipient = types.ProtocolFeeRecipient_COMMUNITY_POOL
+	app.SpotKeeper.SetParams(ctx, params)
+	paramStore := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	paramStore.Delete(types.KeyProtocolFeeShare)
+	paramStore.Delete(types.KeyProtocolFeeRecipient)
+	require.Panics(t, func() { app.SpotKeeper.GetParams(ctx) })
+
+	require.NoError(t, keeper.NewMigrator(app.SpotKeeper).Migrate2to3(ctx))
+	params.ProtocolFeeShare = sdk.ZeroDec()
+	params.ProtocolFeeRecipient = types.ProtocolFeeRecipient_TREASURY
+	require.Equal(t, params, app.SpotKeeper.GetParams(ctx))
+}
+
+func TestMsgServerUpdateParams(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	msgServer := keeper.NewMsgServerImpl(app.SpotKeeper)
+	authority := app.SpotKeeper.GetAuthority()
+
+	params := types.NewParams(1, sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 10)), []string{denoms.NIBI, "uatom"})
+
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(testutil.AccAddress().String(), params))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(
+		authority, types.NewParams(1, sdk.NewCoins(), []string{"uatom", "uatom"})))
+	require.Error(t, err)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+	require.EqualValues(t, params, app.SpotKeeper.GetParams(ctx))
+	testutil.RequireContainsTypedEvent(t, ctx, &types.EventParamsUpdated{Params: params})
+}
+
+func TestMsgServerUpdatePoolParams(t *testing.T) {
+	startTime := time.Unix(1_690_000_000, 0).UTC()
+	app, ctx := testapp.NewNibiruTestAppAndContextAtTime(startTime)
+	msgServer := keeper.NewMsgServerImpl(app.SpotKeeper)
+	authority := app.SpotKeeper.GetAuthority()
+
+	app.SpotKeeper.SetPool(ctx, mock.SpotPool(1, sdk.NewCoins(
+		sdk.NewInt64Coin("uatom", 1_000),
+		sdk.NewInt64Coin("uosmo", 1_000),
+	), 100))
+	app.SpotKeeper.SetPool(ctx, mock.SpotStablePool(2, sdk.NewCoins(
+		sdk.NewInt64Coin("uatom", 1_000_000),
+		sdk.NewInt64Coin("uusdc", 1_000_000),
+	), 100))
+	fee := sdk.NewDecWithPrec(3, 3)
+
+	_, err := msgServer.UpdatePoolParams(sdk.WrapSDKContext(ctx),
+		types.NewMsgUpdatePoolParams(testutil.AccAddress().String(), 1, fee, fee, sdk.Int{}, 0))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.UpdatePoolParams(sdk.WrapSDKContext(ctx),
+		types.NewMsgUpdatePoolParams(authority, 3, fee, fee, sdk.Int{}, 0))
+	require.ErrorIs(t, err, types.ErrPoolNotFound)
+
+	// only stableswap pools have an amplification parameter
+	_, err = msgServer.UpdatePoolParams(sdk.WrapSDKContext(ctx),
+		types.NewMsgUpdatePoolParams(authority, 1, fee, fee, sdk.NewInt(100), 24*time.Hour))
+	require.ErrorIs(t, err, types.ErrInvalidPoolType)
+
+	_, err = msgServer.UpdatePoolParams(sdk.WrapSDKContext(ctx),
+		types.NewMsgUpdatePoolParams(authority, 1, fee, fee, sdk.Int{}, 0))
+	require.NoError(t, err)
+	pool, err := app.SpotKeeper.FetchPool(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, fee, pool.PoolParams.SwapFee)
+	require.Equal(t, fee, pool.PoolParams.ExitFee)
+	testutil.RequireContainsTypedEvent(t, ctx, &types.EventPoolParamsUpdated{
+		PoolId:          1,
+		FinalPoolParams: pool.PoolParams,
+	})
+
+	// ramp A of the stableswap pool from 100 to 300 over two days
+	_, err = msgServer.UpdatePoolParams(sdk.WrapSDKContext(ctx),
+		types.NewMsgUpdatePoolParams(authority, 2, fee, fee, sdk.NewInt(300), 48*time.Hour))
+	require.NoError(t, err)
+
+	tokenOutBefore, _, err := app.SpotKeeper.EstimateSwapRouteExactAmountIn(ctx,
+		[]types.SwapRoute{{PoolId: 2, TokenOutDenom: "uusdc"}}, sdk.NewInt64Coin("uatom", 900_000))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(startTime.Add(24 * time.Hour))
+	pool, err = app.SpotKeeper.FetchPool(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(200), pool.PoolParams.A)
+	require.NotNil(t, pool.PoolParams.ARamp)
+
+	// a larger A flattens the curve, giving more tokens out
+	tokenOutDuring, _, err := app.SpotKeeper.EstimateSwapRouteExactAmountIn(ctx,
+		[]types.SwapRoute{{PoolId: 2, TokenOutDenom: "uusdc"}}, sdk.NewInt64Coin("uatom", 900_000))
+	require.NoError(t, err)
+	require.True(t, tokenOutDuring.Amount.GT(tokenOutBefore.Amount))
+
+	ctx = ctx.WithBlockTime(startTime.Add(72 * time.Hour))
+	pool, err = app.SpotKeeper.FetchPool(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(300), pool.PoolParams.A)
+	require.Nil(t, pool.PoolParams.ARamp)
+}
@@ -0,0 +1,301 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+/*
+GetNextPositionNumber Retrieves the next position id number to use when creating a new
+concentrated liquidity position. Position ids start at one.
+*/
+func (k Keeper) GetNextPositionNumber(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyNextPositionNumber)
+	if bz == nil {
+		return 1
+	}
+	val := gogotypes.UInt64Value{}
+	k.cdc.MustUnmarshal(bz, &val)
+	return val.GetValue()
+}
+
+/*
+SetNextPositionNumber Sets the next position id number to use when creating a new
+concentrated liquidity position.
+*/
+func (k Keeper) SetNextPositionNumber(ctx sdk.Context, positionNumber uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyNextPositionNumber,
+		k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: positionNumber}))
+}
+
+/*
+FetchPosition Fetches a concentrated liquidity position by id number.
+
+args:
+  - ctx: the cosmos-sdk context
+  - positionId: the position id number
+
+ret:
+  - position: the position
+  - err: ErrPositionNotFound if the position does not exist
+*/
+func (k Keeper) FetchPosition(ctx sdk.Context, positionId uint64) (position types.Position, err error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetKeyPrefixPositions(positionId))
+	if bz == nil {
+		return types.Position{}, types.ErrPositionNotFound.Wrapf("position %d", positionId)
+	}
+	k.cdc.MustUnmarshal(bz, &position)
+	return position, nil
+}
+
+/*
+SetPosition Writes a concentrated liquidity position to the state.
+*/
+func (k Keeper) SetPosition(ctx sdk.Context, position types.Position) {
+	ctx.KVStore(k.storeKey).Set(types.GetKeyPrefixPositions(position.Id), k.cdc.MustMarshal(&position))
+}
+
+/*
+FetchAllPositions Fetches all the concentrated liquidity positions, sorted by id.
+*/
+func (k Keeper) FetchAllPositions(ctx sdk.Context) (positions []types.Position) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPositions)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var position types.Position
+		k.cdc.MustUnmarshal(iterator.Value(), &position)
+		positions = append(positions, position)
+	}
+	return positions
+}
+
+/*
+loadTicks Reads the initialized ticks of a concentrated liquidity pool from their
+store into the pool, sorted by index.
+*/
+func (k Keeper) loadTicks(ctx sdk.Context, pool *types.Pool) {
+	if pool.ConcentratedLiquidity == nil {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixTicks(pool.Id))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var ticks []types.Tick
+	for ; iterator.Valid(); iterator.Next() {
+		var tick types.Tick
+		k.cdc.MustUnmarshal(iterator.Value(), &tick)
+		ticks = append(ticks, tick)
+	}
+	pool.ConcentratedLiquidity.Ticks = ticks
+}
+
+/*
+setTicks Writes the initialized ticks of a concentrated liquidity pool. Only the
+ticks which changed are written, and the ticks which are not initialized anymore
+are deleted.
+*/
+func (k Keeper) setTicks(ctx sdk.Context, poolId uint64, ticks []types.Tick) {
+	store := ctx.KVStore(k.storeKey)
+
+	stored := make(map[string][]byte)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetKeyPrefixTicks(poolId))
+	for ; iterator.Valid(); iterator.Next() {
+		stored[string(iterator.Key())] = iterator.Value()
+	}
+	iterator.Close()
+
+	for _, tick := range ticks {
+		key := types.GetTickKey(poolId, tick.Index)
+		bz := k.cdc.MustMarshal(&tick)
+		if prev, found := stored[string(key)]; !found || !bytes.Equal(prev, bz) {
+			store.Set(key, bz)
+		}
+		delete(stored, string(key))
+	}
+
+	// sorted for a deterministic order of the writes
+	removed := make([]string, 0, len(stored))
+	for key := range stored {
+		removed = append(removed, key)
+	}
+	sort.Strings(removed)
+	for _, key := range removed {
+		store.Delete([]byte(key))
+	}
+}
+
+/*
+CreatePosition Provides liquidity to a concentrated liquidity pool between two ticks,
+with the largest liquidity the tokens desired can provide at the current price of
+the pool.
+
+args:
+  - ctx: the cosmos-sdk context
+  - sender: the owner of the position
+  - poolId: the pool's numeric id
+  - lowerTick: the lower tick of the position
+  - upperTick: the upper tick of the position
+  - tokensDesired: the maximum amount of tokens to deposit
+
+ret:
+  - position: the new position
+  - tokensIn: the tokens deposited into the pool
+  - err: error if any
+*/
+func (k Keeper) CreatePosition(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	lowerTick int64,
+	upperTick int64,
+	tokensDesired sdk.Coins,
+) (position types.Position, tokensIn sdk.Coins, err error) {
+	pool, err := k.FetchPool(ctx, poolId)
+	if err != nil {
+		return types.Position{}, nil, err
+	}
+
+	position, tokensIn, err = k.createPosition(ctx, sender, &pool, lowerTick, upperTick, tokensDesired)
+	if err != nil {
+		return types.Position{}, nil, err
+	}
+
+	k.SetPool(ctx, pool)
+	k.updateTwapRecord(ctx, pool)
+	return position, tokensIn, nil
+}
+
+func (k Keeper) createPosition(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool *types.Pool,
+	lowerTick int64,
+	upperTick int64,
+	tokensDesired sdk.Coins,
+) (position types.Position, tokensIn sdk.Coins, err error) {
+	liquidity, err := pool.LiquidityForTokens(lowerTick, upperTick, tokensDesired)
+	if err != nil {
+		return types.Position{}, nil, err
+	}
+	if !liquidity.IsPositive() {
+		return types.Position{}, nil, types.ErrInvalidLiquidity.Wrapf(
+			"tokens %s provide no liquidity between ticks %d and %d", tokensDesired, lowerTick, upperTick)
+	}
+
+	position = types.Position{
+		Id:        k.GetNextPositionNumber(ctx),
+		PoolId:    pool.Id,
+		Owner:     sender.String(),
+		LowerTick: lowerTick,
+		UpperTick: upperTick,
+		Liquidity: sdk.ZeroDec(),
+	}
+	tokensIn, _, err = pool.UpdatePosition(&position, liquidity)
+	if err != nil {
+		return types.Position{}, nil, err
+	}
+
+	if err = k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), tokensIn); err != nil {
+		return types.Position{}, nil, err
+	}
+	if err = k.RecordTotalLiquidityIncrease(ctx, tokensIn); err != nil {
+		return types.Position{}, nil, err
+	}
+
+	k.SetNextPositionNumber(ctx, position.Id+1)
+	k.SetPosition(ctx, position)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventPositionUpdated{
+		Address:       sender.String(),
+		FinalPosition: position,
+		TokensIn:      tokensIn,
+		TokensOut:     sdk.NewCoins(),
+		Fees:          sdk.NewCoins(),
+		FinalPool:     *pool,
+	})
+	if err != nil {
+		return types.Position{}, nil, err
+	}
+
+	return position, tokensIn, nil
+}
+
+/*
+WithdrawPosition Withdraws liquidity from a concentrated liquidity position and
+collects the swap fees it earned. A zero liquidity only collects the fees. The
+position is deleted once all its liquidity is withdrawn.
+
+args:
+  - ctx: the cosmos-sdk context
+  - sender: the owner of the position
+  - positionId: the position's numeric id
+  - liquidity: the liquidity to withdraw
+
+ret:
+  - tokensOut: the tokens withdrawn from the liquidity
+  - fees: the swap fees collected
+  - err: error if any
+*/
+func (k Keeper) WithdrawPosition(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	positionId uint64,
+	liquidity sdk.Dec,
+) (tokensOut sdk.Coins, fees sdk.Coins, err error) {
+	position, err := k.FetchPosition(ctx, positionId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if position.Owner != sender.String() {
+		return nil, nil, types.ErrPositionNotFound.Wrapf("position %d is not owned by %s", positionId, sender)
+	}
+
+	pool, err := k.FetchPool(ctx, position.PoolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokensOut, fees, err = pool.UpdatePosition(&position, liquidity.Neg())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	withdrawn := tokensOut.Add(fees...)
+	if err = k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, withdrawn); err != nil {
+		return nil, nil, err
+	}
+	if err = k.RecordTotalLiquidityDecrease(ctx, withdrawn); err != nil {
+		return nil, nil, err
+	}
+
+	k.SetPool(ctx, pool)
+	k.updateTwapRecord(ctx, pool)
+	if position.Liquidity.IsZero() {
+		ctx.KVStore(k.storeKey).Delete(types.GetKeyPrefixPositions(position.Id))
+	} else {
+		k.SetPosition(ctx, position)
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventPositionUpdated{
+		Address:       sender.String(),
+		FinalPosition: position,
+		TokensIn:      sdk.NewCoins(),
+		TokensOut:     tokensOut,
+		Fees:          fees,
+		FinalPool:     pool,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tokensOut, fees, nil
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/spot/keeper"
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+func TestConcentratedPositions(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext()
+	poolCreationFee := sdk.NewInt64Coin(denoms.NIBI, 1_000)
+	app.SpotKeeper.SetParams(ctx, types.NewParams(
+		/*startingPoolNumber=*/ 1,
+		/*poolCreationFee=*/ sdk.NewCoins(poolCreationFee),
+		/*whitelistedAssets*/ []string{"uatom", "uosmo"},
+	))
+
+	creator := testutil.AccAddress()
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, creator, sdk.NewCoins(
+		sdk.NewInt64Coin("uatom", 1_000_000),
+		sdk.NewInt64Coin("uosmo", 1_000_000),
+		poolCreationFee,
+	)))
+
+	poolId, err := app.SpotKeeper.NewPool(ctx, creator,
+		types.PoolParams{
+			SwapFee:     sdk.NewDecWithPrec(1, 2),
+			ExitFee:     sdk.ZeroDec(),
+			PoolType:    types.PoolType_CONCENTRATED,
+			A:           sdk.ZeroInt(),
+			TickSpacing: 10,
+		},
+		[]types.PoolAsset{
+			{Token: sdk.NewInt64Coin("uatom", 1_000_000), Weight: sdk.OneInt()},
+			{Token: sdk.NewInt64Coin("uosmo", 1_000_000), Weight: sdk.OneInt()},
+		})
+	require.NoError(t, err)
+
+	// the creator owns a full range position
+	pool, err := app.SpotKeeper.FetchPool(ctx, poolId)
+	require.NoError(t, err)
+	require.True(t, pool.TotalShares.IsZero())
+	fullRange, err := app.SpotKeeper.FetchPosition(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, creator.String(), fullRange.Owner)
+	require.Equal(t, pool.ConcentratedLiquidity.Liquidity, fullRange.Liquidity)
+
+	// the share denom of the pool is registered like for the other pool types
+	metadata, found := app.BankKeeper.GetDenomMetaData(ctx, types.GetPoolShareBaseDenom(poolId))
+	require.True(t, found)
+	require.Equal(t, types.GetPoolShareDisplayDenom(poolId), metadata.Display)
+
+	// a second user provides liquidity around the current price
+	user := testutil.AccAddress()
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, user, sdk.NewCoins(
+		sdk.NewInt64Coin("uatom", 100_000),
+		sdk.NewInt64Coin("uosmo", 100_000),
+	)))
+	position, tokensIn, err := app.SpotKeeper.CreatePosition(ctx, user, poolId, -100, 100, sdk.NewCoins(
+		sdk.NewInt64Coin("uatom", 100_000),
+		sdk.NewInt64Coin("uosmo", 100_000),
+	))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), position.Id)
+	require.True(t, tokensIn.IsAllLTE(sdk.NewCoins(
+		sdk.NewInt64Coin("uatom", 100_000),
+		sdk.NewInt64Coin("uosmo", 100_000),
+	)))
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin("uatom", 100_000),
+		sdk.NewInt64Coin("uosmo", 100_000),
+	).Sub(tokensIn...), app.BankKeeper.GetAllBalances(ctx, user))
+
+	// the ticks are stored apart from the pool
+	var storedPool types.Pool
+	app.AppCodec().MustUnmarshal(
+		ctx.KVStore(app.GetKey(types.StoreKey)).Get(types.GetKeyPrefixPools(poolId)), &storedPool)
+	require.Empty(t, storedPool.ConcentratedLiquidity.Ticks)
+	pool, err = app.SpotKeeper.FetchPool(ctx, poolId)
+	require.NoError(t, err)
+	require.Len(t, pool.ConcentratedLiquidity.Ticks, 4)
+
+	_, _, err = app.SpotKeeper.CreatePosition(ctx, user, poolId, -105, 100, sdk.NewCoins(
+		sdk.NewInt64Coin("uatom", 100),
+	))
+	require.ErrorIs(t, err, types.ErrInvalidTickRange)
+
+	// swaps earn fees to the positions
+	trader := testutil.AccAddress()
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, trader, sdk.NewCoins(
+		sdk.NewInt64Coin("uatom", 10_000),
+	)))
+	_, err = app.SpotKeeper.SwapExactAmountIn(ctx, trader, poolId,
+		sdk.NewInt64Coin("uatom", 10_000), "uosmo")
+	require.NoError(t, err)
+
+	querier := keeper.NewQuerier(app.SpotKeeper)
+	resp, err := querier.Positions(sdk.WrapSDKContext(ctx), &types.QueryPositionsRequest{Owner: user.String()})
+	require.NoError(t, err)
+	require.Len(t, resp.Positions, 1)
+	require.Equal(t, position.Id, resp.Positions[0].Id)
+
+	_, _, err = app.SpotKeeper.WithdrawPosition(ctx, creator, position.Id, position.Liquidity)
+	require.ErrorIs(t, err, types.ErrPositionNotFound)
+
+	tokensOut, fees, err := app.SpotKeeper.WithdrawPosition(ctx, user, position.Id, position.Liquidity)
+	require.NoError(t, err)
+	require.True(t, fees.AmountOf("uatom").IsPositive())
+	require.Equal(t, tokensOut.Add(fees...), app.BankKeeper.GetAllBalances(ctx, user).
+		Sub(sdk.NewCoins(
+			sdk.NewInt64Coin("uatom", 100_000),
+			sdk.NewInt64Coin("uosmo", 100_000),
+		).Sub(tokensIn...)...))
+
+	// the ticks of the withdrawn position are deleted
+	pool, err = app.SpotKeeper.FetchPool(ctx, poolId)
+	require.NoError(t, err)
+	require.Len(t, pool.ConcentratedLiquidity.Ticks, 2)
+
+	// the position is deleted once empty
+	_, err = querier.Position(sdk.WrapSDKContext(ctx), &types.QueryPositionRequest{PositionId: position.Id})
+	require.Error(t, err)
+}
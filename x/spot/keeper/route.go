@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+/*
+SwapExactAmountInRoute Swaps an exact amount of tokens in through the pools of the routes, in order.
+The tokens out of every hop are swapped into the next one. All hops are applied atomically.
+
+args:
+  - ctx: the cosmos-sdk context
+  - sender: the address wishing to perform the swap
+  - routes: the pools to swap through and the denoms obtained from them
+  - tokenIn: the amount of tokens given to the first pool
+  - tokenOutMinAmount: the minimum amount of tokens taken out of the last pool
+
+ret:
+  - tokenOut: the amount of tokens taken out of the last pool
+  - err: error if any
+*/
+func (k Keeper) SwapExactAmountInRoute(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapRoute,
+	tokenIn sdk.Coin,
+	tokenOutMinAmount sdkmath.Int,
+) (tokenOut sdk.Coin, err error) {
+	if err = types.ValidateSwapRoutes(routes, tokenIn.Denom); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	tokenOut = tokenIn
+	for _, route := range routes {
+		tokenOut, err = k.SwapExactAmountIn(cacheCtx, sender, route.PoolId, tokenOut, route.TokenOutDenom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if tokenOut.Amount.LT(tokenOutMinAmount) {
+		return sdk.Coin{}, types.ErrTokenOutBelowMin.Wrapf(
+			"got %s, minimum %s%s", tokenOut, tokenOutMinAmount, tokenOut.Denom)
+	}
+
+	writeCache()
+	return tokenOut, nil
+}
+
+/*
+SwapExactAmountOutRoute Swaps tokens of denom tokenInDenom through the pools of the routes, in order,
+for an exact amount of tokenOut. All hops are applied atomically.
+
+args:
+  - ctx: the cosmos-sdk context
+  - sender: the address wishing to perform the swap
+  - routes: the pools to swap through and the denoms obtained from them
+  - tokenInDenom: the denom of the tokens given to the first pool
+  - tokenInMaxAmount: the maximum amount of tokens given to the first pool
+  - tokenOut: the amount of tokens taken out of the last pool
+
+ret:
+  - tokenIn: the amount of tokens given to the first pool
+  - err: error if any
+*/
+func (k Keeper) SwapExactAmountOutRoute(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapRoute,
+	tokenInDenom string,
+	tokenInMaxAmount sdkmath.Int,
+	tokenOut sdk.Coin,
+) (tokenIn sdk.Coin, err error) {
+	tokenIn, hopsOut, err := k.EstimateSwapRouteExactAmountOut(ctx, routes, tokenInDenom, tokenOut)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if tokenIn.Amount.GT(tokenInMaxAmount) {
+		return sdk.Coin{}, types.ErrTokenInAboveMax.Wrapf(
+			"got %s, maximum %s%s", tokenIn, tokenInMaxAmount, tokenIn.Denom)
+	}
+
+	// the pools of a route are distinct, so every pool is still in the state
+	// the estimate was computed from when its hop is swapped
+	cacheCtx, writeCache := ctx.CacheContext()
+	denomIn := tokenInDenom
+	for i, route := range routes {
+		if _, err = k.SwapExactAmountOut(cacheCtx, sender, route.PoolId, hopsOut[i], denomIn); err != nil {
+			return sdk.Coin{}, err
+		}
+		denomIn = route.TokenOutDenom
+	}
+
+	writeCache()
+	return tokenIn, nil
+}
+
+/*
+EstimateSwapRouteExactAmountIn Computes the amount of tokens obtained by swapping tokenIn through the pools of the routes.
+
+args:
+  - ctx: the cosmos-sdk context
+  - routes: the pools to swap through and the denoms obtained from them
+  - tokenIn: the amount of tokens given to the first pool
+
+ret:
+  - tokenOut: the amount of tokens taken out of the last pool
+  - fees: the fees charged by every hop
+  - err: error if any
+*/
+func (k Keeper) EstimateSwapRouteExactAmountIn(
+	ctx sdk.Context, routes []types.SwapRoute, tokenIn sdk.Coin,
+) (tokenOut sdk.Coin, fees sdk.Coins, err error) {
+	if err = types.ValidateSwapRoutes(routes, tokenIn.Denom); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	tokenOut = tokenIn
+	for _, route := range routes {
+		pool, err := k.FetchPool(ctx, route.PoolId)
+		if err != nil {
+			return sdk.Coin{}, nil, err
+		}
+
+		var fee sdk.Coin
+		tokenOut, fee, err = pool.CalcOutAmtGivenIn(tokenOut, route.TokenOutDenom, false)
+		if err != nil {
+			return sdk.Coin{}, nil, err
+		}
+		fees = fees.Add(fee)
+	}
+
+	return tokenOut, fees, nil
+}
+
+/*
+EstimateSwapRouteExactAmountOut Computes the amount of tokens of denom tokenInDenom required to obtain
+tokenOut through the pools of the routes.
+
+args:
+  - ctx: the cosmos-sdk context
+  - routes: the pools to swap through and the denoms obtained from them
+  - tokenInDenom: the denom of the tokens given to the first pool
+  - tokenOut: the amount of tokens taken out of the last pool
+
+ret:
+  - tokenIn: the amount of tokens given to the first pool
+  - hopsOut: the amount of tokens taken out of the pool of every hop
+  - err: error if any
+*/
+func (k Keeper) EstimateSwapRouteExactAmountOut(
+	ctx sdk.Context, routes []types.SwapRoute, tokenInDenom string, tokenOut sdk.Coin,
+) (tokenIn sdk.Coin, hopsOut []sdk.Coin, err error) {
+	if err = types.ValidateSwapRoutes(routes, tokenInDenom); err != nil {
+		return sdk.Coin{}, nil, err
+	}
+	if last := routes[len(routes)-1]; last.TokenOutDenom != tokenOut.Denom {
+		return sdk.Coin{}, nil, types.ErrInvalidSwapRoute.Wrapf(
+			"the route ends with %s, not %s", last.TokenOutDenom, tokenOut.Denom)
+	}
+
+	// walk the route backwards, the tokens in of a hop are the tokens out of the
+	// previous one
+	hopsOut = make([]sdk.Coin, len(routes))
+	tokenIn = tokenOut
+	for i := len(routes) - 1; i >= 0; i-- {
+		hopsOut[i] = tokenIn
+
+		denomIn := tokenInDenom
+		if i > 0 {
+			denomIn = routes[i-1].TokenOutDenom
+		}
+
+		pool, err := k.FetchPool(ctx, routes[i].PoolId)
+		if err != nil {
+			return sdk.Coin{}, nil, err
+		}
+
+		tokenIn, err = pool.CalcInAmtGivenOut(hopsOut[i], denomIn)
+		if err != nil {
+			return sdk.Coin{}, nil, err
+		}
+	}
+
+	return tokenIn, hopsOut, nil
+}
+
+/*
+FindBestSwapRoute Finds the route through at most maxHops pools returning the most tokens of denom
+tokenOutDenom for tokenIn.
+
+args:
+  - ctx: the cosmos-sdk context
+  - tokenIn: the amount of tokens to swap
+  - tokenOutDenom: the denom of the tokens to obtain
+  - maxHops: the maximum number of pools of the route
+
+ret:
+  - routes: the best route
+  - tokenOut: the amount of tokens obtained through the best route
+  - err: error if any
+*/
+func (k Keeper) FindBestSwapRoute(
+	ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, maxHops int,
+) (routes []types.SwapRoute, tokenOut sdk.Coin, err error) {
+	return types.BestSwapRoute(k.FetchAllPools(ctx), tokenIn, tokenOutDenom, maxHops)
+}
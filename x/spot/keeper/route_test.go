@@ -0,0 +1,301 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/spot/keeper"
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+// setupRoutePools creates a deep unibi/unusd pool 1, a deep unusd/uusdc pool 2
+// and a shallow unibi/uusdc pool 3.
+func setupRoutePools(t *testing.T) (*app.NibiruApp, sdk.Context) {
+	nibiru, ctx := testapp.NewNibiruTestAppAndContext()
+
+	pools := []types.Pool{
+		mock.SpotPool(1, sdk.NewCoins(
+			sdk.NewInt64Coin(denoms.NIBI, 1_000),
+			sdk.NewInt64Coin(denoms.NUSD, 1_000),
+		), 100),
+		mock.SpotPool(2, sdk.NewCoins(
+			sdk.NewInt64Coin(denoms.NUSD, 1_000),
+			sdk.NewInt64Coin(denoms.USDC, 1_000),
+		), 100),
+		mock.SpotPool(3, sdk.NewCoins(
+			sdk.NewInt64Coin(denoms.NIBI, 100),
+			sdk.NewInt64Coin(denoms.USDC, 100),
+		), 100),
+	}
+	for _, pool := range pools {
+		poolAddr := testutil.AccAddress()
+		pool.Address = poolAddr.String()
+		require.NoError(t, testapp.FundAccount(nibiru.BankKeeper, ctx, poolAddr, pool.PoolBalances()))
+		nibiru.SpotKeeper.SetPool(ctx, pool)
+	}
+
+	return nibiru, ctx
+}
+
+func TestSwapExactAmountInRoute(t *testing.T) {
+	routes := []types.SwapRoute{
+		{PoolId: 1, TokenOutDenom: denoms.NUSD},
+		{PoolId: 2, TokenOutDenom: denoms.USDC},
+	}
+
+	tests := []struct {
+		name              string
+		routes            []types.SwapRoute
+		tokenOutMinAmount int64
+
+		expectedErr      error
+		expectedTokenOut sdk.Coin
+	}{
+		{
+			name:              "two hops",
+			routes:            routes,
+			tokenOutMinAmount: 82,
+			// 100unibi -> 90unusd -> 82uusdc
+			expectedTokenOut: sdk.NewInt64Coin(denoms.USDC, 82),
+		},
+		{
+			name:              "slippage too high",
+			routes:            routes,
+			tokenOutMinAmount: 83,
+			expectedErr:       types.ErrTokenOutBelowMin,
+		},
+		{
+			name: "pool used twice",
+			routes: []types.SwapRoute{
+				{PoolId: 1, TokenOutDenom: denoms.NUSD},
+				{PoolId: 1, TokenOutDenom: denoms.NIBI},
+			},
+			expectedErr: types.ErrInvalidSwapRoute,
+		},
+		{
+			name: "denom not in pool",
+			routes: []types.SwapRoute{
+				{PoolId: 1, TokenOutDenom: denoms.NUSD},
+				{PoolId: 3, TokenOutDenom: denoms.USDC},
+			},
+			expectedErr: types.ErrTokenDenomNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			nibiru, ctx := setupRoutePools(t)
+			poolsBefore := nibiru.SpotKeeper.FetchAllPools(ctx)
+
+			tokenIn := sdk.NewInt64Coin(denoms.NIBI, 100)
+			sender := testutil.AccAddress()
+			require.NoError(t, testapp.FundAccount(nibiru.BankKeeper, ctx, sender, sdk.NewCoins(tokenIn)))
+
+			tokenOut, err := nibiru.SpotKeeper.SwapExactAmountInRoute(
+				ctx, sender, tc.routes, tokenIn, sdk.NewInt(tc.tokenOutMinAmount))
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				// no hop is applied
+				require.Equal(t, sdk.NewCoins(tokenIn), nibiru.BankKeeper.GetAllBalances(ctx, sender))
+				require.Equal(t, poolsBefore, nibiru.SpotKeeper.FetchAllPools(ctx))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTokenOut, tokenOut)
+			require.Equal(t, sdk.NewCoins(tc.expectedTokenOut), nibiru.BankKeeper.GetAllBalances(ctx, sender))
+
+			pool1, err := nibiru.SpotKeeper.FetchPool(ctx, 1)
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewCoins(
+				sdk.NewInt64Coin(denoms.NIBI, 1_100),
+				sdk.NewInt64Coin(denoms.NUSD, 910),
+			), pool1.PoolBalances())
+		})
+	}
+}
+
+func TestSwapExactAmountOutRoute(t *testing.T) {
+	routes := []types.SwapRoute{
+		{PoolId: 1, TokenOutDenom: denoms.NUSD},
+		{PoolId: 2, TokenOutDenom: denoms.USDC},
+	}
+	tokenOut := sdk.NewInt64Coin(denoms.USDC, 50)
+
+	tests := []struct {
+		name             string
+		routes           []types.SwapRoute
+		tokenInMaxAmount int64
+
+		expectedErr     error
+		expectedTokenIn sdk.Coin
+	}{
+		{
+			name:             "two hops",
+			routes:           routes,
+			tokenInMaxAmount: 56,
+			// 56unibi -> 53unusd -> 50uusdc
+			expectedTokenIn: sdk.NewInt64Coin(denoms.NIBI, 56),
+		},
+		{
+			name:             "slippage too high",
+			routes:           routes,
+			tokenInMaxAmount: 55,
+			expectedErr:      types.ErrTokenInAboveMax,
+		},
+		{
+			name:             "route does not end with token out",
+			routes:           routes[:1],
+			tokenInMaxAmount: 100,
+			expectedErr:      types.ErrInvalidSwapRoute,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			nibiru, ctx := setupRoutePools(t)
+
+			userFunds := sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 100))
+			sender := testutil.AccAddress()
+			require.NoError(t, testapp.FundAccount(nibiru.BankKeeper, ctx, sender, userFunds))
+
+			tokenIn, err := nibiru.SpotKeeper.SwapExactAmountOutRoute(
+				ctx, sender, tc.routes, denoms.NIBI, sdk.NewInt(tc.tokenInMaxAmount), tokenOut)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Equal(t, userFunds, nibiru.BankKeeper.GetAllBalances(ctx, sender))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTokenIn, tokenIn)
+			require.Equal(t,
+				userFunds.Sub(tc.expectedTokenIn).Add(tokenOut),
+				nibiru.BankKeeper.GetAllBalances(ctx, sender),
+			)
+		})
+	}
+}
+
+func TestQueryEstimateSwapRoute(t *testing.T) {
+	nibiru, ctx := setupRoutePools(t)
+	queryServer := keeper.NewQuerier(nibiru.SpotKeeper)
+
+	resp, err := queryServer.EstimateSwapRoute(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryEstimateSwapRouteRequest{
+			TokenIn: sdk.NewInt64Coin(denoms.NIBI, 100),
+			Routes: []types.SwapRoute{
+				{PoolId: 1, TokenOutDenom: denoms.NUSD},
+				{PoolId: 2, TokenOutDenom: denoms.USDC},
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denoms.USDC, 82), resp.TokenOut)
+
+	_, err = queryServer.EstimateSwapRoute(
+		sdk.WrapSDKContext(ctx),
+		&types.QueryEstimateSwapRouteRequest{
+			TokenIn: sdk.NewInt64Coin(denoms.NIBI, 100),
+			Routes:  []types.SwapRoute{{PoolId: 4, TokenOutDenom: denoms.NUSD}},
+		},
+	)
+	require.ErrorIs(t, err, types.ErrPoolNotFound)
+}
+
+func TestQueryBestSwapRoute(t *testing.T) {
+	tests := []struct {
+		name    string
+		maxHops uint32
+
+		expectErr        bool
+		expectedRoutes   []types.SwapRoute
+		expectedTokenOut sdk.Coin
+	}{
+		{
+			name:    "deep pools through two hops beat the shallow pool",
+			maxHops: 0,
+			expectedRoutes: []types.SwapRoute{
+				{PoolId: 1, TokenOutDenom: denoms.NUSD},
+				{PoolId: 2, TokenOutDenom: denoms.USDC},
+			},
+			expectedTokenOut: sdk.NewInt64Coin(denoms.USDC, 82),
+		},
+		{
+			name:             "single hop",
+			maxHops:          1,
+			expectedRoutes:   []types.SwapRoute{{PoolId: 3, TokenOutDenom: denoms.USDC}},
+			expectedTokenOut: sdk.NewInt64Coin(denoms.USDC, 50),
+		},
+		{
+			name:      "too many hops",
+			maxHops:   types.MaxSwapRouteHops + 1,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			nibiru, ctx := setupRoutePools(t)
+			queryServer := keeper.NewQuerier(nibiru.SpotKeeper)
+
+			resp, err := queryServer.BestSwapRoute(
+				sdk.WrapSDKContext(ctx),
+				&types.QueryBestSwapRouteRequest{
+					TokenIn:       sdk.NewInt64Coin(denoms.NIBI, 100),
+					TokenOutDenom: denoms.USDC,
+					MaxHops:       tc.maxHops,
+				},
+			)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRoutes, resp.Routes)
+			require.Equal(t, tc.expectedTokenOut, resp.TokenOut)
+		})
+	}
+}
+
+func TestFindBestSwapRouteCandidatePools(t *testing.T) {
+	nibiru, ctx := testapp.NewNibiruTestAppAndContext()
+
+	// shallow pools first, so that only the deepest candidates are searched
+	var pools []types.Pool
+	for id := uint64(1); id <= types.MaxSwapRouteCandidatePools; id++ {
+		pools = append(pools, mock.SpotPool(id, sdk.NewCoins(
+			sdk.NewInt64Coin(denoms.NIBI, 100),
+			sdk.NewInt64Coin(denoms.USDC, 100),
+		), 100))
+	}
+	deepPoolId := uint64(types.MaxSwapRouteCandidatePools + 1)
+	pools = append(pools, mock.SpotPool(deepPoolId, sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.NIBI, 1_000),
+		sdk.NewInt64Coin(denoms.USDC, 1_000),
+	), 100))
+	for _, pool := range pools {
+		poolAddr := testutil.AccAddress()
+		pool.Address = poolAddr.String()
+		require.NoError(t, testapp.FundAccount(nibiru.BankKeeper, ctx, poolAddr, pool.PoolBalances()))
+		nibiru.SpotKeeper.SetPool(ctx, pool)
+	}
+
+	routes, tokenOut, err := nibiru.SpotKeeper.FindBestSwapRoute(
+		ctx, sdk.NewInt64Coin(denoms.NIBI, 100), denoms.USDC, types.MaxSwapRouteHops+1)
+	require.NoError(t, err)
+	require.Equal(t, []types.SwapRoute{{PoolId: deepPoolId, TokenOutDenom: denoms.USDC}}, routes)
+	require.Equal(t, sdk.NewInt64Coin(denoms.USDC, 90), tokenOut)
+}
@@ -8,22 +8,46 @@ import (
 	"github.com/NibiruChain/nibiru/x/spot/types"
 )
 
+/*
+updatePoolForSwap Moves the tokens of a swap between the sender and the pool, and
+records the swap in the state. The protocol fee is paid by the sender to the
+protocol fee recipient, so the pool only receives the rest of tokenIn.
+
+args:
+  - ctx: the cosmos-sdk context
+  - pool: the pool swapped through
+  - sender: the address performing the swap
+  - tokenIn: the tokens given by the sender, fees included
+  - tokenOut: the tokens given to the sender
+  - fee: the swap fee, included in tokenIn
+  - protocolFee: the protocol share of the swap fee
+
+ret:
+  - err: error if any
+*/
 func (k Keeper) updatePoolForSwap(
 	ctx sdk.Context,
 	pool types.Pool,
 	sender sdk.AccAddress,
 	tokenIn sdk.Coin,
 	tokenOut sdk.Coin,
+	fee sdk.Coin,
+	protocolFee sdk.Coin,
 ) (err error) {
+	poolTokenIn := tokenIn.Sub(protocolFee)
 	if err = k.bankKeeper.SendCoins(
 		ctx,
 		/*from=*/ sender,
 		/*to=*/ pool.GetAddress(),
-		/*coins=*/ sdk.Coins{tokenIn},
+		/*coins=*/ sdk.Coins{poolTokenIn},
 	); err != nil {
 		return err
 	}
 
+	if err = k.payProtocolFee(ctx, sender, protocolFee); err != nil {
+		return err
+	}
+
 	if err = k.bankKeeper.SendCoins(
 		ctx,
 		/*from=*/ pool.GetAddress(),
@@ -33,12 +57,15 @@ func (k Keeper) updatePoolForSwap(
 		return err
 	}
 
-	if err = pool.ApplySwap(tokenIn, tokenOut); err != nil {
+	protocolFeeShare := k.GetParams(ctx).ProtocolFeeShare
+	if err = pool.ApplySwapWithProtocolFee(tokenIn, tokenOut, protocolFee, protocolFeeShare); err != nil {
 		return err
 	}
 	k.SetPool(ctx, pool)
+	k.updateTwapRecord(ctx, pool)
+	k.recordSwapFees(ctx, pool.Id, fee, protocolFee)
 
-	if err = k.RecordTotalLiquidityIncrease(ctx, sdk.Coins{tokenIn}); err != nil {
+	if err = k.RecordTotalLiquidityIncrease(ctx, sdk.Coins{poolTokenIn}); err != nil {
 		return err
 	}
 	if err = k.RecordTotalLiquidityDecrease(ctx, sdk.Coins{tokenOut}); err != nil {
@@ -101,17 +128,19 @@ func (k Keeper) SwapExactAmountIn(
 		return sdk.Coin{}, err
 	}
 
-	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut)
+	protocolFee := k.protocolFeeOf(ctx, fee)
+	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut, fee, protocolFee)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
 
 	err = ctx.EventManager().EmitTypedEvent(&types.EventAssetsSwapped{
-		Address:   sender.String(),
-		TokenIn:   tokenIn,
-		TokenOut:  tokenOut,
-		Fee:       fee,
-		FinalPool: pool,
+		Address:     sender.String(),
+		TokenIn:     tokenIn,
+		TokenOut:    tokenOut,
+		Fee:         fee,
+		FinalPool:   pool,
+		ProtocolFee: protocolFee,
 	})
 	if err != nil {
 		return tokenOut, err
@@ -119,3 +148,78 @@ func (k Keeper) SwapExactAmountIn(
 
 	return tokenOut, nil
 }
+
+/*
+SwapExactAmountOut Given a poolId and the amount of tokens to obtain, swaps in the number of tokens
+of denom tokenInDenom required.
+
+For example, if pool 1 has 100foo and 100bar, this function can be called with
+tokenOut=10bar and tokenInDenom=foo.
+
+args:
+  - ctx: the cosmos-sdk context
+  - sender: the address wishing to perform the swap
+  - poolId: the pool id number
+  - tokenOut: the amount of tokens taken out of the pool
+  - tokenInDenom: the denom of the token given to the pool
+
+ret:
+  - tokenIn: the amount of tokens given to the pool
+  - err: error if any
+*/
+func (k Keeper) SwapExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+) (tokenIn sdk.Coin, err error) {
+	if tokenOut.Denom == tokenInDenom {
+		return sdk.Coin{}, types.ErrSameTokenDenom
+	}
+
+	pool, err := k.FetchPool(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// calculate tokenIn and validate
+	tokenIn, err = pool.CalcInAmtGivenOut(tokenOut, tokenInDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if tokenIn.Amount.LTE(sdk.ZeroInt()) {
+		return sdk.Coin{}, errors.New("tokenIn amount must be greater than zero")
+	}
+	fee := sdk.NewCoin(tokenInDenom, sdk.NewDecFromInt(tokenIn.Amount).Mul(pool.PoolParams.SwapFee).TruncateInt())
+
+	// check sender has enough tokenIn
+	if err = k.CheckEnoughBalances(ctx, sdk.Coins{tokenIn}, sender); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// check pool has enough tokenOut
+	if err = k.CheckEnoughBalances(ctx, sdk.Coins{tokenOut}, pool.GetAddress()); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	protocolFee := k.protocolFeeOf(ctx, fee)
+	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut, fee, protocolFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAssetsSwapped{
+		Address:     sender.String(),
+		TokenIn:     tokenIn,
+		TokenOut:    tokenOut,
+		Fee:         fee,
+		FinalPool:   pool,
+		ProtocolFee: protocolFee,
+	})
+	if err != nil {
+		return tokenIn, err
+	}
+
+	return tokenIn, nil
+}
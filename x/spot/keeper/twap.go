@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+/*
+SetTwapRecord Writes a TWAP record of a pool to the state.
+*/
+func (k Keeper) SetTwapRecord(ctx sdk.Context, record types.TwapRecord) {
+	ctx.KVStore(k.storeKey).Set(
+		types.GetTwapRecordKey(record.PoolId, record.TimestampMs), k.cdc.MustMarshal(&record))
+}
+
+/*
+FetchTwapRecord Fetches the latest TWAP record of a pool written at or before a time.
+
+args:
+  - ctx: the cosmos-sdk context
+  - poolId: the pool's numeric id
+  - timestampMs: the time in milliseconds
+
+ret:
+  - record: the TWAP record
+  - err: ErrNoValidTwap if the pool has no record at or before the time
+*/
+func (k Keeper) FetchTwapRecord(ctx sdk.Context, poolId uint64, timestampMs int64) (record types.TwapRecord, err error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(
+		types.GetTwapRecordKey(poolId, -1<<63),
+		types.GetTwapRecordKey(poolId, timestampMs+1),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.TwapRecord{}, types.ErrNoValidTwap.Wrapf(
+			"no price of pool %d at or before time %d", poolId, timestampMs)
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), &record)
+	return record, nil
+}
+
+/*
+FetchAllTwapRecords Fetches the TWAP records of all the pools, sorted by pool id and time.
+*/
+func (k Keeper) FetchAllTwapRecords(ctx sdk.Context) (records []types.TwapRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTwapRecords)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TwapRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+/*
+updateTwapRecord Accumulates the prices of a pool until the current block time and
+records its new spot prices. Must be called every time the balances of the pool
+change. Records older than TwapRecordHistoryKeepPeriod are pruned.
+*/
+func (k Keeper) updateTwapRecord(ctx sdk.Context, pool types.Pool) {
+	timestampMs := ctx.BlockTime().UnixMilli()
+
+	record, err := k.FetchTwapRecord(ctx, pool.Id, timestampMs)
+	if err != nil {
+		record = types.NewTwapRecord(pool, timestampMs)
+	} else {
+		record = record.Next(pool, timestampMs)
+	}
+	k.SetTwapRecord(ctx, record)
+
+	k.pruneTwapRecords(ctx, pool.Id, ctx.BlockTime().Add(-types.TwapRecordHistoryKeepPeriod).UnixMilli())
+}
+
+// pruneTwapRecords deletes the records of a pool written before a time, except
+// the latest of them which is still needed for the prices at that time.
+func (k Keeper) pruneTwapRecords(ctx sdk.Context, poolId uint64, timestampMs int64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(
+		types.GetTwapRecordKey(poolId, -1<<63),
+		types.GetTwapRecordKey(poolId, timestampMs),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return
+	}
+	var keys [][]byte
+	for iterator.Next(); iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+/*
+GetTwap Computes the time weighted average price of a base asset in a quote asset
+of a pool, between two times.
+
+args:
+  - ctx: the cosmos-sdk context
+  - poolId: the pool's numeric id
+  - baseDenom: the denom of the priced asset
+  - quoteDenom: the denom the price is expressed in
+  - startTimeMs: the start of the time window in milliseconds
+  - endTimeMs: the end of the time window in milliseconds, the current block time if zero
+
+ret:
+  - twap: the amount of quote asset worth one base asset on average
+  - err: error if any
+*/
+func (k Keeper) GetTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseDenom string,
+	quoteDenom string,
+	startTimeMs int64,
+	endTimeMs int64,
+) (twap sdk.Dec, err error) {
+	if endTimeMs == 0 {
+		endTimeMs = ctx.BlockTime().UnixMilli()
+	}
+	if startTimeMs >= endTimeMs {
+		return sdk.Dec{}, types.ErrNoValidTwap.Wrapf(
+			"start time %d must be before end time %d", startTimeMs, endTimeMs)
+	}
+	if endTimeMs > ctx.BlockTime().UnixMilli() {
+		return sdk.Dec{}, types.ErrNoValidTwap.Wrapf(
+			"end time %d is after the block time %d", endTimeMs, ctx.BlockTime().UnixMilli())
+	}
+
+	startRecord, err := k.FetchTwapRecord(ctx, poolId, startTimeMs)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	startPrice, err := startRecord.CumulativePriceAt(baseDenom, quoteDenom, startTimeMs)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	endRecord, err := k.FetchTwapRecord(ctx, poolId, endTimeMs)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	endPrice, err := endRecord.CumulativePriceAt(baseDenom, quoteDenom, endTimeMs)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return endPrice.Sub(startPrice).QuoInt64(endTimeMs - startTimeMs), nil
+}
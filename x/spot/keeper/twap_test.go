@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/spot/keeper"
+	"github.com/NibiruChain/nibiru/x/spot/types"
+)
+
+func TestTwap(t *testing.T) {
+	startTime := time.Unix(1_690_000_000, 0).UTC()
+	startMs := startTime.UnixMilli()
+	app, ctx := testapp.NewNibiruTestAppAndContextAtTime(startTime)
+
+	poolCreationFee := sdk.NewInt64Coin(denoms.NIBI, 1_000)
+	app.SpotKeeper.SetParams(ctx, types.NewParams(
+		/*startingPoolNumber=*/ 1,
+		/*poolCreationFee=*/ sdk.NewCoins(poolCreationFee),
+		/*whitelistedAssets*/ []string{"uatom", "uosmo"},
+	))
+	sender := testutil.AccAddress()
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, sender, sdk.NewCoins(
+		sdk.NewInt64Coin("uatom", 2_000),
+		sdk.NewInt64Coin("uosmo", 1_000),
+		poolCreationFee,
+	)))
+
+	poolId, err := app.SpotKeeper.NewPool(ctx, sender,
+		types.PoolParams{
+			SwapFee:  sdk.ZeroDec(),
+			ExitFee:  sdk.ZeroDec(),
+			PoolType: types.PoolType_BALANCER,
+			A:        sdk.ZeroInt(),
+		},
+		[]types.PoolAsset{
+			{Token: sdk.NewInt64Coin("uatom", 1_000), Weight: sdk.OneInt()},
+			{Token: sdk.NewInt64Coin("uosmo", 1_000), Weight: sdk.OneInt()},
+		})
+	require.NoError(t, err)
+
+	// 10 seconds at a price of 1, then 30 seconds at the price after the swap
+	ctx = ctx.WithBlockTime(startTime.Add(10 * time.Second))
+	_, err = app.SpotKeeper.SwapExactAmountIn(ctx, sender, poolId, sdk.NewInt64Coin("uatom", 1_000), "uosmo")
+	require.NoError(t, err)
+	pool, err := app.SpotKeeper.FetchPool(ctx, poolId)
+	require.NoError(t, err)
+	// about 0.25uosmo per uatom, with 2000uatom and 500uosmo
+	swapPrice, err := pool.CalcSpotPrice("uosmo", "uatom")
+	require.NoError(t, err)
+	inverseSwapPrice, err := pool.CalcSpotPrice("uatom", "uosmo")
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(startTime.Add(40 * time.Second))
+
+	for _, tc := range []struct {
+		name        string
+		baseDenom   string
+		quoteDenom  string
+		startTimeMs int64
+		endTimeMs   int64
+		twap        sdk.Dec
+	}{
+		{
+			name:        "since the creation of the pool",
+			baseDenom:   "uatom",
+			quoteDenom:  "uosmo",
+			startTimeMs: startMs,
+			// (1 * 10 + 0.25 * 30) / 40
+			twap: sdk.NewDec(10_000).Add(swapPrice.MulInt64(30_000)).QuoInt64(40_000),
+		},
+		{
+			name:        "inverse price",
+			baseDenom:   "uosmo",
+			quoteDenom:  "uatom",
+			startTimeMs: startMs,
+			// (1 * 10 + 4 * 30) / 40
+			twap: sdk.NewDec(10_000).Add(inverseSwapPrice.MulInt64(30_000)).QuoInt64(40_000),
+		},
+		{
+			name:        "between two records",
+			baseDenom:   "uatom",
+			quoteDenom:  "uosmo",
+			startTimeMs: startMs + 5_000,
+			endTimeMs:   startMs + 20_000,
+			// (1 * 5 + 0.25 * 10) / 15
+			twap: sdk.NewDec(5_000).Add(swapPrice.MulInt64(10_000)).QuoInt64(15_000),
+		},
+		{
+			name:        "after the last record",
+			baseDenom:   "uatom",
+			quoteDenom:  "uosmo",
+			startTimeMs: startMs + 10_000,
+			twap:        swapPrice,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			twap, err := app.SpotKeeper.GetTwap(ctx, poolId, tc.baseDenom, tc.quoteDenom, tc.startTimeMs, tc.endTimeMs)
+			require.NoError(t, err)
+			require.Equal(t, tc.twap, twap)
+		})
+	}
+
+	for _, tc := range []struct {
+		name        string
+		baseDenom   string
+		startTimeMs int64
+		endTimeMs   int64
+	}{
+		{name: "before the creation of the pool", baseDenom: "uatom", startTimeMs: startMs - 1},
+		{name: "end time in the future", baseDenom: "uatom", startTimeMs: startMs, endTimeMs: startMs + 41_000},
+		{name: "empty time window", baseDenom: "uatom", startTimeMs: startMs + 20_000, endTimeMs: startMs + 20_000},
+		{name: "asset not in the pool", baseDenom: "unibi", startTimeMs: startMs},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := app.SpotKeeper.GetTwap(ctx, poolId, tc.baseDenom, "uosmo", tc.startTimeMs, tc.endTimeMs)
+			require.ErrorIs(t, err, types.ErrNoValidTwap)
+		})
+	}
+
+	resp, err := keeper.NewQuerier(app.SpotKeeper).Twap(sdk.WrapSDKContext(ctx), &types.QueryTwapRequest{
+		PoolId:      poolId,
+		BaseDenom:   "uatom",
+		QuoteDenom:  "uosmo",
+		StartTimeMs: startMs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10_000).Add(swapPrice.MulInt64(30_000)).QuoInt64(40_000), resp.Twap)
+
+	// the records older than the history keep period are pruned, except the
+	// latest of them
+	ctx = ctx.WithBlockTime(startTime.Add(types.TwapRecordHistoryKeepPeriod + time.Hour))
+	_, err = app.SpotKeeper.SwapExactAmountIn(ctx, sender, poolId, sdk.NewInt64Coin("uosmo", 500), "uatom")
+	require.NoError(t, err)
+	records := app.SpotKeeper.FetchAllTwapRecords(ctx)
+	require.Len(t, records, 2)
+	require.Equal(t, startMs+10_000, records[0].TimestampMs)
+
+	_, err = app.SpotKeeper.GetTwap(ctx, poolId, "uatom", "uosmo", startMs, 0)
+	require.ErrorIs(t, err, types.ErrNoValidTwap)
+	twap, err := app.SpotKeeper.GetTwap(ctx, poolId, "uatom", "uosmo", startMs+10_000, startMs+40_000)
+	require.NoError(t, err)
+	require.Equal(t, swapPrice, twap)
+}
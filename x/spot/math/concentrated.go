@@ -0,0 +1,104 @@
+package math
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MinTick is the lowest tick of a concentrated liquidity pool, for a price of about 1e-12.
+	MinTick int64 = -276324
+	// MaxTick is the highest tick of a concentrated liquidity pool, for a price of about 1e12.
+	MaxTick int64 = 276324
+)
+
+// sqrtTickBase is the square root of the price ratio between two adjacent ticks, sqrt(1.0001).
+var sqrtTickBase = mustSqrt(sdk.MustNewDecFromStr("1.0001"))
+
+func mustSqrt(d sdk.Dec) sdk.Dec {
+	root, err := d.ApproxSqrt()
+	if err != nil {
+		panic(err)
+	}
+	return root
+}
+
+// SqrtPriceAtTick returns the square root of the price at a tick,
+// sqrt(1.0001^tick).
+// panics if the tick is out of [MinTick, MaxTick].
+func SqrtPriceAtTick(tick int64) sdk.Dec {
+	if tick < MinTick || tick > MaxTick {
+		panic("tick out of range")
+	}
+	if tick >= 0 {
+		return sqrtTickBase.Power(uint64(tick))
+	}
+	return sdk.OneDec().Quo(sqrtTickBase.Power(uint64(-tick)))
+}
+
+// TickAtSqrtPrice returns the highest tick whose square root price is lower than or
+// equal to sqrtPrice, clamped to [MinTick, MaxTick].
+func TickAtSqrtPrice(sqrtPrice sdk.Dec) int64 {
+	lo, hi := MinTick, MaxTick
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		if SqrtPriceAtTick(mid).LTE(sqrtPrice) {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return lo
+}
+
+// Amount0Delta returns the amount of token0 held by the liquidity between two
+// square root prices: liquidity * (sqrtB - sqrtA) / (sqrtA * sqrtB).
+func Amount0Delta(sqrtPriceA, sqrtPriceB, liquidity sdk.Dec, roundUp bool) sdk.Dec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	if roundUp {
+		return liquidity.MulRoundUp(diff).QuoRoundUp(sqrtPriceB).QuoRoundUp(sqrtPriceA)
+	}
+	return liquidity.MulTruncate(diff).QuoTruncate(sqrtPriceB).QuoTruncate(sqrtPriceA)
+}
+
+// Amount1Delta returns the amount of token1 held by the liquidity between two
+// square root prices: liquidity * (sqrtB - sqrtA).
+func Amount1Delta(sqrtPriceA, sqrtPriceB, liquidity sdk.Dec, roundUp bool) sdk.Dec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	if roundUp {
+		return liquidity.MulRoundUp(diff)
+	}
+	return liquidity.MulTruncate(diff)
+}
+
+// LiquidityForAmounts returns the largest liquidity between the square root prices
+// sqrtA and sqrtB which can be provided with amount0 of token0 and amount1 of token1
+// at the current square root price.
+func LiquidityForAmounts(sqrtPrice, sqrtPriceA, sqrtPriceB, amount0, amount1 sdk.Dec) sdk.Dec {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		sqrtPriceA, sqrtPriceB = sqrtPriceB, sqrtPriceA
+	}
+
+	// liquidity0 = amount0 * sqrtA * sqrtB / (sqrtB - sqrtA)
+	liquidity0 := func(sqrtA sdk.Dec) sdk.Dec {
+		return amount0.MulTruncate(sqrtA).MulTruncate(sqrtPriceB).QuoTruncate(sqrtPriceB.Sub(sqrtA))
+	}
+	// liquidity1 = amount1 / (sqrtB - sqrtA)
+	liquidity1 := func(sqrtB sdk.Dec) sdk.Dec {
+		return amount1.QuoTruncate(sqrtB.Sub(sqrtPriceA))
+	}
+
+	switch {
+	case sqrtPrice.LTE(sqrtPriceA):
+		return liquidity0(sqrtPriceA)
+	case sqrtPrice.GTE(sqrtPriceB):
+		return liquidity1(sqrtPriceB)
+	default:
+		return sdk.MinDec(liquidity0(sqrtPrice), liquidity1(sqrtPrice))
+	}
+}
@@ -0,0 +1,69 @@
+package math
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSqrtPriceAtTick(t *testing.T) {
+	require.Equal(t, sdk.OneDec(), SqrtPriceAtTick(0))
+
+	// 1.0001^(46054/2) ~ 10
+	require.InDelta(t, 10, SqrtPriceAtTick(46054).MustFloat64(), 1e-3)
+	require.InDelta(t, 0.1, SqrtPriceAtTick(-46054).MustFloat64(), 1e-5)
+
+	require.InDelta(t, 1e6, SqrtPriceAtTick(MaxTick).MustFloat64(), 5)
+	require.InDelta(t, 1e-6, SqrtPriceAtTick(MinTick).MustFloat64(), 5e-12)
+
+	require.Panics(t, func() { SqrtPriceAtTick(MaxTick + 1) })
+	require.Panics(t, func() { SqrtPriceAtTick(MinTick - 1) })
+}
+
+func TestTickAtSqrtPrice(t *testing.T) {
+	for _, tick := range []int64{MinTick, -46054, -1, 0, 1, 100, 46054, MaxTick} {
+		require.Equal(t, tick, TickAtSqrtPrice(SqrtPriceAtTick(tick)))
+	}
+
+	// in between two ticks
+	sqrtPrice := SqrtPriceAtTick(10).Add(SqrtPriceAtTick(11)).QuoInt64(2)
+	require.Equal(t, int64(10), TickAtSqrtPrice(sqrtPrice))
+
+	// clamped
+	require.Equal(t, MinTick, TickAtSqrtPrice(sdk.SmallestDec()))
+	require.Equal(t, MaxTick, TickAtSqrtPrice(sdk.NewDec(10_000_000)))
+}
+
+func TestAmountDeltas(t *testing.T) {
+	liquidity := sdk.NewDec(1_000)
+	sqrtA, sqrtB := sdk.OneDec(), sdk.NewDec(2)
+
+	// 1000 * (2 - 1) / (1 * 2)
+	require.Equal(t, sdk.NewDec(500), Amount0Delta(sqrtA, sqrtB, liquidity, false))
+	require.Equal(t, sdk.NewDec(500), Amount0Delta(sqrtB, sqrtA, liquidity, true))
+	// 1000 * (2 - 1)
+	require.Equal(t, sdk.NewDec(1_000), Amount1Delta(sqrtA, sqrtB, liquidity, false))
+
+	// rounding favors the pool
+	sqrtC := sdk.NewDec(3)
+	require.True(t, Amount0Delta(sqrtA, sqrtC, liquidity, true).GT(
+		Amount0Delta(sqrtA, sqrtC, liquidity, false)))
+}
+
+func TestLiquidityForAmounts(t *testing.T) {
+	sqrtA, sqrtB := sdk.OneDec(), sdk.NewDec(2)
+
+	// below the range, only token0
+	require.Equal(t, sdk.NewDec(1_000),
+		LiquidityForAmounts(sdk.NewDecWithPrec(5, 1), sqrtA, sqrtB, sdk.NewDec(500), sdk.ZeroDec()))
+	// above the range, only token1
+	require.Equal(t, sdk.NewDec(1_000),
+		LiquidityForAmounts(sdk.NewDec(3), sqrtA, sqrtB, sdk.ZeroDec(), sdk.NewDec(1_000)))
+
+	// in range, limited by the scarcest token
+	sqrtPrice := sdk.NewDecWithPrec(15, 1)
+	liquidity := LiquidityForAmounts(sqrtPrice, sqrtA, sqrtB, sdk.NewDec(1_000), sdk.NewDec(100))
+	require.Equal(t, sdk.NewDec(200), liquidity)
+	require.True(t, Amount1Delta(sqrtA, sqrtPrice, liquidity, true).LTE(sdk.NewDec(100)))
+}
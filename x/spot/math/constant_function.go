@@ -4,19 +4,17 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// solveConstantFunctionInvariant solves the constant function of an AMM
+// SolveConstantProductInvariant solves the constant function of an AMM
 // that determines the relationship between the differences of two sides
 // of assets inside the pool.
-// For fixed xPrior, xAfter, xWeight, yPrior, yWeight,
+// For fixed xPrior, xAfter, yPrior,
 // we could deduce the deltaY, calculated by:
-// deltaY = balanceY * (1 - (xPrior/xAfter)^(xWeight/yWeight))
+// deltaY = balanceY * (1 - (xPrior/xAfter))
 // deltaY is positive when y's balance liquidity decreases.
 // deltaY is negative when y's balance liquidity increases.
-// panics if yWeight is 0.
 //
-// TODO(https://github.com/NibiruChain/nibiru/issues/141): Currently always calculates the invariant assuming constant weight (xy=k).
-// Once we figure out the floating point arithmetic conversions for exponentiation, we can
-// add unequal weights.
+// The weights are ignored, the invariant is always xy=k. Balancer pools swap
+// with it, see SolveWeightedConstantProductInvariant for weighted pools.
 func SolveConstantProductInvariant(
 	xPrior,
 	xAfter,
@@ -24,17 +22,35 @@ func SolveConstantProductInvariant(
 	yPrior,
 	/*unused*/ _yWeight sdk.Dec,
 ) (deltaY sdk.Dec) {
-	// // weightRatio = (xWeight/yWeight)
-	// weightRatio := xWeight.Quo(yWeight)
+	// r = xPrior/xAfter
+	r := xPrior.Quo(xAfter)
 
+	// amountY = yPrior * (1 - r)
+	return yPrior.Mul(sdk.OneDec().Sub(r))
+}
+
+// SolveWeightedConstantProductInvariant solves the weighted constant function
+// of an AMM that determines the relationship between the differences of two
+// sides of assets inside the pool.
+// For fixed xPrior, xAfter, xWeight, yPrior, yWeight,
+// we could deduce the deltaY, calculated by:
+// deltaY = balanceY * (1 - (xPrior/xAfter)^(xWeight/yWeight))
+// deltaY is positive when y's balance liquidity decreases.
+// deltaY is negative when y's balance liquidity increases.
+// panics if yWeight is 0.
+func SolveWeightedConstantProductInvariant(
+	xPrior,
+	xAfter,
+	xWeight,
+	yPrior,
+	yWeight sdk.Dec,
+) (deltaY sdk.Dec) {
 	// r = xPrior/xAfter
 	r := xPrior.Quo(xAfter)
 
-	// TODO(https://github.com/NibiruChain/nibiru/issues/141): Figure out floating point arithmetic for exponentation.
-	// Naive calculation could lead to significant rounding errors with large numbers
 	// amountY = yPrior * (1 - (r ^ weightRatio))
-	// rToWeightRatio := sdk.MustNewDecFromStr(
-	// 	fmt.Sprintf("%f", math.Pow(r.MustFloat64(), weightRatio.MustFloat64())),
-	// )
+	if !xWeight.Equal(yWeight) {
+		r = Pow(r, xWeight.Quo(yWeight))
+	}
 	return yPrior.Mul(sdk.OneDec().Sub(r))
 }
@@ -0,0 +1,70 @@
+package math
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// powPrecision is the precision at which the binomial series of a fractional
+// power stops.
+var powPrecision = sdk.NewDecWithPrec(1, 14)
+
+// maxPowSeriesTerms bounds the number of terms of the binomial series.
+const maxPowSeriesTerms = 300
+
+var (
+	powBaseLow  = sdk.NewDecWithPrec(5, 1)
+	powBaseHigh = sdk.NewDecWithPrec(15, 1)
+)
+
+// Pow computes base^exp for a positive base and a non-negative exponent.
+// The base is first brought close to one by taking square roots of it and
+// doubling the exponent, so that the binomial series of the fractional part of
+// the exponent converges quickly. The integer part of the exponent is computed
+// exactly by repeated squaring.
+// panics if the base is not positive or the exponent is negative.
+func Pow(base, exp sdk.Dec) sdk.Dec {
+	if !base.IsPositive() {
+		panic("base must be greater than zero")
+	}
+	if exp.IsNegative() {
+		panic("exponent must not be negative")
+	}
+
+	for base.LT(powBaseLow) || base.GT(powBaseHigh) {
+		root, err := base.ApproxSqrt()
+		if err != nil {
+			panic(err)
+		}
+		base = root
+		exp = exp.MulInt64(2)
+	}
+
+	integer := exp.TruncateInt()
+	fractional := exp.Sub(sdk.NewDecFromInt(integer))
+
+	integerPow := base.Power(integer.Uint64())
+	if fractional.IsZero() {
+		return integerPow
+	}
+
+	return integerPow.Mul(powApprox(base, fractional))
+}
+
+// powApprox computes base^exp for an exponent in [0, 1) using the binomial
+// series (1+x)^a = sum_k (a choose k) x^k with x = base - 1.
+func powApprox(base, exp sdk.Dec) sdk.Dec {
+	one := sdk.OneDec()
+	x := base.Sub(one)
+
+	term := one
+	sum := one
+	for k := int64(1); k <= maxPowSeriesTerms; k++ {
+		// term_k = term_{k-1} * (a - (k-1)) * x / k
+		term = term.Mul(exp.Sub(sdk.NewDec(k - 1))).Mul(x).QuoInt64(k)
+		if term.Abs().LT(powPrecision) {
+			break
+		}
+		sum = sum.Add(term)
+	}
+	return sum
+}
@@ -0,0 +1,60 @@
+package math
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPow(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		base     sdk.Dec
+		exp      sdk.Dec
+		expected sdk.Dec
+	}{
+		{
+			name:     "integer exponent",
+			base:     sdk.NewDec(3),
+			exp:      sdk.NewDec(4),
+			expected: sdk.NewDec(81),
+		},
+		{
+			name:     "zero exponent",
+			base:     sdk.NewDecWithPrec(7, 1),
+			exp:      sdk.ZeroDec(),
+			expected: sdk.OneDec(),
+		},
+		{
+			name:     "square root",
+			base:     sdk.NewDec(2),
+			exp:      sdk.NewDecWithPrec(5, 1),
+			expected: sdk.MustNewDecFromStr("1.414213562373095049"),
+		},
+		{
+			// 0.01^0.25
+			name:     "small base",
+			base:     sdk.NewDecWithPrec(1, 2),
+			exp:      sdk.NewDecWithPrec(25, 2),
+			expected: sdk.MustNewDecFromStr("0.316227766016837933"),
+		},
+		{
+			// 150^1.5
+			name:     "large base",
+			base:     sdk.NewDec(150),
+			exp:      sdk.NewDecWithPrec(15, 1),
+			expected: sdk.MustNewDecFromStr("1837.117307087383573647"),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.InDelta(t, tc.expected.MustFloat64(), Pow(tc.base, tc.exp).MustFloat64(), 1e-9*tc.expected.MustFloat64())
+		})
+	}
+}
+
+func TestPowPanics(t *testing.T) {
+	require.Panics(t, func() { Pow(sdk.ZeroDec(), sdk.OneDec()) })
+	require.Panics(t, func() { Pow(sdk.OneDec(), sdk.NewDec(-1)) })
+}
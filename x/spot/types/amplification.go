@@ -0,0 +1,89 @@
+package types
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// AmplificationAt returns the amplification parameter at a block time. While a
+// ramp is set, it is interpolated linearly between the initial and future
+// values of the ramp.
+func (params PoolParams) AmplificationAt(timestampMs int64) sdkmath.Int {
+	ramp := params.ARamp
+	if ramp == nil {
+		return params.A
+	}
+	if timestampMs >= ramp.FutureTimeMs {
+		return ramp.FutureA
+	}
+	if timestampMs <= ramp.InitialTimeMs {
+		return ramp.InitialA
+	}
+
+	elapsed := sdkmath.NewInt(timestampMs - ramp.InitialTimeMs)
+	duration := sdkmath.NewInt(ramp.FutureTimeMs - ramp.InitialTimeMs)
+	return ramp.InitialA.Add(ramp.FutureA.Sub(ramp.InitialA).Mul(elapsed).Quo(duration))
+}
+
+// UpdateAmplification sets the amplification parameter of the pool to its value
+// at a block time, and removes the ramp of A once it is over.
+func (pool *Pool) UpdateAmplification(timestampMs int64) {
+	if pool.PoolParams.ARamp == nil {
+		return
+	}
+	pool.PoolParams.A = pool.PoolParams.AmplificationAt(timestampMs)
+	if timestampMs >= pool.PoolParams.ARamp.FutureTimeMs {
+		pool.PoolParams.ARamp = nil
+	}
+}
+
+/*
+RampAmplification Starts a ramp of the amplification parameter of a stableswap pool,
+from its current value to futureA, like the ramp_A of Curve pools. The ramp stops,
+with A frozen at its current value, if futureA is the current value.
+
+args:
+  - futureA: the amplification parameter at the end of the ramp
+  - rampDuration: the duration of the ramp, at least MinAmplificationRampDuration
+  - timestampMs: the block time of the start of the ramp
+
+ret:
+  - err: error if the ramp changes A by more than MaxAmplificationChange, or above MaxAmplification
+*/
+func (pool *Pool) RampAmplification(futureA sdkmath.Int, rampDuration time.Duration, timestampMs int64) error {
+	if pool.PoolParams.PoolType != PoolType_STABLESWAP {
+		return ErrInvalidPoolType.Wrapf("pool %d is not a stableswap pool", pool.Id)
+	}
+
+	currentA := pool.PoolParams.AmplificationAt(timestampMs)
+	if futureA.Equal(currentA) {
+		pool.PoolParams.A = currentA
+		pool.PoolParams.ARamp = nil
+		return nil
+	}
+
+	if !futureA.IsPositive() {
+		return ErrAmplificationTooLow
+	}
+	if futureA.GT(sdkmath.NewInt(MaxAmplification)) {
+		return ErrInvalidAmplificationRamp.Wrapf("amplification %s is above %d", futureA, MaxAmplification)
+	}
+	if futureA.MulRaw(MaxAmplificationChange).LT(currentA) || futureA.GT(currentA.MulRaw(MaxAmplificationChange)) {
+		return ErrInvalidAmplificationRamp.Wrapf(
+			"amplification can change by a factor of at most %d, from %s to %s", MaxAmplificationChange, currentA, futureA)
+	}
+	if rampDuration < MinAmplificationRampDuration {
+		return ErrInvalidAmplificationRamp.Wrapf(
+			"ramp duration %s is shorter than %s", rampDuration, MinAmplificationRampDuration)
+	}
+
+	pool.PoolParams.A = currentA
+	pool.PoolParams.ARamp = &AmplificationRamp{
+		InitialA:      currentA,
+		FutureA:       futureA,
+		InitialTimeMs: timestampMs,
+		FutureTimeMs:  timestampMs + rampDuration.Milliseconds(),
+	}
+	return nil
+}
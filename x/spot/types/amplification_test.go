@@ -0,0 +1,104 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRampAmplification(t *testing.T) {
+	const dayMs = int64(24 * time.Hour / time.Millisecond)
+	pool := Pool{
+		Id: 1,
+		PoolParams: PoolParams{
+			PoolType: PoolType_STABLESWAP,
+			A:        sdk.NewInt(100),
+		},
+	}
+
+	require.NoError(t, pool.RampAmplification(sdk.NewInt(1_000), 2*24*time.Hour, 0))
+	require.Equal(t, sdk.NewInt(100), pool.PoolParams.A)
+	require.Equal(t, &AmplificationRamp{
+		InitialA:      sdk.NewInt(100),
+		FutureA:       sdk.NewInt(1_000),
+		InitialTimeMs: 0,
+		FutureTimeMs:  2 * dayMs,
+	}, pool.PoolParams.ARamp)
+
+	for _, tc := range []struct {
+		timestampMs int64
+		a           int64
+	}{
+		{timestampMs: -1, a: 100},
+		{timestampMs: 0, a: 100},
+		{timestampMs: dayMs / 2, a: 325},
+		{timestampMs: dayMs, a: 550},
+		{timestampMs: 2*dayMs - 1, a: 999},
+		{timestampMs: 2 * dayMs, a: 1_000},
+		{timestampMs: 3 * dayMs, a: 1_000},
+	} {
+		require.Equal(t, sdk.NewInt(tc.a), pool.PoolParams.AmplificationAt(tc.timestampMs), tc.timestampMs)
+	}
+
+	// stopping the ramp freezes the current value
+	require.NoError(t, pool.RampAmplification(sdk.NewInt(550), 0, dayMs))
+	require.Equal(t, sdk.NewInt(550), pool.PoolParams.A)
+	require.Nil(t, pool.PoolParams.ARamp)
+
+	// ramping down
+	require.NoError(t, pool.RampAmplification(sdk.NewInt(110), 24*time.Hour, dayMs))
+	require.Equal(t, sdk.NewInt(330), pool.PoolParams.AmplificationAt(dayMs+dayMs/2))
+
+	pool.UpdateAmplification(dayMs + dayMs/2)
+	require.Equal(t, sdk.NewInt(330), pool.PoolParams.A)
+	require.NotNil(t, pool.PoolParams.ARamp)
+	pool.UpdateAmplification(2 * dayMs)
+	require.Equal(t, sdk.NewInt(110), pool.PoolParams.A)
+	require.Nil(t, pool.PoolParams.ARamp)
+}
+
+func TestRampAmplificationErrors(t *testing.T) {
+	stablePool := Pool{
+		PoolParams: PoolParams{
+			PoolType: PoolType_STABLESWAP,
+			A:        sdk.NewInt(100),
+		},
+	}
+	balancerPool := Pool{
+		PoolParams: PoolParams{
+			PoolType: PoolType_BALANCER,
+			A:        sdk.ZeroInt(),
+		},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		pool     Pool
+		futureA  int64
+		duration time.Duration
+		err      error
+	}{
+		{name: "not a stableswap pool", pool: balancerPool, futureA: 100, duration: 24 * time.Hour, err: ErrInvalidPoolType},
+		{name: "negative A", pool: stablePool, futureA: -1, duration: 24 * time.Hour, err: ErrAmplificationTooLow},
+		{name: "increase too large", pool: stablePool, futureA: 1_001, duration: 24 * time.Hour, err: ErrInvalidAmplificationRamp},
+		{name: "decrease too large", pool: stablePool, futureA: 9, duration: 24 * time.Hour, err: ErrInvalidAmplificationRamp},
+		{name: "ramp too short", pool: stablePool, futureA: 200, duration: time.Hour, err: ErrInvalidAmplificationRamp},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.pool.RampAmplification(sdk.NewInt(tc.futureA), tc.duration, 0)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	highPool := Pool{
+		PoolParams: PoolParams{
+			PoolType: PoolType_STABLESWAP,
+			A:        sdk.NewInt(500_000),
+		},
+	}
+	require.ErrorIs(t,
+		highPool.RampAmplification(sdk.NewInt(MaxAmplification+1), 24*time.Hour, 0),
+		ErrInvalidAmplificationRamp)
+}
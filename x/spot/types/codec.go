@@ -12,6 +12,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgJoinPool{}, "spot/JoinPool", nil)
 	cdc.RegisterConcrete(&MsgExitPool{}, "spot/ExitPool", nil)
 	cdc.RegisterConcrete(&MsgSwapAssets{}, "spot/SwapAssets", nil)
+	cdc.RegisterConcrete(&MsgSwapExactAmountInRoute{}, "spot/SwapExactAmountInRoute", nil)
+	cdc.RegisterConcrete(&MsgSwapExactAmountOutRoute{}, "spot/SwapExactAmountOutRoute", nil)
+	cdc.RegisterConcrete(&MsgJoinSwapExternAmountIn{}, "spot/JoinSwapExternAmountIn", nil)
+	cdc.RegisterConcrete(&MsgExitSwapShareAmountIn{}, "spot/ExitSwapShareAmountIn", nil)
+	cdc.RegisterConcrete(&MsgCreatePosition{}, "spot/CreatePosition", nil)
+	cdc.RegisterConcrete(&MsgWithdrawPosition{}, "spot/WithdrawPosition", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "spot/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgUpdatePoolParams{}, "spot/UpdatePoolParams", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -22,6 +30,14 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgJoinPool{},
 		&MsgExitPool{},
 		&MsgSwapAssets{},
+		&MsgSwapExactAmountInRoute{},
+		&MsgSwapExactAmountOutRoute{},
+		&MsgJoinSwapExternAmountIn{},
+		&MsgExitSwapShareAmountIn{},
+		&MsgCreatePosition{},
+		&MsgWithdrawPosition{},
+		&MsgUpdateParams{},
+		&MsgUpdatePoolParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
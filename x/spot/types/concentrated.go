@@ -0,0 +1,687 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/spot/math"
+)
+
+// liquidityRoundingMargin is the fraction of liquidity taken off when the
+// tokens deposited for it, rounded up, exceed the tokens provided. It grows a
+// thousandfold on every correction.
+var liquidityRoundingMargin = sdk.NewDecWithPrec(1, 12)
+
+// maxLiquidityRoundingSteps bounds the corrections of the liquidity of a
+// position whose deposit, rounded up, exceeds the tokens provided.
+const maxLiquidityRoundingSteps = 3
+
+// concentratedPool is the math of a concentrated liquidity pool, whose
+// liquidity is provided by positions over tick ranges. Token0 and token1 are
+// the first and second pool assets, and the price is the amount of token1 per
+// token0.
+type concentratedPool struct {
+	pool *Pool
+}
+
+func (p concentratedPool) CalcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, noFee bool) (
+	tokenOut sdk.Coin, fee sdk.Coin, err error,
+) {
+	zeroForOne, err := p.pool.concentratedSwapDirection(tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return tokenOut, fee, err
+	}
+
+	swapFee := p.pool.PoolParams.SwapFee
+	if noFee {
+		swapFee = sdk.ZeroDec()
+	}
+
+	cl := p.pool.ConcentratedLiquidity.deepCopy()
+	_, amountOut, feeAmount, err := cl.swap(zeroForOne, true, sdk.NewDecFromInt(tokenIn.Amount), swapFee, sdk.ZeroDec(), tokenIn.Denom)
+	if err != nil {
+		return tokenOut, fee, err
+	}
+
+	fee = sdk.NewCoin(tokenIn.Denom, feeAmount.TruncateInt())
+	if !amountOut.TruncateInt().IsPositive() {
+		return tokenOut, fee, fmt.Errorf("tokenIn (%s) must be higher to perform a swap", tokenIn.Denom)
+	}
+
+	return sdk.NewCoin(tokenOutDenom, amountOut.TruncateInt()), fee, nil
+}
+
+func (p concentratedPool) CalcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string) (
+	tokenIn sdk.Coin, err error,
+) {
+	zeroForOne, err := p.pool.concentratedSwapDirection(tokenInDenom, tokenOut.Denom)
+	if err != nil {
+		return tokenIn, err
+	}
+	if !tokenOut.Amount.IsPositive() {
+		return tokenIn, fmt.Errorf("tokenOut (%s) must be positive", tokenOut.Denom)
+	}
+
+	cl := p.pool.ConcentratedLiquidity.deepCopy()
+	amountIn, _, _, err := cl.swap(zeroForOne, false, sdk.NewDecFromInt(tokenOut.Amount), p.pool.PoolParams.SwapFee, sdk.ZeroDec(), tokenInDenom)
+	if err != nil {
+		return tokenIn, err
+	}
+	tokenIn = sdk.NewCoin(tokenInDenom, amountIn.Ceil().TruncateInt())
+
+	// the swap is applied with the exact amount in, which must not fall short of
+	// tokenOut because of the rounding of the square root prices
+	out, _, err := p.CalcOutAmtGivenIn(tokenIn, tokenOut.Denom, false)
+	if err != nil || out.Amount.LT(tokenOut.Amount) {
+		tokenIn.Amount = tokenIn.Amount.AddRaw(1)
+		out, _, err = p.CalcOutAmtGivenIn(tokenIn, tokenOut.Denom, false)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		if out.Amount.LT(tokenOut.Amount) {
+			return sdk.Coin{}, ErrNotEnoughLiquidity.Wrapf(
+				"%s only swaps for %s, less than %s", tokenIn, out, tokenOut)
+		}
+	}
+	return tokenIn, nil
+}
+
+/*
+ApplySwap moves the price of the pool by swapping tokenIn, and pays out tokenOut,
+which must not be higher than the tokens out of the swap.
+*/
+func (p concentratedPool) ApplySwap(tokenIn sdk.Coin, tokenOut sdk.Coin) error {
+	return p.applySwap(tokenIn, tokenOut, sdk.ZeroDec())
+}
+
+/*
+applySwap is ApplySwap leaving the protocol share of the swap fee out of the fee
+growth, so that it is not owed to the positions.
+*/
+func (p concentratedPool) applySwap(tokenIn sdk.Coin, tokenOut sdk.Coin, protocolFeeShare sdk.Dec) error {
+	zeroForOne, err := p.pool.concentratedSwapDirection(tokenIn.Denom, tokenOut.Denom)
+	if err != nil {
+		return err
+	}
+
+	cl := p.pool.ConcentratedLiquidity.deepCopy()
+	_, amountOut, _, err := cl.swap(zeroForOne, true, sdk.NewDecFromInt(tokenIn.Amount), p.pool.PoolParams.SwapFee, protocolFeeShare, tokenIn.Denom)
+	if err != nil {
+		return err
+	}
+	if amountOut.TruncateInt().LT(tokenOut.Amount) {
+		return fmt.Errorf("tokenIn (%s) is too low to swap for %s", tokenIn, tokenOut)
+	}
+
+	if err = p.pool.applySwapBalances(tokenIn, tokenOut); err != nil {
+		return err
+	}
+	p.pool.ConcentratedLiquidity = cl
+	return nil
+}
+
+func (p concentratedPool) AddTokensToPool(sdk.Coins) (
+	numShares sdkmath.Int, remCoins sdk.Coins, err error,
+) {
+	return sdk.ZeroInt(), sdk.Coins{}, ErrInvalidPoolType.Wrap("concentrated liquidity pools are joined with positions")
+}
+
+func (p concentratedPool) AddAllTokensToPool(tokensIn sdk.Coins) (
+	numShares sdkmath.Int, remCoins sdk.Coins, err error,
+) {
+	return p.AddTokensToPool(tokensIn)
+}
+
+func (p concentratedPool) TokensOutFromPoolSharesIn(sdkmath.Int) (
+	tokensOut sdk.Coins, fees sdk.Coins, err error,
+) {
+	return nil, nil, ErrInvalidPoolType.Wrap("concentrated liquidity pools are exited with positions")
+}
+
+func (p concentratedPool) CalcSpotPrice(tokenIn, tokenOut string) (sdk.Dec, error) {
+	zeroForOne, err := p.pool.concentratedSwapDirection(tokenIn, tokenOut)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// price of token0 in token1
+	price := p.pool.ConcentratedLiquidity.SqrtPrice.Power(2)
+	if zeroForOne {
+		return sdk.OneDec().Quo(price), nil
+	}
+	return price, nil
+}
+
+/*
+Returns whether swapping tokenInDenom for tokenOutDenom in a concentrated liquidity
+pool swaps token0 for token1, moving the price down.
+*/
+func (pool Pool) concentratedSwapDirection(tokenInDenom, tokenOutDenom string) (zeroForOne bool, err error) {
+	if pool.ConcentratedLiquidity == nil {
+		return false, ErrInvalidPoolType.Wrapf("pool %d has no concentrated liquidity", pool.Id)
+	}
+
+	indexIn, _, err := pool.getPoolAssetAndIndex(tokenInDenom)
+	if err != nil {
+		return false, err
+	}
+	indexOut, _, err := pool.getPoolAssetAndIndex(tokenOutDenom)
+	if err != nil {
+		return false, err
+	}
+	if indexIn == indexOut {
+		return false, ErrSameTokenDenom
+	}
+
+	return indexIn == 0, nil
+}
+
+/*
+initConcentratedLiquidity Sets the initial price of a concentrated liquidity pool
+from the ratio of its initial assets. The assets are then provided by a position,
+so the pool starts without balances and pool shares.
+*/
+func (pool *Pool) initConcentratedLiquidity() error {
+	if len(pool.PoolAssets) != 2 {
+		return ErrTooManyPoolAssets.Wrap("concentrated liquidity pools have exactly two assets")
+	}
+	if pool.PoolParams.TickSpacing == 0 {
+		return ErrInvalidTickRange.Wrap("tick spacing must be positive")
+	}
+
+	price := sdk.NewDecFromInt(pool.PoolAssets[1].Token.Amount).QuoInt(pool.PoolAssets[0].Token.Amount)
+	sqrtPrice, err := price.ApproxSqrt()
+	if err != nil {
+		return err
+	}
+	minSqrtPrice, maxSqrtPrice := math.SqrtPriceAtTick(math.MinTick), math.SqrtPriceAtTick(math.MaxTick)
+	if sqrtPrice.LT(minSqrtPrice) || sqrtPrice.GT(maxSqrtPrice) {
+		return ErrInvalidTickRange.Wrapf("initial price %s is out of range", price)
+	}
+
+	pool.ConcentratedLiquidity = &ConcentratedLiquidity{
+		SqrtPrice:       sqrtPrice,
+		CurrentTick:     math.TickAtSqrtPrice(sqrtPrice),
+		Liquidity:       sdk.ZeroDec(),
+		FeeGrowthGlobal: sdk.DecCoins{},
+	}
+	for i := range pool.PoolAssets {
+		pool.PoolAssets[i].Token.Amount = sdk.ZeroInt()
+	}
+	pool.TotalShares.Amount = sdk.ZeroInt()
+	return nil
+}
+
+/*
+FullRangeTicks Returns the lowest and highest ticks of the pool's tick spacing,
+the range of a position providing liquidity at every price.
+*/
+func (pool Pool) FullRangeTicks() (lowerTick, upperTick int64) {
+	spacing := int64(pool.PoolParams.TickSpacing)
+	return -(-math.MinTick / spacing * spacing), math.MaxTick / spacing * spacing
+}
+
+/*
+ValidatePositionTicks Ensures a position of the pool can be provided between
+lowerTick and upperTick.
+*/
+func (pool Pool) ValidatePositionTicks(lowerTick, upperTick int64) error {
+	if pool.PoolParams.PoolType != PoolType_CONCENTRATED || pool.ConcentratedLiquidity == nil {
+		return ErrInvalidPoolType.Wrapf("pool %d is not a concentrated liquidity pool", pool.Id)
+	}
+	if lowerTick >= upperTick {
+		return ErrInvalidTickRange.Wrapf("lower tick %d must be lower than upper tick %d", lowerTick, upperTick)
+	}
+	if lowerTick < math.MinTick || upperTick > math.MaxTick {
+		return ErrInvalidTickRange.Wrapf("ticks must be in [%d, %d]", math.MinTick, math.MaxTick)
+	}
+	spacing := int64(pool.PoolParams.TickSpacing)
+	if lowerTick%spacing != 0 || upperTick%spacing != 0 {
+		return ErrInvalidTickRange.Wrapf("ticks must be multiples of the tick spacing %d", spacing)
+	}
+	return nil
+}
+
+/*
+LiquidityForTokens Calculates the largest liquidity which can be provided between
+lowerTick and upperTick with the tokens at the current price of the pool.
+
+args:
+  - lowerTick: the lower tick of the position
+  - upperTick: the upper tick of the position
+  - tokens: the tokens available for the position
+
+ret:
+  - liquidity: the liquidity of the position
+  - err: error if any
+*/
+func (pool Pool) LiquidityForTokens(lowerTick, upperTick int64, tokens sdk.Coins) (
+	liquidity sdk.Dec, err error,
+) {
+	if err = pool.ValidatePositionTicks(lowerTick, upperTick); err != nil {
+		return sdk.Dec{}, err
+	}
+	if !pool.AreTokensInDenomInPoolAssets(tokens) {
+		return sdk.Dec{}, ErrTokenDenomNotFound.Wrapf("tokens %s are not all in pool %d", tokens, pool.Id)
+	}
+
+	cl := pool.ConcentratedLiquidity
+	amount0 := tokens.AmountOf(pool.PoolAssets[0].Token.Denom)
+	amount1 := tokens.AmountOf(pool.PoolAssets[1].Token.Denom)
+	sqrtLower, sqrtUpper := math.SqrtPriceAtTick(lowerTick), math.SqrtPriceAtTick(upperTick)
+
+	liquidity = math.LiquidityForAmounts(
+		cl.SqrtPrice, sqrtLower, sqrtUpper, sdk.NewDecFromInt(amount0), sdk.NewDecFromInt(amount1))
+
+	// the tokens deposited for the liquidity are rounded up, scale the liquidity
+	// down by the overshoot and a margin so that they do not exceed the tokens
+	// available
+	margin := liquidityRoundingMargin
+	for step := 0; liquidity.IsPositive(); step++ {
+		deposit0, deposit1 := cl.amountsForLiquidity(sqrtLower, sqrtUpper, liquidity, true)
+		if deposit0.Ceil().TruncateInt().LTE(amount0) && deposit1.Ceil().TruncateInt().LTE(amount1) {
+			return liquidity, nil
+		}
+		if step == maxLiquidityRoundingSteps {
+			return sdk.Dec{}, ErrInvalidLiquidity.Wrapf(
+				"tokens %s are too few to provide liquidity between ticks %d and %d", tokens, lowerTick, upperTick)
+		}
+
+		ratio := sdk.OneDec()
+		if deposit0.GT(sdk.NewDecFromInt(amount0)) {
+			ratio = sdk.MinDec(ratio, sdk.NewDecFromInt(amount0).QuoTruncate(deposit0))
+		}
+		if deposit1.GT(sdk.NewDecFromInt(amount1)) {
+			ratio = sdk.MinDec(ratio, sdk.NewDecFromInt(amount1).QuoTruncate(deposit1))
+		}
+		liquidity = liquidity.MulTruncate(ratio).MulTruncate(sdk.OneDec().Sub(margin))
+		margin = margin.MulInt64(1000)
+	}
+
+	return liquidity, nil
+}
+
+/*
+UpdatePosition Adds liquidityDelta to a position of the pool, or removes it if
+negative, and collects the fees earned by the position. Updates the pool
+balances with the tokens deposited or withdrawn and the fees collected.
+
+args:
+  - position: the position to update
+  - liquidityDelta: the liquidity to add to the position
+
+ret:
+  - tokens: the tokens deposited into the pool if liquidityDelta is positive, withdrawn from it otherwise
+  - fees: the fees earned by the position since they were last collected
+  - err: error if any
+*/
+func (pool *Pool) UpdatePosition(position *Position, liquidityDelta sdk.Dec) (
+	tokens sdk.Coins, fees sdk.Coins, err error,
+) {
+	if err = pool.ValidatePositionTicks(position.LowerTick, position.UpperTick); err != nil {
+		return nil, nil, err
+	}
+	if position.Liquidity.Add(liquidityDelta).IsNegative() {
+		return nil, nil, ErrInvalidLiquidity.Wrapf(
+			"cannot remove %s liquidity from position %d with %s liquidity",
+			liquidityDelta.Neg(), position.Id, position.Liquidity)
+	}
+
+	cl := pool.ConcentratedLiquidity.deepCopy()
+	denoms := pool.concentratedDenoms()
+
+	cl.updateTick(denoms, position.LowerTick, liquidityDelta, false)
+	cl.updateTick(denoms, position.UpperTick, liquidityDelta, true)
+
+	// collect the fees earned by the liquidity of the position
+	feeGrowthInside := cl.feeGrowthInside(denoms, position.LowerTick, position.UpperTick)
+	fees = sdk.NewCoins()
+	for _, denom := range denoms {
+		growth := feeGrowthInside.AmountOf(denom).Sub(position.FeeGrowthInsideLast.AmountOf(denom))
+		fees = fees.Add(sdk.NewCoin(denom, growth.MulTruncate(position.Liquidity).TruncateInt()))
+	}
+	position.FeeGrowthInsideLast = feeGrowthInside
+
+	if position.LowerTick <= cl.CurrentTick && cl.CurrentTick < position.UpperTick {
+		cl.Liquidity = cl.Liquidity.Add(liquidityDelta)
+	}
+
+	// the pool rounds in its favor
+	deposit := liquidityDelta.IsPositive()
+	amount0, amount1 := cl.amountsForLiquidity(
+		math.SqrtPriceAtTick(position.LowerTick), math.SqrtPriceAtTick(position.UpperTick),
+		liquidityDelta.Abs(), deposit)
+	if deposit {
+		tokens = sdk.NewCoins(
+			sdk.NewCoin(denoms[0], amount0.Ceil().TruncateInt()),
+			sdk.NewCoin(denoms[1], amount1.Ceil().TruncateInt()),
+		)
+	} else {
+		tokens = sdk.NewCoins(
+			sdk.NewCoin(denoms[0], amount0.TruncateInt()),
+			sdk.NewCoin(denoms[1], amount1.TruncateInt()),
+		)
+	}
+
+	cl.removeEmptyTicks()
+	position.Liquidity = position.Liquidity.Add(liquidityDelta)
+
+	if deposit {
+		if err = pool.incrementBalances(sdk.ZeroInt(), tokens); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		for _, coin := range tokens {
+			if err = pool.SubtractPoolAssetBalance(coin.Denom, coin.Amount); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	for _, coin := range fees {
+		if err = pool.SubtractPoolAssetBalance(coin.Denom, coin.Amount); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	pool.ConcentratedLiquidity = cl
+	return tokens, fees, nil
+}
+
+// concentratedDenoms returns the denoms of token0 and token1.
+func (pool Pool) concentratedDenoms() []string {
+	return []string{pool.PoolAssets[0].Token.Denom, pool.PoolAssets[1].Token.Denom}
+}
+
+// deepCopy returns a copy of the state which can be modified without modifying the original state.
+func (cl ConcentratedLiquidity) deepCopy() *ConcentratedLiquidity {
+	ticks := make([]Tick, len(cl.Ticks))
+	copy(ticks, cl.Ticks)
+	cl.Ticks = ticks
+	return &cl
+}
+
+/*
+amountsForLiquidity Calculates the amounts of token0 and token1 held by liquidity
+between two square root prices at the current price.
+*/
+func (cl ConcentratedLiquidity) amountsForLiquidity(sqrtLower, sqrtUpper, liquidity sdk.Dec, roundUp bool) (
+	amount0, amount1 sdk.Dec,
+) {
+	switch {
+	case cl.SqrtPrice.LTE(sqrtLower):
+		return math.Amount0Delta(sqrtLower, sqrtUpper, liquidity, roundUp), sdk.ZeroDec()
+	case cl.SqrtPrice.GTE(sqrtUpper):
+		return sdk.ZeroDec(), math.Amount1Delta(sqrtLower, sqrtUpper, liquidity, roundUp)
+	default:
+		return math.Amount0Delta(cl.SqrtPrice, sqrtUpper, liquidity, roundUp),
+			math.Amount1Delta(sqrtLower, cl.SqrtPrice, liquidity, roundUp)
+	}
+}
+
+// tickIndex returns the position of the tick in the initialized ticks, and whether it is initialized.
+func (cl ConcentratedLiquidity) tickIndex(tick int64) (int, bool) {
+	i := sort.Search(len(cl.Ticks), func(i int) bool { return cl.Ticks[i].Index >= tick })
+	return i, i < len(cl.Ticks) && cl.Ticks[i].Index == tick
+}
+
+/*
+updateTick Adds the liquidity of a position starting (upper false) or ending
+(upper true) on a tick, initializing the tick if needed. The fee growth outside
+of a new tick is assumed to have happened below it.
+*/
+func (cl *ConcentratedLiquidity) updateTick(denoms []string, tick int64, liquidityDelta sdk.Dec, upper bool) {
+	i, found := cl.tickIndex(tick)
+	if !found {
+		feeGrowthOutside := make(sdk.DecCoins, len(denoms))
+		for j, denom := range denoms {
+			feeGrowthOutside[j] = sdk.DecCoin{Denom: denom, Amount: sdk.ZeroDec()}
+			if tick <= cl.CurrentTick {
+				feeGrowthOutside[j].Amount = cl.FeeGrowthGlobal.AmountOf(denom)
+			}
+		}
+		cl.Ticks = append(cl.Ticks, Tick{})
+		copy(cl.Ticks[i+1:], cl.Ticks[i:])
+		cl.Ticks[i] = Tick{
+			Index:            tick,
+			LiquidityGross:   sdk.ZeroDec(),
+			LiquidityNet:     sdk.ZeroDec(),
+			FeeGrowthOutside: feeGrowthOutside,
+		}
+	}
+
+	t := cl.Ticks[i]
+	t.LiquidityGross = t.LiquidityGross.Add(liquidityDelta)
+	if upper {
+		t.LiquidityNet = t.LiquidityNet.Sub(liquidityDelta)
+	} else {
+		t.LiquidityNet = t.LiquidityNet.Add(liquidityDelta)
+	}
+	cl.Ticks[i] = t
+}
+
+// removeEmptyTicks removes the ticks on which no position starts or ends anymore.
+func (cl *ConcentratedLiquidity) removeEmptyTicks() {
+	ticks := cl.Ticks[:0]
+	for _, t := range cl.Ticks {
+		if !t.LiquidityGross.IsZero() {
+			ticks = append(ticks, t)
+		}
+	}
+	cl.Ticks = ticks
+}
+
+/*
+feeGrowthInside Calculates the fee growth per unit of liquidity between two
+initialized ticks. The amounts may be negative, only their differences over
+time are meaningful.
+*/
+func (cl ConcentratedLiquidity) feeGrowthInside(denoms []string, lowerTick, upperTick int64) sdk.DecCoins {
+	lowerIndex, _ := cl.tickIndex(lowerTick)
+	upperIndex, _ := cl.tickIndex(upperTick)
+	lower, upper := cl.Ticks[lowerIndex], cl.Ticks[upperIndex]
+
+	inside := make(sdk.DecCoins, len(denoms))
+	for i, denom := range denoms {
+		global := cl.FeeGrowthGlobal.AmountOf(denom)
+
+		below := lower.FeeGrowthOutside.AmountOf(denom)
+		if cl.CurrentTick < lowerTick {
+			below = global.Sub(below)
+		}
+		above := upper.FeeGrowthOutside.AmountOf(denom)
+		if cl.CurrentTick >= upperTick {
+			above = global.Sub(above)
+		}
+
+		inside[i] = sdk.DecCoin{Denom: denom, Amount: global.Sub(below).Sub(above)}
+	}
+	return inside
+}
+
+/*
+nextTick Returns the next initialized tick in the direction of the swap, or the
+bound of the price range if there is none.
+*/
+func (cl ConcentratedLiquidity) nextTick(zeroForOne bool) (tick int64, initialized bool) {
+	i, found := cl.tickIndex(cl.CurrentTick)
+	if zeroForOne {
+		// highest initialized tick lower than or equal to the current tick
+		if found {
+			return cl.CurrentTick, true
+		}
+		if i == 0 {
+			return math.MinTick, false
+		}
+		return cl.Ticks[i-1].Index, true
+	}
+
+	// lowest initialized tick higher than the current tick
+	if found {
+		i++
+	}
+	if i == len(cl.Ticks) {
+		return math.MaxTick, false
+	}
+	return cl.Ticks[i].Index, true
+}
+
+/*
+crossTick Moves the price across an initialized tick, adding or removing the
+liquidity of the positions starting or ending on it, and flipping its fee growth
+outside.
+*/
+func (cl *ConcentratedLiquidity) crossTick(tick int64, zeroForOne bool) {
+	i, _ := cl.tickIndex(tick)
+	t := cl.Ticks[i]
+
+	feeGrowthOutside := make(sdk.DecCoins, len(t.FeeGrowthOutside))
+	for j, coin := range t.FeeGrowthOutside {
+		feeGrowthOutside[j] = sdk.DecCoin{
+			Denom:  coin.Denom,
+			Amount: cl.FeeGrowthGlobal.AmountOf(coin.Denom).Sub(coin.Amount),
+		}
+	}
+	t.FeeGrowthOutside = feeGrowthOutside
+	cl.Ticks[i] = t
+
+	if zeroForOne {
+		cl.Liquidity = cl.Liquidity.Sub(t.LiquidityNet)
+		cl.CurrentTick = tick - 1
+	} else {
+		cl.Liquidity = cl.Liquidity.Add(t.LiquidityNet)
+		cl.CurrentTick = tick
+	}
+}
+
+/*
+swap Moves the price along the curve of the pool, crossing the initialized ticks,
+until amount of token in is swapped (exactIn) or amount of token out is obtained
+(not exactIn). The swap fee of every step, less the protocol share, is shared by
+the liquidity in range.
+
+args:
+  - zeroForOne: whether token0 is swapped for token1
+  - exactIn: whether amount is the amount of token in or token out
+  - amount: the amount of tokens to swap
+  - swapFee: the fee deducted from the token in
+  - protocolFeeShare: the share of the fee taken by the protocol
+  - denomIn: the denom of the token in
+
+ret:
+  - amountIn: the amount of token in, fee included
+  - amountOut: the amount of token out
+  - fee: the fee deducted from the token in
+  - err: ErrNotEnoughLiquidity if the price range is exhausted
+*/
+func (cl *ConcentratedLiquidity) swap(
+	zeroForOne, exactIn bool, amount sdk.Dec, swapFee sdk.Dec, protocolFeeShare sdk.Dec, denomIn string,
+) (
+	amountIn, amountOut, fee sdk.Dec, err error,
+) {
+	feeComplement := sdk.OneDec().Sub(swapFee)
+	lpFeeShare := sdk.OneDec().Sub(protocolFeeShare)
+	remaining := amount
+	amountIn, amountOut, fee = sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()
+
+	for remaining.IsPositive() {
+		tick, initialized := cl.nextTick(zeroForOne)
+		sqrtTarget := math.SqrtPriceAtTick(tick)
+
+		// amounts to move the price to the next tick
+		var maxIn, maxOut sdk.Dec
+		if zeroForOne {
+			maxIn = math.Amount0Delta(sqrtTarget, cl.SqrtPrice, cl.Liquidity, true)
+			maxOut = math.Amount1Delta(sqrtTarget, cl.SqrtPrice, cl.Liquidity, false)
+		} else {
+			maxIn = math.Amount1Delta(cl.SqrtPrice, sqrtTarget, cl.Liquidity, true)
+			maxOut = math.Amount0Delta(cl.SqrtPrice, sqrtTarget, cl.Liquidity, false)
+		}
+
+		var stepIn, stepOut, stepFee sdk.Dec
+		reached := true
+		if exactIn {
+			netRemaining := remaining.Mul(feeComplement)
+			if netRemaining.LT(maxIn) {
+				reached = false
+				stepIn = netRemaining
+				stepFee = remaining.Sub(netRemaining)
+				stepOut = cl.moveSqrtPriceAmountIn(zeroForOne, stepIn)
+			} else {
+				stepIn, stepOut = maxIn, maxOut
+				stepFee = sdk.MinDec(maxIn.Quo(feeComplement), remaining).Sub(maxIn)
+			}
+			remaining = remaining.Sub(stepIn).Sub(stepFee)
+		} else {
+			if remaining.LT(maxOut) {
+				reached = false
+				stepOut = remaining
+				stepIn = cl.moveSqrtPriceAmountOut(zeroForOne, stepOut)
+			} else {
+				stepIn, stepOut = maxIn, maxOut
+			}
+			stepFee = stepIn.Quo(feeComplement).Sub(stepIn)
+			remaining = remaining.Sub(stepOut)
+		}
+
+		amountIn = amountIn.Add(stepIn).Add(stepFee)
+		amountOut = amountOut.Add(stepOut)
+		fee = fee.Add(stepFee)
+		if lpFee := stepFee.MulTruncate(lpFeeShare); cl.Liquidity.IsPositive() && lpFee.IsPositive() {
+			cl.FeeGrowthGlobal = cl.FeeGrowthGlobal.Add(sdk.NewDecCoinFromDec(denomIn, lpFee.QuoTruncate(cl.Liquidity)))
+		}
+
+		if !reached {
+			cl.CurrentTick = math.TickAtSqrtPrice(cl.SqrtPrice)
+			break
+		}
+
+		cl.SqrtPrice = sqrtTarget
+		if !initialized {
+			cl.CurrentTick = tick
+			if remaining.IsPositive() {
+				return amountIn, amountOut, fee, ErrNotEnoughLiquidity.Wrap("the swap exhausts the price range of the pool")
+			}
+			break
+		}
+		cl.crossTick(tick, zeroForOne)
+	}
+
+	return amountIn, amountOut, fee, nil
+}
+
+/*
+moveSqrtPriceAmountIn Moves the price within a tick range by swapping amountIn,
+fee excluded, and returns the amount out.
+*/
+func (cl *ConcentratedLiquidity) moveSqrtPriceAmountIn(zeroForOne bool, amountIn sdk.Dec) (amountOut sdk.Dec) {
+	sqrtPrice := cl.SqrtPrice
+	if zeroForOne {
+		// liquidity * sqrtPrice / (liquidity + amountIn * sqrtPrice)
+		cl.SqrtPrice = cl.Liquidity.MulRoundUp(sqrtPrice).QuoRoundUp(
+			cl.Liquidity.Add(amountIn.Mul(sqrtPrice)))
+		return math.Amount1Delta(cl.SqrtPrice, sqrtPrice, cl.Liquidity, false)
+	}
+	// sqrtPrice + amountIn / liquidity
+	cl.SqrtPrice = sqrtPrice.Add(amountIn.QuoTruncate(cl.Liquidity))
+	return math.Amount0Delta(sqrtPrice, cl.SqrtPrice, cl.Liquidity, false)
+}
+
+/*
+moveSqrtPriceAmountOut Moves the price within a tick range by swapping for
+amountOut, and returns the amount in, fee excluded.
+*/
+func (cl *ConcentratedLiquidity) moveSqrtPriceAmountOut(zeroForOne bool, amountOut sdk.Dec) (amountIn sdk.Dec) {
+	sqrtPrice := cl.SqrtPrice
+	if zeroForOne {
+		// sqrtPrice - amountOut / liquidity
+		cl.SqrtPrice = sqrtPrice.Sub(amountOut.QuoRoundUp(cl.Liquidity))
+		return math.Amount0Delta(cl.SqrtPrice, sqrtPrice, cl.Liquidity, true)
+	}
+	// liquidity * sqrtPrice / (liquidity - amountOut * sqrtPrice)
+	cl.SqrtPrice = cl.Liquidity.MulRoundUp(sqrtPrice).QuoRoundUp(
+		cl.Liquidity.Sub(amountOut.MulRoundUp(sqrtPrice)))
+	return math.Amount1Delta(sqrtPrice, cl.SqrtPrice, cl.Liquidity, true)
+}
@@ -0,0 +1,220 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+)
+
+// concentratedTestPool returns a concentrated liquidity pool at price 1 with a
+// full range position providing 1_000_000aaa and 1_000_000bbb.
+func concentratedTestPool(t *testing.T) (Pool, Position) {
+	pool, err := NewPool(
+		1,
+		testutil.AccAddress(),
+		PoolParams{
+			SwapFee:     sdk.MustNewDecFromStr("0.01"),
+			ExitFee:     sdk.ZeroDec(),
+			PoolType:    PoolType_CONCENTRATED,
+			TickSpacing: 10,
+		},
+		[]PoolAsset{
+			{Token: sdk.NewInt64Coin("aaa", 1_000_000), Weight: sdk.OneInt()},
+			{Token: sdk.NewInt64Coin("bbb", 1_000_000), Weight: sdk.OneInt()},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), pool.ConcentratedLiquidity.SqrtPrice)
+	require.Equal(t, int64(0), pool.ConcentratedLiquidity.CurrentTick)
+	require.True(t, pool.PoolBalances().IsZero())
+	require.True(t, pool.TotalShares.IsZero())
+
+	position := createTestPosition(t, &pool, 1, -276320, 276320, sdk.NewCoins(
+		sdk.NewInt64Coin("aaa", 1_000_000),
+		sdk.NewInt64Coin("bbb", 1_000_000),
+	))
+	return pool, position
+}
+
+func createTestPosition(t *testing.T, pool *Pool, id uint64, lowerTick, upperTick int64, tokens sdk.Coins) Position {
+	liquidity, err := pool.LiquidityForTokens(lowerTick, upperTick, tokens)
+	require.NoError(t, err)
+
+	position := Position{
+		Id:        id,
+		PoolId:    pool.Id,
+		LowerTick: lowerTick,
+		UpperTick: upperTick,
+		Liquidity: sdk.ZeroDec(),
+	}
+	tokensIn, fees, err := pool.UpdatePosition(&position, liquidity)
+	require.NoError(t, err)
+	require.True(t, tokensIn.IsAllLTE(tokens))
+	require.True(t, fees.IsZero())
+	require.Equal(t, liquidity, position.Liquidity)
+	return position
+}
+
+func TestConcentratedPoolFullRange(t *testing.T) {
+	pool, position := concentratedTestPool(t)
+	lowerTick, upperTick := pool.FullRangeTicks()
+	require.Equal(t, int64(-276320), lowerTick)
+	require.Equal(t, int64(276320), upperTick)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin("aaa", 1_000_000),
+		sdk.NewInt64Coin("bbb", 1_000_000),
+	), pool.PoolBalances())
+	require.Equal(t, position.Liquidity, pool.ConcentratedLiquidity.Liquidity)
+
+	spotPrice, err := pool.CalcSpotPrice("aaa", "bbb")
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), spotPrice)
+
+	// a full range position behaves like a constant product pool:
+	// 1_000_000 * 99_000 / 1_099_000
+	tokenOut, fee, err := pool.CalcOutAmtGivenIn(sdk.NewInt64Coin("aaa", 100_000), "bbb", false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("bbb", 90_081), tokenOut)
+	require.Equal(t, sdk.NewInt64Coin("aaa", 1_000), fee)
+
+	require.NoError(t, pool.ApplySwap(sdk.NewInt64Coin("aaa", 100_000), tokenOut))
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewInt64Coin("aaa", 1_100_000),
+		sdk.NewInt64Coin("bbb", 909_919),
+	), pool.PoolBalances())
+	require.True(t, pool.ConcentratedLiquidity.CurrentTick < 0)
+
+	spotPrice, err = pool.CalcSpotPrice("aaa", "bbb")
+	require.NoError(t, err)
+	require.True(t, spotPrice.GT(sdk.OneDec()))
+
+	// withdrawing everything returns the liquidity and the fees
+	tokensOut, fees, err := pool.UpdatePosition(&position, position.Liquidity.Neg())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aaa", 999)), fees)
+	require.True(t, tokensOut.Add(fees...).IsAllLTE(sdk.NewCoins(
+		sdk.NewInt64Coin("aaa", 1_100_000),
+		sdk.NewInt64Coin("bbb", 909_919),
+	)))
+	require.True(t, position.Liquidity.IsZero())
+	require.Empty(t, pool.ConcentratedLiquidity.Ticks)
+}
+
+func TestConcentratedPoolCrossTicks(t *testing.T) {
+	pool, fullRange := concentratedTestPool(t)
+	narrow := createTestPosition(t, &pool, 2, -100, 100, sdk.NewCoins(
+		sdk.NewInt64Coin("aaa", 1_000_000),
+		sdk.NewInt64Coin("bbb", 1_000_000),
+	))
+	require.Equal(t, fullRange.Liquidity.Add(narrow.Liquidity), pool.ConcentratedLiquidity.Liquidity)
+
+	// the narrow range concentrates the liquidity around the price
+	narrowOut, _, err := pool.CalcOutAmtGivenIn(sdk.NewInt64Coin("aaa", 10_000), "bbb", false)
+	require.NoError(t, err)
+	require.True(t, narrowOut.Amount.GT(sdk.NewInt(9_800)))
+
+	// a large swap crosses the lower tick of the narrow range
+	tokenIn := sdk.NewInt64Coin("aaa", 3_000_000)
+	tokenOut, _, err := pool.CalcOutAmtGivenIn(tokenIn, "bbb", false)
+	require.NoError(t, err)
+	require.NoError(t, pool.ApplySwap(tokenIn, tokenOut))
+	require.True(t, pool.ConcentratedLiquidity.CurrentTick < -100)
+	require.Equal(t, fullRange.Liquidity, pool.ConcentratedLiquidity.Liquidity)
+
+	// the narrow position is now only made of aaa
+	tokensOut, fees, err := pool.UpdatePosition(&narrow, narrow.Liquidity.Neg())
+	require.NoError(t, err)
+	require.True(t, tokensOut.AmountOf("bbb").IsZero())
+	require.True(t, tokensOut.AmountOf("aaa").GT(sdk.NewInt(1_000_000)))
+	require.True(t, fees.AmountOf("aaa").IsPositive())
+
+	// and swapping back crosses the range without the narrow liquidity
+	backIn, err := pool.CalcInAmtGivenOut(sdk.NewInt64Coin("aaa", 500_000), "bbb")
+	require.NoError(t, err)
+	backOut, _, err := pool.CalcOutAmtGivenIn(backIn, "aaa", false)
+	require.NoError(t, err)
+	require.True(t, backOut.Amount.GTE(sdk.NewInt(500_000)))
+	require.NoError(t, pool.ApplySwap(backIn, sdk.NewInt64Coin("aaa", 500_000)))
+	require.Equal(t, fullRange.Liquidity, pool.ConcentratedLiquidity.Liquidity)
+}
+
+func TestConcentratedPoolFeesOfPositions(t *testing.T) {
+	pool, fullRange := concentratedTestPool(t)
+	// a second full range position of the same size earns the same fees
+	other := createTestPosition(t, &pool, 2, -276320, 276320, sdk.NewCoins(
+		sdk.NewInt64Coin("aaa", 1_000_000),
+		sdk.NewInt64Coin("bbb", 1_000_000),
+	))
+
+	for _, tokenIn := range []sdk.Coin{sdk.NewInt64Coin("aaa", 100_000), sdk.NewInt64Coin("bbb", 50_000)} {
+		tokenOutDenom := "bbb"
+		if tokenIn.Denom == "bbb" {
+			tokenOutDenom = "aaa"
+		}
+		tokenOut, _, err := pool.CalcOutAmtGivenIn(tokenIn, tokenOutDenom, false)
+		require.NoError(t, err)
+		require.NoError(t, pool.ApplySwap(tokenIn, tokenOut))
+	}
+
+	// collecting only the fees
+	_, fees, err := pool.UpdatePosition(&fullRange, sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("aaa", 499), sdk.NewInt64Coin("bbb", 249)), fees)
+
+	_, otherFees, err := pool.UpdatePosition(&other, sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, fees, otherFees)
+
+	// fees are only collected once
+	_, fees, err = pool.UpdatePosition(&fullRange, sdk.ZeroDec())
+	require.NoError(t, err)
+	require.True(t, fees.IsZero())
+}
+
+func TestConcentratedPoolErrors(t *testing.T) {
+	pool, position := concentratedTestPool(t)
+
+	_, _, err := pool.AddTokensToPool(sdk.NewCoins(sdk.NewInt64Coin("aaa", 100)))
+	require.ErrorIs(t, err, ErrInvalidPoolType)
+
+	_, _, err = pool.TokensOutFromPoolSharesIn(sdk.OneInt())
+	require.ErrorIs(t, err, ErrInvalidPoolType)
+
+	for _, ticks := range [][2]int64{{10, 10}, {20, 10}, {-5, 10}, {-276330, 10}} {
+		_, err = pool.LiquidityForTokens(ticks[0], ticks[1], sdk.NewCoins(sdk.NewInt64Coin("aaa", 100)))
+		require.ErrorIs(t, err, ErrInvalidTickRange)
+	}
+
+	_, _, err = pool.UpdatePosition(&position, position.Liquidity.Add(sdk.OneDec()).Neg())
+	require.ErrorIs(t, err, ErrInvalidLiquidity)
+
+	// swapping more than the liquidity of the pool
+	_, err = pool.CalcInAmtGivenOut(sdk.NewInt64Coin("bbb", 1_000_000), "aaa")
+	require.ErrorIs(t, err, ErrNotEnoughLiquidity)
+
+	_, _, err = pool.UpdatePosition(&position, position.Liquidity.Neg())
+	require.NoError(t, err)
+	_, _, err = pool.CalcOutAmtGivenIn(sdk.NewInt64Coin("aaa", 100), "bbb", false)
+	require.ErrorIs(t, err, ErrNotEnoughLiquidity)
+}
+
+func TestLiquidityForTokensDust(t *testing.T) {
+	pool, _ := concentratedTestPool(t)
+
+	// one unit deposits round up to at most the tokens provided, without looping
+	// over the rounding errors
+	for _, ticks := range [][2]int64{{-276320, 276320}, {-10, 10}, {10, 20}, {-20, -10}} {
+		tokens := sdk.NewCoins(sdk.NewInt64Coin("aaa", 1), sdk.NewInt64Coin("bbb", 1))
+		liquidity, err := pool.LiquidityForTokens(ticks[0], ticks[1], tokens)
+		if err != nil {
+			require.ErrorIs(t, err, ErrInvalidLiquidity)
+			continue
+		}
+		if liquidity.IsPositive() {
+			createTestPosition(t, &pool, 2, ticks[0], ticks[1], tokens)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 )
 
@@ -18,6 +20,21 @@ const (
 	//
 	// This is done so that smooth weight changes have enough precision to actually be smooth.
 	GuaranteedWeightPrecision int64 = 1 << 30
+
+	// MaxSwapRouteHops maximum number of pools a multi-hop swap may go through
+	MaxSwapRouteHops = 4
+	// MaxSwapRouteCandidatePools maximum number of pools holding a denom that
+	// the search of the best swap route swaps the denom through, the deepest first
+	MaxSwapRouteCandidatePools = 8
+
+	// MaxAmplification maximum amplification parameter a stableswap pool may ramp to
+	MaxAmplification = 1_000_000
+	// MaxAmplificationChange maximum factor by which a ramp may change the
+	// amplification parameter of a stableswap pool
+	MaxAmplificationChange = 10
+	// MinAmplificationRampDuration minimum duration of a ramp of the amplification
+	// parameter of a stableswap pool
+	MinAmplificationRampDuration = 24 * time.Hour
 )
 
 var (
@@ -38,5 +38,26 @@ var (
 	ErrTokenDenomNotFound = sdkerrors.Register(ModuleName, 13, "token denom not found in pool")
 	ErrSameTokenDenom     = sdkerrors.Register(ModuleName, 14, "cannot use same token denom to swap in and out")
 
+	// Errors when swapping through a route of pools
+	ErrInvalidSwapRoute = sdkerrors.Register(ModuleName, 24, "invalid swap route")
+	ErrTokenOutBelowMin = sdkerrors.Register(ModuleName, 25, "token out amount is lower than the minimum")
+	ErrTokenInAboveMax  = sdkerrors.Register(ModuleName, 26, "token in amount is higher than the maximum")
+	ErrNoSwapRouteFound = sdkerrors.Register(ModuleName, 27, "no swap route found")
+
+	// Errors when joining or exiting a pool with a single token
+	ErrPoolSharesOutBelowMin = sdkerrors.Register(ModuleName, 28, "pool shares out amount is lower than the minimum")
+	ErrNotEnoughLiquidity    = sdkerrors.Register(ModuleName, 29, "not enough liquidity in the pool")
+
+	// Errors of concentrated liquidity positions
+	ErrInvalidTickRange = sdkerrors.Register(ModuleName, 30, "invalid tick range")
+	ErrPositionNotFound = sdkerrors.Register(ModuleName, 31, "position not found")
+	ErrInvalidLiquidity = sdkerrors.Register(ModuleName, 32, "invalid liquidity")
+
+	// Errors of time weighted average prices
+	ErrNoValidTwap = sdkerrors.Register(ModuleName, 33, "no valid TWAP")
+
+	// Errors when updating the parameters of a pool
+	ErrInvalidAmplificationRamp = sdkerrors.Register(ModuleName, 34, "invalid amplification ramp")
+
 	ErrNotImplemented = sdkerrors.Register(ModuleName, 18, "not implemented")
 )
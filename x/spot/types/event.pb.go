@@ -287,6 +287,8 @@ type EventAssetsSwapped struct {
 	Fee types.Coin `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee"`
 	// the final state of the pool
 	FinalPool Pool `protobuf:"bytes,5,opt,name=final_pool,json=finalPool,proto3" json:"final_pool"`
+	// the part of the fees taken by the protocol
+	ProtocolFee types.Coin `protobuf:"bytes,6,opt,name=protocol_fee,json=protocolFee,proto3" json:"protocol_fee"`
 }
 
 func (m *EventAssetsSwapped) Reset()         { *m = EventAssetsSwapped{} }
@@ -357,50 +359,258 @@ func (m *EventAssetsSwapped) GetFinalPool() Pool {
 	return Pool{}
 }
 
+func (m *EventAssetsSwapped) GetProtocolFee() types.Coin {
+	if m != nil {
+		return m.ProtocolFee
+	}
+	return types.Coin{}
+}
+
+type EventPositionUpdated struct {
+	// the address of the owner of the position
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the final state of the position
+	FinalPosition Position `protobuf:"bytes,2,opt,name=final_position,json=finalPosition,proto3" json:"final_position"`
+	// the amount of tokens that the owner deposited
+	TokensIn []types.Coin `protobuf:"bytes,3,rep,name=tokens_in,json=tokensIn,proto3" json:"tokens_in"`
+	// the amount of tokens returned to the owner
+	TokensOut []types.Coin `protobuf:"bytes,4,rep,name=tokens_out,json=tokensOut,proto3" json:"tokens_out"`
+	// the amount of swap fees collected by the owner
+	Fees []types.Coin `protobuf:"bytes,5,rep,name=fees,proto3" json:"fees"`
+	// the final state of the pool
+	FinalPool Pool `protobuf:"bytes,6,opt,name=final_pool,json=finalPool,proto3" json:"final_pool"`
+}
+
+func (m *EventPositionUpdated) Reset()         { *m = EventPositionUpdated{} }
+func (m *EventPositionUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventPositionUpdated) ProtoMessage()    {}
+func (*EventPositionUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23fa99c8c3a21a65, []int{4}
+}
+func (m *EventPositionUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPositionUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPositionUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPositionUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPositionUpdated.Merge(m, src)
+}
+func (m *EventPositionUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPositionUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPositionUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPositionUpdated proto.InternalMessageInfo
+
+func (m *EventPositionUpdated) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventPositionUpdated) GetFinalPosition() Position {
+	if m != nil {
+		return m.FinalPosition
+	}
+	return Position{}
+}
+
+func (m *EventPositionUpdated) GetTokensIn() []types.Coin {
+	if m != nil {
+		return m.TokensIn
+	}
+	return nil
+}
+
+func (m *EventPositionUpdated) GetTokensOut() []types.Coin {
+	if m != nil {
+		return m.TokensOut
+	}
+	return nil
+}
+
+func (m *EventPositionUpdated) GetFees() []types.Coin {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *EventPositionUpdated) GetFinalPool() Pool {
+	if m != nil {
+		return m.FinalPool
+	}
+	return Pool{}
+}
+
+type EventParamsUpdated struct {
+	// the new parameters of the module
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *EventParamsUpdated) Reset()         { *m = EventParamsUpdated{} }
+func (m *EventParamsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventParamsUpdated) ProtoMessage()    {}
+func (*EventParamsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23fa99c8c3a21a65, []int{5}
+}
+func (m *EventParamsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventParamsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventParamsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventParamsUpdated.Merge(m, src)
+}
+func (m *EventParamsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventParamsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventParamsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventParamsUpdated proto.InternalMessageInfo
+
+func (m *EventParamsUpdated) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type EventPoolParamsUpdated struct {
+	// the id of the updated pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// the new parameters of the pool
+	FinalPoolParams PoolParams `protobuf:"bytes,2,opt,name=final_pool_params,json=finalPoolParams,proto3" json:"final_pool_params"`
+}
+
+func (m *EventPoolParamsUpdated) Reset()         { *m = EventPoolParamsUpdated{} }
+func (m *EventPoolParamsUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventPoolParamsUpdated) ProtoMessage()    {}
+func (*EventPoolParamsUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23fa99c8c3a21a65, []int{6}
+}
+func (m *EventPoolParamsUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPoolParamsUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPoolParamsUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPoolParamsUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPoolParamsUpdated.Merge(m, src)
+}
+func (m *EventPoolParamsUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPoolParamsUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPoolParamsUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPoolParamsUpdated proto.InternalMessageInfo
+
+func (m *EventPoolParamsUpdated) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *EventPoolParamsUpdated) GetFinalPoolParams() PoolParams {
+	if m != nil {
+		return m.FinalPoolParams
+	}
+	return PoolParams{}
+}
+
 func init() {
 	proto.RegisterType((*EventPoolCreated)(nil), "nibiru.spot.v1.EventPoolCreated")
 	proto.RegisterType((*EventPoolJoined)(nil), "nibiru.spot.v1.EventPoolJoined")
 	proto.RegisterType((*EventPoolExited)(nil), "nibiru.spot.v1.EventPoolExited")
 	proto.RegisterType((*EventAssetsSwapped)(nil), "nibiru.spot.v1.EventAssetsSwapped")
+	proto.RegisterType((*EventPositionUpdated)(nil), "nibiru.spot.v1.EventPositionUpdated")
+	proto.RegisterType((*EventParamsUpdated)(nil), "nibiru.spot.v1.EventParamsUpdated")
+	proto.RegisterType((*EventPoolParamsUpdated)(nil), "nibiru.spot.v1.EventPoolParamsUpdated")
 }
 
 func init() { proto.RegisterFile("nibiru/spot/v1/event.proto", fileDescriptor_23fa99c8c3a21a65) }
 
 var fileDescriptor_23fa99c8c3a21a65 = []byte{
-	// 514 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0x13, 0xb7, 0x25, 0x57, 0x68, 0x91, 0xa9, 0x90, 0x9b, 0xc1, 0x44, 0x9d, 0x22, 0x86,
-	0xb3, 0xdc, 0x4e, 0x20, 0x84, 0x44, 0x43, 0x84, 0xc2, 0x00, 0xa8, 0x85, 0x85, 0xc5, 0xb2, 0x93,
-	0x97, 0xe4, 0x44, 0x72, 0xcf, 0xba, 0x3b, 0x87, 0x32, 0xf2, 0x0f, 0x98, 0xf8, 0x4d, 0x1d, 0x3b,
-	0x32, 0x21, 0x94, 0xfc, 0x03, 0x26, 0x46, 0x74, 0x77, 0x4e, 0x08, 0x1d, 0x5a, 0x97, 0x32, 0x74,
-	0xbb, 0xf3, 0x7b, 0xdf, 0x7d, 0xef, 0x7d, 0xdf, 0xf3, 0x23, 0x0d, 0xce, 0x52, 0x26, 0xf2, 0x50,
-	0x66, 0xa8, 0xc2, 0x69, 0x14, 0xc2, 0x14, 0xb8, 0xa2, 0x99, 0x40, 0x85, 0xde, 0x96, 0x8d, 0x51,
-	0x1d, 0xa3, 0xd3, 0xa8, 0xb1, 0x33, 0xc4, 0x21, 0x9a, 0x50, 0xa8, 0x4f, 0x36, 0xab, 0x11, 0xf4,
-	0x50, 0x4e, 0x50, 0x86, 0x69, 0x22, 0x21, 0x9c, 0x46, 0x29, 0xa8, 0x24, 0x0a, 0x7b, 0xc8, 0x78,
-	0x11, 0xdf, 0x3d, 0xc7, 0x90, 0x21, 0x8e, 0x6d, 0x68, 0xef, 0xa7, 0x43, 0xee, 0x76, 0x34, 0xe1,
-	0x1b, 0xc4, 0x71, 0x5b, 0x40, 0xa2, 0xa0, 0xef, 0xf9, 0x64, 0xa3, 0xa7, 0x8f, 0x28, 0x7c, 0xa7,
-	0xe9, 0xb4, 0xea, 0x47, 0x8b, 0xab, 0x77, 0x40, 0xdc, 0x01, 0x80, 0xf4, 0xab, 0xcd, 0x5a, 0x6b,
-	0x73, 0x7f, 0x97, 0x5a, 0x62, 0xaa, 0x89, 0x69, 0x41, 0x4c, 0xdb, 0xc8, 0xf8, 0xa1, 0x7b, 0xfa,
-	0xfd, 0x41, 0xe5, 0xc8, 0x24, 0x7b, 0x8f, 0x08, 0x19, 0x30, 0x9e, 0x8c, 0x63, 0xcd, 0xeb, 0xbb,
-	0x4d, 0xa7, 0xb5, 0xb9, 0xbf, 0x43, 0xff, 0xee, 0x8c, 0x6a, 0xfe, 0x02, 0x55, 0x37, 0xd9, 0xfa,
-	0x83, 0xf7, 0x96, 0xdc, 0xb7, 0xd0, 0x5c, 0x82, 0x30, 0xf8, 0x58, 0x8e, 0x12, 0x01, 0xd2, 0x5f,
-	0x33, 0xcf, 0x5c, 0x5a, 0xc1, 0x3d, 0x03, 0x7f, 0x27, 0x41, 0xe8, 0xf7, 0x8e, 0x0d, 0x76, 0xef,
-	0x73, 0x8d, 0x6c, 0x2f, 0x9b, 0x7e, 0x89, 0x8c, 0xdb, 0x9e, 0x93, 0x7e, 0x5f, 0x80, 0x94, 0x8b,
-	0x9e, 0x8b, 0xab, 0xf7, 0x84, 0xd4, 0x15, 0x7e, 0x00, 0x2e, 0x63, 0xc6, 0xcb, 0x36, 0x7e, 0xcb,
-	0x22, 0xba, 0xdc, 0x7b, 0x41, 0xb6, 0x57, 0xca, 0x8e, 0x31, 0x57, 0x7e, 0xad, 0x5c, 0xe9, 0x77,
-	0xb2, 0x65, 0xc5, 0xaf, 0x73, 0xa5, 0xcb, 0x10, 0x30, 0x89, 0xb5, 0xad, 0xd2, 0x77, 0x4b, 0x96,
-	0x21, 0x60, 0xa2, 0xaf, 0xe7, 0x3d, 0x58, 0xfb, 0x3f, 0x1e, 0xac, 0x5f, 0xc3, 0x83, 0x5f, 0xd5,
-	0x15, 0x0f, 0x3a, 0x27, 0x4c, 0x5d, 0xe8, 0x41, 0x87, 0x6c, 0xad, 0xaa, 0x68, 0x8c, 0x28, 0xc5,
-	0x7d, 0xfb, 0x8f, 0x88, 0x5d, 0xee, 0x3d, 0x25, 0xa4, 0xb0, 0xd2, 0xfa, 0x50, 0x4a, 0xc4, 0xc2,
-	0x7d, 0xed, 0xc1, 0x62, 0xfc, 0xdd, 0x7f, 0x1f, 0xff, 0x1b, 0x20, 0xfd, 0xd7, 0x2a, 0xf1, 0x8c,
-	0xf4, 0xcf, 0xa4, 0x04, 0x25, 0x8f, 0x3f, 0x26, 0x59, 0x76, 0xa1, 0xfa, 0x8f, 0x89, 0x9d, 0xe7,
-	0x2b, 0xe8, 0xbe, 0x61, 0x00, 0x5d, 0xbe, 0xfc, 0x7b, 0xae, 0x32, 0xf9, 0x96, 0x4d, 0x0b, 0x1e,
-	0x91, 0xda, 0x00, 0xa0, 0xd8, 0x19, 0x97, 0xe2, 0x74, 0xee, 0x35, 0xe4, 0x3e, 0x7c, 0x7e, 0x3a,
-	0x0b, 0x9c, 0xb3, 0x59, 0xe0, 0xfc, 0x98, 0x05, 0xce, 0x97, 0x79, 0x50, 0x39, 0x9b, 0x07, 0x95,
-	0x6f, 0xf3, 0xa0, 0xf2, 0xfe, 0xe1, 0x90, 0xa9, 0x51, 0x9e, 0xd2, 0x1e, 0x4e, 0xc2, 0x57, 0xe6,
-	0xa9, 0xf6, 0x28, 0x61, 0x3c, 0x2c, 0x16, 0xeb, 0x89, 0x5d, 0xad, 0xea, 0x53, 0x06, 0x32, 0x5d,
-	0x37, 0x9b, 0xf5, 0xe0, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xf6, 0x0e, 0x47, 0xd8, 0x05,
-	0x00, 0x00,
+	// 661 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4f, 0x6f, 0x12, 0x4f,
+	0x18, 0x66, 0x61, 0x81, 0x1f, 0x43, 0x7f, 0x45, 0x57, 0x82, 0x5b, 0x4c, 0x56, 0xc2, 0x89, 0x78,
+	0xd8, 0x0d, 0xd4, 0x8b, 0xc6, 0x98, 0x58, 0x44, 0x43, 0x63, 0xb4, 0xa1, 0xf6, 0xe2, 0x65, 0xb3,
+	0xb0, 0x03, 0x4c, 0x84, 0x99, 0xcd, 0xce, 0x80, 0xf5, 0x64, 0xfc, 0x06, 0x7e, 0xac, 0x1e, 0x7b,
+	0x34, 0x1e, 0x8c, 0x81, 0xf8, 0x05, 0x3c, 0x79, 0x34, 0xf3, 0x67, 0x29, 0x50, 0x53, 0x17, 0xea,
+	0xc1, 0xdb, 0xce, 0xbe, 0xf3, 0xbc, 0xef, 0xf3, 0x3e, 0xcf, 0x3b, 0x33, 0xa0, 0x8c, 0x51, 0x17,
+	0x85, 0x13, 0x87, 0x06, 0x84, 0x39, 0xd3, 0xba, 0x03, 0xa7, 0x10, 0x33, 0x3b, 0x08, 0x09, 0x23,
+	0xc6, 0xae, 0x8c, 0xd9, 0x3c, 0x66, 0x4f, 0xeb, 0xe5, 0xe2, 0x80, 0x0c, 0x88, 0x08, 0x39, 0xfc,
+	0x4b, 0xee, 0x2a, 0x5b, 0x3d, 0x42, 0xc7, 0x84, 0x3a, 0x5d, 0x8f, 0x42, 0x67, 0x5a, 0xef, 0x42,
+	0xe6, 0xd5, 0x9d, 0x1e, 0x41, 0x58, 0xc5, 0xef, 0xac, 0x55, 0x08, 0xbc, 0xd0, 0x1b, 0x53, 0x15,
+	0xdc, 0x5b, 0x0f, 0x12, 0x32, 0x92, 0xa1, 0xea, 0x0f, 0x0d, 0xdc, 0x68, 0x71, 0x36, 0x47, 0x84,
+	0x8c, 0x9a, 0x21, 0xf4, 0x18, 0xf4, 0x0d, 0x13, 0x64, 0x7b, 0xfc, 0x93, 0x84, 0xa6, 0x56, 0xd1,
+	0x6a, 0xb9, 0x4e, 0xb4, 0x34, 0xf6, 0x81, 0xde, 0x87, 0x90, 0x9a, 0xc9, 0x4a, 0xaa, 0x96, 0x6f,
+	0xec, 0xd9, 0x92, 0x95, 0xcd, 0x59, 0xd9, 0x8a, 0x95, 0xdd, 0x24, 0x08, 0x1f, 0xe8, 0x67, 0x5f,
+	0xef, 0x26, 0x3a, 0x62, 0xb3, 0xf1, 0x00, 0x80, 0x3e, 0xc2, 0xde, 0xc8, 0xe5, 0x75, 0x4d, 0xbd,
+	0xa2, 0xd5, 0xf2, 0x8d, 0xa2, 0xbd, 0xda, 0xb6, 0xcd, 0xeb, 0x2b, 0x54, 0x4e, 0xec, 0xe6, 0x3f,
+	0x8c, 0xd7, 0xa0, 0x24, 0xa1, 0x13, 0x0a, 0x43, 0x81, 0x77, 0xe9, 0xd0, 0x0b, 0x21, 0x35, 0xd3,
+	0x15, 0x2d, 0x0e, 0x83, 0x5b, 0x02, 0x7e, 0x42, 0x61, 0xc8, 0xf3, 0x1d, 0x0b, 0x6c, 0xf5, 0x63,
+	0x0a, 0x14, 0x16, 0x4d, 0x1f, 0x12, 0x84, 0x65, 0xcf, 0x9e, 0xef, 0x87, 0x90, 0xd2, 0xa8, 0x67,
+	0xb5, 0x34, 0x1e, 0x81, 0x1c, 0x23, 0x6f, 0x21, 0xa6, 0x2e, 0xc2, 0x71, 0x1b, 0xff, 0x4f, 0x22,
+	0xda, 0xd8, 0x78, 0x0e, 0x0a, 0x4b, 0xb4, 0x5d, 0x32, 0x61, 0x66, 0x2a, 0x1e, 0xf5, 0xff, 0x83,
+	0x05, 0xe3, 0x57, 0x13, 0xc6, 0x69, 0x84, 0x70, 0xec, 0x72, 0xcf, 0xa9, 0xa9, 0xc7, 0xa4, 0x11,
+	0xc2, 0x31, 0x5f, 0xae, 0x7b, 0x90, 0xfe, 0x3b, 0x1e, 0x64, 0xae, 0xe1, 0xc1, 0xcf, 0xe4, 0x92,
+	0x07, 0xad, 0x53, 0xc4, 0xae, 0xf4, 0xa0, 0x05, 0x76, 0x97, 0x55, 0x14, 0x46, 0xc4, 0xaa, 0xbd,
+	0x73, 0x21, 0x62, 0x1b, 0x1b, 0x8f, 0x01, 0x50, 0x56, 0x4a, 0x1f, 0x62, 0x89, 0xa8, 0xdc, 0xe7,
+	0x1e, 0x44, 0xe3, 0xaf, 0x6f, 0x3f, 0xfe, 0xff, 0x80, 0xf4, 0x5f, 0x92, 0xc0, 0x10, 0xd2, 0x3f,
+	0xa1, 0x14, 0x32, 0x7a, 0xfc, 0xce, 0x0b, 0x82, 0x2b, 0xd5, 0x7f, 0x08, 0xe4, 0x3c, 0x6f, 0xa0,
+	0x7b, 0x56, 0x00, 0xda, 0x78, 0x71, 0x7a, 0x36, 0x99, 0x7c, 0x59, 0x8d, 0x0b, 0x5e, 0x07, 0xa9,
+	0x3e, 0x84, 0xa6, 0x1e, 0x0f, 0xc7, 0xf7, 0x5e, 0x47, 0xee, 0x03, 0xb0, 0x23, 0x6e, 0xc5, 0x1e,
+	0x19, 0xb9, 0xbc, 0x6c, 0x4c, 0x91, 0xf3, 0x11, 0xe8, 0x19, 0x84, 0xd5, 0xef, 0x49, 0x50, 0x54,
+	0x73, 0x4d, 0x11, 0x43, 0x04, 0x9f, 0x04, 0xbe, 0xf7, 0xc7, 0xe1, 0x8e, 0x18, 0x4b, 0x88, 0x12,
+	0xd9, 0xbc, 0xcc, 0x5a, 0xc6, 0xa3, 0x0b, 0x42, 0x31, 0x97, 0x3f, 0x57, 0xef, 0xa9, 0xd4, 0xa6,
+	0xf7, 0xd4, 0xea, 0xd1, 0xd0, 0xb7, 0x3e, 0x1a, 0xe9, 0xed, 0x8f, 0x46, 0x66, 0x03, 0xaf, 0xaa,
+	0x87, 0x6a, 0x86, 0x8f, 0xc4, 0x43, 0x17, 0x89, 0x7c, 0x1f, 0x64, 0xe4, 0xcb, 0x27, 0x34, 0xce,
+	0x37, 0x4a, 0x97, 0x92, 0x89, 0xa8, 0x4a, 0xa7, 0xf6, 0x56, 0x3f, 0x80, 0xd2, 0xe2, 0x2a, 0x5a,
+	0xcd, 0x77, 0x1b, 0x64, 0xc5, 0xa9, 0x43, 0xbe, 0x48, 0xa8, 0x77, 0x32, 0x7c, 0xd9, 0xf6, 0x8d,
+	0x17, 0xe0, 0xe6, 0x05, 0x73, 0x57, 0xd5, 0x94, 0xb6, 0x95, 0x7f, 0xd7, 0xc0, 0x4a, 0xdd, 0xc2,
+	0xa2, 0x0d, 0xf5, 0xfb, 0xe9, 0xd9, 0xcc, 0xd2, 0xce, 0x67, 0x96, 0xf6, 0x6d, 0x66, 0x69, 0x9f,
+	0xe6, 0x56, 0xe2, 0x7c, 0x6e, 0x25, 0x3e, 0xcf, 0xad, 0xc4, 0x9b, 0x7b, 0x03, 0xc4, 0x86, 0x93,
+	0xae, 0xdd, 0x23, 0x63, 0xe7, 0xa5, 0x48, 0xdb, 0x1c, 0x7a, 0x08, 0x3b, 0xea, 0x45, 0x3f, 0x95,
+	0x6f, 0x3a, 0x7b, 0x1f, 0x40, 0xda, 0xcd, 0x88, 0x39, 0xdc, 0xff, 0x35, 0x00, 0x12, 0xd2, 0x5b,
+	0x9f, 0x6e, 0x08, 0x00, 0x00,
 }
 
 func (m *EventPoolCreated) Marshal() (dAtA []byte, err error) {
@@ -663,6 +873,16 @@ func (m *EventAssetsSwapped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ProtocolFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size, err := m.FinalPool.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -713,78 +933,241 @@ func (m *EventAssetsSwapped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventPositionUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventPoolCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventPositionUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPositionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	{
+		size, err := m.FinalPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.Fees) > 0 {
-		for _, e := range m.Fees {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	l = m.FinalPool.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.FinalUserPoolShares.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
-}
-
-func (m *EventPoolJoined) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	if len(m.TokensIn) > 0 {
-		for _, e := range m.TokensIn {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
+		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = m.PoolSharesOut.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if len(m.RemCoins) > 0 {
-		for _, e := range m.RemCoins {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
+	{
+		size, err := m.FinalPosition.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = m.FinalPool.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.FinalUserPoolShares.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventPoolExited) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventParamsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
+	return dAtA[:n], nil
+}
+
+func (m *EventParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPoolParamsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPoolParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPoolParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FinalPoolParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventPoolCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = m.FinalPool.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FinalUserPoolShares.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventPoolJoined) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.TokensIn) > 0 {
+		for _, e := range m.TokensIn {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = m.PoolSharesOut.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if len(m.RemCoins) > 0 {
+		for _, e := range m.RemCoins {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = m.FinalPool.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FinalUserPoolShares.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventPoolExited) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
@@ -827,6 +1210,68 @@ func (m *EventAssetsSwapped) Size() (n int) {
 	n += 1 + l + sovEvent(uint64(l))
 	l = m.FinalPool.Size()
 	n += 1 + l + sovEvent(uint64(l))
+	l = m.ProtocolFee.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventPositionUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.FinalPosition.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if len(m.TokensIn) > 0 {
+		for _, e := range m.TokensIn {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = m.FinalPool.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventParamsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventPoolParamsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovEvent(uint64(m.PoolId))
+	}
+	l = m.FinalPoolParams.Size()
+	n += 1 + l + sovEvent(uint64(l))
 	return n
 }
 
@@ -836,7 +1281,438 @@ func sovEvent(x uint64) (n int) {
 func sozEvent(x uint64) (n int) {
 	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *EventPoolCreated) Unmarshal(dAtA []byte) error {
+func (m *EventPoolCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalUserPoolShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalUserPoolShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPoolJoined) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolJoined: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolJoined: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensIn = append(m.TokensIn, types.Coin{})
+			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSharesOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolSharesOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemCoins = append(m.RemCoins, types.Coin{})
+			if err := m.RemCoins[len(m.RemCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalUserPoolShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalUserPoolShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPoolExited) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -859,15 +1735,15 @@ func (m *EventPoolCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPoolCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPoolExited: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPoolCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPoolExited: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -895,9 +1771,76 @@ func (m *EventPoolCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolSharesIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolSharesIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensOut = append(m.TokensOut, types.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
@@ -931,7 +1874,7 @@ func (m *EventPoolCreated) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FinalPool", wireType)
 			}
@@ -964,7 +1907,7 @@ func (m *EventPoolCreated) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FinalUserPoolShares", wireType)
 			}
@@ -1018,7 +1961,7 @@ func (m *EventPoolCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPoolJoined) Unmarshal(dAtA []byte) error {
+func (m *EventAssetsSwapped) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1041,10 +1984,10 @@ func (m *EventPoolJoined) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPoolJoined: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAssetsSwapped: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPoolJoined: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAssetsSwapped: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1081,7 +2024,7 @@ func (m *EventPoolJoined) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1108,14 +2051,13 @@ func (m *EventPoolJoined) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokensIn = append(m.TokensIn, types.Coin{})
-			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolSharesOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1142,13 +2084,13 @@ func (m *EventPoolJoined) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolSharesOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1175,8 +2117,7 @@ func (m *EventPoolJoined) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemCoins = append(m.RemCoins, types.Coin{})
-			if err := m.RemCoins[len(m.RemCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1215,7 +2156,7 @@ func (m *EventPoolJoined) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalUserPoolShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1242,7 +2183,7 @@ func (m *EventPoolJoined) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinalUserPoolShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProtocolFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1267,7 +2208,7 @@ func (m *EventPoolJoined) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPoolExited) Unmarshal(dAtA []byte) error {
+func (m *EventPositionUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1290,10 +2231,10 @@ func (m *EventPoolExited) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPoolExited: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPositionUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPoolExited: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPositionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1330,7 +2271,7 @@ func (m *EventPoolExited) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolSharesIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalPosition", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1357,13 +2298,13 @@ func (m *EventPoolExited) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolSharesIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinalPosition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1390,14 +2331,14 @@ func (m *EventPoolExited) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokensOut = append(m.TokensOut, types.Coin{})
-			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokensIn = append(m.TokensIn, types.Coin{})
+			if err := m.TokensIn[len(m.TokensIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1424,14 +2365,14 @@ func (m *EventPoolExited) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fees = append(m.Fees, types.Coin{})
-			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokensOut = append(m.TokensOut, types.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1458,13 +2399,14 @@ func (m *EventPoolExited) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinalPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalUserPoolShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1491,7 +2433,7 @@ func (m *EventPoolExited) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinalUserPoolShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinalPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1516,7 +2458,7 @@ func (m *EventPoolExited) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAssetsSwapped) Unmarshal(dAtA []byte) error {
+func (m *EventParamsUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1539,47 +2481,15 @@ func (m *EventAssetsSwapped) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAssetsSwapped: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventParamsUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAssetsSwapped: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1606,48 +2516,65 @@ func (m *EventAssetsSwapped) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPoolParamsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPoolParamsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPoolParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1657,28 +2584,14 @@ func (m *EventAssetsSwapped) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalPoolParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1705,7 +2618,7 @@ func (m *EventAssetsSwapped) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinalPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinalPoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
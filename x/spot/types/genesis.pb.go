@@ -29,6 +29,12 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// pools defines all the pools of the module.
 	Pools []Pool `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools"`
+	// positions defines all the positions of the concentrated liquidity pools.
+	Positions []Position `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions"`
+	// twap_records defines the price history of the pools.
+	TwapRecords []TwapRecord `protobuf:"bytes,4,rep,name=twap_records,json=twapRecords,proto3" json:"twap_records"`
+	// pool_fees defines the cumulative swap fees collected by the pools.
+	PoolFees []PoolFees `protobuf:"bytes,5,rep,name=pool_fees,json=poolFees,proto3" json:"pool_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +84,27 @@ func (m *GenesisState) GetPools() []Pool {
 	return nil
 }
 
+func (m *GenesisState) GetPositions() []Position {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTwapRecords() []TwapRecord {
+	if m != nil {
+		return m.TwapRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolFees() []PoolFees {
+	if m != nil {
+		return m.PoolFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nibiru.spot.v1.GenesisState")
 }
@@ -85,22 +112,27 @@ func init() {
 func init() { proto.RegisterFile("nibiru/spot/v1/genesis.proto", fileDescriptor_f2772e1e838a47ec) }
 
 var fileDescriptor_f2772e1e838a47ec = []byte{
-	// 227 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0xcb, 0x4c, 0xca,
-	0x2c, 0x2a, 0xd5, 0x2f, 0x2e, 0xc8, 0x2f, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xea, 0x81, 0x64, 0xf5,
-	0xca, 0x0c, 0xa5, 0xa4, 0xd1, 0x54, 0x17, 0x24, 0x16, 0x25, 0xe6, 0x42, 0x15, 0x4b, 0x49, 0xa2,
-	0x4b, 0xe6, 0xe7, 0xe7, 0x40, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b,
-	0x22, 0xaa, 0x54, 0xc6, 0xc5, 0xe3, 0x0e, 0xb1, 0x2e, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x84,
-	0x8b, 0x0d, 0x62, 0xa0, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x98, 0x1e, 0xaa, 0xf5, 0x7a,
-	0x01, 0x60, 0x59, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x6a, 0x85, 0x0c, 0xb8, 0x58,
-	0x41, 0x36, 0x15, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x89, 0x60, 0x68, 0xca, 0xcf, 0xcf,
-	0x81, 0x6a, 0x81, 0x28, 0x74, 0x72, 0x39, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
-	0x28, 0xad, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x3f, 0xb0, 0x31,
-	0xce, 0x19, 0x89, 0x99, 0x79, 0xfa, 0x50, 0x9f, 0x55, 0x40, 0xfc, 0x56, 0x52, 0x59, 0x90, 0x5a,
-	0x9c, 0xc4, 0x06, 0xf6, 0x84, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x16, 0xfb, 0x27, 0x7a, 0x42,
-	0x01, 0x00, 0x00,
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xcd, 0x4e, 0x32, 0x31,
+	0x14, 0x9d, 0xe1, 0x2f, 0xdf, 0x37, 0x10, 0x17, 0x13, 0x62, 0x2a, 0x9a, 0x4a, 0x5c, 0x11, 0x17,
+	0xad, 0xa0, 0x3b, 0x5d, 0x89, 0xd1, 0x9d, 0x31, 0xe8, 0xca, 0x0d, 0x29, 0x58, 0x87, 0x26, 0xc3,
+	0xdc, 0x66, 0x5a, 0x40, 0xdf, 0xc2, 0xa7, 0xf1, 0x19, 0x58, 0xb2, 0x74, 0x65, 0x0c, 0xbc, 0x88,
+	0x99, 0xb6, 0x68, 0x1c, 0xc2, 0xee, 0xf6, 0x9e, 0x9f, 0x9e, 0x9b, 0x13, 0x1c, 0x24, 0x62, 0x20,
+	0xd2, 0x09, 0x55, 0x12, 0x34, 0x9d, 0xb6, 0x69, 0xc4, 0x13, 0xae, 0x84, 0x22, 0x32, 0x05, 0x0d,
+	0xe1, 0x8e, 0x45, 0x49, 0x86, 0x92, 0x69, 0xbb, 0xb1, 0x9f, 0x63, 0x4b, 0x96, 0xb2, 0xb1, 0x23,
+	0x37, 0xf6, 0xf2, 0x20, 0x40, 0xbc, 0x05, 0xd2, 0x33, 0x26, 0x1d, 0x54, 0x8f, 0x20, 0x02, 0x33,
+	0xd2, 0x6c, 0xb2, 0xdb, 0xa3, 0xf7, 0x42, 0x50, 0xbb, 0xb1, 0x51, 0xee, 0x35, 0xd3, 0x3c, 0x3c,
+	0x0b, 0x2a, 0xf6, 0x33, 0xe4, 0x37, 0xfd, 0x56, 0xb5, 0xb3, 0x4b, 0xfe, 0x46, 0x23, 0x77, 0x06,
+	0xbd, 0x2c, 0xcd, 0x3f, 0x0f, 0xbd, 0x9e, 0xe3, 0x86, 0x27, 0x41, 0x39, 0x4b, 0xa1, 0x50, 0xa1,
+	0x59, 0x6c, 0x55, 0x3b, 0xf5, 0x0d, 0x11, 0x40, 0xec, 0x24, 0x96, 0x18, 0x5e, 0x04, 0xff, 0x25,
+	0x28, 0xa1, 0x05, 0x24, 0x0a, 0x15, 0x8d, 0x0a, 0x6d, 0xaa, 0x2c, 0xc1, 0x29, 0x7f, 0x05, 0x61,
+	0x37, 0xa8, 0x65, 0xa7, 0xf5, 0x53, 0x3e, 0x84, 0xf4, 0x49, 0xa1, 0x92, 0x31, 0x68, 0xe4, 0x0d,
+	0x1e, 0x66, 0x4c, 0xf6, 0x0c, 0xc5, 0x59, 0x54, 0xf5, 0xcf, 0x46, 0x85, 0xe7, 0x59, 0x04, 0x88,
+	0xfb, 0xcf, 0x9c, 0x2b, 0x54, 0xde, 0x16, 0x01, 0xe2, 0x6b, 0xce, 0xd7, 0xf7, 0xfe, 0x93, 0xeb,
+	0xf7, 0xd5, 0x7c, 0x89, 0xfd, 0xc5, 0x12, 0xfb, 0x5f, 0x4b, 0xec, 0xbf, 0xad, 0xb0, 0xb7, 0x58,
+	0x61, 0xef, 0x63, 0x85, 0xbd, 0xc7, 0xe3, 0x48, 0xe8, 0xd1, 0x64, 0x40, 0x86, 0x30, 0xa6, 0xb7,
+	0xc6, 0xad, 0x3b, 0x62, 0x22, 0xa1, 0xae, 0x9a, 0x17, 0x5b, 0x8e, 0x7e, 0x95, 0x5c, 0x0d, 0x2a,
+	0xa6, 0x85, 0xd3, 0xef, 0x01, 0x00, 0x09, 0x82, 0x27, 0xfb, 0x1e, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +155,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolFees) > 0 {
+		for iNdEx := len(m.PoolFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TwapRecords) > 0 {
+		for iNdEx := len(m.TwapRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TwapRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Pools) > 0 {
 		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -175,6 +249,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TwapRecords) > 0 {
+		for _, e := range m.TwapRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolFees) > 0 {
+		for _, e := range m.PoolFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -280,6 +372,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TwapRecords = append(m.TwapRecords, TwapRecord{})
+			if err := m.TwapRecords[len(m.TwapRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolFees = append(m.PoolFees, PoolFees{})
+			if err := m.PoolFees[len(m.PoolFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/x/spot/types"
@@ -35,3 +36,30 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_ValidateProtocolFee(t *testing.T) {
+	for _, tc := range []struct {
+		desc             string
+		protocolFeeShare sdk.Dec
+		recipient        types.ProtocolFeeRecipient
+		valid            bool
+	}{
+		{desc: "zero share", protocolFeeShare: sdk.ZeroDec(), valid: true},
+		{desc: "full share", protocolFeeShare: sdk.OneDec(), recipient: types.ProtocolFeeRecipient_COMMUNITY_POOL, valid: true},
+		{desc: "negative share", protocolFeeShare: sdk.MustNewDecFromStr("-0.1"), valid: false},
+		{desc: "share above one", protocolFeeShare: sdk.MustNewDecFromStr("1.1"), valid: false},
+		{desc: "unknown recipient", protocolFeeShare: sdk.ZeroDec(), recipient: types.ProtocolFeeRecipient(2), valid: false},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.ProtocolFeeShare = tc.protocolFeeShare
+			params.ProtocolFeeRecipient = tc.recipient
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"sort"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -22,6 +23,10 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_dex"
+
+	// TwapRecordHistoryKeepPeriod is how long the TWAP records of a pool are
+	// kept, which bounds the time windows of the TWAP queries.
+	TwapRecordHistoryKeepPeriod = 48 * time.Hour
 )
 
 func KeyPrefix(p string) []byte {
@@ -37,6 +42,16 @@ var (
 	KeyTotalLiquidity = []byte{0x03}
 	// KeyPrefixPoolIds defines prefix to store pool ids by denoms in the pool
 	KeyPrefixPoolIds = []byte{0x04}
+	// KeyNextPositionNumber defines key to store the next concentrated liquidity position ID to be used
+	KeyNextPositionNumber = []byte{0x05}
+	// KeyPrefixPositions defines prefix to store concentrated liquidity positions
+	KeyPrefixPositions = []byte{0x06}
+	// KeyPrefixTwapRecords defines prefix to store the TWAP records of the pools
+	KeyPrefixTwapRecords = []byte{0x07}
+	// KeyPrefixPoolFees defines prefix to store the cumulative swap fees of the pools
+	KeyPrefixPoolFees = []byte{0x08}
+	// KeyPrefixTicks defines prefix to store the initialized ticks of the concentrated liquidity pools
+	KeyPrefixTicks = []byte{0x09}
 )
 
 func GetDenomPrefixPoolIds(denoms ...string) []byte {
@@ -49,6 +64,34 @@ func GetKeyPrefixPools(poolId uint64) []byte {
 	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
 }
 
+func GetKeyPrefixPositions(positionId uint64) []byte {
+	return append(KeyPrefixPositions, sdk.Uint64ToBigEndian(positionId)...)
+}
+
+func GetKeyPrefixTwapRecords(poolId uint64) []byte {
+	return append(KeyPrefixTwapRecords, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetTwapRecordKey returns the key of a TWAP record. The sign bit of the
+// timestamp is flipped so that the keys of negative timestamps sort first.
+func GetTwapRecordKey(poolId uint64, timestampMs int64) []byte {
+	return append(GetKeyPrefixTwapRecords(poolId), sdk.Uint64ToBigEndian(uint64(timestampMs)^(1<<63))...)
+}
+
+func GetKeyPrefixPoolFees(poolId uint64) []byte {
+	return append(KeyPrefixPoolFees, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyPrefixTicks(poolId uint64) []byte {
+	return append(KeyPrefixTicks, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// GetTickKey returns the key of a tick of a concentrated liquidity pool. The
+// sign bit of the index is flipped so that the keys of negative ticks sort first.
+func GetTickKey(poolId uint64, tick int64) []byte {
+	return append(GetKeyPrefixTicks(poolId), sdk.Uint64ToBigEndian(uint64(tick)^(1<<63))...)
+}
+
 func GetDenomLiquidityPrefix(denom string) []byte {
 	return append(KeyTotalLiquidity, []byte(denom)...)
 }
@@ -1,7 +1,10 @@
 package types
 
 import (
+	"time"
+
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -11,6 +14,18 @@ const (
 	TypeMsgJoinPool   = "join_pool"
 	TypeMsgSwapAssets = "swap_assets"
 	TypeMsgCreatePool = "create_pool"
+
+	TypeMsgSwapExactAmountInRoute  = "swap_exact_amount_in_route"
+	TypeMsgSwapExactAmountOutRoute = "swap_exact_amount_out_route"
+
+	TypeMsgJoinSwapExternAmountIn = "join_swap_extern_amount_in"
+	TypeMsgExitSwapShareAmountIn  = "exit_swap_share_amount_in"
+
+	TypeMsgCreatePosition   = "create_position"
+	TypeMsgWithdrawPosition = "withdraw_position"
+
+	TypeMsgUpdateParams     = "update_params"
+	TypeMsgUpdatePoolParams = "update_pool_params"
 )
 
 var _ sdk.Msg = &MsgExitPool{}
@@ -145,6 +160,236 @@ func (msg *MsgSwapAssets) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgSwapExactAmountInRoute{}
+
+func NewMsgSwapExactAmountInRoute(
+	sender string, routes []SwapRoute, tokenIn sdk.Coin, tokenOutMinAmount sdkmath.Int,
+) *MsgSwapExactAmountInRoute {
+	return &MsgSwapExactAmountInRoute{
+		Sender:            sender,
+		Routes:            routes,
+		TokenIn:           tokenIn,
+		TokenOutMinAmount: tokenOutMinAmount,
+	}
+}
+
+func (msg *MsgSwapExactAmountInRoute) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSwapExactAmountInRoute) Type() string {
+	return TypeMsgSwapExactAmountInRoute
+}
+
+func (msg *MsgSwapExactAmountInRoute) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSwapExactAmountInRoute) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSwapExactAmountInRoute) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if msg.TokenIn.Amount.IsNil() || msg.TokenIn.Amount.LTE(sdk.ZeroInt()) {
+		return ErrInvalidTokenIn.Wrapf("invalid argument %s", msg.TokenIn.String())
+	}
+
+	if msg.TokenOutMinAmount.IsNil() || msg.TokenOutMinAmount.IsNegative() {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid token out min amount: %s", msg.TokenOutMinAmount)
+	}
+
+	return ValidateSwapRoutes(msg.Routes, msg.TokenIn.Denom)
+}
+
+var _ sdk.Msg = &MsgSwapExactAmountOutRoute{}
+
+func NewMsgSwapExactAmountOutRoute(
+	sender string, routes []SwapRoute, tokenInDenom string, tokenInMaxAmount sdkmath.Int, tokenOut sdk.Coin,
+) *MsgSwapExactAmountOutRoute {
+	return &MsgSwapExactAmountOutRoute{
+		Sender:           sender,
+		Routes:           routes,
+		TokenInDenom:     tokenInDenom,
+		TokenInMaxAmount: tokenInMaxAmount,
+		TokenOut:         tokenOut,
+	}
+}
+
+func (msg *MsgSwapExactAmountOutRoute) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSwapExactAmountOutRoute) Type() string {
+	return TypeMsgSwapExactAmountOutRoute
+}
+
+func (msg *MsgSwapExactAmountOutRoute) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSwapExactAmountOutRoute) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSwapExactAmountOutRoute) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if msg.TokenInDenom == "" {
+		return ErrInvalidTokenIn.Wrap("token in denom cannot be empty")
+	}
+
+	if msg.TokenInMaxAmount.IsNil() || !msg.TokenInMaxAmount.IsPositive() {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid token in max amount: %s", msg.TokenInMaxAmount)
+	}
+
+	if msg.TokenOut.Amount.IsNil() || msg.TokenOut.Amount.LTE(sdk.ZeroInt()) {
+		return sdkerrors.Wrapf(errors.ErrInvalidCoins, "invalid token out: %s", msg.TokenOut)
+	}
+
+	if err := ValidateSwapRoutes(msg.Routes, msg.TokenInDenom); err != nil {
+		return err
+	}
+
+	if last := msg.Routes[len(msg.Routes)-1]; last.TokenOutDenom != msg.TokenOut.Denom {
+		return ErrInvalidSwapRoute.Wrapf("the route ends with %s, not %s", last.TokenOutDenom, msg.TokenOut.Denom)
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgJoinSwapExternAmountIn{}
+
+func NewMsgJoinSwapExternAmountIn(
+	sender string, poolId uint64, tokenIn sdk.Coin, shareOutMinAmount sdkmath.Int,
+) *MsgJoinSwapExternAmountIn {
+	return &MsgJoinSwapExternAmountIn{
+		Sender:            sender,
+		PoolId:            poolId,
+		TokenIn:           tokenIn,
+		ShareOutMinAmount: shareOutMinAmount,
+	}
+}
+
+func (msg *MsgJoinSwapExternAmountIn) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgJoinSwapExternAmountIn) Type() string {
+	return TypeMsgJoinSwapExternAmountIn
+}
+
+func (msg *MsgJoinSwapExternAmountIn) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgJoinSwapExternAmountIn) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgJoinSwapExternAmountIn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if msg.PoolId == 0 {
+		return ErrInvalidPoolId.Wrapf("pool id cannot be %d", msg.PoolId)
+	}
+
+	if msg.TokenIn.Amount.IsNil() || msg.TokenIn.Amount.LTE(sdk.ZeroInt()) {
+		return ErrInvalidTokenIn.Wrapf("invalid argument %s", msg.TokenIn.String())
+	}
+
+	if msg.ShareOutMinAmount.IsNil() || msg.ShareOutMinAmount.IsNegative() {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid share out min amount: %s", msg.ShareOutMinAmount)
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgExitSwapShareAmountIn{}
+
+func NewMsgExitSwapShareAmountIn(
+	sender string, poolId uint64, poolShares sdk.Coin, tokenOutDenom string, tokenOutMinAmount sdkmath.Int,
+) *MsgExitSwapShareAmountIn {
+	return &MsgExitSwapShareAmountIn{
+		Sender:            sender,
+		PoolId:            poolId,
+		TokenOutDenom:     tokenOutDenom,
+		PoolShares:        poolShares,
+		TokenOutMinAmount: tokenOutMinAmount,
+	}
+}
+
+func (msg *MsgExitSwapShareAmountIn) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgExitSwapShareAmountIn) Type() string {
+	return TypeMsgExitSwapShareAmountIn
+}
+
+func (msg *MsgExitSwapShareAmountIn) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgExitSwapShareAmountIn) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgExitSwapShareAmountIn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if msg.PoolId == 0 {
+		return ErrInvalidPoolId.Wrapf("pool id cannot be %d", msg.PoolId)
+	}
+
+	if msg.TokenOutDenom == "" {
+		return ErrInvalidTokenOutDenom.Wrap("cannot be empty")
+	}
+
+	if msg.PoolShares.Amount.IsNil() || msg.PoolShares.Amount.LTE(sdk.ZeroInt()) {
+		return sdkerrors.Wrapf(errors.ErrInvalidCoins, "invalid pool shares: %s", msg.PoolShares)
+	}
+
+	if msg.TokenOutMinAmount.IsNil() || msg.TokenOutMinAmount.IsNegative() {
+		return sdkerrors.Wrapf(errors.ErrInvalidRequest, "invalid token out min amount: %s", msg.TokenOutMinAmount)
+	}
+
+	return nil
+}
+
 var _ sdk.Msg = &MsgCreatePool{}
 
 func NewMsgCreatePool(creator string, poolAssets []PoolAsset, poolParams *PoolParams) *MsgCreatePool {
@@ -203,7 +448,7 @@ func (msg *MsgCreatePool) ValidateBasic() error {
 		return ErrInvalidExitFee.Wrapf("invalid exit fee: %s", msg.PoolParams.ExitFee)
 	}
 
-	if (msg.PoolParams.PoolType != PoolType_STABLESWAP) && (msg.PoolParams.PoolType != PoolType_BALANCER) {
+	if _, ok := PoolType_name[int32(msg.PoolParams.PoolType)]; !ok {
 		return ErrInvalidPoolType
 	}
 
@@ -217,5 +462,227 @@ func (msg *MsgCreatePool) ValidateBasic() error {
 		}
 	}
 
+	if msg.PoolParams.ARamp != nil {
+		return ErrInvalidAmplificationRamp.Wrap("a new pool cannot have an amplification ramp")
+	}
+
+	if msg.PoolParams.PoolType == PoolType_CONCENTRATED {
+		if msg.PoolParams.TickSpacing == 0 {
+			return ErrInvalidTickRange.Wrap("tick spacing must be positive")
+		}
+
+		if msg.PoolParams.SwapFee.Equal(sdk.OneDec()) {
+			return ErrInvalidSwapFee.Wrapf("invalid swap fee: %s", msg.PoolParams.SwapFee)
+		}
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgCreatePosition{}
+
+func NewMsgCreatePosition(
+	sender string, poolId uint64, lowerTick int64, upperTick int64, tokensDesired sdk.Coins,
+) *MsgCreatePosition {
+	return &MsgCreatePosition{
+		Sender:        sender,
+		PoolId:        poolId,
+		LowerTick:     lowerTick,
+		UpperTick:     upperTick,
+		TokensDesired: tokensDesired,
+	}
+}
+
+func (msg *MsgCreatePosition) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreatePosition) Type() string {
+	return TypeMsgCreatePosition
+}
+
+func (msg *MsgCreatePosition) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreatePosition) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreatePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if msg.PoolId == 0 {
+		return ErrInvalidPoolId.Wrapf("pool id cannot be %d", msg.PoolId)
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return ErrInvalidTickRange.Wrapf("lower tick %d must be lower than upper tick %d", msg.LowerTick, msg.UpperTick)
+	}
+
+	if msg.TokensDesired.Empty() || !msg.TokensDesired.IsValid() {
+		return sdkerrors.Wrapf(errors.ErrInvalidCoins, "invalid tokens desired: %s", msg.TokensDesired)
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgWithdrawPosition{}
+
+func NewMsgWithdrawPosition(sender string, positionId uint64, liquidity sdk.Dec) *MsgWithdrawPosition {
+	return &MsgWithdrawPosition{
+		Sender:     sender,
+		PositionId: positionId,
+		Liquidity:  liquidity,
+	}
+}
+
+func (msg *MsgWithdrawPosition) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWithdrawPosition) Type() string {
+	return TypeMsgWithdrawPosition
+}
+
+func (msg *MsgWithdrawPosition) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgWithdrawPosition) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgWithdrawPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+
+	if msg.PositionId == 0 {
+		return ErrPositionNotFound.Wrapf("position id cannot be %d", msg.PositionId)
+	}
+
+	if msg.Liquidity.IsNil() || msg.Liquidity.IsNegative() {
+		return ErrInvalidLiquidity.Wrapf("invalid liquidity: %s", msg.Liquidity)
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (msg *MsgUpdateParams) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateParams) Type() string {
+	return TypeMsgUpdateParams
+}
+
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+var _ sdk.Msg = &MsgUpdatePoolParams{}
+
+func NewMsgUpdatePoolParams(
+	authority string, poolId uint64, swapFee sdk.Dec, exitFee sdk.Dec, futureA sdkmath.Int, aRampDuration time.Duration,
+) *MsgUpdatePoolParams {
+	return &MsgUpdatePoolParams{
+		Authority:     authority,
+		PoolId:        poolId,
+		SwapFee:       swapFee,
+		ExitFee:       exitFee,
+		FutureA:       futureA,
+		ARampDuration: aRampDuration,
+	}
+}
+
+func (msg *MsgUpdatePoolParams) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdatePoolParams) Type() string {
+	return TypeMsgUpdatePoolParams
+}
+
+func (msg *MsgUpdatePoolParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdatePoolParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdatePoolParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if msg.PoolId == 0 {
+		return ErrInvalidPoolId.Wrapf("pool id cannot be %d", msg.PoolId)
+	}
+
+	if msg.SwapFee.IsNil() || msg.SwapFee.LT(sdk.ZeroDec()) || msg.SwapFee.GT(sdk.OneDec()) {
+		return ErrInvalidSwapFee.Wrapf("invalid swap fee: %s", msg.SwapFee)
+	}
+
+	if msg.ExitFee.IsNil() || msg.ExitFee.LT(sdk.ZeroDec()) || msg.ExitFee.GT(sdk.OneDec()) {
+		return ErrInvalidExitFee.Wrapf("invalid exit fee: %s", msg.ExitFee)
+	}
+
+	if !msg.FutureA.IsNil() && msg.FutureA.IsNegative() {
+		return ErrAmplificationTooLow
+	}
+
+	if msg.ARampDuration < 0 {
+		return ErrInvalidAmplificationRamp.Wrapf("negative ramp duration: %s", msg.ARampDuration)
+	}
+
 	return nil
 }
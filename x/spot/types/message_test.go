@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 
@@ -348,3 +349,395 @@ func TestMsgSwapAssets_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSwapExactAmountInRoute_ValidateBasic(t *testing.T) {
+	routes := []SwapRoute{
+		{PoolId: 1, TokenOutDenom: "bar"},
+		{PoolId: 2, TokenOutDenom: "baz"},
+	}
+
+	tests := []struct {
+		name string
+		msg  *MsgSwapExactAmountInRoute
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  NewMsgSwapExactAmountInRoute("invalid_address", routes, sdk.NewInt64Coin("foo", 1), sdk.OneInt()),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid tokens in",
+			msg:  NewMsgSwapExactAmountInRoute(testutil.AccAddress().String(), routes, sdk.NewInt64Coin("foo", 0), sdk.OneInt()),
+			err:  ErrInvalidTokenIn,
+		},
+		{
+			name: "empty routes",
+			msg:  NewMsgSwapExactAmountInRoute(testutil.AccAddress().String(), nil, sdk.NewInt64Coin("foo", 1), sdk.OneInt()),
+			err:  ErrInvalidSwapRoute,
+		},
+		{
+			name: "too many hops",
+			msg: NewMsgSwapExactAmountInRoute(testutil.AccAddress().String(), []SwapRoute{
+				{PoolId: 1, TokenOutDenom: "a"},
+				{PoolId: 2, TokenOutDenom: "b"},
+				{PoolId: 3, TokenOutDenom: "c"},
+				{PoolId: 4, TokenOutDenom: "d"},
+				{PoolId: 5, TokenOutDenom: "e"},
+			}, sdk.NewInt64Coin("foo", 1), sdk.OneInt()),
+			err: ErrInvalidSwapRoute,
+		},
+		{
+			name: "repeated pool",
+			msg: NewMsgSwapExactAmountInRoute(testutil.AccAddress().String(), []SwapRoute{
+				{PoolId: 1, TokenOutDenom: "bar"},
+				{PoolId: 1, TokenOutDenom: "foo"},
+			}, sdk.NewInt64Coin("foo", 1), sdk.OneInt()),
+			err: ErrInvalidSwapRoute,
+		},
+		{
+			name: "hop swapping a denom into itself",
+			msg: NewMsgSwapExactAmountInRoute(testutil.AccAddress().String(), []SwapRoute{
+				{PoolId: 1, TokenOutDenom: "foo"},
+			}, sdk.NewInt64Coin("foo", 1), sdk.OneInt()),
+			err: ErrSameTokenDenom,
+		},
+		{
+			name: "valid message",
+			msg:  NewMsgSwapExactAmountInRoute(testutil.AccAddress().String(), routes, sdk.NewInt64Coin("foo", 1), sdk.OneInt()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSwapExactAmountOutRoute_ValidateBasic(t *testing.T) {
+	routes := []SwapRoute{
+		{PoolId: 1, TokenOutDenom: "bar"},
+		{PoolId: 2, TokenOutDenom: "baz"},
+	}
+
+	tests := []struct {
+		name string
+		msg  *MsgSwapExactAmountOutRoute
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  NewMsgSwapExactAmountOutRoute("invalid_address", routes, "foo", sdk.OneInt(), sdk.NewInt64Coin("baz", 1)),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "route does not end with the token out",
+			msg:  NewMsgSwapExactAmountOutRoute(testutil.AccAddress().String(), routes[:1], "foo", sdk.OneInt(), sdk.NewInt64Coin("baz", 1)),
+			err:  ErrInvalidSwapRoute,
+		},
+		{
+			name: "zero pool id",
+			msg: NewMsgSwapExactAmountOutRoute(testutil.AccAddress().String(), []SwapRoute{
+				{PoolId: 0, TokenOutDenom: "baz"},
+			}, "foo", sdk.OneInt(), sdk.NewInt64Coin("baz", 1)),
+			err: ErrInvalidPoolId,
+		},
+		{
+			name: "valid message",
+			msg:  NewMsgSwapExactAmountOutRoute(testutil.AccAddress().String(), routes, "foo", sdk.OneInt(), sdk.NewInt64Coin("baz", 1)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgJoinSwapExternAmountIn_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgJoinSwapExternAmountIn
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  NewMsgJoinSwapExternAmountIn("invalid_address", 1, sdk.NewInt64Coin("foo", 1), sdk.OneInt()),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid pool id",
+			msg:  NewMsgJoinSwapExternAmountIn(testutil.AccAddress().String(), 0, sdk.NewInt64Coin("foo", 1), sdk.OneInt()),
+			err:  ErrInvalidPoolId,
+		},
+		{
+			name: "invalid tokens in",
+			msg:  NewMsgJoinSwapExternAmountIn(testutil.AccAddress().String(), 1, sdk.NewInt64Coin("foo", 0), sdk.OneInt()),
+			err:  ErrInvalidTokenIn,
+		},
+		{
+			name: "negative share out min amount",
+			msg:  NewMsgJoinSwapExternAmountIn(testutil.AccAddress().String(), 1, sdk.NewInt64Coin("foo", 1), sdk.NewInt(-1)),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid message",
+			msg:  NewMsgJoinSwapExternAmountIn(testutil.AccAddress().String(), 1, sdk.NewInt64Coin("foo", 1), sdk.ZeroInt()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgExitSwapShareAmountIn_ValidateBasic(t *testing.T) {
+	poolShares := sdk.NewInt64Coin("nibiru/pool/1", 100)
+
+	tests := []struct {
+		name string
+		msg  *MsgExitSwapShareAmountIn
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  NewMsgExitSwapShareAmountIn("invalid_address", 1, poolShares, "foo", sdk.OneInt()),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid pool id",
+			msg:  NewMsgExitSwapShareAmountIn(testutil.AccAddress().String(), 0, poolShares, "foo", sdk.OneInt()),
+			err:  ErrInvalidPoolId,
+		},
+		{
+			name: "invalid token out denom",
+			msg:  NewMsgExitSwapShareAmountIn(testutil.AccAddress().String(), 1, poolShares, "", sdk.OneInt()),
+			err:  ErrInvalidTokenOutDenom,
+		},
+		{
+			name: "zero pool shares",
+			msg:  NewMsgExitSwapShareAmountIn(testutil.AccAddress().String(), 1, sdk.NewInt64Coin("nibiru/pool/1", 0), "foo", sdk.OneInt()),
+			err:  sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid message",
+			msg:  NewMsgExitSwapShareAmountIn(testutil.AccAddress().String(), 1, poolShares, "foo", sdk.OneInt()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCreatePosition_ValidateBasic(t *testing.T) {
+	tokens := sdk.NewCoins(sdk.NewInt64Coin("foo", 1), sdk.NewInt64Coin("bar", 1))
+
+	tests := []struct {
+		name string
+		msg  *MsgCreatePosition
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  NewMsgCreatePosition("invalid_address", 1, -10, 10, tokens),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid pool id",
+			msg:  NewMsgCreatePosition(testutil.AccAddress().String(), 0, -10, 10, tokens),
+			err:  ErrInvalidPoolId,
+		},
+		{
+			name: "lower tick equal to upper tick",
+			msg:  NewMsgCreatePosition(testutil.AccAddress().String(), 1, 10, 10, tokens),
+			err:  ErrInvalidTickRange,
+		},
+		{
+			name: "lower tick above upper tick",
+			msg:  NewMsgCreatePosition(testutil.AccAddress().String(), 1, 20, 10, tokens),
+			err:  ErrInvalidTickRange,
+		},
+		{
+			name: "no tokens desired",
+			msg:  NewMsgCreatePosition(testutil.AccAddress().String(), 1, -10, 10, sdk.NewCoins()),
+			err:  sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "valid message",
+			msg:  NewMsgCreatePosition(testutil.AccAddress().String(), 1, -10, 10, tokens),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgWithdrawPosition_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgWithdrawPosition
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  NewMsgWithdrawPosition("invalid_address", 1, sdk.OneDec()),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid position id",
+			msg:  NewMsgWithdrawPosition(testutil.AccAddress().String(), 0, sdk.OneDec()),
+			err:  ErrPositionNotFound,
+		},
+		{
+			name: "negative liquidity",
+			msg:  NewMsgWithdrawPosition(testutil.AccAddress().String(), 1, sdk.NewDec(-1)),
+			err:  ErrInvalidLiquidity,
+		},
+		{
+			name: "only collect fees",
+			msg:  NewMsgWithdrawPosition(testutil.AccAddress().String(), 1, sdk.ZeroDec()),
+		},
+		{
+			name: "valid message",
+			msg:  NewMsgWithdrawPosition(testutil.AccAddress().String(), 1, sdk.OneDec()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgUpdateParams
+		err  string
+	}{
+		{
+			name: "invalid authority",
+			msg:  NewMsgUpdateParams("invalid_address", DefaultParams()),
+			err:  "invalid authority address",
+		},
+		{
+			name: "invalid whitelisted asset",
+			msg:  NewMsgUpdateParams(testutil.AccAddress().String(), NewParams(1, sdk.NewCoins(), []string{"1nvalid"})),
+			err:  "invalid whitelisted asset",
+		},
+		{
+			name: "duplicate whitelisted asset",
+			msg:  NewMsgUpdateParams(testutil.AccAddress().String(), NewParams(1, sdk.NewCoins(), []string{"unibi", "unibi"})),
+			err:  "duplicate whitelisted asset",
+		},
+		{
+			name: "valid message",
+			msg:  NewMsgUpdateParams(testutil.AccAddress().String(), DefaultParams()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdatePoolParams_ValidateBasic(t *testing.T) {
+	authority := testutil.AccAddress().String()
+	fee := sdk.NewDecWithPrec(3, 3)
+
+	tests := []struct {
+		name string
+		msg  *MsgUpdatePoolParams
+		err  error
+	}{
+		{
+			name: "invalid authority",
+			msg:  NewMsgUpdatePoolParams("invalid_address", 1, fee, fee, sdk.ZeroInt(), 0),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid pool id",
+			msg:  NewMsgUpdatePoolParams(authority, 0, fee, fee, sdk.ZeroInt(), 0),
+			err:  ErrInvalidPoolId,
+		},
+		{
+			name: "swap fee above one",
+			msg:  NewMsgUpdatePoolParams(authority, 1, sdk.NewDec(2), fee, sdk.ZeroInt(), 0),
+			err:  ErrInvalidSwapFee,
+		},
+		{
+			name: "negative exit fee",
+			msg:  NewMsgUpdatePoolParams(authority, 1, fee, sdk.NewDec(-1), sdk.ZeroInt(), 0),
+			err:  ErrInvalidExitFee,
+		},
+		{
+			name: "negative amplification",
+			msg:  NewMsgUpdatePoolParams(authority, 1, fee, fee, sdk.NewInt(-1), 24*time.Hour),
+			err:  ErrAmplificationTooLow,
+		},
+		{
+			name: "negative ramp duration",
+			msg:  NewMsgUpdatePoolParams(authority, 1, fee, fee, sdk.NewInt(100), -time.Hour),
+			err:  ErrInvalidAmplificationRamp,
+		},
+		{
+			name: "only fees",
+			msg:  NewMsgUpdatePoolParams(authority, 1, fee, fee, sdk.Int{}, 0),
+		},
+		{
+			name: "valid message",
+			msg:  NewMsgUpdatePoolParams(authority, 1, fee, fee, sdk.NewInt(100), 24*time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -13,6 +13,13 @@ import (
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// Parameter keys added after the launch of the module, set by the store
+// migrations.
+var (
+	KeyProtocolFeeShare     = []byte("ProtocolFeeShare")
+	KeyProtocolFeeRecipient = []byte("ProtocolFeeRecipient")
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -24,6 +31,7 @@ func NewParams(startingPoolNumber uint64, poolCreationFee sdk.Coins, whitelisted
 		StartingPoolNumber: startingPoolNumber,
 		PoolCreationFee:    poolCreationFee,
 		WhitelistedAsset:   whitelistedAssets,
+		ProtocolFeeShare:   sdk.ZeroDec(),
 	}
 }
 
@@ -38,6 +46,8 @@ func DefaultParams() Params {
 			denoms.NUSD,
 			denoms.USDT,
 		},
+		ProtocolFeeShare:     sdk.ZeroDec(),
+		ProtocolFeeRecipient: ProtocolFeeRecipient_TREASURY,
 	}
 }
 
@@ -46,7 +56,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair([]byte("StartingPoolNumber"), &p.StartingPoolNumber, validatePoolNumber),
 		paramtypes.NewParamSetPair([]byte("PoolCreationFee"), &p.PoolCreationFee, validatePoolCreationFee),
-		paramtypes.NewParamSetPair([]byte("WhitelistedAsset"), &p.WhitelistedAsset, func(value interface{}) error { return nil }),
+		paramtypes.NewParamSetPair([]byte("WhitelistedAsset"), &p.WhitelistedAsset, validateWhitelistedAssets),
+		paramtypes.NewParamSetPair(KeyProtocolFeeShare, &p.ProtocolFeeShare, validateProtocolFeeShare),
+		paramtypes.NewParamSetPair(KeyProtocolFeeRecipient, &p.ProtocolFeeRecipient, validateProtocolFeeRecipient),
 	}
 }
 
@@ -72,12 +84,71 @@ func validatePoolCreationFee(i interface{}) error {
 	return nil
 }
 
+func validateWhitelistedAssets(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid whitelisted asset: %w", err)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate whitelisted asset: %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+func validateProtocolFeeShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset share is stored as zero
+	if !v.IsNil() && (v.IsNegative() || v.GT(sdk.OneDec())) {
+		return fmt.Errorf("protocol fee share must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateProtocolFeeRecipient(i interface{}) error {
+	v, ok := i.(ProtocolFeeRecipient)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := ProtocolFeeRecipient_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid protocol fee recipient: %d", v)
+	}
+
+	return nil
+}
+
 // Validate validates the set of params
 func (p Params) Validate() error {
 	if err := validatePoolCreationFee(p.PoolCreationFee); err != nil {
 		return err
 	}
 
+	if err := validateWhitelistedAssets(p.WhitelistedAsset); err != nil {
+		return err
+	}
+
+	if err := validateProtocolFeeShare(p.ProtocolFeeShare); err != nil {
+		return err
+	}
+
+	if err := validateProtocolFeeRecipient(p.ProtocolFeeRecipient); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -26,6 +26,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProtocolFeeRecipient is where the protocol share of the swap fees is sent.
+type ProtocolFeeRecipient int32
+
+const (
+	// the treasury pool module account
+	ProtocolFeeRecipient_TREASURY ProtocolFeeRecipient = 0
+	// the community pool of the distribution module
+	ProtocolFeeRecipient_COMMUNITY_POOL ProtocolFeeRecipient = 1
+)
+
+var ProtocolFeeRecipient_name = map[int32]string{
+	0: "TREASURY",
+	1: "COMMUNITY_POOL",
+}
+
+var ProtocolFeeRecipient_value = map[string]int32{
+	"TREASURY":       0,
+	"COMMUNITY_POOL": 1,
+}
+
+func (x ProtocolFeeRecipient) String() string {
+	return proto.EnumName(ProtocolFeeRecipient_name, int32(x))
+}
+
+func (ProtocolFeeRecipient) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_532c93f2cfe0dc59, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	// The start pool number, i.e. the first pool number that isn't taken yet.
@@ -34,6 +62,12 @@ type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
 	// The assets that can be used to create liquidity pools
 	WhitelistedAsset []string `protobuf:"bytes,3,rep,name=whitelisted_asset,json=whitelistedAsset,proto3" json:"whitelisted_asset,omitempty"`
+	// The fraction of the swap fees of balancer and stableswap pools taken by
+	// the protocol, between 0 and 1. The remainder stays in the pools for the
+	// liquidity providers.
+	ProtocolFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=protocol_fee_share,json=protocolFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_fee_share" yaml:"protocol_fee_share"`
+	// The recipient of the protocol share of the swap fees.
+	ProtocolFeeRecipient ProtocolFeeRecipient `protobuf:"varint,5,opt,name=protocol_fee_recipient,json=protocolFeeRecipient,proto3,enum=nibiru.spot.v1.ProtocolFeeRecipient" json:"protocol_fee_recipient,omitempty" yaml:"protocol_fee_recipient"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -89,36 +123,53 @@ func (m *Params) GetWhitelistedAsset() []string {
 	return nil
 }
 
+func (m *Params) GetProtocolFeeRecipient() ProtocolFeeRecipient {
+	if m != nil {
+		return m.ProtocolFeeRecipient
+	}
+	return ProtocolFeeRecipient_TREASURY
+}
+
 func init() {
+	proto.RegisterEnum("nibiru.spot.v1.ProtocolFeeRecipient", ProtocolFeeRecipient_name, ProtocolFeeRecipient_value)
 	proto.RegisterType((*Params)(nil), "nibiru.spot.v1.Params")
 }
 
 func init() { proto.RegisterFile("nibiru/spot/v1/params.proto", fileDescriptor_532c93f2cfe0dc59) }
 
 var fileDescriptor_532c93f2cfe0dc59 = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x3f, 0x4f, 0xc2, 0x40,
-	0x18, 0xc6, 0x5b, 0x20, 0x24, 0xd6, 0x44, 0xa5, 0x61, 0x28, 0x98, 0xb4, 0x84, 0xa9, 0xd1, 0xd8,
-	0xb3, 0xba, 0xb1, 0x09, 0xc6, 0xc9, 0x10, 0xc2, 0xe8, 0xd2, 0x5c, 0xcb, 0x59, 0x2e, 0xb6, 0x7d,
-	0x9b, 0xde, 0x81, 0x32, 0xfb, 0x05, 0x4c, 0x5c, 0x1c, 0x9d, 0xfd, 0x24, 0x8c, 0x8c, 0x4e, 0x68,
-	0xe0, 0x1b, 0xf8, 0x09, 0x4c, 0xef, 0xce, 0x84, 0xc4, 0xe9, 0xfe, 0xfc, 0xde, 0xe7, 0x7d, 0x9f,
-	0xbc, 0x8f, 0x71, 0x9c, 0xd1, 0x90, 0x16, 0x33, 0xc4, 0x72, 0xe0, 0x68, 0xee, 0xa3, 0x1c, 0x17,
-	0x38, 0x65, 0x5e, 0x5e, 0x00, 0x07, 0xf3, 0x40, 0x42, 0xaf, 0x84, 0xde, 0xdc, 0x6f, 0x37, 0x63,
-	0x88, 0x41, 0x20, 0x54, 0xde, 0x64, 0x55, 0xdb, 0x8e, 0x80, 0xa5, 0xc0, 0x50, 0x88, 0x19, 0x41,
-	0x73, 0x3f, 0x24, 0x1c, 0xfb, 0x28, 0x02, 0x9a, 0x29, 0xde, 0x92, 0x3c, 0x90, 0x42, 0xf9, 0x90,
-	0xa8, 0xfb, 0x5c, 0x31, 0xea, 0x23, 0x31, 0xd1, 0x3c, 0x37, 0x9a, 0x8c, 0xe3, 0x82, 0xd3, 0x2c,
-	0x0e, 0x72, 0x80, 0x24, 0xc8, 0x66, 0x69, 0x48, 0x0a, 0x4b, 0xef, 0xe8, 0x6e, 0x6d, 0x6c, 0xfe,
-	0xb1, 0x11, 0x40, 0x32, 0x14, 0xc4, 0x7c, 0xd5, 0x8d, 0x86, 0xa8, 0x8c, 0x0a, 0x82, 0x39, 0x85,
-	0x2c, 0xb8, 0x27, 0xc4, 0xaa, 0x74, 0xaa, 0xee, 0xfe, 0x45, 0xcb, 0x53, 0x73, 0x4a, 0x53, 0x9e,
-	0x32, 0xe5, 0x0d, 0x80, 0x66, 0xfd, 0xdb, 0xe5, 0xda, 0xd1, 0x7e, 0xd6, 0x8e, 0xb5, 0xc0, 0x69,
-	0xd2, 0xeb, 0xfe, 0xeb, 0xd0, 0xfd, 0xf8, 0x72, 0xdc, 0x98, 0xf2, 0xe9, 0x2c, 0xf4, 0x22, 0x48,
-	0x95, 0x61, 0x75, 0x9c, 0xb1, 0xc9, 0x03, 0xe2, 0x8b, 0x9c, 0x30, 0xd1, 0x8c, 0x8d, 0x0f, 0x4b,
-	0xfd, 0x40, 0xc9, 0x6f, 0x08, 0x31, 0x4f, 0x8d, 0xc6, 0xe3, 0x94, 0x72, 0x92, 0x50, 0xc6, 0xc9,
-	0x24, 0xc0, 0x8c, 0x11, 0x6e, 0x55, 0x3b, 0x55, 0x77, 0x6f, 0x7c, 0xb4, 0x03, 0xae, 0xca, 0xff,
-	0x5e, 0xed, 0xed, 0xdd, 0xd1, 0xfa, 0xd7, 0xcb, 0x8d, 0xad, 0xaf, 0x36, 0xb6, 0xfe, 0xbd, 0xb1,
-	0xf5, 0x97, 0xad, 0xad, 0xad, 0xb6, 0xb6, 0xf6, 0xb9, 0xb5, 0xb5, 0xbb, 0x93, 0x1d, 0x1f, 0x43,
-	0x91, 0xc5, 0x60, 0x8a, 0x69, 0x86, 0x54, 0x68, 0x4f, 0x32, 0x36, 0xe1, 0x27, 0xac, 0x8b, 0x95,
-	0x5e, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xe7, 0xf4, 0x89, 0x01, 0xd2, 0x01, 0x00, 0x00,
+	// 492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x6d, 0x12, 0x2a, 0x7a, 0xa0, 0x90, 0x9e, 0x22, 0xe4, 0x14, 0x61, 0x07, 0x0b, 0x21,
+	0xab, 0x08, 0x9b, 0x94, 0x05, 0x75, 0x6b, 0x52, 0x2a, 0x21, 0xda, 0x24, 0x72, 0xdb, 0xa1, 0x2c,
+	0xd6, 0xd9, 0x7d, 0x24, 0x27, 0x6c, 0x9f, 0xe5, 0xbb, 0x04, 0x32, 0xf0, 0x1d, 0x90, 0x58, 0x18,
+	0x99, 0xf9, 0x24, 0x1d, 0x3b, 0x22, 0x06, 0x83, 0x92, 0x6f, 0x90, 0x1d, 0x09, 0xf9, 0xec, 0x40,
+	0xab, 0x66, 0x60, 0xb2, 0xfd, 0x7e, 0xef, 0xfd, 0xff, 0xff, 0x7b, 0x3e, 0x74, 0x3f, 0xa6, 0x3e,
+	0x4d, 0xc7, 0x0e, 0x4f, 0x98, 0x70, 0x26, 0x6d, 0x27, 0x21, 0x29, 0x89, 0xb8, 0x9d, 0xa4, 0x4c,
+	0x30, 0x5c, 0x2b, 0xa0, 0x9d, 0x43, 0x7b, 0xd2, 0xde, 0x6c, 0x0c, 0xd9, 0x90, 0x49, 0xe4, 0xe4,
+	0x6f, 0x45, 0xd7, 0xa6, 0x1e, 0x30, 0x1e, 0x31, 0xee, 0xf8, 0x84, 0x83, 0x33, 0x69, 0xfb, 0x20,
+	0x48, 0xdb, 0x09, 0x18, 0x8d, 0x4b, 0xde, 0x2c, 0xb8, 0x57, 0x0c, 0x16, 0x1f, 0x05, 0x32, 0x7f,
+	0x57, 0xd0, 0xda, 0x40, 0x3a, 0xe2, 0x67, 0xa8, 0xc1, 0x05, 0x49, 0x05, 0x8d, 0x87, 0x5e, 0xc2,
+	0x58, 0xe8, 0xc5, 0xe3, 0xc8, 0x87, 0x54, 0x53, 0x5b, 0xaa, 0x55, 0x75, 0xf1, 0x92, 0x0d, 0x18,
+	0x0b, 0x7b, 0x92, 0xe0, 0xcf, 0x2a, 0xda, 0x90, 0x9d, 0x41, 0x0a, 0x44, 0x50, 0x16, 0x7b, 0x6f,
+	0x01, 0xb4, 0x1b, 0xad, 0x8a, 0x75, 0x7b, 0xbb, 0x69, 0x97, 0x3e, 0x79, 0x28, 0xbb, 0x0c, 0x65,
+	0x77, 0x19, 0x8d, 0x3b, 0x07, 0xe7, 0x99, 0xa1, 0x2c, 0x32, 0x43, 0x9b, 0x92, 0x28, 0xdc, 0x31,
+	0xaf, 0x29, 0x98, 0xdf, 0x7e, 0x1a, 0xd6, 0x90, 0x8a, 0xd1, 0xd8, 0xb7, 0x03, 0x16, 0x95, 0x81,
+	0xcb, 0xc7, 0x53, 0x7e, 0xf6, 0xce, 0x11, 0xd3, 0x04, 0xb8, 0x14, 0xe3, 0xee, 0xdd, 0x7c, 0xbe,
+	0x5b, 0x8e, 0xef, 0x03, 0xe0, 0x27, 0x68, 0xe3, 0xfd, 0x88, 0x0a, 0x08, 0x29, 0x17, 0x70, 0xe6,
+	0x11, 0xce, 0x41, 0x68, 0x95, 0x56, 0xc5, 0x5a, 0x77, 0xeb, 0x97, 0xc0, 0x6e, 0x5e, 0xc7, 0x53,
+	0x84, 0xe5, 0x22, 0x02, 0x16, 0xe6, 0xd6, 0x1e, 0x1f, 0x91, 0x14, 0xb4, 0x6a, 0x4b, 0xb5, 0xd6,
+	0x3b, 0xaf, 0xf3, 0x9c, 0x3f, 0x32, 0xe3, 0xf1, 0x7f, 0x64, 0xd9, 0x83, 0x60, 0x91, 0x19, 0xcd,
+	0xf2, 0x44, 0xd7, 0x14, 0x4d, 0xb7, 0xbe, 0x2c, 0xee, 0x03, 0x1c, 0xe5, 0x25, 0xfc, 0x11, 0xdd,
+	0xbb, 0xd2, 0x98, 0x42, 0x40, 0x13, 0x0a, 0xb1, 0xd0, 0x6e, 0xb6, 0x54, 0xab, 0xb6, 0xfd, 0xc8,
+	0xbe, 0xfa, 0xf3, 0xed, 0xc1, 0x3f, 0x05, 0x77, 0xd9, 0xdb, 0x79, 0xb8, 0xc8, 0x8c, 0x07, 0x2b,
+	0x6c, 0xff, 0xaa, 0x99, 0x6e, 0x23, 0x59, 0x31, 0xb8, 0x53, 0xfd, 0xf2, 0xd5, 0x50, 0xb6, 0x5e,
+	0xa0, 0xc6, 0x2a, 0x59, 0x7c, 0x07, 0xdd, 0x3a, 0x76, 0x5f, 0xee, 0x1e, 0x9d, 0xb8, 0xa7, 0x75,
+	0x05, 0x63, 0x54, 0xeb, 0xf6, 0x0f, 0x0f, 0x4f, 0x7a, 0xaf, 0x8e, 0x4f, 0xbd, 0x41, 0xbf, 0x7f,
+	0x50, 0x57, 0x3b, 0x7b, 0xe7, 0x33, 0x5d, 0xbd, 0x98, 0xe9, 0xea, 0xaf, 0x99, 0xae, 0x7e, 0x9a,
+	0xeb, 0xca, 0xc5, 0x5c, 0x57, 0xbe, 0xcf, 0x75, 0xe5, 0xcd, 0xd6, 0xa5, 0x7d, 0xf5, 0xe4, 0x11,
+	0xba, 0x23, 0x42, 0x63, 0xa7, 0xbc, 0xe8, 0x1f, 0x8a, 0xab, 0x2e, 0xf7, 0xe6, 0xaf, 0xc9, 0x6c,
+	0xcf, 0xff, 0x0c, 0x00, 0x3e, 0x71, 0x7a, 0x8a, 0x06, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -141,6 +192,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProtocolFeeRecipient != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ProtocolFeeRecipient))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.ProtocolFeeShare.Size()
+		i -= size
+		if _, err := m.ProtocolFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.WhitelistedAsset) > 0 {
 		for iNdEx := len(m.WhitelistedAsset) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.WhitelistedAsset[iNdEx])
@@ -204,6 +270,11 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = m.ProtocolFeeShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ProtocolFeeRecipient != 0 {
+		n += 1 + sovParams(uint64(m.ProtocolFeeRecipient))
+	}
 	return n
 }
 
@@ -327,6 +398,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.WhitelistedAsset = append(m.WhitelistedAsset, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeRecipient", wireType)
+			}
+			m.ProtocolFeeRecipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolFeeRecipient |= ProtocolFeeRecipient(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -81,6 +81,12 @@ func NewPool(
 		return Pool{}, err
 	}
 
+	if poolParams.PoolType == PoolType_CONCENTRATED {
+		if err = pool.initConcentratedLiquidity(); err != nil {
+			return Pool{}, err
+		}
+	}
+
 	return pool, nil
 }
 
@@ -119,20 +125,30 @@ ret:
 func (pool *Pool) AddTokensToPool(tokensIn sdk.Coins) (
 	numShares sdkmath.Int, remCoins sdk.Coins, err error,
 ) {
-	if pool.TotalShares.Amount.IsZero() {
-		// Mint the initial 100.000000000000000000 pool share tokens to the sender
-		numShares = InitPoolSharesSupply
-		remCoins = sdk.Coins{}
-	} else if pool.PoolParams.PoolType == PoolType_STABLESWAP {
-		numShares, err = pool.numSharesOutFromTokensInStableSwap(tokensIn)
-		remCoins = sdk.Coins{}
-	} else {
-		numShares, remCoins, err = pool.numSharesOutFromTokensIn(tokensIn)
-	}
-	if err != nil {
-		return sdk.ZeroInt(), sdk.Coins{}, err
-	}
+	return pool.impl().AddTokensToPool(tokensIn)
+}
+
+/*
+Mints the initial pool shares for the first deposit into the pool.
+*/
+func (pool *Pool) addInitialTokens(tokensIn sdk.Coins) (
+	numShares sdkmath.Int, remCoins sdk.Coins, err error,
+) {
+	// Mint the initial 100.000000000000000000 pool share tokens to the sender
+	return pool.addTokens(InitPoolSharesSupply, tokensIn, sdk.Coins{})
+}
 
+/*
+Adds the tokens used from tokensIn to the pool balances and mints numShares.
+
+args:
+  - numShares: the number of LP shares given to the user for the deposit
+  - tokensIn: the tokens deposited
+  - remCoins: the tokens of tokensIn not used by the deposit
+*/
+func (pool *Pool) addTokens(numShares sdkmath.Int, tokensIn sdk.Coins, remCoins sdk.Coins) (
+	sdkmath.Int, sdk.Coins, error,
+) {
 	tokensIn.Sort()
 	if err := pool.incrementBalances(numShares, tokensIn.Sub(remCoins...)); err != nil {
 		return sdk.ZeroInt(), sdk.Coins{}, err
@@ -158,30 +174,7 @@ ret:
 func (pool *Pool) AddAllTokensToPool(tokensIn sdk.Coins) (
 	numShares sdkmath.Int, remCoins sdk.Coins, err error,
 ) {
-	if pool.PoolParams.PoolType == PoolType_STABLESWAP {
-		err = ErrInvalidPoolType
-		return
-	}
-
-	remCoins = tokensIn
-	if tokensIn.Len() > 1 {
-		numShares, remCoins, err = pool.AddTokensToPool(tokensIn)
-	} else {
-		numShares = sdk.ZeroInt()
-	}
-
-	if remCoins.Empty() {
-		return
-	}
-
-	numShares2nd, _, err := pool.AddTokensToPool(remCoins)
-	if err != nil {
-		return
-	}
-
-	numShares = numShares2nd.Add(numShares)
-	remCoins = sdk.NewCoins()
-	return
+	return pool.impl().AddAllTokensToPool(tokensIn)
 }
 
 /*
@@ -231,6 +224,94 @@ func (pool *Pool) ExitPool(exitingShares sdkmath.Int) (
 	return exitedCoins, fees, nil
 }
 
+/*
+JoinSwapExternAmountIn Adds a single token to the pool and updates the pool balances.
+For a balancer pool, half of the swap fee is deducted from the token, as half of it
+is implicitly swapped into the other asset. A stableswap pool mints shares following
+the increase of its invariant.
+
+args:
+  - tokenIn: the token to add to the pool
+
+ret:
+  - numShares: the number of LP shares given to the user for the deposit
+  - err: error if any
+*/
+func (pool *Pool) JoinSwapExternAmountIn(tokenIn sdk.Coin) (
+	numShares sdkmath.Int, err error,
+) {
+	if pool.TotalShares.Amount.IsZero() {
+		return sdk.ZeroInt(), ErrInitialDeposit
+	}
+	if !tokenIn.Amount.IsPositive() {
+		return sdk.ZeroInt(), ErrInvalidTokenIn.Wrapf("%s must be positive", tokenIn)
+	}
+	if _, _, err = pool.getPoolAssetAndIndex(tokenIn.Denom); err != nil {
+		return sdk.ZeroInt(), err
+	}
+
+	numShares, _, err = pool.AddTokensToPool(sdk.NewCoins(tokenIn))
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	if !numShares.IsPositive() {
+		return sdk.ZeroInt(), fmt.Errorf("tokenIn (%s) must be higher to join the pool", tokenIn.Denom)
+	}
+
+	return numShares, nil
+}
+
+/*
+ExitSwapShareAmountIn Exits the pool into a single token and updates the pool balances.
+The pool shares are first exited into all the assets of the pool, then the assets
+other than tokenOutDenom are swapped into tokenOutDenom, paying the swap fee.
+
+args:
+  - exitingShares: the number of pool shares to exit from the pool
+  - tokenOutDenom: the denom of the token to withdraw
+
+ret:
+  - tokenOut: the tokens withdrawn from the pool
+  - fees: the exit and swap fees collected
+  - err: error if any
+*/
+func (pool *Pool) ExitSwapShareAmountIn(exitingShares sdkmath.Int, tokenOutDenom string) (
+	tokenOut sdk.Coin, fees sdk.Coins, err error,
+) {
+	if _, _, err = pool.getPoolAssetAndIndex(tokenOutDenom); err != nil {
+		return sdk.Coin{}, sdk.Coins{}, err
+	}
+	if exitingShares.GTE(pool.TotalShares.Amount) {
+		// the other assets need liquidity left in the pool to be swapped
+		return sdk.Coin{}, sdk.Coins{}, ErrNotEnoughLiquidity.Wrap("cannot exit all the pool shares into a single token")
+	}
+
+	exitedCoins, fees, err := pool.ExitPool(exitingShares)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coins{}, err
+	}
+
+	tokenOut = sdk.NewCoin(tokenOutDenom, exitedCoins.AmountOf(tokenOutDenom))
+	for _, coin := range exitedCoins {
+		if coin.Denom == tokenOutDenom {
+			continue
+		}
+
+		swapOut, swapFee, err := pool.CalcOutAmtGivenIn(coin, tokenOutDenom, false)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coins{}, err
+		}
+		if err = pool.ApplySwap(coin, swapOut); err != nil {
+			return sdk.Coin{}, sdk.Coins{}, err
+		}
+
+		tokenOut = tokenOut.Add(swapOut)
+		fees = fees.Add(swapFee)
+	}
+
+	return tokenOut, fees, nil
+}
+
 /*
 Updates the pool's asset liquidity using the provided tokens.
 
@@ -301,7 +382,7 @@ func (pool Pool) GetD(poolAssets []PoolAsset) (*uint256.Int, error) {
 
 	S := new(uint256.Int)
 
-	Amp := uint256.NewInt(uint64(pool.PoolParams.A.Int64()))
+	Amp := pool.getA()
 
 	Ann := new(uint256.Int)
 
@@ -367,7 +448,9 @@ func (pool Pool) GetD(poolAssets []PoolAsset) (*uint256.Int, error) {
 	return D, nil
 }
 
-// getA returns the amplification factor of the pool
+// getA returns the amplification factor of the pool. During a ramp of A, the
+// keeper interpolates it at the block time when fetching the pool, see
+// UpdateAmplification.
 func (pool Pool) getA() (Amp *uint256.Int) {
 	Amp = uint256.NewInt(uint64(pool.PoolParams.A.Int64()))
 	return
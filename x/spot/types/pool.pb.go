@@ -30,21 +30,32 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // the weighs introduced by Balancer.
 // - `stableswap`: Stableswap pools are defined by a combination of
 // constant-product and constant-sum pool
+// - `concentrated`: Concentrated liquidity pools are constant-product pools
+// whose liquidity is provided over price ranges by positions
+// - `weighted`: Weighted pools are balancer pools whose swaps and single asset
+// joins follow the weights of the assets. Balancer pools swap as xy=k
+// regardless of their weights.
 type PoolType int32
 
 const (
-	PoolType_BALANCER   PoolType = 0
-	PoolType_STABLESWAP PoolType = 1
+	PoolType_BALANCER     PoolType = 0
+	PoolType_STABLESWAP   PoolType = 1
+	PoolType_CONCENTRATED PoolType = 2
+	PoolType_WEIGHTED     PoolType = 3
 )
 
 var PoolType_name = map[int32]string{
 	0: "BALANCER",
 	1: "STABLESWAP",
+	2: "CONCENTRATED",
+	3: "WEIGHTED",
 }
 
 var PoolType_value = map[string]int32{
-	"BALANCER":   0,
-	"STABLESWAP": 1,
+	"BALANCER":     0,
+	"STABLESWAP":   1,
+	"CONCENTRATED": 2,
+	"WEIGHTED":     3,
 }
 
 func (x PoolType) String() string {
@@ -66,6 +77,13 @@ type PoolParams struct {
 	// pool_type is set to 1 (stableswap)
 	A        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=A,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"A" yaml:"amplification"`
 	PoolType PoolType                               `protobuf:"varint,4,opt,name=pool_type,json=poolType,proto3,enum=nibiru.spot.v1.PoolType" json:"pool_type,omitempty" yaml:"pool_type"`
+	// Number of ticks between two initialized ticks of the pool. Positions can
+	// only start and end on multiples of the tick spacing. This is only used if
+	// the pool_type is set to 2 (concentrated)
+	TickSpacing uint64 `protobuf:"varint,5,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty" yaml:"tick_spacing"`
+	// Gradual change of the amplification parameter of a stableswap pool. While
+	// a ramp is set, A is interpolated between its initial and future values.
+	ARamp *AmplificationRamp `protobuf:"bytes,6,opt,name=a_ramp,json=aRamp,proto3" json:"a_ramp,omitempty" yaml:"a_ramp"`
 }
 
 func (m *PoolParams) Reset()         { *m = PoolParams{} }
@@ -108,6 +126,80 @@ func (m *PoolParams) GetPoolType() PoolType {
 	return PoolType_BALANCER
 }
 
+func (m *PoolParams) GetTickSpacing() uint64 {
+	if m != nil {
+		return m.TickSpacing
+	}
+	return 0
+}
+
+func (m *PoolParams) GetARamp() *AmplificationRamp {
+	if m != nil {
+		return m.ARamp
+	}
+	return nil
+}
+
+// Linear change of the amplification parameter of a stableswap pool over a
+// time window.
+type AmplificationRamp struct {
+	// The amplification parameter at the start of the ramp.
+	InitialA github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=initial_a,json=initialA,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_a" yaml:"initial_a"`
+	// The amplification parameter at the end of the ramp.
+	FutureA github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=future_a,json=futureA,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"future_a" yaml:"future_a"`
+	// The block time of the start of the ramp in milliseconds.
+	InitialTimeMs int64 `protobuf:"varint,3,opt,name=initial_time_ms,json=initialTimeMs,proto3" json:"initial_time_ms,omitempty" yaml:"initial_time_ms"`
+	// The block time of the end of the ramp in milliseconds.
+	FutureTimeMs int64 `protobuf:"varint,4,opt,name=future_time_ms,json=futureTimeMs,proto3" json:"future_time_ms,omitempty" yaml:"future_time_ms"`
+}
+
+func (m *AmplificationRamp) Reset()         { *m = AmplificationRamp{} }
+func (m *AmplificationRamp) String() string { return proto.CompactTextString(m) }
+func (*AmplificationRamp) ProtoMessage()    {}
+func (*AmplificationRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0eee5bfc2c3a2b, []int{1}
+}
+func (m *AmplificationRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AmplificationRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AmplificationRamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AmplificationRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AmplificationRamp.Merge(m, src)
+}
+func (m *AmplificationRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *AmplificationRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_AmplificationRamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AmplificationRamp proto.InternalMessageInfo
+
+func (m *AmplificationRamp) GetInitialTimeMs() int64 {
+	if m != nil {
+		return m.InitialTimeMs
+	}
+	return 0
+}
+
+func (m *AmplificationRamp) GetFutureTimeMs() int64 {
+	if m != nil {
+		return m.FutureTimeMs
+	}
+	return 0
+}
+
 // Which assets the pool contains.
 type PoolAsset struct {
 	// Coins we are talking about,
@@ -121,7 +213,7 @@ func (m *PoolAsset) Reset()         { *m = PoolAsset{} }
 func (m *PoolAsset) String() string { return proto.CompactTextString(m) }
 func (*PoolAsset) ProtoMessage()    {}
 func (*PoolAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0eee5bfc2c3a2b, []int{1}
+	return fileDescriptor_cf0eee5bfc2c3a2b, []int{2}
 }
 func (m *PoolAsset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -171,13 +263,15 @@ type Pool struct {
 	TotalWeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_weight" yaml:"total_weight"`
 	// sum of all LP tokens sent out
 	TotalShares types.Coin `protobuf:"bytes,6,opt,name=total_shares,json=totalShares,proto3" json:"total_shares" yaml:"total_shares"`
+	// State of a concentrated liquidity pool, nil for the other pool types.
+	ConcentratedLiquidity *ConcentratedLiquidity `protobuf:"bytes,7,opt,name=concentrated_liquidity,json=concentratedLiquidity,proto3" json:"concentrated_liquidity,omitempty" yaml:"concentrated_liquidity"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf0eee5bfc2c3a2b, []int{2}
+	return fileDescriptor_cf0eee5bfc2c3a2b, []int{3}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,56 +300,448 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// ConcentratedLiquidity is the state of a concentrated liquidity pool. Token0
+// and token1 are the first and second pool assets sorted by denom, and the
+// price is the amount of token1 per token0.
+type ConcentratedLiquidity struct {
+	// Square root of the current price.
+	SqrtPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=sqrt_price,json=sqrtPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sqrt_price" yaml:"sqrt_price"`
+	// Highest tick whose price is lower than or equal to the current price.
+	CurrentTick int64 `protobuf:"varint,2,opt,name=current_tick,json=currentTick,proto3" json:"current_tick,omitempty" yaml:"current_tick"`
+	// Liquidity of the positions in range of the current price.
+	Liquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+	// Swap fees collected per unit of liquidity since the pool creation.
+	FeeGrowthGlobal github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=fee_growth_global,json=feeGrowthGlobal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fee_growth_global" yaml:"fee_growth_global"`
+	// Initialized ticks, sorted by index. The keeper stores them apart from the
+	// pool and fills them in when the pool is fetched.
+	Ticks []Tick `protobuf:"bytes,5,rep,name=ticks,proto3" json:"ticks" yaml:"ticks"`
+}
+
+func (m *ConcentratedLiquidity) Reset()         { *m = ConcentratedLiquidity{} }
+func (m *ConcentratedLiquidity) String() string { return proto.CompactTextString(m) }
+func (*ConcentratedLiquidity) ProtoMessage()    {}
+func (*ConcentratedLiquidity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0eee5bfc2c3a2b, []int{4}
+}
+func (m *ConcentratedLiquidity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConcentratedLiquidity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConcentratedLiquidity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConcentratedLiquidity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConcentratedLiquidity.Merge(m, src)
+}
+func (m *ConcentratedLiquidity) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConcentratedLiquidity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConcentratedLiquidity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConcentratedLiquidity proto.InternalMessageInfo
+
+func (m *ConcentratedLiquidity) GetCurrentTick() int64 {
+	if m != nil {
+		return m.CurrentTick
+	}
+	return 0
+}
+
+func (m *ConcentratedLiquidity) GetFeeGrowthGlobal() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FeeGrowthGlobal
+	}
+	return nil
+}
+
+func (m *ConcentratedLiquidity) GetTicks() []Tick {
+	if m != nil {
+		return m.Ticks
+	}
+	return nil
+}
+
+// Tick is a tick of a concentrated liquidity pool on which at least one
+// position starts or ends.
+type Tick struct {
+	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Total liquidity of the positions starting or ending on the tick.
+	LiquidityGross github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquidity_gross,json=liquidityGross,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_gross" yaml:"liquidity_gross"`
+	// Liquidity added to the pool when the price crosses the tick upwards.
+	LiquidityNet github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_net" yaml:"liquidity_net"`
+	// Fee growth on the other side of the tick from the current price.
+	FeeGrowthOutside github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=fee_growth_outside,json=feeGrowthOutside,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fee_growth_outside" yaml:"fee_growth_outside"`
+}
+
+func (m *Tick) Reset()         { *m = Tick{} }
+func (m *Tick) String() string { return proto.CompactTextString(m) }
+func (*Tick) ProtoMessage()    {}
+func (*Tick) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0eee5bfc2c3a2b, []int{5}
+}
+func (m *Tick) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tick.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Tick) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tick.Merge(m, src)
+}
+func (m *Tick) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tick) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tick.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tick proto.InternalMessageInfo
+
+func (m *Tick) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Tick) GetFeeGrowthOutside() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FeeGrowthOutside
+	}
+	return nil
+}
+
+// Position is liquidity provided to a concentrated liquidity pool between two
+// ticks.
+type Position struct {
+	Id        uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PoolId    uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Owner     string                                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	LowerTick int64                                  `protobuf:"varint,4,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64                                  `protobuf:"varint,5,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	Liquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+	// Fee growth inside the range of the position when its fees were last
+	// collected.
+	FeeGrowthInsideLast github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,7,rep,name=fee_growth_inside_last,json=feeGrowthInsideLast,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fee_growth_inside_last" yaml:"fee_growth_inside_last"`
+}
+
+func (m *Position) Reset()         { *m = Position{} }
+func (m *Position) String() string { return proto.CompactTextString(m) }
+func (*Position) ProtoMessage()    {}
+func (*Position) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0eee5bfc2c3a2b, []int{6}
+}
+func (m *Position) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Position.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Position) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Position.Merge(m, src)
+}
+func (m *Position) XXX_Size() int {
+	return m.Size()
+}
+func (m *Position) XXX_DiscardUnknown() {
+	xxx_messageInfo_Position.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Position proto.InternalMessageInfo
+
+func (m *Position) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Position) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *Position) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Position) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *Position) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *Position) GetFeeGrowthInsideLast() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FeeGrowthInsideLast
+	}
+	return nil
+}
+
+// SwapRoute is a hop of a multi-hop swap: the pool to swap through and the
+// denom obtained from it, which is the denom swapped into the next hop.
+type SwapRoute struct {
+	PoolId        uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+}
+
+func (m *SwapRoute) Reset()         { *m = SwapRoute{} }
+func (m *SwapRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapRoute) ProtoMessage()    {}
+func (*SwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0eee5bfc2c3a2b, []int{7}
+}
+func (m *SwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapRoute.Merge(m, src)
+}
+func (m *SwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapRoute proto.InternalMessageInfo
+
+func (m *SwapRoute) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *SwapRoute) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+// PoolFees are the cumulative swap fees collected by a pool since its
+// creation, per denom.
+type PoolFees struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// all the swap fees paid by the swappers, protocol fees included
+	SwapFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=swap_fees,json=swapFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_fees" yaml:"swap_fees"`
+	// the part of the swap fees taken by the protocol
+	ProtocolFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=protocol_fees,json=protocolFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"protocol_fees" yaml:"protocol_fees"`
+}
+
+func (m *PoolFees) Reset()         { *m = PoolFees{} }
+func (m *PoolFees) String() string { return proto.CompactTextString(m) }
+func (*PoolFees) ProtoMessage()    {}
+func (*PoolFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0eee5bfc2c3a2b, []int{8}
+}
+func (m *PoolFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolFees.Merge(m, src)
+}
+func (m *PoolFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolFees proto.InternalMessageInfo
+
+func (m *PoolFees) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolFees) GetSwapFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SwapFees
+	}
+	return nil
+}
+
+func (m *PoolFees) GetProtocolFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProtocolFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("nibiru.spot.v1.PoolType", PoolType_name, PoolType_value)
 	proto.RegisterType((*PoolParams)(nil), "nibiru.spot.v1.PoolParams")
+	proto.RegisterType((*AmplificationRamp)(nil), "nibiru.spot.v1.AmplificationRamp")
 	proto.RegisterType((*PoolAsset)(nil), "nibiru.spot.v1.PoolAsset")
 	proto.RegisterType((*Pool)(nil), "nibiru.spot.v1.Pool")
+	proto.RegisterType((*ConcentratedLiquidity)(nil), "nibiru.spot.v1.ConcentratedLiquidity")
+	proto.RegisterType((*Tick)(nil), "nibiru.spot.v1.Tick")
+	proto.RegisterType((*Position)(nil), "nibiru.spot.v1.Position")
+	proto.RegisterType((*SwapRoute)(nil), "nibiru.spot.v1.SwapRoute")
+	proto.RegisterType((*PoolFees)(nil), "nibiru.spot.v1.PoolFees")
 }
 
 func init() { proto.RegisterFile("nibiru/spot/v1/pool.proto", fileDescriptor_cf0eee5bfc2c3a2b) }
 
 var fileDescriptor_cf0eee5bfc2c3a2b = []byte{
-	// 620 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcd, 0x6a, 0xdb, 0x40,
-	0x10, 0xc7, 0x25, 0xc7, 0x49, 0x9c, 0x75, 0xea, 0x86, 0xad, 0x0f, 0x8a, 0x0b, 0x52, 0xd8, 0x43,
-	0x09, 0xa1, 0x95, 0x70, 0x7a, 0xcb, 0xa5, 0x48, 0x89, 0x03, 0xa5, 0x21, 0x04, 0x25, 0xd4, 0xb4,
-	0x14, 0xcc, 0xda, 0xde, 0xd8, 0x4b, 0x6c, 0xad, 0xf0, 0x6e, 0x3e, 0xfc, 0x06, 0x3d, 0xf6, 0x11,
-	0x7a, 0xef, 0x4b, 0xf4, 0x98, 0x63, 0x8e, 0xa5, 0x07, 0x51, 0xec, 0x63, 0x6f, 0x7e, 0x82, 0xb2,
-	0x1f, 0x6a, 0x12, 0x30, 0x14, 0xd3, 0x93, 0x76, 0xf8, 0xcf, 0xfc, 0xb4, 0xf3, 0x9f, 0x61, 0xc1,
-	0x66, 0x42, 0xdb, 0x74, 0x74, 0x19, 0xf0, 0x94, 0x89, 0xe0, 0xaa, 0x1e, 0xa4, 0x8c, 0x0d, 0xfc,
-	0x74, 0xc4, 0x04, 0x83, 0x15, 0x2d, 0xf9, 0x52, 0xf2, 0xaf, 0xea, 0xb5, 0x6a, 0x8f, 0xf5, 0x98,
-	0x92, 0x02, 0x79, 0xd2, 0x59, 0x35, 0xb7, 0xc3, 0xf8, 0x90, 0xf1, 0xa0, 0x8d, 0x39, 0x09, 0xae,
-	0xea, 0x6d, 0x22, 0x70, 0x3d, 0xe8, 0x30, 0x9a, 0x18, 0x7d, 0x53, 0xeb, 0x2d, 0x5d, 0xa8, 0x03,
-	0x2d, 0xa1, 0xdf, 0x05, 0x00, 0x4e, 0x18, 0x1b, 0x9c, 0xe0, 0x11, 0x1e, 0x72, 0xf8, 0x09, 0x94,
-	0xf8, 0x35, 0x4e, 0x5b, 0xe7, 0x84, 0x38, 0xf6, 0x96, 0xbd, 0xbd, 0x16, 0x85, 0xb7, 0x99, 0x67,
-	0xfd, 0xcc, 0xbc, 0x17, 0x3d, 0x2a, 0xfa, 0x97, 0x6d, 0xbf, 0xc3, 0x86, 0x86, 0x60, 0x3e, 0xaf,
-	0x78, 0xf7, 0x22, 0x10, 0xe3, 0x94, 0x70, 0xff, 0x80, 0x74, 0x66, 0x99, 0xf7, 0x74, 0x8c, 0x87,
-	0x83, 0x3d, 0x94, 0x73, 0x50, 0xbc, 0x2a, 0x8f, 0x87, 0x84, 0x48, 0x3a, 0xb9, 0xa1, 0x42, 0xd1,
-	0x0b, 0xff, 0x47, 0xcf, 0x39, 0x28, 0x5e, 0x95, 0x47, 0x49, 0x3f, 0x03, 0x76, 0xe8, 0x2c, 0x29,
-	0xec, 0xe1, 0x02, 0xd8, 0xb7, 0x89, 0x98, 0x65, 0x5e, 0x55, 0x63, 0xf1, 0x30, 0x1d, 0xd0, 0x73,
-	0xda, 0xc1, 0x82, 0xb2, 0x04, 0xc5, 0x76, 0x08, 0xdf, 0x81, 0x35, 0x39, 0x8f, 0x96, 0x4c, 0x76,
-	0x8a, 0x5b, 0xf6, 0x76, 0x65, 0xd7, 0xf1, 0x1f, 0x4f, 0xc5, 0x97, 0x06, 0x9e, 0x8d, 0x53, 0x12,
-	0x55, 0x67, 0x99, 0xb7, 0xa1, 0x49, 0x7f, 0x8b, 0x50, 0x5c, 0x4a, 0x8d, 0x8e, 0xbe, 0xd9, 0x60,
-	0x4d, 0x26, 0x87, 0x9c, 0x13, 0x01, 0x1b, 0x60, 0x59, 0xb0, 0x0b, 0x92, 0x28, 0xa7, 0xcb, 0xbb,
-	0x9b, 0xbe, 0x99, 0x8c, 0x1c, 0xa3, 0x6f, 0xc6, 0xe8, 0xef, 0x33, 0x9a, 0x44, 0x55, 0xd9, 0xcf,
-	0x2c, 0xf3, 0xd6, 0x35, 0x5b, 0x55, 0xa1, 0x58, 0x57, 0xc3, 0x26, 0x58, 0xb9, 0x26, 0xb4, 0xd7,
-	0x17, 0xc6, 0xd3, 0x37, 0x0b, 0x37, 0xff, 0x44, 0x63, 0x35, 0x05, 0xc5, 0x06, 0x87, 0xbe, 0x2f,
-	0x81, 0xa2, 0xbc, 0x2d, 0xac, 0x80, 0x02, 0xed, 0xaa, 0x5b, 0x16, 0xe3, 0x02, 0xed, 0xc2, 0x97,
-	0x60, 0x15, 0x77, 0xbb, 0x23, 0xc2, 0xb9, 0xf9, 0x25, 0x9c, 0x65, 0x5e, 0xc5, 0x38, 0xa8, 0x05,
-	0x14, 0xe7, 0x29, 0xb0, 0x09, 0xca, 0xca, 0x8c, 0x54, 0xad, 0x98, 0x9a, 0x50, 0x79, 0xb7, 0x36,
-	0xcf, 0x43, 0xbd, 0x84, 0x51, 0xcd, 0x74, 0x0b, 0x1f, 0x38, 0xa9, 0x8b, 0x51, 0x0c, 0xd2, 0xfb,
-	0x65, 0x7d, 0x6f, 0xc0, 0x58, 0xba, 0xc9, 0x9d, 0xe2, 0xd6, 0x92, 0x72, 0x71, 0x0e, 0x58, 0xf9,
-	0x3d, 0x97, 0xab, 0x6b, 0x0d, 0x57, 0xa5, 0x71, 0xd8, 0x07, 0xeb, 0x82, 0x09, 0x3c, 0x68, 0x19,
-	0x5b, 0x97, 0x55, 0x8f, 0x8d, 0x85, 0x6d, 0x7d, 0x96, 0x4f, 0xeb, 0x9e, 0x85, 0xe2, 0xb2, 0x0a,
-	0x9b, 0x2a, 0x82, 0x1f, 0xf2, 0x3f, 0xf1, 0x3e, 0x1e, 0x11, 0xee, 0xac, 0xfc, 0x6b, 0x11, 0x9e,
-	0x9b, 0x16, 0x1e, 0xa1, 0x75, 0x71, 0x8e, 0x3e, 0x55, 0xd1, 0x5e, 0xf1, 0xf3, 0x57, 0xcf, 0xda,
-	0xd9, 0x06, 0xa5, 0x7c, 0x39, 0xe1, 0x3a, 0x28, 0x45, 0xe1, 0x51, 0x78, 0xbc, 0xdf, 0x88, 0x37,
-	0x2c, 0x58, 0x01, 0xe0, 0xf4, 0x2c, 0x8c, 0x8e, 0x1a, 0xa7, 0xcd, 0xf0, 0x64, 0xc3, 0x8e, 0x0e,
-	0x6e, 0x27, 0xae, 0x7d, 0x37, 0x71, 0xed, 0x5f, 0x13, 0xd7, 0xfe, 0x32, 0x75, 0xad, 0xbb, 0xa9,
-	0x6b, 0xfd, 0x98, 0xba, 0xd6, 0xc7, 0x9d, 0x07, 0x0d, 0x1f, 0x2b, 0x6f, 0xf7, 0xfb, 0x98, 0x26,
-	0x81, 0x79, 0xb5, 0x6e, 0xf4, 0xbb, 0xa5, 0x1a, 0x6f, 0xaf, 0xa8, 0x57, 0xe5, 0xf5, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x0e, 0x8e, 0x88, 0xaa, 0xd3, 0x04, 0x00, 0x00,
+	// 1399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x4f, 0x1b, 0x47,
+	0x1b, 0x67, 0xb1, 0x0d, 0x78, 0x30, 0x60, 0x26, 0x86, 0xd7, 0xf0, 0xbe, 0xf1, 0x92, 0x91, 0xde,
+	0x57, 0x28, 0x79, 0x63, 0x0b, 0x9a, 0x53, 0x2e, 0xa9, 0xd7, 0x38, 0x80, 0x42, 0x01, 0x0d, 0x56,
+	0x51, 0xab, 0x4a, 0xdb, 0xf5, 0x7a, 0x30, 0x23, 0xec, 0x9d, 0xcd, 0xee, 0x38, 0x04, 0x29, 0x52,
+	0xaf, 0x39, 0xf6, 0x5a, 0xb5, 0x87, 0xde, 0x2a, 0xb5, 0xd7, 0x7e, 0x80, 0xf6, 0x96, 0x63, 0xd4,
+	0x4b, 0xab, 0x1e, 0xdc, 0x2a, 0xf9, 0x00, 0x95, 0xac, 0x4a, 0xbd, 0x56, 0xf3, 0x67, 0xed, 0xc5,
+	0x58, 0x0d, 0x4e, 0xd5, 0x93, 0xe7, 0xd9, 0xe7, 0x79, 0x7e, 0xf3, 0xcc, 0xef, 0xf9, 0x33, 0x1e,
+	0xb0, 0xe2, 0xd1, 0x3a, 0x0d, 0x3a, 0xa5, 0xd0, 0x67, 0xbc, 0xf4, 0x64, 0xa3, 0xe4, 0x33, 0xd6,
+	0x2a, 0xfa, 0x01, 0xe3, 0x0c, 0xce, 0x2b, 0x55, 0x51, 0xa8, 0x8a, 0x4f, 0x36, 0x56, 0x73, 0x4d,
+	0xd6, 0x64, 0x52, 0x55, 0x12, 0x2b, 0x65, 0xb5, 0x5a, 0x70, 0x59, 0xd8, 0x66, 0x61, 0xa9, 0xee,
+	0x84, 0xa4, 0xf4, 0x64, 0xa3, 0x4e, 0xb8, 0xb3, 0x51, 0x72, 0x19, 0xf5, 0xb4, 0x7e, 0x45, 0xe9,
+	0x6d, 0xe5, 0xa8, 0x04, 0xa5, 0x42, 0xbf, 0x27, 0x00, 0x38, 0x64, 0xac, 0x75, 0xe8, 0x04, 0x4e,
+	0x3b, 0x84, 0x1f, 0x81, 0x99, 0xf0, 0xdc, 0xf1, 0xed, 0x13, 0x42, 0xf2, 0xc6, 0x9a, 0xb1, 0x9e,
+	0xb6, 0xca, 0x2f, 0xba, 0xe6, 0xc4, 0xcf, 0x5d, 0xf3, 0x7f, 0x4d, 0xca, 0x4f, 0x3b, 0xf5, 0xa2,
+	0xcb, 0xda, 0x1a, 0x41, 0xff, 0xdc, 0x0d, 0x1b, 0x67, 0x25, 0x7e, 0xe1, 0x93, 0xb0, 0xb8, 0x45,
+	0xdc, 0x5e, 0xd7, 0x5c, 0xb8, 0x70, 0xda, 0xad, 0xfb, 0x28, 0xc2, 0x41, 0x78, 0x5a, 0x2c, 0x1f,
+	0x12, 0x22, 0xd0, 0xc9, 0x53, 0xca, 0x25, 0xfa, 0xe4, 0xdf, 0x43, 0x8f, 0x70, 0x10, 0x9e, 0x16,
+	0x4b, 0x81, 0x5e, 0x03, 0x46, 0x39, 0x9f, 0x90, 0xb0, 0x0f, 0xc7, 0x80, 0xdd, 0xf5, 0x78, 0xaf,
+	0x6b, 0xe6, 0x14, 0xac, 0xd3, 0xf6, 0x5b, 0xf4, 0x84, 0xba, 0x0e, 0xa7, 0xcc, 0x43, 0xd8, 0x28,
+	0xc3, 0x47, 0x20, 0x2d, 0xf2, 0x61, 0x0b, 0xe3, 0x7c, 0x72, 0xcd, 0x58, 0x9f, 0xdf, 0xcc, 0x17,
+	0x2f, 0x67, 0xa5, 0x28, 0x08, 0xac, 0x5d, 0xf8, 0xc4, 0xca, 0xf5, 0xba, 0x66, 0x56, 0x21, 0xf5,
+	0x9d, 0x10, 0x9e, 0xf1, 0xb5, 0x1e, 0xde, 0x07, 0x19, 0x4e, 0xdd, 0x33, 0x3b, 0xf4, 0x1d, 0x97,
+	0x7a, 0xcd, 0x7c, 0x6a, 0xcd, 0x58, 0x4f, 0x5a, 0xff, 0xea, 0x75, 0xcd, 0x1b, 0xca, 0x2b, 0xae,
+	0x45, 0x78, 0x56, 0x88, 0x47, 0x4a, 0x82, 0x8f, 0xc0, 0x94, 0x63, 0x07, 0x4e, 0xdb, 0xcf, 0x4f,
+	0xad, 0x19, 0xeb, 0xb3, 0x9b, 0xb7, 0x86, 0xa3, 0x28, 0xc7, 0x63, 0xc7, 0x4e, 0xdb, 0xb7, 0x16,
+	0x7b, 0x5d, 0x73, 0x4e, 0x1f, 0x4c, 0xba, 0x22, 0x9c, 0x72, 0x84, 0x06, 0xfd, 0x38, 0x09, 0x16,
+	0xaf, 0xd8, 0x43, 0x1b, 0xa4, 0xa9, 0x47, 0x39, 0x75, 0x5a, 0xb6, 0xa3, 0xd3, 0x6f, 0x8d, 0xcd,
+	0xa4, 0x3e, 0x7f, 0x1f, 0x08, 0xe1, 0x19, 0xbd, 0x2e, 0x8b, 0x02, 0x38, 0xe9, 0xf0, 0x4e, 0x40,
+	0x6c, 0xe7, 0x2d, 0x0a, 0x40, 0xe1, 0xeb, 0x02, 0x88, 0x70, 0x10, 0x9e, 0x56, 0xcb, 0x32, 0xb4,
+	0xc0, 0x42, 0xb4, 0x2b, 0xa7, 0x6d, 0x62, 0xb7, 0x43, 0x59, 0x0e, 0x09, 0x6b, 0xb5, 0xd7, 0x35,
+	0x97, 0x2f, 0x87, 0xa5, 0x0d, 0x10, 0x9e, 0xd3, 0x5f, 0x6a, 0xb4, 0x4d, 0xde, 0x0b, 0xe1, 0x03,
+	0x30, 0xaf, 0x91, 0x23, 0x88, 0xa4, 0x84, 0x58, 0xe9, 0x75, 0xcd, 0xa5, 0x4b, 0x3b, 0xf7, 0x11,
+	0x32, 0xea, 0x83, 0x02, 0x40, 0xdf, 0x18, 0x20, 0x2d, 0xea, 0xa1, 0x1c, 0x86, 0x84, 0xc3, 0x2a,
+	0x48, 0x71, 0x76, 0x46, 0x3c, 0xc9, 0xe6, 0xec, 0xe6, 0x4a, 0x51, 0x37, 0x9f, 0xe8, 0xd4, 0xa2,
+	0xee, 0xd4, 0x62, 0x85, 0x51, 0xcf, 0xca, 0x09, 0x22, 0x7a, 0x5d, 0x33, 0xa3, 0x0b, 0x41, 0x78,
+	0x21, 0xac, 0xbc, 0xe1, 0x31, 0x98, 0x3a, 0x27, 0xb4, 0x79, 0xca, 0x35, 0x6b, 0x0f, 0xc6, 0x66,
+	0x4d, 0x97, 0x81, 0x42, 0x41, 0x58, 0xc3, 0xa1, 0xef, 0x93, 0x20, 0x29, 0xa2, 0x85, 0xf3, 0x60,
+	0x92, 0x36, 0x64, 0x94, 0x49, 0x3c, 0x49, 0x1b, 0xf0, 0xff, 0x60, 0xda, 0x69, 0x34, 0x02, 0x12,
+	0x86, 0x7a, 0x4b, 0xd8, 0xeb, 0x9a, 0xf3, 0xba, 0x96, 0x94, 0x02, 0xe1, 0xc8, 0x04, 0x1e, 0x83,
+	0x59, 0x59, 0xef, 0xbe, 0x9c, 0x22, 0x92, 0xf5, 0xd9, 0xcd, 0xd5, 0x51, 0x6d, 0xa2, 0xe6, 0x8c,
+	0xb5, 0xaa, 0x4f, 0x0b, 0x63, 0xcd, 0xa2, 0x9c, 0x11, 0x06, 0xfe, 0x60, 0x1e, 0xbd, 0xaf, 0x81,
+	0x1d, 0xc1, 0xa6, 0xc8, 0x45, 0x42, 0xb2, 0x38, 0x02, 0x58, 0xf2, 0x3d, 0x12, 0x57, 0xf9, 0x6a,
+	0x5c, 0x69, 0x16, 0xc2, 0x53, 0x90, 0xe1, 0x8c, 0x3b, 0x2d, 0x5b, 0xd3, 0x9a, 0x92, 0x67, 0xac,
+	0x8e, 0x4d, 0x6b, 0xd4, 0xb6, 0x31, 0x2c, 0xd1, 0xb6, 0x42, 0x3c, 0x96, 0x12, 0xfc, 0x20, 0xda,
+	0x29, 0x3c, 0x75, 0x02, 0x12, 0xe6, 0xa7, 0xde, 0x54, 0x08, 0xff, 0xd6, 0x47, 0xb8, 0x04, 0xad,
+	0x9c, 0x23, 0xe8, 0x23, 0x29, 0xc1, 0x4f, 0xc0, 0xb2, 0xcb, 0x3c, 0x97, 0x78, 0x3c, 0x70, 0x38,
+	0x69, 0xd8, 0x2d, 0xfa, 0xb8, 0x43, 0x1b, 0x94, 0x5f, 0xe4, 0xa7, 0xe5, 0x26, 0xff, 0x1d, 0xe6,
+	0xa9, 0x12, 0xb3, 0xde, 0x8b, 0x8c, 0xad, 0x5b, 0xbd, 0xae, 0x79, 0x53, 0x6d, 0x36, 0x1a, 0x0e,
+	0xe1, 0x25, 0x77, 0x94, 0xe7, 0xfd, 0xe4, 0xf3, 0x2f, 0xcd, 0x09, 0xf4, 0x47, 0x02, 0x2c, 0x8d,
+	0x44, 0x86, 0x75, 0x00, 0xc2, 0xc7, 0x01, 0xb7, 0xfd, 0x80, 0xba, 0xd1, 0x7d, 0x52, 0x19, 0x7b,
+	0xe2, 0x2f, 0xea, 0xfb, 0xa4, 0x8f, 0x84, 0x70, 0x5a, 0x08, 0x87, 0x62, 0x2d, 0x46, 0xaa, 0xdb,
+	0x09, 0x02, 0xe2, 0x71, 0x5b, 0x4c, 0x4b, 0x59, 0xad, 0x89, 0xf8, 0x48, 0x8d, 0x6b, 0x11, 0x9e,
+	0xd5, 0x62, 0x8d, 0xba, 0x67, 0xf0, 0x63, 0x90, 0x1e, 0x70, 0x96, 0x18, 0x7b, 0xde, 0xa9, 0xf0,
+	0xf4, 0xbc, 0x8b, 0xb1, 0x35, 0x00, 0x85, 0x9f, 0x19, 0x60, 0xf1, 0x84, 0x10, 0xbb, 0x19, 0xb0,
+	0x73, 0x7e, 0x6a, 0x37, 0x5b, 0xac, 0xee, 0xb4, 0x74, 0x19, 0xff, 0x67, 0x64, 0x0d, 0x6c, 0x11,
+	0x57, 0x96, 0xc1, 0x81, 0x2e, 0x83, 0xbc, 0x1e, 0x3a, 0xc3, 0x20, 0xe8, 0xeb, 0x5f, 0xcc, 0x3b,
+	0xd7, 0x0b, 0x52, 0xe0, 0x85, 0x78, 0xe1, 0x84, 0x90, 0x6d, 0x89, 0xb0, 0x2d, 0x01, 0xe0, 0xbb,
+	0x20, 0x25, 0x38, 0x09, 0xf3, 0x29, 0x19, 0x4e, 0x6e, 0xb8, 0x5a, 0x04, 0x45, 0x57, 0xc6, 0x92,
+	0x70, 0x10, 0x63, 0x49, 0xfe, 0x7e, 0x9e, 0x00, 0x49, 0x49, 0x64, 0x0e, 0xa4, 0xa8, 0xd7, 0x20,
+	0x4f, 0x65, 0x8e, 0x13, 0x58, 0x09, 0xf0, 0x31, 0x58, 0xe8, 0x33, 0x21, 0x82, 0xef, 0xcf, 0x92,
+	0x9d, 0xb1, 0x49, 0x5e, 0x1e, 0x22, 0x59, 0xc1, 0x21, 0x3c, 0xdf, 0xff, 0xb2, 0x2d, 0x3e, 0xc0,
+	0x33, 0x30, 0x37, 0xb0, 0xf1, 0x08, 0x7f, 0x8b, 0xff, 0x03, 0x6a, 0xc3, 0xdc, 0xf0, 0x86, 0x1e,
+	0xe1, 0x08, 0x67, 0xfa, 0xf2, 0x3e, 0xe1, 0xf0, 0x0b, 0x03, 0xc0, 0x58, 0x5e, 0x58, 0x87, 0x87,
+	0xb4, 0x41, 0xae, 0x95, 0xdd, 0x43, 0x4d, 0xeb, 0xca, 0x95, 0xec, 0x6a, 0x94, 0xb1, 0xd3, 0x9b,
+	0xed, 0xa7, 0xf7, 0x40, 0x23, 0xfc, 0x96, 0x00, 0x33, 0x87, 0x2c, 0xa4, 0xe2, 0x7a, 0xbf, 0x32,
+	0xdf, 0xef, 0x80, 0x69, 0x39, 0x1c, 0x69, 0x43, 0xe6, 0x24, 0x19, 0x9f, 0xef, 0x5a, 0x81, 0xf0,
+	0x94, 0x58, 0xed, 0x36, 0x60, 0x11, 0xa4, 0xd8, 0xb9, 0x47, 0x02, 0xcd, 0x66, 0xfe, 0x87, 0x6f,
+	0xef, 0xe6, 0xf4, 0xe9, 0xca, 0xea, 0x06, 0x38, 0xe2, 0x01, 0xf5, 0x9a, 0x58, 0x99, 0xc1, 0x7b,
+	0x00, 0xb4, 0xd8, 0x39, 0x09, 0x54, 0x47, 0xaa, 0x0b, 0x74, 0x69, 0xd0, 0xc9, 0x03, 0x9d, 0xe8,
+	0x15, 0x21, 0xc8, 0x22, 0xba, 0x07, 0x40, 0xc7, 0xf7, 0x23, 0xaf, 0xd4, 0xb0, 0xd7, 0x40, 0x87,
+	0x70, 0x5a, 0x0a, 0x57, 0x7b, 0x78, 0xea, 0x9f, 0xe8, 0xe1, 0xaf, 0x0c, 0xb0, 0x1c, 0x4b, 0x10,
+	0xf5, 0x04, 0xbb, 0x76, 0xcb, 0x09, 0x79, 0x7e, 0xfa, 0x1a, 0xa9, 0xae, 0xe9, 0x54, 0xdf, 0xbc,
+	0x92, 0xea, 0x18, 0xd2, 0xd8, 0xe9, 0xbe, 0xd1, 0x4f, 0xf7, 0xae, 0x44, 0xd9, 0x13, 0x20, 0xcf,
+	0x40, 0xfa, 0xe8, 0xdc, 0xf1, 0x31, 0xeb, 0x70, 0x12, 0xcf, 0xb0, 0xf1, 0xc6, 0x0c, 0x5b, 0x60,
+	0x41, 0xfe, 0xd3, 0x10, 0xe5, 0x67, 0x37, 0x88, 0xc7, 0xda, 0xba, 0x55, 0x63, 0x7f, 0x9d, 0x86,
+	0x0c, 0x10, 0x9e, 0x93, 0x5f, 0x0e, 0x3a, 0x7c, 0x4b, 0xca, 0xdf, 0x4d, 0x8a, 0x7a, 0x63, 0xad,
+	0x87, 0x84, 0x84, 0xe3, 0xed, 0xfe, 0x0c, 0xa4, 0xa3, 0xd7, 0x82, 0x18, 0x11, 0x89, 0xbf, 0xbe,
+	0x20, 0xb7, 0x34, 0xa1, 0xd9, 0xcb, 0xef, 0x8c, 0x50, 0x70, 0xb8, 0x7e, 0x0d, 0x0e, 0x15, 0x81,
+	0x33, 0xfa, 0x51, 0x12, 0xc2, 0xe7, 0x06, 0x98, 0x93, 0x8f, 0x21, 0x97, 0xb5, 0x54, 0x08, 0x89,
+	0x37, 0x85, 0xb0, 0xa3, 0x43, 0xd0, 0x53, 0xe2, 0x92, 0xf7, 0x78, 0x61, 0x64, 0x22, 0x5f, 0x11,
+	0xca, 0xed, 0x1d, 0xc5, 0xa0, 0x7c, 0x2b, 0x64, 0xc0, 0x8c, 0x55, 0xde, 0x2b, 0xef, 0x57, 0xaa,
+	0x38, 0x3b, 0x01, 0xe7, 0x01, 0x38, 0xaa, 0x95, 0xad, 0xbd, 0xea, 0xd1, 0x71, 0xf9, 0x30, 0x6b,
+	0xc0, 0x2c, 0xc8, 0x54, 0x0e, 0xf6, 0x2b, 0xd5, 0xfd, 0x1a, 0x2e, 0xd7, 0xaa, 0x5b, 0xd9, 0x49,
+	0x61, 0x7f, 0x5c, 0xdd, 0xdd, 0xde, 0x11, 0x52, 0xc2, 0xda, 0x7a, 0xf1, 0xaa, 0x60, 0xbc, 0x7c,
+	0x55, 0x30, 0x7e, 0x7d, 0x55, 0x30, 0x3e, 0x7d, 0x5d, 0x98, 0x78, 0xf9, 0xba, 0x30, 0xf1, 0xd3,
+	0xeb, 0xc2, 0xc4, 0x87, 0xb7, 0x63, 0xb1, 0xed, 0xcb, 0x89, 0x5f, 0x39, 0x75, 0xa8, 0x57, 0xd2,
+	0x8f, 0xd0, 0xa7, 0xea, 0x19, 0x2a, 0x63, 0xac, 0x4f, 0xc9, 0xe8, 0xde, 0xf9, 0x73, 0x00, 0xa7,
+	0x74, 0x63, 0x6d, 0xa2, 0x0e, 0x00, 0x00,
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -278,6 +764,23 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ARamp != nil {
+		{
+			size, err := m.ARamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TickSpacing != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.PoolType != 0 {
 		i = encodeVarintPool(dAtA, i, uint64(m.PoolType))
 		i--
@@ -316,7 +819,7 @@ func (m *PoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolAsset) Marshal() (dAtA []byte, err error) {
+func (m *AmplificationRamp) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -326,20 +829,30 @@ func (m *PoolAsset) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolAsset) MarshalTo(dAtA []byte) (int, error) {
+func (m *AmplificationRamp) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AmplificationRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.FutureTimeMs != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.FutureTimeMs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InitialTimeMs != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.InitialTimeMs))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
-		size := m.Weight.Size()
+		size := m.FutureA.Size()
 		i -= size
-		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.FutureA.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintPool(dAtA, i, uint64(size))
@@ -347,11 +860,11 @@ func (m *PoolAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x12
 	{
-		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.InitialA.Size()
+		i -= size
+		if _, err := m.InitialA.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintPool(dAtA, i, uint64(size))
 	}
 	i--
@@ -359,7 +872,7 @@ func (m *PoolAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Pool) Marshal() (dAtA []byte, err error) {
+func (m *PoolAsset) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -369,18 +882,73 @@ func (m *Pool) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+func (m *PoolAsset) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PoolAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Pool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConcentratedLiquidity != nil {
+		{
+			size, err := m.ConcentratedLiquidity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.TotalShares.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -438,130 +1006,1356 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPool(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ConcentratedLiquidity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PoolParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ConcentratedLiquidity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConcentratedLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.SwapFee.Size()
-	n += 1 + l + sovPool(uint64(l))
-	l = m.ExitFee.Size()
-	n += 1 + l + sovPool(uint64(l))
-	l = m.A.Size()
-	n += 1 + l + sovPool(uint64(l))
-	if m.PoolType != 0 {
-		n += 1 + sovPool(uint64(m.PoolType))
+	if len(m.Ticks) > 0 {
+		for iNdEx := len(m.Ticks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ticks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	return n
+	if len(m.FeeGrowthGlobal) > 0 {
+		for iNdEx := len(m.FeeGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentTick != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.SqrtPrice.Size()
+		i -= size
+		if _, err := m.SqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PoolAsset) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Tick) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Token.Size()
-	n += 1 + l + sovPool(uint64(l))
-	l = m.Weight.Size()
-	n += 1 + l + sovPool(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Pool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Tick) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Tick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovPool(uint64(m.Id))
+	if len(m.FeeGrowthOutside) > 0 {
+		for iNdEx := len(m.FeeGrowthOutside) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeGrowthOutside[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovPool(uint64(l))
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
 	}
-	l = m.PoolParams.Size()
-	n += 1 + l + sovPool(uint64(l))
-	if len(m.PoolAssets) > 0 {
-		for _, e := range m.PoolAssets {
-			l = e.Size()
-			n += 1 + l + sovPool(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidityGross.Size()
+		i -= size
+		if _, err := m.LiquidityGross.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
 	}
-	l = m.TotalWeight.Size()
-	n += 1 + l + sovPool(uint64(l))
-	l = m.TotalShares.Size()
-	n += 1 + l + sovPool(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if m.Index != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovPool(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozPool(x uint64) (n int) {
-	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *PoolParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPool
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeGrowthInsideLast) > 0 {
+		for iNdEx := len(m.FeeGrowthInsideLast) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeGrowthInsideLast[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
 			}
+			i--
+			dAtA[i] = 0x3a
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PoolParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolParams: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPool
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.UpperTick != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProtocolFees) > 0 {
+		for iNdEx := len(m.ProtocolFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SwapFees) > 0 {
+		for iNdEx := len(m.SwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintPool(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPool(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapFee.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.ExitFee.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.A.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.PoolType != 0 {
+		n += 1 + sovPool(uint64(m.PoolType))
+	}
+	if m.TickSpacing != 0 {
+		n += 1 + sovPool(uint64(m.TickSpacing))
+	}
+	if m.ARamp != nil {
+		l = m.ARamp.Size()
+		n += 1 + l + sovPool(uint64(l))
+	}
+	return n
+}
+
+func (m *AmplificationRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialA.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.FutureA.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.InitialTimeMs != 0 {
+		n += 1 + sovPool(uint64(m.InitialTimeMs))
+	}
+	if m.FutureTimeMs != 0 {
+		n += 1 + sovPool(uint64(m.FutureTimeMs))
+	}
+	return n
+}
+
+func (m *PoolAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Token.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.Weight.Size()
+	n += 1 + l + sovPool(uint64(l))
+	return n
+}
+
+func (m *Pool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPool(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = m.PoolParams.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if len(m.PoolAssets) > 0 {
+		for _, e := range m.PoolAssets {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	l = m.TotalWeight.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.TotalShares.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.ConcentratedLiquidity != nil {
+		l = m.ConcentratedLiquidity.Size()
+		n += 1 + l + sovPool(uint64(l))
+	}
+	return n
+}
+
+func (m *ConcentratedLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SqrtPrice.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if m.CurrentTick != 0 {
+		n += 1 + sovPool(uint64(m.CurrentTick))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if len(m.FeeGrowthGlobal) > 0 {
+		for _, e := range m.FeeGrowthGlobal {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	if len(m.Ticks) > 0 {
+		for _, e := range m.Ticks {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Tick) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovPool(uint64(m.Index))
+	}
+	l = m.LiquidityGross.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if len(m.FeeGrowthOutside) > 0 {
+		for _, e := range m.FeeGrowthOutside {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPool(uint64(m.Id))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovPool(uint64(m.PoolId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovPool(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovPool(uint64(m.UpperTick))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovPool(uint64(l))
+	if len(m.FeeGrowthInsideLast) > 0 {
+		for _, e := range m.FeeGrowthInsideLast {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPool(uint64(m.PoolId))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovPool(uint64(m.PoolId))
+	}
+	if len(m.SwapFees) > 0 {
+		for _, e := range m.SwapFees {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	if len(m.ProtocolFees) > 0 {
+		for _, e := range m.ProtocolFees {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPool(x uint64) (n int) {
+	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.A.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			}
+			m.PoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolType |= PoolType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
+			}
+			m.TickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ARamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ARamp == nil {
+				m.ARamp = &AmplificationRamp{}
+			}
+			if err := m.ARamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AmplificationRamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AmplificationRamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AmplificationRamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FutureA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialTimeMs", wireType)
+			}
+			m.InitialTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FutureTimeMs", wireType)
+			}
+			m.FutureTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FutureTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAssets = append(m.PoolAssets, PoolAsset{})
+			if err := m.PoolAssets[len(m.PoolAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedLiquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConcentratedLiquidity == nil {
+				m.ConcentratedLiquidity = &ConcentratedLiquidity{}
+			}
+			if err := m.ConcentratedLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConcentratedLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConcentratedLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConcentratedLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 			intStringLen := int(stringLen)
 			if intStringLen < 0 {
 				return ErrInvalidLengthPool
@@ -573,13 +2367,203 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthGlobal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGrowthGlobal = append(m.FeeGrowthGlobal, types.DecCoin{})
+			if err := m.FeeGrowthGlobal[len(m.FeeGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticks = append(m.Ticks, Tick{})
+			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tick) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tick: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tick: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityGross", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -607,13 +2591,13 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidityGross.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -641,15 +2625,15 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.A.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthOutside", wireType)
 			}
-			m.PoolType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -659,11 +2643,26 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolType |= PoolType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGrowthOutside = append(m.FeeGrowthOutside, types.DecCoin{})
+			if err := m.FeeGrowthOutside[len(m.FeeGrowthOutside)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
@@ -685,7 +2684,7 @@ func (m *PoolParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolAsset) Unmarshal(dAtA []byte) error {
+func (m *Position) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -708,17 +2707,55 @@ func (m *PoolAsset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAsset: wiretype end group for non-group")
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -728,28 +2765,65 @@ func (m *PoolAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPool
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPool
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -777,7 +2851,41 @@ func (m *PoolAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthInsideLast", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGrowthInsideLast = append(m.FeeGrowthInsideLast, types.DecCoin{})
+			if err := m.FeeGrowthInsideLast[len(m.FeeGrowthInsideLast)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -802,7 +2910,7 @@ func (m *PoolAsset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *SwapRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -825,17 +2933,17 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.Id = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -845,14 +2953,14 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -880,46 +2988,63 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPool
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPool
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPool
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolAssets", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -929,31 +3054,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPool
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPool
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PoolAssets = append(m.PoolAssets, PoolAsset{})
-			if err := m.PoolAssets[len(m.PoolAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPool
@@ -963,29 +3073,29 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPool
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPool
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SwapFees = append(m.SwapFees, types.Coin{})
+			if err := m.SwapFees[len(m.SwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1012,7 +3122,8 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProtocolFees = append(m.ProtocolFees, types.Coin{})
+			if err := m.ProtocolFees[len(m.ProtocolFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -985,3 +985,140 @@ func TestSolveStableswapInvariant(t *testing.T) {
 		}
 	})
 }
+
+// singleAssetTestPool returns a 1000bar/1000foo pool with 1000 shares and a 1% swap fee.
+func singleAssetTestPool(poolType PoolType) Pool {
+	return Pool{
+		Id: 1,
+		PoolParams: PoolParams{
+			SwapFee:  sdk.MustNewDecFromStr("0.01"),
+			ExitFee:  sdk.ZeroDec(),
+			PoolType: poolType,
+			A:        sdk.NewInt(100),
+		},
+		PoolAssets: []PoolAsset{
+			{Token: sdk.NewInt64Coin("bar", 1_000), Weight: sdk.OneInt()},
+			{Token: sdk.NewInt64Coin("foo", 1_000), Weight: sdk.OneInt()},
+		},
+		TotalShares: sdk.NewInt64Coin("nibiru/pool/1", 1_000),
+		TotalWeight: sdk.NewInt(2),
+	}
+}
+
+func TestJoinSwapExternAmountIn(t *testing.T) {
+	for _, tc := range []struct {
+		name              string
+		poolType          PoolType
+		tokenIn           sdk.Coin
+		expectedErr       error
+		expectedNumShares sdkmath.Int
+	}{
+		{
+			name:     "balancer",
+			poolType: PoolType_BALANCER,
+			tokenIn:  sdk.NewInt64Coin("foo", 100),
+			// 1000 * (sqrt(1 + 100 * 0.995 / 1000) - 1)
+			expectedNumShares: sdk.NewInt(48),
+		},
+		{
+			name:              "stableswap",
+			poolType:          PoolType_STABLESWAP,
+			tokenIn:           sdk.NewInt64Coin("foo", 100),
+			expectedNumShares: sdk.NewInt(49),
+		},
+		{
+			name:        "denom not in pool",
+			poolType:    PoolType_BALANCER,
+			tokenIn:     sdk.NewInt64Coin("baz", 100),
+			expectedErr: ErrTokenDenomNotFound,
+		},
+		{
+			name:        "zero token in",
+			poolType:    PoolType_STABLESWAP,
+			tokenIn:     sdk.NewInt64Coin("foo", 0),
+			expectedErr: ErrInvalidTokenIn,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pool := singleAssetTestPool(tc.poolType)
+			numShares, err := pool.JoinSwapExternAmountIn(tc.tokenIn)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedNumShares, numShares)
+			require.Equal(t, sdk.NewInt(1_000).Add(tc.expectedNumShares), pool.TotalShares.Amount)
+			require.Equal(t, sdk.NewCoins(
+				sdk.NewInt64Coin("bar", 1_000),
+				sdk.NewInt64Coin("foo", 1_100),
+			), pool.PoolBalances())
+		})
+	}
+}
+
+func TestExitSwapShareAmountIn(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		poolType         PoolType
+		exitingShares    sdkmath.Int
+		tokenOutDenom    string
+		expectedErr      error
+		expectedTokenOut sdk.Coin
+		expectedFees     sdk.Coins
+	}{
+		{
+			name:          "balancer",
+			poolType:      PoolType_BALANCER,
+			exitingShares: sdk.NewInt(100),
+			tokenOutDenom: "foo",
+			// 100foo exited, 100bar exited then swapped into 89foo
+			expectedTokenOut: sdk.NewInt64Coin("foo", 189),
+			expectedFees:     sdk.NewCoins(sdk.NewInt64Coin("bar", 1)),
+		},
+		{
+			name:             "stableswap",
+			poolType:         PoolType_STABLESWAP,
+			exitingShares:    sdk.NewInt(100),
+			tokenOutDenom:    "foo",
+			expectedTokenOut: sdk.NewInt64Coin("foo", 199),
+			expectedFees:     sdk.NewCoins(sdk.NewInt64Coin("bar", 1)),
+		},
+		{
+			name:          "denom not in pool",
+			poolType:      PoolType_BALANCER,
+			exitingShares: sdk.NewInt(100),
+			tokenOutDenom: "baz",
+			expectedErr:   ErrTokenDenomNotFound,
+		},
+		{
+			name:          "all pool shares",
+			poolType:      PoolType_BALANCER,
+			exitingShares: sdk.NewInt(1_000),
+			tokenOutDenom: "foo",
+			expectedErr:   ErrNotEnoughLiquidity,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pool := singleAssetTestPool(tc.poolType)
+			tokenOut, fees, err := pool.ExitSwapShareAmountIn(tc.exitingShares, tc.tokenOutDenom)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTokenOut, tokenOut)
+			require.Equal(t, tc.expectedFees, fees)
+			require.Equal(t, sdk.NewInt(900), pool.TotalShares.Amount)
+			// only the token out leaves the pool
+			require.Equal(t, sdk.NewCoins(
+				sdk.NewInt64Coin("bar", 1_000),
+				sdk.NewCoin("foo", sdk.NewInt(1_000).Sub(tc.expectedTokenOut.Amount)),
+			), pool.PoolBalances())
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PoolI is the math of a pool: swaps, joins and exits. Each pool type
+// implements it, and the methods of Pool dispatch to the implementation of the
+// pool's type.
+type PoolI interface {
+	// CalcOutAmtGivenIn calculates the amount of tokenOut given tokenIn, deducting the swap fee.
+	CalcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, noFee bool) (
+		tokenOut sdk.Coin, fee sdk.Coin, err error)
+	// CalcInAmtGivenOut calculates the amount of tokenIn required to obtain tokenOut, accounting for the swap fee.
+	CalcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string) (tokenIn sdk.Coin, err error)
+	// ApplySwap applies a swap of tokenIn for tokenOut to the pool.
+	ApplySwap(tokenIn sdk.Coin, tokenOut sdk.Coin) error
+	// AddTokensToPool adds tokens to the pool in exchange for pool shares.
+	AddTokensToPool(tokensIn sdk.Coins) (numShares sdkmath.Int, remCoins sdk.Coins, err error)
+	// AddAllTokensToPool adds all the tokens to the pool in exchange for pool shares.
+	AddAllTokensToPool(tokensIn sdk.Coins) (numShares sdkmath.Int, remCoins sdk.Coins, err error)
+	// TokensOutFromPoolSharesIn calculates the tokens withdrawn from the pool for pool shares.
+	TokensOutFromPoolSharesIn(numSharesIn sdkmath.Int) (tokensOut sdk.Coins, fees sdk.Coins, err error)
+	// CalcSpotPrice calculates the amount of tokenIn worth one tokenOut.
+	CalcSpotPrice(tokenIn, tokenOut string) (sdk.Dec, error)
+}
+
+var (
+	_ PoolI = (*Pool)(nil)
+	_ PoolI = balancerPool{}
+	_ PoolI = stableswapPool{}
+	_ PoolI = concentratedPool{}
+)
+
+/*
+impl Returns the math of the pool's type, operating on the pool.
+Weighted pools and pools of an unknown type use the balancer math.
+*/
+func (pool *Pool) impl() PoolI {
+	switch pool.PoolParams.PoolType {
+	case PoolType_STABLESWAP:
+		return stableswapPool{pool: pool}
+	case PoolType_CONCENTRATED:
+		return concentratedPool{pool: pool}
+	default:
+		return balancerPool{pool: pool}
+	}
+}
+
+// balancerPool is the math of a constant product pool, weighted for pools of
+// type WEIGHTED.
+type balancerPool struct {
+	pool *Pool
+}
+
+func (p balancerPool) CalcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, noFee bool) (
+	tokenOut sdk.Coin, fee sdk.Coin, err error,
+) {
+	poolAssetIn, poolAssetOut, tokenAmountInAfterFee, fee, err := p.pool.swapAssetsAfterFee(tokenIn, tokenOutDenom, noFee)
+	if err != nil {
+		return tokenOut, fee, err
+	}
+
+	poolTokenInBalance := sdk.NewDecFromInt(poolAssetIn.Token.Amount)
+	poolTokenInBalancePostSwap := poolTokenInBalance.Add(tokenAmountInAfterFee)
+
+	// delta balanceOut is positive(tokens inside the pool decreases)
+	tokenAmountOut := p.pool.solveConstantProductInvariant(
+		/*xPrior=*/ poolTokenInBalance,
+		/*xAfter=*/ poolTokenInBalancePostSwap,
+		/*xWeight=*/ sdk.NewDecFromInt(poolAssetIn.Weight),
+		/*yPrior=*/ sdk.NewDecFromInt(poolAssetOut.Token.Amount),
+		/*yWeight=*/ sdk.NewDecFromInt(poolAssetOut.Weight),
+	).TruncateInt()
+
+	if tokenAmountOut.IsZero() {
+		return tokenOut, fee, fmt.Errorf("tokenIn (%s) must be higher to perform a swap", tokenIn.Denom)
+	}
+
+	return sdk.NewCoin(tokenOutDenom, tokenAmountOut), fee, nil
+}
+
+func (p balancerPool) CalcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string) (sdk.Coin, error) {
+	return p.pool.CalcInAmtGivenOutBalancer(tokenOut, tokenInDenom)
+}
+
+func (p balancerPool) ApplySwap(tokenIn sdk.Coin, tokenOut sdk.Coin) error {
+	return p.pool.applySwapBalances(tokenIn, tokenOut)
+}
+
+func (p balancerPool) AddTokensToPool(tokensIn sdk.Coins) (
+	numShares sdkmath.Int, remCoins sdk.Coins, err error,
+) {
+	if p.pool.TotalShares.Amount.IsZero() {
+		return p.pool.addInitialTokens(tokensIn)
+	}
+
+	numShares, remCoins, err = p.pool.numSharesOutFromTokensIn(tokensIn)
+	if err != nil {
+		return sdk.ZeroInt(), sdk.Coins{}, err
+	}
+	return p.pool.addTokens(numShares, tokensIn, remCoins)
+}
+
+/*
+AddAllTokensToPool maximally joins with all the tokens first, and then
+performs a single asset join with the remaining tokens.
+*/
+func (p balancerPool) AddAllTokensToPool(tokensIn sdk.Coins) (
+	numShares sdkmath.Int, remCoins sdk.Coins, err error,
+) {
+	remCoins = tokensIn
+	if tokensIn.Len() > 1 {
+		numShares, remCoins, err = p.AddTokensToPool(tokensIn)
+	} else {
+		numShares = sdk.ZeroInt()
+	}
+
+	if remCoins.Empty() {
+		return
+	}
+
+	numShares2nd, _, err := p.AddTokensToPool(remCoins)
+	if err != nil {
+		return
+	}
+
+	numShares = numShares2nd.Add(numShares)
+	remCoins = sdk.NewCoins()
+	return
+}
+
+func (p balancerPool) TokensOutFromPoolSharesIn(numSharesIn sdkmath.Int) (
+	tokensOut sdk.Coins, fees sdk.Coins, err error,
+) {
+	return p.pool.proportionalTokensOut(numSharesIn)
+}
+
+func (p balancerPool) CalcSpotPrice(tokenIn, tokenOut string) (sdk.Dec, error) {
+	return p.pool.weightedSpotPrice(tokenIn, tokenOut)
+}
+
+// stableswapPool is the math of a curve stableswap pool.
+type stableswapPool struct {
+	pool *Pool
+}
+
+func (p stableswapPool) CalcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, noFee bool) (
+	tokenOut sdk.Coin, fee sdk.Coin, err error,
+) {
+	_, _, tokenAmountInAfterFee, fee, err := p.pool.swapAssetsAfterFee(tokenIn, tokenOutDenom, noFee)
+	if err != nil {
+		return tokenOut, fee, err
+	}
+
+	tokenAmountOut, err := p.pool.Exchange(sdk.NewCoin(tokenIn.Denom, tokenAmountInAfterFee.TruncateInt()), tokenOutDenom)
+	if err != nil {
+		return tokenOut, fee, err
+	}
+
+	if tokenAmountOut.IsZero() {
+		return tokenOut, fee, fmt.Errorf("tokenIn (%s) must be higher to perform a swap", tokenIn.Denom)
+	}
+
+	return sdk.NewCoin(tokenOutDenom, tokenAmountOut), fee, nil
+}
+
+func (p stableswapPool) CalcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string) (sdk.Coin, error) {
+	return p.pool.CalcInAmtGivenOutStableswap(tokenOut, tokenInDenom)
+}
+
+func (p stableswapPool) ApplySwap(tokenIn sdk.Coin, tokenOut sdk.Coin) error {
+	return p.pool.applySwapBalances(tokenIn, tokenOut)
+}
+
+func (p stableswapPool) AddTokensToPool(tokensIn sdk.Coins) (
+	numShares sdkmath.Int, remCoins sdk.Coins, err error,
+) {
+	if p.pool.TotalShares.Amount.IsZero() {
+		return p.pool.addInitialTokens(tokensIn)
+	}
+
+	numShares, err = p.pool.numSharesOutFromTokensInStableSwap(tokensIn)
+	if err != nil {
+		return sdk.ZeroInt(), sdk.Coins{}, err
+	}
+	return p.pool.addTokens(numShares, tokensIn, sdk.Coins{})
+}
+
+/*
+AddAllTokensToPool is not supported, a stableswap pool already takes all the
+deposit of AddTokensToPool.
+*/
+func (p stableswapPool) AddAllTokensToPool(sdk.Coins) (
+	numShares sdkmath.Int, remCoins sdk.Coins, err error,
+) {
+	return numShares, remCoins, ErrInvalidPoolType
+}
+
+func (p stableswapPool) TokensOutFromPoolSharesIn(numSharesIn sdkmath.Int) (
+	tokensOut sdk.Coins, fees sdk.Coins, err error,
+) {
+	return p.pool.proportionalTokensOut(numSharesIn)
+}
+
+func (p stableswapPool) CalcSpotPrice(tokenIn, tokenOut string) (sdk.Dec, error) {
+	return p.pool.weightedSpotPrice(tokenIn, tokenOut)
+}
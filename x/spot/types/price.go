@@ -2,9 +2,15 @@ package types
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
-// CalcSpotPrice calculates the spot price based on weight.
-// spotPrice = (BalanceIn / WeightIn) / (BalanceOut / WeightOut)
+// CalcSpotPrice calculates the spot price of the pool, the amount of tokenIn
+// worth one tokenOut.
 func (pool Pool) CalcSpotPrice(tokenIn, tokenOut string) (sdk.Dec, error) {
+	return pool.impl().CalcSpotPrice(tokenIn, tokenOut)
+}
+
+// weightedSpotPrice calculates the spot price based on weight.
+// spotPrice = (BalanceIn / WeightIn) / (BalanceOut / WeightOut)
+func (pool Pool) weightedSpotPrice(tokenIn, tokenOut string) (sdk.Dec, error) {
 	_, poolAssetIn, err := pool.getPoolAssetAndIndex(tokenIn)
 	if err != nil {
 		return sdk.Dec{}, err
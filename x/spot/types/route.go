@@ -0,0 +1,143 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+ValidateSwapRoutes checks that the routes form a path of at most
+MaxSwapRouteHops distinct pools starting from tokenInDenom.
+
+args:
+  - routes: the hops of the swap
+  - tokenInDenom: the denom swapped into the first hop
+
+ret:
+  - err: error if any
+*/
+func ValidateSwapRoutes(routes []SwapRoute, tokenInDenom string) error {
+	if len(routes) == 0 {
+		return ErrInvalidSwapRoute.Wrap("routes cannot be empty")
+	}
+	if len(routes) > MaxSwapRouteHops {
+		return ErrInvalidSwapRoute.Wrapf(
+			"a route can have at most %d hops, got %d", MaxSwapRouteHops, len(routes))
+	}
+
+	usedPools := make(map[uint64]bool)
+	denomIn := tokenInDenom
+	for _, route := range routes {
+		if route.PoolId == 0 {
+			return ErrInvalidPoolId.Wrapf("pool id cannot be %d", route.PoolId)
+		}
+		if usedPools[route.PoolId] {
+			return ErrInvalidSwapRoute.Wrapf("pool %d is used more than once", route.PoolId)
+		}
+		usedPools[route.PoolId] = true
+
+		if route.TokenOutDenom == "" {
+			return ErrInvalidTokenOutDenom.Wrap("cannot be empty")
+		}
+		if route.TokenOutDenom == denomIn {
+			return ErrSameTokenDenom.Wrapf("pool %d swaps %s into itself", route.PoolId, denomIn)
+		}
+		denomIn = route.TokenOutDenom
+	}
+
+	return nil
+}
+
+/*
+BestSwapRoute searches the routes of at most maxHops distinct pools swapping
+tokenIn into tokenOutDenom, and returns the one with the largest amount out.
+Routes end as soon as they reach tokenOutDenom. To bound the search, maxHops is
+capped at MaxSwapRouteHops and a denom is only swapped through the
+MaxSwapRouteCandidatePools pools holding the most of it.
+
+args:
+  - pools: the pools to route through
+  - tokenIn: the tokens to swap
+  - tokenOutDenom: the denom to obtain
+  - maxHops: the maximum number of pools of the route
+
+ret:
+  - routes: the hops of the best route
+  - tokenOut: the tokens obtained through the best route
+  - err: ErrNoSwapRouteFound if no route swaps tokenIn into tokenOutDenom
+*/
+func BestSwapRoute(pools []Pool, tokenIn sdk.Coin, tokenOutDenom string, maxHops int) (
+	routes []SwapRoute, tokenOut sdk.Coin, err error,
+) {
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, tokenOut, ErrSameTokenDenom
+	}
+
+	if maxHops > MaxSwapRouteHops {
+		maxHops = MaxSwapRouteHops
+	}
+
+	poolsByDenom := make(map[string][]Pool)
+	for _, pool := range pools {
+		for _, asset := range pool.PoolAssets {
+			poolsByDenom[asset.Token.Denom] = append(poolsByDenom[asset.Token.Denom], pool)
+		}
+	}
+	for denom, candidates := range poolsByDenom {
+		if len(candidates) <= MaxSwapRouteCandidatePools {
+			continue
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].PoolBalances().AmountOf(denom).GT(candidates[j].PoolBalances().AmountOf(denom))
+		})
+		poolsByDenom[denom] = candidates[:MaxSwapRouteCandidatePools]
+	}
+
+	usedPools := make(map[uint64]bool)
+	var search func(token sdk.Coin, path []SwapRoute)
+	search = func(token sdk.Coin, path []SwapRoute) {
+		if len(path) == maxHops {
+			return
+		}
+
+		for _, pool := range poolsByDenom[token.Denom] {
+			if usedPools[pool.Id] {
+				continue
+			}
+
+			for _, asset := range pool.PoolAssets {
+				if asset.Token.Denom == token.Denom {
+					continue
+				}
+
+				out, _, err := pool.CalcOutAmtGivenIn(token, asset.Token.Denom, false)
+				if err != nil || !out.Amount.IsPositive() {
+					continue
+				}
+
+				hops := append(path[:len(path):len(path)], SwapRoute{
+					PoolId:        pool.Id,
+					TokenOutDenom: asset.Token.Denom,
+				})
+				if asset.Token.Denom == tokenOutDenom {
+					if tokenOut.IsNil() || out.Amount.GT(tokenOut.Amount) {
+						routes, tokenOut = hops, out
+					}
+					continue
+				}
+
+				usedPools[pool.Id] = true
+				search(out, hops)
+				delete(usedPools, pool.Id)
+			}
+		}
+	}
+	search(tokenIn, nil)
+
+	if routes == nil {
+		return nil, sdk.Coin{}, ErrNoSwapRouteFound.Wrapf(
+			"from %s to %s in at most %d hops", tokenIn.Denom, tokenOutDenom, maxHops)
+	}
+	return routes, tokenOut, nil
+}
@@ -5,6 +5,8 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/spot/math"
 )
 
 /*
@@ -32,18 +34,28 @@ func (pool Pool) numSharesOutFromTokensIn(tokensIn sdk.Coins) (
 
 	poolLiquidity := pool.PoolBalances()
 	if len(tokensIn) == 1 {
-		// From balancer whitepaper, for 2 assets with the same weight, the shares issued are:
-		// P_{supply} * (sqrt(1+((1-f/2) * x_{in})/X)-1)
+		// From balancer whitepaper, for an asset of normalized weight w, the shares issued are:
+		// P_{supply} * ((1+((1-(1-w)*f) * x_{in})/X)^w-1)
+		// The part (1-w) of the deposit is implicitly swapped into the other assets and pays the swap fee.
+		// Only weighted pools follow the weights of their assets, balancer pools use w = 1/2.
 
 		one := sdk.OneDec()
 
-		joinShare := sdk.NewDecFromInt(tokensIn[0].Amount).Mul(one.Sub(pool.PoolParams.SwapFee.Quo(sdk.NewDec(2)))).QuoInt(
+		weight := sdk.NewDecWithPrec(5, 1)
+		if pool.PoolParams.PoolType == PoolType_WEIGHTED {
+			weight = pool.normalizedWeight(tokensIn[0].Denom)
+		}
+		joinShare := sdk.NewDecFromInt(tokensIn[0].Amount).Mul(one.Sub(one.Sub(weight).Mul(pool.PoolParams.SwapFee))).QuoInt(
 			poolLiquidity.AmountOfNoDenomValidation(tokensIn[0].Denom),
 		).Add(one)
 
-		joinShare, err = joinShare.ApproxSqrt()
-		if err != nil {
-			return
+		if weight.Equal(sdk.NewDecWithPrec(5, 1)) {
+			joinShare, err = joinShare.ApproxSqrt()
+			if err != nil {
+				return
+			}
+		} else {
+			joinShare = math.Pow(joinShare, weight)
 		}
 
 		numShares = joinShare.Sub(one).MulInt(pool.TotalShares.Amount).TruncateInt()
@@ -93,6 +105,18 @@ func (pool Pool) numSharesOutFromTokensIn(tokensIn sdk.Coins) (
 	return numShares, remCoins, nil
 }
 
+/*
+Returns the weight of a pool asset divided by the total weight of the pool.
+Assets are equally weighted if the pool has no total weight.
+*/
+func (pool Pool) normalizedWeight(denom string) sdk.Dec {
+	_, poolAsset, err := pool.getPoolAssetAndIndex(denom)
+	if err != nil || pool.TotalWeight.IsNil() || !pool.TotalWeight.IsPositive() {
+		return sdk.OneDec().QuoInt64(int64(len(pool.PoolAssets)))
+	}
+	return sdk.NewDecFromInt(poolAsset.Weight).QuoInt(pool.TotalWeight)
+}
+
 /*
 For a stableswap pool, takes the amount of tokens desired to add to the pool,
 and calculates the number of pool shares and remaining coins after theoretically
@@ -170,6 +194,23 @@ ret:
 */
 func (pool Pool) TokensOutFromPoolSharesIn(numSharesIn sdkmath.Int) (
 	tokensOut sdk.Coins, fees sdk.Coins, err error,
+) {
+	return pool.impl().TokensOutFromPoolSharesIn(numSharesIn)
+}
+
+/*
+Calculates the share of every pool asset withdrawn for LP shares, deducting the exit fee.
+
+args:
+  - numSharesIn: number of LP shares to return to the pool
+
+ret:
+  - tokensOut: the tokens withdrawn from the pool
+  - fees: the fees collected
+  - err: error if any
+*/
+func (pool Pool) proportionalTokensOut(numSharesIn sdkmath.Int) (
+	tokensOut sdk.Coins, fees sdk.Coins, err error,
 ) {
 	if numSharesIn.IsZero() {
 		return nil, nil, errors.New("num shares in must be greater than zero")
@@ -219,6 +260,127 @@ func (pool Pool) MinSharesInForTokensOut() (minShares sdkmath.Int) {
 	return
 }
 
+// maxAmountSearchSteps bounds the number of steps searching for an amount of
+// tokens or shares.
+const maxAmountSearchSteps = 256
+
+/*
+TokenInForExactSharesOut Calculates the amount of a single token to join the pool with
+in order to obtain at least numSharesOut pool shares. Inverse of JoinSwapExternAmountIn.
+
+Note that this function is pure/read-only. It only calculates the theoretical amount
+and doesn't modify the actual state.
+
+args:
+  - numSharesOut: the number of LP shares to obtain
+  - tokenInDenom: the denom of the token to join the pool with
+
+ret:
+  - tokenIn: the tokens to join the pool with
+  - err: error if any
+*/
+func (pool Pool) TokenInForExactSharesOut(numSharesOut sdkmath.Int, tokenInDenom string) (
+	tokenIn sdk.Coin, err error,
+) {
+	if !numSharesOut.IsPositive() {
+		return sdk.Coin{}, errors.New("num shares out must be greater than zero")
+	}
+	if _, _, err = pool.getPoolAssetAndIndex(tokenInDenom); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	enough := func(amount sdkmath.Int) bool {
+		poolCopy := pool.deepCopy()
+		numShares, err := poolCopy.JoinSwapExternAmountIn(sdk.NewCoin(tokenInDenom, amount))
+		return err == nil && numShares.GTE(numSharesOut)
+	}
+
+	amount, err := searchMinAmount(sdk.OneInt(), nil, enough)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(tokenInDenom, amount), nil
+}
+
+/*
+SharesInForExactTokenOut Calculates the number of pool shares to exit the pool with
+in order to withdraw at least tokenOut. Inverse of ExitSwapShareAmountIn.
+
+Note that this function is pure/read-only. It only calculates the theoretical amount
+and doesn't modify the actual state.
+
+args:
+  - tokenOut: the tokens to withdraw
+
+ret:
+  - numSharesIn: the number of LP shares to return to the pool
+  - err: error if any
+*/
+func (pool Pool) SharesInForExactTokenOut(tokenOut sdk.Coin) (
+	numSharesIn sdkmath.Int, err error,
+) {
+	if !tokenOut.Amount.IsPositive() {
+		return sdkmath.Int{}, errors.New("token out must be greater than zero")
+	}
+	if _, _, err = pool.getPoolAssetAndIndex(tokenOut.Denom); err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	enough := func(shares sdkmath.Int) bool {
+		poolCopy := pool.deepCopy()
+		out, _, err := poolCopy.ExitSwapShareAmountIn(shares, tokenOut.Denom)
+		return err == nil && out.Amount.GTE(tokenOut.Amount)
+	}
+
+	maxShares := pool.TotalShares.Amount.SubRaw(1)
+	return searchMinAmount(sdk.OneInt(), &maxShares, enough)
+}
+
+/*
+searchMinAmount Finds the smallest amount in [lo, hi] for which enough returns true,
+enough being monotonic up to the first amount which is enough. The upper bound is
+found by doubling lo, capped at hi if not nil.
+
+args:
+  - lo: the lower bound of the search
+  - hi: the upper bound of the search, nil if unbounded
+  - enough: whether an amount is enough
+
+ret:
+  - amount: the smallest amount which is enough
+  - err: ErrNotEnoughLiquidity if no amount is enough
+*/
+func searchMinAmount(lo sdkmath.Int, hi *sdkmath.Int, enough func(sdkmath.Int) bool) (
+	amount sdkmath.Int, err error,
+) {
+	if hi != nil && hi.LT(lo) {
+		return sdkmath.Int{}, ErrNotEnoughLiquidity
+	}
+
+	upper := lo
+	for step := 0; !enough(upper); step++ {
+		if step == maxAmountSearchSteps || (hi != nil && upper.Equal(*hi)) {
+			return sdkmath.Int{}, ErrNotEnoughLiquidity
+		}
+		lo = upper.AddRaw(1)
+		upper = upper.MulRaw(2)
+		if hi != nil && upper.GT(*hi) {
+			upper = *hi
+		}
+	}
+
+	// invariant: upper is enough, every amount below lo is not
+	for lo.LT(upper) {
+		mid := lo.Add(upper).QuoRaw(2)
+		if enough(mid) {
+			upper = mid
+		} else {
+			lo = mid.AddRaw(1)
+		}
+	}
+	return upper, nil
+}
+
 /*
 Adds new liquidity to the pool and increments the total number of shares.
 
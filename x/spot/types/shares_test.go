@@ -497,3 +497,118 @@ func TestNumSharesOutStableswap(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenInForExactSharesOut(t *testing.T) {
+	for _, poolType := range []PoolType{PoolType_BALANCER, PoolType_STABLESWAP} {
+		pool := singleAssetTestPool(poolType)
+
+		tokenIn, err := pool.TokenInForExactSharesOut(sdk.NewInt(48), "foo")
+		require.NoError(t, err)
+
+		// the estimate is the smallest amount minting the shares
+		poolCopy := pool.deepCopy()
+		numShares, err := poolCopy.JoinSwapExternAmountIn(tokenIn)
+		require.NoError(t, err)
+		require.True(t, numShares.GTE(sdk.NewInt(48)))
+
+		poolCopy = pool.deepCopy()
+		numShares, err = poolCopy.JoinSwapExternAmountIn(tokenIn.SubAmount(sdk.OneInt()))
+		require.NoError(t, err)
+		require.True(t, numShares.LT(sdk.NewInt(48)))
+
+		// the pool is not modified
+		require.Equal(t, singleAssetTestPool(poolType), pool)
+
+		_, err = pool.TokenInForExactSharesOut(sdk.NewInt(48), "baz")
+		require.ErrorIs(t, err, ErrTokenDenomNotFound)
+
+		_, err = pool.TokenInForExactSharesOut(sdk.ZeroInt(), "foo")
+		require.Error(t, err)
+	}
+}
+
+func TestSharesInForExactTokenOut(t *testing.T) {
+	for _, tc := range []struct {
+		name                string
+		poolType            PoolType
+		tokenOut            sdk.Coin
+		expectedErr         error
+		expectedNumSharesIn sdkmath.Int
+	}{
+		{
+			name:                "balancer",
+			poolType:            PoolType_BALANCER,
+			tokenOut:            sdk.NewInt64Coin("foo", 180),
+			expectedNumSharesIn: sdk.NewInt(95),
+		},
+		{
+			name:                "stableswap",
+			poolType:            PoolType_STABLESWAP,
+			tokenOut:            sdk.NewInt64Coin("foo", 180),
+			expectedNumSharesIn: sdk.NewInt(91),
+		},
+		{
+			name:        "more than the pool can give",
+			poolType:    PoolType_BALANCER,
+			tokenOut:    sdk.NewInt64Coin("foo", 1_000),
+			expectedErr: ErrNotEnoughLiquidity,
+		},
+		{
+			name:        "denom not in pool",
+			poolType:    PoolType_BALANCER,
+			tokenOut:    sdk.NewInt64Coin("baz", 100),
+			expectedErr: ErrTokenDenomNotFound,
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pool := singleAssetTestPool(tc.poolType)
+			numSharesIn, err := pool.SharesInForExactTokenOut(tc.tokenOut)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedNumSharesIn, numSharesIn)
+
+			poolCopy := pool.deepCopy()
+			tokenOut, _, err := poolCopy.ExitSwapShareAmountIn(numSharesIn.SubRaw(1), tc.tokenOut.Denom)
+			require.NoError(t, err)
+			require.True(t, tokenOut.Amount.LT(tc.tokenOut.Amount))
+		})
+	}
+}
+
+func TestNumSharesOutWeightedSingleAsset(t *testing.T) {
+	// 80/20 pool
+	pool := Pool{
+		PoolParams: PoolParams{
+			PoolType: PoolType_WEIGHTED,
+			SwapFee:  sdk.MustNewDecFromStr("0.1"),
+		},
+		PoolAssets: []PoolAsset{
+			{Token: sdk.NewInt64Coin("aaa", 1_000), Weight: sdk.NewInt(4)},
+			{Token: sdk.NewInt64Coin("bbb", 1_000), Weight: sdk.OneInt()},
+		},
+		TotalShares: sdk.NewInt64Coin("nibiru/pool/1", 1_000),
+		TotalWeight: sdk.NewInt(5),
+	}
+
+	// 1000 * ((1 + 100*(1-0.2*0.1)/1000)^0.8 - 1)
+	numShares, _, err := pool.numSharesOutFromTokensIn(sdk.NewCoins(sdk.NewInt64Coin("aaa", 100)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(77), numShares)
+
+	// 1000 * ((1 + 100*(1-0.8*0.1)/1000)^0.2 - 1)
+	numShares, _, err = pool.numSharesOutFromTokensIn(sdk.NewCoins(sdk.NewInt64Coin("bbb", 100)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(17), numShares)
+
+	// balancer pools ignore the weights
+	// 1000 * (sqrt(1 + 100*(1-0.1/2)/1000) - 1)
+	pool.PoolParams.PoolType = PoolType_BALANCER
+	numShares, _, err = pool.numSharesOutFromTokensIn(sdk.NewCoins(sdk.NewInt64Coin("aaa", 100)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(46), numShares)
+}
@@ -3,8 +3,6 @@ package types
 import (
 	"fmt"
 
-	sdkmath "cosmossdk.io/math"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/x/spot/math"
@@ -12,7 +10,7 @@ import (
 
 /*
 CalcOutAmtGivenIn Calculates the amount of tokenOut given tokenIn, deducting the swap fee.
-Solved using the SolveConstantProductInvariant AMM curve.
+Solved using the curve of the pool type.
 Only supports single asset swaps.
 
 args:
@@ -28,17 +26,38 @@ ret:
 func (pool Pool) CalcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, noFee bool) (
 	tokenOut sdk.Coin, fee sdk.Coin, err error,
 ) {
-	_, poolAssetIn, err := pool.getPoolAssetAndIndex(tokenIn.Denom)
+	return pool.impl().CalcOutAmtGivenIn(tokenIn, tokenOutDenom, noFee)
+}
+
+/*
+Finds the pool assets of a swap and deducts the swap fee from tokenIn.
+
+args:
+  - tokenIn: the amount of tokens to swap
+  - tokenOutDenom: the target token denom
+  - noFee: whether we want to bypass swap fee
+
+ret:
+  - poolAssetIn: the pool asset of tokenIn
+  - poolAssetOut: the pool asset of tokenOutDenom
+  - tokenAmountInAfterFee: the amount of tokenIn swapped along the curve
+  - fee: the fee deducted from tokenIn
+  - err: error if any
+*/
+func (pool Pool) swapAssetsAfterFee(tokenIn sdk.Coin, tokenOutDenom string, noFee bool) (
+	poolAssetIn PoolAsset, poolAssetOut PoolAsset, tokenAmountInAfterFee sdk.Dec, fee sdk.Coin, err error,
+) {
+	_, poolAssetIn, err = pool.getPoolAssetAndIndex(tokenIn.Denom)
 	if err != nil {
-		return tokenOut, fee, err
+		return
 	}
 
-	_, poolAssetOut, err := pool.getPoolAssetAndIndex(tokenOutDenom)
+	_, poolAssetOut, err = pool.getPoolAssetAndIndex(tokenOutDenom)
 	if err != nil {
-		return tokenOut, fee, err
+		return
 	}
 
-	var tokenAmountInAfterFee sdk.Dec
+	// deduct swapfee on the in asset
 	if noFee {
 		tokenAmountInAfterFee = sdk.NewDecFromInt(tokenIn.Amount)
 	} else {
@@ -47,33 +66,7 @@ func (pool Pool) CalcOutAmtGivenIn(tokenIn sdk.Coin, tokenOutDenom string, noFee
 	feeAmount := sdk.NewDecFromInt(tokenIn.Amount).Sub(tokenAmountInAfterFee)
 	fee = sdk.NewCoin(tokenIn.Denom, feeAmount.TruncateInt())
 
-	poolTokenInBalance := sdk.NewDecFromInt(poolAssetIn.Token.Amount)
-	poolTokenInBalancePostSwap := poolTokenInBalance.Add(tokenAmountInAfterFee)
-
-	// deduct swapfee on the in asset
-	// delta balanceOut is positive(tokens inside the pool decreases)
-	var tokenAmountOut sdkmath.Int
-	if pool.PoolParams.PoolType == PoolType_STABLESWAP {
-		tokenAmountOut, err = pool.Exchange(sdk.NewCoin(tokenIn.Denom, tokenAmountInAfterFee.TruncateInt()), tokenOutDenom)
-
-		if err != nil {
-			return
-		}
-	} else if pool.PoolParams.PoolType == PoolType_BALANCER {
-		tokenAmountOut = math.SolveConstantProductInvariant(
-			/*xPrior=*/ poolTokenInBalance,
-			/*xAfter=*/ poolTokenInBalancePostSwap,
-			/*xWeight=*/ sdk.NewDecFromInt(poolAssetIn.Weight),
-			/*yPrior=*/ sdk.NewDecFromInt(poolAssetOut.Token.Amount),
-			/*yWeight=*/ sdk.NewDecFromInt(poolAssetOut.Weight),
-		).TruncateInt()
-	}
-
-	if tokenAmountOut.IsZero() {
-		return tokenOut, fee, fmt.Errorf("tokenIn (%s) must be higher to perform a swap", tokenIn.Denom)
-	}
-
-	return sdk.NewCoin(tokenOutDenom, tokenAmountOut), fee, nil
+	return poolAssetIn, poolAssetOut, tokenAmountInAfterFee, fee, nil
 }
 
 /*
@@ -93,22 +86,56 @@ ret:
 func (pool Pool) CalcInAmtGivenOut(tokenOut sdk.Coin, tokenInDenom string) (
 	tokenIn sdk.Coin, err error,
 ) {
-	if pool.PoolParams.PoolType == PoolType_BALANCER {
-		return pool.CalcInAmtGivenOutBalancer(tokenOut, tokenInDenom)
-	} else if pool.PoolParams.PoolType == PoolType_STABLESWAP {
-		return pool.CalcInAmtGivenOutStableswap(tokenOut, tokenInDenom)
-	}
-	return sdk.Coin{}, ErrInvalidPoolType
+	return pool.impl().CalcInAmtGivenOut(tokenOut, tokenInDenom)
 }
 
 /*
-Calculates the amount of tokenIn required to obtain tokenOut coins from a swap,
-accounting for additional fees. This is not implemented yet in curve and in Nibiru.
+Calculates the amount of tokenIn required to obtain tokenOut coins from a swap
+of a stableswap pool, accounting for additional fees.
+The invariant is symmetric in the pool assets, so the balance of tokenIn post
+swap is solved from the balance of tokenOut post swap, the same way Exchange
+solves the balance of tokenOut.
+
+args:
+  - tokenOut: the amount of tokens to swap
+  - tokenInDenom: the target token denom
+
+ret:
+  - tokenIn: the tokens required by the swap
+  - err: error if any
 */
 func (pool Pool) CalcInAmtGivenOutStableswap(tokenOut sdk.Coin, tokenInDenom string) (
 	tokenIn sdk.Coin, err error,
 ) {
-	return sdk.Coin{}, ErrNotImplemented
+	_, poolAssetOut, err := pool.getPoolAssetAndIndex(tokenOut.Denom)
+	if err != nil {
+		return tokenIn, err
+	}
+
+	_, poolAssetIn, err := pool.getPoolAssetAndIndex(tokenInDenom)
+	if err != nil {
+		return tokenIn, err
+	}
+
+	if tokenOut.Amount.GTE(poolAssetOut.Token.Amount) {
+		return tokenIn, fmt.Errorf("tokenOut (%s) must be lower than the pool balance", tokenOut.Denom)
+	}
+
+	poolTokenInBalancePostSwap, err := pool.SolveStableswapInvariant(poolAssetOut.Token.Sub(tokenOut), tokenInDenom)
+	if err != nil {
+		return tokenIn, err
+	}
+
+	// the invariant is solved iteratively up to one unit, round in favour of
+	// the pool
+	tokenAmountIn := poolTokenInBalancePostSwap.Sub(poolAssetIn.Token.Amount).AddRaw(1)
+	if !tokenAmountIn.IsPositive() {
+		return tokenIn, fmt.Errorf("tokenOut (%s) must be higher to perform a swap", tokenOut.Denom)
+	}
+
+	// the swap fee is deducted from the input asset, see CalcInAmtGivenOutBalancer
+	tokenAmountInBeforeFee := sdk.NewDecFromInt(tokenAmountIn).Quo(sdk.OneDec().Sub(pool.PoolParams.SwapFee)).Ceil().TruncateInt()
+	return sdk.NewCoin(tokenInDenom, tokenAmountInBeforeFee), nil
 }
 
 /*
@@ -138,11 +165,15 @@ func (pool Pool) CalcInAmtGivenOutBalancer(tokenOut sdk.Coin, tokenInDenom strin
 		return tokenIn, err
 	}
 
+	if tokenOut.Amount.GTE(poolAssetOut.Token.Amount) {
+		return tokenIn, fmt.Errorf("tokenOut (%s) must be lower than the pool balance", tokenOut.Denom)
+	}
+
 	// assuming the user wishes to withdraw 'tokenOut', the balance of 'tokenOut' post swap will be lower
 	poolTokenOutBalance := sdk.NewDecFromInt(poolAssetOut.Token.Amount)
 	poolTokenOutBalancePostSwap := poolTokenOutBalance.Sub(sdk.NewDecFromInt(tokenOut.Amount))
 	// (x_0)(y_0) = (x_0 + in)(y_0 - out)
-	tokenAmountIn := math.SolveConstantProductInvariant(
+	tokenAmountIn := pool.solveConstantProductInvariant(
 		/*xPrior=*/ poolTokenOutBalance,
 		/*xAfter=*/ poolTokenOutBalancePostSwap,
 		/*xWeight=*/ sdk.NewDecFromInt(poolAssetOut.Weight),
@@ -158,6 +189,18 @@ func (pool Pool) CalcInAmtGivenOutBalancer(tokenOut sdk.Coin, tokenInDenom strin
 	return sdk.NewCoin(tokenInDenom, tokenAmountInBeforeFee), nil
 }
 
+/*
+Solves the constant product invariant of the pool, see math.SolveConstantProductInvariant.
+Only weighted pools follow the weights of their assets, balancer pools keep
+swapping as xy=k.
+*/
+func (pool Pool) solveConstantProductInvariant(xPrior, xAfter, xWeight, yPrior, yWeight sdk.Dec) sdk.Dec {
+	if pool.PoolParams.PoolType == PoolType_WEIGHTED {
+		return math.SolveWeightedConstantProductInvariant(xPrior, xAfter, xWeight, yPrior, yWeight)
+	}
+	return math.SolveConstantProductInvariant(xPrior, xAfter, xWeight, yPrior, yWeight)
+}
+
 /*
 Applies a swap to the pool by adding tokenIn and removing tokenOut from pool asset balances.
 
@@ -169,6 +212,52 @@ ret:
   - err: error if any
 */
 func (pool *Pool) ApplySwap(tokenIn sdk.Coin, tokenOut sdk.Coin) (err error) {
+	return pool.impl().ApplySwap(tokenIn, tokenOut)
+}
+
+/*
+ApplySwapWithProtocolFee Applies a swap to the pool like ApplySwap, then removes
+the protocol share of the swap fee from the pool asset balances. Concentrated
+liquidity pools leave the protocol share out of the fee growth of the swap, so
+it is not owed to the positions.
+
+args:
+  - tokenIn: the amount of token to deposit, fees included
+  - tokenOut: the amount of token to withdraw
+  - protocolFee: the protocol share of the swap fee
+  - protocolFeeShare: the share of the swap fees taken by the protocol
+
+ret:
+  - err: error if any
+*/
+func (pool *Pool) ApplySwapWithProtocolFee(
+	tokenIn sdk.Coin, tokenOut sdk.Coin, protocolFee sdk.Coin, protocolFeeShare sdk.Dec,
+) (err error) {
+	if pool.PoolParams.PoolType == PoolType_CONCENTRATED {
+		err = concentratedPool{pool: pool}.applySwap(tokenIn, tokenOut, protocolFeeShare)
+	} else {
+		err = pool.ApplySwap(tokenIn, tokenOut)
+	}
+	if err != nil {
+		return err
+	}
+	if !protocolFee.IsPositive() {
+		return nil
+	}
+	return pool.DeductProtocolFee(protocolFee)
+}
+
+/*
+Adds tokenIn to and removes tokenOut from the pool asset balances.
+
+args:
+  - tokenIn: the amount of token to deposit
+  - tokenOut: the amount of token to withdraw
+
+ret:
+  - err: error if any
+*/
+func (pool *Pool) applySwapBalances(tokenIn sdk.Coin, tokenOut sdk.Coin) (err error) {
 	if tokenIn.Amount.LTE(sdk.ZeroInt()) {
 		return fmt.Errorf("tokenIn (%s) cannot be zero", tokenIn.Denom)
 	}
@@ -191,3 +280,23 @@ func (pool *Pool) ApplySwap(tokenIn sdk.Coin, tokenOut sdk.Coin) (err error) {
 
 	return pool.updatePoolAssetBalances(poolAssetIn.Token, poolAssetOut.Token)
 }
+
+/*
+DeductProtocolFee Removes the protocol share of the swap fees from the pool asset
+balances.
+
+args:
+  - protocolFee: the protocol share of the swap fees
+
+ret:
+  - err: error if any
+*/
+func (pool *Pool) DeductProtocolFee(protocolFee sdk.Coin) (err error) {
+	_, poolAsset, err := pool.getPoolAssetAndIndex(protocolFee.Denom)
+	if err != nil {
+		return err
+	}
+
+	poolAsset.Token.Amount = poolAsset.Token.Amount.Sub(protocolFee.Amount)
+	return pool.updatePoolAssetBalances(poolAsset.Token)
+}
@@ -154,6 +154,43 @@ func TestCalcOutAmtGivenIn(t *testing.T) {
 	}
 }
 
+func TestCalcAmtWeightedPool(t *testing.T) {
+	// 80/20 pool
+	pool := Pool{
+		PoolParams: PoolParams{
+			PoolType: PoolType_WEIGHTED,
+			SwapFee:  sdk.ZeroDec(),
+		},
+		PoolAssets: []PoolAsset{
+			{Token: sdk.NewInt64Coin("aaa", 1_000), Weight: sdk.NewInt(4)},
+			{Token: sdk.NewInt64Coin("bbb", 1_000), Weight: sdk.OneInt()},
+		},
+		TotalWeight: sdk.NewInt(5),
+	}
+
+	// 1000 * (1 - (1000/1100)^(4/1))
+	tokenOut, _, err := pool.CalcOutAmtGivenIn(sdk.NewInt64Coin("aaa", 100), "bbb", false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("bbb", 316), tokenOut)
+
+	// 1000 * (1 - (1000/1100)^(1/4))
+	tokenOut, _, err = pool.CalcOutAmtGivenIn(sdk.NewInt64Coin("bbb", 100), "aaa", false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("aaa", 23), tokenOut)
+
+	// 1000 * ((1000/684)^(1/4) - 1)
+	tokenIn, err := pool.CalcInAmtGivenOut(sdk.NewInt64Coin("bbb", 316), "aaa")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("aaa", 100), tokenIn)
+
+	// balancer pools ignore the weights
+	// 1000 * (1 - (1000/1100))
+	pool.PoolParams.PoolType = PoolType_BALANCER
+	tokenOut, _, err = pool.CalcOutAmtGivenIn(sdk.NewInt64Coin("aaa", 100), "bbb", false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("bbb", 90), tokenOut)
+}
+
 func TestCalcInAmtGivenOut(t *testing.T) {
 	for _, tc := range []struct {
 		name            string
@@ -266,6 +303,78 @@ func TestCalcInAmtGivenOut(t *testing.T) {
 	}
 }
 
+func TestCalcInAmtGivenOutStableswap(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		balances []int64
+		amp      int64
+		swapFee  string
+		tokenOut sdk.Coin
+	}{
+		{
+			name:     "balanced pool",
+			balances: []int64{1_000_000, 1_000_000},
+			amp:      100,
+			swapFee:  "0.0003",
+			tokenOut: sdk.NewInt64Coin("bbb", 10_000),
+		},
+		{
+			name:     "imbalanced pool, low amplification",
+			balances: []int64{3_498_723, 1_318_504},
+			amp:      2,
+			swapFee:  "0.003",
+			tokenOut: sdk.NewInt64Coin("bbb", 38_877),
+		},
+		{
+			name:     "three assets, most of the balance out",
+			balances: []int64{5_000_000, 4_000_000, 6_000_000},
+			amp:      1000,
+			swapFee:  "0.01",
+			tokenOut: sdk.NewInt64Coin("bbb", 3_500_000),
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			pool := Pool{
+				PoolParams: PoolParams{
+					PoolType: PoolType_STABLESWAP,
+					SwapFee:  sdk.MustNewDecFromStr(tc.swapFee),
+					A:        sdk.NewInt(tc.amp),
+				},
+			}
+			for i, balance := range tc.balances {
+				pool.PoolAssets = append(pool.PoolAssets, PoolAsset{
+					Token:  sdk.NewInt64Coin([]string{"aaa", "bbb", "ccc"}[i], balance),
+					Weight: sdk.OneInt(),
+				})
+			}
+
+			tokenIn, err := pool.CalcInAmtGivenOut(tc.tokenOut, "aaa")
+			require.NoError(t, err)
+
+			// the tokens in are enough to obtain the tokens out, with at most a
+			// couple of units of rounding in favour of the pool
+			tokenOut, _, err := pool.CalcOutAmtGivenIn(tokenIn, tc.tokenOut.Denom, false)
+			require.NoError(t, err)
+			require.True(t, tokenOut.Amount.GTE(tc.tokenOut.Amount), "got %s, expected at least %s", tokenOut, tc.tokenOut)
+
+			tokenOut, _, err = pool.CalcOutAmtGivenIn(tokenIn.SubAmount(sdk.NewInt(3)), tc.tokenOut.Denom, false)
+			require.NoError(t, err)
+			require.True(t, tokenOut.Amount.LT(tc.tokenOut.Amount), "got %s, expected less than %s", tokenOut, tc.tokenOut)
+		})
+	}
+
+	pool := Pool{
+		PoolParams: PoolParams{PoolType: PoolType_STABLESWAP, SwapFee: sdk.ZeroDec(), A: sdk.NewInt(100)},
+		PoolAssets: []PoolAsset{
+			{Token: sdk.NewInt64Coin("aaa", 100), Weight: sdk.OneInt()},
+			{Token: sdk.NewInt64Coin("bbb", 100), Weight: sdk.OneInt()},
+		},
+	}
+	_, err := pool.CalcInAmtGivenOut(sdk.NewInt64Coin("bbb", 100), "aaa")
+	require.ErrorContains(t, err, "must be lower than the pool balance")
+}
+
 func TestApplySwap(t *testing.T) {
 	for _, tc := range []struct {
 		name               string
@@ -0,0 +1,96 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTwapRecord returns the first TWAP record of a pool, with the spot prices of
+// every ordered pair of its assets and empty cumulative prices.
+func NewTwapRecord(pool Pool, timestampMs int64) TwapRecord {
+	record := TwapRecord{
+		PoolId:      pool.Id,
+		TimestampMs: timestampMs,
+	}
+	for _, base := range pool.PoolAssets {
+		for _, quote := range pool.PoolAssets {
+			if base.Token.Denom == quote.Token.Denom {
+				continue
+			}
+			spotPrice, ok := pool.twapSpotPrice(base.Token.Denom, quote.Token.Denom)
+			if !ok {
+				spotPrice = sdk.ZeroDec()
+			}
+			record.Accumulators = append(record.Accumulators, TwapAccumulator{
+				BaseDenom:       base.Token.Denom,
+				QuoteDenom:      quote.Token.Denom,
+				SpotPrice:       spotPrice,
+				CumulativePrice: sdk.ZeroDec(),
+			})
+		}
+	}
+	return record
+}
+
+// Next returns the record following this one at a later time, once the
+// balances of the pool changed. The spot prices of this record are
+// accumulated until the new timestamp, and the new spot prices are read from
+// the pool. A spot price that can't be computed, e.g. when the pool is empty,
+// keeps its previous value.
+func (record TwapRecord) Next(pool Pool, timestampMs int64) TwapRecord {
+	next := TwapRecord{
+		PoolId:       record.PoolId,
+		TimestampMs:  timestampMs,
+		Accumulators: make([]TwapAccumulator, len(record.Accumulators)),
+	}
+	for i, acc := range record.Accumulators {
+		spotPrice, ok := pool.twapSpotPrice(acc.BaseDenom, acc.QuoteDenom)
+		if !ok {
+			spotPrice = acc.SpotPrice
+		}
+		next.Accumulators[i] = TwapAccumulator{
+			BaseDenom:       acc.BaseDenom,
+			QuoteDenom:      acc.QuoteDenom,
+			SpotPrice:       spotPrice,
+			CumulativePrice: acc.cumulativePriceAt(record.TimestampMs, timestampMs),
+		}
+	}
+	return next
+}
+
+// CumulativePriceAt returns the cumulative price of the base asset in the
+// quote asset at a time, which must not be before the record.
+func (record TwapRecord) CumulativePriceAt(baseDenom, quoteDenom string, timestampMs int64) (sdk.Dec, error) {
+	if timestampMs < record.TimestampMs {
+		return sdk.Dec{}, ErrNoValidTwap.Wrapf(
+			"time %d is before the record of pool %d at %d", timestampMs, record.PoolId, record.TimestampMs)
+	}
+	for _, acc := range record.Accumulators {
+		if acc.BaseDenom == baseDenom && acc.QuoteDenom == quoteDenom {
+			return acc.cumulativePriceAt(record.TimestampMs, timestampMs), nil
+		}
+	}
+	return sdk.Dec{}, ErrNoValidTwap.Wrapf(
+		"pool %d has no price of %s in %s", record.PoolId, baseDenom, quoteDenom)
+}
+
+// cumulativePriceAt extends the cumulative price with the spot price held
+// from the time of the record.
+func (acc TwapAccumulator) cumulativePriceAt(recordTimestampMs, timestampMs int64) sdk.Dec {
+	return acc.CumulativePrice.Add(acc.SpotPrice.MulInt64(timestampMs - recordTimestampMs))
+}
+
+// twapSpotPrice returns the amount of quote asset worth one base asset, or
+// false if the pool doesn't hold both assets.
+func (pool Pool) twapSpotPrice(baseDenom, quoteDenom string) (sdk.Dec, bool) {
+	if pool.PoolParams.PoolType != PoolType_CONCENTRATED {
+		balances := pool.PoolBalances()
+		if !balances.AmountOf(baseDenom).IsPositive() || !balances.AmountOf(quoteDenom).IsPositive() {
+			return sdk.Dec{}, false
+		}
+	}
+	spotPrice, err := pool.CalcSpotPrice(quoteDenom, baseDenom)
+	if err != nil {
+		return sdk.Dec{}, false
+	}
+	return spotPrice, true
+}
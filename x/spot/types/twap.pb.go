@@ -0,0 +1,735 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nibiru/spot/v1/twap.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TwapRecord is a snapshot of the cumulative prices of a pool, written every
+// time the pool's balances change.
+type TwapRecord struct {
+	// the pool's numeric id
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// the block time of the snapshot in milliseconds
+	TimestampMs int64 `protobuf:"varint,2,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	// one accumulator per ordered pair of assets of the pool
+	Accumulators []TwapAccumulator `protobuf:"bytes,3,rep,name=accumulators,proto3" json:"accumulators"`
+}
+
+func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
+func (m *TwapRecord) String() string { return proto.CompactTextString(m) }
+func (*TwapRecord) ProtoMessage()    {}
+func (*TwapRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bbd84ec2c420f28, []int{0}
+}
+func (m *TwapRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapRecord.Merge(m, src)
+}
+func (m *TwapRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapRecord proto.InternalMessageInfo
+
+func (m *TwapRecord) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *TwapRecord) GetTimestampMs() int64 {
+	if m != nil {
+		return m.TimestampMs
+	}
+	return 0
+}
+
+func (m *TwapRecord) GetAccumulators() []TwapAccumulator {
+	if m != nil {
+		return m.Accumulators
+	}
+	return nil
+}
+
+// TwapAccumulator accumulates the spot price of a base asset in a quote asset
+// over time.
+type TwapAccumulator struct {
+	BaseDenom  string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// the amount of quote asset worth one base asset after the snapshot
+	SpotPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=spot_price,json=spotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spot_price"`
+	// the sum of the spot prices weighted by their duration in milliseconds,
+	// since the creation of the pool
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price"`
+}
+
+func (m *TwapAccumulator) Reset()         { *m = TwapAccumulator{} }
+func (m *TwapAccumulator) String() string { return proto.CompactTextString(m) }
+func (*TwapAccumulator) ProtoMessage()    {}
+func (*TwapAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bbd84ec2c420f28, []int{1}
+}
+func (m *TwapAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapAccumulator.Merge(m, src)
+}
+func (m *TwapAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapAccumulator proto.InternalMessageInfo
+
+func (m *TwapAccumulator) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *TwapAccumulator) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*TwapRecord)(nil), "nibiru.spot.v1.TwapRecord")
+	proto.RegisterType((*TwapAccumulator)(nil), "nibiru.spot.v1.TwapAccumulator")
+}
+
+func init() { proto.RegisterFile("nibiru/spot/v1/twap.proto", fileDescriptor_6bbd84ec2c420f28) }
+
+var fileDescriptor_6bbd84ec2c420f28 = []byte{
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4f, 0x4f, 0xfa, 0x30,
+	0x1c, 0xc6, 0x57, 0x46, 0xf8, 0x85, 0x42, 0x7e, 0x98, 0xc5, 0xc4, 0x69, 0xe2, 0x86, 0x1c, 0x0c,
+	0x31, 0xb1, 0x0b, 0xfa, 0x0a, 0x44, 0x2e, 0x1c, 0x30, 0x66, 0xf1, 0xa2, 0x97, 0x65, 0x7f, 0x1a,
+	0x68, 0xa4, 0xb4, 0xae, 0x1d, 0xe8, 0xbb, 0x30, 0xf1, 0x4d, 0x71, 0xe4, 0x68, 0x3c, 0x10, 0x03,
+	0x2f, 0xc3, 0x8b, 0x69, 0x37, 0x45, 0x3c, 0x7a, 0xda, 0xf6, 0x3c, 0xcf, 0x3e, 0xdf, 0x27, 0xed,
+	0x17, 0xee, 0x4f, 0x48, 0x44, 0xd2, 0xcc, 0x13, 0x9c, 0x49, 0x6f, 0xda, 0xf1, 0xe4, 0x2c, 0xe4,
+	0x88, 0xa7, 0x4c, 0x32, 0xeb, 0x7f, 0x6e, 0x21, 0x65, 0xa1, 0x69, 0xe7, 0x60, 0x77, 0xc8, 0x86,
+	0x4c, 0x5b, 0x9e, 0x7a, 0xcb, 0x53, 0xad, 0x17, 0x00, 0xe1, 0xcd, 0x2c, 0xe4, 0x3e, 0x8e, 0x59,
+	0x9a, 0x58, 0x7b, 0xf0, 0x1f, 0x67, 0x6c, 0x1c, 0x90, 0xc4, 0x06, 0x4d, 0xd0, 0x2e, 0xfb, 0x15,
+	0xf5, 0xd9, 0x4f, 0xac, 0x23, 0x58, 0x97, 0x84, 0x62, 0x21, 0x43, 0xca, 0x03, 0x2a, 0xec, 0x52,
+	0x13, 0xb4, 0x4d, 0xbf, 0xf6, 0xad, 0x0d, 0x84, 0xd5, 0x87, 0xf5, 0x30, 0x8e, 0x33, 0x9a, 0x8d,
+	0x43, 0xc9, 0x52, 0x61, 0x9b, 0x4d, 0xb3, 0x5d, 0x3b, 0x73, 0xd1, 0x76, 0x0f, 0xa4, 0xa6, 0x5d,
+	0x6c, 0x72, 0xdd, 0xf2, 0x7c, 0xe9, 0x1a, 0xfe, 0xd6, 0xaf, 0xad, 0x0f, 0x00, 0x1b, 0xbf, 0x72,
+	0xd6, 0x21, 0x84, 0x51, 0x28, 0x70, 0x90, 0xe0, 0x09, 0xa3, 0xba, 0x5d, 0xd5, 0xaf, 0x2a, 0xa5,
+	0xa7, 0x04, 0xcb, 0x85, 0xb5, 0x87, 0x8c, 0xc9, 0x2f, 0xbf, 0xa4, 0x7d, 0xa8, 0xa5, 0x3c, 0x30,
+	0x80, 0x50, 0x55, 0x08, 0x78, 0x4a, 0x62, 0x6c, 0x9b, 0xca, 0xef, 0x22, 0x35, 0xfb, 0x6d, 0xe9,
+	0x1e, 0x0f, 0x89, 0x1c, 0x65, 0x11, 0x8a, 0x19, 0xf5, 0x62, 0x26, 0x28, 0x13, 0xc5, 0xe3, 0x54,
+	0x24, 0xf7, 0x9e, 0x7c, 0xe2, 0x58, 0xa0, 0x1e, 0x8e, 0xfd, 0xaa, 0x22, 0x5c, 0x2b, 0x80, 0x75,
+	0x0b, 0x77, 0x8a, 0x6e, 0x64, 0x8a, 0x0b, 0x68, 0xf9, 0x4f, 0xd0, 0xc6, 0x86, 0xa3, 0xd1, 0xdd,
+	0xde, 0x7c, 0xe5, 0x80, 0xc5, 0xca, 0x01, 0xef, 0x2b, 0x07, 0x3c, 0xaf, 0x1d, 0x63, 0xb1, 0x76,
+	0x8c, 0xd7, 0xb5, 0x63, 0xdc, 0x9d, 0xfc, 0x40, 0x5e, 0xe9, 0x63, 0xbd, 0x1c, 0x85, 0x64, 0xe2,
+	0x15, 0x5b, 0xf0, 0x98, 0xef, 0x81, 0x46, 0x47, 0x15, 0x7d, 0xc1, 0xe7, 0x9f, 0x03, 0x00, 0x5a,
+	0x70, 0x27, 0x01, 0x23, 0x02, 0x00, 0x00,
+}
+
+func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accumulators) > 0 {
+		for iNdEx := len(m.Accumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TimestampMs != 0 {
+		i = encodeVarintTwap(dAtA, i, uint64(m.TimestampMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTwap(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TwapAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTwap(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTwap(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TwapRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTwap(uint64(m.PoolId))
+	}
+	if m.TimestampMs != 0 {
+		n += 1 + sovTwap(uint64(m.TimestampMs))
+	}
+	if len(m.Accumulators) > 0 {
+		for _, e := range m.Accumulators {
+			l = e.Size()
+			n += 1 + l + sovTwap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TwapAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTwap(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTwap(uint64(l))
+	}
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovTwap(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovTwap(uint64(l))
+	return n
+}
+
+func sovTwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTwap(x uint64) (n int) {
+	return sovTwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TwapRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+			}
+			m.TimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accumulators = append(m.Accumulators, TwapAccumulator{})
+			if err := m.Accumulators[len(m.Accumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTwap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTwap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTwap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTwap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTwap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTwap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTwap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTwap = fmt.Errorf("proto: unexpected end of group")
+)
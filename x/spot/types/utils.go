@@ -61,6 +61,17 @@ func (pool Pool) PoolBalances() sdk.Coins {
 	return coins
 }
 
+/*
+Returns a copy of the pool whose assets can be modified without modifying the
+original pool.
+*/
+func (pool Pool) deepCopy() Pool {
+	poolAssets := make([]PoolAsset, len(pool.PoolAssets))
+	copy(poolAssets, pool.PoolAssets)
+	pool.PoolAssets = poolAssets
+	return pool
+}
+
 /*
 Sorts poolAssets in place by denom, lexicographically increasing.
 
@@ -3,8 +3,6 @@ package stablecoin
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/NibiruChain/nibiru/x/common/asset"
-	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/stablecoin/keeper"
 )
 
@@ -20,7 +18,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		k.SetParams(ctx, params)
 	}
 
-	_, err := k.OracleKeeper.GetExchangeRateTwap(ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD))
+	_, err := k.GetStablePriceTwap(ctx)
 	if err != nil {
 		params := k.GetParams(ctx)
 		params.IsCollateralRatioValid = false
@@ -97,6 +97,7 @@ func (s IntegrationTestSuite) TestMintStableCmd() {
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(denoms.NIBI, sdk.NewInt(10))).String()),
+		fmt.Sprintf("--%s=%d", flags.FlagGas, 400_000),
 	}
 	s.Require().NoError(s.network.WaitForNextBlock())
 
@@ -177,6 +178,7 @@ func (s IntegrationTestSuite) TestBurnStableCmd() {
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, defaultBondCoinsString),
+		fmt.Sprintf("--%s=%d", flags.FlagGas, 400_000),
 	}
 
 	testCases := []struct {
@@ -7,6 +7,9 @@ import (
 const (
 	// Will be parsed to []string.
 	MintDenoms = "swap-route-denoms"
+
+	// FlagCollDenom is the denom of the collateral used by a transaction.
+	FlagCollDenom = "coll-denom"
 )
 
 func FlagSetSwapAmountOutRoutes() *flag.FlagSet {
@@ -15,3 +18,10 @@ func FlagSetSwapAmountOutRoutes() *flag.FlagSet {
 	fs.StringArray(MintDenoms, []string{""}, "mint denoms")
 	return fs
 }
+
+func FlagSetCollDenom() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagCollDenom, "", "denom of the collateral, the first enabled collateral if empty")
+	return fs
+}
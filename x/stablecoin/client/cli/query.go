@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -29,6 +30,12 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryModuleAccountBalances(),
 		CmdQueryCirculatingSupplies(),
 		CmdQueryLiquidityRatioInfo(),
+		CmdQueryCollateralBasket(),
+		CmdQueryControllerState(),
+		CmdQueryCollRatioDecisions(),
+		CmdQueryRateLimits(),
+		CmdQueryRedemptionQueue(),
+		CmdQueryRedemption(),
 	}
 	for _, cmd := range cmds {
 		stablecoinQueryCmd.AddCommand(cmd)
@@ -147,3 +154,192 @@ func CmdQueryLiquidityRatioInfo() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryCollateralBasket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collateral-basket",
+		Short: "collaterals held by the x/stablecoin module and their value",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CollateralBasket(
+				context.Background(), &types.QueryCollateralBasketRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryControllerState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "controller-state",
+		Short: "state of the collateral ratio controller",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ControllerState(
+				context.Background(), &types.QueryControllerStateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryCollRatioDecisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "coll-ratio-decisions",
+		Short: "updates of the collateral ratio at the end of each epoch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CollRatioDecisions(
+				context.Background(), &types.QueryCollRatioDecisionsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "coll-ratio-decisions")
+
+	return cmd
+}
+
+func CmdQueryRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits [address]",
+		Short: "mint and burn capacity left in the epoch, globally and for an optional account",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRateLimitsRequest{}
+			if len(args) > 0 {
+				req.Address = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimits(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryRedemptionQueue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemption-queue",
+		Short: "redemptions waiting in the queue",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RedemptionQueue(
+				context.Background(), &types.QueryRedemptionQueueRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "redemption-queue")
+
+	return cmd
+}
+
+func CmdQueryRedemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redemption [id]",
+		Short: "a queued redemption and its position in the queue",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Redemption(
+				context.Background(), &types.QueryRedemptionRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -24,6 +27,7 @@ func GetTxCmd() *cobra.Command {
 		BurnStableCmd(),
 		BuybackCmd(),
 		RecollateralizeCmd(),
+		CancelRedemptionCmd(),
 	)
 
 	return txCmd
@@ -56,15 +60,21 @@ func MintStableCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			collDenom, err := cmd.Flags().GetString(FlagCollDenom)
+			if err != nil {
+				return err
+			}
 			msg := &types.MsgMintStable{
-				Creator: clientCtx.GetFromAddress().String(),
-				Stable:  inCoin,
+				Creator:   clientCtx.GetFromAddress().String(),
+				Stable:    inCoin,
+				CollDenom: collDenom,
 			}
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().AddFlagSet(FlagSetCollDenom())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -92,14 +102,20 @@ func BurnStableCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			collDenom, err := cmd.Flags().GetString(FlagCollDenom)
+			if err != nil {
+				return err
+			}
 			msg := &types.MsgBurnStable{
-				Creator: clientCtx.GetFromAddress().String(),
-				Stable:  inCoin,
+				Creator:   clientCtx.GetFromAddress().String(),
+				Stable:    inCoin,
+				CollDenom: collDenom,
 			}
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().AddFlagSet(FlagSetCollDenom())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -131,15 +147,21 @@ func BuybackCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			collDenom, err := cmd.Flags().GetString(FlagCollDenom)
+			if err != nil {
+				return err
+			}
 			msg := &types.MsgBuyback{
-				Creator: clientCtx.GetFromAddress().String(),
-				Gov:     inCoin,
+				Creator:   clientCtx.GetFromAddress().String(),
+				Gov:       inCoin,
+				CollDenom: collDenom,
 			}
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().AddFlagSet(FlagSetCollDenom())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -187,3 +209,34 @@ func RecollateralizeCmd() *cobra.Command {
 
 	return cmd
 }
+
+/*
+CancelRedemptionCmd is a CLI command that removes a redemption of the sender
+from the redemption queue.
+Example: "cancel-redemption 3"
+*/
+func CancelRedemptionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-redemption [redemption-id]",
+		Short: "Cancel a queued redemption and get its stablecoins back",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			redemptionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid redemption id %s: %w", args[0], err)
+			}
+			msg := types.NewMsgCancelRedemption(clientCtx.GetFromAddress().String(), redemptionId)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -16,6 +16,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 	k.SetParams(ctx, genState.Params)
+	k.SetControllerState(ctx, genState.ControllerState)
+
+	for _, decision := range genState.CollRatioDecisions {
+		k.SetCollRatioDecision(ctx, decision)
+	}
+
+	if !genState.EpochUsage.Minted.IsNil() {
+		k.SetEpochUsage(ctx, genState.EpochUsage)
+	}
+	for _, usage := range genState.AccountUsages {
+		k.SetAccountEpochUsage(ctx, sdk.MustAccAddressFromBech32(usage.Address), usage.Usage)
+	}
+	for _, redemption := range genState.RedemptionQueue {
+		k.SetRedemption(ctx, redemption)
+	}
+	k.SetNextRedemptionID(ctx, genState.NextRedemptionId)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -23,6 +39,12 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 	genesis.ModuleAccountBalance = k.GetModuleAccountBalance(ctx)
+	genesis.ControllerState = k.GetControllerState(ctx)
+	genesis.CollRatioDecisions = k.FetchAllCollRatioDecisions(ctx)
+	genesis.EpochUsage = k.GetEpochUsage(ctx)
+	genesis.AccountUsages = k.FetchAllAccountEpochUsages(ctx)
+	genesis.RedemptionQueue = k.FetchAllRedemptions(ctx)
+	genesis.NextRedemptionId = k.GetNextRedemptionID(ctx)
 
 	return genesis
 }
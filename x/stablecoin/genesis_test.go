@@ -15,16 +15,71 @@ import (
 )
 
 func TestGenesis(t *testing.T) {
+	owner := testutil.AccAddress()
 	genesisState := types.GenesisState{
 		Params:               types.DefaultParams(),
 		ModuleAccountBalance: sdk.NewCoin(denoms.USDC, sdk.ZeroInt()),
+		ControllerState: types.ControllerState{
+			LastError: sdk.MustNewDecFromStr("0.01"),
+			Integral:  sdk.MustNewDecFromStr("0.03"),
+		},
+		CollRatioDecisions: []types.CollRatioDecision{{
+			EpochNumber:     7,
+			BlockHeight:     42,
+			Controller:      types.CollRatioController_PI,
+			StablePriceTwap: sdk.MustNewDecFromStr("1.01"),
+			PrevCollRatio:   sdk.MustNewDecFromStr("0.8"),
+			CollRatio:       sdk.MustNewDecFromStr("0.806"),
+			State: types.ControllerState{
+				LastError: sdk.MustNewDecFromStr("0.01"),
+				Integral:  sdk.MustNewDecFromStr("0.03"),
+			},
+		}},
+		EpochUsage: types.EpochUsage{
+			Minted: sdk.NewInt(100),
+			Burned: sdk.NewInt(50),
+		},
+		AccountUsages: []types.AccountEpochUsage{{
+			Address: owner.String(),
+			Usage: types.EpochUsage{
+				Minted: sdk.NewInt(100),
+				Burned: sdk.NewInt(50),
+			},
+		}},
+		RedemptionQueue: []types.Redemption{{
+			Id:          3,
+			Owner:       owner.String(),
+			Stable:      sdk.NewInt64Coin(denoms.NUSD, 20),
+			CollDenom:   denoms.USDC,
+			BlockHeight: 40,
+		}},
+		NextRedemptionId: 4,
 	}
+	require.NoError(t, genesisState.Validate())
 
 	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
 	k := nibiruApp.StablecoinKeeper
 	stablecoin.InitGenesis(ctx, k, genesisState)
 	got := stablecoin.ExportGenesis(ctx, k)
 	require.NotNil(t, got)
+	require.Equal(t, genesisState.ControllerState, got.ControllerState)
+	require.Equal(t, genesisState.CollRatioDecisions, got.CollRatioDecisions)
+	require.Equal(t, genesisState.EpochUsage, got.EpochUsage)
+	require.Equal(t, genesisState.AccountUsages, got.AccountUsages)
+	require.Equal(t, genesisState.RedemptionQueue, got.RedemptionQueue)
+	require.Equal(t, genesisState.NextRedemptionId, got.NextRedemptionId)
+	id, found := k.GetOwnerRedemptionID(ctx, owner)
+	require.True(t, found)
+	require.EqualValues(t, 3, id)
+
+	duplicate := genesisState
+	duplicate.RedemptionQueue = append(duplicate.RedemptionQueue, types.Redemption{
+		Id:     5,
+		Owner:  owner.String(),
+		Stable: sdk.NewInt64Coin(denoms.NUSD, 10),
+	})
+	duplicate.NextRedemptionId = 6
+	require.ErrorContains(t, duplicate.Validate(), "duplicate queued redemption")
 
 	testutil.Fill(&genesisState)
 	testutil.Fill(got)
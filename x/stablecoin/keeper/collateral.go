@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/stablecoin/types"
+)
+
+// ---------------------------------------------------------------------------
+// Collateral Basket
+// ---------------------------------------------------------------------------
+
+/*
+GetCollateralBasket returns the collaterals of the registry held by the module
+and their value in stablecoins, priced by the oracle. Collaterals that aren't
+held don't need a price.
+*/
+func (k Keeper) GetCollateralBasket(ctx sdk.Context) (
+	holdings []types.CollateralHolding, totalValue sdk.Dec, err error,
+) {
+	moduleAddr := k.AccountKeeper.GetModuleAddress(types.ModuleName)
+	moduleCoins := k.BankKeeper.SpendableCoins(ctx, moduleAddr)
+
+	totalValue = sdk.ZeroDec()
+	for _, collateral := range k.GetParams(ctx).Collaterals {
+		balance := sdk.NewCoin(collateral.Denom, moduleCoins.AmountOf(collateral.Denom))
+		value := sdk.ZeroDec()
+		if balance.IsPositive() {
+			price, err := k.OracleKeeper.GetExchangeRate(ctx, collateral.OraclePair)
+			if err != nil {
+				return nil, sdk.Dec{}, err
+			}
+			value = price.MulInt(balance.Amount)
+		}
+
+		holdings = append(holdings, types.CollateralHolding{
+			Collateral: collateral,
+			Balance:    balance,
+			Value:      value,
+		})
+		totalValue = totalValue.Add(value)
+	}
+
+	return holdings, totalValue, nil
+}
+
+/*
+GetStablePriceTwap returns the TWAP of the peg oracle pair, the price in
+stablecoins of an asset pegged to the dollar. The collaterals only back the
+stablecoin: a collateral that isn't pegged to the dollar doesn't move the peg
+signal.
+*/
+func (k Keeper) GetStablePriceTwap(ctx sdk.Context) (price sdk.Dec, err error) {
+	return k.OracleKeeper.GetExchangeRateTwap(ctx, k.GetParams(ctx).PegOraclePair)
+}
+
+// checkCollateralCeiling checks that the module can receive an amount of collateral
+// without exceeding its ceiling.
+func (k Keeper) checkCollateralCeiling(
+	ctx sdk.Context, collateral types.Collateral, amount sdkmath.Int,
+) error {
+	moduleAddr := k.AccountKeeper.GetModuleAddress(types.ModuleName)
+	balance := k.BankKeeper.GetBalance(ctx, moduleAddr, collateral.Denom)
+	if collateral.IsAboveCeiling(balance.Amount.Add(amount)) {
+		return types.CollateralCeilingReached.Wrapf(
+			"holding %s%s would exceed the ceiling of %s%s",
+			balance.Amount.Add(amount), collateral.Denom, collateral.Ceiling, collateral.Denom)
+	}
+	return nil
+}
@@ -58,78 +58,57 @@ func (k *Keeper) SetCollRatio(ctx sdk.Context, collRatio sdk.Dec) (err error) {
 // ---------------------------------------------------------------------------
 
 /*
-updateCollRatio updates the value of the target collateral ratio based on
-whether the price of NUSD is above or below peg
+EvaluateCollRatio updates the collateral ratio with the controller selected by
+the params, from the TWAP of the collaterals.
 */
-func (k *Keeper) updateCollRatio(ctx sdk.Context, isPriceUp bool) (err error) {
-	params := k.GetParams(ctx)
-	nibiruStep := params.GetAdjustmentStepAsDec()
-	var adjustment sdk.Dec
-
-	if !isPriceUp {
-		adjustment = nibiruStep
-	} else {
-		adjustment = nibiruStep.Mul(sdk.MustNewDecFromStr("-1"))
-	}
-	currCollRatio := k.GetCollRatio(ctx)
-	err = k.SetCollRatio(ctx, currCollRatio.Add(adjustment))
-	if err != nil {
-		return err
-	}
-
-	return nil
+func (k *Keeper) EvaluateCollRatio(ctx sdk.Context) (err error) {
+	_, err = k.evaluateCollRatio(ctx)
+	return err
 }
 
-/*
-Evaluate Coll ratio updates the collateral ratio if the price is out of the bounds.
-*/
-func (k *Keeper) EvaluateCollRatio(ctx sdk.Context) (err error) {
+// evaluateCollRatio updates the collateral ratio and the controller state, and
+// returns the decision taken by the controller.
+func (k *Keeper) evaluateCollRatio(ctx sdk.Context) (decision types.CollRatioDecision, err error) {
 	params := k.GetParams(ctx)
 
-	lowerBound := params.GetPriceLowerBoundAsDec()
-	upperBound := params.GetPriceUpperBoundAsDec()
-
-	stablePrice, err := k.OracleKeeper.GetExchangeRateTwap(
-		ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD))
+	stablePrice, err := k.GetStablePriceTwap(ctx)
 	if err != nil {
-		return err
+		return decision, err
 	}
 
-	if stablePrice.LTE(lowerBound) {
-		err = k.updateCollRatio(ctx, true)
-	} else if stablePrice.GTE(upperBound) {
-		err = k.updateCollRatio(ctx, false)
-	}
-	if err != nil {
-		return err
+	prevCollRatio := k.GetCollRatio(ctx)
+	collRatio, state := params.NewController().
+		NextCollRatio(prevCollRatio, stablePrice, k.GetControllerState(ctx))
+	if !collRatio.Equal(prevCollRatio) {
+		if err = k.SetCollRatio(ctx, collRatio); err != nil {
+			return decision, err
+		}
 	}
-	return nil
+	k.SetControllerState(ctx, state)
+
+	return types.CollRatioDecision{
+		BlockHeight:     ctx.BlockHeight(),
+		Controller:      params.Controller,
+		StablePriceTwap: stablePrice,
+		PrevCollRatio:   prevCollRatio,
+		CollRatio:       k.GetCollRatio(ctx),
+		State:           state,
+	}, nil
 }
 
 /*
 StableRequiredForTargetCollRatio is the collateral value in USD needed to reach
-a target collateral ratio.
+a target collateral ratio, given the value of the whole collateral basket.
 */
 func (k *Keeper) StableRequiredForTargetCollRatio(
 	ctx sdk.Context,
 ) (neededStable sdk.Dec, err error) {
 	stableSupply := k.GetSupplyNUSD(ctx)
 	targetCollRatio := k.GetCollRatio(ctx)
-	moduleAddr := k.AccountKeeper.GetModuleAddress(types.ModuleName)
-	moduleCoins := k.BankKeeper.SpendableCoins(ctx, moduleAddr)
-	collDenoms := []string{denoms.USDC}
-
-	currentTotalCollUSD := sdk.ZeroDec()
-
-	for _, collDenom := range collDenoms {
-		amtColl := moduleCoins.AmountOf(collDenom)
-		priceColl, err := k.OracleKeeper.GetExchangeRate(
-			ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD))
-		if err != nil {
-			return sdk.ZeroDec(), err
-		}
-		collUSD := priceColl.MulInt(amtColl)
-		currentTotalCollUSD = currentTotalCollUSD.Add(collUSD)
+
+	_, currentTotalCollUSD, err := k.GetCollateralBasket(ctx)
+	if err != nil {
+		return sdk.ZeroDec(), err
 	}
 
 	targetCollUSD := targetCollRatio.MulInt(stableSupply.Amount)
@@ -137,12 +116,21 @@ func (k *Keeper) StableRequiredForTargetCollRatio(
 	return neededStable, err
 }
 
+/*
+RecollateralizeCollAmtForTargetCollRatio is the amount of a collateral needed to
+reach the target collateral ratio.
+*/
 func (k *Keeper) RecollateralizeCollAmtForTargetCollRatio(
-	ctx sdk.Context,
+	ctx sdk.Context, collDenom string,
 ) (neededCollAmount sdkmath.Int, err error) {
+	params := k.GetParams(ctx)
+	collateral, err := params.GetCollateral(collDenom)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
 	neededUSDForRecoll, _ := k.StableRequiredForTargetCollRatio(ctx)
-	priceCollStable, err := k.OracleKeeper.GetExchangeRate(
-		ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD))
+	priceCollStable, err := k.OracleKeeper.GetExchangeRate(ctx, collateral.OraclePair)
 	if err != nil {
 		return sdkmath.Int{}, err
 	}
@@ -184,7 +172,12 @@ func (k Keeper) Recollateralize(
 	params := k.GetParams(ctx)
 	targetCollRatio := params.GetCollRatioAsDec()
 
-	neededCollAmt, err := k.RecollateralizeCollAmtForTargetCollRatio(ctx)
+	collateral, err := params.GetEnabledCollateral(msg.Coll.Denom)
+	if err != nil {
+		return response, err
+	}
+
+	neededCollAmt, err := k.RecollateralizeCollAmtForTargetCollRatio(ctx, collateral.Denom)
 	if err != nil {
 		return response, err
 	} else if neededCollAmt.LTE(sdk.ZeroInt()) {
@@ -209,6 +202,10 @@ func (k Keeper) Recollateralize(
 	if err != nil {
 		return response, err
 	}
+	err = k.checkCollateralCeiling(ctx, collateral, inColl.Amount)
+	if err != nil {
+		return response, err
+	}
 	err = k.BankKeeper.SendCoinsFromAccountToModule(
 		ctx, caller, types.ModuleName, sdk.NewCoins(inColl),
 	)
@@ -225,8 +222,7 @@ func (k Keeper) Recollateralize(
 	}
 
 	// Compute GOV rewarded to user
-	priceCollStable, err := k.OracleKeeper.GetExchangeRate(
-		ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD))
+	priceCollStable, err := k.OracleKeeper.GetExchangeRate(ctx, collateral.OraclePair)
 	if err != nil {
 		return response, err
 	}
@@ -363,6 +359,17 @@ func (k Keeper) Buyback(
 	params := k.GetParams(ctx)
 	targetCollRatio := params.GetCollRatioAsDec()
 
+	// Any collateral of the registry can be bought back, including disabled ones.
+	var collateral types.Collateral
+	if msg.CollDenom == "" {
+		collateral, err = params.GetEnabledCollateral(msg.CollDenom)
+	} else {
+		collateral, err = params.GetCollateral(msg.CollDenom)
+	}
+	if err != nil {
+		return response, err
+	}
+
 	neededGovAmt, err := k.BuybackGovAmtForTargetCollRatio(ctx)
 	if err != nil {
 		return response, err
@@ -424,11 +431,11 @@ func (k Keeper) Buyback(
 	inUSD := priceGovStable.MulInt(inGov.Amount)
 
 	// Compute collateral amount sent to caller: 'outColl'
-	outCollAmount, err := k.CollAmtFromBuyback(ctx, inUSD)
+	outCollAmount, err := k.CollAmtFromBuyback(ctx, collateral.Denom, inUSD)
 	if err != nil {
 		return response, err
 	}
-	outColl := sdk.NewCoin(denoms.USDC, outCollAmount)
+	outColl := sdk.NewCoin(collateral.Denom, outCollAmount)
 
 	// Send COLL from the module to the caller
 	err = k.BankKeeper.SendCoinsFromModuleToAccount(
@@ -467,6 +474,7 @@ buyback.
 Args:
 
 	ctx (sdk.Context): Carries information about the current state of the application.
+	collDenom (string): Denom of the collateral given for 'Buyback'.
 	valUSD (sdk.Dec): Value in NUSD stablecoin to be used for buyback.
 
 Returns:
@@ -474,10 +482,15 @@ Returns:
 	collAmt (sdk.Int): Amount of COLL token rewarded for 'Buyback'.
 */
 func (k *Keeper) CollAmtFromBuyback(
-	ctx sdk.Context, valUSD sdk.Dec,
+	ctx sdk.Context, collDenom string, valUSD sdk.Dec,
 ) (collAmt sdkmath.Int, err error) {
-	priceCollStable, err := k.OracleKeeper.GetExchangeRate(
-		ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD))
+	params := k.GetParams(ctx)
+	collateral, err := params.GetCollateral(collDenom)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	priceCollStable, err := k.OracleKeeper.GetExchangeRate(ctx, collateral.OraclePair)
 	if err != nil {
 		return sdkmath.Int{}, err
 	}
@@ -488,12 +501,12 @@ func (k *Keeper) CollAmtFromBuyback(
 
 // TODO hygiene: cover with test cases | https://github.com/NibiruChain/nibiru/issues/537
 func (k *Keeper) CollAmtFromFullBuyback(
-	ctx sdk.Context,
+	ctx sdk.Context, collDenom string,
 ) (collAmt sdkmath.Int, err error) {
 	neededUSDForRecoll, err := k.StableRequiredForTargetCollRatio(ctx)
 	if err != nil {
 		return sdkmath.Int{}, err
 	}
 	neededUSDForBuyback := neededUSDForRecoll.Neg()
-	return k.CollAmtFromBuyback(ctx, neededUSDForBuyback)
+	return k.CollAmtFromBuyback(ctx, collDenom, neededUSDForBuyback)
 }
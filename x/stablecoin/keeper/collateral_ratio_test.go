@@ -299,7 +299,7 @@ func TestRecollateralizeCollAmtForTargetCollRatio(t *testing.T) {
 			pair := asset.Registry.Pair(denoms.USDC, denoms.NUSD)
 			nibiruApp.OracleKeeper.SetPrice(ctx, pair, tc.priceCollStable)
 
-			neededCollAmount, err := stablecoinKeeper.RecollateralizeCollAmtForTargetCollRatio(ctx)
+			neededCollAmount, err := stablecoinKeeper.RecollateralizeCollAmtForTargetCollRatio(ctx, denoms.USDC)
 			if tc.expectedPass {
 				require.NoError(t, err)
 				require.EqualValues(t, tc.neededCollAmt, neededCollAmount)
@@ -338,7 +338,7 @@ func TestRecollateralizeCollAmtForTargetCollRatio(t *testing.T) {
 			// pair := asset.AssetRegistry.Pair(denoms.USDC, denoms.NUSD)
 			// nibiruApp.OracleKeeper.SetPrice(ctx, pair, tc.priceCollStable)
 
-			neededCollAmount, err := stablecoinKeeper.RecollateralizeCollAmtForTargetCollRatio(ctx)
+			neededCollAmount, err := stablecoinKeeper.RecollateralizeCollAmtForTargetCollRatio(ctx, denoms.USDC)
 			if tc.expectedPass {
 				require.NoError(t, err)
 				require.EqualValues(t, tc.neededCollAmt, neededCollAmount)
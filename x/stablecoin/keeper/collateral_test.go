@@ -0,0 +1,246 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/stablecoin/types"
+)
+
+// setupMultiCollateral registers USDC, USDT with a ceiling and a disabled ETH
+// collateral.
+func setupMultiCollateral(nibiruApp *app.NibiruApp, ctx sdk.Context) {
+	params := types.NewParams(
+		/* collRatio */ sdk.MustNewDecFromStr("0.9"),
+		/* feeRatio */ sdk.MustNewDecFromStr("0.002"),
+		/* efFeeRatio */ sdk.MustNewDecFromStr("0.5"),
+		/* bonusRateRecoll */ sdk.MustNewDecFromStr("0.002"),
+		/* distrEpochIdentifier */ "15 min",
+		/* adjustmentStep */ sdk.MustNewDecFromStr("0.0025"),
+		/* priceLowerBound */ sdk.MustNewDecFromStr("0.9999"),
+		/* priceUpperBound */ sdk.MustNewDecFromStr("1.0001"),
+		/* isCollateralRatioValid */ true,
+	)
+
+	usdt := types.NewCollateral(
+		denoms.USDT, asset.Registry.Pair(denoms.USDT, denoms.NUSD), sdk.MustNewDecFromStr("0.001"))
+	usdt.Ceiling = sdk.NewInt(2 * common.TO_MICRO)
+	eth := types.NewCollateral(
+		denoms.ETH, asset.NewPair(denoms.ETH, denoms.NUSD), sdk.MustNewDecFromStr("0.002"))
+	eth.Enabled = false
+	params.Collaterals = append(params.Collaterals, usdt, eth)
+
+	nibiruApp.StablecoinKeeper.SetParams(ctx, params)
+}
+
+func TestMintStable_MultiCollateral(t *testing.T) {
+	testCases := []struct {
+		name        string
+		msgMint     types.MsgMintStable
+		msgResponse types.MsgMintStableResponse
+		err         error
+	}{
+		{
+			name: "mint with the default collateral",
+			msgMint: types.MsgMintStable{
+				Stable: sdk.NewCoin(denoms.NUSD, sdk.NewInt(1*common.TO_MICRO)),
+			},
+			msgResponse: types.MsgMintStableResponse{
+				Stable: sdk.NewCoin(denoms.NUSD, sdk.NewInt(1*common.TO_MICRO)),
+				UsedCoins: sdk.NewCoins(
+					sdk.NewInt64Coin(denoms.USDC, 900_000),
+					sdk.NewInt64Coin(denoms.NIBI, 10_000),
+				),
+				FeesPayed: sdk.NewCoins(
+					sdk.NewInt64Coin(denoms.USDC, 1_800), // 0.002 fee
+					sdk.NewInt64Coin(denoms.NIBI, 20),    // 0.002 fee
+				),
+			},
+		},
+		{
+			name: "mint with another collateral and its mint fee",
+			msgMint: types.MsgMintStable{
+				Stable:    sdk.NewCoin(denoms.NUSD, sdk.NewInt(1*common.TO_MICRO)),
+				CollDenom: denoms.USDT,
+			},
+			msgResponse: types.MsgMintStableResponse{
+				Stable: sdk.NewCoin(denoms.NUSD, sdk.NewInt(1*common.TO_MICRO)),
+				UsedCoins: sdk.NewCoins(
+					sdk.NewInt64Coin(denoms.USDT, 1_000_000), // price of 0.9
+					sdk.NewInt64Coin(denoms.NIBI, 10_000),
+				),
+				FeesPayed: sdk.NewCoins(
+					sdk.NewInt64Coin(denoms.USDT, 1_000), // 0.001 fee
+					sdk.NewInt64Coin(denoms.NIBI, 20),    // 0.002 fee
+				),
+			},
+		},
+		{
+			name: "ceiling of the collateral reached",
+			msgMint: types.MsgMintStable{
+				Stable:    sdk.NewCoin(denoms.NUSD, sdk.NewInt(3*common.TO_MICRO)),
+				CollDenom: denoms.USDT,
+			},
+			err: types.CollateralCeilingReached,
+		},
+		{
+			name: "disabled collateral",
+			msgMint: types.MsgMintStable{
+				Stable:    sdk.NewCoin(denoms.NUSD, sdk.NewInt(1*common.TO_MICRO)),
+				CollDenom: denoms.ETH,
+			},
+			err: types.CollateralDisabled,
+		},
+		{
+			name: "unknown collateral",
+			msgMint: types.MsgMintStable{
+				Stable:    sdk.NewCoin(denoms.NUSD, sdk.NewInt(1*common.TO_MICRO)),
+				CollDenom: denoms.ATOM,
+			},
+			err: types.CollateralNotFound,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+			setupMultiCollateral(nibiruApp, ctx)
+			nibiruApp.AccountKeeper.GetModuleAccount(ctx, types.StableEFModuleAccount)
+
+			nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.NIBI, denoms.NUSD), sdk.NewDec(10))
+			nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD), sdk.OneDec())
+			nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.USDT, denoms.NUSD), sdk.MustNewDecFromStr("0.9"))
+
+			acc := testutil.AccAddress()
+			tc.msgMint.Creator = acc.String()
+			require.NoError(t, testapp.FundAccount(nibiruApp.BankKeeper, ctx, acc, sdk.NewCoins(
+				sdk.NewInt64Coin(denoms.NIBI, 10*common.TO_MICRO),
+				sdk.NewInt64Coin(denoms.USDC, 10*common.TO_MICRO),
+				sdk.NewInt64Coin(denoms.USDT, 10*common.TO_MICRO),
+			)))
+
+			resp, err := nibiruApp.StablecoinKeeper.MintStable(sdk.WrapSDKContext(ctx), &tc.msgMint)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.EqualValues(t, tc.msgResponse, *resp)
+		})
+	}
+}
+
+func TestBurnStable_MultiCollateral(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	setupMultiCollateral(nibiruApp, ctx)
+	nibiruApp.AccountKeeper.GetModuleAccount(ctx, types.StableEFModuleAccount)
+
+	nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.NIBI, denoms.NUSD), sdk.NewDec(10))
+	nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.USDT, denoms.NUSD), sdk.MustNewDecFromStr("0.9"))
+
+	require.NoError(t, nibiruApp.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.USDT, 10*common.TO_MICRO),
+	)))
+	acc := testutil.AccAddress()
+	require.NoError(t, testapp.FundAccount(nibiruApp.BankKeeper, ctx, acc, sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.NUSD, 1*common.TO_MICRO),
+	)))
+
+	resp, err := nibiruApp.StablecoinKeeper.BurnStable(sdk.WrapSDKContext(ctx), &types.MsgBurnStable{
+		Creator:   acc.String(),
+		Stable:    sdk.NewInt64Coin(denoms.NUSD, 1*common.TO_MICRO),
+		CollDenom: denoms.USDT,
+	})
+	require.NoError(t, err)
+
+	t.Log("the burn is charged the fee ratio, not the mint fee of the collateral")
+	assert.EqualValues(t, types.MsgBurnStableResponse{
+		Collateral: sdk.NewInt64Coin(denoms.USDT, 998_000), // price of 0.9
+		Gov:        sdk.NewInt64Coin(denoms.NIBI, 9_980),
+		FeesPayed: sdk.NewCoins(
+			sdk.NewInt64Coin(denoms.USDT, 2_000), // 0.002 fee
+			sdk.NewInt64Coin(denoms.NIBI, 20),    // 0.002 fee
+		),
+	}, *resp)
+	require.Equal(t,
+		sdk.NewInt64Coin(denoms.USDT, 998_000),
+		nibiruApp.BankKeeper.GetBalance(ctx, acc, denoms.USDT))
+}
+
+func TestGetStablePriceTwap(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	setupMultiCollateral(nibiruApp, ctx)
+
+	require.NoError(t, nibiruApp.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.USDC, 500),
+		sdk.NewInt64Coin(denoms.USDT, 300),
+	)))
+	nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.USDT, denoms.NUSD), sdk.MustNewDecFromStr("0.9"))
+
+	t.Log("the peg signal needs a price of the peg oracle pair")
+	_, err := nibiruApp.StablecoinKeeper.GetStablePriceTwap(ctx)
+	require.Error(t, err)
+
+	t.Log("the held collaterals don't move the peg signal")
+	nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD), sdk.MustNewDecFromStr("1.01"))
+	price, err := nibiruApp.StablecoinKeeper.GetStablePriceTwap(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.MustNewDecFromStr("1.01"), price)
+
+	t.Log("the peg oracle pair is a param")
+	params := nibiruApp.StablecoinKeeper.GetParams(ctx)
+	params.PegOraclePair = asset.Registry.Pair(denoms.USDT, denoms.NUSD)
+	nibiruApp.StablecoinKeeper.SetParams(ctx, params)
+	price, err = nibiruApp.StablecoinKeeper.GetStablePriceTwap(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.MustNewDecFromStr("0.9"), price)
+}
+
+func TestGetCollateralBasket(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	setupMultiCollateral(nibiruApp, ctx)
+
+	t.Log("an empty basket needs no price")
+	holdings, totalValue, err := nibiruApp.StablecoinKeeper.GetCollateralBasket(ctx)
+	require.NoError(t, err)
+	assert.Len(t, holdings, 3)
+	assert.True(t, totalValue.IsZero())
+
+	require.NoError(t, nibiruApp.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.USDC, 500),
+		sdk.NewInt64Coin(denoms.USDT, 300),
+	)))
+
+	t.Log("a held collateral needs a price")
+	nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD), sdk.OneDec())
+	_, _, err = nibiruApp.StablecoinKeeper.GetCollateralBasket(ctx)
+	require.Error(t, err)
+
+	nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.USDT, denoms.NUSD), sdk.MustNewDecFromStr("0.9"))
+	holdings, totalValue, err = nibiruApp.StablecoinKeeper.GetCollateralBasket(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(770), totalValue)
+	assert.Equal(t, sdk.NewInt64Coin(denoms.USDC, 500), holdings[0].Balance)
+	assert.Equal(t, sdk.NewDec(500), holdings[0].Value)
+	assert.Equal(t, sdk.NewInt64Coin(denoms.USDT, 300), holdings[1].Balance)
+	assert.Equal(t, sdk.NewDec(270), holdings[1].Value)
+	assert.True(t, holdings[2].Value.IsZero())
+
+	t.Log("the needed stable accounts for every collateral of the basket")
+	require.NoError(t, nibiruApp.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.NUSD, 1_000),
+	)))
+	neededStable, err := nibiruApp.StablecoinKeeper.StableRequiredForTargetCollRatio(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(900-770), neededStable)
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/stablecoin/types"
+)
+
+// ---------------------------------------------------------------------------
+// Controller State
+// ---------------------------------------------------------------------------
+
+// GetControllerState returns the state of the collateral ratio controller.
+func (k Keeper) GetControllerState(ctx sdk.Context) (state types.ControllerState) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyControllerState)
+	if bz == nil {
+		return types.DefaultControllerState()
+	}
+	k.cdc.MustUnmarshal(bz, &state)
+	return state
+}
+
+// SetControllerState sets the state of the collateral ratio controller.
+func (k Keeper) SetControllerState(ctx sdk.Context, state types.ControllerState) {
+	ctx.KVStore(k.storeKey).Set(types.KeyControllerState, k.cdc.MustMarshal(&state))
+}
+
+// ---------------------------------------------------------------------------
+// Collateral Ratio Decisions
+// ---------------------------------------------------------------------------
+
+// SetCollRatioDecision stores the update of the collateral ratio of an epoch.
+func (k Keeper) SetCollRatioDecision(ctx sdk.Context, decision types.CollRatioDecision) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollRatioDecision)
+	store.Set(sdk.Uint64ToBigEndian(decision.EpochNumber), k.cdc.MustMarshal(&decision))
+}
+
+// GetCollRatioDecision returns the update of the collateral ratio of an epoch.
+func (k Keeper) GetCollRatioDecision(
+	ctx sdk.Context, epochNumber uint64,
+) (decision types.CollRatioDecision, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollRatioDecision)
+	bz := store.Get(sdk.Uint64ToBigEndian(epochNumber))
+	if bz == nil {
+		return decision, false
+	}
+	k.cdc.MustUnmarshal(bz, &decision)
+	return decision, true
+}
+
+// FetchAllCollRatioDecisions returns the updates of the collateral ratio of all
+// the epochs, sorted by epoch number.
+func (k Keeper) FetchAllCollRatioDecisions(ctx sdk.Context) (decisions []types.CollRatioDecision) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollRatioDecision)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var decision types.CollRatioDecision
+		k.cdc.MustUnmarshal(iterator.Value(), &decision)
+		decisions = append(decisions, decision)
+	}
+	return decisions
+}
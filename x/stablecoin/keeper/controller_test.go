@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/stablecoin/types"
+)
+
+func TestAfterEpochEnd_CollRatioDecision(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	stablecoinKeeper := &nibiruApp.StablecoinKeeper
+
+	params := types.DefaultParams()
+	params.Controller = types.CollRatioController_PI
+	params.MinCollRatio = 500_000
+	stablecoinKeeper.SetParams(ctx, params)
+	require.NoError(t, stablecoinKeeper.SetCollRatio(ctx, sdk.MustNewDecFromStr("0.8")))
+
+	t.Log("no decision without a price")
+	stablecoinKeeper.AfterEpochEnd(ctx, params.DistrEpochIdentifier, 1)
+	_, found := stablecoinKeeper.GetCollRatioDecision(ctx, 1)
+	require.False(t, found)
+	require.False(t, stablecoinKeeper.GetParams(ctx).IsCollateralRatioValid)
+
+	t.Log("decision of the PI controller")
+	nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD), sdk.MustNewDecFromStr("1.02"))
+	stablecoinKeeper.AfterEpochEnd(ctx, params.DistrEpochIdentifier, 2)
+
+	decision, found := stablecoinKeeper.GetCollRatioDecision(ctx, 2)
+	require.True(t, found)
+	require.EqualValues(t, 2, decision.EpochNumber)
+	require.Equal(t, types.CollRatioController_PI, decision.Controller)
+	require.Equal(t, sdk.MustNewDecFromStr("1.02"), decision.StablePriceTwap)
+	require.Equal(t, sdk.MustNewDecFromStr("0.8"), decision.PrevCollRatio)
+	// 0.8 + 0.5 * 0.02 + 0.1 * 0.02
+	require.Equal(t, sdk.MustNewDecFromStr("0.812"), decision.CollRatio)
+	require.Equal(t, sdk.MustNewDecFromStr("0.812"), stablecoinKeeper.GetCollRatio(ctx))
+	require.Equal(t, decision.State, stablecoinKeeper.GetControllerState(ctx))
+	require.True(t, stablecoinKeeper.GetParams(ctx).IsCollateralRatioValid)
+
+	t.Log("other epochs are ignored")
+	stablecoinKeeper.AfterEpochEnd(ctx, "other", 3)
+	_, found = stablecoinKeeper.GetCollRatioDecision(ctx, 3)
+	require.False(t, found)
+
+	t.Log("query the decisions")
+	resp, err := stablecoinKeeper.CollRatioDecisions(
+		sdk.WrapSDKContext(ctx), &types.QueryCollRatioDecisionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.CollRatioDecision{decision}, resp.Decisions)
+
+	stateResp, err := stablecoinKeeper.ControllerState(
+		sdk.WrapSDKContext(ctx), &types.QueryControllerStateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.CollRatioController_PI, stateResp.Controller)
+	require.Equal(t, sdk.MustNewDecFromStr("0.02"), stateResp.State.LastError)
+}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/NibiruChain/nibiru/x/stablecoin/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -78,3 +80,146 @@ func (k Keeper) LiquidityRatioInfo(
 		},
 	}, nil
 }
+
+func (k Keeper) CollateralBasket(
+	goCtx context.Context, req *types.QueryCollateralBasketRequest,
+) (*types.QueryCollateralBasketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	holdings, totalValue, err := k.GetCollateralBasket(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCollateralBasketResponse{
+		Holdings:   holdings,
+		TotalValue: totalValue,
+	}, nil
+}
+
+func (k Keeper) ControllerState(
+	goCtx context.Context, req *types.QueryControllerStateRequest,
+) (*types.QueryControllerStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryControllerStateResponse{
+		Controller: k.GetParams(ctx).Controller,
+		State:      k.GetControllerState(ctx),
+	}, nil
+}
+
+func (k Keeper) CollRatioDecisions(
+	goCtx context.Context, req *types.QueryCollRatioDecisionsRequest,
+) (*types.QueryCollRatioDecisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollRatioDecision)
+
+	var decisions []types.CollRatioDecision
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(key []byte, value []byte) error {
+			var decision types.CollRatioDecision
+			if err := k.cdc.Unmarshal(value, &decision); err != nil {
+				return err
+			}
+			decisions = append(decisions, decision)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCollRatioDecisionsResponse{
+		Decisions:  decisions,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) RateLimits(
+	goCtx context.Context, req *types.QueryRateLimitsRequest,
+) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var account sdk.AccAddress
+	if req.Address != "" {
+		var err error
+		account, err = sdk.AccAddressFromBech32(req.Address)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	mint, burn, accountMint, accountBurn := k.GetRateLimits(ctx, account)
+	return &types.QueryRateLimitsResponse{
+		Mint:        mint,
+		Burn:        burn,
+		AccountMint: accountMint,
+		AccountBurn: accountBurn,
+	}, nil
+}
+
+func (k Keeper) RedemptionQueue(
+	goCtx context.Context, req *types.QueryRedemptionQueueRequest,
+) (*types.QueryRedemptionQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedemptionQueue)
+
+	var redemptions []types.Redemption
+	pageRes, err := query.Paginate(
+		store,
+		req.Pagination,
+		func(key []byte, value []byte) error {
+			var redemption types.Redemption
+			if err := k.cdc.Unmarshal(value, &redemption); err != nil {
+				return err
+			}
+			redemptions = append(redemptions, redemption)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedemptionQueueResponse{
+		Redemptions: redemptions,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Keeper) Redemption(
+	goCtx context.Context, req *types.QueryRedemptionRequest,
+) (*types.QueryRedemptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redemption, position, stableAhead, err := k.GetRedemptionPosition(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryRedemptionResponse{
+		Redemption:  redemption,
+		Position:    position,
+		StableAhead: stableAhead,
+	}, nil
+}
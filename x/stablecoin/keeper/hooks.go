@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
+	"github.com/NibiruChain/nibiru/x/stablecoin/types"
 )
 
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
@@ -12,12 +13,20 @@ func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochN
 func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
 	params := k.GetParams(ctx)
 	if epochIdentifier == params.DistrEpochIdentifier {
-		err := k.EvaluateCollRatio(ctx)
+		decision, err := k.evaluateCollRatio(ctx)
+		if err == nil {
+			decision.EpochNumber = epochNumber
+			k.SetCollRatioDecision(ctx, decision)
+			_ = ctx.EventManager().EmitTypedEvent(&types.EventCollRatioDecision{Decision: decision})
+		}
 
 		params = k.GetParams(ctx)
 		params.IsCollateralRatioValid = err == nil
 
 		k.SetParams(ctx, params)
+
+		k.ResetEpochUsages(ctx)
+		k.ProcessRedemptionQueue(ctx)
 	}
 }
 
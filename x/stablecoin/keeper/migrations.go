@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/stablecoin/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 sets the params of the collateral registry, the collateral ratio
+// controllers, the mint and burn caps and the peg oracle pair, as the param set
+// cannot be read while one of its keys is missing. The registry holds the USDC
+// collateral, whose mint fee is the fee ratio, and the step controller keeps
+// updating the collateral ratio, without caps.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	subspace := m.keeper.ParamSubspace
+
+	var feeRatio int64
+	subspace.Get(ctx, []byte("FeeRatio"), &feeRatio)
+	mintFee := sdk.NewDec(feeRatio).QuoInt64(1 * common.TO_MICRO)
+
+	subspace.Set(ctx, types.KeyCollaterals, types.DefaultCollaterals(mintFee))
+	subspace.Set(ctx, types.KeyController, types.CollRatioController_STEP)
+	subspace.Set(ctx, types.KeyPiKp, types.DefaultPiKp)
+	subspace.Set(ctx, types.KeyPiKi, types.DefaultPiKi)
+	subspace.Set(ctx, types.KeyMinCollRatio, int64(0))
+	subspace.Set(ctx, types.KeyMaxCollRatio, int64(1*common.TO_MICRO))
+	for _, key := range [][]byte{
+		types.KeyMintCap, types.KeyBurnCap, types.KeyAccountMintCap, types.KeyAccountBurnCap,
+	} {
+		subspace.Set(ctx, key, int64(0))
+	}
+	subspace.Set(ctx, types.KeyPegOraclePair, types.DefaultPegOraclePair)
+	return nil
+}
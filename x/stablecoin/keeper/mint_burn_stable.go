@@ -33,6 +33,15 @@ func (k Keeper) MintStable(
 		return nil, types.NoValidCollateralRatio
 	}
 
+	collateral, err := params.GetEnabledCollateral(msg.CollDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.checkMintCaps(ctx, msgCreator, msg.Stable.Amount); err != nil {
+		return nil, err
+	}
+
 	feeRatio := params.GetFeeRatioAsDec()
 	collRatio := params.GetCollRatioAsDec()
 	efFeeRatio := params.GetEfFeeRatioAsDec()
@@ -40,10 +49,13 @@ func (k Keeper) MintStable(
 
 	// The user deposits a mixture of collateral and GOV tokens based on the collateral ratio.
 	neededColl, collFees, err := k.
-		calcNeededCollateralAndFees(ctx, msg.Stable, collRatio, feeRatio)
+		calcNeededCollateralAndFees(ctx, msg.Stable, collateral, collRatio, collateral.MintFee)
 	if err != nil {
 		return nil, err
 	}
+	if err = k.checkCollateralCeiling(ctx, collateral, neededColl.Amount); err != nil {
+		return nil, err
+	}
 	neededGov, govFees, err := k.
 		calcNeededGovAndFees(ctx, msg.Stable, govRatio, feeRatio)
 	if err != nil {
@@ -82,6 +94,7 @@ func (k Keeper) MintStable(
 	if err != nil {
 		return nil, err
 	}
+	k.recordMint(ctx, msgCreator, msg.Stable.Amount)
 
 	return &types.MsgMintStableResponse{
 		Stable:    msg.Stable,
@@ -109,24 +122,25 @@ func (k Keeper) calcNeededGovAndFees(
 	return neededGov, govFee, nil
 }
 
-// calcNeededCollateralAndFees returns the needed collateral and the collateral fees
+// calcNeededCollateralAndFees returns the needed collateral and the collateral
+// fees, taken with the given fee ratio
 func (k Keeper) calcNeededCollateralAndFees(
 	ctx sdk.Context,
 	stable sdk.Coin,
+	collateral types.Collateral,
 	collRatio sdk.Dec,
 	feeRatio sdk.Dec,
 ) (sdk.Coin, sdk.Coin, error) {
-	priceColl, err := k.OracleKeeper.GetExchangeRate(
-		ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD))
+	priceColl, err := k.OracleKeeper.GetExchangeRate(ctx, collateral.OraclePair)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
 	neededCollUSD := sdk.NewDecFromInt(stable.Amount).Mul(collRatio)
 	neededCollAmt := neededCollUSD.Quo(priceColl).TruncateInt()
-	neededColl := sdk.NewCoin(denoms.USDC, neededCollAmt)
+	neededColl := sdk.NewCoin(collateral.Denom, neededCollAmt)
 	collFeeAmt := sdk.NewDecFromInt(neededCollAmt).Mul(feeRatio).RoundInt()
-	collFee := sdk.NewCoin(denoms.USDC, collFeeAmt)
+	collFee := sdk.NewCoin(collateral.Denom, collFeeAmt)
 
 	return neededColl, collFee, nil
 }
@@ -292,6 +306,8 @@ func (k Keeper) splitAndSendFeesToEfAndTreasury(
 
 // BurnStable burns stable coin (plus fees) and returns the equivalent of collateral and gov token.
 // Fees are distributed between ecosystem fund and treasury based on feeRatio.
+// The stables above the burn caps of the epoch are added to the redemption
+// queue.
 func (k Keeper) BurnStable(goCtx context.Context, msg *types.MsgBurnStable,
 ) (*types.MsgBurnStableResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -310,15 +326,75 @@ func (k Keeper) BurnStable(goCtx context.Context, msg *types.MsgBurnStable,
 		return nil, types.NoValidCollateralRatio
 	}
 
+	collateral, err := params.GetEnabledCollateral(msg.CollDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	// the stables above the burn caps are queued, the rest is burned now
+	amount := k.availableBurn(ctx, msgCreator, msg.Stable.Amount)
+	queued := msg.Stable.SubAmount(amount)
+	if queued.IsPositive() {
+		// an account has at most one queued redemption
+		if id, found := k.GetOwnerRedemptionID(ctx, msgCreator); found {
+			return nil, types.RedemptionAlreadyQueued.Wrapf("redemption %d of %s", id, msgCreator)
+		}
+	}
+
+	resp := &types.MsgBurnStableResponse{
+		Collateral: sdk.NewCoin(collateral.Denom, sdk.ZeroInt()),
+		Gov:        sdk.NewCoin(denoms.NIBI, sdk.ZeroInt()),
+		FeesPayed:  sdk.NewCoins(),
+	}
+	if amount.IsPositive() {
+		// Send NUSD from account to module
+		stable := sdk.NewCoin(msg.Stable.Denom, amount)
+		err = k.BankKeeper.SendCoinsFromAccountToModule(
+			ctx, msgCreator, types.ModuleName, sdk.NewCoins(stable))
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err = k.redeemStable(ctx, params, msgCreator, stable, collateral)
+		if err != nil {
+			return nil, err
+		}
+		k.recordBurn(ctx, msgCreator, amount)
+	}
+
+	if queued.IsPositive() {
+		redemption, err := k.queueRedemption(ctx, msgCreator, queued, collateral.Denom)
+		if err != nil {
+			return nil, err
+		}
+		resp.Queued = true
+		resp.RedemptionId = redemption.Id
+		resp.QueuedStable = queued
+	}
+
+	return resp, nil
+}
+
+/*
+redeemStable burns stables held by the module account and gives their owner the
+equivalent of collateral and gov token, minus the fees.
+*/
+func (k Keeper) redeemStable(
+	ctx sdk.Context,
+	params types.Params,
+	owner sdk.AccAddress,
+	stable sdk.Coin,
+	collateral types.Collateral,
+) (*types.MsgBurnStableResponse, error) {
 	feeRatio := params.GetFeeRatioAsDec()
 	collRatio := params.GetCollRatioAsDec()
 	govRatio := sdk.OneDec().Sub(collRatio)
 
-	redeemGovCoin, govFees, err := k.calcNeededGovAndFees(ctx, msg.Stable, govRatio, feeRatio)
+	redeemGovCoin, govFees, err := k.calcNeededGovAndFees(ctx, stable, govRatio, feeRatio)
 	if err != nil {
 		return nil, err
 	}
-	redeemCollCoin, collFees, err := k.calcNeededCollateralAndFees(ctx, msg.Stable, collRatio, feeRatio)
+	redeemCollCoin, collFees, err := k.calcNeededCollateralAndFees(ctx, stable, collateral, collRatio, feeRatio)
 	if err != nil {
 		return nil, err
 	}
@@ -328,17 +404,8 @@ func (k Keeper) BurnStable(goCtx context.Context, msg *types.MsgBurnStable,
 	}
 	// The user receives a mixure of collateral (COLL) and governance (GOV) tokens
 	// based on the collateral ratio.
-
-	// Send NUSD from account to module
-	stablesToBurn := sdk.NewCoins(msg.Stable)
-	err = k.BankKeeper.SendCoinsFromAccountToModule(
-		ctx, msgCreator, types.ModuleName, stablesToBurn)
-	if err != nil {
-		return nil, err
-	}
-
 	redeemedCoins := sdk.NewCoins(redeemCollCoin, redeemGovCoin)
-	err = k.sendCoinsFromModuleAccountToUser(ctx, msgCreator, redeemedCoins)
+	err = k.sendCoinsFromModuleAccountToUser(ctx, owner, redeemedCoins)
 	if err != nil {
 		return nil, err
 	}
@@ -346,7 +413,7 @@ func (k Keeper) BurnStable(goCtx context.Context, msg *types.MsgBurnStable,
 	feesToSendEF := sdk.NewCoins(govFees, collFees)
 	err = k.splitAndSendFeesToEfAndTreasury(
 		ctx,
-		msgCreator,
+		owner,
 		params.GetEfFeeRatioAsDec(),
 		feesToSendEF,
 	)
@@ -354,7 +421,7 @@ func (k Keeper) BurnStable(goCtx context.Context, msg *types.MsgBurnStable,
 		return nil, err
 	}
 
-	err = k.burnStableTokens(ctx, msg.Stable)
+	err = k.burnStableTokens(ctx, stable)
 	if err != nil {
 		return nil, err
 	}
@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/stablecoin/keeper"
 	"github.com/NibiruChain/nibiru/x/stablecoin/types"
 )
 
@@ -23,6 +28,34 @@ func TestGetParams(t *testing.T) {
 	require.EqualValues(t, params, stableKeeper.GetParams(ctx))
 }
 
+func TestMigrate2to3(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	stableKeeper := nibiruApp.StablecoinKeeper
+
+	// a store of version 2 has none of the params added since
+	params := types.DefaultParams()
+	params.FeeRatio = 3_000
+	params.Controller = types.CollRatioController_PI
+	params.MintCap = 1_000
+	stableKeeper.SetParams(ctx, params)
+	paramStore := prefix.NewStore(ctx.KVStore(nibiruApp.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, key := range [][]byte{
+		types.KeyCollaterals, types.KeyController, types.KeyPiKp, types.KeyPiKi,
+		types.KeyMinCollRatio, types.KeyMaxCollRatio, types.KeyMintCap, types.KeyBurnCap,
+		types.KeyAccountMintCap, types.KeyAccountBurnCap, types.KeyPegOraclePair,
+	} {
+		paramStore.Delete(key)
+	}
+	require.Panics(t, func() { stableKeeper.GetParams(ctx) })
+
+	require.NoError(t, keeper.NewMigrator(stableKeeper).Migrate2to3(ctx))
+	params.Collaterals = types.DefaultCollaterals(sdk.MustNewDecFromStr("0.003"))
+	params.Controller = types.CollRatioController_STEP
+	params.MintCap = 0
+	require.Equal(t, params, stableKeeper.GetParams(ctx))
+	require.NoError(t, params.Validate())
+}
+
 func TestNewParams_Errors(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -80,6 +113,15 @@ func TestNewParams_Errors(t *testing.T) {
 				"stable EF fee ratio is above max value(1e6): %s",
 				sdk.MustNewDecFromStr("2").Mul(sdk.NewDec(1*common.TO_MICRO)).TruncateInt()),
 		},
+		{
+			"peg oracle pair not priced in stablecoins",
+			func() types.Params {
+				params := types.DefaultParams()
+				params.PegOraclePair = asset.Registry.Pair(denoms.NUSD, denoms.USDC)
+				return params
+			}(),
+			fmt.Errorf("peg oracle pair unusd:uusdc does not price in unusd"),
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/stablecoin/types"
+)
+
+// ---------------------------------------------------------------------------
+// Epoch Usage
+// ---------------------------------------------------------------------------
+
+// GetEpochUsage returns the amount of stables minted and burned during the
+// current epoch.
+func (k Keeper) GetEpochUsage(ctx sdk.Context) (usage types.EpochUsage) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyEpochUsage)
+	if bz == nil {
+		return types.NewEpochUsage()
+	}
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage
+}
+
+// SetEpochUsage sets the amount of stables minted and burned during the current
+// epoch.
+func (k Keeper) SetEpochUsage(ctx sdk.Context, usage types.EpochUsage) {
+	ctx.KVStore(k.storeKey).Set(types.KeyEpochUsage, k.cdc.MustMarshal(&usage))
+}
+
+// GetAccountEpochUsage returns the amount of stables minted and burned by an
+// account during the current epoch.
+func (k Keeper) GetAccountEpochUsage(ctx sdk.Context, account sdk.AccAddress) (usage types.EpochUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccountEpochUsage)
+	bz := store.Get(account)
+	if bz == nil {
+		return types.NewEpochUsage()
+	}
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage
+}
+
+// SetAccountEpochUsage sets the amount of stables minted and burned by an
+// account during the current epoch.
+func (k Keeper) SetAccountEpochUsage(ctx sdk.Context, account sdk.AccAddress, usage types.EpochUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccountEpochUsage)
+	store.Set(account, k.cdc.MustMarshal(&usage))
+}
+
+// FetchAllAccountEpochUsages returns the usages of all the accounts during the
+// current epoch.
+func (k Keeper) FetchAllAccountEpochUsages(ctx sdk.Context) (usages []types.AccountEpochUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccountEpochUsage)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.EpochUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+		usages = append(usages, types.AccountEpochUsage{
+			Address: sdk.AccAddress(iterator.Key()).String(),
+			Usage:   usage,
+		})
+	}
+	return usages
+}
+
+// ResetEpochUsages clears the global and account usages at the start of an
+// epoch.
+func (k Keeper) ResetEpochUsages(ctx sdk.Context) {
+	k.SetEpochUsage(ctx, types.NewEpochUsage())
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAccountEpochUsage)
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Rate Limits
+// ---------------------------------------------------------------------------
+
+/*
+GetRateLimits returns the mint and burn capacity left in the current epoch,
+globally and for an account. The account rate limits are empty if the account is
+nil.
+*/
+func (k Keeper) GetRateLimits(ctx sdk.Context, account sdk.AccAddress) (
+	mint, burn, accountMint, accountBurn types.RateLimit,
+) {
+	params := k.GetParams(ctx)
+	usage := k.GetEpochUsage(ctx)
+	mint = types.NewRateLimit(params.MintCap, usage.Minted)
+	burn = types.NewRateLimit(params.BurnCap, usage.Burned)
+
+	if account.Empty() {
+		return mint, burn, types.NewRateLimit(0, sdkmath.ZeroInt()), types.NewRateLimit(0, sdkmath.ZeroInt())
+	}
+
+	accountUsage := k.GetAccountEpochUsage(ctx, account)
+	accountMint = types.NewRateLimit(params.AccountMintCap, accountUsage.Minted)
+	accountBurn = types.NewRateLimit(params.AccountBurnCap, accountUsage.Burned)
+	return mint, burn, accountMint, accountBurn
+}
+
+// checkMintCaps checks that an account can mint an amount of stables without
+// exceeding the global and account mint caps.
+func (k Keeper) checkMintCaps(ctx sdk.Context, account sdk.AccAddress, amount sdkmath.Int) error {
+	mint, _, accountMint, _ := k.GetRateLimits(ctx, account)
+	if mint.Available(amount).LT(amount) {
+		return types.MintCapReached.Wrapf(
+			"%s stables left to mint in the epoch, got %s", mint.Remaining, amount)
+	}
+	if accountMint.Available(amount).LT(amount) {
+		return types.MintCapReached.Wrapf(
+			"%s stables left to mint in the epoch for %s, got %s", accountMint.Remaining, account, amount)
+	}
+	return nil
+}
+
+// availableBurn returns the part of an amount of stables that an account can
+// burn without exceeding the global and account burn caps.
+func (k Keeper) availableBurn(ctx sdk.Context, account sdk.AccAddress, amount sdkmath.Int) sdkmath.Int {
+	_, burn, _, accountBurn := k.GetRateLimits(ctx, account)
+	return accountBurn.Available(burn.Available(amount))
+}
+
+// recordMint adds a mint to the global and account usages.
+func (k Keeper) recordMint(ctx sdk.Context, account sdk.AccAddress, amount sdkmath.Int) {
+	usage := k.GetEpochUsage(ctx)
+	usage.Minted = usage.Minted.Add(amount)
+	k.SetEpochUsage(ctx, usage)
+
+	accountUsage := k.GetAccountEpochUsage(ctx, account)
+	accountUsage.Minted = accountUsage.Minted.Add(amount)
+	k.SetAccountEpochUsage(ctx, account, accountUsage)
+}
+
+// recordBurn adds a burn to the global and account usages.
+func (k Keeper) recordBurn(ctx sdk.Context, account sdk.AccAddress, amount sdkmath.Int) {
+	usage := k.GetEpochUsage(ctx)
+	usage.Burned = usage.Burned.Add(amount)
+	k.SetEpochUsage(ctx, usage)
+
+	accountUsage := k.GetAccountEpochUsage(ctx, account)
+	accountUsage.Burned = accountUsage.Burned.Add(amount)
+	k.SetAccountEpochUsage(ctx, account, accountUsage)
+}
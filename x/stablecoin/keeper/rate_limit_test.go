@@ -0,0 +1,325 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/stablecoin/types"
+)
+
+// setupRateLimits sets valid params with the given caps and posts the prices of
+// NIBI and USDC.
+func setupRateLimits(
+	nibiruApp *app.NibiruApp, ctx sdk.Context, mintCap, burnCap, accountMintCap, accountBurnCap int64,
+) types.Params {
+	params := types.NewParams(
+		/* collRatio */ sdk.MustNewDecFromStr("0.9"),
+		/* feeRatio */ sdk.MustNewDecFromStr("0.002"),
+		/* efFeeRatio */ sdk.MustNewDecFromStr("0.5"),
+		/* bonusRateRecoll */ sdk.MustNewDecFromStr("0.002"),
+		/* distrEpochIdentifier */ "15 min",
+		/* adjustmentStep */ sdk.MustNewDecFromStr("0.0025"),
+		/* priceLowerBound */ sdk.MustNewDecFromStr("0.9999"),
+		/* priceUpperBound */ sdk.MustNewDecFromStr("1.0001"),
+		/* isCollateralRatioValid */ true,
+	)
+	params.MintCap = mintCap
+	params.BurnCap = burnCap
+	params.AccountMintCap = accountMintCap
+	params.AccountBurnCap = accountBurnCap
+	nibiruApp.StablecoinKeeper.SetParams(ctx, params)
+	nibiruApp.AccountKeeper.GetModuleAccount(ctx, types.StableEFModuleAccount)
+
+	nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.NIBI, denoms.NUSD), sdk.NewDec(10))
+	nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD), sdk.OneDec())
+	return params
+}
+
+func TestMintStable_MintCaps(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	params := setupRateLimits(nibiruApp, ctx, 1_500_000, 0, 1*common.TO_MICRO, 0)
+	stablecoinKeeper := nibiruApp.StablecoinKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	alice, bob := testutil.AccAddress(), testutil.AccAddress()
+	for _, acc := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, testapp.FundAccount(nibiruApp.BankKeeper, ctx, acc, sdk.NewCoins(
+			sdk.NewInt64Coin(denoms.NIBI, 10*common.TO_MICRO),
+			sdk.NewInt64Coin(denoms.USDC, 10*common.TO_MICRO),
+		)))
+	}
+	mint := func(acc sdk.AccAddress, amount int64) error {
+		_, err := stablecoinKeeper.MintStable(goCtx, &types.MsgMintStable{
+			Creator: acc.String(),
+			Stable:  sdk.NewInt64Coin(denoms.NUSD, amount),
+		})
+		return err
+	}
+
+	require.NoError(t, mint(alice, 1*common.TO_MICRO))
+	t.Log("account mint cap reached")
+	require.ErrorIs(t, mint(alice, 100_000), types.MintCapReached)
+	t.Log("global mint cap reached")
+	require.ErrorIs(t, mint(bob, 600_000), types.MintCapReached)
+	require.NoError(t, mint(bob, 500_000))
+
+	resp, err := stablecoinKeeper.RateLimits(goCtx, &types.QueryRateLimitsRequest{Address: alice.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1_500_000), resp.Mint.Used)
+	require.True(t, resp.Mint.Remaining.IsZero())
+	require.Equal(t, sdk.NewInt(1*common.TO_MICRO), resp.AccountMint.Used)
+	require.False(t, resp.Burn.Cap.IsPositive())
+
+	t.Log("the caps are reset at the end of the epoch")
+	stablecoinKeeper.AfterEpochEnd(ctx, params.DistrEpochIdentifier, 1)
+	require.NoError(t, mint(alice, 1*common.TO_MICRO))
+}
+
+func TestBurnStable_RedemptionQueue(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	params := setupRateLimits(nibiruApp, ctx, 0, 1*common.TO_MICRO, 0, 0)
+	stablecoinKeeper := nibiruApp.StablecoinKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	require.NoError(t, nibiruApp.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.USDC, 10*common.TO_MICRO),
+	)))
+	alice, bob := testutil.AccAddress(), testutil.AccAddress()
+	for _, acc := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, testapp.FundAccount(nibiruApp.BankKeeper, ctx, acc, sdk.NewCoins(
+			sdk.NewInt64Coin(denoms.NUSD, 2*common.TO_MICRO),
+		)))
+	}
+	burn := func(acc sdk.AccAddress, amount int64) *types.MsgBurnStableResponse {
+		resp, err := stablecoinKeeper.BurnStable(goCtx, &types.MsgBurnStable{
+			Creator: acc.String(),
+			Stable:  sdk.NewInt64Coin(denoms.NUSD, amount),
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Log("burn within the cap")
+	resp := burn(alice, 800_000)
+	require.False(t, resp.Queued)
+	require.True(t, resp.Collateral.IsPositive())
+
+	t.Log("the stables above the cap are queued")
+	resp = burn(bob, 500_000)
+	require.True(t, resp.Queued)
+	require.True(t, resp.Collateral.IsPositive())
+	require.Equal(t, sdk.NewInt64Coin(denoms.NUSD, 300_000), resp.QueuedStable)
+	require.EqualValues(t, 0, resp.RedemptionId)
+	resp = burn(alice, 1_000_000)
+	require.True(t, resp.Queued)
+	require.True(t, resp.Collateral.IsZero())
+	require.Equal(t, sdk.NewInt64Coin(denoms.NUSD, 1_000_000), resp.QueuedStable)
+	require.EqualValues(t, 1, resp.RedemptionId)
+	require.Equal(t, sdk.NewInt(1_500_000), nibiruApp.BankKeeper.GetBalance(ctx, bob, denoms.NUSD).Amount)
+
+	t.Log("an account has at most one queued redemption")
+	_, err := stablecoinKeeper.BurnStable(goCtx, types.NewMsgBurn(bob.String(), sdk.NewInt64Coin(denoms.NUSD, 100)))
+	require.ErrorIs(t, err, types.RedemptionAlreadyQueued)
+	require.Equal(t, sdk.NewInt(1_500_000), nibiruApp.BankKeeper.GetBalance(ctx, bob, denoms.NUSD).Amount)
+
+	redemption, err := stablecoinKeeper.GetRedemption(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denoms.NUSD, 300_000), redemption.Stable)
+	redemptionResp, err := stablecoinKeeper.Redemption(goCtx, &types.QueryRedemptionRequest{Id: 1})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, redemptionResp.Position)
+	require.Equal(t, sdk.NewInt64Coin(denoms.NUSD, 300_000), redemptionResp.StableAhead)
+
+	rateLimits, err := stablecoinKeeper.RateLimits(goCtx, &types.QueryRateLimitsRequest{})
+	require.NoError(t, err)
+	require.True(t, rateLimits.Burn.Remaining.IsZero())
+
+	t.Log("the queue is processed in order at the end of the epoch")
+	bobCollateral := nibiruApp.BankKeeper.GetBalance(ctx, bob, denoms.USDC)
+	stablecoinKeeper.AfterEpochEnd(ctx, params.DistrEpochIdentifier, 1)
+	_, err = stablecoinKeeper.GetRedemption(ctx, 0)
+	require.ErrorIs(t, err, types.RedemptionNotFound)
+	require.True(t, nibiruApp.BankKeeper.GetBalance(ctx, bob, denoms.USDC).Amount.GT(bobCollateral.Amount))
+
+	redemption, err = stablecoinKeeper.GetRedemption(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denoms.NUSD, 300_000), redemption.Stable)
+	require.Equal(t, sdk.NewInt(1*common.TO_MICRO), stablecoinKeeper.GetEpochUsage(ctx).Burned)
+
+	t.Log("redemptions of a disabled collateral are refunded")
+	params = stablecoinKeeper.GetParams(ctx)
+	params.Collaterals[0].Enabled = false
+	stablecoinKeeper.SetParams(ctx, params)
+	aliceBalance := nibiruApp.BankKeeper.GetBalance(ctx, alice, denoms.NUSD)
+
+	stablecoinKeeper.AfterEpochEnd(ctx, params.DistrEpochIdentifier, 2)
+	require.Empty(t, stablecoinKeeper.FetchAllRedemptions(ctx))
+	require.Equal(t,
+		aliceBalance.Add(sdk.NewInt64Coin(denoms.NUSD, 300_000)),
+		nibiruApp.BankKeeper.GetBalance(ctx, alice, denoms.NUSD))
+}
+
+func TestProcessRedemptionQueue_FailedRedemption(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	params := setupRateLimits(nibiruApp, ctx, 0, 1, 0, 0)
+	params.Collaterals = append(params.Collaterals, types.NewCollateral(
+		denoms.USDT, asset.Registry.Pair(denoms.USDT, denoms.NUSD), sdk.MustNewDecFromStr("0.002")))
+	nibiruApp.StablecoinKeeper.SetParams(ctx, params)
+	nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.USDT, denoms.NUSD), sdk.OneDec())
+	stablecoinKeeper := nibiruApp.StablecoinKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	// the module holds no USDT to redeem
+	require.NoError(t, nibiruApp.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.USDC, 10*common.TO_MICRO),
+	)))
+	alice, bob := testutil.AccAddress(), testutil.AccAddress()
+	for _, acc := range []sdk.AccAddress{alice, bob} {
+		require.NoError(t, testapp.FundAccount(nibiruApp.BankKeeper, ctx, acc, sdk.NewCoins(
+			sdk.NewInt64Coin(denoms.NUSD, 1*common.TO_MICRO),
+		)))
+	}
+	burn := func(acc sdk.AccAddress, collDenom string) {
+		resp, err := stablecoinKeeper.BurnStable(goCtx, &types.MsgBurnStable{
+			Creator:   acc.String(),
+			Stable:    sdk.NewInt64Coin(denoms.NUSD, 1*common.TO_MICRO),
+			CollDenom: collDenom,
+		})
+		require.NoError(t, err)
+		require.True(t, resp.Queued)
+	}
+	burn(alice, denoms.USDT)
+	burn(bob, denoms.USDC)
+
+	params.BurnCap = 0
+	stablecoinKeeper.SetParams(ctx, params)
+
+	t.Log("a failed redemption is refunded and doesn't block the queue")
+	stablecoinKeeper.AfterEpochEnd(ctx, params.DistrEpochIdentifier, 1)
+	require.Empty(t, stablecoinKeeper.FetchAllRedemptions(ctx))
+	require.Equal(t,
+		sdk.NewInt64Coin(denoms.NUSD, 1*common.TO_MICRO-1),
+		nibiruApp.BankKeeper.GetBalance(ctx, alice, denoms.NUSD))
+	require.True(t, nibiruApp.BankKeeper.GetBalance(ctx, bob, denoms.USDC).IsPositive())
+}
+
+func TestCancelRedemption(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	setupRateLimits(nibiruApp, ctx, 0, 1, 0, 0)
+	stablecoinKeeper := nibiruApp.StablecoinKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	require.NoError(t, nibiruApp.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.USDC, 10*common.TO_MICRO),
+	)))
+	alice, bob := testutil.AccAddress(), testutil.AccAddress()
+	require.NoError(t, testapp.FundAccount(nibiruApp.BankKeeper, ctx, alice, sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.NUSD, 1*common.TO_MICRO),
+	)))
+	resp, err := stablecoinKeeper.BurnStable(goCtx, &types.MsgBurnStable{
+		Creator: alice.String(),
+		Stable:  sdk.NewInt64Coin(denoms.NUSD, 1*common.TO_MICRO),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denoms.NUSD, 1*common.TO_MICRO-1), resp.QueuedStable)
+
+	_, err = stablecoinKeeper.CancelRedemption(goCtx, types.NewMsgCancelRedemption(bob.String(), resp.RedemptionId))
+	require.ErrorIs(t, err, errors.ErrUnauthorized)
+	_, err = stablecoinKeeper.CancelRedemption(goCtx, types.NewMsgCancelRedemption(alice.String(), resp.RedemptionId+1))
+	require.ErrorIs(t, err, types.RedemptionNotFound)
+
+	cancelResp, err := stablecoinKeeper.CancelRedemption(goCtx, types.NewMsgCancelRedemption(alice.String(), resp.RedemptionId))
+	require.NoError(t, err)
+	require.Equal(t, resp.QueuedStable, cancelResp.Stable)
+	require.Equal(t, resp.QueuedStable, nibiruApp.BankKeeper.GetBalance(ctx, alice, denoms.NUSD))
+	require.Empty(t, stablecoinKeeper.FetchAllRedemptions(ctx))
+}
+
+func TestProcessRedemptionQueue_Bounded(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	params := setupRateLimits(nibiruApp, ctx, 0, 1, 0, 0)
+	stablecoinKeeper := nibiruApp.StablecoinKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	require.NoError(t, nibiruApp.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.USDC, 10*common.TO_MICRO),
+	)))
+	for i := 0; i < types.MaxRedemptionsPerEpoch+1; i++ {
+		acc := testutil.AccAddress()
+		require.NoError(t, testapp.FundAccount(nibiruApp.BankKeeper, ctx, acc, sdk.NewCoins(
+			sdk.NewInt64Coin(denoms.NUSD, 1_000),
+		)))
+		_, err := stablecoinKeeper.BurnStable(goCtx, types.NewMsgBurn(acc.String(), sdk.NewInt64Coin(denoms.NUSD, 1_000)))
+		require.NoError(t, err)
+	}
+	require.Len(t, stablecoinKeeper.FetchAllRedemptions(ctx), types.MaxRedemptionsPerEpoch+1)
+
+	params.BurnCap = 0
+	stablecoinKeeper.SetParams(ctx, params)
+
+	t.Log("at most MaxRedemptionsPerEpoch redemptions are processed per epoch")
+	stablecoinKeeper.AfterEpochEnd(ctx, params.DistrEpochIdentifier, 1)
+	redemptions := stablecoinKeeper.FetchAllRedemptions(ctx)
+	require.Len(t, redemptions, 1)
+	require.EqualValues(t, types.MaxRedemptionsPerEpoch, redemptions[0].Id)
+}
+
+func TestProcessRedemptionQueue_BurnCapReached(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext()
+	params := setupRateLimits(nibiruApp, ctx, 0, 1*common.TO_MICRO, 0, 0)
+	params.Collaterals = append(params.Collaterals, types.NewCollateral(
+		denoms.USDT, asset.Registry.Pair(denoms.USDT, denoms.NUSD), sdk.MustNewDecFromStr("0.002")))
+	nibiruApp.StablecoinKeeper.SetParams(ctx, params)
+	nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.USDT, denoms.NUSD), sdk.OneDec())
+	stablecoinKeeper := nibiruApp.StablecoinKeeper
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	require.NoError(t, nibiruApp.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.USDC, 10*common.TO_MICRO),
+	)))
+	alice, bob, carol, dave := testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress()
+	for _, acc := range []sdk.AccAddress{alice, bob, carol, dave} {
+		require.NoError(t, testapp.FundAccount(nibiruApp.BankKeeper, ctx, acc, sdk.NewCoins(
+			sdk.NewInt64Coin(denoms.NUSD, 1*common.TO_MICRO),
+		)))
+	}
+	burn := func(acc sdk.AccAddress, amount int64, collDenom string) {
+		_, err := stablecoinKeeper.BurnStable(goCtx, &types.MsgBurnStable{
+			Creator:   acc.String(),
+			Stable:    sdk.NewInt64Coin(denoms.NUSD, amount),
+			CollDenom: collDenom,
+		})
+		require.NoError(t, err)
+	}
+	burn(alice, 1*common.TO_MICRO, denoms.USDC)
+	burn(bob, 1*common.TO_MICRO, denoms.USDC)
+	burn(carol, 500_000, denoms.USDC)
+	burn(dave, 500_000, denoms.USDT)
+	require.Len(t, stablecoinKeeper.FetchAllRedemptions(ctx), 3)
+
+	params.Collaterals[1].Enabled = false
+	stablecoinKeeper.SetParams(ctx, params)
+
+	t.Log("processing stops once the global burn cap is reached")
+	stablecoinKeeper.AfterEpochEnd(ctx, params.DistrEpochIdentifier, 1)
+	require.Equal(t, sdk.NewInt(1*common.TO_MICRO), stablecoinKeeper.GetEpochUsage(ctx).Burned)
+	_, found := stablecoinKeeper.GetOwnerRedemptionID(ctx, bob)
+	require.False(t, found)
+
+	redemptions := stablecoinKeeper.FetchAllRedemptions(ctx)
+	require.Len(t, redemptions, 2)
+	require.Equal(t, carol.String(), redemptions[0].Owner)
+	require.Equal(t, sdk.NewInt64Coin(denoms.NUSD, 500_000), redemptions[0].Stable)
+	// the redemption of the disabled collateral behind the cap is not refunded yet
+	require.Equal(t, dave.String(), redemptions[1].Owner)
+	require.Equal(t, sdk.NewInt64Coin(denoms.NUSD, 500_000), nibiruApp.BankKeeper.GetBalance(ctx, dave, denoms.NUSD))
+}
@@ -0,0 +1,278 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/NibiruChain/nibiru/x/stablecoin/types"
+)
+
+// ---------------------------------------------------------------------------
+// Redemption Queue
+// ---------------------------------------------------------------------------
+
+// GetNextRedemptionID returns the id of the next queued redemption.
+func (k Keeper) GetNextRedemptionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyNextRedemptionID)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextRedemptionID sets the id of the next queued redemption.
+func (k Keeper) SetNextRedemptionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyNextRedemptionID, sdk.Uint64ToBigEndian(id))
+}
+
+// SetRedemption stores a redemption in the queue.
+func (k Keeper) SetRedemption(ctx sdk.Context, redemption types.Redemption) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedemptionQueue)
+	store.Set(sdk.Uint64ToBigEndian(redemption.Id), k.cdc.MustMarshal(&redemption))
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOwnerRedemption)
+	owner := sdk.MustAccAddressFromBech32(redemption.Owner)
+	ownerStore.Set(owner, sdk.Uint64ToBigEndian(redemption.Id))
+}
+
+// GetRedemption returns a queued redemption.
+func (k Keeper) GetRedemption(ctx sdk.Context, id uint64) (redemption types.Redemption, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedemptionQueue)
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
+	if bz == nil {
+		return redemption, types.RedemptionNotFound.Wrapf("id %d", id)
+	}
+	k.cdc.MustUnmarshal(bz, &redemption)
+	return redemption, nil
+}
+
+// GetOwnerRedemptionID returns the id of the queued redemption of an account,
+// if any.
+func (k Keeper) GetOwnerRedemptionID(ctx sdk.Context, owner sdk.AccAddress) (id uint64, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOwnerRedemption)
+	bz := store.Get(owner)
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// deleteRedemption removes a redemption from the queue.
+func (k Keeper) deleteRedemption(ctx sdk.Context, redemption types.Redemption) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedemptionQueue)
+	store.Delete(sdk.Uint64ToBigEndian(redemption.Id))
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixOwnerRedemption)
+	ownerStore.Delete(sdk.MustAccAddressFromBech32(redemption.Owner))
+}
+
+// FetchAllRedemptions returns the queued redemptions, in queue order.
+func (k Keeper) FetchAllRedemptions(ctx sdk.Context) (redemptions []types.Redemption) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedemptionQueue)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var redemption types.Redemption
+		k.cdc.MustUnmarshal(iterator.Value(), &redemption)
+		redemptions = append(redemptions, redemption)
+	}
+	return redemptions
+}
+
+/*
+GetRedemptionPosition returns the position of a redemption in the queue,
+starting at 1, and the amount of stables to redeem before it.
+*/
+func (k Keeper) GetRedemptionPosition(ctx sdk.Context, id uint64) (
+	redemption types.Redemption, position uint64, stableAhead sdk.Coin, err error,
+) {
+	redemption, err = k.GetRedemption(ctx, id)
+	if err != nil {
+		return redemption, 0, sdk.Coin{}, err
+	}
+
+	stableAhead = sdk.NewCoin(redemption.Stable.Denom, sdk.ZeroInt())
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedemptionQueue)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(id))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ahead types.Redemption
+		k.cdc.MustUnmarshal(iterator.Value(), &ahead)
+		stableAhead = stableAhead.Add(ahead.Stable)
+		position++
+	}
+	return redemption, position + 1, stableAhead, nil
+}
+
+// queueRedemption holds the stables of a burn above the burn caps in the module
+// account and adds them at the end of the redemption queue. The caller checks
+// that the owner has no queued redemption yet.
+func (k Keeper) queueRedemption(
+	ctx sdk.Context, owner sdk.AccAddress, stable sdk.Coin, collDenom string,
+) (redemption types.Redemption, err error) {
+	err = k.sendCoinsToModuleAccount(ctx, owner, sdk.NewCoins(stable))
+	if err != nil {
+		return redemption, err
+	}
+
+	id := k.GetNextRedemptionID(ctx)
+	redemption = types.Redemption{
+		Id:          id,
+		Owner:       owner.String(),
+		Stable:      stable,
+		CollDenom:   collDenom,
+		BlockHeight: ctx.BlockHeight(),
+	}
+	k.SetRedemption(ctx, redemption)
+	k.SetNextRedemptionID(ctx, id+1)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRedemptionQueued{Redemption: redemption})
+	return redemption, err
+}
+
+/*
+ProcessRedemptionQueue redeems the queued stables at the prevailing rate, in
+queue order, within the burn caps of the new epoch. A redemption exceeding the
+caps is partially redeemed and keeps its place in the queue, and redemptions of
+accounts that reached their account burn cap are skipped. Redemptions whose
+collateral is no longer enabled, or that fail to be redeemed, are refunded so
+that they don't block the queue. Processing stops once the global burn cap is
+reached or MaxRedemptionsPerEpoch redemptions were redeemed or refunded; the
+skipped redemptions don't count towards that limit.
+*/
+func (k Keeper) ProcessRedemptionQueue(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.IsCollateralRatioValid {
+		return
+	}
+
+	var processed int
+	var start []byte
+	for processed < types.MaxRedemptionsPerEpoch {
+		redemption, found := k.nextRedemption(ctx, start)
+		if !found {
+			return
+		}
+		start = sdk.Uint64ToBigEndian(redemption.Id + 1)
+		owner := sdk.MustAccAddressFromBech32(redemption.Owner)
+
+		collateral, err := params.GetEnabledCollateral(redemption.CollDenom)
+		if err != nil {
+			k.tryRefundRedemption(ctx, owner, redemption)
+			processed++
+			continue
+		}
+
+		_, burn, _, _ := k.GetRateLimits(ctx, owner)
+		if burn.IsCapped() && burn.Remaining.IsZero() {
+			return
+		}
+		amount := k.availableBurn(ctx, owner, redemption.Stable.Amount)
+		if amount.IsZero() {
+			// the owner reached the account burn cap
+			continue
+		}
+		processed++
+
+		stable := sdk.NewCoin(redemption.Stable.Denom, amount)
+		cacheCtx, commit := ctx.CacheContext()
+		resp, err := k.redeemStable(cacheCtx, params, owner, stable, collateral)
+		if err != nil {
+			k.Logger(ctx).Error("failed to process redemption", "id", redemption.Id, "error", err)
+			k.tryRefundRedemption(ctx, owner, redemption)
+			continue
+		}
+		commit()
+		k.recordBurn(ctx, owner, amount)
+
+		redemption.Stable = redemption.Stable.Sub(stable)
+		if redemption.Stable.IsZero() {
+			k.deleteRedemption(ctx, redemption)
+		} else {
+			k.SetRedemption(ctx, redemption)
+		}
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventRedemptionProcessed{
+			RedemptionId: redemption.Id,
+			Owner:        redemption.Owner,
+			Stable:       stable,
+			Collateral:   resp.Collateral,
+			Gov:          resp.Gov,
+		})
+	}
+}
+
+// nextRedemption returns the first queued redemption whose id key is at or
+// after start, or the head of the queue if start is nil.
+func (k Keeper) nextRedemption(ctx sdk.Context, start []byte) (redemption types.Redemption, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRedemptionQueue)
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return redemption, false
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), &redemption)
+	return redemption, true
+}
+
+// tryRefundRedemption refunds a redemption, leaving it in the queue if the
+// refund fails.
+func (k Keeper) tryRefundRedemption(ctx sdk.Context, owner sdk.AccAddress, redemption types.Redemption) {
+	cacheCtx, commit := ctx.CacheContext()
+	if err := k.refundRedemption(cacheCtx, owner, redemption); err != nil {
+		k.Logger(ctx).Error("failed to refund redemption", "id", redemption.Id, "error", err)
+		return
+	}
+	commit()
+}
+
+// CancelRedemption removes a redemption of the creator from the queue and gives
+// its held stables back.
+func (k Keeper) CancelRedemption(
+	goCtx context.Context, msg *types.MsgCancelRedemption,
+) (*types.MsgCancelRedemptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	redemption, err := k.GetRedemption(ctx, msg.RedemptionId)
+	if err != nil {
+		return nil, err
+	}
+	if redemption.Owner != msg.Creator {
+		return nil, errors.ErrUnauthorized.Wrapf(
+			"redemption %d is owned by %s, not %s", redemption.Id, redemption.Owner, msg.Creator)
+	}
+
+	if err = k.refundRedemption(ctx, owner, redemption); err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelRedemptionResponse{Stable: redemption.Stable}, nil
+}
+
+// refundRedemption gives the held stables back to the owner of a redemption and
+// removes it from the queue.
+func (k Keeper) refundRedemption(
+	ctx sdk.Context, owner sdk.AccAddress, redemption types.Redemption,
+) error {
+	err := k.sendCoinsFromModuleAccountToUser(ctx, owner, sdk.NewCoins(redemption.Stable))
+	if err != nil {
+		return err
+	}
+	k.deleteRedemption(ctx, redemption)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRedemptionProcessed{
+		RedemptionId: redemption.Id,
+		Owner:        redemption.Owner,
+		Stable:       redemption.Stable,
+		Refunded:     true,
+	})
+}
@@ -10,12 +10,14 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgMintStable{}, "stablecoin/MintStable", nil)
 	cdc.RegisterConcrete(&MsgBurnStable{}, "stablecoin/BurnStable", nil)
+	cdc.RegisterConcrete(&MsgCancelRedemption{}, "stablecoin/CancelRedemption", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgMintStable{},
 		&MsgBurnStable{},
+		&MsgCancelRedemption{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
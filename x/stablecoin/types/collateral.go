@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+)
+
+// NewCollateral creates a new, enabled Collateral without ceiling.
+func NewCollateral(denom string, oraclePair asset.Pair, mintFee sdk.Dec) Collateral {
+	return Collateral{
+		Denom:      denom,
+		OraclePair: oraclePair,
+		Ceiling:    sdk.ZeroInt(),
+		MintFee:    mintFee,
+		Enabled:    true,
+	}
+}
+
+// DefaultCollaterals returns the default collateral registry, USDC only.
+func DefaultCollaterals(mintFee sdk.Dec) []Collateral {
+	return []Collateral{
+		NewCollateral(denoms.USDC, asset.Registry.Pair(denoms.USDC, denoms.NUSD), mintFee),
+	}
+}
+
+// Validate checks the fields of the collateral.
+func (c Collateral) Validate() error {
+	if err := sdk.ValidateDenom(c.Denom); err != nil {
+		return fmt.Errorf("invalid collateral denom: %w", err)
+	}
+	if err := c.OraclePair.Validate(); err != nil {
+		return fmt.Errorf("invalid oracle pair of collateral %s: %w", c.Denom, err)
+	}
+	if c.OraclePair.BaseDenom() != c.Denom {
+		return fmt.Errorf("oracle pair %s does not price collateral %s", c.OraclePair, c.Denom)
+	}
+	if c.Ceiling.IsNil() || c.Ceiling.IsNegative() {
+		return fmt.Errorf("ceiling of collateral %s is negative: %s", c.Denom, c.Ceiling)
+	}
+	if c.MintFee.IsNil() || c.MintFee.IsNegative() || c.MintFee.GT(sdk.OneDec()) {
+		return fmt.Errorf("mint fee of collateral %s must be between 0 and 1: %s", c.Denom, c.MintFee)
+	}
+	return nil
+}
+
+// IsAboveCeiling returns whether holding an amount of the collateral exceeds
+// its ceiling.
+func (c Collateral) IsAboveCeiling(amount sdkmath.Int) bool {
+	return c.Ceiling.IsPositive() && amount.GT(c.Ceiling)
+}
+
+// GetCollateral returns the collateral of a denom from the registry.
+func (p Params) GetCollateral(denom string) (Collateral, error) {
+	for _, collateral := range p.Collaterals {
+		if collateral.Denom == denom {
+			return collateral, nil
+		}
+	}
+	return Collateral{}, CollateralNotFound.Wrap(denom)
+}
+
+/*
+GetEnabledCollateral returns the enabled collateral of a denom, or the first
+enabled collateral of the registry if the denom is empty.
+*/
+func (p Params) GetEnabledCollateral(denom string) (Collateral, error) {
+	if denom == "" {
+		for _, collateral := range p.Collaterals {
+			if collateral.Enabled {
+				return collateral, nil
+			}
+		}
+		return Collateral{}, NoEnabledCollateral
+	}
+
+	collateral, err := p.GetCollateral(denom)
+	if err != nil {
+		return Collateral{}, err
+	}
+	if !collateral.Enabled {
+		return Collateral{}, CollateralDisabled.Wrap(denom)
+	}
+	return collateral, nil
+}
+
+func validateCollaterals(i interface{}) error {
+	collaterals, ok := i.([]Collateral)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, collateral := range collaterals {
+		if err := collateral.Validate(); err != nil {
+			return err
+		}
+		if seen[collateral.Denom] {
+			return fmt.Errorf("duplicate collateral: %s", collateral.Denom)
+		}
+		if collateral.Denom == denoms.NUSD || collateral.Denom == denoms.NIBI {
+			return fmt.Errorf("%s cannot be a collateral", collateral.Denom)
+		}
+		seen[collateral.Denom] = true
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/stablecoin/types"
+)
+
+func TestParams_ValidateCollaterals(t *testing.T) {
+	mintFee := sdk.MustNewDecFromStr("0.002")
+	usdt := types.NewCollateral(denoms.USDT, asset.Registry.Pair(denoms.USDT, denoms.NUSD), mintFee)
+
+	testCases := []struct {
+		name        string
+		collaterals func(usdt types.Collateral) []types.Collateral
+		expectErr   bool
+	}{
+		{
+			name: "second collateral",
+			collaterals: func(usdt types.Collateral) []types.Collateral {
+				return append(types.DefaultCollaterals(mintFee), usdt)
+			},
+		},
+		{
+			name: "empty registry",
+			collaterals: func(usdt types.Collateral) []types.Collateral {
+				return nil
+			},
+		},
+		{
+			name: "duplicate collateral",
+			collaterals: func(usdt types.Collateral) []types.Collateral {
+				return []types.Collateral{usdt, usdt}
+			},
+			expectErr: true,
+		},
+		{
+			name: "oracle pair of another denom",
+			collaterals: func(usdt types.Collateral) []types.Collateral {
+				usdt.OraclePair = asset.Registry.Pair(denoms.USDC, denoms.NUSD)
+				return []types.Collateral{usdt}
+			},
+			expectErr: true,
+		},
+		{
+			name: "negative ceiling",
+			collaterals: func(usdt types.Collateral) []types.Collateral {
+				usdt.Ceiling = sdk.NewInt(-1)
+				return []types.Collateral{usdt}
+			},
+			expectErr: true,
+		},
+		{
+			name: "mint fee above one",
+			collaterals: func(usdt types.Collateral) []types.Collateral {
+				usdt.MintFee = sdk.NewDec(2)
+				return []types.Collateral{usdt}
+			},
+			expectErr: true,
+		},
+		{
+			name: "stablecoin as collateral",
+			collaterals: func(usdt types.Collateral) []types.Collateral {
+				return []types.Collateral{
+					types.NewCollateral(denoms.NUSD, asset.NewPair(denoms.NUSD, denoms.USD), mintFee),
+				}
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.Collaterals = tc.collaterals(usdt)
+			err := params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParams_GetEnabledCollateral(t *testing.T) {
+	params := types.DefaultParams()
+	usdt := types.NewCollateral(
+		denoms.USDT, asset.Registry.Pair(denoms.USDT, denoms.NUSD), sdk.ZeroDec())
+	usdt.Enabled = false
+	params.Collaterals = append([]types.Collateral{usdt}, params.Collaterals...)
+
+	collateral, err := params.GetEnabledCollateral("")
+	require.NoError(t, err)
+	require.Equal(t, denoms.USDC, collateral.Denom)
+
+	_, err = params.GetEnabledCollateral(denoms.USDT)
+	require.ErrorIs(t, err, types.CollateralDisabled)
+
+	_, err = params.GetEnabledCollateral(denoms.ATOM)
+	require.ErrorIs(t, err, types.CollateralNotFound)
+
+	params.Collaterals = nil
+	_, err = params.GetEnabledCollateral("")
+	require.ErrorIs(t, err, types.NoEnabledCollateral)
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+Controller computes the collateral ratio of the next epoch from the TWAP of the
+collaterals in stablecoins. A price of the collaterals above the peg means that
+the stablecoin is below the peg, so the collateral ratio is raised.
+*/
+type Controller interface {
+	// NextCollRatio returns the next collateral ratio and the new state of the
+	// controller.
+	NextCollRatio(
+		collRatio sdk.Dec, stablePrice sdk.Dec, state ControllerState,
+	) (nextCollRatio sdk.Dec, nextState ControllerState)
+}
+
+// NewController returns the collateral ratio controller selected by the params.
+func (p *Params) NewController() Controller {
+	switch p.Controller {
+	case CollRatioController_PI:
+		return PIController{
+			Kp:           p.GetPiKpAsDec(),
+			Ki:           p.GetPiKiAsDec(),
+			MinCollRatio: p.GetMinCollRatioAsDec(),
+			MaxCollRatio: p.GetMaxCollRatioAsDec(),
+		}
+	default:
+		return StepController{
+			AdjustmentStep:  p.GetAdjustmentStepAsDec(),
+			PriceLowerBound: p.GetPriceLowerBoundAsDec(),
+			PriceUpperBound: p.GetPriceUpperBoundAsDec(),
+		}
+	}
+}
+
+// DefaultControllerState returns the state of a controller that never ran.
+func DefaultControllerState() ControllerState {
+	return ControllerState{
+		LastError: sdk.ZeroDec(),
+		Integral:  sdk.ZeroDec(),
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Step Controller
+// ---------------------------------------------------------------------------
+
+// StepController moves the collateral ratio by a fixed step when the price of
+// the collaterals is out of the price bounds.
+type StepController struct {
+	AdjustmentStep  sdk.Dec
+	PriceLowerBound sdk.Dec
+	PriceUpperBound sdk.Dec
+}
+
+var _ Controller = StepController{}
+
+func (c StepController) NextCollRatio(
+	collRatio sdk.Dec, stablePrice sdk.Dec, state ControllerState,
+) (nextCollRatio sdk.Dec, nextState ControllerState) {
+	nextState = state.next(stablePrice.Sub(sdk.OneDec()))
+
+	switch {
+	case stablePrice.LTE(c.PriceLowerBound):
+		return collRatio.Sub(c.AdjustmentStep), nextState
+	case stablePrice.GTE(c.PriceUpperBound):
+		return collRatio.Add(c.AdjustmentStep), nextState
+	default:
+		return collRatio, nextState
+	}
+}
+
+// ---------------------------------------------------------------------------
+// PI Controller
+// ---------------------------------------------------------------------------
+
+/*
+PIController is a proportional-integral controller on the deviation of the price
+of the collaterals from the peg, in its incremental form:
+
+	nextCollRatio = collRatio + Kp * (error - lastError) + Ki * error
+
+The collateral ratio is kept between MinCollRatio and MaxCollRatio.
+*/
+type PIController struct {
+	Kp           sdk.Dec
+	Ki           sdk.Dec
+	MinCollRatio sdk.Dec
+	MaxCollRatio sdk.Dec
+}
+
+var _ Controller = PIController{}
+
+func (c PIController) NextCollRatio(
+	collRatio sdk.Dec, stablePrice sdk.Dec, state ControllerState,
+) (nextCollRatio sdk.Dec, nextState ControllerState) {
+	priceError := stablePrice.Sub(sdk.OneDec())
+	lastError := state.LastError
+	if lastError.IsNil() {
+		lastError = sdk.ZeroDec()
+	}
+
+	adjustment := c.Kp.Mul(priceError.Sub(lastError)).Add(c.Ki.Mul(priceError))
+	nextCollRatio = collRatio.Add(adjustment)
+	if nextCollRatio.LT(c.MinCollRatio) {
+		nextCollRatio = c.MinCollRatio
+	} else if nextCollRatio.GT(c.MaxCollRatio) {
+		nextCollRatio = c.MaxCollRatio
+	}
+
+	return nextCollRatio, state.next(priceError)
+}
+
+// next returns the state after an evaluation with the given error.
+func (s ControllerState) next(priceError sdk.Dec) ControllerState {
+	integral := s.Integral
+	if integral.IsNil() {
+		integral = sdk.ZeroDec()
+	}
+	return ControllerState{
+		LastError: priceError,
+		Integral:  integral.Add(priceError),
+	}
+}
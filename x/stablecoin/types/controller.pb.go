@@ -0,0 +1,809 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nibiru/stablecoin/v1/controller.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ControllerState is the state carried by the collateral ratio controller from
+// one epoch to the next.
+type ControllerState struct {
+	// last_error is the deviation of the price of the collaterals from the peg
+	// at the last evaluation
+	LastError github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=last_error,json=lastError,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"last_error" yaml:"last_error"`
+	// integral is the sum of the errors of all the evaluations
+	Integral github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=integral,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"integral"`
+}
+
+func (m *ControllerState) Reset()         { *m = ControllerState{} }
+func (m *ControllerState) String() string { return proto.CompactTextString(m) }
+func (*ControllerState) ProtoMessage()    {}
+func (*ControllerState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930f04630283d79c, []int{0}
+}
+func (m *ControllerState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControllerState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ControllerState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ControllerState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControllerState.Merge(m, src)
+}
+func (m *ControllerState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControllerState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControllerState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControllerState proto.InternalMessageInfo
+
+// CollRatioDecision is the update of the collateral ratio at the end of an
+// epoch.
+type CollRatioDecision struct {
+	EpochNumber uint64              `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
+	BlockHeight int64               `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+	Controller  CollRatioController `protobuf:"varint,3,opt,name=controller,proto3,enum=nibiru.stablecoin.v1.CollRatioController" json:"controller,omitempty"`
+	// stable_price_twap is the TWAP of the collaterals in stablecoins
+	StablePriceTwap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=stable_price_twap,json=stablePriceTwap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"stable_price_twap" yaml:"stable_price_twap"`
+	PrevCollRatio   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=prev_coll_ratio,json=prevCollRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"prev_coll_ratio" yaml:"prev_coll_ratio"`
+	CollRatio       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=coll_ratio,json=collRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"coll_ratio" yaml:"coll_ratio"`
+	// state is the controller state after the decision
+	State ControllerState `protobuf:"bytes,7,opt,name=state,proto3" json:"state"`
+}
+
+func (m *CollRatioDecision) Reset()         { *m = CollRatioDecision{} }
+func (m *CollRatioDecision) String() string { return proto.CompactTextString(m) }
+func (*CollRatioDecision) ProtoMessage()    {}
+func (*CollRatioDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_930f04630283d79c, []int{1}
+}
+func (m *CollRatioDecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollRatioDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollRatioDecision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollRatioDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollRatioDecision.Merge(m, src)
+}
+func (m *CollRatioDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollRatioDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollRatioDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollRatioDecision proto.InternalMessageInfo
+
+func (m *CollRatioDecision) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *CollRatioDecision) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *CollRatioDecision) GetController() CollRatioController {
+	if m != nil {
+		return m.Controller
+	}
+	return CollRatioController_STEP
+}
+
+func (m *CollRatioDecision) GetState() ControllerState {
+	if m != nil {
+		return m.State
+	}
+	return ControllerState{}
+}
+
+func init() {
+	proto.RegisterType((*ControllerState)(nil), "nibiru.stablecoin.v1.ControllerState")
+	proto.RegisterType((*CollRatioDecision)(nil), "nibiru.stablecoin.v1.CollRatioDecision")
+}
+
+func init() {
+	proto.RegisterFile("nibiru/stablecoin/v1/controller.proto", fileDescriptor_930f04630283d79c)
+}
+
+var fileDescriptor_930f04630283d79c = []byte{
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x6e, 0x58, 0x37, 0xa8, 0x0b, 0x54, 0x0d, 0x13, 0x44, 0x3b, 0xa4, 0x25, 0xd2, 0x50, 0x39,
+	0x90, 0xb0, 0x71, 0xdb, 0x8d, 0x76, 0x48, 0xa3, 0x87, 0x09, 0x19, 0x4e, 0x5c, 0x22, 0xc7, 0x58,
+	0x89, 0x35, 0x27, 0xb6, 0x6c, 0xb7, 0x63, 0xff, 0x82, 0xbf, 0xc4, 0x6d, 0xc7, 0x1d, 0x11, 0x87,
+	0x0a, 0xb5, 0x07, 0xee, 0xfb, 0x05, 0xc8, 0xf6, 0x94, 0x06, 0x18, 0x87, 0x8a, 0x53, 0xfc, 0x9e,
+	0xdf, 0xf7, 0xbe, 0xcf, 0xf9, 0xde, 0x03, 0xfb, 0x15, 0xcd, 0xa8, 0x9c, 0x25, 0x4a, 0xa3, 0x8c,
+	0x11, 0xcc, 0x69, 0x95, 0xcc, 0x0f, 0x12, 0xcc, 0x2b, 0x2d, 0x39, 0x63, 0x44, 0xc6, 0x42, 0x72,
+	0xcd, 0xfd, 0x5d, 0x57, 0x16, 0xaf, 0xcb, 0xe2, 0xf9, 0xc1, 0xde, 0x6e, 0xce, 0x73, 0x6e, 0x0b,
+	0x12, 0x73, 0x72, 0xb5, 0x7b, 0x4f, 0x6f, 0x6d, 0x29, 0x90, 0x44, 0xa5, 0x72, 0x25, 0xd1, 0x57,
+	0x0f, 0xf4, 0x26, 0x35, 0xc7, 0x7b, 0x8d, 0x34, 0xf1, 0x33, 0x00, 0x18, 0x52, 0x3a, 0x25, 0x52,
+	0x72, 0x19, 0x78, 0x43, 0x6f, 0xd4, 0x19, 0x4f, 0x2e, 0x17, 0x83, 0xd6, 0xf7, 0xc5, 0xe0, 0x59,
+	0x4e, 0x75, 0x31, 0xcb, 0x62, 0xcc, 0xcb, 0x04, 0x73, 0x55, 0x72, 0x75, 0xf3, 0x79, 0xa1, 0x3e,
+	0x9d, 0x25, 0xfa, 0x42, 0x10, 0x15, 0x1f, 0x13, 0x7c, 0xbd, 0x18, 0xf4, 0x2f, 0x50, 0xc9, 0x8e,
+	0xa2, 0x75, 0xa7, 0x08, 0x76, 0x4c, 0xf0, 0xc6, 0x9c, 0xfd, 0x29, 0xb8, 0x47, 0x2b, 0x4d, 0x72,
+	0x89, 0x58, 0x70, 0xc7, 0x32, 0xc4, 0x9b, 0x31, 0xc0, 0x1a, 0x1f, 0xfd, 0x6c, 0x83, 0xfe, 0x84,
+	0x33, 0x06, 0x91, 0xa6, 0xfc, 0x98, 0x60, 0xaa, 0x28, 0xaf, 0xfc, 0x23, 0x70, 0x9f, 0x08, 0x8e,
+	0x8b, 0xb4, 0x9a, 0x95, 0x19, 0x71, 0xef, 0x68, 0x8f, 0x9f, 0x5c, 0x2f, 0x06, 0x8f, 0x9c, 0xb2,
+	0xe6, 0x6d, 0x04, 0xbb, 0x36, 0x3c, 0xb5, 0x91, 0xc1, 0x66, 0x8c, 0xe3, 0xb3, 0xb4, 0x20, 0x34,
+	0x2f, 0xb4, 0x55, 0xb8, 0xd5, 0xc4, 0x36, 0x6f, 0x23, 0xd8, 0xb5, 0xe1, 0x89, 0x8d, 0xfc, 0xb7,
+	0x00, 0xac, 0x4d, 0x0b, 0xb6, 0x86, 0xde, 0xe8, 0xe1, 0xe1, 0xf3, 0xf8, 0x36, 0xd7, 0xe2, 0x5a,
+	0xf4, 0xda, 0x01, 0xd8, 0x00, 0xfb, 0x73, 0xd0, 0x77, 0x80, 0x54, 0x48, 0x8a, 0x49, 0xaa, 0xcf,
+	0x91, 0x08, 0xda, 0xf6, 0x6f, 0x4d, 0x37, 0xf6, 0x23, 0x70, 0xca, 0xff, 0x6a, 0x18, 0xc1, 0x9e,
+	0xcb, 0xbd, 0x33, 0xa9, 0x0f, 0xe7, 0x48, 0xf8, 0x02, 0xf4, 0x84, 0x24, 0xf3, 0x14, 0x73, 0xc6,
+	0x52, 0x69, 0x04, 0x06, 0xdb, 0x96, 0xf5, 0x64, 0x63, 0xd6, 0xc7, 0x8e, 0xf5, 0x8f, 0x76, 0x11,
+	0x7c, 0x60, 0x32, 0xf5, 0xfb, 0xcd, 0xc8, 0x35, 0xc8, 0x76, 0xfe, 0x6f, 0xe4, 0x9a, 0x3c, 0x1d,
+	0x5c, 0x73, 0xbc, 0x06, 0xdb, 0xca, 0xcc, 0x77, 0x70, 0x77, 0xe8, 0x8d, 0xba, 0x87, 0xfb, 0xff,
+	0xf2, 0xe4, 0xb7, 0x65, 0x18, 0xb7, 0x8d, 0x0a, 0xe8, 0x90, 0xe3, 0xe9, 0xe5, 0x32, 0xf4, 0xae,
+	0x96, 0xa1, 0xf7, 0x63, 0x19, 0x7a, 0x5f, 0x56, 0x61, 0xeb, 0x6a, 0x15, 0xb6, 0xbe, 0xad, 0xc2,
+	0xd6, 0xc7, 0x97, 0x0d, 0x91, 0xa7, 0xb6, 0xef, 0xa4, 0x40, 0xb4, 0x4a, 0x6e, 0x36, 0xf0, 0x73,
+	0x73, 0x07, 0xad, 0xe4, 0x6c, 0xc7, 0x2e, 0xe0, 0xab, 0x5f, 0x03, 0x00, 0x62, 0x66, 0x8c, 0x4c,
+	0xf8, 0x03, 0x00, 0x00,
+}
+
+func (m *ControllerState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControllerState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControllerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Integral.Size()
+		i -= size
+		if _, err := m.Integral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LastError.Size()
+		i -= size
+		if _, err := m.LastError.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CollRatioDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollRatioDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollRatioDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.CollRatio.Size()
+		i -= size
+		if _, err := m.CollRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PrevCollRatio.Size()
+		i -= size
+		if _, err := m.PrevCollRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.StablePriceTwap.Size()
+		i -= size
+		if _, err := m.StablePriceTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Controller != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.Controller))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintController(dAtA []byte, offset int, v uint64) int {
+	offset -= sovController(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ControllerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LastError.Size()
+	n += 1 + l + sovController(uint64(l))
+	l = m.Integral.Size()
+	n += 1 + l + sovController(uint64(l))
+	return n
+}
+
+func (m *CollRatioDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovController(uint64(m.EpochNumber))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovController(uint64(m.BlockHeight))
+	}
+	if m.Controller != 0 {
+		n += 1 + sovController(uint64(m.Controller))
+	}
+	l = m.StablePriceTwap.Size()
+	n += 1 + l + sovController(uint64(l))
+	l = m.PrevCollRatio.Size()
+	n += 1 + l + sovController(uint64(l))
+	l = m.CollRatio.Size()
+	n += 1 + l + sovController(uint64(l))
+	l = m.State.Size()
+	n += 1 + l + sovController(uint64(l))
+	return n
+}
+
+func sovController(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozController(x uint64) (n int) {
+	return sovController(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ControllerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControllerState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControllerState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Integral", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Integral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollRatioDecision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollRatioDecision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollRatioDecision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			m.Controller = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Controller |= CollRatioController(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StablePriceTwap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StablePriceTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevCollRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrevCollRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipController(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthController
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupController
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthController
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthController        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowController          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupController = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,139 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/stablecoin/types"
+)
+
+func TestStepController(t *testing.T) {
+	controller := types.StepController{
+		AdjustmentStep:  sdk.MustNewDecFromStr("0.0025"),
+		PriceLowerBound: sdk.MustNewDecFromStr("0.9999"),
+		PriceUpperBound: sdk.MustNewDecFromStr("1.0001"),
+	}
+	collRatio := sdk.MustNewDecFromStr("0.8")
+
+	testCases := []struct {
+		name              string
+		stablePrice       sdk.Dec
+		expectedCollRatio sdk.Dec
+	}{
+		{
+			name:              "collateral price above the upper bound",
+			stablePrice:       sdk.MustNewDecFromStr("1.1"),
+			expectedCollRatio: sdk.MustNewDecFromStr("0.8025"),
+		},
+		{
+			name:              "collateral price within the bounds",
+			stablePrice:       sdk.MustNewDecFromStr("1.00005"),
+			expectedCollRatio: collRatio,
+		},
+		{
+			name:              "collateral price below the lower bound",
+			stablePrice:       sdk.MustNewDecFromStr("0.9"),
+			expectedCollRatio: sdk.MustNewDecFromStr("0.7975"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			nextCollRatio, state := controller.NextCollRatio(
+				collRatio, tc.stablePrice, types.DefaultControllerState())
+			require.Equal(t, tc.expectedCollRatio, nextCollRatio)
+			require.Equal(t, tc.stablePrice.Sub(sdk.OneDec()), state.LastError)
+		})
+	}
+}
+
+func TestPIController(t *testing.T) {
+	controller := types.PIController{
+		Kp:           sdk.MustNewDecFromStr("0.5"),
+		Ki:           sdk.MustNewDecFromStr("0.1"),
+		MinCollRatio: sdk.MustNewDecFromStr("0.6"),
+		MaxCollRatio: sdk.MustNewDecFromStr("0.813"),
+	}
+
+	t.Log("first evaluation: proportional and integral terms")
+	collRatio, state := controller.NextCollRatio(
+		sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("1.02"), types.DefaultControllerState())
+	// 0.8 + 0.5 * 0.02 + 0.1 * 0.02
+	require.Equal(t, sdk.MustNewDecFromStr("0.812"), collRatio)
+	require.Equal(t, sdk.MustNewDecFromStr("0.02"), state.LastError)
+	require.Equal(t, sdk.MustNewDecFromStr("0.02"), state.Integral)
+
+	t.Log("constant error: integral term only, capped by the max collateral ratio")
+	collRatio, state = controller.NextCollRatio(collRatio, sdk.MustNewDecFromStr("1.02"), state)
+	// 0.812 + 0.1 * 0.02 = 0.814 > 0.813
+	require.Equal(t, sdk.MustNewDecFromStr("0.813"), collRatio)
+	require.Equal(t, sdk.MustNewDecFromStr("0.04"), state.Integral)
+
+	t.Log("price crash: floored by the min collateral ratio")
+	collRatio, state = controller.NextCollRatio(collRatio, sdk.MustNewDecFromStr("0.5"), state)
+	require.Equal(t, sdk.MustNewDecFromStr("0.6"), collRatio)
+	require.Equal(t, sdk.MustNewDecFromStr("-0.5"), state.LastError)
+	require.Equal(t, sdk.MustNewDecFromStr("-0.46"), state.Integral)
+}
+
+func TestParams_ValidateController(t *testing.T) {
+	testCases := []struct {
+		name      string
+		modify    func(params *types.Params)
+		expectErr bool
+	}{
+		{
+			name: "PI controller",
+			modify: func(params *types.Params) {
+				params.Controller = types.CollRatioController_PI
+				params.MinCollRatio = 500_000
+			},
+		},
+		{
+			name: "unknown controller",
+			modify: func(params *types.Params) {
+				params.Controller = 42
+			},
+			expectErr: true,
+		},
+		{
+			name: "negative gain",
+			modify: func(params *types.Params) {
+				params.PiKi = -1
+			},
+			expectErr: true,
+		},
+		{
+			name: "max collateral ratio above one",
+			modify: func(params *types.Params) {
+				params.MaxCollRatio = 1_000_001
+			},
+			expectErr: true,
+		},
+		{
+			name: "min collateral ratio above max",
+			modify: func(params *types.Params) {
+				params.MinCollRatio = 700_000
+				params.MaxCollRatio = 600_000
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modify(&params)
+			err := params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -8,7 +8,14 @@ import (
 
 // x/stablecoin module sentinel errors
 var (
-	NoCoinFound            = sdkerrors.Register(ModuleName, 1, "No coin found")
-	NotEnoughBalance       = sdkerrors.Register(ModuleName, 2, "Not enough balance")
-	NoValidCollateralRatio = sdkerrors.Register(ModuleName, 3, "No valid collateral ratio, waiting for new prices")
+	NoCoinFound              = sdkerrors.Register(ModuleName, 1, "No coin found")
+	NotEnoughBalance         = sdkerrors.Register(ModuleName, 2, "Not enough balance")
+	NoValidCollateralRatio   = sdkerrors.Register(ModuleName, 3, "No valid collateral ratio, waiting for new prices")
+	CollateralNotFound       = sdkerrors.Register(ModuleName, 4, "Collateral not found")
+	CollateralDisabled       = sdkerrors.Register(ModuleName, 5, "Collateral is disabled")
+	CollateralCeilingReached = sdkerrors.Register(ModuleName, 6, "Collateral ceiling reached")
+	NoEnabledCollateral      = sdkerrors.Register(ModuleName, 7, "No enabled collateral")
+	MintCapReached           = sdkerrors.Register(ModuleName, 8, "Mint cap of the epoch reached")
+	RedemptionNotFound       = sdkerrors.Register(ModuleName, 9, "Redemption not found")
+	RedemptionAlreadyQueued  = sdkerrors.Register(ModuleName, 10, "Account already has a queued redemption")
 )
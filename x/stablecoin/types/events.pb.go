@@ -355,6 +355,187 @@ func (m *EventBuyback) GetOutCoin() types.Coin {
 	return types.Coin{}
 }
 
+// EventCollRatioDecision is emitted when the collateral ratio is updated at the
+// end of an epoch.
+type EventCollRatioDecision struct {
+	Decision CollRatioDecision `protobuf:"bytes,1,opt,name=decision,proto3" json:"decision"`
+}
+
+func (m *EventCollRatioDecision) Reset()         { *m = EventCollRatioDecision{} }
+func (m *EventCollRatioDecision) String() string { return proto.CompactTextString(m) }
+func (*EventCollRatioDecision) ProtoMessage()    {}
+func (*EventCollRatioDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d156cd0ca9fb9a, []int{7}
+}
+func (m *EventCollRatioDecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCollRatioDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCollRatioDecision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCollRatioDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCollRatioDecision.Merge(m, src)
+}
+func (m *EventCollRatioDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCollRatioDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCollRatioDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCollRatioDecision proto.InternalMessageInfo
+
+func (m *EventCollRatioDecision) GetDecision() CollRatioDecision {
+	if m != nil {
+		return m.Decision
+	}
+	return CollRatioDecision{}
+}
+
+// EventRedemptionQueued is emitted when a burn above the burn caps is added to
+// the redemption queue.
+type EventRedemptionQueued struct {
+	Redemption Redemption `protobuf:"bytes,1,opt,name=redemption,proto3" json:"redemption"`
+}
+
+func (m *EventRedemptionQueued) Reset()         { *m = EventRedemptionQueued{} }
+func (m *EventRedemptionQueued) String() string { return proto.CompactTextString(m) }
+func (*EventRedemptionQueued) ProtoMessage()    {}
+func (*EventRedemptionQueued) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d156cd0ca9fb9a, []int{8}
+}
+func (m *EventRedemptionQueued) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRedemptionQueued) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRedemptionQueued.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRedemptionQueued) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRedemptionQueued.Merge(m, src)
+}
+func (m *EventRedemptionQueued) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRedemptionQueued) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRedemptionQueued.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRedemptionQueued proto.InternalMessageInfo
+
+func (m *EventRedemptionQueued) GetRedemption() Redemption {
+	if m != nil {
+		return m.Redemption
+	}
+	return Redemption{}
+}
+
+// EventRedemptionProcessed is emitted when a queued redemption is, fully or
+// partially, processed at the end of an epoch.
+type EventRedemptionProcessed struct {
+	RedemptionId uint64 `protobuf:"varint,1,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	Owner        string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// stable is the amount of stables redeemed
+	Stable     types.Coin `protobuf:"bytes,3,opt,name=stable,proto3" json:"stable"`
+	Collateral types.Coin `protobuf:"bytes,4,opt,name=collateral,proto3" json:"collateral"`
+	Gov        types.Coin `protobuf:"bytes,5,opt,name=gov,proto3" json:"gov"`
+	// refunded is whether the stables were given back to the owner because the
+	// redemption was cancelled or failed, or its collateral is no longer enabled
+	Refunded bool `protobuf:"varint,6,opt,name=refunded,proto3" json:"refunded,omitempty"`
+}
+
+func (m *EventRedemptionProcessed) Reset()         { *m = EventRedemptionProcessed{} }
+func (m *EventRedemptionProcessed) String() string { return proto.CompactTextString(m) }
+func (*EventRedemptionProcessed) ProtoMessage()    {}
+func (*EventRedemptionProcessed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10d156cd0ca9fb9a, []int{9}
+}
+func (m *EventRedemptionProcessed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRedemptionProcessed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRedemptionProcessed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRedemptionProcessed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRedemptionProcessed.Merge(m, src)
+}
+func (m *EventRedemptionProcessed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRedemptionProcessed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRedemptionProcessed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRedemptionProcessed proto.InternalMessageInfo
+
+func (m *EventRedemptionProcessed) GetRedemptionId() uint64 {
+	if m != nil {
+		return m.RedemptionId
+	}
+	return 0
+}
+
+func (m *EventRedemptionProcessed) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventRedemptionProcessed) GetStable() types.Coin {
+	if m != nil {
+		return m.Stable
+	}
+	return types.Coin{}
+}
+
+func (m *EventRedemptionProcessed) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
+}
+
+func (m *EventRedemptionProcessed) GetGov() types.Coin {
+	if m != nil {
+		return m.Gov
+	}
+	return types.Coin{}
+}
+
+func (m *EventRedemptionProcessed) GetRefunded() bool {
+	if m != nil {
+		return m.Refunded
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*EventTransfer)(nil), "nibiru.stablecoin.v1.EventTransfer")
 	proto.RegisterType((*EventMintStable)(nil), "nibiru.stablecoin.v1.EventMintStable")
@@ -363,39 +544,53 @@ func init() {
 	proto.RegisterType((*EventBurnNIBI)(nil), "nibiru.stablecoin.v1.EventBurnNIBI")
 	proto.RegisterType((*EventRecollateralize)(nil), "nibiru.stablecoin.v1.EventRecollateralize")
 	proto.RegisterType((*EventBuyback)(nil), "nibiru.stablecoin.v1.EventBuyback")
+	proto.RegisterType((*EventCollRatioDecision)(nil), "nibiru.stablecoin.v1.EventCollRatioDecision")
+	proto.RegisterType((*EventRedemptionQueued)(nil), "nibiru.stablecoin.v1.EventRedemptionQueued")
+	proto.RegisterType((*EventRedemptionProcessed)(nil), "nibiru.stablecoin.v1.EventRedemptionProcessed")
 }
 
 func init() { proto.RegisterFile("nibiru/stablecoin/v1/events.proto", fileDescriptor_10d156cd0ca9fb9a) }
 
 var fileDescriptor_10d156cd0ca9fb9a = []byte{
-	// 434 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0x3f, 0x6f, 0xd4, 0x30,
-	0x18, 0xc6, 0xe3, 0x34, 0x4a, 0x39, 0xf3, 0x4f, 0xb2, 0x4e, 0x28, 0x74, 0x48, 0xcb, 0x0d, 0xa8,
-	0x0b, 0x36, 0xa1, 0x0b, 0x62, 0x4c, 0x01, 0xe9, 0x90, 0xda, 0x21, 0x20, 0x21, 0x58, 0x2a, 0x27,
-	0xb8, 0x77, 0x56, 0x13, 0xbf, 0x95, 0xed, 0x44, 0x94, 0x4f, 0xc1, 0xc7, 0xea, 0xd8, 0x11, 0x31,
-	0x9c, 0xd0, 0xdd, 0xc8, 0xc8, 0x17, 0x40, 0x76, 0x22, 0xb8, 0xf1, 0x90, 0x6e, 0x62, 0x8a, 0x1d,
-	0x3f, 0xcf, 0xef, 0x7d, 0xec, 0x57, 0x7a, 0xf1, 0x23, 0x25, 0x4b, 0xa9, 0x5b, 0x66, 0x2c, 0x2f,
-	0x6b, 0x51, 0x81, 0x54, 0xac, 0xcb, 0x98, 0xe8, 0x84, 0xb2, 0x86, 0x5e, 0x6a, 0xb0, 0x40, 0xc6,
-	0xbd, 0x84, 0xfe, 0x95, 0xd0, 0x2e, 0xdb, 0x1b, 0xcf, 0x60, 0x06, 0x5e, 0xc0, 0xdc, 0xaa, 0xd7,
-	0xee, 0xa5, 0x15, 0x98, 0x06, 0x0c, 0x2b, 0xb9, 0x11, 0xac, 0xcb, 0x4a, 0x61, 0x79, 0xc6, 0xbc,
-	0xc5, 0x9f, 0x4f, 0xe6, 0xf8, 0xee, 0x2b, 0xc7, 0x7e, 0xa7, 0xb9, 0x32, 0xe7, 0x42, 0x93, 0x23,
-	0x1c, 0xb9, 0xe3, 0x04, 0x1d, 0xa0, 0xc3, 0xdb, 0xcf, 0x1e, 0xd2, 0xde, 0x4f, 0x9d, 0x9f, 0x0e,
-	0x7e, 0x7a, 0x0c, 0x52, 0xe5, 0xd1, 0xf5, 0x62, 0x3f, 0x28, 0xbc, 0x98, 0x10, 0x1c, 0x9d, 0x6b,
-	0x68, 0x92, 0xf0, 0x00, 0x1d, 0x8e, 0x0a, 0xbf, 0x26, 0xf7, 0x70, 0x68, 0x21, 0xd9, 0xf1, 0x7f,
-	0x42, 0x0b, 0x93, 0x0f, 0xf8, 0xbe, 0xaf, 0x74, 0x22, 0x95, 0x7d, 0xeb, 0x93, 0x93, 0xd7, 0x38,
-	0xe6, 0x0d, 0xb4, 0xca, 0xfa, 0x6a, 0xa3, 0x9c, 0x3a, 0xe4, 0xf7, 0xc5, 0xfe, 0xe3, 0x99, 0xb4,
-	0xf3, 0xb6, 0xa4, 0x15, 0x34, 0x6c, 0xc8, 0xdf, 0x7f, 0x9e, 0x98, 0x4f, 0x17, 0xcc, 0x5e, 0x5d,
-	0x0a, 0x43, 0xa7, 0xca, 0x16, 0x83, 0xfb, 0x0f, 0x3a, 0x6f, 0xb5, 0xda, 0x32, 0xfa, 0xfd, 0xf0,
-	0x3e, 0x2e, 0xf5, 0xe9, 0x34, 0x9f, 0x6e, 0x1d, 0xec, 0x32, 0x6f, 0x15, 0xfc, 0x0b, 0xe1, 0xb1,
-	0x27, 0x17, 0xa2, 0x82, 0xba, 0xe6, 0x56, 0x68, 0x5e, 0xcb, 0x2f, 0x82, 0x3c, 0xc0, 0x71, 0xc5,
-	0xeb, 0x5a, 0xe8, 0xbe, 0x40, 0x31, 0xec, 0xc8, 0x73, 0xbc, 0x2b, 0xd5, 0x99, 0x6f, 0x7a, 0xb8,
-	0x59, 0xd3, 0x63, 0xa9, 0xdc, 0x8e, 0xbc, 0xc0, 0xb7, 0xa0, 0xb5, 0xbd, 0x75, 0x67, 0x33, 0xeb,
-	0x2e, 0xb4, 0xd6, 0x7b, 0x4f, 0x30, 0x76, 0xf1, 0xce, 0x34, 0xb7, 0x12, 0x92, 0xe8, 0x9f, 0xaf,
-	0xfc, 0x52, 0x54, 0xc5, 0xc8, 0x11, 0x0a, 0x07, 0x98, 0xfc, 0x44, 0xf8, 0xce, 0xf0, 0x9e, 0x57,
-	0x25, 0xaf, 0x2e, 0xfe, 0xeb, 0xdb, 0xe6, 0x6f, 0xae, 0x97, 0x29, 0xba, 0x59, 0xa6, 0xe8, 0xc7,
-	0x32, 0x45, 0x5f, 0x57, 0x69, 0x70, 0xb3, 0x4a, 0x83, 0x6f, 0xab, 0x34, 0xf8, 0xf8, 0x74, 0x0d,
-	0x76, 0xea, 0xc7, 0xc4, 0xf1, 0x9c, 0x4b, 0xc5, 0x86, 0xa9, 0xf2, 0x79, 0x7d, 0xae, 0x78, 0x74,
-	0x19, 0xfb, 0x41, 0x70, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x6f, 0x32, 0xc4, 0x79, 0x04,
+	// 610 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x54, 0xcf, 0x6e, 0x13, 0x3d,
+	0x10, 0xcf, 0xa6, 0x69, 0x9a, 0xce, 0xd7, 0x7e, 0x48, 0x56, 0xa8, 0x96, 0x1e, 0xb6, 0x21, 0x08,
+	0xe8, 0x05, 0x2f, 0xa1, 0x07, 0x10, 0x17, 0xa4, 0xb4, 0x54, 0x0a, 0x52, 0x2b, 0x58, 0x90, 0x10,
+	0x5c, 0x22, 0xef, 0xae, 0x9b, 0x5a, 0xdd, 0x78, 0x2a, 0xaf, 0x37, 0x50, 0x9e, 0x82, 0x27, 0xe0,
+	0x79, 0x7a, 0xec, 0x11, 0x71, 0xa8, 0x50, 0x7b, 0xe4, 0xc8, 0x0b, 0x20, 0x7b, 0x4d, 0x12, 0x41,
+	0x85, 0x82, 0x94, 0x13, 0xa7, 0xd8, 0x93, 0xdf, 0x3f, 0xdb, 0xb3, 0x03, 0x37, 0xa5, 0x88, 0x85,
+	0x2a, 0xc2, 0x5c, 0xb3, 0x38, 0xe3, 0x09, 0x0a, 0x19, 0x8e, 0x3a, 0x21, 0x1f, 0x71, 0xa9, 0x73,
+	0x7a, 0xac, 0x50, 0x23, 0x69, 0x96, 0x10, 0x3a, 0x81, 0xd0, 0x51, 0x67, 0xbd, 0x39, 0xc0, 0x01,
+	0x5a, 0x40, 0x68, 0x56, 0x25, 0x76, 0x3d, 0x48, 0x30, 0x1f, 0x62, 0x1e, 0xc6, 0x2c, 0xe7, 0xe1,
+	0xa8, 0x13, 0x73, 0xcd, 0x3a, 0xa1, 0xa5, 0x94, 0xff, 0xdf, 0xbe, 0xd2, 0x2e, 0x41, 0xa9, 0x15,
+	0x66, 0x19, 0x57, 0x7f, 0x84, 0x29, 0xa6, 0x79, 0x3f, 0x13, 0x43, 0xa1, 0x4b, 0x58, 0xfb, 0x10,
+	0x56, 0x9f, 0x9a, 0xa4, 0xaf, 0x14, 0x93, 0xf9, 0x01, 0x57, 0x64, 0x0b, 0x6a, 0x06, 0xec, 0x7b,
+	0x2d, 0x6f, 0xf3, 0xbf, 0x07, 0x37, 0x68, 0x99, 0x86, 0x9a, 0x34, 0xd4, 0xa5, 0xa1, 0xdb, 0x28,
+	0x64, 0xb7, 0x76, 0x7a, 0xbe, 0x51, 0x89, 0x2c, 0x98, 0x10, 0xa8, 0x1d, 0x28, 0x1c, 0xfa, 0xd5,
+	0x96, 0xb7, 0xb9, 0x1c, 0xd9, 0x35, 0xf9, 0x1f, 0xaa, 0x1a, 0xfd, 0x05, 0x5b, 0xa9, 0x6a, 0x6c,
+	0xbf, 0x81, 0x6b, 0xd6, 0x69, 0x4f, 0x48, 0xfd, 0xd2, 0x86, 0x22, 0xbb, 0x50, 0x67, 0x43, 0x2c,
+	0xa4, 0xb6, 0x6e, 0xcb, 0x5d, 0x6a, 0x24, 0xbf, 0x9c, 0x6f, 0xdc, 0x19, 0x08, 0x7d, 0x58, 0xc4,
+	0x34, 0xc1, 0x61, 0xe8, 0x6e, 0xa3, 0xfc, 0xb9, 0x97, 0xa7, 0x47, 0xa1, 0x3e, 0x39, 0xe6, 0x39,
+	0xed, 0x49, 0x1d, 0x39, 0xf6, 0x58, 0xba, 0x5b, 0x28, 0x39, 0x67, 0xe9, 0xd7, 0xb0, 0x3a, 0x4e,
+	0xbd, 0xdf, 0xeb, 0xf6, 0xe6, 0x2e, 0x6c, 0x32, 0xcf, 0x55, 0xf8, 0xbb, 0x07, 0x4d, 0xab, 0x1c,
+	0xf1, 0x04, 0xb3, 0x8c, 0x69, 0xae, 0x58, 0x26, 0x3e, 0x70, 0xb2, 0x06, 0xf5, 0x84, 0x99, 0x0e,
+	0x29, 0x0d, 0x22, 0xb7, 0x23, 0x8f, 0x60, 0x49, 0xc8, 0xbe, 0x7d, 0xf4, 0xea, 0x6c, 0x8f, 0x5e,
+	0x17, 0xd2, 0xec, 0xc8, 0x63, 0x68, 0x60, 0xa1, 0x4b, 0xea, 0xc2, 0x6c, 0xd4, 0x25, 0x2c, 0xb4,
+	0xe5, 0xee, 0x01, 0x98, 0x78, 0x7d, 0xc5, 0xb4, 0x40, 0xbf, 0xf6, 0xd7, 0x47, 0xde, 0xe1, 0x49,
+	0xb4, 0x6c, 0x14, 0x22, 0x23, 0xd0, 0xfe, 0xe6, 0xc1, 0x8a, 0xbb, 0xcf, 0x93, 0x98, 0x25, 0x47,
+	0xff, 0xf6, 0x69, 0x13, 0x58, 0xb3, 0x87, 0xdd, 0xfe, 0x59, 0xd9, 0xe1, 0x89, 0xc8, 0x05, 0x4a,
+	0xd2, 0x83, 0x46, 0xea, 0xd6, 0xee, 0x13, 0xbe, 0x4b, 0xaf, 0x1a, 0x3e, 0xf4, 0x37, 0xaa, 0x8b,
+	0x3c, 0xa6, 0xb7, 0xfb, 0x70, 0xdd, 0xf5, 0x51, 0xca, 0x87, 0xc7, 0x5a, 0xa0, 0x7c, 0x51, 0xf0,
+	0x82, 0xa7, 0x64, 0x17, 0x40, 0x8d, 0x6b, 0xce, 0xa5, 0x75, 0xb5, 0xcb, 0x84, 0xeb, 0xe4, 0xa7,
+	0x98, 0xed, 0x4f, 0x55, 0xf0, 0x7f, 0x71, 0x78, 0xae, 0x30, 0xe1, 0x79, 0xce, 0x53, 0x72, 0x0b,
+	0x56, 0x27, 0xd0, 0xbe, 0x48, 0xad, 0x4f, 0x2d, 0x5a, 0x99, 0x14, 0x7b, 0x29, 0x69, 0xc2, 0x22,
+	0xbe, 0x93, 0x5c, 0xb9, 0xc1, 0x53, 0x6e, 0xc8, 0x43, 0xa8, 0x97, 0x29, 0x66, 0x7d, 0x26, 0x07,
+	0x27, 0x4f, 0x00, 0x26, 0x9f, 0x8c, 0x5f, 0x9b, 0x8d, 0x3c, 0x45, 0x21, 0x1d, 0x58, 0x18, 0xe0,
+	0xc8, 0x5f, 0x9c, 0x8d, 0x69, 0xb0, 0x64, 0x1d, 0x1a, 0x8a, 0x1f, 0x14, 0x32, 0xe5, 0xa9, 0x5f,
+	0x6f, 0x79, 0x9b, 0x8d, 0x68, 0xbc, 0xef, 0x3e, 0x3b, 0xbd, 0x08, 0xbc, 0xb3, 0x8b, 0xc0, 0xfb,
+	0x7a, 0x11, 0x78, 0x1f, 0x2f, 0x83, 0xca, 0xd9, 0x65, 0x50, 0xf9, 0x7c, 0x19, 0x54, 0xde, 0xde,
+	0x9f, 0xea, 0x99, 0x7d, 0x7b, 0xf1, 0xdb, 0x87, 0x4c, 0xc8, 0xd0, 0x0d, 0xfd, 0xf7, 0xd3, 0x63,
+	0xdf, 0x76, 0x50, 0x5c, 0xb7, 0xf3, 0x7e, 0xeb, 0xc7, 0x00, 0x9c, 0xe2, 0x43, 0x1a, 0xae, 0x06,
 	0x00, 0x00,
 }
 
@@ -698,6 +893,147 @@ func (m *EventBuyback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCollRatioDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCollRatioDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCollRatioDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Decision.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRedemptionQueued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRedemptionQueued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRedemptionQueued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Redemption.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRedemptionProcessed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRedemptionProcessed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRedemptionProcessed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Refunded {
+		i--
+		if m.Refunded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.Gov.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Stable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RedemptionId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RedemptionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -810,6 +1146,53 @@ func (m *EventBuyback) Size() (n int) {
 	return n
 }
 
+func (m *EventCollRatioDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Decision.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventRedemptionQueued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redemption.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventRedemptionProcessed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RedemptionId != 0 {
+		n += 1 + sovEvents(uint64(m.RedemptionId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Stable.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Collateral.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Gov.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Refunded {
+		n += 2
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1663,6 +2046,392 @@ func (m *EventBuyback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCollRatioDecision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCollRatioDecision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCollRatioDecision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Decision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRedemptionQueued) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRedemptionQueued: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRedemptionQueued: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Redemption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRedemptionProcessed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRedemptionProcessed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRedemptionProcessed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
+			}
+			m.RedemptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gov", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gov.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Refunded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
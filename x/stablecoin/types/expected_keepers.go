@@ -37,6 +37,7 @@ type BankKeeper interface {
 type OracleKeeper interface {
 	GetExchangeRate(ctx sdk.Context, pair asset.Pair) (sdk.Dec, error)
 	GetExchangeRateTwap(ctx sdk.Context, pair asset.Pair) (sdk.Dec, error)
+	GetExchangeRateEma(ctx sdk.Context, pair asset.Pair) (sdk.Dec, error)
 }
 
 type SpotKeeper interface {
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/x/common/denoms"
@@ -14,11 +16,58 @@ func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Params:               DefaultParams(),
 		ModuleAccountBalance: sdk.NewCoin(denoms.USDC, sdk.ZeroInt()),
+		ControllerState:      DefaultControllerState(),
+		EpochUsage:           NewEpochUsage(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenEpochs := make(map[uint64]bool)
+	for _, decision := range gs.CollRatioDecisions {
+		if seenEpochs[decision.EpochNumber] {
+			return fmt.Errorf("duplicate collateral ratio decision for epoch %d", decision.EpochNumber)
+		}
+		seenEpochs[decision.EpochNumber] = true
+	}
+
+	seenAccounts := make(map[string]bool)
+	for _, usage := range gs.AccountUsages {
+		if _, err := sdk.AccAddressFromBech32(usage.Address); err != nil {
+			return fmt.Errorf("invalid address of account usage: %w", err)
+		}
+		if seenAccounts[usage.Address] {
+			return fmt.Errorf("duplicate usage for account %s", usage.Address)
+		}
+		seenAccounts[usage.Address] = true
+	}
+
+	lastID := uint64(0)
+	seenOwners := make(map[string]bool)
+	for i, redemption := range gs.RedemptionQueue {
+		if i > 0 && redemption.Id <= lastID {
+			return fmt.Errorf("redemption queue is not sorted by id at redemption %d", redemption.Id)
+		}
+		if redemption.Id >= gs.NextRedemptionId {
+			return fmt.Errorf(
+				"redemption id %d is not below the next redemption id %d", redemption.Id, gs.NextRedemptionId)
+		}
+		if _, err := sdk.AccAddressFromBech32(redemption.Owner); err != nil {
+			return fmt.Errorf("invalid owner of redemption %d: %w", redemption.Id, err)
+		}
+		if seenOwners[redemption.Owner] {
+			return fmt.Errorf("duplicate queued redemption for account %s", redemption.Owner)
+		}
+		seenOwners[redemption.Owner] = true
+		if err := redemption.Stable.Validate(); err != nil {
+			return fmt.Errorf("invalid stables of redemption %d: %w", redemption.Id, err)
+		}
+		lastID = redemption.Id
+	}
+	return nil
 }
@@ -28,6 +28,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params               Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	ModuleAccountBalance types.Coin `protobuf:"bytes,2,opt,name=module_account_balance,json=moduleAccountBalance,proto3" json:"module_account_balance" yaml:"module_account_balance"`
+	// controller_state is the state of the collateral ratio controller
+	ControllerState ControllerState `protobuf:"bytes,3,opt,name=controller_state,json=controllerState,proto3" json:"controller_state" yaml:"controller_state"`
+	// coll_ratio_decisions are the past updates of the collateral ratio
+	CollRatioDecisions []CollRatioDecision `protobuf:"bytes,4,rep,name=coll_ratio_decisions,json=collRatioDecisions,proto3" json:"coll_ratio_decisions" yaml:"coll_ratio_decisions"`
+	// epoch_usage is the amount of stables minted and burned during the current
+	// epoch
+	EpochUsage EpochUsage `protobuf:"bytes,5,opt,name=epoch_usage,json=epochUsage,proto3" json:"epoch_usage" yaml:"epoch_usage"`
+	// account_usages are the usages of the accounts during the current epoch
+	AccountUsages []AccountEpochUsage `protobuf:"bytes,6,rep,name=account_usages,json=accountUsages,proto3" json:"account_usages" yaml:"account_usages"`
+	// redemption_queue are the redemptions waiting in the queue, in order
+	RedemptionQueue []Redemption `protobuf:"bytes,7,rep,name=redemption_queue,json=redemptionQueue,proto3" json:"redemption_queue" yaml:"redemption_queue"`
+	// next_redemption_id is the id of the next queued redemption
+	NextRedemptionId uint64 `protobuf:"varint,8,opt,name=next_redemption_id,json=nextRedemptionId,proto3" json:"next_redemption_id,omitempty" yaml:"next_redemption_id"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +90,48 @@ func (m *GenesisState) GetModuleAccountBalance() types.Coin {
 	return types.Coin{}
 }
 
+func (m *GenesisState) GetControllerState() ControllerState {
+	if m != nil {
+		return m.ControllerState
+	}
+	return ControllerState{}
+}
+
+func (m *GenesisState) GetCollRatioDecisions() []CollRatioDecision {
+	if m != nil {
+		return m.CollRatioDecisions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochUsage() EpochUsage {
+	if m != nil {
+		return m.EpochUsage
+	}
+	return EpochUsage{}
+}
+
+func (m *GenesisState) GetAccountUsages() []AccountEpochUsage {
+	if m != nil {
+		return m.AccountUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedemptionQueue() []Redemption {
+	if m != nil {
+		return m.RedemptionQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextRedemptionId() uint64 {
+	if m != nil {
+		return m.NextRedemptionId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nibiru.stablecoin.v1.GenesisState")
 }
@@ -86,26 +141,41 @@ func init() {
 }
 
 var fileDescriptor_0aa97d97dd3fb3f7 = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x50, 0xb1, 0x4e, 0xc3, 0x30,
-	0x14, 0x8c, 0x11, 0xea, 0x10, 0x98, 0xaa, 0x0a, 0x95, 0x0a, 0x5c, 0x88, 0x84, 0xc4, 0x64, 0x13,
-	0xd8, 0xba, 0x91, 0x0e, 0x48, 0x0c, 0x08, 0x95, 0x8d, 0xa5, 0xb2, 0x8d, 0x95, 0x5a, 0x4a, 0xfc,
-	0xaa, 0xd8, 0x89, 0xe8, 0x5f, 0xf0, 0x4d, 0x4c, 0x1d, 0x3b, 0x32, 0x55, 0x28, 0xf9, 0x03, 0xbe,
-	0x00, 0xc5, 0x8e, 0x04, 0x43, 0xb6, 0xa7, 0x7b, 0xf7, 0xee, 0xdd, 0x5d, 0x18, 0x69, 0xc5, 0x55,
-	0x51, 0x52, 0x63, 0x19, 0xcf, 0xa4, 0x00, 0xa5, 0x69, 0x15, 0xd3, 0x54, 0x6a, 0x69, 0x94, 0x21,
-	0xeb, 0x02, 0x2c, 0x0c, 0x47, 0x9e, 0x43, 0xfe, 0x38, 0xa4, 0x8a, 0x27, 0x58, 0x80, 0xc9, 0xc1,
-	0x50, 0xce, 0x8c, 0xa4, 0x55, 0xcc, 0xa5, 0x65, 0x31, 0x75, 0x4b, 0x77, 0x35, 0x19, 0xa5, 0x90,
-	0x82, 0x1b, 0x69, 0x3b, 0x75, 0xe8, 0x65, 0xef, 0xbf, 0x35, 0x2b, 0x58, 0xde, 0xbd, 0x8b, 0x3e,
-	0x51, 0x78, 0xfc, 0xe0, 0x0d, 0xbc, 0x58, 0x66, 0xe5, 0x70, 0x16, 0x0e, 0x3c, 0x61, 0x8c, 0x2e,
-	0xd0, 0xf5, 0xd1, 0xed, 0x19, 0xe9, 0x33, 0x44, 0x9e, 0x1d, 0x27, 0x39, 0xdc, 0xee, 0xa7, 0xc1,
-	0xa2, 0xbb, 0x18, 0x56, 0xe1, 0x49, 0x0e, 0x6f, 0x65, 0x26, 0x97, 0x4c, 0x08, 0x28, 0xb5, 0x5d,
-	0x72, 0x96, 0x31, 0x2d, 0xe4, 0xf8, 0xc0, 0x69, 0x9d, 0x12, 0x1f, 0x83, 0xb4, 0x31, 0x48, 0x17,
-	0x83, 0xcc, 0x41, 0xe9, 0xe4, 0xaa, 0x15, 0xfa, 0xd9, 0x4f, 0xcf, 0x37, 0x2c, 0xcf, 0x66, 0x51,
-	0xbf, 0x4c, 0xb4, 0x18, 0xf9, 0xc5, 0xbd, 0xc7, 0x13, 0x0f, 0x27, 0x8f, 0xdb, 0x1a, 0xa3, 0x5d,
-	0x8d, 0xd1, 0x77, 0x8d, 0xd1, 0x47, 0x83, 0x83, 0x5d, 0x83, 0x83, 0xaf, 0x06, 0x07, 0xaf, 0x37,
-	0xa9, 0xb2, 0xab, 0x92, 0x13, 0x01, 0x39, 0x7d, 0x72, 0x39, 0xe6, 0x2b, 0xa6, 0x34, 0xed, 0x8a,
-	0x79, 0xff, 0x5f, 0x8d, 0xdd, 0xac, 0xa5, 0xe1, 0x03, 0xd7, 0xcb, 0xdd, 0x6f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xf8, 0x3a, 0xd0, 0x91, 0xac, 0x01, 0x00, 0x00,
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0x1b, 0x5a, 0x0a, 0x4a, 0x81, 0x55, 0x56, 0x81, 0xac, 0xd0, 0xb4, 0x04, 0x55, 0xf4,
+	0x94, 0xd0, 0x71, 0xdb, 0x8d, 0x14, 0x84, 0x00, 0x09, 0x41, 0x10, 0x17, 0x24, 0x14, 0x39, 0xae,
+	0xd5, 0x5a, 0x72, 0xec, 0x2e, 0x76, 0xaa, 0xed, 0xc2, 0x33, 0xf0, 0x44, 0x9c, 0x77, 0xdc, 0x91,
+	0x53, 0x85, 0xda, 0x37, 0xd8, 0x13, 0x4c, 0xb1, 0xbd, 0xb6, 0xeb, 0xb2, 0xdd, 0xa2, 0xcf, 0xdf,
+	0xff, 0xfb, 0xe5, 0xfb, 0x5b, 0xb6, 0x3d, 0x46, 0x12, 0x92, 0xe5, 0x81, 0x90, 0x30, 0xa1, 0x18,
+	0x71, 0xc2, 0x82, 0xf9, 0x30, 0x98, 0x60, 0x86, 0x05, 0x11, 0xfe, 0x2c, 0xe3, 0x92, 0x83, 0x96,
+	0xf6, 0xf8, 0x1b, 0x8f, 0x3f, 0x1f, 0xb6, 0x5d, 0xc4, 0x45, 0xca, 0x45, 0x90, 0x40, 0x81, 0x83,
+	0xf9, 0x30, 0xc1, 0x12, 0x0e, 0x03, 0x75, 0xa8, 0xa6, 0xda, 0xad, 0x09, 0x9f, 0x70, 0xf5, 0x19,
+	0x14, 0x5f, 0x46, 0x7d, 0x51, 0xca, 0x9b, 0xc1, 0x0c, 0xa6, 0x06, 0xd7, 0xee, 0x97, 0x5a, 0x10,
+	0x67, 0x32, 0xe3, 0x94, 0xe2, 0xec, 0x56, 0x5b, 0x06, 0x25, 0x8e, 0x29, 0x49, 0x89, 0xd4, 0x36,
+	0xef, 0x6f, 0xdd, 0x7e, 0xf0, 0x41, 0xd7, 0xf9, 0x2e, 0xa1, 0xc4, 0xe0, 0xd0, 0xae, 0x6b, 0x9c,
+	0x63, 0xf5, 0xac, 0x41, 0xe3, 0xe0, 0xb9, 0x5f, 0x56, 0xcf, 0xff, 0xaa, 0x3c, 0x61, 0xed, 0x74,
+	0xd1, 0xad, 0x44, 0x66, 0x02, 0xcc, 0xed, 0x27, 0x29, 0x1f, 0xe7, 0x14, 0xc7, 0x10, 0x21, 0x9e,
+	0x33, 0x19, 0x27, 0x90, 0x42, 0x86, 0xb0, 0x73, 0x47, 0x65, 0xed, 0xfb, 0x7a, 0x29, 0x7e, 0xb1,
+	0x14, 0xdf, 0x2c, 0xc5, 0x1f, 0x71, 0xc2, 0xc2, 0x7e, 0x11, 0x74, 0xbe, 0xe8, 0x76, 0x4e, 0x60,
+	0x4a, 0x0f, 0xbd, 0xf2, 0x18, 0x2f, 0x6a, 0xe9, 0x83, 0xb7, 0x5a, 0x0f, 0xb5, 0x0c, 0x8e, 0xec,
+	0xe6, 0xa6, 0x7f, 0x2c, 0x8a, 0x1e, 0x4e, 0x55, 0x11, 0xfb, 0xe5, 0x7f, 0x3f, 0x5a, 0xbb, 0x55,
+	0xe9, 0xb0, 0x6b, 0xe8, 0x4f, 0x35, 0x7d, 0x37, 0xcc, 0x8b, 0xf6, 0xd0, 0xd5, 0x09, 0xf0, 0xdb,
+	0x6e, 0x21, 0x4e, 0x69, 0x9c, 0x41, 0x49, 0x78, 0x3c, 0xc6, 0x88, 0x08, 0xc2, 0x99, 0x70, 0x6a,
+	0xbd, 0xea, 0xa0, 0x71, 0xf0, 0xea, 0x26, 0x2c, 0xa5, 0x51, 0x31, 0xf0, 0xce, 0xf8, 0xc3, 0x97,
+	0x06, 0xfc, 0xec, 0x12, 0x7c, 0x3d, 0xd2, 0x8b, 0x00, 0xda, 0x9d, 0x13, 0xe0, 0x97, 0xdd, 0xc0,
+	0x33, 0x8e, 0xa6, 0x71, 0x2e, 0xe0, 0x04, 0x3b, 0x77, 0x55, 0xdb, 0x5e, 0x39, 0xf6, 0x7d, 0x61,
+	0xfc, 0x51, 0xf8, 0xc2, 0xb6, 0xe1, 0x01, 0xcd, 0xdb, 0x8a, 0xf0, 0x22, 0x1b, 0xaf, 0x7d, 0x20,
+	0xb5, 0x1f, 0x5d, 0xee, 0x5e, 0x9d, 0x0a, 0xa7, 0x7e, 0x5b, 0x31, 0x73, 0x1f, 0x5b, 0xa0, 0x8e,
+	0x01, 0x3d, 0xd6, 0xa0, 0xab, 0x61, 0x5e, 0xf4, 0xd0, 0x08, 0xca, 0x2c, 0x00, 0xb5, 0x9b, 0x19,
+	0x1e, 0xe3, 0x74, 0x26, 0x09, 0x67, 0xf1, 0x51, 0x8e, 0x73, 0xec, 0xdc, 0xeb, 0x55, 0x6f, 0xae,
+	0x14, 0xad, 0xdd, 0xbb, 0x77, 0xb7, 0x9b, 0xe3, 0x45, 0x7b, 0x1b, 0xe9, 0x5b, 0xa1, 0x80, 0xcf,
+	0x36, 0x60, 0xf8, 0x58, 0xc6, 0x5b, 0x56, 0x32, 0x76, 0xee, 0xf7, 0xac, 0x41, 0x2d, 0xec, 0x9c,
+	0x2f, 0xba, 0xfb, 0x3a, 0xe9, 0xba, 0xc7, 0x8b, 0x9a, 0x85, 0xb8, 0x81, 0x7f, 0x1c, 0x87, 0x9f,
+	0x4e, 0x97, 0xae, 0x75, 0xb6, 0x74, 0xad, 0xff, 0x4b, 0xd7, 0xfa, 0xb3, 0x72, 0x2b, 0x67, 0x2b,
+	0xb7, 0xf2, 0x6f, 0xe5, 0x56, 0x7e, 0xbe, 0x9e, 0x10, 0x39, 0xcd, 0x13, 0x1f, 0xf1, 0x34, 0xf8,
+	0xa2, 0x4a, 0x8c, 0xa6, 0x90, 0xb0, 0xc0, 0x3c, 0xcc, 0xe3, 0xed, 0xa7, 0x29, 0x4f, 0x66, 0x58,
+	0x24, 0x75, 0xf5, 0x26, 0xdf, 0x5c, 0x0c, 0x00, 0xae, 0x2f, 0x4f, 0xc8, 0x76, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -128,6 +198,73 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextRedemptionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextRedemptionId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RedemptionQueue) > 0 {
+		for iNdEx := len(m.RedemptionQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedemptionQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AccountUsages) > 0 {
+		for iNdEx := len(m.AccountUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.EpochUsage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.CollRatioDecisions) > 0 {
+		for iNdEx := len(m.CollRatioDecisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollRatioDecisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.ControllerState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.ModuleAccountBalance.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -172,6 +309,31 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.ModuleAccountBalance.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ControllerState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.CollRatioDecisions) > 0 {
+		for _, e := range m.CollRatioDecisions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.EpochUsage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AccountUsages) > 0 {
+		for _, e := range m.AccountUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RedemptionQueue) > 0 {
+		for _, e := range m.RedemptionQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextRedemptionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextRedemptionId))
+	}
 	return n
 }
 
@@ -276,6 +438,193 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ControllerState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollRatioDecisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollRatioDecisions = append(m.CollRatioDecisions, CollRatioDecision{})
+			if err := m.CollRatioDecisions[len(m.CollRatioDecisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountUsages = append(m.AccountUsages, AccountEpochUsage{})
+			if err := m.AccountUsages[len(m.AccountUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedemptionQueue = append(m.RedemptionQueue, Redemption{})
+			if err := m.RedemptionQueue[len(m.RedemptionQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRedemptionId", wireType)
+			}
+			m.NextRedemptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRedemptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
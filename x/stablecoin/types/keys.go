@@ -19,3 +19,25 @@ const (
 
 // Stable Ecosystem Fund
 const StableEFModuleAccount = "stable_ef"
+
+var (
+	// KeyControllerState is the key of the state of the collateral ratio controller.
+	KeyControllerState = []byte{0x01}
+	// KeyPrefixCollRatioDecision is the prefix of the collateral ratio decisions,
+	// indexed by epoch number.
+	KeyPrefixCollRatioDecision = []byte{0x02}
+	// KeyEpochUsage is the key of the amount of stables minted and burned during
+	// the current epoch.
+	KeyEpochUsage = []byte{0x03}
+	// KeyPrefixAccountEpochUsage is the prefix of the usages of the accounts
+	// during the current epoch, indexed by address.
+	KeyPrefixAccountEpochUsage = []byte{0x04}
+	// KeyPrefixRedemptionQueue is the prefix of the queued redemptions, indexed
+	// by id.
+	KeyPrefixRedemptionQueue = []byte{0x05}
+	// KeyNextRedemptionID is the key of the id of the next queued redemption.
+	KeyNextRedemptionID = []byte{0x06}
+	// KeyPrefixOwnerRedemption is the prefix of the ids of the queued
+	// redemptions, indexed by owner.
+	KeyPrefixOwnerRedemption = []byte{0x07}
+)
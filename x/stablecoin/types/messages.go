@@ -158,3 +158,45 @@ func (msg *MsgBuyback) ValidateBasic() error {
 	}
 	return nil
 }
+
+// ----------------------------------------------------------------
+// MsgCancelRedemption
+// ----------------------------------------------------------------
+
+var _ sdk.Msg = &MsgCancelRedemption{}
+
+func NewMsgCancelRedemption(creator string, redemptionId uint64) *MsgCancelRedemption {
+	return &MsgCancelRedemption{
+		Creator:      creator,
+		RedemptionId: redemptionId,
+	}
+}
+
+func (msg *MsgCancelRedemption) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelRedemption) Type() string {
+	return "cancel-redemption"
+}
+
+func (msg *MsgCancelRedemption) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelRedemption) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelRedemption) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(errors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -7,17 +7,47 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
 	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// Default gains of the PI controller, in micro units.
+const (
+	DefaultPiKp int64 = 500_000
+	DefaultPiKi int64 = 100_000
+)
+
+// Parameter keys added after the launch of the module, set by the store
+// migrations.
+var (
+	KeyCollaterals    = []byte("Collaterals")
+	KeyController     = []byte("Controller")
+	KeyPiKp           = []byte("PiKp")
+	KeyPiKi           = []byte("PiKi")
+	KeyMinCollRatio   = []byte("MinCollRatio")
+	KeyMaxCollRatio   = []byte("MaxCollRatio")
+	KeyMintCap        = []byte("MintCap")
+	KeyBurnCap        = []byte("BurnCap")
+	KeyAccountMintCap = []byte("AccountMintCap")
+	KeyAccountBurnCap = []byte("AccountBurnCap")
+	KeyPegOraclePair  = []byte("PegOraclePair")
+)
+
+// DefaultPegOraclePair is the oracle pair whose TWAP is the default peg signal
+// of the collateral ratio controller.
+var DefaultPegOraclePair = asset.Registry.Pair(denoms.USDC, denoms.NUSD)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-// NewParams creates a new Params instance
+// NewParams creates a new Params instance, backed by the default USDC collateral
+// whose mint fee is the fee ratio. The collateral ratio is updated by the step
+// controller.
 func NewParams(
 	collRatio sdk.Dec,
 	feeRatio sdk.Dec,
@@ -49,6 +79,17 @@ func NewParams(
 		PriceLowerBound:        priceLowerBoundInt,
 		PriceUpperBound:        priceUpperBoundInt,
 		IsCollateralRatioValid: isCollateralRatioValid,
+		Collaterals:            DefaultCollaterals(feeRatio),
+		Controller:             CollRatioController_STEP,
+		PiKp:                   DefaultPiKp,
+		PiKi:                   DefaultPiKi,
+		MinCollRatio:           0,
+		MaxCollRatio:           1 * common.TO_MICRO,
+		MintCap:                0,
+		BurnCap:                0,
+		AccountMintCap:         0,
+		AccountBurnCap:         0,
+		PegOraclePair:          DefaultPegOraclePair,
 	}
 }
 
@@ -117,6 +158,61 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.IsCollateralRatioValid,
 			validateIsCollateralRatioValid,
 		),
+		paramtypes.NewParamSetPair(
+			KeyCollaterals,
+			&p.Collaterals,
+			validateCollaterals,
+		),
+		paramtypes.NewParamSetPair(
+			KeyController,
+			&p.Controller,
+			validateController,
+		),
+		paramtypes.NewParamSetPair(
+			KeyPiKp,
+			&p.PiKp,
+			validatePiGain,
+		),
+		paramtypes.NewParamSetPair(
+			KeyPiKi,
+			&p.PiKi,
+			validatePiGain,
+		),
+		paramtypes.NewParamSetPair(
+			KeyMinCollRatio,
+			&p.MinCollRatio,
+			validateCollRatio,
+		),
+		paramtypes.NewParamSetPair(
+			KeyMaxCollRatio,
+			&p.MaxCollRatio,
+			validateCollRatio,
+		),
+		paramtypes.NewParamSetPair(
+			KeyMintCap,
+			&p.MintCap,
+			validateCap,
+		),
+		paramtypes.NewParamSetPair(
+			KeyBurnCap,
+			&p.BurnCap,
+			validateCap,
+		),
+		paramtypes.NewParamSetPair(
+			KeyAccountMintCap,
+			&p.AccountMintCap,
+			validateCap,
+		),
+		paramtypes.NewParamSetPair(
+			KeyAccountBurnCap,
+			&p.AccountBurnCap,
+			validateCap,
+		),
+		paramtypes.NewParamSetPair(
+			KeyPegOraclePair,
+			&p.PegOraclePair,
+			validatePegOraclePair,
+		),
 	}
 }
 
@@ -132,7 +228,43 @@ func (p *Params) Validate() error {
 		return err
 	}
 
-	return validateEfFeeRatio(p.EfFeeRatio)
+	err = validateEfFeeRatio(p.EfFeeRatio)
+	if err != nil {
+		return err
+	}
+
+	err = validateCollaterals(p.Collaterals)
+	if err != nil {
+		return err
+	}
+
+	err = validateController(p.Controller)
+	if err != nil {
+		return err
+	}
+
+	for _, gain := range []int64{p.PiKp, p.PiKi} {
+		if err = validatePiGain(gain); err != nil {
+			return err
+		}
+	}
+	for _, bound := range []int64{p.MinCollRatio, p.MaxCollRatio} {
+		if err = validateCollRatio(bound); err != nil {
+			return err
+		}
+	}
+	if p.MinCollRatio > p.MaxCollRatio {
+		return fmt.Errorf(
+			"min collateral ratio %d is above max collateral ratio %d", p.MinCollRatio, p.MaxCollRatio)
+	}
+
+	for _, limit := range []int64{p.MintCap, p.BurnCap, p.AccountMintCap, p.AccountBurnCap} {
+		if err = validateCap(limit); err != nil {
+			return err
+		}
+	}
+
+	return validatePegOraclePair(p.PegOraclePair)
 }
 
 func (p *Params) GetFeeRatioAsDec() sdk.Dec {
@@ -170,6 +302,22 @@ func (p *Params) GetPriceUpperBoundAsDec() sdk.Dec {
 		Quo(sdk.MustNewDecFromStr("1000000"))
 }
 
+func (p *Params) GetPiKpAsDec() sdk.Dec {
+	return sdk.NewDec(p.PiKp).QuoInt64(1 * common.TO_MICRO)
+}
+
+func (p *Params) GetPiKiAsDec() sdk.Dec {
+	return sdk.NewDec(p.PiKi).QuoInt64(1 * common.TO_MICRO)
+}
+
+func (p *Params) GetMinCollRatioAsDec() sdk.Dec {
+	return sdk.NewDec(p.MinCollRatio).QuoInt64(1 * common.TO_MICRO)
+}
+
+func (p *Params) GetMaxCollRatioAsDec() sdk.Dec {
+	return sdk.NewDec(p.MaxCollRatio).QuoInt64(1 * common.TO_MICRO)
+}
+
 func validateCollRatio(i interface{}) error {
 	collRatio, err := getAsInt64(i)
 	if err != nil {
@@ -291,6 +439,60 @@ func validatePriceUpperBound(i interface{}) error {
 	}
 }
 
+func validateController(i interface{}) error {
+	controller, ok := i.(CollRatioController)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, ok := CollRatioController_name[int32(controller)]; !ok {
+		return fmt.Errorf("unknown collateral ratio controller: %d", controller)
+	}
+	return nil
+}
+
+func validatePiGain(i interface{}) error {
+	gain, err := getAsInt64(i)
+	if err != nil {
+		return err
+	}
+
+	if gain < 0 {
+		return fmt.Errorf("PI gain is negative: %d", gain)
+	}
+	return nil
+}
+
+func validateCap(i interface{}) error {
+	limit, err := getAsInt64(i)
+	if err != nil {
+		return err
+	}
+
+	if limit < 0 {
+		return fmt.Errorf("mint or burn cap is negative: %d", limit)
+	}
+	return nil
+}
+
+func validatePegOraclePair(i interface{}) error {
+	pair, ok := i.(asset.Pair)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// without a peg oracle pair, there is no peg signal and the collateral
+	// ratio is not updated
+	if pair == "" {
+		return nil
+	}
+	if err := pair.Validate(); err != nil {
+		return fmt.Errorf("invalid peg oracle pair: %w", err)
+	}
+	if pair.QuoteDenom() != denoms.NUSD {
+		return fmt.Errorf("peg oracle pair %s does not price in %s", pair, denoms.NUSD)
+	}
+	return nil
+}
+
 func getString(i interface{}) (string, error) {
 	value, ok := i.(string)
 	if !ok {
@@ -4,7 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_NibiruChain_nibiru_x_common_asset "github.com/NibiruChain/nibiru/x/common/asset"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -23,6 +26,36 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CollRatioController is the controller updating the collateral ratio.
+// - `STEP`: moves the ratio by the adjustment step when the TWAP of the peg
+// oracle pair is out of the price bounds
+// - `PI`: proportional-integral controller on the deviation of the TWAP of the
+// peg oracle pair from the peg
+type CollRatioController int32
+
+const (
+	CollRatioController_STEP CollRatioController = 0
+	CollRatioController_PI   CollRatioController = 1
+)
+
+var CollRatioController_name = map[int32]string{
+	0: "STEP",
+	1: "PI",
+}
+
+var CollRatioController_value = map[string]int32{
+	"STEP": 0,
+	"PI":   1,
+}
+
+func (x CollRatioController) String() string {
+	return proto.EnumName(CollRatioController_name, int32(x))
+}
+
+func (CollRatioController) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d2b84d268bc3814, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	// collRatio is the ratio needed as collateral to exchange for stables
@@ -32,7 +65,7 @@ type Params struct {
 	// efFeeRatio is the ratio taken from the fees that goes to Ecosystem Fund
 	EfFeeRatio int64 `protobuf:"varint,3,opt,name=ef_fee_ratio,json=efFeeRatio,proto3" json:"ef_fee_ratio,omitempty"`
 	// BonusRateRecoll is the percentage of extra stablecoin value given to the
-	// caller of 'Recollateralize' in units of governance tokens.
+	//caller of 'Recollateralize' in units of governance tokens.
 	BonusRateRecoll int64 `protobuf:"varint,4,opt,name=bonus_rate_recoll,json=bonusRateRecoll,proto3" json:"bonus_rate_recoll,omitempty"`
 	// distr_epoch_identifier defines the frequnecy of update for the collateral
 	// ratio
@@ -48,6 +81,35 @@ type Params struct {
 	PriceUpperBound int64 `protobuf:"varint,8,opt,name=price_upper_bound,json=priceUpperBound,proto3" json:"price_upper_bound,omitempty"`
 	// isCollateralRatioValid checks if the collateral ratio is correctly updated
 	IsCollateralRatioValid bool `protobuf:"varint,9,opt,name=is_collateral_ratio_valid,json=isCollateralRatioValid,proto3" json:"is_collateral_ratio_valid,omitempty"`
+	// collaterals is the registry of the assets backing the stablecoin
+	Collaterals []Collateral `protobuf:"bytes,10,rep,name=collaterals,proto3" json:"collaterals"`
+	// controller is the controller updating the collateral ratio each epoch
+	Controller CollRatioController `protobuf:"varint,11,opt,name=controller,proto3,enum=nibiru.stablecoin.v1.CollRatioController" json:"controller,omitempty"`
+	// piKp is the proportional gain of the PI controller
+	PiKp int64 `protobuf:"varint,12,opt,name=pi_kp,json=piKp,proto3" json:"pi_kp,omitempty"`
+	// piKi is the integral gain of the PI controller
+	PiKi int64 `protobuf:"varint,13,opt,name=pi_ki,json=piKi,proto3" json:"pi_ki,omitempty"`
+	// minCollRatio is the floor of the collateral ratio set by the PI controller
+	MinCollRatio int64 `protobuf:"varint,14,opt,name=min_coll_ratio,json=minCollRatio,proto3" json:"min_coll_ratio,omitempty"`
+	// maxCollRatio is the ceiling of the collateral ratio set by the PI
+	// controller
+	MaxCollRatio int64 `protobuf:"varint,15,opt,name=max_coll_ratio,json=maxCollRatio,proto3" json:"max_coll_ratio,omitempty"`
+	// mintCap is the amount of stables that can be minted per epoch, no cap if
+	// zero
+	MintCap int64 `protobuf:"varint,16,opt,name=mint_cap,json=mintCap,proto3" json:"mint_cap,omitempty"`
+	// burnCap is the amount of stables that can be burned per epoch, no cap if
+	// zero. Burns above the cap are queued for the next epochs.
+	BurnCap int64 `protobuf:"varint,17,opt,name=burn_cap,json=burnCap,proto3" json:"burn_cap,omitempty"`
+	// accountMintCap is the amount of stables that an account can mint per
+	// epoch, no cap if zero
+	AccountMintCap int64 `protobuf:"varint,18,opt,name=account_mint_cap,json=accountMintCap,proto3" json:"account_mint_cap,omitempty"`
+	// accountBurnCap is the amount of stables that an account can burn per
+	// epoch, no cap if zero
+	AccountBurnCap int64 `protobuf:"varint,19,opt,name=account_burn_cap,json=accountBurnCap,proto3" json:"account_burn_cap,omitempty"`
+	// pegOraclePair is the oracle pair pricing an asset pegged to the dollar in
+	// stablecoins, whose TWAP is the peg signal of the collateral ratio
+	// controller
+	PegOraclePair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,20,opt,name=peg_oracle_pair,json=pegOraclePair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"peg_oracle_pair" yaml:"peg_oracle_pair"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -146,41 +208,237 @@ func (m *Params) GetIsCollateralRatioValid() bool {
 	return false
 }
 
+func (m *Params) GetCollaterals() []Collateral {
+	if m != nil {
+		return m.Collaterals
+	}
+	return nil
+}
+
+func (m *Params) GetController() CollRatioController {
+	if m != nil {
+		return m.Controller
+	}
+	return CollRatioController_STEP
+}
+
+func (m *Params) GetPiKp() int64 {
+	if m != nil {
+		return m.PiKp
+	}
+	return 0
+}
+
+func (m *Params) GetPiKi() int64 {
+	if m != nil {
+		return m.PiKi
+	}
+	return 0
+}
+
+func (m *Params) GetMinCollRatio() int64 {
+	if m != nil {
+		return m.MinCollRatio
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCollRatio() int64 {
+	if m != nil {
+		return m.MaxCollRatio
+	}
+	return 0
+}
+
+func (m *Params) GetMintCap() int64 {
+	if m != nil {
+		return m.MintCap
+	}
+	return 0
+}
+
+func (m *Params) GetBurnCap() int64 {
+	if m != nil {
+		return m.BurnCap
+	}
+	return 0
+}
+
+func (m *Params) GetAccountMintCap() int64 {
+	if m != nil {
+		return m.AccountMintCap
+	}
+	return 0
+}
+
+func (m *Params) GetAccountBurnCap() int64 {
+	if m != nil {
+		return m.AccountBurnCap
+	}
+	return 0
+}
+
+// Collateral is an asset that can back the stablecoin.
+type Collateral struct {
+	// denom is the denomination of the collateral
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// oracle_pair is the oracle pair pricing the collateral in stablecoins
+	OraclePair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=oracle_pair,json=oraclePair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"oracle_pair" yaml:"oracle_pair"`
+	// ceiling is the maximum amount of the collateral the module may hold, no
+	// ceiling if zero
+	Ceiling cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=ceiling,proto3,customtype=cosmossdk.io/math.Int" json:"ceiling"`
+	// mint_fee is the ratio of the collateral taken as fees when minting
+	// stables with it. Burns are charged the fee_ratio param.
+	MintFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=mint_fee,json=mintFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mint_fee" yaml:"mint_fee"`
+	// enabled is whether stables can be minted and burned with the collateral
+	Enabled bool `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *Collateral) Reset()         { *m = Collateral{} }
+func (m *Collateral) String() string { return proto.CompactTextString(m) }
+func (*Collateral) ProtoMessage()    {}
+func (*Collateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d2b84d268bc3814, []int{1}
+}
+func (m *Collateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Collateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Collateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Collateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Collateral.Merge(m, src)
+}
+func (m *Collateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *Collateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_Collateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Collateral proto.InternalMessageInfo
+
+func (m *Collateral) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Collateral) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("nibiru.stablecoin.v1.CollRatioController", CollRatioController_name, CollRatioController_value)
 	proto.RegisterType((*Params)(nil), "nibiru.stablecoin.v1.Params")
+	proto.RegisterType((*Collateral)(nil), "nibiru.stablecoin.v1.Collateral")
 }
 
 func init() { proto.RegisterFile("nibiru/stablecoin/v1/params.proto", fileDescriptor_2d2b84d268bc3814) }
 
 var fileDescriptor_2d2b84d268bc3814 = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x8a, 0xd3, 0x40,
-	0x18, 0x80, 0x1b, 0xab, 0xb5, 0x19, 0xc4, 0x62, 0x28, 0x25, 0x2a, 0x8d, 0x69, 0x2f, 0x16, 0x0f,
-	0x89, 0xc5, 0x93, 0x1e, 0x5b, 0x14, 0x14, 0x11, 0x89, 0xa8, 0xb0, 0x97, 0x61, 0x92, 0xfc, 0x69,
-	0x67, 0x49, 0x32, 0xc3, 0xcc, 0xa4, 0xbb, 0x7d, 0x8b, 0x7d, 0x8b, 0x7d, 0x95, 0x3d, 0xf6, 0xb8,
-	0xa7, 0x65, 0x69, 0xdf, 0x60, 0x9f, 0x60, 0x99, 0x49, 0xb7, 0xe9, 0x61, 0x6f, 0xe1, 0xfb, 0xbe,
-	0x84, 0x3f, 0x33, 0x3f, 0x1a, 0x95, 0x34, 0xa6, 0xa2, 0x0a, 0xa5, 0x22, 0x71, 0x0e, 0x09, 0xa3,
-	0x65, 0xb8, 0x9a, 0x86, 0x9c, 0x08, 0x52, 0xc8, 0x80, 0x0b, 0xa6, 0x98, 0xd3, 0xaf, 0x93, 0xa0,
-	0x49, 0x82, 0xd5, 0xf4, 0x4d, 0x7f, 0xc1, 0x16, 0xcc, 0x04, 0xa1, 0x7e, 0xaa, 0xdb, 0xf1, 0x65,
-	0x1b, 0x75, 0x7e, 0x9b, 0x97, 0x9d, 0x21, 0x42, 0x09, 0xcb, 0x73, 0x2c, 0x88, 0xa2, 0xcc, 0xb5,
-	0x7c, 0x6b, 0xd2, 0x8e, 0x6c, 0x4d, 0x22, 0x0d, 0x9c, 0xb7, 0xc8, 0xce, 0x00, 0xf6, 0xf6, 0x89,
-	0xb1, 0xdd, 0x0c, 0xa0, 0x96, 0x3e, 0x7a, 0x01, 0x19, 0x6e, 0x7c, 0xdb, 0x78, 0x04, 0xd9, 0xb7,
-	0x87, 0xe2, 0x03, 0x7a, 0x15, 0xb3, 0xb2, 0x92, 0x3a, 0x00, 0x2c, 0x40, 0x7f, 0xd8, 0x7d, 0x6a,
-	0xb2, 0x9e, 0x11, 0x11, 0x51, 0x10, 0x19, 0xec, 0xfc, 0x47, 0x83, 0x94, 0x4a, 0x25, 0x30, 0x70,
-	0x96, 0x2c, 0x31, 0x4d, 0xa1, 0x54, 0x34, 0xa3, 0x20, 0xdc, 0x67, 0xbe, 0x35, 0xb1, 0x67, 0xa3,
-	0xbb, 0x9b, 0x77, 0xc3, 0x35, 0x29, 0xf2, 0x2f, 0xe3, 0xc7, 0xbb, 0x71, 0xd4, 0x37, 0xe2, 0xab,
-	0xe6, 0xdf, 0x0f, 0xd8, 0x79, 0x8f, 0x7a, 0x24, 0x3d, 0xad, 0xa4, 0x2a, 0xa0, 0x54, 0x58, 0x2a,
-	0xe0, 0x6e, 0xc7, 0x8c, 0xf0, 0xb2, 0xc1, 0x7f, 0x14, 0x70, 0x3d, 0x2d, 0x17, 0x34, 0x01, 0x9c,
-	0xb3, 0x33, 0x10, 0x38, 0x66, 0x55, 0x99, 0xba, 0xcf, 0xeb, 0x69, 0x8d, 0xf8, 0xa9, 0xf9, 0x4c,
-	0xe3, 0xa6, 0xad, 0x38, 0x3f, 0xb4, 0xdd, 0xa3, 0xf6, 0xaf, 0xe6, 0x75, 0xfb, 0x19, 0xbd, 0xa6,
-	0x12, 0xeb, 0x9f, 0x24, 0x0a, 0x04, 0xd9, 0x1f, 0x36, 0x5e, 0x91, 0x9c, 0xa6, 0xae, 0xed, 0x5b,
-	0x93, 0x6e, 0x34, 0xa0, 0x72, 0x7e, 0xf0, 0xe6, 0xec, 0xfe, 0x69, 0x3b, 0xfb, 0x71, 0xb5, 0xf5,
-	0xac, 0xcd, 0xd6, 0xb3, 0x6e, 0xb7, 0x9e, 0x75, 0xb1, 0xf3, 0x5a, 0x9b, 0x9d, 0xd7, 0xba, 0xde,
-	0x79, 0xad, 0x93, 0x8f, 0x0b, 0xaa, 0x96, 0x55, 0x1c, 0x24, 0xac, 0x08, 0x7f, 0x99, 0xab, 0x9f,
-	0x2f, 0x09, 0x2d, 0xc3, 0xfd, 0xa6, 0x9c, 0x1f, 0xef, 0x8a, 0x5a, 0x73, 0x90, 0x71, 0xc7, 0x5c,
-	0xfe, 0xa7, 0xfb, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x67, 0x2b, 0x61, 0x4d, 0x02, 0x00, 0x00,
+	// 788 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4f, 0x6f, 0xe3, 0x44,
+	0x18, 0xc6, 0xe3, 0x36, 0x6d, 0x92, 0x69, 0xb7, 0xe9, 0x4e, 0x43, 0xe5, 0x05, 0x35, 0xc9, 0x46,
+	0x88, 0x0d, 0x2b, 0x61, 0xd3, 0xe5, 0x80, 0xd8, 0x1b, 0x29, 0xbb, 0xa2, 0xfc, 0xdb, 0xca, 0xe5,
+	0x8f, 0x84, 0x90, 0x46, 0x13, 0xfb, 0x4d, 0x3a, 0xd4, 0x9e, 0x19, 0xcd, 0x4c, 0x4a, 0x57, 0x7c,
+	0x09, 0x3e, 0x02, 0x5f, 0x83, 0x6f, 0xb0, 0x12, 0x97, 0x3d, 0x22, 0x0e, 0x11, 0x6a, 0x2f, 0x9c,
+	0xfb, 0x09, 0xd0, 0x8c, 0x1d, 0xdb, 0x8b, 0x8a, 0x38, 0xec, 0x29, 0x99, 0xe7, 0xf9, 0xbd, 0xaf,
+	0x1f, 0xcf, 0xbc, 0x1e, 0x74, 0x9f, 0xb3, 0x29, 0x53, 0x8b, 0x50, 0x1b, 0x3a, 0x4d, 0x21, 0x16,
+	0x8c, 0x87, 0x17, 0x87, 0xa1, 0xa4, 0x8a, 0x66, 0x3a, 0x90, 0x4a, 0x18, 0x81, 0x7b, 0x39, 0x12,
+	0x54, 0x48, 0x70, 0x71, 0xf8, 0x66, 0x6f, 0x2e, 0xe6, 0xc2, 0x01, 0xa1, 0xfd, 0x97, 0xb3, 0xa3,
+	0xdf, 0x5a, 0x68, 0xf3, 0xc4, 0x15, 0xe3, 0x03, 0x84, 0x62, 0x91, 0xa6, 0x44, 0x51, 0xc3, 0x84,
+	0xef, 0x0d, 0xbd, 0xf1, 0x7a, 0xd4, 0xb1, 0x4a, 0x64, 0x05, 0xfc, 0x16, 0xea, 0xcc, 0x00, 0x0a,
+	0x77, 0xcd, 0xb9, 0xed, 0x19, 0x40, 0x6e, 0x0e, 0xd1, 0x36, 0xcc, 0x48, 0xe5, 0xaf, 0x3b, 0x1f,
+	0xc1, 0xec, 0xe9, 0x8a, 0x78, 0x88, 0xee, 0x4e, 0x05, 0x5f, 0x68, 0x0b, 0x00, 0x51, 0x60, 0x1b,
+	0xfb, 0x4d, 0x87, 0x75, 0x9d, 0x11, 0x51, 0x03, 0x91, 0x93, 0xf1, 0x77, 0x68, 0x3f, 0x61, 0xda,
+	0x28, 0x02, 0x52, 0xc4, 0x67, 0x84, 0x25, 0xc0, 0x0d, 0x9b, 0x31, 0x50, 0xfe, 0xc6, 0xd0, 0x1b,
+	0x77, 0x26, 0xf7, 0x6f, 0x96, 0x83, 0x83, 0xe7, 0x34, 0x4b, 0x1f, 0x8f, 0x6e, 0xe7, 0x46, 0x51,
+	0xcf, 0x19, 0x4f, 0xac, 0x7e, 0x5c, 0xca, 0xf8, 0x01, 0xea, 0xd2, 0xe4, 0xc7, 0x85, 0x36, 0x19,
+	0x70, 0x43, 0xb4, 0x01, 0xe9, 0x6f, 0xba, 0x08, 0x3b, 0x95, 0x7c, 0x6a, 0x40, 0xda, 0xb4, 0x52,
+	0xb1, 0x18, 0x48, 0x2a, 0x7e, 0x02, 0x45, 0xa6, 0x62, 0xc1, 0x13, 0xbf, 0x95, 0xa7, 0x75, 0xc6,
+	0x17, 0x56, 0x9f, 0x58, 0xb9, 0x62, 0x17, 0x52, 0x96, 0x6c, 0xbb, 0xc6, 0x7e, 0x23, 0xe5, 0x8a,
+	0xfd, 0x08, 0xdd, 0x63, 0x9a, 0xd8, 0x97, 0xa4, 0x06, 0x14, 0x2d, 0x36, 0x9b, 0x5c, 0xd0, 0x94,
+	0x25, 0x7e, 0x67, 0xe8, 0x8d, 0xdb, 0xd1, 0x3e, 0xd3, 0x47, 0xa5, 0xef, 0xf6, 0xee, 0x5b, 0xeb,
+	0xe2, 0x4f, 0xd1, 0x56, 0x55, 0xa7, 0x7d, 0x34, 0x5c, 0x1f, 0x6f, 0x3d, 0x1a, 0x06, 0xb7, 0x9d,
+	0x75, 0x50, 0x35, 0x98, 0x34, 0x5f, 0x2c, 0x07, 0x8d, 0xa8, 0x5e, 0x8a, 0x8f, 0xed, 0x41, 0x73,
+	0xa3, 0x44, 0x9a, 0x82, 0xf2, 0xb7, 0x86, 0xde, 0x78, 0xe7, 0xd1, 0xbb, 0xff, 0xdd, 0xc8, 0x65,
+	0x38, 0x2a, 0x0b, 0xa2, 0x5a, 0x31, 0xde, 0x43, 0x1b, 0x92, 0x91, 0x73, 0xe9, 0x6f, 0xbb, 0xf7,
+	0x6d, 0x4a, 0xf6, 0xb9, 0x5c, 0x89, 0xcc, 0xbf, 0x53, 0x8a, 0x0c, 0xbf, 0x8d, 0x76, 0x32, 0xc6,
+	0x49, 0x6d, 0xc2, 0x76, 0x9c, 0xbb, 0x9d, 0x31, 0x5e, 0x3e, 0xc5, 0x51, 0xf4, 0xb2, 0x4e, 0x75,
+	0x0b, 0x8a, 0x5e, 0x56, 0xd4, 0x3d, 0xd4, 0xce, 0x18, 0x37, 0x24, 0xa6, 0xd2, 0xdf, 0x75, 0x7e,
+	0xcb, 0xae, 0x8f, 0xa8, 0xb4, 0xd6, 0x74, 0xa1, 0xb8, 0xb3, 0xee, 0xe6, 0x96, 0x5d, 0x5b, 0x6b,
+	0x8c, 0x76, 0x69, 0x1c, 0x8b, 0x05, 0x37, 0xa4, 0xac, 0xc6, 0xc5, 0xe9, 0xe7, 0xfa, 0x97, 0x45,
+	0x93, 0x1a, 0x59, 0x36, 0xdb, 0x7b, 0x85, 0x9c, 0x14, 0x3d, 0x7f, 0x46, 0x5d, 0x09, 0x73, 0x22,
+	0x14, 0x8d, 0x53, 0x20, 0x92, 0x32, 0xe5, 0xf7, 0xdc, 0x88, 0x9e, 0xda, 0x6d, 0xff, 0x73, 0x39,
+	0x38, 0x9c, 0x33, 0x73, 0xb6, 0x98, 0x06, 0xb1, 0xc8, 0xc2, 0xaf, 0xdc, 0x0e, 0x1f, 0x9d, 0x51,
+	0xc6, 0xc3, 0xe2, 0x2b, 0xbe, 0x0c, 0x63, 0x91, 0x65, 0x82, 0x87, 0x54, 0x6b, 0x30, 0xc1, 0x09,
+	0x65, 0xea, 0x66, 0x39, 0xd8, 0xcf, 0x67, 0xfb, 0x5f, 0x9d, 0x47, 0xd1, 0x1d, 0x09, 0xf3, 0x67,
+	0x4e, 0xb0, 0xe0, 0xe8, 0xf7, 0x35, 0x84, 0xaa, 0x93, 0xc6, 0x3d, 0xb4, 0x91, 0x00, 0x17, 0x99,
+	0xfb, 0x74, 0x3b, 0x51, 0xbe, 0xc0, 0x12, 0x6d, 0xd5, 0xd3, 0xad, 0xb9, 0x74, 0xcf, 0x5e, 0x27,
+	0x1d, 0xce, 0xd3, 0xbd, 0x92, 0x0c, 0x89, 0x32, 0x16, 0xfe, 0x10, 0xb5, 0x62, 0x60, 0x29, 0xe3,
+	0x73, 0x77, 0x0d, 0x74, 0x26, 0x07, 0xc5, 0xd3, 0xde, 0x88, 0x85, 0xce, 0x84, 0xd6, 0xc9, 0x79,
+	0xc0, 0x44, 0x98, 0x51, 0x73, 0x16, 0x1c, 0x73, 0x13, 0xad, 0x68, 0xfc, 0x43, 0x71, 0xac, 0x33,
+	0x00, 0x77, 0x33, 0x74, 0x26, 0x1f, 0x17, 0x95, 0xef, 0xd4, 0x72, 0xe6, 0x4d, 0x8a, 0x9f, 0xf7,
+	0x74, 0x72, 0x1e, 0x9a, 0xe7, 0x12, 0x74, 0xf0, 0x09, 0xc4, 0x37, 0xcb, 0x41, 0x37, 0x0f, 0xb7,
+	0xea, 0x33, 0xca, 0x27, 0xe3, 0x29, 0x00, 0xf6, 0x51, 0x0b, 0xb8, 0x9d, 0xed, 0xc4, 0xdd, 0x22,
+	0xed, 0x68, 0xb5, 0x7c, 0xdc, 0xfc, 0xfb, 0xd7, 0x81, 0xf7, 0xf0, 0x01, 0xda, 0xbb, 0x65, 0xda,
+	0x71, 0x1b, 0x35, 0x4f, 0xbf, 0x7e, 0x72, 0xb2, 0xdb, 0xc0, 0x9b, 0x68, 0xed, 0xe4, 0x78, 0xd7,
+	0x9b, 0x7c, 0xf6, 0xe2, 0xaa, 0xef, 0xbd, 0xbc, 0xea, 0x7b, 0x7f, 0x5d, 0xf5, 0xbd, 0x5f, 0xae,
+	0xfb, 0x8d, 0x97, 0xd7, 0xfd, 0xc6, 0x1f, 0xd7, 0xfd, 0xc6, 0xf7, 0xef, 0xff, 0xdf, 0x76, 0xd6,
+	0x2e, 0x6d, 0x17, 0x7a, 0xba, 0xe9, 0x6e, 0xe1, 0x0f, 0xfe, 0x19, 0x00, 0xfb, 0xe7, 0xac, 0x6f,
+	0xd6, 0x05, 0x00, 0x00,
 }
 
+func (this *Collateral) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Collateral)
+	if !ok {
+		that2, ok := that.(Collateral)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.OraclePair.Equal(that1.OraclePair) {
+		return false
+	}
+	if !this.Ceiling.Equal(that1.Ceiling) {
+		return false
+	}
+	if !this.MintFee.Equal(that1.MintFee) {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -201,6 +459,85 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.PegOraclePair.Size()
+		i -= size
+		if _, err := m.PegOraclePair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.AccountBurnCap != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AccountBurnCap))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.AccountMintCap != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AccountMintCap))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.BurnCap != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BurnCap))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MintCap != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MintCap))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxCollRatio != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCollRatio))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MinCollRatio != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinCollRatio))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.PiKi != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PiKi))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.PiKp != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PiKp))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Controller != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Controller))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Collaterals) > 0 {
+		for iNdEx := len(m.Collaterals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collaterals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.IsCollateralRatioValid {
 		i--
 		if m.IsCollateralRatioValid {
@@ -256,6 +593,76 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Collateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Collateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Collateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MintFee.Size()
+		i -= size
+		if _, err := m.MintFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Ceiling.Size()
+		i -= size
+		if _, err := m.Ceiling.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.OraclePair.Size()
+		i -= size
+		if _, err := m.OraclePair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -301,6 +708,63 @@ func (m *Params) Size() (n int) {
 	if m.IsCollateralRatioValid {
 		n += 2
 	}
+	if len(m.Collaterals) > 0 {
+		for _, e := range m.Collaterals {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.Controller != 0 {
+		n += 1 + sovParams(uint64(m.Controller))
+	}
+	if m.PiKp != 0 {
+		n += 1 + sovParams(uint64(m.PiKp))
+	}
+	if m.PiKi != 0 {
+		n += 1 + sovParams(uint64(m.PiKi))
+	}
+	if m.MinCollRatio != 0 {
+		n += 1 + sovParams(uint64(m.MinCollRatio))
+	}
+	if m.MaxCollRatio != 0 {
+		n += 1 + sovParams(uint64(m.MaxCollRatio))
+	}
+	if m.MintCap != 0 {
+		n += 2 + sovParams(uint64(m.MintCap))
+	}
+	if m.BurnCap != 0 {
+		n += 2 + sovParams(uint64(m.BurnCap))
+	}
+	if m.AccountMintCap != 0 {
+		n += 2 + sovParams(uint64(m.AccountMintCap))
+	}
+	if m.AccountBurnCap != 0 {
+		n += 2 + sovParams(uint64(m.AccountBurnCap))
+	}
+	l = m.PegOraclePair.Size()
+	n += 2 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *Collateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.OraclePair.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Ceiling.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MintFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.Enabled {
+		n += 2
+	}
 	return n
 }
 
@@ -524,6 +988,449 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsCollateralRatioValid = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collaterals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collaterals = append(m.Collaterals, Collateral{})
+			if err := m.Collaterals[len(m.Collaterals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			m.Controller = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Controller |= CollRatioController(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PiKp", wireType)
+			}
+			m.PiKp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PiKp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PiKi", wireType)
+			}
+			m.PiKi = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PiKi |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCollRatio", wireType)
+			}
+			m.MinCollRatio = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCollRatio |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCollRatio", wireType)
+			}
+			m.MaxCollRatio = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCollRatio |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintCap", wireType)
+			}
+			m.MintCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintCap |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnCap", wireType)
+			}
+			m.BurnCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BurnCap |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountMintCap", wireType)
+			}
+			m.AccountMintCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountMintCap |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountBurnCap", wireType)
+			}
+			m.AccountBurnCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountBurnCap |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PegOraclePair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PegOraclePair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Collateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Collateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Collateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OraclePair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ceiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ceiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -8,7 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -499,763 +499,3597 @@ func (m *QueryLiquidityRatioInfoResponse) GetInfo() LiquidityRatioInfo {
 	return LiquidityRatioInfo{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "nibiru.stablecoin.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "nibiru.stablecoin.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryModuleAccountBalances)(nil), "nibiru.stablecoin.v1.QueryModuleAccountBalances")
-	proto.RegisterType((*QueryModuleAccountBalancesResponse)(nil), "nibiru.stablecoin.v1.QueryModuleAccountBalancesResponse")
-	proto.RegisterType((*QueryCirculatingSupplies)(nil), "nibiru.stablecoin.v1.QueryCirculatingSupplies")
-	proto.RegisterType((*QueryCirculatingSuppliesResponse)(nil), "nibiru.stablecoin.v1.QueryCirculatingSuppliesResponse")
-	proto.RegisterType((*QueryGovToMintStable)(nil), "nibiru.stablecoin.v1.QueryGovToMintStable")
-	proto.RegisterType((*QueryGovToMintStableResponse)(nil), "nibiru.stablecoin.v1.QueryGovToMintStableResponse")
-	proto.RegisterType((*LiquidityRatioInfo)(nil), "nibiru.stablecoin.v1.LiquidityRatioInfo")
-	proto.RegisterType((*QueryLiquidityRatioInfoRequest)(nil), "nibiru.stablecoin.v1.QueryLiquidityRatioInfoRequest")
-	proto.RegisterType((*QueryLiquidityRatioInfoResponse)(nil), "nibiru.stablecoin.v1.QueryLiquidityRatioInfoResponse")
+// CollateralHolding is the amount of a collateral held by x/stablecoin.
+type CollateralHolding struct {
+	Collateral Collateral `protobuf:"bytes,1,opt,name=collateral,proto3" json:"collateral"`
+	Balance    types.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+	// value is the value of the balance in stablecoins
+	Value github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
 }
 
-func init() { proto.RegisterFile("nibiru/stablecoin/v1/query.proto", fileDescriptor_cd427158b4504e94) }
-
-var fileDescriptor_cd427158b4504e94 = []byte{
-	// 744 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x18, 0x8d, 0xfb, 0x27, 0x75, 0x7a, 0x75, 0xaf, 0x34, 0x4d, 0x75, 0x83, 0x15, 0x39, 0xc1, 0x42,
-	0x90, 0x82, 0xb0, 0x9b, 0x16, 0x10, 0xea, 0x06, 0x70, 0x91, 0x10, 0x88, 0x22, 0x9a, 0x22, 0x55,
-	0x62, 0x13, 0x8d, 0x9d, 0xa9, 0x3b, 0xc2, 0x99, 0x71, 0x3d, 0x76, 0x20, 0x5b, 0x58, 0xc2, 0x02,
-	0xa9, 0x6f, 0x81, 0x10, 0x0b, 0x16, 0x2c, 0x78, 0x82, 0x2e, 0x2b, 0xb1, 0x41, 0x2c, 0x0a, 0x6a,
-	0x79, 0x02, 0x9e, 0x00, 0xcd, 0xd8, 0x4e, 0x53, 0x3a, 0x89, 0x12, 0x56, 0x89, 0x66, 0xce, 0x39,
-	0xdf, 0x99, 0x33, 0xf3, 0x7d, 0x06, 0x55, 0x4a, 0x5c, 0x12, 0x25, 0x36, 0x8f, 0x91, 0x1b, 0x60,
-	0x8f, 0x11, 0x6a, 0x77, 0xea, 0xf6, 0x6e, 0x82, 0xa3, 0xae, 0x15, 0x46, 0x2c, 0x66, 0xb0, 0x98,
-	0x22, 0xac, 0x13, 0x84, 0xd5, 0xa9, 0xeb, 0x45, 0x9f, 0xf9, 0x4c, 0x02, 0x6c, 0xf1, 0x2f, 0xc5,
-	0xea, 0x65, 0x9f, 0x31, 0x3f, 0xc0, 0x36, 0x0a, 0x89, 0x8d, 0x28, 0x65, 0x31, 0x8a, 0x09, 0xa3,
-	0x3c, 0xdb, 0xbd, 0xec, 0x31, 0xde, 0x66, 0xdc, 0x76, 0x11, 0xc7, 0x69, 0x09, 0xbb, 0x53, 0x77,
-	0x71, 0x8c, 0xea, 0x76, 0x88, 0x7c, 0x42, 0x25, 0x38, 0xc3, 0x1a, 0xfd, 0xd8, 0x1c, 0x25, 0x8b,
-	0xa7, 0xfb, 0xe7, 0x95, 0xbe, 0x43, 0x14, 0xa1, 0x76, 0x56, 0xce, 0x2c, 0x02, 0xb8, 0x21, 0x8a,
-	0x3c, 0x96, 0x8b, 0x0d, 0xbc, 0x9b, 0x60, 0x1e, 0x9b, 0x1b, 0x60, 0xfe, 0xd4, 0x2a, 0x0f, 0x19,
-	0xe5, 0x18, 0xae, 0x82, 0x99, 0x94, 0x5c, 0xd2, 0xaa, 0x5a, 0x6d, 0x6e, 0xb9, 0x6c, 0xa9, 0x8e,
-	0x6d, 0xa5, 0x2c, 0x67, 0x6a, 0xff, 0xb0, 0x52, 0x68, 0x64, 0x0c, 0xb3, 0x0c, 0x74, 0x29, 0xb9,
-	0xce, 0x5a, 0x49, 0x80, 0xef, 0x78, 0x1e, 0x4b, 0x68, 0xec, 0xa0, 0x00, 0x51, 0x0f, 0x73, 0xf3,
-	0xb3, 0x06, 0xcc, 0xc1, 0xdb, 0x3d, 0x03, 0x7b, 0x1a, 0xf8, 0xbf, 0x2d, 0x11, 0x4d, 0x94, 0x42,
-	0x9a, 0x6e, 0x86, 0x29, 0x69, 0xd5, 0xc9, 0xda, 0xdc, 0xf2, 0x39, 0x2b, 0xcd, 0xc4, 0x12, 0x99,
-	0x58, 0x59, 0x26, 0xd6, 0x1a, 0x23, 0xd4, 0xb9, 0x2d, 0xfc, 0xfc, 0x3a, 0xac, 0xfc, 0xd3, 0x45,
-	0xed, 0x60, 0xd5, 0x14, 0x6e, 0xb9, 0xf9, 0xee, 0x7b, 0xa5, 0xe6, 0x93, 0x78, 0x27, 0x71, 0x2d,
-	0x8f, 0xb5, 0xed, 0x2c, 0xd0, 0xf4, 0xe7, 0x2a, 0x6f, 0x3d, 0xb3, 0xe3, 0x6e, 0x88, 0xb9, 0x14,
-	0xe0, 0x8d, 0x85, 0xb6, 0xd2, 0xbc, 0x0e, 0x4a, 0xd2, 0xfb, 0x1a, 0x89, 0xbc, 0x24, 0x40, 0x31,
-	0xa1, 0xfe, 0x66, 0x12, 0x86, 0x01, 0xc1, 0xdc, 0x7c, 0xa3, 0x81, 0xea, 0xa0, 0xcd, 0xde, 0xb1,
-	0x56, 0xc0, 0x94, 0x08, 0x32, 0x4b, 0x75, 0xc8, 0x11, 0xd2, 0x48, 0x25, 0x58, 0x92, 0x12, 0xde,
-	0x2a, 0x4d, 0x8c, 0x4a, 0x4a, 0x78, 0xcb, 0xdc, 0x02, 0x45, 0xe9, 0xe6, 0x1e, 0xeb, 0x3c, 0x61,
-	0xeb, 0x84, 0xc6, 0x9b, 0xf2, 0xe6, 0xe0, 0x2d, 0x00, 0x3c, 0x16, 0x04, 0x28, 0xc6, 0x11, 0x0a,
-	0x46, 0xf5, 0xd1, 0x47, 0x31, 0x37, 0x40, 0x59, 0x25, 0xdc, 0x3b, 0x62, 0x1d, 0x4c, 0xfa, 0xac,
-	0x33, 0xaa, 0xb2, 0xc0, 0x9a, 0xaf, 0x27, 0x00, 0x7c, 0x48, 0x76, 0x13, 0xd2, 0x22, 0x71, 0xb7,
-	0x21, 0xde, 0xfd, 0x7d, 0xba, 0xcd, 0xe0, 0x16, 0xf8, 0x2f, 0xc8, 0x57, 0x9b, 0x91, 0x58, 0x96,
-	0xaa, 0xb3, 0x8e, 0x25, 0xa8, 0xdf, 0x0e, 0x2b, 0x17, 0x47, 0xb8, 0xcf, 0xbb, 0xd8, 0x6b, 0xfc,
-	0x1b, 0x9c, 0x12, 0x87, 0xeb, 0x00, 0x24, 0x61, 0x88, 0xa3, 0xa6, 0x8b, 0x68, 0x1a, 0xeb, 0xf8,
-	0x9a, 0xb3, 0x52, 0xc1, 0x41, 0xb4, 0x25, 0xe4, 0x02, 0xf6, 0x3c, 0x97, 0x9b, 0xfc, 0x3b, 0x39,
-	0xa9, 0x20, 0xe4, 0xcc, 0x2a, 0x30, 0x64, 0xc0, 0x67, 0x13, 0xc9, 0x9b, 0x16, 0x83, 0xca, 0x40,
-	0x44, 0x76, 0x0b, 0x0e, 0x98, 0x22, 0x74, 0x9b, 0x65, 0xd7, 0x50, 0x53, 0xb7, 0xef, 0x59, 0x7e,
-	0xfe, 0x84, 0x04, 0x77, 0xf9, 0xfd, 0x34, 0x98, 0x96, 0x75, 0xe0, 0x2b, 0x0d, 0xcc, 0xa4, 0xbd,
-	0x0e, 0x07, 0x48, 0x9d, 0x1d, 0x2d, 0xfa, 0xe2, 0x08, 0xc8, 0xd4, 0xad, 0x79, 0xe1, 0xe5, 0x97,
-	0x9f, 0x7b, 0x13, 0x06, 0x2c, 0xdb, 0x43, 0xe6, 0x18, 0xfc, 0xa4, 0x81, 0x05, 0xe5, 0xd4, 0x80,
-	0x4b, 0x43, 0x4a, 0x29, 0x19, 0xfa, 0xcd, 0x71, 0x19, 0x3d, 0xaf, 0x75, 0xe9, 0xf5, 0x0a, 0x5c,
-	0x54, 0x78, 0x55, 0x4f, 0x2c, 0xf8, 0x41, 0x03, 0xf3, 0x8a, 0xa9, 0x00, 0xad, 0x21, 0x26, 0x14,
-	0x78, 0xfd, 0xc6, 0x78, 0xf8, 0x9e, 0x65, 0x5b, 0x5a, 0x5e, 0x84, 0x97, 0x14, 0x96, 0xbd, 0x13,
-	0x5e, 0x93, 0xe7, 0xc6, 0x3e, 0x6a, 0xca, 0x86, 0xbc, 0x36, 0xa4, 0xfe, 0xc0, 0xd7, 0xaa, 0x5f,
-	0x1f, 0x93, 0x35, 0x82, 0xe9, 0x3f, 0xc6, 0x42, 0x53, 0x3c, 0x57, 0xe7, 0xc1, 0xfe, 0x91, 0xa1,
-	0x1d, 0x1c, 0x19, 0xda, 0x8f, 0x23, 0x43, 0x7b, 0x7b, 0x6c, 0x14, 0x0e, 0x8e, 0x8d, 0xc2, 0xd7,
-	0x63, 0xa3, 0xf0, 0x74, 0xa9, 0xaf, 0x09, 0x1f, 0x49, 0xb1, 0xb5, 0x1d, 0x44, 0x68, 0x2e, 0xfc,
-	0xa2, 0x5f, 0x5a, 0xb6, 0xa4, 0x3b, 0x23, 0xbf, 0x99, 0x2b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xec, 0x4c, 0xa2, 0xce, 0x10, 0x08, 0x00, 0x00,
+func (m *CollateralHolding) Reset()         { *m = CollateralHolding{} }
+func (m *CollateralHolding) String() string { return proto.CompactTextString(m) }
+func (*CollateralHolding) ProtoMessage()    {}
+func (*CollateralHolding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd427158b4504e94, []int{11}
+}
+func (m *CollateralHolding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollateralHolding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollateralHolding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollateralHolding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralHolding.Merge(m, src)
+}
+func (m *CollateralHolding) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollateralHolding) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralHolding.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_CollateralHolding proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the x/stablecoin module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ModuleAccountBalances queries the account balance of x/stablecoin.
-	ModuleAccountBalances(ctx context.Context, in *QueryModuleAccountBalances, opts ...grpc.CallOption) (*QueryModuleAccountBalancesResponse, error)
-	CirculatingSupplies(ctx context.Context, in *QueryCirculatingSupplies, opts ...grpc.CallOption) (*QueryCirculatingSuppliesResponse, error)
-	LiquidityRatioInfo(ctx context.Context, in *QueryLiquidityRatioInfoRequest, opts ...grpc.CallOption) (*QueryLiquidityRatioInfoResponse, error)
+func (m *CollateralHolding) GetCollateral() Collateral {
+	if m != nil {
+		return m.Collateral
+	}
+	return Collateral{}
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *CollateralHolding) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+type QueryCollateralBasketRequest struct {
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.stablecoin.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCollateralBasketRequest) Reset()         { *m = QueryCollateralBasketRequest{} }
+func (m *QueryCollateralBasketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCollateralBasketRequest) ProtoMessage()    {}
+func (*QueryCollateralBasketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd427158b4504e94, []int{12}
+}
+func (m *QueryCollateralBasketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollateralBasketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollateralBasketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCollateralBasketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollateralBasketRequest.Merge(m, src)
+}
+func (m *QueryCollateralBasketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollateralBasketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollateralBasketRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ModuleAccountBalances(ctx context.Context, in *QueryModuleAccountBalances, opts ...grpc.CallOption) (*QueryModuleAccountBalancesResponse, error) {
-	out := new(QueryModuleAccountBalancesResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.stablecoin.v1.Query/ModuleAccountBalances", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryCollateralBasketRequest proto.InternalMessageInfo
+
+type QueryCollateralBasketResponse struct {
+	Holdings []CollateralHolding `protobuf:"bytes,1,rep,name=holdings,proto3" json:"holdings"`
+	// total_value is the value of all the collaterals in stablecoins
+	TotalValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=total_value,json=totalValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_value"`
 }
 
-func (c *queryClient) CirculatingSupplies(ctx context.Context, in *QueryCirculatingSupplies, opts ...grpc.CallOption) (*QueryCirculatingSuppliesResponse, error) {
-	out := new(QueryCirculatingSuppliesResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.stablecoin.v1.Query/CirculatingSupplies", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCollateralBasketResponse) Reset()         { *m = QueryCollateralBasketResponse{} }
+func (m *QueryCollateralBasketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCollateralBasketResponse) ProtoMessage()    {}
+func (*QueryCollateralBasketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd427158b4504e94, []int{13}
+}
+func (m *QueryCollateralBasketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollateralBasketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollateralBasketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryCollateralBasketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollateralBasketResponse.Merge(m, src)
+}
+func (m *QueryCollateralBasketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollateralBasketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollateralBasketResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LiquidityRatioInfo(ctx context.Context, in *QueryLiquidityRatioInfoRequest, opts ...grpc.CallOption) (*QueryLiquidityRatioInfoResponse, error) {
-	out := new(QueryLiquidityRatioInfoResponse)
-	err := c.cc.Invoke(ctx, "/nibiru.stablecoin.v1.Query/LiquidityRatioInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCollateralBasketResponse proto.InternalMessageInfo
+
+func (m *QueryCollateralBasketResponse) GetHoldings() []CollateralHolding {
+	if m != nil {
+		return m.Holdings
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the x/stablecoin module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ModuleAccountBalances queries the account balance of x/stablecoin.
-	ModuleAccountBalances(context.Context, *QueryModuleAccountBalances) (*QueryModuleAccountBalancesResponse, error)
-	CirculatingSupplies(context.Context, *QueryCirculatingSupplies) (*QueryCirculatingSuppliesResponse, error)
-	LiquidityRatioInfo(context.Context, *QueryLiquidityRatioInfoRequest) (*QueryLiquidityRatioInfoResponse, error)
+type QueryControllerStateRequest struct {
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryControllerStateRequest) Reset()         { *m = QueryControllerStateRequest{} }
+func (m *QueryControllerStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryControllerStateRequest) ProtoMessage()    {}
+func (*QueryControllerStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd427158b4504e94, []int{14}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryControllerStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ModuleAccountBalances(ctx context.Context, req *QueryModuleAccountBalances) (*QueryModuleAccountBalancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountBalances not implemented")
+func (m *QueryControllerStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryControllerStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) CirculatingSupplies(ctx context.Context, req *QueryCirculatingSupplies) (*QueryCirculatingSuppliesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CirculatingSupplies not implemented")
+func (m *QueryControllerStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryControllerStateRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LiquidityRatioInfo(ctx context.Context, req *QueryLiquidityRatioInfoRequest) (*QueryLiquidityRatioInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityRatioInfo not implemented")
+func (m *QueryControllerStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryControllerStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryControllerStateRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryControllerStateRequest proto.InternalMessageInfo
+
+type QueryControllerStateResponse struct {
+	Controller CollRatioController `protobuf:"varint,1,opt,name=controller,proto3,enum=nibiru.stablecoin.v1.CollRatioController" json:"controller,omitempty"`
+	State      ControllerState     `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.stablecoin.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryControllerStateResponse) Reset()         { *m = QueryControllerStateResponse{} }
+func (m *QueryControllerStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryControllerStateResponse) ProtoMessage()    {}
+func (*QueryControllerStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd427158b4504e94, []int{15}
+}
+func (m *QueryControllerStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryControllerStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryControllerStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryControllerStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryControllerStateResponse.Merge(m, src)
+}
+func (m *QueryControllerStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryControllerStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryControllerStateResponse.DiscardUnknown(m)
 }
 
-func _Query_ModuleAccountBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryModuleAccountBalances)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ModuleAccountBalances(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.stablecoin.v1.Query/ModuleAccountBalances",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ModuleAccountBalances(ctx, req.(*QueryModuleAccountBalances))
+var xxx_messageInfo_QueryControllerStateResponse proto.InternalMessageInfo
+
+func (m *QueryControllerStateResponse) GetController() CollRatioController {
+	if m != nil {
+		return m.Controller
 	}
-	return interceptor(ctx, in, info, handler)
+	return CollRatioController_STEP
 }
 
-func _Query_CirculatingSupplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCirculatingSupplies)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CirculatingSupplies(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.stablecoin.v1.Query/CirculatingSupplies",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CirculatingSupplies(ctx, req.(*QueryCirculatingSupplies))
+func (m *QueryControllerStateResponse) GetState() ControllerState {
+	if m != nil {
+		return m.State
 	}
-	return interceptor(ctx, in, info, handler)
+	return ControllerState{}
 }
 
-func _Query_LiquidityRatioInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidityRatioInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityRatioInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/nibiru.stablecoin.v1.Query/LiquidityRatioInfo",
+type QueryCollRatioDecisionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCollRatioDecisionsRequest) Reset()         { *m = QueryCollRatioDecisionsRequest{} }
+func (m *QueryCollRatioDecisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCollRatioDecisionsRequest) ProtoMessage()    {}
+func (*QueryCollRatioDecisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd427158b4504e94, []int{16}
+}
+func (m *QueryCollRatioDecisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollRatioDecisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollRatioDecisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityRatioInfo(ctx, req.(*QueryLiquidityRatioInfoRequest))
+}
+func (m *QueryCollRatioDecisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollRatioDecisionsRequest.Merge(m, src)
+}
+func (m *QueryCollRatioDecisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollRatioDecisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollRatioDecisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCollRatioDecisionsRequest proto.InternalMessageInfo
+
+func (m *QueryCollRatioDecisionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "nibiru.stablecoin.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ModuleAccountBalances",
-			Handler:    _Query_ModuleAccountBalances_Handler,
-		},
-		{
-			MethodName: "CirculatingSupplies",
-			Handler:    _Query_CirculatingSupplies_Handler,
-		},
-		{
-			MethodName: "LiquidityRatioInfo",
-			Handler:    _Query_LiquidityRatioInfo_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "nibiru/stablecoin/v1/query.proto",
+type QueryCollRatioDecisionsResponse struct {
+	Decisions  []CollRatioDecision `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCollRatioDecisionsResponse) Reset()         { *m = QueryCollRatioDecisionsResponse{} }
+func (m *QueryCollRatioDecisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCollRatioDecisionsResponse) ProtoMessage()    {}
+func (*QueryCollRatioDecisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd427158b4504e94, []int{17}
+}
+func (m *QueryCollRatioDecisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollRatioDecisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollRatioDecisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCollRatioDecisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollRatioDecisionsResponse.Merge(m, src)
+}
+func (m *QueryCollRatioDecisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollRatioDecisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollRatioDecisionsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryCollRatioDecisionsResponse proto.InternalMessageInfo
+
+func (m *QueryCollRatioDecisionsResponse) GetDecisions() []CollRatioDecision {
+	if m != nil {
+		return m.Decisions
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCollRatioDecisionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRateLimitsRequest struct {
+	// address is the account whose capacity is queried, optional
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRateLimitsRequest) Reset()         { *m = QueryRateLimitsRequest{} }
+func (m *QueryRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsRequest) ProtoMessage()    {}
+func (*QueryRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd427158b4504e94, []int{18}
+}
+func (m *QueryRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryModuleAccountBalances) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsRequest.Merge(m, src)
 }
-
-func (m *QueryModuleAccountBalances) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRateLimitsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryModuleAccountBalances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryModuleAccountBalancesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRateLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryModuleAccountBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRateLimitsResponse struct {
+	Mint RateLimit `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint"`
+	Burn RateLimit `protobuf:"bytes,2,opt,name=burn,proto3" json:"burn"`
+	// account_mint is the mint capacity of the account, empty without address
+	AccountMint RateLimit `protobuf:"bytes,3,opt,name=account_mint,json=accountMint,proto3" json:"account_mint"`
+	// account_burn is the burn capacity of the account, empty without address
+	AccountBurn RateLimit `protobuf:"bytes,4,opt,name=account_burn,json=accountBurn,proto3" json:"account_burn"`
 }
 
-func (m *QueryModuleAccountBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ModuleAccountBalances) > 0 {
-		for iNdEx := len(m.ModuleAccountBalances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ModuleAccountBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRateLimitsResponse) Reset()         { *m = QueryRateLimitsResponse{} }
+func (m *QueryRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsResponse) ProtoMessage()    {}
+func (*QueryRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd427158b4504e94, []int{19}
+}
+func (m *QueryRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCirculatingSupplies) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitsResponse) GetMint() RateLimit {
+	if m != nil {
+		return m.Mint
 	}
-	return dAtA[:n], nil
+	return RateLimit{}
 }
 
-func (m *QueryCirculatingSupplies) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRateLimitsResponse) GetBurn() RateLimit {
+	if m != nil {
+		return m.Burn
+	}
+	return RateLimit{}
 }
 
-func (m *QueryCirculatingSupplies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRateLimitsResponse) GetAccountMint() RateLimit {
+	if m != nil {
+		return m.AccountMint
+	}
+	return RateLimit{}
 }
 
-func (m *QueryCirculatingSuppliesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitsResponse) GetAccountBurn() RateLimit {
+	if m != nil {
+		return m.AccountBurn
 	}
-	return dAtA[:n], nil
+	return RateLimit{}
 }
 
-func (m *QueryCirculatingSuppliesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRedemptionQueueRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCirculatingSuppliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Nusd.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Nibi.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRedemptionQueueRequest) Reset()         { *m = QueryRedemptionQueueRequest{} }
+func (m *QueryRedemptionQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionQueueRequest) ProtoMessage()    {}
+func (*QueryRedemptionQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd427158b4504e94, []int{20}
+}
+func (m *QueryRedemptionQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRedemptionQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionQueueRequest.Merge(m, src)
+}
+func (m *QueryRedemptionQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionQueueRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGovToMintStable) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRedemptionQueueRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionQueueRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGovToMintStable) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRedemptionQueueResponse struct {
+	Redemptions []Redemption        `protobuf:"bytes,1,rep,name=redemptions,proto3" json:"redemptions"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGovToMintStable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRedemptionQueueResponse) Reset()         { *m = QueryRedemptionQueueResponse{} }
+func (m *QueryRedemptionQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionQueueResponse) ProtoMessage()    {}
+func (*QueryRedemptionQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd427158b4504e94, []int{21}
+}
+func (m *QueryRedemptionQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGovToMintStableResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionQueueResponse.Merge(m, src)
+}
+func (m *QueryRedemptionQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedemptionQueueResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionQueueResponse) GetRedemptions() []Redemption {
+	if m != nil {
+		return m.Redemptions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGovToMintStableResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRedemptionQueueResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGovToMintStableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Gov.MarshalToSizedBuffer(dAtA[:i])
+type QueryRedemptionRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryRedemptionRequest) Reset()         { *m = QueryRedemptionRequest{} }
+func (m *QueryRedemptionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionRequest) ProtoMessage()    {}
+func (*QueryRedemptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd427158b4504e94, []int{22}
+}
+func (m *QueryRedemptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRedemptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionRequest.Merge(m, src)
+}
+func (m *QueryRedemptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionRequest.DiscardUnknown(m)
 }
 
-func (m *LiquidityRatioInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRedemptionRequest proto.InternalMessageInfo
+
+func (m *QueryRedemptionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LiquidityRatioInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRedemptionResponse struct {
+	Redemption Redemption `protobuf:"bytes,1,opt,name=redemption,proto3" json:"redemption"`
+	// position is the position of the redemption in the queue, starting at 1
+	Position uint64 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	// stable_ahead is the amount of stables to redeem before the redemption
+	StableAhead types.Coin `protobuf:"bytes,3,opt,name=stable_ahead,json=stableAhead,proto3" json:"stable_ahead"`
 }
 
-func (m *LiquidityRatioInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.LowerBand.Size()
-		i -= size
-		if _, err := m.LowerBand.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryRedemptionResponse) Reset()         { *m = QueryRedemptionResponse{} }
+func (m *QueryRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedemptionResponse) ProtoMessage()    {}
+func (*QueryRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd427158b4504e94, []int{23}
+}
+func (m *QueryRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.UpperBand.Size()
-		i -= size
-		if _, err := m.UpperBand.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedemptionResponse.Merge(m, src)
+}
+func (m *QueryRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedemptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRedemptionResponse proto.InternalMessageInfo
+
+func (m *QueryRedemptionResponse) GetRedemption() Redemption {
+	if m != nil {
+		return m.Redemption
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.LiquidityRatio.Size()
-		i -= size
-		if _, err := m.LiquidityRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	return Redemption{}
+}
+
+func (m *QueryRedemptionResponse) GetPosition() uint64 {
+	if m != nil {
+		return m.Position
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryLiquidityRatioInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRedemptionResponse) GetStableAhead() types.Coin {
+	if m != nil {
+		return m.StableAhead
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryLiquidityRatioInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "nibiru.stablecoin.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "nibiru.stablecoin.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryModuleAccountBalances)(nil), "nibiru.stablecoin.v1.QueryModuleAccountBalances")
+	proto.RegisterType((*QueryModuleAccountBalancesResponse)(nil), "nibiru.stablecoin.v1.QueryModuleAccountBalancesResponse")
+	proto.RegisterType((*QueryCirculatingSupplies)(nil), "nibiru.stablecoin.v1.QueryCirculatingSupplies")
+	proto.RegisterType((*QueryCirculatingSuppliesResponse)(nil), "nibiru.stablecoin.v1.QueryCirculatingSuppliesResponse")
+	proto.RegisterType((*QueryGovToMintStable)(nil), "nibiru.stablecoin.v1.QueryGovToMintStable")
+	proto.RegisterType((*QueryGovToMintStableResponse)(nil), "nibiru.stablecoin.v1.QueryGovToMintStableResponse")
+	proto.RegisterType((*LiquidityRatioInfo)(nil), "nibiru.stablecoin.v1.LiquidityRatioInfo")
+	proto.RegisterType((*QueryLiquidityRatioInfoRequest)(nil), "nibiru.stablecoin.v1.QueryLiquidityRatioInfoRequest")
+	proto.RegisterType((*QueryLiquidityRatioInfoResponse)(nil), "nibiru.stablecoin.v1.QueryLiquidityRatioInfoResponse")
+	proto.RegisterType((*CollateralHolding)(nil), "nibiru.stablecoin.v1.CollateralHolding")
+	proto.RegisterType((*QueryCollateralBasketRequest)(nil), "nibiru.stablecoin.v1.QueryCollateralBasketRequest")
+	proto.RegisterType((*QueryCollateralBasketResponse)(nil), "nibiru.stablecoin.v1.QueryCollateralBasketResponse")
+	proto.RegisterType((*QueryControllerStateRequest)(nil), "nibiru.stablecoin.v1.QueryControllerStateRequest")
+	proto.RegisterType((*QueryControllerStateResponse)(nil), "nibiru.stablecoin.v1.QueryControllerStateResponse")
+	proto.RegisterType((*QueryCollRatioDecisionsRequest)(nil), "nibiru.stablecoin.v1.QueryCollRatioDecisionsRequest")
+	proto.RegisterType((*QueryCollRatioDecisionsResponse)(nil), "nibiru.stablecoin.v1.QueryCollRatioDecisionsResponse")
+	proto.RegisterType((*QueryRateLimitsRequest)(nil), "nibiru.stablecoin.v1.QueryRateLimitsRequest")
+	proto.RegisterType((*QueryRateLimitsResponse)(nil), "nibiru.stablecoin.v1.QueryRateLimitsResponse")
+	proto.RegisterType((*QueryRedemptionQueueRequest)(nil), "nibiru.stablecoin.v1.QueryRedemptionQueueRequest")
+	proto.RegisterType((*QueryRedemptionQueueResponse)(nil), "nibiru.stablecoin.v1.QueryRedemptionQueueResponse")
+	proto.RegisterType((*QueryRedemptionRequest)(nil), "nibiru.stablecoin.v1.QueryRedemptionRequest")
+	proto.RegisterType((*QueryRedemptionResponse)(nil), "nibiru.stablecoin.v1.QueryRedemptionResponse")
 }
 
-func (m *QueryLiquidityRatioInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("nibiru/stablecoin/v1/query.proto", fileDescriptor_cd427158b4504e94) }
+
+var fileDescriptor_cd427158b4504e94 = []byte{
+	// 1379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4b, 0x6f, 0x1b, 0x55,
+	0x14, 0xce, 0x24, 0xee, 0x23, 0x27, 0xa5, 0x85, 0xdb, 0x94, 0x86, 0x21, 0xb5, 0xcd, 0xd0, 0xa6,
+	0x49, 0x1f, 0x9e, 0xda, 0xa5, 0x88, 0x76, 0x03, 0x75, 0xaa, 0x3e, 0xa0, 0x85, 0xc6, 0x45, 0x54,
+	0x62, 0x63, 0x5d, 0x7b, 0x6e, 0x9d, 0xab, 0x8e, 0xe7, 0x3a, 0xf3, 0x30, 0x44, 0x88, 0x0d, 0x2c,
+	0x41, 0x02, 0xd4, 0x25, 0x4b, 0xc4, 0x02, 0x84, 0x90, 0x60, 0x01, 0x12, 0xac, 0xd8, 0x75, 0x59,
+	0x89, 0x0d, 0x42, 0xa8, 0xa0, 0x96, 0x5f, 0xc0, 0x2f, 0x40, 0x73, 0x1f, 0x33, 0x76, 0x7c, 0x3d,
+	0x19, 0x47, 0x5d, 0xb5, 0x99, 0x39, 0xdf, 0x77, 0xbf, 0xf3, 0x9d, 0x73, 0x7d, 0x8e, 0x0d, 0x65,
+	0x8f, 0xb6, 0xa8, 0x1f, 0xd9, 0x41, 0x88, 0x5b, 0x2e, 0x69, 0x33, 0xea, 0xd9, 0xfd, 0xaa, 0xbd,
+	0x11, 0x11, 0x7f, 0xb3, 0xd2, 0xf3, 0x59, 0xc8, 0xd0, 0xbc, 0x88, 0xa8, 0xa4, 0x11, 0x95, 0x7e,
+	0xd5, 0x9c, 0xef, 0xb0, 0x0e, 0xe3, 0x01, 0x76, 0xfc, 0x3f, 0x11, 0x6b, 0x2e, 0x76, 0x18, 0xeb,
+	0xb8, 0xc4, 0xc6, 0x3d, 0x6a, 0x63, 0xcf, 0x63, 0x21, 0x0e, 0x29, 0xf3, 0x02, 0xf9, 0xf6, 0x44,
+	0x9b, 0x05, 0x5d, 0x16, 0xd8, 0x2d, 0x1c, 0x10, 0x71, 0x84, 0xdd, 0xaf, 0xb6, 0x48, 0x88, 0xab,
+	0x76, 0x0f, 0x77, 0xa8, 0xc7, 0x83, 0x65, 0x6c, 0x71, 0x30, 0x56, 0x45, 0xf1, 0xc3, 0xc5, 0xfb,
+	0x17, 0xb4, 0xba, 0x7b, 0xd8, 0xc7, 0x5d, 0x75, 0xdc, 0x31, 0x6d, 0x48, 0x9b, 0x79, 0xa1, 0xcf,
+	0x5c, 0x97, 0xf8, 0x99, 0x61, 0x3e, 0x0e, 0x49, 0xd3, 0xa5, 0x5d, 0x1a, 0x8a, 0x30, 0x6b, 0x1e,
+	0xd0, 0x5a, 0x2c, 0xf9, 0x26, 0x3f, 0xa2, 0x41, 0x36, 0x22, 0x12, 0x84, 0xd6, 0x1a, 0x1c, 0x1c,
+	0x7a, 0x1a, 0xf4, 0x98, 0x17, 0x10, 0x74, 0x01, 0x76, 0x0b, 0x29, 0x0b, 0x46, 0xd9, 0x58, 0x9e,
+	0xab, 0x2d, 0x56, 0x74, 0x26, 0x56, 0x04, 0xaa, 0x5e, 0xb8, 0xff, 0xb0, 0x34, 0xd5, 0x90, 0x08,
+	0x6b, 0x11, 0x4c, 0x4e, 0x79, 0x83, 0x39, 0x91, 0x4b, 0x2e, 0xb6, 0xdb, 0x2c, 0xf2, 0xc2, 0x3a,
+	0x76, 0xb1, 0xd7, 0x26, 0x81, 0xf5, 0x8b, 0x01, 0xd6, 0xf8, 0xd7, 0x89, 0x80, 0x7b, 0x06, 0x1c,
+	0xee, 0xf2, 0x88, 0x26, 0x16, 0x21, 0xcd, 0x96, 0x8c, 0x59, 0x30, 0xca, 0x33, 0xcb, 0x73, 0xb5,
+	0xe7, 0x2a, 0xc2, 0xe1, 0x4a, 0xec, 0x70, 0x45, 0x3a, 0x5c, 0x59, 0x65, 0xd4, 0xab, 0xbf, 0x16,
+	0xeb, 0xf9, 0xef, 0x61, 0x69, 0xdf, 0x26, 0xee, 0xba, 0x17, 0xac, 0x58, 0x6d, 0x60, 0x7d, 0xfb,
+	0x77, 0x69, 0xb9, 0x43, 0xc3, 0xf5, 0xa8, 0x55, 0x69, 0xb3, 0xae, 0x2d, 0xcb, 0x23, 0xfe, 0x39,
+	0x1d, 0x38, 0x77, 0xed, 0x70, 0xb3, 0x47, 0x02, 0x4e, 0x10, 0x34, 0x0e, 0x75, 0xb5, 0xe2, 0x4d,
+	0x58, 0xe0, 0xda, 0x57, 0xa9, 0xdf, 0x8e, 0x5c, 0x1c, 0x52, 0xaf, 0x73, 0x2b, 0xea, 0xf5, 0x5c,
+	0x4a, 0x02, 0xeb, 0x53, 0x03, 0xca, 0xe3, 0x5e, 0x26, 0x69, 0x9d, 0x85, 0x42, 0x6c, 0xa4, 0x74,
+	0x35, 0x23, 0x05, 0x61, 0x29, 0x0f, 0xe6, 0xa0, 0x28, 0x70, 0x16, 0xa6, 0xf3, 0x82, 0xa2, 0xc0,
+	0xb1, 0x6e, 0xc3, 0x3c, 0x57, 0x73, 0x85, 0xf5, 0xdf, 0x66, 0x37, 0xa8, 0x17, 0xde, 0xe2, 0x95,
+	0x43, 0xaf, 0x02, 0xb4, 0x99, 0xeb, 0xe2, 0x90, 0xf8, 0xd8, 0xcd, 0xab, 0x63, 0x00, 0x62, 0xad,
+	0xc1, 0xa2, 0x8e, 0x38, 0x49, 0xb1, 0x0a, 0x33, 0x1d, 0xd6, 0xcf, 0xcb, 0x1c, 0xc7, 0x5a, 0x9f,
+	0x4c, 0x03, 0xba, 0x4e, 0x37, 0x22, 0xea, 0xd0, 0x70, 0xb3, 0x11, 0xdf, 0xa2, 0x6b, 0xde, 0x1d,
+	0x86, 0x6e, 0xc3, 0x01, 0x57, 0x3d, 0x6d, 0xfa, 0xf1, 0x63, 0xce, 0x3a, 0x5b, 0xaf, 0xc4, 0xd0,
+	0x3f, 0x1f, 0x96, 0x96, 0x72, 0xd4, 0xf3, 0x12, 0x69, 0x37, 0xf6, 0xbb, 0x43, 0xe4, 0xe8, 0x06,
+	0x40, 0xd4, 0xeb, 0x11, 0xbf, 0xd9, 0xc2, 0x9e, 0xb0, 0x75, 0x72, 0xce, 0x59, 0xce, 0x50, 0xc7,
+	0x9e, 0x13, 0xd3, 0xb9, 0xec, 0x3d, 0x45, 0x37, 0xb3, 0x33, 0x3a, 0xce, 0x10, 0xd3, 0x59, 0x65,
+	0x28, 0x72, 0x83, 0x47, 0x1d, 0x51, 0x97, 0x96, 0x40, 0x69, 0x6c, 0x84, 0xac, 0x42, 0x1d, 0x0a,
+	0xd4, 0xbb, 0xc3, 0x64, 0x19, 0x96, 0xf5, 0xd7, 0x77, 0x14, 0xaf, 0x5a, 0x28, 0xc6, 0x5a, 0x7f,
+	0x19, 0xf0, 0xcc, 0x6a, 0x52, 0xf8, 0xab, 0xcc, 0x75, 0xa8, 0xd7, 0x41, 0x97, 0x35, 0x0d, 0x54,
+	0xd6, 0xf3, 0xa7, 0xe0, 0xd1, 0x3e, 0x42, 0xe7, 0x61, 0x8f, 0xbc, 0xd1, 0x79, 0x1b, 0x5b, 0xc5,
+	0xa3, 0x4b, 0xb0, 0xab, 0x8f, 0xdd, 0x88, 0xec, 0xd0, 0x6b, 0x01, 0xb6, 0x8a, 0xb2, 0x91, 0x07,
+	0x54, 0xe2, 0xe0, 0x2e, 0x09, 0x95, 0xcb, 0xbf, 0x1a, 0x70, 0x64, 0x4c, 0x80, 0x34, 0xf9, 0x1a,
+	0xec, 0x5d, 0x17, 0xae, 0xa8, 0x0f, 0xa5, 0xe3, 0xdb, 0x19, 0x21, 0x5d, 0x94, 0x19, 0x25, 0x70,
+	0xf4, 0x16, 0xcc, 0x85, 0x2c, 0xc4, 0x6e, 0x53, 0x24, 0xb6, 0xb3, 0x9e, 0x04, 0x4e, 0xf1, 0x0e,
+	0xcf, 0xee, 0x08, 0x3c, 0x2f, 0xc5, 0xab, 0x71, 0x71, 0x2b, 0xc4, 0x21, 0x51, 0xc9, 0x7d, 0x67,
+	0xc0, 0xa2, 0xfe, 0x7d, 0x92, 0x1b, 0xa4, 0x93, 0x86, 0x97, 0x79, 0x7f, 0x6d, 0x65, 0x7c, 0x76,
+	0xbc, 0x83, 0x52, 0xae, 0xc6, 0x00, 0x18, 0x5d, 0x84, 0x5d, 0x41, 0xcc, 0x2d, 0xeb, 0x7c, 0x6c,
+	0x1c, 0xcb, 0x90, 0x10, 0xe9, 0x90, 0x40, 0x5a, 0xeb, 0xf2, 0x4e, 0x24, 0x47, 0x5d, 0x22, 0x6d,
+	0x1a, 0x50, 0xe6, 0xa9, 0x41, 0x16, 0xb7, 0x65, 0x3a, 0x83, 0x65, 0x5b, 0x2e, 0x0d, 0x75, 0x94,
+	0xd8, 0x09, 0x54, 0x5f, 0xdd, 0xc4, 0x1d, 0x65, 0x46, 0x63, 0x00, 0x69, 0xfd, 0x6c, 0x40, 0x69,
+	0xec, 0x51, 0xd2, 0x9b, 0x37, 0x60, 0xd6, 0x51, 0x0f, 0xb7, 0x2f, 0xfc, 0x10, 0x89, 0x4c, 0x2b,
+	0xc5, 0xa3, 0x2b, 0x43, 0xc2, 0x85, 0x45, 0xc7, 0xb7, 0x15, 0x2e, 0x94, 0x0c, 0x29, 0xaf, 0xc1,
+	0xb3, 0x5c, 0x78, 0x03, 0x87, 0xe4, 0x7a, 0x3c, 0xf8, 0x13, 0x6f, 0x16, 0x60, 0x0f, 0x76, 0x1c,
+	0x9f, 0x04, 0x62, 0x9c, 0xcf, 0x36, 0xd4, 0x9f, 0xd6, 0x57, 0xd3, 0x70, 0x78, 0x04, 0x24, 0xb3,
+	0x3c, 0x0f, 0x85, 0x2e, 0xf5, 0x42, 0xe9, 0x65, 0x49, 0x9f, 0x60, 0x82, 0x53, 0x9f, 0x1c, 0x31,
+	0x24, 0x86, 0xb6, 0x22, 0x5f, 0x65, 0x93, 0x17, 0x1a, 0x43, 0xd0, 0x55, 0xd8, 0xa7, 0x06, 0x3e,
+	0x3f, 0x7d, 0x66, 0x12, 0x8a, 0x39, 0x09, 0x8d, 0xc7, 0xd2, 0x20, 0x13, 0x17, 0x53, 0xd8, 0x09,
+	0x53, 0x3d, 0xf2, 0x3d, 0x8b, 0xc8, 0xbb, 0xd4, 0x20, 0x0e, 0xe9, 0xf6, 0x62, 0xb3, 0xd7, 0x22,
+	0x12, 0x91, 0x27, 0xdd, 0x7a, 0x3f, 0xa8, 0x3b, 0x39, 0x72, 0x8e, 0xac, 0xc8, 0x55, 0x98, 0xf3,
+	0x93, 0x57, 0xaa, 0xf3, 0xc6, 0x7c, 0xf6, 0xa6, 0x1c, 0x2a, 0xa3, 0x01, 0xe8, 0x93, 0x6b, 0xba,
+	0x65, 0xd5, 0x74, 0x09, 0xb9, 0x72, 0x65, 0x3f, 0x4c, 0x53, 0x87, 0xbb, 0x51, 0x68, 0x4c, 0x53,
+	0xc7, 0xfa, 0xcd, 0x80, 0xc3, 0x23, 0xa1, 0x32, 0xb1, 0xcb, 0x00, 0xa9, 0xba, 0xec, 0x99, 0x32,
+	0x92, 0xd7, 0x00, 0x12, 0x99, 0xb0, 0xb7, 0xc7, 0x02, 0x9a, 0x24, 0x55, 0x68, 0x24, 0x7f, 0xa3,
+	0x3a, 0xec, 0x13, 0x4c, 0x4d, 0xbc, 0x4e, 0xb0, 0xb3, 0x30, 0x93, 0x6f, 0xe8, 0xcc, 0x09, 0xd0,
+	0xc5, 0x18, 0x53, 0xfb, 0xec, 0x29, 0xd8, 0xc5, 0x73, 0x40, 0x1f, 0x1b, 0xb0, 0x5b, 0x6c, 0xbf,
+	0x68, 0xcc, 0x70, 0x1d, 0x5d, 0xb6, 0xcd, 0x95, 0x1c, 0x91, 0xc2, 0x11, 0xeb, 0xe8, 0x47, 0xbf,
+	0xff, 0x7b, 0x6f, 0xba, 0x88, 0x16, 0xed, 0x8c, 0xef, 0x09, 0xe8, 0x27, 0x03, 0x0e, 0x69, 0xf7,
+	0x68, 0x74, 0x26, 0xe3, 0x28, 0x2d, 0xc2, 0x7c, 0x65, 0x52, 0x44, 0xa2, 0xb5, 0xca, 0xb5, 0x9e,
+	0x44, 0x2b, 0x1a, 0xad, 0xfa, 0x1d, 0x1e, 0x7d, 0x6f, 0xc0, 0x41, 0xcd, 0x9e, 0x8c, 0x2a, 0x19,
+	0x22, 0x34, 0xf1, 0xe6, 0xcb, 0x93, 0xc5, 0x27, 0x92, 0x6d, 0x2e, 0x79, 0x05, 0x1d, 0xd7, 0x48,
+	0x6e, 0xa7, 0xb8, 0x66, 0xa0, 0x84, 0xfd, 0x68, 0x68, 0x57, 0xd4, 0x97, 0x32, 0xce, 0x1f, 0xbb,
+	0xbf, 0x99, 0xe7, 0x26, 0x44, 0xe5, 0x10, 0xbd, 0x65, 0x51, 0x6e, 0xc6, 0x0b, 0x1c, 0xfa, 0xc6,
+	0x80, 0xa7, 0xb7, 0x2e, 0x2f, 0xa8, 0x96, 0x65, 0x99, 0x7e, 0x15, 0x32, 0xcf, 0x4e, 0x84, 0x91,
+	0x72, 0x4f, 0x71, 0xb9, 0x4b, 0xe8, 0xa8, 0xce, 0xe3, 0x04, 0xd4, 0x6c, 0x09, 0x59, 0x5f, 0x1b,
+	0x70, 0x60, 0xcb, 0x0a, 0x80, 0xaa, 0x99, 0xc7, 0xea, 0xf6, 0x1a, 0xb3, 0x36, 0x09, 0x44, 0x0a,
+	0x3d, 0xc9, 0x85, 0x1e, 0x43, 0x2f, 0x6a, 0x85, 0x2a, 0x4c, 0x93, 0x6f, 0x22, 0xbc, 0x11, 0x46,
+	0x57, 0x83, 0xcc, 0x46, 0x18, 0xbb, 0xb4, 0x98, 0xe7, 0x26, 0x44, 0xe5, 0xe9, 0x5e, 0xe6, 0xba,
+	0xb2, 0x07, 0xd2, 0x1d, 0xe3, 0x0b, 0x03, 0x20, 0x9d, 0xf0, 0xe8, 0x54, 0xc6, 0xb1, 0x23, 0xdb,
+	0x83, 0x79, 0x3a, 0x67, 0xb4, 0x14, 0xb7, 0xc4, 0xc5, 0x95, 0x51, 0x51, 0x23, 0x2e, 0xfd, 0x51,
+	0x22, 0xe0, 0x05, 0xdf, 0x32, 0xe8, 0x32, 0x0b, 0xae, 0x1f, 0xbe, 0x66, 0x6d, 0x12, 0x48, 0x8e,
+	0x82, 0xa7, 0xd3, 0xa4, 0xb9, 0xc1, 0x35, 0x7d, 0x19, 0x7b, 0x97, 0x3c, 0xcc, 0xf6, 0x6e, 0xeb,
+	0x10, 0x34, 0x4f, 0xe7, 0x8c, 0x96, 0xc2, 0xce, 0x70, 0x61, 0x27, 0xd0, 0x72, 0x0e, 0x61, 0xf6,
+	0x07, 0xd4, 0xf9, 0xb0, 0xfe, 0xfa, 0xfd, 0x47, 0x45, 0xe3, 0xc1, 0xa3, 0xa2, 0xf1, 0xcf, 0xa3,
+	0xa2, 0xf1, 0xf9, 0xe3, 0xe2, 0xd4, 0x83, 0xc7, 0xc5, 0xa9, 0x3f, 0x1e, 0x17, 0xa7, 0xde, 0x3d,
+	0x33, 0xf0, 0xa5, 0xe1, 0x4d, 0xce, 0xb6, 0xba, 0x8e, 0xa9, 0xa7, 0x98, 0xdf, 0x1f, 0xe4, 0xe6,
+	0x5f, 0x21, 0x5a, 0xbb, 0xf9, 0x0f, 0x45, 0x67, 0xff, 0x1f, 0x00, 0xd3, 0x2c, 0xe4, 0x2b, 0x53,
+	0x13, 0x00, 0x00,
 }
 
-func (m *QueryLiquidityRatioInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the x/stablecoin module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ModuleAccountBalances queries the account balance of x/stablecoin.
+	ModuleAccountBalances(ctx context.Context, in *QueryModuleAccountBalances, opts ...grpc.CallOption) (*QueryModuleAccountBalancesResponse, error)
+	CirculatingSupplies(ctx context.Context, in *QueryCirculatingSupplies, opts ...grpc.CallOption) (*QueryCirculatingSuppliesResponse, error)
+	LiquidityRatioInfo(ctx context.Context, in *QueryLiquidityRatioInfoRequest, opts ...grpc.CallOption) (*QueryLiquidityRatioInfoResponse, error)
+	// CollateralBasket queries the collaterals held by x/stablecoin and their
+	// value in stablecoins.
+	CollateralBasket(ctx context.Context, in *QueryCollateralBasketRequest, opts ...grpc.CallOption) (*QueryCollateralBasketResponse, error)
+	// ControllerState queries the state of the collateral ratio controller.
+	ControllerState(ctx context.Context, in *QueryControllerStateRequest, opts ...grpc.CallOption) (*QueryControllerStateResponse, error)
+	// CollRatioDecisions queries the updates of the collateral ratio made at the
+	// end of each epoch.
+	CollRatioDecisions(ctx context.Context, in *QueryCollRatioDecisionsRequest, opts ...grpc.CallOption) (*QueryCollRatioDecisionsResponse, error)
+	// RateLimits queries the mint and burn capacity left in the current epoch,
+	// globally and for an account.
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	// RedemptionQueue queries the redemptions waiting in the queue.
+	RedemptionQueue(ctx context.Context, in *QueryRedemptionQueueRequest, opts ...grpc.CallOption) (*QueryRedemptionQueueResponse, error)
+	// Redemption queries a queued redemption and its position in the queue.
+	Redemption(ctx context.Context, in *QueryRedemptionRequest, opts ...grpc.CallOption) (*QueryRedemptionResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.stablecoin.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLiquidityRatioInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ModuleAccountBalances(ctx context.Context, in *QueryModuleAccountBalances, opts ...grpc.CallOption) (*QueryModuleAccountBalancesResponse, error) {
+	out := new(QueryModuleAccountBalancesResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.stablecoin.v1.Query/ModuleAccountBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLiquidityRatioInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) CirculatingSupplies(ctx context.Context, in *QueryCirculatingSupplies, opts ...grpc.CallOption) (*QueryCirculatingSuppliesResponse, error) {
+	out := new(QueryCirculatingSuppliesResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.stablecoin.v1.Query/CirculatingSupplies", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) LiquidityRatioInfo(ctx context.Context, in *QueryLiquidityRatioInfoRequest, opts ...grpc.CallOption) (*QueryLiquidityRatioInfoResponse, error) {
+	out := new(QueryLiquidityRatioInfoResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.stablecoin.v1.Query/LiquidityRatioInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) CollateralBasket(ctx context.Context, in *QueryCollateralBasketRequest, opts ...grpc.CallOption) (*QueryCollateralBasketResponse, error) {
+	out := new(QueryCollateralBasketResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.stablecoin.v1.Query/CollateralBasket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ControllerState(ctx context.Context, in *QueryControllerStateRequest, opts ...grpc.CallOption) (*QueryControllerStateResponse, error) {
+	out := new(QueryControllerStateResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.stablecoin.v1.Query/ControllerState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryModuleAccountBalances) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) CollRatioDecisions(ctx context.Context, in *QueryCollRatioDecisionsRequest, opts ...grpc.CallOption) (*QueryCollRatioDecisionsResponse, error) {
+	out := new(QueryCollRatioDecisionsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.stablecoin.v1.Query/CollRatioDecisions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryModuleAccountBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.stablecoin.v1.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.ModuleAccountBalances) > 0 {
-		for _, e := range m.ModuleAccountBalances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) RedemptionQueue(ctx context.Context, in *QueryRedemptionQueueRequest, opts ...grpc.CallOption) (*QueryRedemptionQueueResponse, error) {
+	out := new(QueryRedemptionQueueResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.stablecoin.v1.Query/RedemptionQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryCirculatingSupplies) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Redemption(ctx context.Context, in *QueryRedemptionRequest, opts ...grpc.CallOption) (*QueryRedemptionResponse, error) {
+	out := new(QueryRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.stablecoin.v1.Query/Redemption", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryCirculatingSuppliesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the x/stablecoin module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ModuleAccountBalances queries the account balance of x/stablecoin.
+	ModuleAccountBalances(context.Context, *QueryModuleAccountBalances) (*QueryModuleAccountBalancesResponse, error)
+	CirculatingSupplies(context.Context, *QueryCirculatingSupplies) (*QueryCirculatingSuppliesResponse, error)
+	LiquidityRatioInfo(context.Context, *QueryLiquidityRatioInfoRequest) (*QueryLiquidityRatioInfoResponse, error)
+	// CollateralBasket queries the collaterals held by x/stablecoin and their
+	// value in stablecoins.
+	CollateralBasket(context.Context, *QueryCollateralBasketRequest) (*QueryCollateralBasketResponse, error)
+	// ControllerState queries the state of the collateral ratio controller.
+	ControllerState(context.Context, *QueryControllerStateRequest) (*QueryControllerStateResponse, error)
+	// CollRatioDecisions queries the updates of the collateral ratio made at the
+	// end of each epoch.
+	CollRatioDecisions(context.Context, *QueryCollRatioDecisionsRequest) (*QueryCollRatioDecisionsResponse, error)
+	// RateLimits queries the mint and burn capacity left in the current epoch,
+	// globally and for an account.
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error)
+	// RedemptionQueue queries the redemptions waiting in the queue.
+	RedemptionQueue(context.Context, *QueryRedemptionQueueRequest) (*QueryRedemptionQueueResponse, error)
+	// Redemption queries a queued redemption and its position in the queue.
+	Redemption(context.Context, *QueryRedemptionRequest) (*QueryRedemptionResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ModuleAccountBalances(ctx context.Context, req *QueryModuleAccountBalances) (*QueryModuleAccountBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleAccountBalances not implemented")
+}
+func (*UnimplementedQueryServer) CirculatingSupplies(ctx context.Context, req *QueryCirculatingSupplies) (*QueryCirculatingSuppliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CirculatingSupplies not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityRatioInfo(ctx context.Context, req *QueryLiquidityRatioInfoRequest) (*QueryLiquidityRatioInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityRatioInfo not implemented")
+}
+func (*UnimplementedQueryServer) CollateralBasket(ctx context.Context, req *QueryCollateralBasketRequest) (*QueryCollateralBasketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollateralBasket not implemented")
+}
+func (*UnimplementedQueryServer) ControllerState(ctx context.Context, req *QueryControllerStateRequest) (*QueryControllerStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ControllerState not implemented")
+}
+func (*UnimplementedQueryServer) CollRatioDecisions(ctx context.Context, req *QueryCollRatioDecisionsRequest) (*QueryCollRatioDecisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollRatioDecisions not implemented")
+}
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (*UnimplementedQueryServer) RedemptionQueue(ctx context.Context, req *QueryRedemptionQueueRequest) (*QueryRedemptionQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedemptionQueue not implemented")
+}
+func (*UnimplementedQueryServer) Redemption(ctx context.Context, req *QueryRedemptionRequest) (*QueryRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redemption not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Nibi.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Nusd.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.stablecoin.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGovToMintStable) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ModuleAccountBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountBalances)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Collateral.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleAccountBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.stablecoin.v1.Query/ModuleAccountBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleAccountBalances(ctx, req.(*QueryModuleAccountBalances))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGovToMintStableResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_CirculatingSupplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCirculatingSupplies)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CirculatingSupplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.stablecoin.v1.Query/CirculatingSupplies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CirculatingSupplies(ctx, req.(*QueryCirculatingSupplies))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityRatioInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityRatioInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityRatioInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.stablecoin.v1.Query/LiquidityRatioInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityRatioInfo(ctx, req.(*QueryLiquidityRatioInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CollateralBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollateralBasketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollateralBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.stablecoin.v1.Query/CollateralBasket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollateralBasket(ctx, req.(*QueryCollateralBasketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ControllerState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryControllerStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ControllerState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.stablecoin.v1.Query/ControllerState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ControllerState(ctx, req.(*QueryControllerStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CollRatioDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollRatioDecisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollRatioDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.stablecoin.v1.Query/CollRatioDecisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollRatioDecisions(ctx, req.(*QueryCollRatioDecisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.stablecoin.v1.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedemptionQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedemptionQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.stablecoin.v1.Query/RedemptionQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedemptionQueue(ctx, req.(*QueryRedemptionQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Redemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Redemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.stablecoin.v1.Query/Redemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Redemption(ctx, req.(*QueryRedemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nibiru.stablecoin.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ModuleAccountBalances",
+			Handler:    _Query_ModuleAccountBalances_Handler,
+		},
+		{
+			MethodName: "CirculatingSupplies",
+			Handler:    _Query_CirculatingSupplies_Handler,
+		},
+		{
+			MethodName: "LiquidityRatioInfo",
+			Handler:    _Query_LiquidityRatioInfo_Handler,
+		},
+		{
+			MethodName: "CollateralBasket",
+			Handler:    _Query_CollateralBasket_Handler,
+		},
+		{
+			MethodName: "ControllerState",
+			Handler:    _Query_ControllerState_Handler,
+		},
+		{
+			MethodName: "CollRatioDecisions",
+			Handler:    _Query_CollRatioDecisions_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "RedemptionQueue",
+			Handler:    _Query_RedemptionQueue_Handler,
+		},
+		{
+			MethodName: "Redemption",
+			Handler:    _Query_Redemption_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nibiru/stablecoin/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountBalances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountBalances) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountBalances) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleAccountBalances) > 0 {
+		for iNdEx := len(m.ModuleAccountBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleAccountBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCirculatingSupplies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCirculatingSupplies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCirculatingSupplies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCirculatingSuppliesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCirculatingSuppliesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCirculatingSuppliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Nusd.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Nibi.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGovToMintStable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGovToMintStable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGovToMintStable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGovToMintStableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGovToMintStableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGovToMintStableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Gov.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityRatioInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityRatioInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityRatioInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LowerBand.Size()
+		i -= size
+		if _, err := m.LowerBand.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.UpperBand.Size()
+		i -= size
+		if _, err := m.UpperBand.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LiquidityRatio.Size()
+		i -= size
+		if _, err := m.LiquidityRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityRatioInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityRatioInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityRatioInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityRatioInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityRatioInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityRatioInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CollateralHolding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollateralHolding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollateralHolding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralBasketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralBasketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralBasketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralBasketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralBasketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralBasketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalValue.Size()
+		i -= size
+		if _, err := m.TotalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Holdings) > 0 {
+		for iNdEx := len(m.Holdings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holdings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryControllerStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryControllerStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryControllerStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryControllerStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryControllerStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryControllerStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Controller != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Controller))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollRatioDecisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollRatioDecisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollRatioDecisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollRatioDecisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollRatioDecisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollRatioDecisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Decisions) > 0 {
+		for iNdEx := len(m.Decisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Decisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AccountBurn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.AccountMint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Burn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Mint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Redemptions) > 0 {
+		for iNdEx := len(m.Redemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StableAhead.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Position != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Redemption.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryModuleAccountBalances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleAccountBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModuleAccountBalances) > 0 {
+		for _, e := range m.ModuleAccountBalances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCirculatingSupplies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCirculatingSuppliesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Nibi.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Nusd.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGovToMintStable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Collateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGovToMintStableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Gov.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LiquidityRatioInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UpperBand.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LowerBand.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidityRatioInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLiquidityRatioInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Info.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *CollateralHolding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Collateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCollateralBasketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCollateralBasketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Holdings) > 0 {
+		for _, e := range m.Holdings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryControllerStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryControllerStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Controller != 0 {
+		n += 1 + sovQuery(uint64(m.Controller))
+	}
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCollRatioDecisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCollRatioDecisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Decisions) > 0 {
+		for _, e := range m.Decisions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Mint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Burn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AccountMint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AccountBurn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRedemptionQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redemptions) > 0 {
+		for _, e := range m.Redemptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedemptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redemption.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Position != 0 {
+		n += 1 + sovQuery(uint64(m.Position))
+	}
+	l = m.StableAhead.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountBalances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountBalances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountBalancesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountBalancesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccountBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccountBalances = append(m.ModuleAccountBalances, types.Coin{})
+			if err := m.ModuleAccountBalances[len(m.ModuleAccountBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCirculatingSupplies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCirculatingSupplies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCirculatingSupplies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCirculatingSuppliesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCirculatingSuppliesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCirculatingSuppliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nibi", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Nibi.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nusd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Nusd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGovToMintStable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGovToMintStable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGovToMintStable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGovToMintStableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGovToMintStableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGovToMintStableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gov", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Gov.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityRatioInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityRatioInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityRatioInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowerBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityRatioInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityRatioInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityRatioInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityRatioInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityRatioInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityRatioInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollateralHolding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollateralHolding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollateralHolding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralBasketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralBasketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralBasketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Gov.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *LiquidityRatioInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.LiquidityRatio.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.UpperBand.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.LowerBand.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryLiquidityRatioInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCollateralBasketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralBasketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralBasketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holdings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holdings = append(m.Holdings, CollateralHolding{})
+			if err := m.Holdings[len(m.Holdings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryLiquidityRatioInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Info.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryControllerStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1278,10 +4112,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryControllerStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryControllerStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1305,7 +4139,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryControllerStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1328,15 +4162,34 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryControllerStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryControllerStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			m.Controller = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Controller |= CollRatioController(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1363,7 +4216,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1388,7 +4241,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountBalances) Unmarshal(dAtA []byte) error {
+func (m *QueryCollRatioDecisionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1411,12 +4264,48 @@ func (m *QueryModuleAccountBalances) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountBalances: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCollRatioDecisionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCollRatioDecisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1438,7 +4327,7 @@ func (m *QueryModuleAccountBalances) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleAccountBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCollRatioDecisionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1461,15 +4350,49 @@ func (m *QueryModuleAccountBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleAccountBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCollRatioDecisionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleAccountBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCollRatioDecisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccountBalances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decisions = append(m.Decisions, CollRatioDecision{})
+			if err := m.Decisions[len(m.Decisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1496,8 +4419,10 @@ func (m *QueryModuleAccountBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAccountBalances = append(m.ModuleAccountBalances, types.Coin{})
-			if err := m.ModuleAccountBalances[len(m.ModuleAccountBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1522,7 +4447,7 @@ func (m *QueryModuleAccountBalancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCirculatingSupplies) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1545,12 +4470,44 @@ func (m *QueryCirculatingSupplies) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCirculatingSupplies: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCirculatingSupplies: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1572,7 +4529,7 @@ func (m *QueryCirculatingSupplies) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCirculatingSuppliesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1595,15 +4552,15 @@ func (m *QueryCirculatingSuppliesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCirculatingSuppliesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCirculatingSuppliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nibi", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1630,13 +4587,13 @@ func (m *QueryCirculatingSuppliesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Nibi.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Mint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nusd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1663,63 +4620,46 @@ func (m *QueryCirculatingSuppliesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Nusd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountMint", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGovToMintStable) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.AccountMint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGovToMintStable: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGovToMintStable: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountBurn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1746,7 +4686,7 @@ func (m *QueryGovToMintStable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccountBurn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1771,7 +4711,7 @@ func (m *QueryGovToMintStable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGovToMintStableResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRedemptionQueueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1794,15 +4734,15 @@ func (m *QueryGovToMintStableResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGovToMintStableResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedemptionQueueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGovToMintStableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedemptionQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gov", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1829,7 +4769,10 @@ func (m *QueryGovToMintStableResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Gov.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1854,7 +4797,7 @@ func (m *QueryGovToMintStableResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityRatioInfo) Unmarshal(dAtA []byte) error {
+func (m *QueryRedemptionQueueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1877,17 +4820,17 @@ func (m *LiquidityRatioInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityRatioInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedemptionQueueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityRatioInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedemptionQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1897,31 +4840,31 @@ func (m *LiquidityRatioInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Redemptions = append(m.Redemptions, Redemption{})
+			if err := m.Redemptions[len(m.Redemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpperBand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1931,57 +4874,25 @@ func (m *LiquidityRatioInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UpperBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowerBand", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.LowerBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2006,7 +4917,7 @@ func (m *LiquidityRatioInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityRatioInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRedemptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2029,12 +4940,31 @@ func (m *QueryLiquidityRatioInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityRatioInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedemptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityRatioInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2056,7 +4986,7 @@ func (m *QueryLiquidityRatioInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityRatioInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRedemptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2079,15 +5009,15 @@ func (m *QueryLiquidityRatioInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityRatioInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedemptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityRatioInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemption", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2114,7 +5044,59 @@ func (m *QueryLiquidityRatioInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Redemption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableAhead", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StableAhead.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
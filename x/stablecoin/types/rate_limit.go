@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// MaxRedemptionsPerEpoch is the maximum number of queued redemptions processed
+// at the end of an epoch.
+const MaxRedemptionsPerEpoch = 100
+
+// NewEpochUsage returns the usage of an epoch without mints nor burns.
+func NewEpochUsage() EpochUsage {
+	return EpochUsage{
+		Minted: sdkmath.ZeroInt(),
+		Burned: sdkmath.ZeroInt(),
+	}
+}
+
+// NewRateLimit returns the usage of a per-epoch cap, no cap if zero.
+func NewRateLimit(limit int64, used sdkmath.Int) RateLimit {
+	capAmount := sdkmath.NewInt(limit)
+	remaining := sdkmath.ZeroInt()
+	if capAmount.GT(used) {
+		remaining = capAmount.Sub(used)
+	}
+	return RateLimit{
+		Cap:       capAmount,
+		Used:      used,
+		Remaining: remaining,
+	}
+}
+
+// IsCapped returns whether the rate limit has a cap.
+func (r RateLimit) IsCapped() bool {
+	return r.Cap.IsPositive()
+}
+
+// Available returns the part of an amount that fits within the cap.
+func (r RateLimit) Available(amount sdkmath.Int) sdkmath.Int {
+	if !r.IsCapped() {
+		return amount
+	}
+	return sdkmath.MinInt(amount, r.Remaining)
+}
@@ -37,6 +37,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MsgMintStable struct {
 	Creator string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Stable  types.Coin `protobuf:"bytes,2,opt,name=stable,proto3" json:"stable"`
+	// coll_denom is the collateral deposited, the first enabled collateral if
+	// empty
+	CollDenom string `protobuf:"bytes,3,opt,name=coll_denom,json=collDenom,proto3" json:"coll_denom,omitempty"`
 }
 
 func (m *MsgMintStable) Reset()         { *m = MsgMintStable{} }
@@ -86,6 +89,13 @@ func (m *MsgMintStable) GetStable() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgMintStable) GetCollDenom() string {
+	if m != nil {
+		return m.CollDenom
+	}
+	return ""
+}
+
 // MsgMintStableResponse specifies the amount of NUSD token the user will
 // receive after their mint transaction
 type MsgMintStableResponse struct {
@@ -154,6 +164,9 @@ func (m *MsgMintStableResponse) GetFeesPayed() github_com_cosmos_cosmos_sdk_type
 type MsgBurnStable struct {
 	Creator string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Stable  types.Coin `protobuf:"bytes,2,opt,name=stable,proto3" json:"stable"`
+	// coll_denom is the collateral redeemed, the first enabled collateral if
+	// empty
+	CollDenom string `protobuf:"bytes,3,opt,name=coll_denom,json=collDenom,proto3" json:"coll_denom,omitempty"`
 }
 
 func (m *MsgBurnStable) Reset()         { *m = MsgBurnStable{} }
@@ -203,12 +216,26 @@ func (m *MsgBurnStable) GetStable() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgBurnStable) GetCollDenom() string {
+	if m != nil {
+		return m.CollDenom
+	}
+	return ""
+}
+
 // MsgBurnStableResponse specifies the amount of collateral and governance
 // token the user will receive after their burn transaction.
 type MsgBurnStableResponse struct {
 	Collateral types.Coin                               `protobuf:"bytes,1,opt,name=collateral,proto3" json:"collateral"`
 	Gov        types.Coin                               `protobuf:"bytes,2,opt,name=gov,proto3" json:"gov"`
 	FeesPayed  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fees_payed,json=feesPayed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees_payed"`
+	// queued is whether the burn exceeded the burn caps and the stables above
+	// the caps were added to the redemption queue
+	Queued bool `protobuf:"varint,4,opt,name=queued,proto3" json:"queued,omitempty"`
+	// redemption_id is the id of the queued redemption
+	RedemptionId uint64 `protobuf:"varint,5,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	// queued_stable is the amount of stables added to the redemption queue
+	QueuedStable types.Coin `protobuf:"bytes,6,opt,name=queued_stable,json=queuedStable,proto3" json:"queued_stable"`
 }
 
 func (m *MsgBurnStableResponse) Reset()         { *m = MsgBurnStableResponse{} }
@@ -265,6 +292,27 @@ func (m *MsgBurnStableResponse) GetFeesPayed() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+func (m *MsgBurnStableResponse) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
+}
+
+func (m *MsgBurnStableResponse) GetRedemptionId() uint64 {
+	if m != nil {
+		return m.RedemptionId
+	}
+	return 0
+}
+
+func (m *MsgBurnStableResponse) GetQueuedStable() types.Coin {
+	if m != nil {
+		return m.QueuedStable
+	}
+	return types.Coin{}
+}
+
 // MsgRecollateralize
 type MsgRecollateralize struct {
 	Creator string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
@@ -369,8 +417,11 @@ func (m *MsgRecollateralizeResponse) GetGov() types.Coin {
 type MsgBuyback struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	// Gov (sdk.Coin): Tokens the caller wants to sell to the protocol in exchange
-	// for collateral.
+	//for collateral.
 	Gov types.Coin `protobuf:"bytes,2,opt,name=gov,proto3" json:"gov"`
+	// coll_denom is the collateral received, the first enabled collateral if
+	// empty
+	CollDenom string `protobuf:"bytes,3,opt,name=coll_denom,json=collDenom,proto3" json:"coll_denom,omitempty"`
 }
 
 func (m *MsgBuyback) Reset()         { *m = MsgBuyback{} }
@@ -420,6 +471,13 @@ func (m *MsgBuyback) GetGov() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgBuyback) GetCollDenom() string {
+	if m != nil {
+		return m.CollDenom
+	}
+	return ""
+}
+
 // MsgBuybackResponse is the output of a successful 'Buyback'
 type MsgBuybackResponse struct {
 	// Coll (sdk.Coin): Tokens sold to the caller in exchange for her collateral.
@@ -466,6 +524,108 @@ func (m *MsgBuybackResponse) GetColl() types.Coin {
 	return types.Coin{}
 }
 
+// MsgCancelRedemption removes a redemption of the creator from the redemption
+// queue.
+type MsgCancelRedemption struct {
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	RedemptionId uint64 `protobuf:"varint,2,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+}
+
+func (m *MsgCancelRedemption) Reset()         { *m = MsgCancelRedemption{} }
+func (m *MsgCancelRedemption) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRedemption) ProtoMessage()    {}
+func (*MsgCancelRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c52aa4b3b498950, []int{8}
+}
+func (m *MsgCancelRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRedemption.Merge(m, src)
+}
+func (m *MsgCancelRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRedemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRedemption proto.InternalMessageInfo
+
+func (m *MsgCancelRedemption) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCancelRedemption) GetRedemptionId() uint64 {
+	if m != nil {
+		return m.RedemptionId
+	}
+	return 0
+}
+
+// MsgCancelRedemptionResponse is the output of a successful
+// 'CancelRedemption'
+type MsgCancelRedemptionResponse struct {
+	// Stable (sdk.Coin): the stables of the redemption given back to the
+	// creator.
+	Stable types.Coin `protobuf:"bytes,1,opt,name=stable,proto3" json:"stable"`
+}
+
+func (m *MsgCancelRedemptionResponse) Reset()         { *m = MsgCancelRedemptionResponse{} }
+func (m *MsgCancelRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRedemptionResponse) ProtoMessage()    {}
+func (*MsgCancelRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c52aa4b3b498950, []int{9}
+}
+func (m *MsgCancelRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRedemptionResponse.Merge(m, src)
+}
+func (m *MsgCancelRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRedemptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRedemptionResponse proto.InternalMessageInfo
+
+func (m *MsgCancelRedemptionResponse) GetStable() types.Coin {
+	if m != nil {
+		return m.Stable
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*MsgMintStable)(nil), "nibiru.stablecoin.v1.MsgMintStable")
 	proto.RegisterType((*MsgMintStableResponse)(nil), "nibiru.stablecoin.v1.MsgMintStableResponse")
@@ -475,49 +635,60 @@ func init() {
 	proto.RegisterType((*MsgRecollateralizeResponse)(nil), "nibiru.stablecoin.v1.MsgRecollateralizeResponse")
 	proto.RegisterType((*MsgBuyback)(nil), "nibiru.stablecoin.v1.MsgBuyback")
 	proto.RegisterType((*MsgBuybackResponse)(nil), "nibiru.stablecoin.v1.MsgBuybackResponse")
+	proto.RegisterType((*MsgCancelRedemption)(nil), "nibiru.stablecoin.v1.MsgCancelRedemption")
+	proto.RegisterType((*MsgCancelRedemptionResponse)(nil), "nibiru.stablecoin.v1.MsgCancelRedemptionResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/stablecoin/v1/tx.proto", fileDescriptor_7c52aa4b3b498950) }
 
 var fileDescriptor_7c52aa4b3b498950 = []byte{
-	// 580 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0x31, 0x6f, 0x13, 0x31,
-	0x14, 0xc7, 0xe3, 0x24, 0x6a, 0xd5, 0x87, 0x10, 0xd2, 0xa9, 0x48, 0xe9, 0x51, 0xae, 0xe1, 0x58,
-	0x22, 0xa1, 0xda, 0x49, 0x3b, 0x30, 0x22, 0xa5, 0x13, 0x48, 0x01, 0x14, 0x26, 0x58, 0x2a, 0xdf,
-	0xc5, 0x5c, 0x4d, 0x13, 0x3b, 0x3a, 0x3b, 0x51, 0xc3, 0xd8, 0x4f, 0x50, 0x89, 0x99, 0x1d, 0xf1,
-	0x49, 0x3a, 0x56, 0x62, 0x61, 0x02, 0x94, 0xf0, 0x1d, 0x58, 0x91, 0x9d, 0x4b, 0x72, 0x6d, 0xda,
-	0xe3, 0x32, 0x94, 0x29, 0x4e, 0xfc, 0x7f, 0xef, 0xfd, 0xde, 0xff, 0xd9, 0x0e, 0x3c, 0x14, 0x3c,
-	0xe0, 0xf1, 0x80, 0x28, 0x4d, 0x83, 0x2e, 0x0b, 0x25, 0x17, 0x64, 0xd8, 0x20, 0xfa, 0x04, 0xf7,
-	0x63, 0xa9, 0xa5, 0xb3, 0x39, 0xdd, 0xc6, 0x8b, 0x6d, 0x3c, 0x6c, 0xb8, 0x5e, 0x28, 0x55, 0x4f,
-	0x2a, 0x12, 0x50, 0xc5, 0xc8, 0xb0, 0x11, 0x30, 0x4d, 0x1b, 0xc4, 0x6e, 0xda, 0x28, 0x77, 0x33,
-	0x92, 0x91, 0xb4, 0x4b, 0x62, 0x56, 0xc9, 0xaf, 0xdb, 0x91, 0x94, 0x51, 0x97, 0x11, 0xda, 0xe7,
-	0x84, 0x0a, 0x21, 0x35, 0xd5, 0x5c, 0x0a, 0x35, 0xdd, 0xf5, 0x03, 0xb8, 0xdb, 0x52, 0x51, 0x8b,
-	0x0b, 0xfd, 0xc6, 0xd6, 0x72, 0x2a, 0xb0, 0x1e, 0xc6, 0x8c, 0x6a, 0x19, 0x57, 0x50, 0x15, 0xd5,
-	0x36, 0xda, 0xb3, 0xaf, 0xce, 0x53, 0x58, 0x9b, 0xf2, 0x54, 0x8a, 0x55, 0x54, 0xbb, 0xb3, 0xb7,
-	0x85, 0xa7, 0x3c, 0xd8, 0xf0, 0xe0, 0x84, 0x07, 0x1f, 0x48, 0x2e, 0x9a, 0xe5, 0xf3, 0x1f, 0x3b,
-	0x85, 0x76, 0x22, 0xf7, 0xbf, 0x14, 0xe1, 0xfe, 0xa5, 0x22, 0x6d, 0xa6, 0xfa, 0x52, 0x28, 0x96,
-	0x4a, 0x89, 0x56, 0x4a, 0xe9, 0x7c, 0x00, 0x18, 0x28, 0xd6, 0x39, 0x34, 0xdd, 0xab, 0x4a, 0xb1,
-	0x5a, 0xca, 0x0e, 0xae, 0x9b, 0xe0, 0xaf, 0x3f, 0x77, 0x6a, 0x11, 0xd7, 0x47, 0x83, 0x00, 0x87,
-	0xb2, 0x47, 0x12, 0x33, 0xa7, 0x1f, 0xbb, 0xaa, 0x73, 0x4c, 0xf4, 0xa8, 0xcf, 0x94, 0x0d, 0x50,
-	0xed, 0x0d, 0x93, 0xde, 0x2e, 0x4d, 0xad, 0xf7, 0x8c, 0xa9, 0xc3, 0x3e, 0x1d, 0xb1, 0x4e, 0xa5,
-	0x74, 0x0b, 0xb5, 0x4c, 0xfa, 0xd7, 0x26, 0x7b, 0x32, 0x8e, 0xe6, 0x20, 0x16, 0xb7, 0x37, 0x8e,
-	0x3f, 0xc8, 0x8e, 0x63, 0x51, 0x64, 0x3e, 0x8e, 0x67, 0x00, 0xa1, 0xec, 0x76, 0xa9, 0x66, 0x31,
-	0xed, 0xe6, 0x1d, 0x49, 0x2a, 0xc4, 0x69, 0x40, 0x29, 0x92, 0xc3, 0xbc, 0x40, 0x46, 0xfb, 0x5f,
-	0xdd, 0x0d, 0xc1, 0x69, 0xa9, 0xa8, 0xcd, 0x16, 0xc4, 0xfc, 0x63, 0x96, 0xc5, 0xfb, 0x50, 0x36,
-	0xd2, 0xbc, 0xfd, 0x58, 0xb1, 0xff, 0x0a, 0xdc, 0xe5, 0x22, 0x73, 0x8b, 0x13, 0x87, 0x50, 0x7e,
-	0x87, 0xfc, 0xb7, 0x00, 0x76, 0x5c, 0xa3, 0x80, 0x86, 0xc7, 0x19, 0xb4, 0xab, 0x9b, 0xef, 0x3f,
-	0xb7, 0x86, 0x24, 0xa9, 0xe7, 0x8c, 0xb3, 0xb6, 0xd1, 0x0a, 0x6d, 0xef, 0x7d, 0x2e, 0x43, 0xa9,
-	0xa5, 0x22, 0xe7, 0x14, 0x01, 0xa4, 0x9e, 0x93, 0xc7, 0xf8, 0xba, 0xa7, 0x0c, 0x5f, 0x7a, 0x0e,
-	0xdc, 0x27, 0x39, 0x44, 0x33, 0x3a, 0xdf, 0x3f, 0xfd, 0xf6, 0xfb, 0x53, 0x71, 0xdb, 0x77, 0xc9,
-	0xf2, 0x1b, 0xda, 0xe3, 0x42, 0xef, 0xaa, 0xd0, 0x42, 0xa4, 0x2e, 0xd1, 0xcd, 0x10, 0x0b, 0x51,
-	0x06, 0xc4, 0xf2, 0x4d, 0xc9, 0x84, 0x08, 0x06, 0xb1, 0x30, 0x10, 0x67, 0x08, 0xee, 0x5d, 0x3d,
-	0x6b, 0xb5, 0x1b, 0x8b, 0x5c, 0x51, 0xba, 0xf5, 0xbc, 0xca, 0x39, 0xd3, 0x23, 0xcb, 0xf4, 0xc0,
-	0xdf, 0xba, 0x86, 0x29, 0xb6, 0x31, 0xce, 0x08, 0xd6, 0x67, 0xe7, 0xa8, 0x9a, 0xd1, 0xae, 0x55,
-	0xb8, 0xb5, 0x7f, 0x29, 0x72, 0xba, 0x61, 0xb5, 0xcd, 0x17, 0xe7, 0x63, 0x0f, 0x5d, 0x8c, 0x3d,
-	0xf4, 0x6b, 0xec, 0xa1, 0xb3, 0x89, 0x57, 0xb8, 0x98, 0x78, 0x85, 0xef, 0x13, 0xaf, 0xf0, 0xae,
-	0x9e, 0xba, 0xca, 0x2f, 0x6d, 0xfc, 0xc1, 0x11, 0xe5, 0x62, 0x96, 0xeb, 0x24, 0x9d, 0xcd, 0x5e,
-	0xec, 0x60, 0xcd, 0xfe, 0x77, 0xed, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x81, 0x2d, 0x97,
-	0x46, 0x07, 0x00, 0x00,
+	// 723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0xcd, 0x24, 0x69, 0xfa, 0x7a, 0x5f, 0xab, 0xf7, 0xe4, 0xd7, 0x87, 0x5c, 0xb7, 0x75, 0x83,
+	0x8b, 0x90, 0x51, 0xa9, 0xdd, 0xb4, 0x0b, 0x96, 0x48, 0x6d, 0x37, 0x45, 0x0a, 0x20, 0x83, 0x58,
+	0xb0, 0x89, 0xfc, 0x31, 0xb8, 0xa6, 0xc9, 0x4c, 0xf0, 0xd8, 0x51, 0xc3, 0x8e, 0xfe, 0x82, 0x4a,
+	0xec, 0x59, 0x23, 0x7e, 0x04, 0xeb, 0x2e, 0x2b, 0xb1, 0x61, 0x05, 0xa8, 0xe5, 0x57, 0xb0, 0x42,
+	0x33, 0x76, 0xe2, 0xb4, 0x49, 0x53, 0x17, 0x04, 0xac, 0x62, 0xcf, 0x9c, 0x7b, 0xcf, 0x99, 0x7b,
+	0xee, 0x5c, 0x07, 0x16, 0x49, 0xe0, 0x04, 0x61, 0x6c, 0xb2, 0xc8, 0x76, 0x9a, 0xd8, 0xa5, 0x01,
+	0x31, 0x3b, 0x35, 0x33, 0xda, 0x37, 0xda, 0x21, 0x8d, 0xa8, 0x34, 0x9b, 0x6c, 0x1b, 0xd9, 0xb6,
+	0xd1, 0xa9, 0x29, 0xaa, 0x4b, 0x59, 0x8b, 0x32, 0xd3, 0xb1, 0x19, 0x36, 0x3b, 0x35, 0x07, 0x47,
+	0x76, 0xcd, 0x14, 0x9b, 0x22, 0x4a, 0x99, 0xf5, 0xa9, 0x4f, 0xc5, 0xa3, 0xc9, 0x9f, 0xd2, 0xd5,
+	0x05, 0x9f, 0x52, 0xbf, 0x89, 0x4d, 0xbb, 0x1d, 0x98, 0x36, 0x21, 0x34, 0xb2, 0xa3, 0x80, 0x12,
+	0x96, 0xec, 0x6a, 0xaf, 0x10, 0xcc, 0xd4, 0x99, 0x5f, 0x0f, 0x48, 0xf4, 0x48, 0x90, 0x49, 0x32,
+	0x4c, 0xba, 0x21, 0xb6, 0x23, 0x1a, 0xca, 0xa8, 0x8a, 0xf4, 0x29, 0xab, 0xf7, 0x2a, 0xdd, 0x81,
+	0x4a, 0x22, 0x48, 0x2e, 0x56, 0x91, 0xfe, 0xf7, 0xfa, 0x9c, 0x91, 0x08, 0x32, 0xb8, 0x20, 0x23,
+	0x15, 0x64, 0x6c, 0xd1, 0x80, 0x6c, 0x96, 0x8f, 0x3e, 0x2d, 0x15, 0xac, 0x14, 0x2e, 0x2d, 0x02,
+	0xb8, 0xb4, 0xd9, 0x6c, 0x78, 0x98, 0xd0, 0x96, 0x5c, 0x12, 0x59, 0xa7, 0xf8, 0xca, 0x36, 0x5f,
+	0xd0, 0xde, 0x16, 0xe1, 0xff, 0x33, 0x1a, 0x2c, 0xcc, 0xda, 0x94, 0x30, 0x3c, 0xc0, 0x88, 0xae,
+	0xc6, 0xf8, 0x1c, 0x20, 0x66, 0xd8, 0x6b, 0xf0, 0xea, 0x30, 0xb9, 0x58, 0x2d, 0x8d, 0x0f, 0x5e,
+	0xe3, 0xc1, 0xef, 0x3e, 0x2f, 0xe9, 0x7e, 0x10, 0xed, 0xc6, 0x8e, 0xe1, 0xd2, 0x96, 0x99, 0x16,
+	0x3b, 0xf9, 0x59, 0x65, 0xde, 0x9e, 0x19, 0x75, 0xdb, 0x98, 0x89, 0x00, 0x66, 0x4d, 0xf1, 0xf4,
+	0xe2, 0x91, 0x73, 0x3d, 0xc3, 0x98, 0x35, 0xda, 0x76, 0x17, 0x7b, 0x72, 0xe9, 0x17, 0x70, 0xf1,
+	0xf4, 0x0f, 0x79, 0xf6, 0x9e, 0x5d, 0x9b, 0x71, 0x48, 0xfe, 0x98, 0x5d, 0xdf, 0x12, 0xbb, 0x32,
+	0x0d, 0x7d, 0xbb, 0xee, 0x26, 0x81, 0x76, 0x84, 0x43, 0xbb, 0x99, 0xd7, 0xb2, 0x81, 0x10, 0xa9,
+	0x06, 0x25, 0x9f, 0x76, 0xf2, 0xea, 0xe5, 0xd8, 0xdf, 0x59, 0x7d, 0xe9, 0x1a, 0x54, 0x5e, 0xc4,
+	0x38, 0xc6, 0x9e, 0x5c, 0xae, 0x22, 0xfd, 0x2f, 0x2b, 0x7d, 0x93, 0x96, 0x61, 0x26, 0xc4, 0x1e,
+	0x6e, 0xb5, 0xf9, 0xcd, 0x6a, 0x04, 0x9e, 0x3c, 0x51, 0x45, 0x7a, 0xd9, 0x9a, 0xce, 0x16, 0x77,
+	0x3c, 0x69, 0x1b, 0x66, 0x12, 0x78, 0x23, 0x75, 0xa5, 0x92, 0xef, 0x94, 0xd3, 0x49, 0x54, 0x52,
+	0x6a, 0xcd, 0x05, 0xa9, 0xce, 0x7c, 0x0b, 0x67, 0x45, 0x0b, 0x5e, 0x8e, 0x6b, 0x82, 0x0d, 0x28,
+	0x73, 0x68, 0xde, 0x92, 0x0a, 0xb0, 0xf6, 0x00, 0x94, 0x61, 0x92, 0xbe, 0xcb, 0xa9, 0x49, 0x28,
+	0xbf, 0x49, 0xda, 0x3e, 0x80, 0xe8, 0x98, 0xae, 0x63, 0xbb, 0x7b, 0x63, 0xd4, 0xfe, 0x80, 0xff,
+	0x97, 0x34, 0xeb, 0x0e, 0x48, 0x19, 0x73, 0xff, 0x08, 0xbd, 0xaa, 0xa0, 0xab, 0x54, 0xe5, 0x31,
+	0xfc, 0x57, 0x67, 0xfe, 0x96, 0x4d, 0x5c, 0xdc, 0xb4, 0xfa, 0xce, 0x8e, 0x39, 0xcd, 0x50, 0x5b,
+	0x14, 0x87, 0xdb, 0x42, 0x7b, 0x02, 0xf3, 0x23, 0xb2, 0xfe, 0xf4, 0x04, 0x5c, 0x7f, 0x3f, 0x01,
+	0xa5, 0x3a, 0xf3, 0xa5, 0x03, 0x04, 0x30, 0x30, 0xdd, 0x97, 0x8d, 0x51, 0x9f, 0x16, 0xe3, 0xcc,
+	0xf8, 0x55, 0x56, 0x72, 0x80, 0x7a, 0x0a, 0x35, 0xed, 0xe0, 0xc3, 0xd7, 0xd7, 0xc5, 0x05, 0x4d,
+	0x31, 0x87, 0xbf, 0x69, 0xad, 0x80, 0x44, 0xab, 0xcc, 0x15, 0x22, 0x06, 0x66, 0xd6, 0xc5, 0x22,
+	0x32, 0x90, 0xb2, 0x92, 0x03, 0x94, 0x4b, 0x84, 0x13, 0x87, 0x84, 0x8b, 0x38, 0x44, 0xf0, 0xcf,
+	0xf9, 0x8b, 0xa3, 0x5f, 0x48, 0x72, 0x0e, 0xa9, 0xac, 0xe5, 0x45, 0xf6, 0x35, 0x5d, 0x17, 0x9a,
+	0xe6, 0xb5, 0xb9, 0x11, 0x9a, 0x42, 0x11, 0x23, 0x75, 0x61, 0xb2, 0x77, 0x29, 0xaa, 0x63, 0x8e,
+	0x2b, 0x10, 0x8a, 0x7e, 0x19, 0x22, 0x67, 0x35, 0x12, 0xbe, 0x37, 0x08, 0xfe, 0x1d, 0xea, 0xe5,
+	0x5b, 0x17, 0x52, 0x9c, 0x87, 0x2a, 0xb5, 0xdc, 0xd0, 0xbe, 0xac, 0xdb, 0x42, 0xd6, 0x4d, 0xed,
+	0xc6, 0x08, 0x59, 0xae, 0x08, 0x5a, 0xcd, 0xae, 0xc6, 0xe6, 0xbd, 0xa3, 0x13, 0x15, 0x1d, 0x9f,
+	0xa8, 0xe8, 0xcb, 0x89, 0x8a, 0x0e, 0x4f, 0xd5, 0xc2, 0xf1, 0xa9, 0x5a, 0xf8, 0x78, 0xaa, 0x16,
+	0x9e, 0xae, 0x0d, 0xcc, 0xee, 0xfb, 0x22, 0xd3, 0xd6, 0xae, 0x1d, 0x90, 0x5e, 0xd6, 0xfd, 0xc1,
+	0xbc, 0x62, 0x92, 0x3b, 0x15, 0xf1, 0x67, 0x67, 0xe3, 0xfb, 0x00, 0x3f, 0xa6, 0xc7, 0x2e, 0x77,
+	0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -533,20 +704,23 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	// MintStable defines a method for trading a mixture of GOV and COLL to mint
-	// an equivalent value of stablecoins.
+	//an equivalent value of stablecoins.
 	MintStable(ctx context.Context, in *MsgMintStable, opts ...grpc.CallOption) (*MsgMintStableResponse, error)
 	// BurnStable defines a method for redeeming/burning stablecoins to receive an
-	// equivalent value as a mixture of governance and collateral tokens.
+	//equivalent value as a mixture of governance and collateral tokens.
 	BurnStable(ctx context.Context, in *MsgBurnStable, opts ...grpc.CallOption) (*MsgBurnStableResponse, error)
 	// Recollateralize defines a method for manually adding collateral to the
-	// protocol in exchange for an equivalent stablecoin value in governance tokens
-	// plus a small bonus.
+	//protocol in exchange for an equivalent stablecoin value in governance tokens
+	//plus a small bonus.
 	Recollateralize(ctx context.Context, in *MsgRecollateralize, opts ...grpc.CallOption) (*MsgRecollateralizeResponse, error)
 	// Buyback defines a method for manually adding NIBI to the protocol
-	// in exchange for an equivalent stablecoin value in collateral, effectively
-	// executing a share buyback for Nibiru Chain. The NIBI purchased by the protocol
-	// is then burned, distributing value to all NIBI hodlers.
+	//in exchange for an equivalent stablecoin value in collateral, effectively
+	//executing a share buyback for Nibiru Chain. The NIBI purchased by the protocol
+	//is then burned, distributing value to all NIBI hodlers.
 	Buyback(ctx context.Context, in *MsgBuyback, opts ...grpc.CallOption) (*MsgBuybackResponse, error)
+	// CancelRedemption defines a method for removing a redemption from the
+	//redemption queue, giving its held stablecoins back to its owner.
+	CancelRedemption(ctx context.Context, in *MsgCancelRedemption, opts ...grpc.CallOption) (*MsgCancelRedemptionResponse, error)
 }
 
 type msgClient struct {
@@ -593,23 +767,35 @@ func (c *msgClient) Buyback(ctx context.Context, in *MsgBuyback, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) CancelRedemption(ctx context.Context, in *MsgCancelRedemption, opts ...grpc.CallOption) (*MsgCancelRedemptionResponse, error) {
+	out := new(MsgCancelRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.stablecoin.v1.Msg/CancelRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// MintStable defines a method for trading a mixture of GOV and COLL to mint
-	// an equivalent value of stablecoins.
+	//an equivalent value of stablecoins.
 	MintStable(context.Context, *MsgMintStable) (*MsgMintStableResponse, error)
 	// BurnStable defines a method for redeeming/burning stablecoins to receive an
-	// equivalent value as a mixture of governance and collateral tokens.
+	//equivalent value as a mixture of governance and collateral tokens.
 	BurnStable(context.Context, *MsgBurnStable) (*MsgBurnStableResponse, error)
 	// Recollateralize defines a method for manually adding collateral to the
-	// protocol in exchange for an equivalent stablecoin value in governance tokens
-	// plus a small bonus.
+	//protocol in exchange for an equivalent stablecoin value in governance tokens
+	//plus a small bonus.
 	Recollateralize(context.Context, *MsgRecollateralize) (*MsgRecollateralizeResponse, error)
 	// Buyback defines a method for manually adding NIBI to the protocol
-	// in exchange for an equivalent stablecoin value in collateral, effectively
-	// executing a share buyback for Nibiru Chain. The NIBI purchased by the protocol
-	// is then burned, distributing value to all NIBI hodlers.
+	//in exchange for an equivalent stablecoin value in collateral, effectively
+	//executing a share buyback for Nibiru Chain. The NIBI purchased by the protocol
+	//is then burned, distributing value to all NIBI hodlers.
 	Buyback(context.Context, *MsgBuyback) (*MsgBuybackResponse, error)
+	// CancelRedemption defines a method for removing a redemption from the
+	//redemption queue, giving its held stablecoins back to its owner.
+	CancelRedemption(context.Context, *MsgCancelRedemption) (*MsgCancelRedemptionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -628,6 +814,9 @@ func (*UnimplementedMsgServer) Recollateralize(ctx context.Context, req *MsgReco
 func (*UnimplementedMsgServer) Buyback(ctx context.Context, req *MsgBuyback) (*MsgBuybackResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Buyback not implemented")
 }
+func (*UnimplementedMsgServer) CancelRedemption(ctx context.Context, req *MsgCancelRedemption) (*MsgCancelRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRedemption not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -705,6 +894,24 @@ func _Msg_Buyback_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.stablecoin.v1.Msg/CancelRedemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRedemption(ctx, req.(*MsgCancelRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.stablecoin.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -725,6 +932,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Buyback",
 			Handler:    _Msg_Buyback_Handler,
 		},
+		{
+			MethodName: "CancelRedemption",
+			Handler:    _Msg_CancelRedemption_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nibiru/stablecoin/v1/tx.proto",
@@ -750,6 +961,13 @@ func (m *MsgMintStable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CollDenom) > 0 {
+		i -= len(m.CollDenom)
+		copy(dAtA[i:], m.CollDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Stable.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -851,6 +1069,13 @@ func (m *MsgBurnStable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CollDenom) > 0 {
+		i -= len(m.CollDenom)
+		copy(dAtA[i:], m.CollDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Stable.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -891,6 +1116,31 @@ func (m *MsgBurnStableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.QueuedStable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.RedemptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedemptionId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Queued {
+		i--
+		if m.Queued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.FeesPayed) > 0 {
 		for iNdEx := len(m.FeesPayed) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1021,6 +1271,13 @@ func (m *MsgBuyback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CollDenom) > 0 {
+		i -= len(m.CollDenom)
+		copy(dAtA[i:], m.CollDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Gov.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1074,6 +1331,74 @@ func (m *MsgBuybackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RedemptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedemptionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1097,6 +1422,10 @@ func (m *MsgMintStable) Size() (n int) {
 	}
 	l = m.Stable.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1135,6 +1464,10 @@ func (m *MsgBurnStable) Size() (n int) {
 	}
 	l = m.Stable.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1154,6 +1487,14 @@ func (m *MsgBurnStableResponse) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	if m.Queued {
+		n += 2
+	}
+	if m.RedemptionId != 0 {
+		n += 1 + sovTx(uint64(m.RedemptionId))
+	}
+	l = m.QueuedStable.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1195,6 +1536,10 @@ func (m *MsgBuyback) Size() (n int) {
 	}
 	l = m.Gov.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.CollDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1209,6 +1554,33 @@ func (m *MsgBuybackResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RedemptionId != 0 {
+		n += 1 + sovTx(uint64(m.RedemptionId))
+	}
+	return n
+}
+
+func (m *MsgCancelRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stable.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1309,6 +1681,38 @@ func (m *MsgMintStable) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1575,10 +1979,42 @@ func (m *MsgBurnStable) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
@@ -1725,6 +2161,78 @@ func (m *MsgBurnStableResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queued = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
+			}
+			m.RedemptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedStable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueuedStable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2038,6 +2546,38 @@ func (m *MsgBuyback) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2142,6 +2682,190 @@ func (m *MsgBuybackResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
+			}
+			m.RedemptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
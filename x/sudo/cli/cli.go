@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/NibiruChain/nibiru/x/sudo/types"
@@ -15,6 +16,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// FlagContract is the address of a sudo contract.
+const FlagContract = "contract"
+
 // GetTxCmd returns a cli command for this module's transactions
 func GetTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -29,6 +33,9 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		CmdEditSudoers(),
 		CmdChangeRoot(),
+		CmdUpdateRootConfig(),
+		CmdApproveRootAction(),
+		CmdCancelRootAction(),
 	)
 
 	return txCmd
@@ -48,6 +55,10 @@ func GetQueryCmd() *cobra.Command {
 	// Add subcommands
 	cmds := []*cobra.Command{
 		CmdQuerySudoers(),
+		CmdQueryPermissions(),
+		CmdQueryRootConfig(),
+		CmdQueryPendingRootActions(),
+		CmdQueryAuditLog(),
 	}
 	for _, cmd := range cmds {
 		moduleQueryCmd.AddCommand(cmd)
@@ -77,7 +88,21 @@ func CmdEditSudoers() *cobra.Command {
 			  "contracts": "..."
 			}
 
-			- Valid action types: "add_contracts", "remove_contracts"	
+			- Valid action types: "add_contracts", "remove_contracts",
+			  "grant_permissions", "revoke_permissions"
+
+			The "grant_permissions" and "revoke_permissions" actions take the
+			permissions of the contracts:
+			{
+			  "action": "grant_permissions",
+			  "contracts": ["..."],
+			  "permissions": [{
+			    "action": "peg_shift",
+			    "pair": "ubtc:unusd",
+			    "max_calls_per_epoch": "1",
+			    "epoch_identifier": "day"
+			  }]
+			}
 			`),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -159,6 +184,132 @@ func CmdChangeRoot() *cobra.Command {
 	return cmd
 }
 
+// CmdUpdateRootConfig is a terminal command corresponding to the
+// UpdateRootConfig function of the sdk.Msg handler for x/sudo.
+func CmdUpdateRootConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-root-config [config-json]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Update the timelock and the approvals required by the root actions",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example: 
+			$ %s tx sudo update-root-config <path/to/config.json> --from=<key_or_address>
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Updates the configuration of the root actions. Once the timelock is
+			set or more than one approval is required, the root actions are
+			queued and executed after the timelock, when enough signers
+			approved them.
+
+			The config.json is of the form:
+			{
+			  "timelock_blocks": "100",
+			  "guardian": "...",
+			  "signers": ["..."],
+			  "threshold": 2
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := new(types.MsgUpdateRootConfig)
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			if err = clientCtx.Codec.UnmarshalJSON(contents, &msg.Config); err != nil {
+				return err
+			}
+
+			msg.Sender = clientCtx.GetFromAddress().String()
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdApproveRootAction is a terminal command corresponding to the
+// ApproveRootAction function of the sdk.Msg handler for x/sudo.
+func CmdApproveRootAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-root-action [action-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Approve a pending root action as one of the root signers",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			actionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgApproveRootAction{
+				Sender:   clientCtx.GetFromAddress().String(),
+				ActionId: actionID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdCancelRootAction is a terminal command corresponding to the
+// CancelRootAction function of the sdk.Msg handler for x/sudo.
+func CmdCancelRootAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-root-action [action-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a pending root action as the guardian or root",
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			actionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelRootAction{
+				Sender:   clientCtx.GetFromAddress().String(),
+				ActionId: actionID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdQuerySudoers() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "state",
@@ -188,3 +339,133 @@ func CmdQuerySudoers() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryPermissions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "permissions [contract]",
+		Short: "displays the permissions of a sudo contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.QueryPermissions(
+				cmd.Context(), &types.QueryPermissionsRequest{Contract: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryRootConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "root-config",
+		Short: "displays the timelock and the approvals required by the root actions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.QueryRootConfig(
+				cmd.Context(), &types.QueryRootConfigRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPendingRootActions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-root-actions",
+		Short: "displays the root actions queued behind the timelock",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.QueryPendingRootActions(
+				cmd.Context(), &types.QueryPendingRootActionsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAuditLog() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit-log",
+		Short: "displays the most recent privileged executions of the sudo contracts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := cmd.Flags().GetString(FlagContract)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			resp, err := queryClient.QueryAuditLog(
+				cmd.Context(), &types.QueryAuditLogRequest{
+					Contract:   contract,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	cmd.Flags().String(FlagContract, "", "only display the records of the sudo contract")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "audit-log")
+
+	return cmd
+}
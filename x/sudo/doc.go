@@ -20,4 +20,32 @@ Note that this package does not provide actual system integration or execute
 commands with elevated privileges. It only offers a way to manage and verify
 permissions in a sudoers-like manner within your application.
 
+Permissions
+
+By default, a sudo contract may execute every permissioned action of the
+x/wasm bindings. The root user can restrict a contract with the
+"grant_permissions" and "revoke_permissions" actions of MsgEditSudoers. Each
+permission allows a single action (e.g. "peg_shift"), optionally scoped to a
+pair and capped to a number of calls per epoch. A shifter contract can, for
+example, be limited to one "peg_shift" on "ubtc:unusd" per day. The calls are
+reset at the end of every epoch with the permission's epoch identifier.
+
+Timelock
+
+The root actions (MsgEditSudoers, MsgChangeRoot and MsgUpdateRootConfig) are
+executed immediately by default. Once the RootConfig sets a timelock or a
+threshold above one, they are queued as pending root actions instead. A
+pending root action is executed at the end of the first block past its
+timelock in which it has the approvals of at least "threshold" signers, the
+root included. The guardian or the root can cancel it until then. A pending
+root action that doesn't gather its approvals within RootActionExpiryBlocks
+blocks past its timelock expires and is pruned from the queue.
+
+Audit log
+
+Every privileged binding call of a sudo contract is recorded in the audit log
+with the contract, the action, the hash of the JSON payload, the block height
+and the result, and emitted as an EventSudoExecution. Only the most recent
+MaxAuditRecords records are kept in state.
+
 */
@@ -1,6 +1,7 @@
 package sudo
 
 import (
+	"github.com/NibiruChain/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/x/sudo/keeper"
@@ -13,6 +14,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		panic(err)
 	}
 	k.Sudoers.Set(ctx, genState.Sudoers)
+	for _, permissions := range genState.Permissions {
+		k.Permissions.Insert(ctx, permissions.Contract, permissions)
+	}
+	k.RootConfig.Set(ctx, genState.RootConfig)
+	for _, action := range genState.PendingRootActions {
+		k.PendingRootActions.Insert(ctx, action.Id, action)
+	}
+	k.NextPendingRootActionId.Set(ctx, genState.NextPendingRootActionId)
+	for _, record := range genState.AuditLog {
+		k.AuditLog.Insert(ctx, record.Id, record)
+	}
+	k.NextAuditRecordId.Set(ctx, genState.NextAuditRecordId)
 }
 
 // ExportGenesis returns the module's exported genesis state.
@@ -24,7 +37,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 
 	return &types.GenesisState{
-		Sudoers: pbSudoers,
+		Sudoers:     pbSudoers,
+		Permissions: k.Permissions.Iterate(ctx, collections.Range[string]{}).Values(),
+		RootConfig:  k.GetRootConfig(ctx),
+		PendingRootActions: k.PendingRootActions.Iterate(
+			ctx, collections.Range[uint64]{}).Values(),
+		NextPendingRootActionId: k.NextPendingRootActionId.Peek(ctx),
+		AuditLog:                k.AuditLog.Iterate(ctx, collections.Range[uint64]{}).Values(),
+		NextAuditRecordId:       k.NextAuditRecordId.Peek(ctx),
 	}
 }
 
@@ -34,5 +54,11 @@ func DefaultGenesis() *types.GenesisState {
 			Root:      "",
 			Contracts: []string{},
 		},
+		Permissions:             []types.ContractPermissions{},
+		RootConfig:              types.DefaultRootConfig(),
+		PendingRootActions:      []types.PendingRootAction{},
+		NextPendingRootActionId: collections.DefaultSequenceStart,
+		AuditLog:                []types.AuditRecord{},
+		NextAuditRecordId:       collections.DefaultSequenceStart,
 	}
 }
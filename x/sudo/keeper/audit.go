@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	sudotypes "github.com/NibiruChain/nibiru/x/sudo/types"
+)
+
+// RecordExecution adds a privileged binding call of a sudo contract to the
+// audit log and emits it as an event. Only the last MaxAuditRecords records
+// are kept. Note that a failed call is only recorded if its error does not
+// revert the execution of the contract.
+func (k Keeper) RecordExecution(
+	ctx sdk.Context,
+	contract sdk.AccAddress,
+	action sudotypes.SudoAction,
+	payload []byte,
+	execErr error,
+) sudotypes.AuditRecord {
+	record := sudotypes.NewAuditRecord(contract, action, payload, ctx.BlockHeight(), execErr)
+	record.Id = k.NextAuditRecordId.Next(ctx)
+	k.AuditLog.Insert(ctx, record.Id, record)
+	if record.Id >= sudotypes.MaxAuditRecords {
+		_ = k.AuditLog.Delete(ctx, record.Id-sudotypes.MaxAuditRecords)
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&sudotypes.EventSudoExecution{Record: record})
+	return record
+}
+
+// PaginateAuditLog returns a page of the audit log, in ascending order of id
+// unless reversed. Only the records of the contract are returned if it is set.
+func (k Keeper) PaginateAuditLog(
+	ctx sdk.Context, contract string, pagination *query.PageRequest,
+) (records []sudotypes.AuditRecord, pageRes *query.PageResponse, err error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), auditLogNamespace.Prefix())
+	pageRes, err = query.FilteredPaginate(
+		store,
+		pagination,
+		func(_ []byte, value []byte, accumulate bool) (bool, error) {
+			var record sudotypes.AuditRecord
+			if err := k.cdc.Unmarshal(value, &record); err != nil {
+				return false, err
+			}
+			if contract != "" && record.Contract != contract {
+				return false, nil
+			}
+			if accumulate {
+				records = append(records, record)
+			}
+			return true, nil
+		},
+	)
+	return records, pageRes, err
+}
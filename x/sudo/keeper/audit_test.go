@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/sudo/keeper"
+	"github.com/NibiruChain/nibiru/x/sudo/types"
+)
+
+func TestRecordExecution(t *testing.T) {
+	nibiru, ctx := setup()
+	k := nibiru.SudoKeeper
+	contractA := testutil.AccAddress()
+	contractB := testutil.AccAddress()
+
+	record := k.RecordExecution(ctx, contractA, types.PegShift, []byte(`{"msg":{}}`), nil)
+	require.EqualValues(t, 1, record.Id)
+	require.True(t, record.Success)
+	require.Len(t, record.PayloadHash, 64)
+	require.Equal(t, ctx.BlockHeight(), record.BlockHeight)
+
+	record = k.RecordExecution(ctx, contractB, types.InsuranceFundWithdraw, []byte(`{}`), errors.New("boom"))
+	require.False(t, record.Success)
+	require.Equal(t, "boom", record.Error)
+	k.RecordExecution(ctx, contractA, types.DepthShift, []byte(`{}`), nil)
+
+	querier := keeper.NewQuerier(k)
+	resp, err := querier.QueryAuditLog(sdk.WrapSDKContext(ctx), &types.QueryAuditLogRequest{
+		Contract: contractA.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 2)
+	require.Equal(t, string(types.PegShift), resp.Records[0].Action)
+	require.Equal(t, string(types.DepthShift), resp.Records[1].Action)
+
+	resp, err = querier.QueryAuditLog(sdk.WrapSDKContext(ctx), &types.QueryAuditLogRequest{
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 1)
+	require.EqualValues(t, 3, resp.Records[0].Id)
+	require.NotNil(t, resp.Pagination.NextKey)
+}
+
+func TestRecordExecution_Bounded(t *testing.T) {
+	nibiru, ctx := setup()
+	k := nibiru.SudoKeeper
+	contract := testutil.AccAddress()
+
+	k.AuditLog.Insert(ctx, 1, types.AuditRecord{Id: 1, Contract: contract.String()})
+	k.NextAuditRecordId.Set(ctx, types.MaxAuditRecords)
+
+	k.RecordExecution(ctx, contract, types.PegShift, nil, nil)
+	_, err := k.AuditLog.Get(ctx, 1)
+	require.NoError(t, err)
+
+	k.RecordExecution(ctx, contract, types.PegShift, nil, nil)
+	_, err = k.AuditLog.Get(ctx, 1)
+	require.Error(t, err, "the oldest record is evicted")
+}
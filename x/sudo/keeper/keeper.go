@@ -15,14 +15,76 @@ import (
 
 type Keeper struct {
 	Sudoers collections.Item[sudotypes.Sudoers]
+	// Permissions: The permissions of the sudo contracts, keyed by contract
+	// address. A sudo contract without permissions may execute every action.
+	Permissions collections.Map[string, sudotypes.ContractPermissions]
+	// RootConfig: The timelock and approvals required by the root actions.
+	RootConfig collections.Item[sudotypes.RootConfig]
+	// PendingRootActions: The root actions queued behind the timelock, keyed
+	// by id and indexed by execute height.
+	PendingRootActions      collections.IndexedMap[uint64, sudotypes.PendingRootAction, PendingRootActionIndexes]
+	NextPendingRootActionId collections.Sequence
+	// AuditLog: The most recent privileged executions of the sudo contracts,
+	// keyed by id.
+	AuditLog          collections.Map[uint64, sudotypes.AuditRecord]
+	NextAuditRecordId collections.Sequence
+
+	storeKey types.StoreKey
+	cdc      codec.BinaryCodec
+}
+
+type PendingRootActionIndexes struct {
+	// ExecuteHeight MultiIndex:
+	//  - indexing key (IK): block height from which the action can be executed
+	//  - primary key (PK): action id
+	//  - value (V): pending root action
+	ExecuteHeight collections.MultiIndex[uint64, uint64, sudotypes.PendingRootAction]
 }
 
+func (idxs PendingRootActionIndexes) IndexerList() []collections.Indexer[uint64, sudotypes.PendingRootAction] {
+	return []collections.Indexer[uint64, sudotypes.PendingRootAction]{idxs.ExecuteHeight}
+}
+
+// auditLogNamespace is the namespace of the audit log, used to paginate it.
+const auditLogNamespace collections.Namespace = 6
+
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey types.StoreKey,
 ) Keeper {
 	return Keeper{
 		Sudoers: collections.NewItem(storeKey, 1, SudoersValueEncoder(cdc)),
+		Permissions: collections.NewMap(
+			storeKey, 2,
+			collections.StringKeyEncoder,
+			collections.ProtoValueEncoder[sudotypes.ContractPermissions](cdc),
+		),
+		RootConfig: collections.NewItem(
+			storeKey, 3, collections.ProtoValueEncoder[sudotypes.RootConfig](cdc),
+		),
+		PendingRootActions: collections.NewIndexedMap[uint64, sudotypes.PendingRootAction](
+			storeKey, 4,
+			collections.Uint64KeyEncoder,
+			collections.ProtoValueEncoder[sudotypes.PendingRootAction](cdc),
+			PendingRootActionIndexes{
+				ExecuteHeight: collections.NewMultiIndex[uint64, uint64, sudotypes.PendingRootAction](
+					storeKey, 8,
+					collections.Uint64KeyEncoder, // index key (IK)
+					collections.Uint64KeyEncoder, // primary key (PK)
+					func(v sudotypes.PendingRootAction) uint64 { return uint64(v.ExecuteHeight) },
+				),
+			},
+		),
+		NextPendingRootActionId: collections.NewSequence(storeKey, 5),
+		AuditLog: collections.NewMap(
+			storeKey, auditLogNamespace,
+			collections.Uint64KeyEncoder,
+			collections.ProtoValueEncoder[sudotypes.AuditRecord](cdc),
+		),
+		NextAuditRecordId: collections.NewSequence(storeKey, 7),
+
+		storeKey: storeKey,
+		cdc:      cdc,
 	}
 }
 
@@ -103,6 +165,9 @@ func (k Keeper) RemoveContracts(
 	sudoers.RemoveContracts(msg.Contracts)
 	pbSudoers = sudoers.ToPb()
 	k.Sudoers.Set(ctx, pbSudoers)
+	for _, contract := range msg.Contracts {
+		_ = k.Permissions.Delete(ctx, contract)
+	}
 
 	msgResp = new(sudotypes.MsgEditSudoersResponse)
 	return msgResp, ctx.EventManager().EmitTypedEvent(&sudotypes.EventUpdateSudoers{
@@ -111,6 +176,107 @@ func (k Keeper) RemoveContracts(
 	})
 }
 
+// ————————————————————————————————————————————————————————————————————————————
+// GrantPermissions and RevokePermissions
+// ————————————————————————————————————————————————————————————————————————————
+
+// GrantPermissions executes a MsgEditSudoers message with action type
+// "grant_permissions". This adds the contracts to the sudoer set and grants
+// them the permissions. A contract that was allowed to execute every action
+// is restricted to the granted permissions.
+func (k Keeper) GrantPermissions(
+	goCtx context.Context, msg *sudotypes.MsgEditSudoers,
+) (msgResp *sudotypes.MsgEditSudoersResponse, err error) {
+	if msg.RootAction() != sudotypes.GrantPermissions {
+		err = fmt.Errorf("invalid action type %s for msg grant permissions", msg.Action)
+		return
+	}
+
+	// Read state
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pbSudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return
+	}
+	sudoers := SudoersFromPb(pbSudoers)
+	err = k.senderHasPermission(msg.Sender, sudoers.Root)
+	if err != nil {
+		return
+	}
+
+	// Update state
+	if _, err = sudoers.AddContracts(msg.Contracts); err != nil {
+		return
+	}
+	pbSudoers = sudoers.ToPb()
+	k.Sudoers.Set(ctx, pbSudoers)
+
+	for _, contract := range msg.Contracts {
+		permissions := k.Permissions.GetOr(
+			ctx, contract, sudotypes.ContractPermissions{Contract: contract})
+		permissions.Grant(msg.Permissions)
+		k.Permissions.Insert(ctx, contract, permissions)
+
+		if err = ctx.EventManager().EmitTypedEvent(&sudotypes.EventUpdatePermissions{
+			Permissions: permissions,
+			Action:      msg.Action,
+		}); err != nil {
+			return
+		}
+	}
+
+	msgResp = new(sudotypes.MsgEditSudoersResponse)
+	return msgResp, ctx.EventManager().EmitTypedEvent(&sudotypes.EventUpdateSudoers{
+		Sudoers: pbSudoers,
+		Action:  msg.Action,
+	})
+}
+
+// RevokePermissions executes a MsgEditSudoers message with action type
+// "revoke_permissions". This removes the permissions with the same action and
+// pair from the contracts. Revoking from a contract that was allowed to
+// execute every action leaves it with every other action.
+func (k Keeper) RevokePermissions(
+	goCtx context.Context, msg *sudotypes.MsgEditSudoers,
+) (msgResp *sudotypes.MsgEditSudoersResponse, err error) {
+	if msg.RootAction() != sudotypes.RevokePermissions {
+		err = fmt.Errorf("invalid action type %s for msg revoke permissions", msg.Action)
+		return
+	}
+
+	// Read state
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pbSudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return
+	}
+	sudoers := SudoersFromPb(pbSudoers)
+	err = k.senderHasPermission(msg.Sender, sudoers.Root)
+	if err != nil {
+		return
+	}
+
+	// Update state
+	for _, contract := range msg.Contracts {
+		if !sudoers.Contracts.Has(contract) {
+			return nil, fmt.Errorf("contract %s is not a sudo contract", contract)
+		}
+		permissions := k.Permissions.GetOr(
+			ctx, contract, sudotypes.NewUnrestrictedPermissions(contract))
+		permissions.Revoke(msg.Permissions)
+		k.Permissions.Insert(ctx, contract, permissions)
+
+		if err = ctx.EventManager().EmitTypedEvent(&sudotypes.EventUpdatePermissions{
+			Permissions: permissions,
+			Action:      msg.Action,
+		}); err != nil {
+			return
+		}
+	}
+
+	return new(sudotypes.MsgEditSudoersResponse), nil
+}
+
 // CheckPermissions Checks if a contract is contained within the set of sudo
 // contracts defined in the x/sudo module. These smart contracts are able to
 // execute certain permissioned functions.
@@ -132,3 +298,37 @@ func (k Keeper) CheckPermissions(
 	}
 	return nil
 }
+
+// CheckActionPermission checks if a sudo contract is allowed to execute the
+// action on the pair, and counts the call against the cap of the permission
+// that allows it. A sudo contract without permissions may execute every
+// action. Actions that do not act on a single market are checked with an
+// empty pair.
+func (k Keeper) CheckActionPermission(
+	ctx sdk.Context, contract sdk.AccAddress, action sudotypes.SudoAction, pair string,
+) error {
+	if err := k.CheckPermissions(contract, ctx); err != nil {
+		return err
+	}
+
+	permissions, err := k.Permissions.Get(ctx, contract.String())
+	if err != nil {
+		// no permissions: the contract may execute every action
+		return nil
+	}
+	if err := permissions.Allow(action, pair); err != nil {
+		return err
+	}
+	k.Permissions.Insert(ctx, contract.String(), permissions)
+	return nil
+}
+
+// ResetPermissionCalls resets the calls of the permissions capped over epochs
+// with the given identifier.
+func (k Keeper) ResetPermissionCalls(ctx sdk.Context, epochIdentifier string) {
+	for _, permissions := range k.Permissions.Iterate(ctx, collections.Range[string]{}).Values() {
+		if permissions.ResetCalls(epochIdentifier) {
+			k.Permissions.Insert(ctx, permissions.Contract, permissions)
+		}
+	}
+}
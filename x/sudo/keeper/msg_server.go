@@ -24,55 +24,81 @@ func NewMsgServer(keeper Keeper) *MsgServer {
 // Ensure the interface is properly implemented at compile time
 var _ sudotypes.MsgServer = MsgServer{}
 
-// EditSudoers adds or removes sudo contracts from state.
+// EditSudoers adds or removes sudo contracts from state, or grants or revokes
+// the permissions of sudo contracts. The edit is queued if the root actions
+// are timelocked.
 func (m MsgServer) EditSudoers(
 	goCtx context.Context, msg *sudotypes.MsgEditSudoers,
 ) (*sudotypes.MsgEditSudoersResponse, error) {
-	switch msg.RootAction() {
-	case sudotypes.AddContracts:
-		return m.keeper.AddContracts(goCtx, msg)
-	case sudotypes.RemoveContracts:
-		return m.keeper.RemoveContracts(goCtx, msg)
-	default:
-		return nil, fmt.Errorf("invalid action type specified on msg: %s", msg)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	queued, id, err := m.keeper.QueueRootAction(
+		ctx, msg.Sender, sudotypes.PendingRootAction{EditSudoers: msg})
+	if err != nil || queued {
+		return &sudotypes.MsgEditSudoersResponse{Queued: queued, PendingRootActionId: id}, err
 	}
+	return m.keeper.EditSudoers(goCtx, msg)
 }
 
-func (m MsgServer) ChangeRoot(ctx context.Context, msg *sudotypes.MsgChangeRoot) (*sudotypes.MsgChangeRootResponse, error) {
-	sdkContext := sdk.UnwrapSDKContext(ctx)
+// ChangeRoot changes the root of the sudoers. The change is queued if the
+// root actions are timelocked.
+func (m MsgServer) ChangeRoot(
+	goCtx context.Context, msg *sudotypes.MsgChangeRoot,
+) (*sudotypes.MsgChangeRootResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	queued, id, err := m.keeper.QueueRootAction(
+		ctx, msg.Sender, sudotypes.PendingRootAction{ChangeRoot: msg})
+	if err != nil || queued {
+		return &sudotypes.MsgChangeRootResponse{Queued: queued, PendingRootActionId: id}, err
+	}
+	if err := m.keeper.ChangeRoot(ctx, msg); err != nil {
+		return nil, err
+	}
+	return &sudotypes.MsgChangeRootResponse{}, nil
+}
 
-	pbSudoers, err := m.keeper.Sudoers.Get(sdkContext)
+// UpdateRootConfig updates the timelock and the approvals required by the
+// root actions. The update is queued if the root actions are timelocked.
+func (m MsgServer) UpdateRootConfig(
+	goCtx context.Context, msg *sudotypes.MsgUpdateRootConfig,
+) (*sudotypes.MsgUpdateRootConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pbSudoers, err := m.keeper.Sudoers.Get(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get sudoers: %w", err)
 	}
-
-	err = m.validateRootPermissions(pbSudoers, msg)
-	if err != nil {
+	if err := msg.Config.ValidateThreshold(pbSudoers.Root); err != nil {
 		return nil, err
 	}
 
-	pbSudoers.Root = msg.NewRoot
-	m.keeper.Sudoers.Set(sdkContext, pbSudoers)
-
-	return &sudotypes.MsgChangeRootResponse{}, nil
+	queued, id, err := m.keeper.QueueRootAction(
+		ctx, msg.Sender, sudotypes.PendingRootAction{UpdateRootConfig: msg})
+	if err != nil || queued {
+		return &sudotypes.MsgUpdateRootConfigResponse{Queued: queued, PendingRootActionId: id}, err
+	}
+	if err := m.keeper.UpdateRootConfig(ctx, msg); err != nil {
+		return nil, err
+	}
+	return &sudotypes.MsgUpdateRootConfigResponse{}, nil
 }
 
-func (m MsgServer) validateRootPermissions(pbSudoers sudotypes.Sudoers, msg *sudotypes.MsgChangeRoot) error {
-	root, err := sdk.AccAddressFromBech32(pbSudoers.Root)
+func (m MsgServer) ApproveRootAction(
+	goCtx context.Context, msg *sudotypes.MsgApproveRootAction,
+) (*sudotypes.MsgApproveRootActionResponse, error) {
+	approvals, err := m.keeper.ApproveRootAction(sdk.UnwrapSDKContext(goCtx), msg.Sender, msg.ActionId)
 	if err != nil {
-		return fmt.Errorf("failed to parse root address: %w", err)
+		return nil, err
 	}
+	return &sudotypes.MsgApproveRootActionResponse{Approvals: approvals}, nil
+}
 
-	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+func (m MsgServer) CancelRootAction(
+	goCtx context.Context, msg *sudotypes.MsgCancelRootAction,
+) (*sudotypes.MsgCancelRootActionResponse, error) {
+	err := m.keeper.CancelRootAction(sdk.UnwrapSDKContext(goCtx), msg.Sender, msg.ActionId)
 	if err != nil {
-		return fmt.Errorf("failed to parse sender address: %w", err)
-	}
-
-	if !root.Equals(sender) {
-		return sudotypes.ErrUnauthorized
+		return nil, err
 	}
-
-	return nil
+	return &sudotypes.MsgCancelRootActionResponse{}, nil
 }
 
 // ————————————————————————————————————————————————————————————————————————————
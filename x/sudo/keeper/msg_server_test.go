@@ -37,6 +37,7 @@ func setup() (*app.NibiruApp, sdk.Context) {
 }
 
 func TestGenesis(t *testing.T) {
+	permissionedContract := testutil.AccAddress().String()
 	for _, testCase := range []struct {
 		name     string
 		genState *types.GenesisState
@@ -62,6 +63,67 @@ func TestGenesis(t *testing.T) {
 			},
 			empty: false,
 		},
+		{
+			name: "happy genesis with permissions",
+			genState: &types.GenesisState{
+				Sudoers: types.Sudoers{
+					Root:      testutil.AccAddress().String(),
+					Contracts: []string{permissionedContract},
+				},
+				Permissions: []types.ContractPermissions{{
+					Contract: permissionedContract,
+					Permissions: []types.Permission{{
+						Action:           string(types.PegShift),
+						Pair:             "ubtc:unusd",
+						MaxCallsPerEpoch: 2,
+						EpochIdentifier:  "day",
+						Calls:            1,
+					}},
+				}},
+			},
+			empty: false,
+		},
+		{
+			name: "happy genesis with pending root actions",
+			genState: &types.GenesisState{
+				Sudoers: types.Sudoers{
+					Root:      permissionedContract,
+					Contracts: []string{testutil.AccAddress().String()},
+				},
+				RootConfig: types.RootConfig{
+					TimelockBlocks: 100,
+					Guardian:       testutil.AccAddress().String(),
+					Signers:        []string{testutil.AccAddress().String()},
+					Threshold:      2,
+				},
+				PendingRootActions: []types.PendingRootAction{{
+					Id:            3,
+					Submitter:     permissionedContract,
+					ExecuteHeight: 101,
+					ExpireHeight:  101 + types.RootActionExpiryBlocks,
+					Approvals:     []string{permissionedContract},
+					ChangeRoot: &types.MsgChangeRoot{
+						Sender:  permissionedContract,
+						NewRoot: testutil.AccAddress().String(),
+					},
+				}},
+				NextPendingRootActionId: 4,
+			},
+			empty: false,
+		},
+		{
+			name: "permissions of a non-sudo contract (panic)",
+			genState: &types.GenesisState{
+				Sudoers: types.Sudoers{
+					Root:      testutil.AccAddress().String(),
+					Contracts: []string{},
+				},
+				Permissions: []types.ContractPermissions{{
+					Contract: permissionedContract,
+				}},
+			},
+			panic: true,
+		},
 		{
 			name:     "nil genesis (panic)",
 			genState: nil,
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/sudo/keeper"
+	"github.com/NibiruChain/nibiru/x/sudo/types"
+)
+
+func TestCheckActionPermission(t *testing.T) {
+	const pair = "ubtc:unusd"
+	root := testutil.AccAddress()
+	shifter := testutil.AccAddress()
+
+	nibiru, ctx := setup()
+	k := nibiru.SudoKeeper
+	msgServer := keeper.NewMsgServer(k)
+	k.Sudoers.Set(ctx, types.Sudoers{
+		Root:      root.String(),
+		Contracts: []string{shifter.String()},
+	})
+
+	t.Log("a sudo contract without permissions may execute every action")
+	require.NoError(t, k.CheckActionPermission(ctx, shifter, types.PegShift, pair))
+	require.NoError(t, k.CheckActionPermission(ctx, shifter, types.InsuranceFundWithdraw, ""))
+	require.Error(t, k.CheckActionPermission(ctx, testutil.AccAddress(), types.PegShift, pair))
+
+	t.Log("non-root users cannot grant permissions")
+	_, err := msgServer.EditSudoers(sdk.WrapSDKContext(ctx), &types.MsgEditSudoers{
+		Action:      string(types.GrantPermissions),
+		Contracts:   []string{shifter.String()},
+		Sender:      shifter.String(),
+		Permissions: []types.Permission{{Action: string(types.PegShift)}},
+	})
+	require.Error(t, err)
+
+	t.Log("grant a single peg shift on the pair per day")
+	_, err = msgServer.EditSudoers(sdk.WrapSDKContext(ctx), &types.MsgEditSudoers{
+		Action:    string(types.GrantPermissions),
+		Contracts: []string{shifter.String()},
+		Sender:    root.String(),
+		Permissions: []types.Permission{{
+			Action:           string(types.PegShift),
+			Pair:             pair,
+			MaxCallsPerEpoch: 1,
+			EpochIdentifier:  "day",
+		}},
+	})
+	require.NoError(t, err)
+
+	require.ErrorIs(t,
+		k.CheckActionPermission(ctx, shifter, types.InsuranceFundWithdraw, ""),
+		types.ErrUnauthorized)
+	require.ErrorIs(t,
+		k.CheckActionPermission(ctx, shifter, types.PegShift, "ueth:unusd"),
+		types.ErrUnauthorized)
+	require.NoError(t, k.CheckActionPermission(ctx, shifter, types.PegShift, pair))
+	require.ErrorIs(t,
+		k.CheckActionPermission(ctx, shifter, types.PegShift, pair),
+		types.ErrUnauthorized)
+
+	t.Log("the calls are only reset by the epoch of the permission")
+	nibiru.EpochsKeeper.AfterEpochEnd(ctx, "week", 1)
+	require.Error(t, k.CheckActionPermission(ctx, shifter, types.PegShift, pair))
+	nibiru.EpochsKeeper.AfterEpochEnd(ctx, "day", 1)
+	require.NoError(t, k.CheckActionPermission(ctx, shifter, types.PegShift, pair))
+
+	t.Log("query the permissions")
+	querier := keeper.NewQuerier(k)
+	resp, err := querier.QueryPermissions(sdk.WrapSDKContext(ctx), &types.QueryPermissionsRequest{
+		Contract: shifter.String(),
+	})
+	require.NoError(t, err)
+	require.False(t, resp.Unrestricted)
+	require.Len(t, resp.Permissions.Permissions, 1)
+	require.EqualValues(t, 1, resp.Permissions.Permissions[0].Calls)
+
+	t.Log("revoke the peg shift")
+	_, err = msgServer.EditSudoers(sdk.WrapSDKContext(ctx), &types.MsgEditSudoers{
+		Action:      string(types.RevokePermissions),
+		Contracts:   []string{shifter.String()},
+		Sender:      root.String(),
+		Permissions: []types.Permission{{Action: string(types.PegShift), Pair: pair}},
+	})
+	require.NoError(t, err)
+	nibiru.EpochsKeeper.AfterEpochEnd(ctx, "day", 2)
+	require.Error(t, k.CheckActionPermission(ctx, shifter, types.PegShift, pair))
+
+	t.Log("removing the contract removes its permissions")
+	_, err = msgServer.EditSudoers(sdk.WrapSDKContext(ctx), &types.MsgEditSudoers{
+		Action:    string(types.RemoveContracts),
+		Contracts: []string{shifter.String()},
+		Sender:    root.String(),
+	})
+	require.NoError(t, err)
+	_, err = k.Permissions.Get(ctx, shifter.String())
+	require.Error(t, err)
+}
+
+func TestRevokePermissions_Unrestricted(t *testing.T) {
+	root := testutil.AccAddress()
+	contract := testutil.AccAddress()
+
+	nibiru, ctx := setup()
+	k := nibiru.SudoKeeper
+	k.Sudoers.Set(ctx, types.Sudoers{
+		Root:      root.String(),
+		Contracts: []string{contract.String()},
+	})
+
+	_, err := keeper.NewMsgServer(k).EditSudoers(sdk.WrapSDKContext(ctx), &types.MsgEditSudoers{
+		Action:      string(types.RevokePermissions),
+		Contracts:   []string{contract.String()},
+		Sender:      root.String(),
+		Permissions: []types.Permission{{Action: string(types.InsuranceFundWithdraw)}},
+	})
+	require.NoError(t, err)
+
+	require.ErrorIs(t,
+		k.CheckActionPermission(ctx, contract, types.InsuranceFundWithdraw, ""),
+		types.ErrUnauthorized)
+	for _, action := range []types.SudoAction{
+		types.PegShift, types.DepthShift, types.CreateMarket,
+		types.SetMarketEnabled, types.EditOracleParams,
+	} {
+		require.NoError(t, k.CheckActionPermission(ctx, contract, action, "ubtc:unusd"))
+	}
+
+	t.Log("revoking from a non-sudo contract fails")
+	_, err = keeper.NewMsgServer(k).EditSudoers(sdk.WrapSDKContext(ctx), &types.MsgEditSudoers{
+		Action:      string(types.RevokePermissions),
+		Contracts:   []string{testutil.AccAddress().String()},
+		Sender:      root.String(),
+		Permissions: []types.Permission{{Action: string(types.InsuranceFundWithdraw)}},
+	})
+	require.Error(t, err)
+}
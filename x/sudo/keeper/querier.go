@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"github.com/NibiruChain/collections"
+
 	"github.com/NibiruChain/nibiru/x/sudo/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,3 +38,82 @@ func (q Querier) QuerySudoers(
 		Sudoers: sudoers,
 	}, err
 }
+
+func (q Querier) QueryPermissions(
+	goCtx context.Context,
+	req *types.QueryPermissionsRequest,
+) (resp *types.QueryPermissionsResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := q.keeper.CheckPermissions(contract, ctx); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	permissions, err := q.keeper.Permissions.Get(ctx, contract.String())
+	if err != nil {
+		return &types.QueryPermissionsResponse{
+			Permissions:  types.ContractPermissions{Contract: contract.String()},
+			Unrestricted: true,
+		}, nil
+	}
+	return &types.QueryPermissionsResponse{Permissions: permissions}, nil
+}
+
+func (q Querier) QueryRootConfig(
+	goCtx context.Context,
+	req *types.QueryRootConfigRequest,
+) (*types.QueryRootConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryRootConfigResponse{
+		Config: q.keeper.GetRootConfig(ctx),
+	}, nil
+}
+
+func (q Querier) QueryPendingRootActions(
+	goCtx context.Context,
+	req *types.QueryPendingRootActionsRequest,
+) (*types.QueryPendingRootActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryPendingRootActionsResponse{
+		PendingRootActions: q.keeper.PendingRootActions.Iterate(ctx, collections.Range[uint64]{}).Values(),
+	}, nil
+}
+
+func (q Querier) QueryAuditLog(
+	goCtx context.Context,
+	req *types.QueryAuditLogRequest,
+) (*types.QueryAuditLogResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Contract != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Contract); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	records, pageRes, err := q.keeper.PaginateAuditLog(ctx, req.Contract, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryAuditLogResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
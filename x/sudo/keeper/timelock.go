@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sudotypes "github.com/NibiruChain/nibiru/x/sudo/types"
+)
+
+// EditSudoers executes a MsgEditSudoers message according to its action type.
+func (k Keeper) EditSudoers(
+	goCtx context.Context, msg *sudotypes.MsgEditSudoers,
+) (*sudotypes.MsgEditSudoersResponse, error) {
+	switch msg.RootAction() {
+	case sudotypes.AddContracts:
+		return k.AddContracts(goCtx, msg)
+	case sudotypes.RemoveContracts:
+		return k.RemoveContracts(goCtx, msg)
+	case sudotypes.GrantPermissions:
+		return k.GrantPermissions(goCtx, msg)
+	case sudotypes.RevokePermissions:
+		return k.RevokePermissions(goCtx, msg)
+	default:
+		return nil, fmt.Errorf("invalid action type specified on msg: %s", msg)
+	}
+}
+
+// ChangeRoot executes a MsgChangeRoot message.
+func (k Keeper) ChangeRoot(ctx sdk.Context, msg *sudotypes.MsgChangeRoot) error {
+	pbSudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get sudoers: %w", err)
+	}
+
+	err = k.validateRootPermissions(pbSudoers.Root, msg.Sender)
+	if err != nil {
+		return err
+	}
+
+	pbSudoers.Root = msg.NewRoot
+	k.Sudoers.Set(ctx, pbSudoers)
+	return nil
+}
+
+// UpdateRootConfig executes a MsgUpdateRootConfig message.
+func (k Keeper) UpdateRootConfig(ctx sdk.Context, msg *sudotypes.MsgUpdateRootConfig) error {
+	pbSudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get sudoers: %w", err)
+	}
+
+	err = k.validateRootPermissions(pbSudoers.Root, msg.Sender)
+	if err != nil {
+		return err
+	}
+	if err := msg.Config.ValidateThreshold(pbSudoers.Root); err != nil {
+		return err
+	}
+
+	k.RootConfig.Set(ctx, msg.Config)
+	return nil
+}
+
+func (k Keeper) validateRootPermissions(rootStr string, senderStr string) error {
+	root, err := sdk.AccAddressFromBech32(rootStr)
+	if err != nil {
+		return fmt.Errorf("failed to parse root address: %w", err)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(senderStr)
+	if err != nil {
+		return fmt.Errorf("failed to parse sender address: %w", err)
+	}
+
+	if !root.Equals(sender) {
+		return sudotypes.ErrUnauthorized
+	}
+
+	return nil
+}
+
+// GetRootConfig returns the configuration of the root actions, which executes
+// them immediately if it was never set.
+func (k Keeper) GetRootConfig(ctx sdk.Context) sudotypes.RootConfig {
+	return k.RootConfig.GetOr(ctx, sudotypes.DefaultRootConfig())
+}
+
+// QueueRootAction queues the root action behind the timelock, approved by its
+// submitter. Returns false if the root actions are executed immediately.
+func (k Keeper) QueueRootAction(
+	ctx sdk.Context, submitter string, action sudotypes.PendingRootAction,
+) (queued bool, id uint64, err error) {
+	config := k.GetRootConfig(ctx)
+	if config.IsImmediate() {
+		return false, 0, nil
+	}
+
+	pbSudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return false, 0, fmt.Errorf("failed to get sudoers: %w", err)
+	}
+	if err := k.validateRootPermissions(pbSudoers.Root, submitter); err != nil {
+		return false, 0, err
+	}
+
+	action.Id = k.NextPendingRootActionId.Next(ctx)
+	action.Submitter = submitter
+	action.ExecuteHeight = ctx.BlockHeight() + int64(config.TimelockBlocks)
+	action.ExpireHeight = action.ExecuteHeight + sudotypes.RootActionExpiryBlocks
+	action.Approvals = []string{submitter}
+	k.PendingRootActions.Insert(ctx, action.Id, action)
+
+	return true, action.Id, ctx.EventManager().EmitTypedEvent(
+		&sudotypes.EventRootActionQueued{Action: action})
+}
+
+// ApproveRootAction records the approval of a signer on a pending root
+// action. Returns the number of approvals of the current signers.
+func (k Keeper) ApproveRootAction(
+	ctx sdk.Context, sender string, id uint64,
+) (approvals uint32, err error) {
+	action, err := k.PendingRootActions.Get(ctx, id)
+	if err != nil {
+		return 0, sudotypes.ErrPendingRootActionNotFound.Wrapf("id %d", id)
+	}
+	pbSudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get sudoers: %w", err)
+	}
+	config := k.GetRootConfig(ctx)
+	if !config.IsSigner(pbSudoers.Root, sender) {
+		return 0, sudotypes.ErrUnauthorized.Wrapf("%s is not a root signer", sender)
+	}
+	if action.HasApproval(sender) {
+		return 0, sudotypes.ErrRootActionAlreadyApproved.Wrapf("id %d, signer %s", id, sender)
+	}
+
+	action.Approvals = append(action.Approvals, sender)
+	k.PendingRootActions.Insert(ctx, id, action)
+
+	approvals = config.CountApprovals(pbSudoers.Root, action.Approvals)
+	return approvals, ctx.EventManager().EmitTypedEvent(&sudotypes.EventRootActionApproved{
+		ActionId:  id,
+		Approver:  sender,
+		Approvals: approvals,
+	})
+}
+
+// CancelRootAction removes a pending root action. Only the guardian and the
+// root can cancel.
+func (k Keeper) CancelRootAction(ctx sdk.Context, sender string, id uint64) error {
+	if _, err := k.PendingRootActions.Get(ctx, id); err != nil {
+		return sudotypes.ErrPendingRootActionNotFound.Wrapf("id %d", id)
+	}
+	pbSudoers, err := k.Sudoers.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get sudoers: %w", err)
+	}
+	if !k.GetRootConfig(ctx).CanCancel(pbSudoers.Root, sender) {
+		return sudotypes.ErrUnauthorized.Wrapf("%s is neither the guardian nor the root", sender)
+	}
+
+	if err := k.PendingRootActions.Delete(ctx, id); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&sudotypes.EventRootActionCancelled{
+		ActionId: id,
+		Sender:   sender,
+	})
+}
+
+// ExecutePendingRootActions executes, in order of execute height, the pending
+// root actions whose timelock expired and that reached the threshold of
+// approvals. A root action that fails is dropped from the queue and its state
+// changes are discarded. The actions that reached their expire height without
+// enough approvals are pruned.
+func (k Keeper) ExecutePendingRootActions(ctx sdk.Context) {
+	// only the actions past their timelock are visited
+	rng := collections.Range[collections.Pair[uint64, uint64]]{}.
+		EndInclusive(collections.Join(uint64(ctx.BlockHeight()), uint64(math.MaxUint64)))
+	actions := k.PendingRootActions.Collect(
+		ctx, k.PendingRootActions.Indexes.ExecuteHeight.Iterate(ctx, rng))
+
+	for _, action := range actions {
+		if ctx.BlockHeight() >= action.ExpireHeight {
+			_ = k.PendingRootActions.Delete(ctx, action.Id)
+			_ = ctx.EventManager().EmitTypedEvent(&sudotypes.EventRootActionExpired{ActionId: action.Id})
+			continue
+		}
+		pbSudoers, err := k.Sudoers.Get(ctx)
+		if err != nil {
+			return
+		}
+		config := k.GetRootConfig(ctx)
+		if config.CountApprovals(pbSudoers.Root, action.Approvals) < config.Threshold {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		err = k.executeRootAction(cacheCtx, action)
+		if err == nil {
+			writeCache()
+		}
+
+		_ = k.PendingRootActions.Delete(ctx, action.Id)
+		event := &sudotypes.EventRootActionExecuted{ActionId: action.Id}
+		if err != nil {
+			event.Error = err.Error()
+		}
+		_ = ctx.EventManager().EmitTypedEvent(event)
+	}
+}
+
+func (k Keeper) executeRootAction(ctx sdk.Context, action sudotypes.PendingRootAction) error {
+	switch {
+	case action.EditSudoers != nil:
+		_, err := k.EditSudoers(sdk.WrapSDKContext(ctx), action.EditSudoers)
+		return err
+	case action.ChangeRoot != nil:
+		return k.ChangeRoot(ctx, action.ChangeRoot)
+	case action.UpdateRootConfig != nil:
+		return k.UpdateRootConfig(ctx, action.UpdateRootConfig)
+	default:
+		return fmt.Errorf("pending root action %d has no msg", action.Id)
+	}
+}
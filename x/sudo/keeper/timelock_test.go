@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/sudo/keeper"
+	"github.com/NibiruChain/nibiru/x/sudo/types"
+)
+
+func TestTimelockedRootActions(t *testing.T) {
+	root := testutil.AccAddress().String()
+	guardian := testutil.AccAddress().String()
+	signer := testutil.AccAddress().String()
+	newRoot := testutil.AccAddress().String()
+	contract := testutil.AccAddress().String()
+
+	nibiru, ctx := setup()
+	k := nibiru.SudoKeeper
+	msgServer := keeper.NewMsgServer(k)
+	k.Sudoers.Set(ctx, types.Sudoers{Root: root, Contracts: []string{}})
+
+	t.Log("the root config is updated immediately by default")
+	configResp, err := msgServer.UpdateRootConfig(sdk.WrapSDKContext(ctx), &types.MsgUpdateRootConfig{
+		Sender: root,
+		Config: types.RootConfig{
+			TimelockBlocks: 10,
+			Guardian:       guardian,
+			Signers:        []string{signer},
+			Threshold:      2,
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, configResp.Queued)
+
+	t.Log("a threshold above the number of signers is rejected")
+	_, err = msgServer.UpdateRootConfig(sdk.WrapSDKContext(ctx), &types.MsgUpdateRootConfig{
+		Sender: root,
+		Config: types.RootConfig{Threshold: 3},
+	})
+	require.Error(t, err)
+
+	t.Log("only the root can queue root actions")
+	_, err = msgServer.EditSudoers(sdk.WrapSDKContext(ctx), &types.MsgEditSudoers{
+		Action:    string(types.AddContracts),
+		Contracts: []string{contract},
+		Sender:    signer,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	t.Log("the root queues a new contract")
+	editResp, err := msgServer.EditSudoers(sdk.WrapSDKContext(ctx), &types.MsgEditSudoers{
+		Action:    string(types.AddContracts),
+		Contracts: []string{contract},
+		Sender:    root,
+	})
+	require.NoError(t, err)
+	require.True(t, editResp.Queued)
+	pending, err := keeper.NewQuerier(k).QueryPendingRootActions(
+		sdk.WrapSDKContext(ctx), &types.QueryPendingRootActionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, pending.PendingRootActions, 1)
+	require.Equal(t, ctx.BlockHeight()+10, pending.PendingRootActions[0].ExecuteHeight)
+	require.Equal(t, []string{root}, pending.PendingRootActions[0].Approvals)
+
+	t.Log("the action waits for the timelock and the approvals")
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	k.ExecutePendingRootActions(ctx)
+	require.Error(t, k.CheckPermissions(sdk.MustAccAddressFromBech32(contract), ctx))
+
+	_, err = msgServer.ApproveRootAction(sdk.WrapSDKContext(ctx), &types.MsgApproveRootAction{
+		Sender: guardian, ActionId: editResp.PendingRootActionId,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	approveResp, err := msgServer.ApproveRootAction(sdk.WrapSDKContext(ctx), &types.MsgApproveRootAction{
+		Sender: signer, ActionId: editResp.PendingRootActionId,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, approveResp.Approvals)
+	_, err = msgServer.ApproveRootAction(sdk.WrapSDKContext(ctx), &types.MsgApproveRootAction{
+		Sender: signer, ActionId: editResp.PendingRootActionId,
+	})
+	require.ErrorIs(t, err, types.ErrRootActionAlreadyApproved)
+
+	k.ExecutePendingRootActions(ctx)
+	require.NoError(t, k.CheckPermissions(sdk.MustAccAddressFromBech32(contract), ctx))
+	_, err = k.PendingRootActions.Get(ctx, editResp.PendingRootActionId)
+	require.Error(t, err)
+
+	t.Log("the guardian cancels a root change")
+	rootResp, err := msgServer.ChangeRoot(sdk.WrapSDKContext(ctx), &types.MsgChangeRoot{
+		Sender: root, NewRoot: newRoot,
+	})
+	require.NoError(t, err)
+	require.True(t, rootResp.Queued)
+	_, err = msgServer.CancelRootAction(sdk.WrapSDKContext(ctx), &types.MsgCancelRootAction{
+		Sender: signer, ActionId: rootResp.PendingRootActionId,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.CancelRootAction(sdk.WrapSDKContext(ctx), &types.MsgCancelRootAction{
+		Sender: guardian, ActionId: rootResp.PendingRootActionId,
+	})
+	require.NoError(t, err)
+	_, err = msgServer.CancelRootAction(sdk.WrapSDKContext(ctx), &types.MsgCancelRootAction{
+		Sender: guardian, ActionId: rootResp.PendingRootActionId,
+	})
+	require.ErrorIs(t, err, types.ErrPendingRootActionNotFound)
+
+	t.Log("an action that fails on execution is dropped")
+	rootResp, err = msgServer.ChangeRoot(sdk.WrapSDKContext(ctx), &types.MsgChangeRoot{
+		Sender: root, NewRoot: newRoot,
+	})
+	require.NoError(t, err)
+	editResp, err = msgServer.EditSudoers(sdk.WrapSDKContext(ctx), &types.MsgEditSudoers{
+		Action:    string(types.RemoveContracts),
+		Contracts: []string{contract},
+		Sender:    root,
+	})
+	require.NoError(t, err)
+	for _, id := range []uint64{rootResp.PendingRootActionId, editResp.PendingRootActionId} {
+		_, err = k.ApproveRootAction(ctx, signer, id)
+		require.NoError(t, err)
+	}
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	k.ExecutePendingRootActions(ctx)
+
+	sudoers, err := k.Sudoers.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, newRoot, sudoers.Root)
+	_, err = k.PendingRootActions.Get(ctx, editResp.PendingRootActionId)
+	require.NoError(t, err, "the approval of the old root no longer counts")
+
+	_, err = k.ApproveRootAction(ctx, newRoot, editResp.PendingRootActionId)
+	require.NoError(t, err)
+	k.ExecutePendingRootActions(ctx)
+	sudoers, err = k.Sudoers.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{contract}, sudoers.Contracts, "the old root can no longer edit")
+	_, err = k.PendingRootActions.Get(ctx, editResp.PendingRootActionId)
+	require.Error(t, err)
+}
+
+func TestPendingRootActionExpiry(t *testing.T) {
+	root := testutil.AccAddress().String()
+	signer := testutil.AccAddress().String()
+	contract := testutil.AccAddress().String()
+
+	nibiru, ctx := setup()
+	k := nibiru.SudoKeeper
+	msgServer := keeper.NewMsgServer(k)
+	k.Sudoers.Set(ctx, types.Sudoers{Root: root, Contracts: []string{}})
+	k.RootConfig.Set(ctx, types.RootConfig{
+		TimelockBlocks: 10,
+		Signers:        []string{signer},
+		Threshold:      2,
+	})
+
+	editResp, err := msgServer.EditSudoers(sdk.WrapSDKContext(ctx), &types.MsgEditSudoers{
+		Action:    string(types.AddContracts),
+		Contracts: []string{contract},
+		Sender:    root,
+	})
+	require.NoError(t, err)
+	action, err := k.PendingRootActions.Get(ctx, editResp.PendingRootActionId)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight()+10+types.RootActionExpiryBlocks, action.ExpireHeight)
+
+	t.Log("the pending root actions are indexed by execute height")
+	ids := k.PendingRootActions.Indexes.ExecuteHeight.ExactMatch(
+		ctx, uint64(action.ExecuteHeight)).PrimaryKeys()
+	require.Equal(t, []uint64{action.Id}, ids)
+
+	t.Log("an action without enough approvals stays pending until it expires")
+	ctx = ctx.WithBlockHeight(action.ExpireHeight - 1)
+	k.ExecutePendingRootActions(ctx)
+	_, err = k.PendingRootActions.Get(ctx, action.Id)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(action.ExpireHeight)
+	k.ExecutePendingRootActions(ctx)
+	_, err = k.PendingRootActions.Get(ctx, action.Id)
+	require.Error(t, err)
+	require.Empty(t, k.PendingRootActions.Indexes.ExecuteHeight.ExactMatch(
+		ctx, uint64(action.ExecuteHeight)).PrimaryKeys())
+	require.Error(t, k.CheckPermissions(sdk.MustAccAddressFromBech32(contract), ctx))
+
+	t.Log("an expired action can no longer be approved")
+	_, err = k.ApproveRootAction(ctx, signer, action.Id)
+	require.ErrorIs(t, err, types.ErrPendingRootActionNotFound)
+}
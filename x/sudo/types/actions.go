@@ -5,12 +5,48 @@ import "github.com/NibiruChain/nibiru/x/common/set"
 type RootAction string
 
 const (
-	AddContracts    RootAction = "add_contracts"
-	RemoveContracts RootAction = "remove_contracts"
+	AddContracts      RootAction = "add_contracts"
+	RemoveContracts   RootAction = "remove_contracts"
+	GrantPermissions  RootAction = "grant_permissions"
+	RevokePermissions RootAction = "revoke_permissions"
 )
 
 // RootActions set[string]: The set of all root actions.
 var RootActions = set.New[RootAction](
 	AddContracts,
 	RemoveContracts,
+	GrantPermissions,
+	RevokePermissions,
+)
+
+// SudoAction: A permissioned action that a sudo contract can execute through
+// the x/wasm bindings.
+type SudoAction string
+
+const (
+	PegShift              SudoAction = "peg_shift"
+	DepthShift            SudoAction = "depth_shift"
+	CreateMarket          SudoAction = "create_market"
+	InsuranceFundWithdraw SudoAction = "insurance_fund_withdraw"
+	SetMarketEnabled      SudoAction = "set_market_enabled"
+	EditOracleParams      SudoAction = "edit_oracle_params"
+)
+
+// SudoActions set[string]: The set of all sudo actions.
+var SudoActions = set.New[SudoAction](
+	PegShift,
+	DepthShift,
+	CreateMarket,
+	InsuranceFundWithdraw,
+	SetMarketEnabled,
+	EditOracleParams,
+)
+
+// PairScopedSudoActions set[string]: The sudo actions that act on a single
+// market and can therefore be scoped to a pair.
+var PairScopedSudoActions = set.New[SudoAction](
+	PegShift,
+	DepthShift,
+	CreateMarket,
+	SetMarketEnabled,
 )
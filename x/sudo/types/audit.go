@@ -0,0 +1,30 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxAuditRecords is the number of most recent audit records kept in state.
+const MaxAuditRecords uint64 = 10_000
+
+// NewAuditRecord returns the record of a privileged binding call, identified
+// by the hash of its JSON payload.
+func NewAuditRecord(
+	contract sdk.AccAddress, action SudoAction, payload []byte, blockHeight int64, execErr error,
+) AuditRecord {
+	payloadHash := sha256.Sum256(payload)
+	record := AuditRecord{
+		Contract:    contract.String(),
+		Action:      string(action),
+		PayloadHash: hex.EncodeToString(payloadHash[:]),
+		BlockHeight: blockHeight,
+		Success:     execErr == nil,
+	}
+	if execErr != nil {
+		record.Error = execErr.Error()
+	}
+	return record
+}
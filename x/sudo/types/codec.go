@@ -9,6 +9,9 @@ import (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgEditSudoers{}, "sudo/edit_sudoers", nil)
+	cdc.RegisterConcrete(&MsgUpdateRootConfig{}, "sudo/update_root_config", nil)
+	cdc.RegisterConcrete(&MsgApproveRootAction{}, "sudo/approve_root_action", nil)
+	cdc.RegisterConcrete(&MsgCancelRootAction{}, "sudo/cancel_root_action", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -16,6 +19,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		/* interface */ (*sdk.Msg)(nil),
 		/* implementations */
 		&MsgEditSudoers{},
+		&MsgUpdateRootConfig{},
+		&MsgApproveRootAction{},
+		&MsgCancelRootAction{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -2,4 +2,8 @@ package types
 
 import sdkerrors "cosmossdk.io/errors"
 
-var ErrUnauthorized = sdkerrors.Register(ModuleName, 2, "unauthorized: missing sudo permissions")
+var (
+	ErrUnauthorized              = sdkerrors.Register(ModuleName, 2, "unauthorized: missing sudo permissions")
+	ErrPendingRootActionNotFound = sdkerrors.Register(ModuleName, 3, "pending root action not found")
+	ErrRootActionAlreadyApproved = sdkerrors.Register(ModuleName, 4, "pending root action already approved by signer")
+)
@@ -5,6 +5,9 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/set"
 )
 
 func (gen *GenesisState) Validate() error {
@@ -13,6 +16,58 @@ func (gen *GenesisState) Validate() error {
 	} else if err := gen.Sudoers.Validate(); err != nil {
 		return err
 	}
+
+	contracts := set.New(gen.Sudoers.Contracts...)
+	seen := set.New[string]()
+	for _, permissions := range gen.Permissions {
+		if err := permissions.Validate(); err != nil {
+			return err
+		}
+		if !contracts.Has(permissions.Contract) {
+			return fmt.Errorf("permissions of %s, which is not a sudo contract", permissions.Contract)
+		}
+		if seen.Has(permissions.Contract) {
+			return fmt.Errorf("duplicate permissions of contract %s", permissions.Contract)
+		}
+		seen.Add(permissions.Contract)
+	}
+
+	if err := gen.RootConfig.Validate(); err != nil {
+		return err
+	}
+	lastID := uint64(0)
+	for i, action := range gen.PendingRootActions {
+		if err := action.Validate(); err != nil {
+			return err
+		}
+		if i > 0 && action.Id <= lastID {
+			return fmt.Errorf("pending root actions are not sorted by id at action %d", action.Id)
+		}
+		if action.Id >= gen.NextPendingRootActionId {
+			return fmt.Errorf(
+				"pending root action id %d is not below the next id %d",
+				action.Id, gen.NextPendingRootActionId,
+			)
+		}
+		lastID = action.Id
+	}
+
+	if uint64(len(gen.AuditLog)) > MaxAuditRecords {
+		return fmt.Errorf(
+			"audit log has %d records, above the maximum of %d", len(gen.AuditLog), MaxAuditRecords)
+	}
+	for i, record := range gen.AuditLog {
+		if i > 0 && record.Id <= gen.AuditLog[i-1].Id {
+			return fmt.Errorf("audit log is not sorted by id at record %d", record.Id)
+		}
+		if record.Id >= gen.NextAuditRecordId {
+			return fmt.Errorf(
+				"audit record id %d is not below the next id %d", record.Id, gen.NextAuditRecordId)
+		}
+		if _, err := sdk.AccAddressFromBech32(record.Contract); err != nil {
+			return fmt.Errorf("invalid contract of audit record %d: %w", record.Id, err)
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,648 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nibiru/sudo/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the module's genesis state.
+type GenesisState struct {
+	Sudoers Sudoers `protobuf:"bytes,1,opt,name=sudoers,proto3" json:"sudoers"`
+	// Permissions: The permissions of the sudo contracts.
+	Permissions []ContractPermissions `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions"`
+	// RootConfig: Configuration of the timelock of the root actions.
+	RootConfig RootConfig `protobuf:"bytes,3,opt,name=root_config,json=rootConfig,proto3" json:"root_config"`
+	// PendingRootActions: The queued root actions.
+	PendingRootActions []PendingRootAction `protobuf:"bytes,4,rep,name=pending_root_actions,json=pendingRootActions,proto3" json:"pending_root_actions"`
+	// NextPendingRootActionId: Id of the next queued root action.
+	NextPendingRootActionId uint64 `protobuf:"varint,5,opt,name=next_pending_root_action_id,json=nextPendingRootActionId,proto3" json:"next_pending_root_action_id,omitempty"`
+	// AuditLog: The most recent privileged executions of the sudo contracts.
+	AuditLog []AuditRecord `protobuf:"bytes,6,rep,name=audit_log,json=auditLog,proto3" json:"audit_log"`
+	// NextAuditRecordId: Id of the next audit record.
+	NextAuditRecordId uint64 `protobuf:"varint,7,opt,name=next_audit_record_id,json=nextAuditRecordId,proto3" json:"next_audit_record_id,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4c846ad6238e5eb, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetSudoers() Sudoers {
+	if m != nil {
+		return m.Sudoers
+	}
+	return Sudoers{}
+}
+
+func (m *GenesisState) GetPermissions() []ContractPermissions {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRootConfig() RootConfig {
+	if m != nil {
+		return m.RootConfig
+	}
+	return RootConfig{}
+}
+
+func (m *GenesisState) GetPendingRootActions() []PendingRootAction {
+	if m != nil {
+		return m.PendingRootActions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextPendingRootActionId() uint64 {
+	if m != nil {
+		return m.NextPendingRootActionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAuditLog() []AuditRecord {
+	if m != nil {
+		return m.AuditLog
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextAuditRecordId() uint64 {
+	if m != nil {
+		return m.NextAuditRecordId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "nibiru.sudo.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("nibiru/sudo/v1/genesis.proto", fileDescriptor_d4c846ad6238e5eb) }
+
+var fileDescriptor_d4c846ad6238e5eb = []byte{
+	// 400 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0xcb, 0xd3, 0x30,
+	0x18, 0xc7, 0x5b, 0x37, 0xdf, 0x69, 0x2a, 0x82, 0x61, 0xb0, 0xd2, 0x69, 0x9d, 0x7a, 0x19, 0x1e,
+	0x5a, 0x36, 0x0f, 0x5e, 0x44, 0xd8, 0x26, 0xc8, 0x50, 0x64, 0x74, 0x27, 0xbd, 0x94, 0xae, 0x8d,
+	0x59, 0x70, 0xcb, 0x53, 0x92, 0x74, 0xcc, 0x6f, 0xe1, 0xc7, 0xda, 0x71, 0x47, 0x4f, 0xa2, 0xdb,
+	0x17, 0x91, 0x24, 0x55, 0x67, 0xf5, 0xbd, 0x95, 0xfe, 0x7f, 0xcf, 0xef, 0xf9, 0x43, 0x1e, 0x74,
+	0x9f, 0xb3, 0x15, 0x13, 0x55, 0x2c, 0xab, 0x02, 0xe2, 0xdd, 0x28, 0xa6, 0x84, 0x13, 0xc9, 0x64,
+	0x54, 0x0a, 0x50, 0x80, 0xef, 0xda, 0x34, 0xd2, 0x69, 0xb4, 0x1b, 0x05, 0x5d, 0x0a, 0x14, 0x4c,
+	0x14, 0xeb, 0x2f, 0x4b, 0x05, 0x41, 0xc3, 0x91, 0x55, 0x05, 0x53, 0xd7, 0x64, 0x52, 0x65, 0x8a,
+	0xd4, 0xd9, 0x83, 0x46, 0xa6, 0xd8, 0x96, 0x6c, 0x20, 0xff, 0x64, 0xe3, 0xc7, 0x3f, 0x5a, 0xe8,
+	0xce, 0x6b, 0x5b, 0x67, 0xa9, 0xa7, 0xf0, 0x73, 0xd4, 0xd1, 0x28, 0x11, 0xd2, 0x77, 0x07, 0xee,
+	0xd0, 0x1b, 0xf7, 0xa2, 0xbf, 0xfb, 0x45, 0x4b, 0x1b, 0x4f, 0xdb, 0x87, 0x6f, 0x0f, 0x9d, 0xe4,
+	0x17, 0x8d, 0xdf, 0x20, 0xaf, 0x24, 0x62, 0xcb, 0xa4, 0x64, 0xc0, 0xa5, 0x7f, 0x63, 0xd0, 0x1a,
+	0x7a, 0xe3, 0x27, 0xcd, 0xe1, 0x19, 0x70, 0x25, 0xb2, 0x5c, 0x2d, 0xfe, 0xa0, 0xb5, 0xe8, 0x72,
+	0x1a, 0x4f, 0x90, 0x27, 0x00, 0x54, 0x9a, 0x03, 0xff, 0xc8, 0xa8, 0xdf, 0x32, 0x4d, 0x82, 0xa6,
+	0x2c, 0x01, 0x50, 0x33, 0x43, 0xd4, 0x0e, 0x24, 0x7e, 0xff, 0xc1, 0xef, 0x51, 0xb7, 0x24, 0xbc,
+	0x60, 0x9c, 0xa6, 0x46, 0x95, 0xe5, 0xca, 0x14, 0x6b, 0x9b, 0x62, 0x8f, 0x9a, 0xae, 0x85, 0x65,
+	0xb5, 0x72, 0x62, 0xc8, 0x5a, 0x89, 0xcb, 0x66, 0x20, 0xf1, 0x0b, 0xd4, 0xe7, 0x64, 0xaf, 0xd2,
+	0xff, 0xf8, 0x53, 0x56, 0xf8, 0x37, 0x07, 0xee, 0xb0, 0x9d, 0xf4, 0x34, 0xf2, 0x8f, 0x75, 0x5e,
+	0xe0, 0x97, 0xe8, 0xb6, 0x79, 0xbc, 0x74, 0x03, 0xd4, 0xbf, 0x32, 0x6d, 0xfa, 0xcd, 0x36, 0x13,
+	0x0d, 0x24, 0x24, 0x07, 0x51, 0xd4, 0x3d, 0x6e, 0x99, 0x99, 0xb7, 0x40, 0x71, 0x8c, 0xba, 0x66,
+	0xbb, 0x95, 0x08, 0x03, 0xe9, 0xb5, 0x1d, 0xb3, 0xf6, 0x9e, 0xce, 0x2e, 0xc6, 0xe7, 0xc5, 0xf4,
+	0xd5, 0xe1, 0x14, 0xba, 0xc7, 0x53, 0xe8, 0x7e, 0x3f, 0x85, 0xee, 0x97, 0x73, 0xe8, 0x1c, 0xcf,
+	0xa1, 0xf3, 0xf5, 0x1c, 0x3a, 0x1f, 0x9e, 0x52, 0xa6, 0xd6, 0xd5, 0x2a, 0xca, 0x61, 0x1b, 0xbf,
+	0x33, 0x0d, 0x66, 0xeb, 0x8c, 0xf1, 0xb8, 0xbe, 0x99, 0xbd, 0xbd, 0x1a, 0xf5, 0xb9, 0x24, 0x72,
+	0x75, 0x65, 0x0e, 0xe6, 0xd9, 0xcf, 0x01, 0x00, 0x7b, 0x4e, 0x69, 0xbd, 0xcd, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextAuditRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextAuditRecordId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AuditLog) > 0 {
+		for iNdEx := len(m.AuditLog) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditLog[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NextPendingRootActionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPendingRootActionId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PendingRootActions) > 0 {
+		for iNdEx := len(m.PendingRootActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRootActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.RootConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Sudoers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sudoers.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Permissions) > 0 {
+		for _, e := range m.Permissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.RootConfig.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingRootActions) > 0 {
+		for _, e := range m.PendingRootActions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPendingRootActionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPendingRootActionId))
+	}
+	if len(m.AuditLog) > 0 {
+		for _, e := range m.AuditLog {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextAuditRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextAuditRecordId))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sudoers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sudoers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, ContractPermissions{})
+			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RootConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRootActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRootActions = append(m.PendingRootActions, PendingRootAction{})
+			if err := m.PendingRootActions[len(m.PendingRootActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPendingRootActionId", wireType)
+			}
+			m.NextPendingRootActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPendingRootActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditLog = append(m.AuditLog, AuditRecord{})
+			if err := m.AuditLog[len(m.AuditLog)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAuditRecordId", wireType)
+			}
+			m.NextAuditRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAuditRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,7 +6,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ sdk.Msg = &MsgEditSudoers{}
+var (
+	_ sdk.Msg = &MsgEditSudoers{}
+	_ sdk.Msg = &MsgChangeRoot{}
+	_ sdk.Msg = &MsgUpdateRootConfig{}
+	_ sdk.Msg = &MsgApproveRootAction{}
+	_ sdk.Msg = &MsgCancelRootAction{}
+)
 
 // MsgEditSudoers
 
@@ -28,6 +34,22 @@ func (m *MsgEditSudoers) ValidateBasic() error {
 		)
 	}
 
+	switch m.RootAction() {
+	case GrantPermissions, RevokePermissions:
+		if len(m.Permissions) == 0 {
+			return fmt.Errorf("action %s requires at least one permission", m.Action)
+		}
+		for _, permission := range m.Permissions {
+			if err := permission.Validate(); err != nil {
+				return err
+			}
+		}
+	default:
+		if len(m.Permissions) != 0 {
+			return fmt.Errorf("action %s does not accept permissions", m.Action)
+		}
+	}
+
 	return nil
 }
 
@@ -64,3 +86,54 @@ func (m *MsgChangeRoot) ValidateBasic() error {
 
 	return nil
 }
+
+// MsgUpdateRootConfig
+
+func (m *MsgUpdateRootConfig) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m *MsgUpdateRootConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	return m.Config.Validate()
+}
+
+// MsgApproveRootAction
+
+func (m *MsgApproveRootAction) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m *MsgApproveRootAction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MsgCancelRootAction
+
+func (m *MsgCancelRootAction) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (m *MsgCancelRootAction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+)
+
+func (p Permission) SudoAction() SudoAction {
+	return SudoAction(p.Action)
+}
+
+// Validate checks that the permission names a known action, that only
+// pair-scoped actions are restricted to a pair, and that capped permissions
+// name the epoch over which the calls are counted.
+func (p Permission) Validate() error {
+	if !SudoActions.Has(p.SudoAction()) {
+		return fmt.Errorf(
+			"invalid sudo action %s, expected one of %s",
+			p.Action, SudoActions.ToSlice(),
+		)
+	}
+	if p.Pair != "" {
+		if !PairScopedSudoActions.Has(p.SudoAction()) {
+			return fmt.Errorf("sudo action %s cannot be scoped to a pair", p.Action)
+		}
+		if _, err := asset.TryNewPair(p.Pair); err != nil {
+			return err
+		}
+	}
+	if p.MaxCallsPerEpoch > 0 && p.EpochIdentifier == "" {
+		return fmt.Errorf("capped sudo action %s is missing an epoch identifier", p.Action)
+	}
+	return nil
+}
+
+// Matches returns true if the permission allows the action on the pair.
+func (p Permission) Matches(action SudoAction, pair string) bool {
+	return p.SudoAction() == action && (p.Pair == "" || p.Pair == pair)
+}
+
+// HasCallsLeft returns true if the permission allows another call in the
+// current epoch.
+func (p Permission) HasCallsLeft() bool {
+	return p.MaxCallsPerEpoch == 0 || p.Calls < p.MaxCallsPerEpoch
+}
+
+// NewUnrestrictedPermissions returns a permission for every sudo action on
+// any pair, which is equivalent to having no permissions at all.
+func NewUnrestrictedPermissions(contract string) ContractPermissions {
+	actions := SudoActions.ToSlice()
+	sort.Slice(actions, func(i, j int) bool { return actions[i] < actions[j] })
+
+	permissions := ContractPermissions{Contract: contract}
+	for _, action := range actions {
+		permissions.Permissions = append(permissions.Permissions, Permission{Action: string(action)})
+	}
+	return permissions
+}
+
+func (cp ContractPermissions) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cp.Contract); err != nil {
+		return err
+	}
+	for _, permission := range cp.Permissions {
+		if err := permission.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Grant adds the permissions to the contract, replacing the ones with the
+// same action and pair. The calls of the replaced permissions are reset.
+func (cp *ContractPermissions) Grant(permissions []Permission) {
+	for _, permission := range permissions {
+		permission.Calls = 0
+		idx := cp.indexOf(permission.Action, permission.Pair)
+		if idx < 0 {
+			cp.Permissions = append(cp.Permissions, permission)
+			continue
+		}
+		cp.Permissions[idx] = permission
+	}
+}
+
+// Revoke removes the permissions with the same action and pair from the
+// contract.
+func (cp *ContractPermissions) Revoke(permissions []Permission) {
+	for _, permission := range permissions {
+		idx := cp.indexOf(permission.Action, permission.Pair)
+		if idx < 0 {
+			continue
+		}
+		cp.Permissions = append(cp.Permissions[:idx], cp.Permissions[idx+1:]...)
+	}
+}
+
+// Allow finds the permission that allows the action on the pair and counts
+// the call against its cap. Permissions scoped to the pair take precedence
+// over the ones on any pair.
+func (cp *ContractPermissions) Allow(action SudoAction, pair string) error {
+	idx := -1
+	for i, permission := range cp.Permissions {
+		if !permission.Matches(action, pair) || !permission.HasCallsLeft() {
+			continue
+		}
+		if idx < 0 || permission.Pair != "" {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		return ErrUnauthorized.Wrapf(
+			"contract %s is not allowed to execute %s on pair %q",
+			cp.Contract, action, pair,
+		)
+	}
+	if cp.Permissions[idx].MaxCallsPerEpoch > 0 {
+		cp.Permissions[idx].Calls++
+	}
+	return nil
+}
+
+// ResetCalls resets the calls of the permissions capped over epochs with the
+// given identifier. Returns true if any call was reset.
+func (cp *ContractPermissions) ResetCalls(epochIdentifier string) (reset bool) {
+	for i, permission := range cp.Permissions {
+		if permission.EpochIdentifier == epochIdentifier && permission.Calls > 0 {
+			cp.Permissions[i].Calls = 0
+			reset = true
+		}
+	}
+	return reset
+}
+
+func (cp ContractPermissions) indexOf(action string, pair string) int {
+	for i, permission := range cp.Permissions {
+		if permission.Action == action && permission.Pair == pair {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/sudo/types"
+)
+
+func TestPermission_Validate(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		permission types.Permission
+		wantErr    bool
+	}{
+		{
+			name:       "any pair",
+			permission: types.Permission{Action: string(types.PegShift)},
+		},
+		{
+			name: "capped on a pair",
+			permission: types.Permission{
+				Action: string(types.PegShift), Pair: "ubtc:unusd",
+				MaxCallsPerEpoch: 1, EpochIdentifier: "day",
+			},
+		},
+		{
+			name:       "unknown action",
+			permission: types.Permission{Action: "mint"},
+			wantErr:    true,
+		},
+		{
+			name:       "invalid pair",
+			permission: types.Permission{Action: string(types.PegShift), Pair: "ubtc"},
+			wantErr:    true,
+		},
+		{
+			name:       "pair on an action without market",
+			permission: types.Permission{Action: string(types.InsuranceFundWithdraw), Pair: "ubtc:unusd"},
+			wantErr:    true,
+		},
+		{
+			name:       "cap without epoch",
+			permission: types.Permission{Action: string(types.PegShift), MaxCallsPerEpoch: 1},
+			wantErr:    true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.permission.Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestContractPermissions_Allow(t *testing.T) {
+	permissions := types.ContractPermissions{Contract: "contract"}
+	permissions.Grant([]types.Permission{
+		{Action: string(types.DepthShift)},
+		{Action: string(types.DepthShift), Pair: "ubtc:unusd", MaxCallsPerEpoch: 1, EpochIdentifier: "day"},
+	})
+
+	t.Log("the permission on the pair is used first")
+	require.NoError(t, permissions.Allow(types.DepthShift, "ubtc:unusd"))
+	require.EqualValues(t, 1, permissions.Permissions[1].Calls)
+
+	t.Log("then the permission on any pair")
+	require.NoError(t, permissions.Allow(types.DepthShift, "ubtc:unusd"))
+	require.EqualValues(t, 0, permissions.Permissions[0].Calls)
+
+	require.ErrorIs(t, permissions.Allow(types.PegShift, "ubtc:unusd"), types.ErrUnauthorized)
+
+	require.True(t, permissions.ResetCalls("day"))
+	require.False(t, permissions.ResetCalls("day"))
+
+	permissions.Revoke([]types.Permission{{Action: string(types.DepthShift)}})
+	require.Len(t, permissions.Permissions, 1)
+	require.Equal(t, "ubtc:unusd", permissions.Permissions[0].Pair)
+}
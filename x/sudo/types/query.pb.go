@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -110,33 +111,431 @@ func (m *QuerySudoersResponse) GetSudoers() Sudoers {
 	return Sudoers{}
 }
 
+type QueryPermissionsRequest struct {
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *QueryPermissionsRequest) Reset()         { *m = QueryPermissionsRequest{} }
+func (m *QueryPermissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPermissionsRequest) ProtoMessage()    {}
+func (*QueryPermissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{2}
+}
+func (m *QueryPermissionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPermissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPermissionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPermissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPermissionsRequest.Merge(m, src)
+}
+func (m *QueryPermissionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPermissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPermissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPermissionsRequest proto.InternalMessageInfo
+
+func (m *QueryPermissionsRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// QueryPermissionsResponse returns the permissions of a sudo contract.
+type QueryPermissionsResponse struct {
+	Permissions ContractPermissions `protobuf:"bytes,1,opt,name=permissions,proto3" json:"permissions"`
+	// Unrestricted: Whether the contract is allowed to execute every action.
+	Unrestricted bool `protobuf:"varint,2,opt,name=unrestricted,proto3" json:"unrestricted,omitempty"`
+}
+
+func (m *QueryPermissionsResponse) Reset()         { *m = QueryPermissionsResponse{} }
+func (m *QueryPermissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPermissionsResponse) ProtoMessage()    {}
+func (*QueryPermissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{3}
+}
+func (m *QueryPermissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPermissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPermissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPermissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPermissionsResponse.Merge(m, src)
+}
+func (m *QueryPermissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPermissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPermissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPermissionsResponse proto.InternalMessageInfo
+
+func (m *QueryPermissionsResponse) GetPermissions() ContractPermissions {
+	if m != nil {
+		return m.Permissions
+	}
+	return ContractPermissions{}
+}
+
+func (m *QueryPermissionsResponse) GetUnrestricted() bool {
+	if m != nil {
+		return m.Unrestricted
+	}
+	return false
+}
+
+type QueryRootConfigRequest struct {
+}
+
+func (m *QueryRootConfigRequest) Reset()         { *m = QueryRootConfigRequest{} }
+func (m *QueryRootConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRootConfigRequest) ProtoMessage()    {}
+func (*QueryRootConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{4}
+}
+func (m *QueryRootConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRootConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRootConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRootConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRootConfigRequest.Merge(m, src)
+}
+func (m *QueryRootConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRootConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRootConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRootConfigRequest proto.InternalMessageInfo
+
+type QueryRootConfigResponse struct {
+	Config RootConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config"`
+}
+
+func (m *QueryRootConfigResponse) Reset()         { *m = QueryRootConfigResponse{} }
+func (m *QueryRootConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRootConfigResponse) ProtoMessage()    {}
+func (*QueryRootConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{5}
+}
+func (m *QueryRootConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRootConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRootConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRootConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRootConfigResponse.Merge(m, src)
+}
+func (m *QueryRootConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRootConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRootConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRootConfigResponse proto.InternalMessageInfo
+
+func (m *QueryRootConfigResponse) GetConfig() RootConfig {
+	if m != nil {
+		return m.Config
+	}
+	return RootConfig{}
+}
+
+type QueryPendingRootActionsRequest struct {
+}
+
+func (m *QueryPendingRootActionsRequest) Reset()         { *m = QueryPendingRootActionsRequest{} }
+func (m *QueryPendingRootActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRootActionsRequest) ProtoMessage()    {}
+func (*QueryPendingRootActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{6}
+}
+func (m *QueryPendingRootActionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRootActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRootActionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRootActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRootActionsRequest.Merge(m, src)
+}
+func (m *QueryPendingRootActionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRootActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRootActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRootActionsRequest proto.InternalMessageInfo
+
+type QueryPendingRootActionsResponse struct {
+	PendingRootActions []PendingRootAction `protobuf:"bytes,1,rep,name=pending_root_actions,json=pendingRootActions,proto3" json:"pending_root_actions"`
+}
+
+func (m *QueryPendingRootActionsResponse) Reset()         { *m = QueryPendingRootActionsResponse{} }
+func (m *QueryPendingRootActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRootActionsResponse) ProtoMessage()    {}
+func (*QueryPendingRootActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{7}
+}
+func (m *QueryPendingRootActionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRootActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRootActionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRootActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRootActionsResponse.Merge(m, src)
+}
+func (m *QueryPendingRootActionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRootActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRootActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRootActionsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRootActionsResponse) GetPendingRootActions() []PendingRootAction {
+	if m != nil {
+		return m.PendingRootActions
+	}
+	return nil
+}
+
+type QueryAuditLogRequest struct {
+	// Contract: Only returns the records of the contract if set.
+	Contract   string             `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuditLogRequest) Reset()         { *m = QueryAuditLogRequest{} }
+func (m *QueryAuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogRequest) ProtoMessage()    {}
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{8}
+}
+func (m *QueryAuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuditLogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogRequest.Merge(m, src)
+}
+func (m *QueryAuditLogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuditLogRequest proto.InternalMessageInfo
+
+func (m *QueryAuditLogRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryAuditLogRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAuditLogResponse struct {
+	Records    []AuditRecord       `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuditLogResponse) Reset()         { *m = QueryAuditLogResponse{} }
+func (m *QueryAuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogResponse) ProtoMessage()    {}
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c5c8e03d8d77d77, []int{9}
+}
+func (m *QueryAuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuditLogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogResponse.Merge(m, src)
+}
+func (m *QueryAuditLogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuditLogResponse proto.InternalMessageInfo
+
+func (m *QueryAuditLogResponse) GetRecords() []AuditRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryAuditLogResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QuerySudoersRequest)(nil), "nibiru.sudo.v1.QuerySudoersRequest")
 	proto.RegisterType((*QuerySudoersResponse)(nil), "nibiru.sudo.v1.QuerySudoersResponse")
+	proto.RegisterType((*QueryPermissionsRequest)(nil), "nibiru.sudo.v1.QueryPermissionsRequest")
+	proto.RegisterType((*QueryPermissionsResponse)(nil), "nibiru.sudo.v1.QueryPermissionsResponse")
+	proto.RegisterType((*QueryRootConfigRequest)(nil), "nibiru.sudo.v1.QueryRootConfigRequest")
+	proto.RegisterType((*QueryRootConfigResponse)(nil), "nibiru.sudo.v1.QueryRootConfigResponse")
+	proto.RegisterType((*QueryPendingRootActionsRequest)(nil), "nibiru.sudo.v1.QueryPendingRootActionsRequest")
+	proto.RegisterType((*QueryPendingRootActionsResponse)(nil), "nibiru.sudo.v1.QueryPendingRootActionsResponse")
+	proto.RegisterType((*QueryAuditLogRequest)(nil), "nibiru.sudo.v1.QueryAuditLogRequest")
+	proto.RegisterType((*QueryAuditLogResponse)(nil), "nibiru.sudo.v1.QueryAuditLogResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/sudo/v1/query.proto", fileDescriptor_3c5c8e03d8d77d77) }
 
 var fileDescriptor_3c5c8e03d8d77d77 = []byte{
-	// 280 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0xcb, 0x4c, 0xca,
-	0x2c, 0x2a, 0xd5, 0x2f, 0x2e, 0x4d, 0xc9, 0xd7, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa,
-	0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xe9, 0x81, 0xe4, 0xf4, 0xca, 0x0c,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x4c, 0x7a,
-	0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e, 0x49, 0x62,
-	0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x16, 0xdd, 0xfc, 0xe2, 0x92, 0xc4, 0x92, 0x54, 0x88, 0x9c,
-	0x92, 0x28, 0x97, 0x70, 0x20, 0xc8, 0xba, 0xe0, 0xd2, 0x94, 0xfc, 0xd4, 0xa2, 0xe2, 0xa0, 0xd4,
-	0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x25, 0x7f, 0x2e, 0x11, 0x54, 0xe1, 0xe2, 0x82, 0xfc, 0xbc, 0xe2,
-	0x54, 0x21, 0x73, 0x2e, 0xf6, 0x62, 0x88, 0x90, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xb8,
-	0x1e, 0xaa, 0x03, 0xf5, 0xa0, 0x3a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xa9, 0x36,
-	0x6a, 0x60, 0xe4, 0x62, 0x05, 0x9b, 0x28, 0x54, 0xce, 0xc5, 0x83, 0x6c, 0xb4, 0x90, 0x32, 0xba,
-	0x09, 0x58, 0xdc, 0x23, 0xa5, 0x82, 0x5f, 0x11, 0xc4, 0x75, 0x4a, 0x32, 0x4d, 0x97, 0x9f, 0x4c,
-	0x66, 0x12, 0x13, 0x12, 0xd1, 0x47, 0xf6, 0x31, 0xd4, 0x09, 0x4e, 0x2e, 0x27, 0x1e, 0xc9, 0x31,
-	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
-	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c,
-	0x9f, 0xab, 0xef, 0x07, 0xd6, 0xe9, 0x9c, 0x91, 0x98, 0x99, 0x07, 0x33, 0xa5, 0x02, 0x62, 0x4e,
-	0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xdc, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x97, 0xc1, 0x8d, 0xe0, 0xb5, 0x01, 0x00, 0x00,
+	// 715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcf, 0x6f, 0xd3, 0x4a,
+	0x10, 0xc7, 0xe3, 0xbe, 0xd7, 0x1f, 0x6f, 0xd3, 0xf7, 0x1e, 0x5a, 0xd2, 0xd6, 0x32, 0xc5, 0x4d,
+	0x5d, 0xda, 0x86, 0x22, 0xd9, 0x4a, 0x10, 0x02, 0x89, 0x53, 0x5b, 0x04, 0x07, 0x10, 0x14, 0xf7,
+	0x04, 0x97, 0xca, 0x71, 0x16, 0x77, 0x45, 0xe3, 0x75, 0xbd, 0xeb, 0x42, 0xa1, 0x5c, 0x10, 0x37,
+	0x2e, 0x08, 0xce, 0x1c, 0xf9, 0x47, 0x38, 0xf5, 0x58, 0x89, 0x0b, 0x27, 0x84, 0x5a, 0xfe, 0x10,
+	0xe4, 0xdd, 0x49, 0xeb, 0x1f, 0x09, 0xe9, 0x2d, 0xd9, 0xf9, 0xce, 0xcc, 0x67, 0xc7, 0xf3, 0xb5,
+	0x91, 0x11, 0xd2, 0x36, 0x8d, 0x13, 0x87, 0x27, 0x1d, 0xe6, 0xec, 0x35, 0x9d, 0xdd, 0x84, 0xc4,
+	0xfb, 0x76, 0x14, 0x33, 0xc1, 0xf0, 0x7f, 0x2a, 0x66, 0xa7, 0x31, 0x7b, 0xaf, 0x69, 0xd4, 0x02,
+	0x16, 0x30, 0x19, 0x72, 0xd2, 0x5f, 0x4a, 0x65, 0xcc, 0x06, 0x8c, 0x05, 0x3b, 0xc4, 0xf1, 0x22,
+	0xea, 0x78, 0x61, 0xc8, 0x84, 0x27, 0x28, 0x0b, 0x39, 0x44, 0x57, 0x7c, 0xc6, 0xbb, 0x8c, 0x3b,
+	0x6d, 0x8f, 0x13, 0x55, 0xdc, 0xd9, 0x6b, 0xb6, 0x89, 0xf0, 0x9a, 0x4e, 0xe4, 0x05, 0x34, 0x94,
+	0x62, 0xd0, 0x16, 0x59, 0xbc, 0xa4, 0x43, 0xc5, 0x80, 0x18, 0x17, 0x9e, 0x20, 0x10, 0xbb, 0x5c,
+	0x88, 0x09, 0xda, 0x25, 0x3b, 0xcc, 0x7f, 0xae, 0xc2, 0xd6, 0x14, 0xba, 0xf8, 0x38, 0x6d, 0xbc,
+	0x99, 0x74, 0x18, 0x89, 0xb9, 0x4b, 0x76, 0x13, 0xc2, 0x85, 0xf5, 0x08, 0xd5, 0xf2, 0xc7, 0x3c,
+	0x62, 0x21, 0x27, 0xf8, 0x26, 0x1a, 0xe7, 0xea, 0x48, 0xd7, 0xea, 0x5a, 0xa3, 0xda, 0x9a, 0xb1,
+	0xf3, 0x73, 0xb0, 0x21, 0x63, 0xed, 0xef, 0xc3, 0x1f, 0x73, 0x15, 0xb7, 0xa7, 0xb6, 0x6e, 0xa0,
+	0x19, 0x59, 0x70, 0x83, 0xc4, 0x5d, 0xca, 0x79, 0x3a, 0x04, 0xe8, 0x85, 0x0d, 0x34, 0xe1, 0xb3,
+	0x50, 0xc4, 0x9e, 0x2f, 0x64, 0xd1, 0x7f, 0xdc, 0xd3, 0xff, 0xd6, 0x7b, 0x0d, 0xe9, 0xe5, 0x3c,
+	0x80, 0xb9, 0x8f, 0xaa, 0xd1, 0xd9, 0x31, 0x00, 0x2d, 0x14, 0x81, 0xd6, 0xa1, 0x56, 0xa6, 0x02,
+	0xc0, 0x65, 0xb3, 0xb1, 0x85, 0x26, 0x93, 0x30, 0x26, 0x5c, 0xc4, 0xd4, 0x17, 0xa4, 0xa3, 0x8f,
+	0xd4, 0xb5, 0xc6, 0x84, 0x9b, 0x3b, 0xb3, 0x74, 0x34, 0x2d, 0x61, 0x5c, 0xc6, 0xc4, 0x3a, 0x0b,
+	0x9f, 0xd1, 0xa0, 0x37, 0xaf, 0x4d, 0x34, 0x53, 0x8a, 0x00, 0xe5, 0x2d, 0x34, 0xe6, 0xcb, 0x13,
+	0x00, 0x34, 0x8a, 0x80, 0x67, 0x39, 0xc0, 0x05, 0x7a, 0xab, 0x8e, 0x4c, 0xb8, 0x7b, 0xd8, 0xa1,
+	0x61, 0x90, 0xea, 0x56, 0x7d, 0x91, 0x19, 0x9d, 0x75, 0x80, 0xe6, 0x06, 0x2a, 0xa0, 0xfd, 0x13,
+	0x54, 0x8b, 0x54, 0x74, 0x2b, 0x66, 0x4c, 0x6c, 0x79, 0x2a, 0xae, 0x6b, 0xf5, 0xbf, 0x1a, 0xd5,
+	0xd6, 0x7c, 0x11, 0xa6, 0x54, 0x09, 0x98, 0x70, 0x54, 0x6a, 0x61, 0xbd, 0x82, 0x25, 0x59, 0x4d,
+	0x57, 0xf1, 0x01, 0x0b, 0xce, 0xf1, 0x40, 0xf1, 0x5d, 0x84, 0xce, 0x56, 0x5b, 0x0e, 0xb9, 0xda,
+	0x5a, 0xb2, 0x95, 0x0f, 0xec, 0xd4, 0x07, 0xb6, 0x32, 0x19, 0xf8, 0xc0, 0xde, 0xf0, 0x02, 0x02,
+	0x75, 0xdd, 0x4c, 0xa6, 0xf5, 0x59, 0x43, 0x53, 0x85, 0xe6, 0x70, 0xe1, 0xdb, 0x68, 0x3c, 0x26,
+	0x3e, 0x8b, 0x3b, 0xbd, 0x3b, 0x5e, 0x2a, 0xde, 0x51, 0xa6, 0xb8, 0x52, 0xd3, 0x5b, 0x53, 0xc8,
+	0xc0, 0xf7, 0xfa, 0xe0, 0x2d, 0x0f, 0xc5, 0x53, 0x9d, 0xb3, 0x7c, 0xad, 0xaf, 0xa3, 0x68, 0x54,
+	0xf2, 0xe1, 0x17, 0x68, 0x32, 0x6b, 0x25, 0x5c, 0x5a, 0xd0, 0x3e, 0xfe, 0x33, 0xae, 0xfc, 0x59,
+	0xa4, 0x1a, 0x5a, 0xb3, 0x6f, 0xbf, 0xfd, 0xfa, 0x34, 0x32, 0x8d, 0x6b, 0x4e, 0xd6, 0xe4, 0x60,
+	0x39, 0xfc, 0x51, 0x43, 0x17, 0x8a, 0xde, 0xc1, 0xcb, 0x7d, 0x0b, 0x97, 0x5d, 0x69, 0x34, 0x86,
+	0x0b, 0x81, 0xe2, 0x9a, 0xa4, 0x58, 0xc4, 0x0b, 0x39, 0x8a, 0x8c, 0xb7, 0x9c, 0xd7, 0xbd, 0xc7,
+	0xff, 0x06, 0xbf, 0xd3, 0xd0, 0xff, 0x05, 0xa7, 0xe0, 0xa5, 0xbe, 0xad, 0x4a, 0x26, 0x33, 0x96,
+	0x87, 0xea, 0x80, 0xa8, 0x2e, 0x89, 0x0c, 0xac, 0xe7, 0x88, 0xe4, 0xfa, 0x2b, 0x6b, 0xe1, 0x2f,
+	0xda, 0xe9, 0xfb, 0xa8, 0xb8, 0xd6, 0xd8, 0x1e, 0x70, 0xf3, 0x01, 0x26, 0x34, 0x9c, 0x73, 0xeb,
+	0x01, 0xef, 0xaa, 0xc4, 0x5b, 0xc0, 0xf3, 0x85, 0x81, 0x95, 0x5d, 0x8a, 0x0f, 0xd0, 0xbf, 0xb9,
+	0x2d, 0xc7, 0xfd, 0x17, 0xa3, 0xe0, 0x40, 0x63, 0x71, 0x88, 0x0a, 0x40, 0x4c, 0x09, 0xa2, 0xe3,
+	0xe9, 0x1c, 0x88, 0xfc, 0xb2, 0x6c, 0xed, 0xb0, 0x60, 0xed, 0xce, 0xe1, 0xb1, 0xa9, 0x1d, 0x1d,
+	0x9b, 0xda, 0xcf, 0x63, 0x53, 0xfb, 0x70, 0x62, 0x56, 0x8e, 0x4e, 0xcc, 0xca, 0xf7, 0x13, 0xb3,
+	0xf2, 0x74, 0x25, 0xa0, 0x62, 0x3b, 0x69, 0xdb, 0x3e, 0xeb, 0x3a, 0x0f, 0x65, 0xee, 0xfa, 0xb6,
+	0x47, 0xc3, 0x5e, 0x9d, 0x97, 0xaa, 0x92, 0xd8, 0x8f, 0x08, 0x6f, 0x8f, 0xc9, 0x2f, 0xcd, 0xf5,
+	0xdf, 0x03, 0x00, 0x60, 0xcc, 0x69, 0xa5, 0x4e, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -152,6 +551,15 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	QuerySudoers(ctx context.Context, in *QuerySudoersRequest, opts ...grpc.CallOption) (*QuerySudoersResponse, error)
+	// QueryPermissions returns the permissions of a sudo contract.
+	QueryPermissions(ctx context.Context, in *QueryPermissionsRequest, opts ...grpc.CallOption) (*QueryPermissionsResponse, error)
+	// QueryRootConfig returns the configuration of the root actions.
+	QueryRootConfig(ctx context.Context, in *QueryRootConfigRequest, opts ...grpc.CallOption) (*QueryRootConfigResponse, error)
+	// QueryPendingRootActions returns the queued root actions.
+	QueryPendingRootActions(ctx context.Context, in *QueryPendingRootActionsRequest, opts ...grpc.CallOption) (*QueryPendingRootActionsResponse, error)
+	// QueryAuditLog returns the most recent privileged executions of the sudo
+	// contracts, optionally filtered by contract.
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
 }
 
 type queryClient struct {
@@ -171,9 +579,54 @@ func (c *queryClient) QuerySudoers(ctx context.Context, in *QuerySudoersRequest,
 	return out, nil
 }
 
+func (c *queryClient) QueryPermissions(ctx context.Context, in *QueryPermissionsRequest, opts ...grpc.CallOption) (*QueryPermissionsResponse, error) {
+	out := new(QueryPermissionsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Query/QueryPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryRootConfig(ctx context.Context, in *QueryRootConfigRequest, opts ...grpc.CallOption) (*QueryRootConfigResponse, error) {
+	out := new(QueryRootConfigResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Query/QueryRootConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPendingRootActions(ctx context.Context, in *QueryPendingRootActionsRequest, opts ...grpc.CallOption) (*QueryPendingRootActionsResponse, error) {
+	out := new(QueryPendingRootActionsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Query/QueryPendingRootActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Query/QueryAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	QuerySudoers(context.Context, *QuerySudoersRequest) (*QuerySudoersResponse, error)
+	// QueryPermissions returns the permissions of a sudo contract.
+	QueryPermissions(context.Context, *QueryPermissionsRequest) (*QueryPermissionsResponse, error)
+	// QueryRootConfig returns the configuration of the root actions.
+	QueryRootConfig(context.Context, *QueryRootConfigRequest) (*QueryRootConfigResponse, error)
+	// QueryPendingRootActions returns the queued root actions.
+	QueryPendingRootActions(context.Context, *QueryPendingRootActionsRequest) (*QueryPendingRootActionsResponse, error)
+	// QueryAuditLog returns the most recent privileged executions of the sudo
+	// contracts, optionally filtered by contract.
+	QueryAuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -183,6 +636,18 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) QuerySudoers(ctx context.Context, req *QuerySudoersRequest) (*QuerySudoersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuerySudoers not implemented")
 }
+func (*UnimplementedQueryServer) QueryPermissions(ctx context.Context, req *QueryPermissionsRequest) (*QueryPermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPermissions not implemented")
+}
+func (*UnimplementedQueryServer) QueryRootConfig(ctx context.Context, req *QueryRootConfigRequest) (*QueryRootConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRootConfig not implemented")
+}
+func (*UnimplementedQueryServer) QueryPendingRootActions(ctx context.Context, req *QueryPendingRootActionsRequest) (*QueryPendingRootActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingRootActions not implemented")
+}
+func (*UnimplementedQueryServer) QueryAuditLog(ctx context.Context, req *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -206,27 +671,115 @@ func _Query_QuerySudoers_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "nibiru.sudo.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QuerySudoers",
-			Handler:    _Query_QuerySudoers_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "nibiru/sudo/v1/query.proto",
-}
-
-func (m *QuerySudoersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPermissionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Query/QueryPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPermissions(ctx, req.(*QueryPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRootConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRootConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRootConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Query/QueryRootConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRootConfig(ctx, req.(*QueryRootConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPendingRootActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRootActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPendingRootActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Query/QueryPendingRootActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPendingRootActions(ctx, req.(*QueryPendingRootActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Query/QueryAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nibiru.sudo.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QuerySudoers",
+			Handler:    _Query_QuerySudoers_Handler,
+		},
+		{
+			MethodName: "QueryPermissions",
+			Handler:    _Query_QueryPermissions_Handler,
+		},
+		{
+			MethodName: "QueryRootConfig",
+			Handler:    _Query_QueryRootConfig_Handler,
+		},
+		{
+			MethodName: "QueryPendingRootActions",
+			Handler:    _Query_QueryPendingRootActions_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _Query_QueryAuditLog_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nibiru/sudo/v1/query.proto",
+}
+
+func (m *QuerySudoersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
 func (m *QuerySudoersRequest) MarshalTo(dAtA []byte) (int, error) {
@@ -267,52 +820,1024 @@ func (m *QuerySudoersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPermissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPermissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPermissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPermissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPermissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPermissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unrestricted {
+		i--
+		if m.Unrestricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Permissions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRootConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRootConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRootConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRootConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRootConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRootConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRootActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRootActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRootActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRootActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRootActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRootActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingRootActions) > 0 {
+		for iNdEx := len(m.PendingRootActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRootActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuditLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuditLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuditLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuditLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuditLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuditLogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySudoersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySudoersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sudoers.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPermissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPermissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Permissions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Unrestricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRootConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRootConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingRootActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingRootActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingRootActions) > 0 {
+		for _, e := range m.PendingRootActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAuditLogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuditLogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySudoersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySudoersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySudoersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySudoersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySudoersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySudoersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sudoers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sudoers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPermissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPermissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPermissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPermissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPermissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPermissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Permissions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unrestricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unrestricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRootConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRootConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRootConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRootConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRootConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRootConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QuerySudoersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingRootActionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRootActionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRootActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QuerySudoersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Sudoers.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryPendingRootActionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRootActionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRootActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRootActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRootActions = append(m.PendingRootActions, PendingRootAction{})
+			if err := m.PendingRootActions[len(m.PendingRootActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuerySudoersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuditLogRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -335,12 +1860,80 @@ func (m *QuerySudoersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySudoersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuditLogRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySudoersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuditLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -362,7 +1955,7 @@ func (m *QuerySudoersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySudoersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuditLogResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -385,15 +1978,15 @@ func (m *QuerySudoersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySudoersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuditLogResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySudoersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuditLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sudoers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -420,7 +2013,44 @@ func (m *QuerySudoersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sudoers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, AuditRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -77,23 +77,33 @@ func (m *Sudoers) GetContracts() []string {
 	return nil
 }
 
-// GenesisState defines the module's genesis state.
-type GenesisState struct {
-	Sudoers Sudoers `protobuf:"bytes,1,opt,name=sudoers,proto3" json:"sudoers"`
+// Permission: An action that a sudo contract is allowed to execute.
+type Permission struct {
+	// Action: Name of the permissioned action, e.g. "peg_shift".
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// Pair: Market to which the action is scoped. Any market if empty.
+	Pair string `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	// MaxCallsPerEpoch: Cap on the number of calls of the action per epoch.
+	// No cap if zero.
+	MaxCallsPerEpoch uint64 `protobuf:"varint,3,opt,name=max_calls_per_epoch,json=maxCallsPerEpoch,proto3" json:"max_calls_per_epoch,omitempty"`
+	// EpochIdentifier: Identifier of the epochs over which the calls are capped.
+	EpochIdentifier string `protobuf:"bytes,4,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+	// Calls: Number of calls of the action in the current epoch.
+	Calls uint64 `protobuf:"varint,5,opt,name=calls,proto3" json:"calls,omitempty"`
 }
 
-func (m *GenesisState) Reset()         { *m = GenesisState{} }
-func (m *GenesisState) String() string { return proto.CompactTextString(m) }
-func (*GenesisState) ProtoMessage()    {}
-func (*GenesisState) Descriptor() ([]byte, []int) {
+func (m *Permission) Reset()         { *m = Permission{} }
+func (m *Permission) String() string { return proto.CompactTextString(m) }
+func (*Permission) ProtoMessage()    {}
+func (*Permission) Descriptor() ([]byte, []int) {
 	return fileDescriptor_4b462ff6aaf658cf, []int{1}
 }
-func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+func (m *Permission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Permission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Permission.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -103,51 +113,227 @@ func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *GenesisState) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenesisState.Merge(m, src)
+func (m *Permission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Permission.Merge(m, src)
 }
-func (m *GenesisState) XXX_Size() int {
+func (m *Permission) XXX_Size() int {
 	return m.Size()
 }
-func (m *GenesisState) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+func (m *Permission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Permission.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+var xxx_messageInfo_Permission proto.InternalMessageInfo
 
-func (m *GenesisState) GetSudoers() Sudoers {
+func (m *Permission) GetAction() string {
 	if m != nil {
-		return m.Sudoers
+		return m.Action
 	}
-	return Sudoers{}
+	return ""
+}
+
+func (m *Permission) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *Permission) GetMaxCallsPerEpoch() uint64 {
+	if m != nil {
+		return m.MaxCallsPerEpoch
+	}
+	return 0
+}
+
+func (m *Permission) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+func (m *Permission) GetCalls() uint64 {
+	if m != nil {
+		return m.Calls
+	}
+	return 0
+}
+
+// ContractPermissions: The actions that a sudo contract is allowed to execute.
+// A sudo contract without permissions is allowed to execute every action.
+type ContractPermissions struct {
+	// Contract: Address of the sudo contract.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Permissions: The permissions granted to the contract.
+	Permissions []Permission `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions"`
+}
+
+func (m *ContractPermissions) Reset()         { *m = ContractPermissions{} }
+func (m *ContractPermissions) String() string { return proto.CompactTextString(m) }
+func (*ContractPermissions) ProtoMessage()    {}
+func (*ContractPermissions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{2}
+}
+func (m *ContractPermissions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractPermissions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractPermissions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractPermissions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractPermissions.Merge(m, src)
+}
+func (m *ContractPermissions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractPermissions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractPermissions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractPermissions proto.InternalMessageInfo
+
+func (m *ContractPermissions) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *ContractPermissions) GetPermissions() []Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+// RootConfig: Configuration of the timelock and of the approvals required by
+// the root actions, i.e. MsgEditSudoers, MsgChangeRoot and MsgUpdateRootConfig.
+type RootConfig struct {
+	// TimelockBlocks: Number of blocks that a root action waits in the pending
+	// queue before it is executed. Root actions are executed immediately if zero
+	// and the threshold is at most one.
+	TimelockBlocks uint64 `protobuf:"varint,1,opt,name=timelock_blocks,json=timelockBlocks,proto3" json:"timelock_blocks,omitempty"`
+	// Guardian: Address allowed to cancel pending root actions, along with the
+	// root.
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// Signers: Addresses whose approvals count toward the threshold. The root is
+	// always a signer.
+	Signers []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	// Threshold: Number of distinct signers that must approve a pending root
+	// action before it is executed.
+	Threshold uint32 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *RootConfig) Reset()         { *m = RootConfig{} }
+func (m *RootConfig) String() string { return proto.CompactTextString(m) }
+func (*RootConfig) ProtoMessage()    {}
+func (*RootConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b462ff6aaf658cf, []int{3}
+}
+func (m *RootConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RootConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RootConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RootConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RootConfig.Merge(m, src)
+}
+func (m *RootConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *RootConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_RootConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RootConfig proto.InternalMessageInfo
+
+func (m *RootConfig) GetTimelockBlocks() uint64 {
+	if m != nil {
+		return m.TimelockBlocks
+	}
+	return 0
+}
+
+func (m *RootConfig) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *RootConfig) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *RootConfig) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
 }
 
 func init() {
 	proto.RegisterType((*Sudoers)(nil), "nibiru.sudo.v1.Sudoers")
-	proto.RegisterType((*GenesisState)(nil), "nibiru.sudo.v1.GenesisState")
+	proto.RegisterType((*Permission)(nil), "nibiru.sudo.v1.Permission")
+	proto.RegisterType((*ContractPermissions)(nil), "nibiru.sudo.v1.ContractPermissions")
+	proto.RegisterType((*RootConfig)(nil), "nibiru.sudo.v1.RootConfig")
 }
 
 func init() { proto.RegisterFile("nibiru/sudo/v1/state.proto", fileDescriptor_4b462ff6aaf658cf) }
 
 var fileDescriptor_4b462ff6aaf658cf = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0xcb, 0x4c, 0xca,
-	0x2c, 0x2a, 0xd5, 0x2f, 0x2e, 0x4d, 0xc9, 0xd7, 0x2f, 0x33, 0xd4, 0x2f, 0x2e, 0x49, 0x2c, 0x49,
-	0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xe9, 0x81, 0xe4, 0xf4, 0xca, 0x0c,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x4c, 0x7a,
-	0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e, 0x49, 0x62,
-	0x49, 0x66, 0x7e, 0x5e, 0x31, 0x44, 0x56, 0xc9, 0x91, 0x8b, 0x3d, 0xb8, 0x34, 0x25, 0x3f, 0xb5,
-	0xa8, 0x58, 0x48, 0x88, 0x8b, 0xa5, 0x28, 0x3f, 0xbf, 0x44, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33,
-	0x08, 0xcc, 0x16, 0x92, 0xe1, 0xe2, 0x4c, 0xce, 0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x29, 0x96,
-	0x60, 0x52, 0x60, 0xd6, 0xe0, 0x0c, 0x42, 0x08, 0x58, 0xb1, 0xcc, 0x58, 0x20, 0xcf, 0xa0, 0xe4,
-	0xce, 0xc5, 0xe3, 0x9e, 0x9a, 0x97, 0x5a, 0x9c, 0x59, 0x1c, 0x0c, 0x72, 0x9c, 0x90, 0x39, 0x17,
-	0x7b, 0x31, 0xc4, 0x48, 0xb0, 0x51, 0xdc, 0x46, 0xe2, 0x7a, 0xa8, 0x0e, 0xd5, 0x83, 0xda, 0xe8,
-	0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x4c, 0xb5, 0x93, 0xcb, 0x89, 0x47, 0x72, 0x8c, 0x17,
-	0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c,
-	0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7,
-	0xea, 0xfb, 0x81, 0xcd, 0x72, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x87, 0x06, 0x4e, 0x05, 0x24, 0x78,
-	0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x1e, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0x5e, 0x2c, 0x41, 0x6d, 0x3a, 0x01, 0x00, 0x00,
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x52, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xb6, 0x1b, 0xb7, 0x25, 0xaf, 0xa2, 0xad, 0xae, 0x15, 0xb2, 0xac, 0xca, 0x8d, 0xb2, 0x10,
+	0x90, 0xb0, 0x55, 0xd8, 0xd8, 0x48, 0x60, 0x60, 0x41, 0x95, 0xd9, 0x58, 0xac, 0x8b, 0x7d, 0xb5,
+	0x4f, 0xd8, 0xf7, 0xac, 0xbb, 0x73, 0x15, 0x7e, 0x02, 0x1b, 0x23, 0x23, 0x23, 0x3f, 0xa5, 0x63,
+	0x46, 0x26, 0x84, 0x92, 0x3f, 0x82, 0xee, 0xec, 0xc4, 0xb0, 0x58, 0xef, 0xfb, 0xbe, 0xe7, 0xef,
+	0xde, 0x7d, 0xf7, 0x20, 0x10, 0x7c, 0xc9, 0x65, 0x1b, 0xab, 0x36, 0xc7, 0xf8, 0xfe, 0x26, 0x56,
+	0x9a, 0x6a, 0x16, 0x35, 0x12, 0x35, 0x92, 0xd3, 0x4e, 0x8b, 0x8c, 0x16, 0xdd, 0xdf, 0x04, 0x97,
+	0x05, 0x16, 0x68, 0xa5, 0xd8, 0x54, 0x5d, 0x57, 0x70, 0x55, 0x20, 0x16, 0x15, 0x8b, 0x69, 0xc3,
+	0x63, 0x2a, 0x04, 0x6a, 0xaa, 0x39, 0x0a, 0xd5, 0xa9, 0xd3, 0x37, 0x70, 0xfc, 0xb1, 0xcd, 0x91,
+	0x49, 0x45, 0x08, 0x78, 0x12, 0x51, 0xfb, 0xee, 0xc4, 0x9d, 0x8d, 0x13, 0x5b, 0x93, 0x2b, 0x18,
+	0x67, 0x28, 0xb4, 0xa4, 0x99, 0x56, 0xfe, 0xc1, 0x64, 0x34, 0x1b, 0x27, 0x03, 0xf1, 0xda, 0xfb,
+	0xfe, 0xe3, 0xda, 0x99, 0xfe, 0x74, 0x01, 0x6e, 0x99, 0xac, 0xb9, 0x52, 0x1c, 0x05, 0x79, 0x02,
+	0x47, 0x34, 0x33, 0x47, 0xf4, 0x46, 0x3d, 0x32, 0xf6, 0x0d, 0xe5, 0xd2, 0x3f, 0xe8, 0xec, 0x4d,
+	0x4d, 0x5e, 0xc0, 0x45, 0x4d, 0x57, 0x69, 0x46, 0xab, 0x4a, 0xa5, 0x0d, 0x93, 0x29, 0x6b, 0x30,
+	0x2b, 0xfd, 0xd1, 0xc4, 0x9d, 0x79, 0xc9, 0x79, 0x4d, 0x57, 0x0b, 0xa3, 0xdc, 0x32, 0xf9, 0xce,
+	0xf0, 0xe4, 0x19, 0x9c, 0xdb, 0x86, 0x94, 0xe7, 0x4c, 0x68, 0x7e, 0xc7, 0x99, 0xf4, 0x3d, 0x6b,
+	0x77, 0x66, 0xf9, 0xf7, 0x7b, 0x9a, 0x5c, 0xc2, 0xa1, 0x75, 0xf5, 0x0f, 0xad, 0x57, 0x07, 0xa6,
+	0x2d, 0x5c, 0x2c, 0xfa, 0xe9, 0x87, 0x89, 0x15, 0x09, 0xe0, 0xd1, 0xee, 0x52, 0xfd, 0xd0, 0x7b,
+	0x4c, 0xe6, 0x70, 0xd2, 0x0c, 0xad, 0x36, 0x83, 0x93, 0x97, 0x41, 0xf4, 0x7f, 0xf4, 0xd1, 0xe0,
+	0x36, 0xf7, 0x1e, 0x7e, 0x5f, 0x3b, 0xc9, 0xbf, 0x3f, 0x4d, 0xbf, 0xba, 0x00, 0x09, 0xa2, 0x5e,
+	0xa0, 0xb8, 0xe3, 0x05, 0x79, 0x0a, 0x67, 0x9a, 0xd7, 0xac, 0xc2, 0xec, 0x73, 0xba, 0x34, 0x5f,
+	0x65, 0x4f, 0xf5, 0x92, 0xd3, 0x1d, 0x3d, 0xb7, 0xac, 0x99, 0xab, 0x68, 0xa9, 0xcc, 0x39, 0x15,
+	0x7d, 0x6c, 0x7b, 0x4c, 0x7c, 0x38, 0x56, 0xbc, 0x10, 0x4c, 0x2a, 0x7f, 0x64, 0xdf, 0x65, 0x07,
+	0xcd, 0x9b, 0xe9, 0x52, 0x32, 0x55, 0x62, 0x95, 0xdb, 0x78, 0x1e, 0x27, 0x03, 0x31, 0x7f, 0xfb,
+	0xb0, 0x09, 0xdd, 0xf5, 0x26, 0x74, 0xff, 0x6c, 0x42, 0xf7, 0xdb, 0x36, 0x74, 0xd6, 0xdb, 0xd0,
+	0xf9, 0xb5, 0x0d, 0x9d, 0x4f, 0xcf, 0x0b, 0xae, 0xcb, 0x76, 0x19, 0x65, 0x58, 0xc7, 0x1f, 0xec,
+	0xf5, 0x16, 0x25, 0xe5, 0x22, 0xee, 0x37, 0x70, 0xd5, 0xed, 0xa0, 0xfe, 0xd2, 0x30, 0xb5, 0x3c,
+	0xb2, 0xdb, 0xf3, 0xea, 0xef, 0x00, 0xaf, 0xc1, 0x65, 0xdf, 0x9f, 0x02, 0x00, 0x00,
 }
 
 func (m *Sudoers) Marshal() (dAtA []byte, err error) {
@@ -189,7 +375,7 @@ func (m *Sudoers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *Permission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -199,26 +385,140 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *Permission) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Permission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Sudoers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Calls != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Calls))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintState(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxCallsPerEpoch != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MaxCallsPerEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Pair) > 0 {
+		i -= len(m.Pair)
+		copy(dAtA[i:], m.Pair)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Pair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractPermissions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractPermissions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractPermissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RootConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RootConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RootConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintState(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TimelockBlocks != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TimelockBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -252,14 +552,74 @@ func (m *Sudoers) Size() (n int) {
 	return n
 }
 
-func (m *GenesisState) Size() (n int) {
+func (m *Permission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Pair)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MaxCallsPerEpoch != 0 {
+		n += 1 + sovState(uint64(m.MaxCallsPerEpoch))
+	}
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Calls != 0 {
+		n += 1 + sovState(uint64(m.Calls))
+	}
+	return n
+}
+
+func (m *ContractPermissions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, e := range m.Permissions {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RootConfig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Sudoers.Size()
-	n += 1 + l + sovState(uint64(l))
+	if m.TimelockBlocks != 0 {
+		n += 1 + sovState(uint64(m.TimelockBlocks))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovState(uint64(m.Threshold))
+	}
 	return n
 }
 
@@ -383,7 +743,7 @@ func (m *Sudoers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *Permission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -406,17 +766,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: Permission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Permission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sudoers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -426,25 +786,394 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sudoers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Pair = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallsPerEpoch", wireType)
+			}
+			m.MaxCallsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			m.Calls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Calls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractPermissions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractPermissions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractPermissions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, Permission{})
+			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RootConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RootConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RootConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockBlocks", wireType)
+			}
+			m.TimelockBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimelockBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
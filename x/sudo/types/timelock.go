@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/set"
+)
+
+// RootActionExpiryBlocks is the number of blocks, past its timelock, during
+// which a pending root action can gather its approvals before it expires.
+const RootActionExpiryBlocks int64 = 100_000
+
+// DefaultRootConfig returns a configuration where the root actions are
+// executed immediately on the signature of the root.
+func DefaultRootConfig() RootConfig {
+	return RootConfig{
+		TimelockBlocks: 0,
+		Guardian:       "",
+		Signers:        []string{},
+		Threshold:      0,
+	}
+}
+
+func (c RootConfig) Validate() error {
+	if c.Guardian != "" {
+		if _, err := sdk.AccAddressFromBech32(c.Guardian); err != nil {
+			return fmt.Errorf("invalid guardian: %w", err)
+		}
+	}
+	seen := set.New[string]()
+	for _, signer := range c.Signers {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return fmt.Errorf("invalid signer: %w", err)
+		}
+		if seen.Has(signer) {
+			return fmt.Errorf("duplicate signer %s", signer)
+		}
+		seen.Add(signer)
+	}
+	return nil
+}
+
+// ValidateThreshold checks that the root and the signers can reach the
+// threshold.
+func (c RootConfig) ValidateThreshold(root string) error {
+	signers := c.signerSet(root)
+	if int(c.Threshold) > signers.Len() {
+		return fmt.Errorf(
+			"threshold %d exceeds the number of signers %d", c.Threshold, signers.Len())
+	}
+	return nil
+}
+
+// IsImmediate returns true if the root actions skip the pending queue.
+func (c RootConfig) IsImmediate() bool {
+	return c.TimelockBlocks == 0 && c.Threshold <= 1
+}
+
+// IsSigner returns true if the address may approve pending root actions.
+func (c RootConfig) IsSigner(root string, addr string) bool {
+	return c.signerSet(root).Has(addr)
+}
+
+// CanCancel returns true if the address may cancel pending root actions.
+func (c RootConfig) CanCancel(root string, addr string) bool {
+	return addr == root || (c.Guardian != "" && addr == c.Guardian)
+}
+
+// CountApprovals returns the number of approvals of the current signers.
+func (c RootConfig) CountApprovals(root string, approvals []string) uint32 {
+	signers := c.signerSet(root)
+	count := uint32(0)
+	for _, approval := range approvals {
+		if signers.Has(approval) {
+			count++
+		}
+	}
+	return count
+}
+
+func (c RootConfig) signerSet(root string) set.Set[string] {
+	signers := set.New(c.Signers...)
+	if root != "" {
+		signers.Add(root)
+	}
+	return signers
+}
+
+// Validate checks that exactly one root action is set and that the action
+// expires after its timelock.
+func (a PendingRootAction) Validate() error {
+	numMsgs := 0
+	for _, isSet := range []bool{
+		a.EditSudoers != nil, a.ChangeRoot != nil, a.UpdateRootConfig != nil,
+	} {
+		if isSet {
+			numMsgs++
+		}
+	}
+	if numMsgs != 1 {
+		return fmt.Errorf("pending root action %d must have exactly one msg, got %d", a.Id, numMsgs)
+	}
+	if a.ExecuteHeight < 0 || a.ExpireHeight <= a.ExecuteHeight {
+		return fmt.Errorf(
+			"pending root action %d must expire after its execute height %d, got %d",
+			a.Id, a.ExecuteHeight, a.ExpireHeight)
+	}
+	return nil
+}
+
+// HasApproval returns true if the address already approved the action.
+func (a PendingRootAction) HasApproval(addr string) bool {
+	for _, approval := range a.Approvals {
+		if approval == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,677 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nibiru/sudo/v1/timelock.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingRootAction: A root action waiting in the queue for its timelock to
+// expire and for the approvals of the signers. Exactly one of the messages is
+// set.
+type PendingRootAction struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Submitter: Address of the root that queued the action.
+	Submitter string `protobuf:"bytes,2,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// ExecuteHeight: Block height from which the action can be executed.
+	ExecuteHeight int64 `protobuf:"varint,3,opt,name=execute_height,json=executeHeight,proto3" json:"execute_height,omitempty"`
+	// Approvals: Addresses of the signers that approved the action.
+	Approvals        []string             `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	EditSudoers      *MsgEditSudoers      `protobuf:"bytes,5,opt,name=edit_sudoers,json=editSudoers,proto3" json:"edit_sudoers,omitempty"`
+	ChangeRoot       *MsgChangeRoot       `protobuf:"bytes,6,opt,name=change_root,json=changeRoot,proto3" json:"change_root,omitempty"`
+	UpdateRootConfig *MsgUpdateRootConfig `protobuf:"bytes,7,opt,name=update_root_config,json=updateRootConfig,proto3" json:"update_root_config,omitempty"`
+	// ExpireHeight: Block height from which the action can no longer be
+	// executed and is pruned from the queue.
+	ExpireHeight int64 `protobuf:"varint,8,opt,name=expire_height,json=expireHeight,proto3" json:"expire_height,omitempty"`
+}
+
+func (m *PendingRootAction) Reset()         { *m = PendingRootAction{} }
+func (m *PendingRootAction) String() string { return proto.CompactTextString(m) }
+func (*PendingRootAction) ProtoMessage()    {}
+func (*PendingRootAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3647f73499b3debf, []int{0}
+}
+func (m *PendingRootAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRootAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRootAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRootAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRootAction.Merge(m, src)
+}
+func (m *PendingRootAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRootAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRootAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRootAction proto.InternalMessageInfo
+
+func (m *PendingRootAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingRootAction) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *PendingRootAction) GetExecuteHeight() int64 {
+	if m != nil {
+		return m.ExecuteHeight
+	}
+	return 0
+}
+
+func (m *PendingRootAction) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *PendingRootAction) GetEditSudoers() *MsgEditSudoers {
+	if m != nil {
+		return m.EditSudoers
+	}
+	return nil
+}
+
+func (m *PendingRootAction) GetChangeRoot() *MsgChangeRoot {
+	if m != nil {
+		return m.ChangeRoot
+	}
+	return nil
+}
+
+func (m *PendingRootAction) GetUpdateRootConfig() *MsgUpdateRootConfig {
+	if m != nil {
+		return m.UpdateRootConfig
+	}
+	return nil
+}
+
+func (m *PendingRootAction) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PendingRootAction)(nil), "nibiru.sudo.v1.PendingRootAction")
+}
+
+func init() { proto.RegisterFile("nibiru/sudo/v1/timelock.proto", fileDescriptor_3647f73499b3debf) }
+
+var fileDescriptor_3647f73499b3debf = []byte{
+	// 374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
+	0x14, 0xc6, 0x3b, 0x4d, 0x6f, 0xef, 0xed, 0xf4, 0x0f, 0xf7, 0x0e, 0x17, 0x0c, 0xc5, 0x86, 0x60,
+	0x11, 0x82, 0x8b, 0x84, 0xea, 0x5e, 0xa8, 0x55, 0x70, 0xa3, 0x68, 0xc4, 0x8d, 0x9b, 0x90, 0x3f,
+	0xe3, 0xe4, 0x60, 0x9b, 0x09, 0xc9, 0xa4, 0xc4, 0xa5, 0x6f, 0xe0, 0x63, 0xb9, 0xec, 0xd2, 0xa5,
+	0xb4, 0x2f, 0x22, 0x99, 0x29, 0x2d, 0x96, 0xee, 0x4e, 0xbe, 0xef, 0x3b, 0xbf, 0xcc, 0x39, 0x07,
+	0x0f, 0x12, 0x08, 0x20, 0x2b, 0x9c, 0xbc, 0x88, 0xb8, 0x33, 0x1f, 0x39, 0x02, 0x66, 0x74, 0xca,
+	0xc3, 0x17, 0x3b, 0xcd, 0xb8, 0xe0, 0xa4, 0xa7, 0x6c, 0xbb, 0xb2, 0xed, 0xf9, 0xa8, 0xff, 0x9f,
+	0x71, 0xc6, 0xa5, 0xe5, 0x54, 0x95, 0x4a, 0xf5, 0x0f, 0x76, 0x21, 0xa5, 0x32, 0x8e, 0xde, 0x34,
+	0xfc, 0xef, 0x8e, 0x26, 0x11, 0x24, 0xcc, 0xe5, 0x5c, 0x8c, 0x43, 0x01, 0x3c, 0x21, 0x3d, 0x5c,
+	0x87, 0x48, 0x47, 0x26, 0xb2, 0x1a, 0x6e, 0x1d, 0x22, 0x72, 0x88, 0x5b, 0x79, 0x11, 0xcc, 0x40,
+	0x08, 0x9a, 0xe9, 0x75, 0x13, 0x59, 0x2d, 0x77, 0x2b, 0x90, 0x63, 0xdc, 0xa3, 0x25, 0x0d, 0x0b,
+	0x41, 0xbd, 0x98, 0x02, 0x8b, 0x85, 0xae, 0x99, 0xc8, 0xd2, 0xdc, 0xee, 0x5a, 0xbd, 0x96, 0x62,
+	0x05, 0xf1, 0xd3, 0x34, 0xe3, 0x73, 0x7f, 0x9a, 0xeb, 0x0d, 0x53, 0xab, 0x20, 0x1b, 0x81, 0x8c,
+	0x71, 0x87, 0x46, 0x20, 0xbc, 0xea, 0x85, 0x34, 0xcb, 0xf5, 0x5f, 0x26, 0xb2, 0xda, 0xa7, 0x86,
+	0xfd, 0x73, 0x3c, 0xfb, 0x26, 0x67, 0x57, 0x11, 0x88, 0x07, 0x95, 0x72, 0xdb, 0x74, 0xfb, 0x41,
+	0xce, 0x71, 0x3b, 0x8c, 0xfd, 0x84, 0x51, 0x2f, 0xe3, 0x5c, 0xe8, 0x4d, 0x49, 0x18, 0xec, 0x21,
+	0x4c, 0x64, 0xaa, 0x9a, 0xd7, 0xc5, 0xe1, 0xa6, 0x26, 0xf7, 0x98, 0x14, 0x69, 0xe4, 0x0b, 0xd5,
+	0xef, 0x85, 0x3c, 0x79, 0x06, 0xa6, 0xff, 0x96, 0x98, 0xe1, 0x1e, 0xcc, 0xa3, 0x0c, 0x57, 0xad,
+	0x13, 0x19, 0x75, 0xff, 0x16, 0x3b, 0x0a, 0x19, 0xe2, 0x2e, 0x2d, 0x53, 0xc8, 0x36, 0x9b, 0xf9,
+	0x23, 0x37, 0xd3, 0x51, 0xa2, 0x5a, 0xcc, 0xc5, 0xe5, 0xc7, 0xd2, 0x40, 0x8b, 0xa5, 0x81, 0xbe,
+	0x96, 0x06, 0x7a, 0x5f, 0x19, 0xb5, 0xc5, 0xca, 0xa8, 0x7d, 0xae, 0x8c, 0xda, 0xd3, 0x09, 0x03,
+	0x11, 0x17, 0x81, 0x1d, 0xf2, 0x99, 0x73, 0x2b, 0xff, 0x3f, 0x89, 0x7d, 0x48, 0x9c, 0xf5, 0x35,
+	0x4b, 0x75, 0x4f, 0xf1, 0x9a, 0xd2, 0x3c, 0x68, 0xca, 0x83, 0x9e, 0x7d, 0x0f, 0x00, 0xb4, 0xcd,
+	0x0a, 0xff, 0x30, 0x02, 0x00, 0x00,
+}
+
+func (m *PendingRootAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRootAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRootAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpireHeight != 0 {
+		i = encodeVarintTimelock(dAtA, i, uint64(m.ExpireHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UpdateRootConfig != nil {
+		{
+			size, err := m.UpdateRootConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ChangeRoot != nil {
+		{
+			size, err := m.ChangeRoot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EditSudoers != nil {
+		{
+			size, err := m.EditSudoers.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintTimelock(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ExecuteHeight != 0 {
+		i = encodeVarintTimelock(dAtA, i, uint64(m.ExecuteHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTimelock(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTimelock(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTimelock(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTimelock(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingRootAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTimelock(uint64(m.Id))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	if m.ExecuteHeight != 0 {
+		n += 1 + sovTimelock(uint64(m.ExecuteHeight))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovTimelock(uint64(l))
+		}
+	}
+	if m.EditSudoers != nil {
+		l = m.EditSudoers.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	if m.ChangeRoot != nil {
+		l = m.ChangeRoot.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	if m.UpdateRootConfig != nil {
+		l = m.UpdateRootConfig.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	if m.ExpireHeight != 0 {
+		n += 1 + sovTimelock(uint64(m.ExpireHeight))
+	}
+	return n
+}
+
+func sovTimelock(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTimelock(x uint64) (n int) {
+	return sovTimelock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingRootAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRootAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRootAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteHeight", wireType)
+			}
+			m.ExecuteHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditSudoers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EditSudoers == nil {
+				m.EditSudoers = &MsgEditSudoers{}
+			}
+			if err := m.EditSudoers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeRoot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangeRoot == nil {
+				m.ChangeRoot = &MsgChangeRoot{}
+			}
+			if err := m.ChangeRoot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateRootConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateRootConfig == nil {
+				m.UpdateRootConfig = &MsgUpdateRootConfig{}
+			}
+			if err := m.UpdateRootConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireHeight", wireType)
+			}
+			m.ExpireHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpireHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTimelock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTimelock(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTimelock
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTimelock
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTimelock
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTimelock        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTimelock          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTimelock = fmt.Errorf("proto: unexpected end of group")
+)
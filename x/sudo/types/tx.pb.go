@@ -39,6 +39,9 @@ type MsgEditSudoers struct {
 	Contracts []string `protobuf:"bytes,2,rep,name=contracts,proto3" json:"contracts,omitempty"`
 	// Sender: Address for the signer of the transaction.
 	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Permissions: The permissions granted or revoked by the
+	//   "grant_permissions" and "revoke_permissions" actions.
+	Permissions []Permission `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions"`
 }
 
 func (m *MsgEditSudoers) Reset()         { *m = MsgEditSudoers{} }
@@ -95,8 +98,19 @@ func (m *MsgEditSudoers) GetSender() string {
 	return ""
 }
 
+func (m *MsgEditSudoers) GetPermissions() []Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
 // MsgEditSudoersResponse indicates the successful execution of MsgEditSudeors.
 type MsgEditSudoersResponse struct {
+	// Queued: Whether the edit was queued behind the timelock.
+	Queued bool `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`
+	// PendingRootActionId: Id of the pending root action if queued.
+	PendingRootActionId uint64 `protobuf:"varint,2,opt,name=pending_root_action_id,json=pendingRootActionId,proto3" json:"pending_root_action_id,omitempty"`
 }
 
 func (m *MsgEditSudoersResponse) Reset()         { *m = MsgEditSudoersResponse{} }
@@ -132,6 +146,20 @@ func (m *MsgEditSudoersResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEditSudoersResponse proto.InternalMessageInfo
 
+func (m *MsgEditSudoersResponse) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
+}
+
+func (m *MsgEditSudoersResponse) GetPendingRootActionId() uint64 {
+	if m != nil {
+		return m.PendingRootActionId
+	}
+	return 0
+}
+
 // MsgChangeRoot: Msg to update the "Sudoers" state.
 type MsgChangeRoot struct {
 	// Sender: Address for the signer of the transaction.
@@ -189,6 +217,10 @@ func (m *MsgChangeRoot) GetNewRoot() string {
 
 // MsgChangeRootResponse indicates the successful execution of MsgChangeRoot.
 type MsgChangeRootResponse struct {
+	// Queued: Whether the change was queued behind the timelock.
+	Queued bool `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`
+	// PendingRootActionId: Id of the pending root action if queued.
+	PendingRootActionId uint64 `protobuf:"varint,2,opt,name=pending_root_action_id,json=pendingRootActionId,proto3" json:"pending_root_action_id,omitempty"`
 }
 
 func (m *MsgChangeRootResponse) Reset()         { *m = MsgChangeRootResponse{} }
@@ -224,40 +256,385 @@ func (m *MsgChangeRootResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgChangeRootResponse proto.InternalMessageInfo
 
+func (m *MsgChangeRootResponse) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
+}
+
+func (m *MsgChangeRootResponse) GetPendingRootActionId() uint64 {
+	if m != nil {
+		return m.PendingRootActionId
+	}
+	return 0
+}
+
+// MsgUpdateRootConfig: Msg to update the timelock and the approvals required
+// by the root actions.
+type MsgUpdateRootConfig struct {
+	// Sender: Address for the signer of the transaction.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Config: New configuration of the root actions.
+	Config RootConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+}
+
+func (m *MsgUpdateRootConfig) Reset()         { *m = MsgUpdateRootConfig{} }
+func (m *MsgUpdateRootConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRootConfig) ProtoMessage()    {}
+func (*MsgUpdateRootConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{4}
+}
+func (m *MsgUpdateRootConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRootConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRootConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateRootConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRootConfig.Merge(m, src)
+}
+func (m *MsgUpdateRootConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRootConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRootConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRootConfig proto.InternalMessageInfo
+
+func (m *MsgUpdateRootConfig) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUpdateRootConfig) GetConfig() RootConfig {
+	if m != nil {
+		return m.Config
+	}
+	return RootConfig{}
+}
+
+// MsgUpdateRootConfigResponse indicates the successful execution of
+// MsgUpdateRootConfig.
+type MsgUpdateRootConfigResponse struct {
+	// Queued: Whether the update was queued behind the timelock.
+	Queued bool `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`
+	// PendingRootActionId: Id of the pending root action if queued.
+	PendingRootActionId uint64 `protobuf:"varint,2,opt,name=pending_root_action_id,json=pendingRootActionId,proto3" json:"pending_root_action_id,omitempty"`
+}
+
+func (m *MsgUpdateRootConfigResponse) Reset()         { *m = MsgUpdateRootConfigResponse{} }
+func (m *MsgUpdateRootConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRootConfigResponse) ProtoMessage()    {}
+func (*MsgUpdateRootConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{5}
+}
+func (m *MsgUpdateRootConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRootConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRootConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateRootConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRootConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdateRootConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRootConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRootConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRootConfigResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateRootConfigResponse) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
+}
+
+func (m *MsgUpdateRootConfigResponse) GetPendingRootActionId() uint64 {
+	if m != nil {
+		return m.PendingRootActionId
+	}
+	return 0
+}
+
+// MsgApproveRootAction: Msg to approve a pending root action.
+type MsgApproveRootAction struct {
+	// Sender: Address of the approving signer.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ActionId: Id of the pending root action.
+	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+}
+
+func (m *MsgApproveRootAction) Reset()         { *m = MsgApproveRootAction{} }
+func (m *MsgApproveRootAction) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveRootAction) ProtoMessage()    {}
+func (*MsgApproveRootAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{6}
+}
+func (m *MsgApproveRootAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveRootAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveRootAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveRootAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveRootAction.Merge(m, src)
+}
+func (m *MsgApproveRootAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveRootAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveRootAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveRootAction proto.InternalMessageInfo
+
+func (m *MsgApproveRootAction) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgApproveRootAction) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
+	}
+	return 0
+}
+
+// MsgApproveRootActionResponse indicates the successful execution of
+// MsgApproveRootAction.
+type MsgApproveRootActionResponse struct {
+	// Approvals: Number of signers that approved the action.
+	Approvals uint32 `protobuf:"varint,1,opt,name=approvals,proto3" json:"approvals,omitempty"`
+}
+
+func (m *MsgApproveRootActionResponse) Reset()         { *m = MsgApproveRootActionResponse{} }
+func (m *MsgApproveRootActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveRootActionResponse) ProtoMessage()    {}
+func (*MsgApproveRootActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{7}
+}
+func (m *MsgApproveRootActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveRootActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveRootActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveRootActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveRootActionResponse.Merge(m, src)
+}
+func (m *MsgApproveRootActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveRootActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveRootActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveRootActionResponse proto.InternalMessageInfo
+
+func (m *MsgApproveRootActionResponse) GetApprovals() uint32 {
+	if m != nil {
+		return m.Approvals
+	}
+	return 0
+}
+
+// MsgCancelRootAction: Msg to cancel a pending root action.
+type MsgCancelRootAction struct {
+	// Sender: Address of the guardian or root.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ActionId: Id of the pending root action.
+	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+}
+
+func (m *MsgCancelRootAction) Reset()         { *m = MsgCancelRootAction{} }
+func (m *MsgCancelRootAction) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRootAction) ProtoMessage()    {}
+func (*MsgCancelRootAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{8}
+}
+func (m *MsgCancelRootAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRootAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRootAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRootAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRootAction.Merge(m, src)
+}
+func (m *MsgCancelRootAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRootAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRootAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRootAction proto.InternalMessageInfo
+
+func (m *MsgCancelRootAction) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgCancelRootAction) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
+	}
+	return 0
+}
+
+// MsgCancelRootActionResponse indicates the successful execution of
+// MsgCancelRootAction.
+type MsgCancelRootActionResponse struct {
+}
+
+func (m *MsgCancelRootActionResponse) Reset()         { *m = MsgCancelRootActionResponse{} }
+func (m *MsgCancelRootActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRootActionResponse) ProtoMessage()    {}
+func (*MsgCancelRootActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a610e3c1609cdcbc, []int{9}
+}
+func (m *MsgCancelRootActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRootActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRootActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRootActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRootActionResponse.Merge(m, src)
+}
+func (m *MsgCancelRootActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRootActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRootActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRootActionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEditSudoers)(nil), "nibiru.sudo.v1.MsgEditSudoers")
 	proto.RegisterType((*MsgEditSudoersResponse)(nil), "nibiru.sudo.v1.MsgEditSudoersResponse")
 	proto.RegisterType((*MsgChangeRoot)(nil), "nibiru.sudo.v1.MsgChangeRoot")
 	proto.RegisterType((*MsgChangeRootResponse)(nil), "nibiru.sudo.v1.MsgChangeRootResponse")
+	proto.RegisterType((*MsgUpdateRootConfig)(nil), "nibiru.sudo.v1.MsgUpdateRootConfig")
+	proto.RegisterType((*MsgUpdateRootConfigResponse)(nil), "nibiru.sudo.v1.MsgUpdateRootConfigResponse")
+	proto.RegisterType((*MsgApproveRootAction)(nil), "nibiru.sudo.v1.MsgApproveRootAction")
+	proto.RegisterType((*MsgApproveRootActionResponse)(nil), "nibiru.sudo.v1.MsgApproveRootActionResponse")
+	proto.RegisterType((*MsgCancelRootAction)(nil), "nibiru.sudo.v1.MsgCancelRootAction")
+	proto.RegisterType((*MsgCancelRootActionResponse)(nil), "nibiru.sudo.v1.MsgCancelRootActionResponse")
 }
 
 func init() { proto.RegisterFile("nibiru/sudo/v1/tx.proto", fileDescriptor_a610e3c1609cdcbc) }
 
 var fileDescriptor_a610e3c1609cdcbc = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0x4e, 0xea, 0x40,
-	0x18, 0x86, 0x29, 0x24, 0x9c, 0xc3, 0x9c, 0x1c, 0x16, 0x8d, 0x42, 0xa9, 0xd8, 0x60, 0x13, 0x0d,
-	0x71, 0xd1, 0x09, 0x7a, 0x07, 0xa0, 0x4b, 0x5c, 0xd4, 0x9d, 0x0b, 0xc9, 0xd0, 0x4e, 0x86, 0x49,
-	0x74, 0xbe, 0xa6, 0x33, 0x05, 0xdc, 0x7a, 0x05, 0x26, 0xde, 0x94, 0x4b, 0x12, 0x37, 0x2e, 0x0d,
-	0x78, 0x0b, 0xee, 0x4d, 0xa7, 0xfc, 0xb4, 0x89, 0x61, 0xd7, 0xe9, 0xf3, 0xbd, 0xcf, 0x3b, 0x33,
-	0x2d, 0x6a, 0x0a, 0x3e, 0xe6, 0x71, 0x82, 0x65, 0x12, 0x02, 0x9e, 0xf6, 0xb0, 0x9a, 0x7b, 0x51,
-	0x0c, 0x0a, 0xcc, 0x7a, 0x06, 0xbc, 0x14, 0x78, 0xd3, 0x9e, 0x7d, 0xc0, 0x80, 0x81, 0x46, 0x38,
-	0x7d, 0xca, 0xa6, 0xec, 0x36, 0x03, 0x60, 0x0f, 0x14, 0x93, 0x88, 0x63, 0x22, 0x04, 0x28, 0xa2,
-	0x38, 0x08, 0x99, 0x51, 0xf7, 0x1e, 0xd5, 0x87, 0x92, 0x5d, 0x87, 0x5c, 0xdd, 0x26, 0x21, 0xd0,
-	0x58, 0x9a, 0x0d, 0x54, 0x25, 0x41, 0x3a, 0x62, 0x19, 0x1d, 0xa3, 0x5b, 0xf3, 0xd7, 0x2b, 0xb3,
-	0x8d, 0x6a, 0x01, 0x08, 0x15, 0x93, 0x40, 0x49, 0xab, 0xdc, 0xa9, 0x74, 0x6b, 0xfe, 0xee, 0x45,
-	0x9a, 0x92, 0x54, 0x84, 0x34, 0xb6, 0x2a, 0x59, 0x2a, 0x5b, 0xb9, 0x16, 0x6a, 0x14, 0xfd, 0x3e,
-	0x95, 0x11, 0x08, 0x49, 0xdd, 0x3e, 0xfa, 0x3f, 0x94, 0x6c, 0x30, 0x21, 0x82, 0x51, 0x1f, 0x40,
-	0xe5, 0x14, 0x46, 0x5e, 0x61, 0xb6, 0xd0, 0x5f, 0x41, 0x67, 0xa3, 0x18, 0x40, 0x59, 0x65, 0x4d,
-	0xfe, 0x08, 0x3a, 0x4b, 0x23, 0x6e, 0x13, 0x1d, 0x16, 0x1c, 0x1b, 0xf9, 0xc5, 0xb7, 0x81, 0x2a,
-	0x43, 0xc9, 0xcc, 0x39, 0xfa, 0x97, 0x3f, 0x9b, 0xe3, 0x15, 0xaf, 0xcc, 0x2b, 0xee, 0xcd, 0x3e,
-	0xdb, 0xcf, 0xb7, 0x7b, 0x3f, 0x79, 0x7e, 0xff, 0x7a, 0x2d, 0x1f, 0xb9, 0x2d, 0x9c, 0xff, 0x36,
-	0x34, 0xe4, 0x6a, 0x24, 0xd7, 0x55, 0x0a, 0xa1, 0xdc, 0xd9, 0x8e, 0x7f, 0x11, 0xef, 0xb0, 0x7d,
-	0xba, 0x17, 0x6f, 0x6b, 0x3b, 0xba, 0xd6, 0x76, 0xad, 0x42, 0x6d, 0xa0, 0x07, 0xf5, 0xfd, 0xf4,
-	0xaf, 0xde, 0x96, 0x8e, 0xb1, 0x58, 0x3a, 0xc6, 0xe7, 0xd2, 0x31, 0x5e, 0x56, 0x4e, 0x69, 0xb1,
-	0x72, 0x4a, 0x1f, 0x2b, 0xa7, 0x74, 0x77, 0xce, 0xb8, 0x9a, 0x24, 0x63, 0x2f, 0x80, 0x47, 0x7c,
-	0xa3, 0xd3, 0x83, 0x09, 0xe1, 0x62, 0x63, 0x9a, 0x67, 0x2e, 0xf5, 0x14, 0x51, 0x39, 0xae, 0xea,
-	0x7f, 0xe3, 0xf2, 0x27, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x5c, 0x76, 0xe7, 0x7a, 0x02, 0x00, 0x00,
+	// 648 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0x52, 0x44, 0xfa, 0x08, 0x44, 0x17, 0xc4, 0xb2, 0x2d, 0x4b, 0x19, 0x7f, 0x35, 0x62,
+	0xba, 0x01, 0x2e, 0x1e, 0xbc, 0xd0, 0xea, 0x41, 0x4d, 0x8d, 0x59, 0xe3, 0xc5, 0x4b, 0x33, 0xec,
+	0x8e, 0xc3, 0x18, 0x98, 0x59, 0x77, 0x66, 0x01, 0xaf, 0xde, 0x4d, 0x34, 0xfe, 0x07, 0xde, 0xfc,
+	0x4f, 0x38, 0x92, 0x78, 0xf1, 0x64, 0x0c, 0xf8, 0x87, 0x98, 0x9d, 0xdd, 0xb6, 0xbb, 0xed, 0x82,
+	0x24, 0x86, 0x5b, 0x67, 0xbe, 0xef, 0x7d, 0xef, 0x7b, 0xaf, 0xdf, 0xb4, 0x70, 0x93, 0xb3, 0x6d,
+	0x16, 0x46, 0x8e, 0x8c, 0x7c, 0xe1, 0xec, 0xaf, 0x3b, 0xea, 0xb0, 0x15, 0x84, 0x42, 0x09, 0x73,
+	0x2e, 0x01, 0x5a, 0x31, 0xd0, 0xda, 0x5f, 0xb7, 0x16, 0xa8, 0xa0, 0x42, 0x43, 0x4e, 0xfc, 0x29,
+	0x61, 0x59, 0x75, 0x2a, 0x04, 0xdd, 0x25, 0x0e, 0x0e, 0x98, 0x83, 0x39, 0x17, 0x0a, 0x2b, 0x26,
+	0xb8, 0x4c, 0x51, 0x6b, 0x44, 0x5c, 0x2a, 0xac, 0x48, 0x82, 0xa1, 0x6f, 0x06, 0xcc, 0x75, 0x25,
+	0x7d, 0xe2, 0x33, 0xf5, 0x2a, 0xf2, 0x05, 0x09, 0xa5, 0xb9, 0x08, 0x53, 0xd8, 0x8b, 0xeb, 0xab,
+	0x46, 0xc3, 0x68, 0x56, 0xdc, 0xf4, 0x64, 0xd6, 0xa1, 0xe2, 0x09, 0xae, 0x42, 0xec, 0x29, 0x59,
+	0x9d, 0x68, 0x94, 0x9b, 0x15, 0x77, 0x78, 0x11, 0x57, 0x49, 0xc2, 0x7d, 0x12, 0x56, 0xcb, 0x49,
+	0x55, 0x72, 0x32, 0xdb, 0x30, 0x13, 0x90, 0x70, 0x8f, 0x49, 0x19, 0x3b, 0xaa, 0x4e, 0x36, 0xca,
+	0xcd, 0x99, 0x0d, 0xab, 0x95, 0x1f, 0xab, 0xf5, 0x72, 0x40, 0x69, 0x4f, 0x1e, 0xfd, 0x5a, 0x29,
+	0xb9, 0xd9, 0x22, 0x44, 0x60, 0x31, 0xef, 0xd1, 0x25, 0x32, 0x10, 0x5c, 0x92, 0xb8, 0xeb, 0xfb,
+	0x88, 0x44, 0xc4, 0xd7, 0x5e, 0xa7, 0xdd, 0xf4, 0x64, 0x6e, 0xc2, 0x62, 0x40, 0xb8, 0xcf, 0x38,
+	0xed, 0x85, 0x42, 0xa8, 0x5e, 0x32, 0x42, 0x8f, 0xf9, 0xd5, 0x89, 0x86, 0xd1, 0x9c, 0x74, 0xe7,
+	0x53, 0xd4, 0x15, 0x42, 0x6d, 0x69, 0xec, 0xa9, 0x8f, 0xda, 0x30, 0xdb, 0x95, 0xb4, 0xb3, 0x83,
+	0x39, 0x25, 0x31, 0x90, 0x99, 0xc9, 0xc8, 0xcd, 0xb4, 0x04, 0xd3, 0x9c, 0x1c, 0x68, 0x65, 0xad,
+	0x57, 0x71, 0xaf, 0x72, 0x72, 0x10, 0x97, 0x20, 0x1f, 0x6e, 0xe4, 0x34, 0x2e, 0xc7, 0x29, 0x85,
+	0xf9, 0xae, 0xa4, 0xaf, 0x03, 0x1f, 0x2b, 0xdd, 0xa5, 0x23, 0xf8, 0x5b, 0x46, 0xcf, 0xf4, 0xfb,
+	0x10, 0xa6, 0x3c, 0xcd, 0xd0, 0x9a, 0x05, 0xeb, 0x1f, 0x6a, 0xa4, 0xeb, 0x4f, 0xf9, 0xe8, 0x1d,
+	0xd4, 0x0a, 0x1a, 0x5d, 0xce, 0x50, 0xcf, 0x61, 0xa1, 0x2b, 0xe9, 0x56, 0x10, 0x84, 0x62, 0x9f,
+	0x0c, 0x91, 0x33, 0xa7, 0xaa, 0x41, 0x65, 0x54, 0x77, 0x1a, 0xf7, 0xc5, 0x1e, 0x41, 0xbd, 0x48,
+	0x6c, 0xe0, 0xbc, 0x0e, 0x15, 0xac, 0x41, 0xbc, 0x2b, 0xb5, 0xee, 0xac, 0x3b, 0xbc, 0x40, 0xcf,
+	0xf4, 0x7e, 0x3b, 0x98, 0x7b, 0x64, 0xf7, 0x7f, 0x9d, 0x2c, 0x43, 0xad, 0x40, 0xab, 0x6f, 0x64,
+	0xe3, 0xfb, 0x15, 0x28, 0x77, 0x25, 0x35, 0x0f, 0x61, 0x26, 0xfb, 0x08, 0xed, 0xd1, 0xaf, 0x28,
+	0xff, 0x00, 0xac, 0xbb, 0xe7, 0xe3, 0x7d, 0x79, 0xb4, 0xfa, 0xf1, 0xc7, 0x9f, 0xaf, 0x13, 0x35,
+	0xb4, 0xe4, 0x64, 0x7f, 0x04, 0x88, 0xcf, 0x54, 0x4f, 0xa6, 0xad, 0x14, 0x40, 0x26, 0xf3, 0xcb,
+	0x05, 0xc2, 0x43, 0xd8, 0xba, 0x73, 0x2e, 0x3c, 0x68, 0xdb, 0xd0, 0x6d, 0x2d, 0x54, 0xcd, 0xb5,
+	0xf5, 0x34, 0x51, 0x47, 0xc2, 0xfc, 0x64, 0xc0, 0xb5, 0xb1, 0x00, 0xdf, 0x2a, 0x50, 0x1f, 0x25,
+	0x59, 0x6b, 0x17, 0x20, 0x0d, 0x8c, 0xdc, 0xd3, 0x46, 0x56, 0xd1, 0x4a, 0xce, 0x48, 0xa4, 0xe9,
+	0x49, 0x36, 0x93, 0xa4, 0x9b, 0x5f, 0x0c, 0xb8, 0x3e, 0x9e, 0xbd, 0xdb, 0x05, 0xbd, 0xc6, 0x58,
+	0xd6, 0x83, 0x8b, 0xb0, 0x06, 0x96, 0x9a, 0xda, 0x12, 0x42, 0x8d, 0x9c, 0xa5, 0x24, 0x7c, 0x24,
+	0xfb, 0x5e, 0xf4, 0x8e, 0xc6, 0x42, 0x58, 0xb4, 0xa3, 0x51, 0x92, 0xb5, 0x76, 0x01, 0xd2, 0x3f,
+	0x76, 0xe4, 0x69, 0x7a, 0xd6, 0x4f, 0xfb, 0xf1, 0xd1, 0x89, 0x6d, 0x1c, 0x9f, 0xd8, 0xc6, 0xef,
+	0x13, 0xdb, 0xf8, 0x7c, 0x6a, 0x97, 0x8e, 0x4f, 0xed, 0xd2, 0xcf, 0x53, 0xbb, 0xf4, 0xe6, 0x3e,
+	0x65, 0x6a, 0x27, 0xda, 0x6e, 0x79, 0x62, 0xcf, 0x79, 0xa1, 0x45, 0x3a, 0x3b, 0x98, 0xf1, 0xbe,
+	0xe0, 0x61, 0x22, 0xa9, 0x3e, 0x04, 0x44, 0x6e, 0x4f, 0xe9, 0x7f, 0x9e, 0xcd, 0xbf, 0x03, 0x00,
+	0x03, 0x24, 0xe2, 0x45, 0xf4, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -275,6 +652,13 @@ type MsgClient interface {
 	// EditSudoers updates the "Sudoers" state
 	EditSudoers(ctx context.Context, in *MsgEditSudoers, opts ...grpc.CallOption) (*MsgEditSudoersResponse, error)
 	ChangeRoot(ctx context.Context, in *MsgChangeRoot, opts ...grpc.CallOption) (*MsgChangeRootResponse, error)
+	// UpdateRootConfig updates the timelock and the approvals required by the
+	// root actions.
+	UpdateRootConfig(ctx context.Context, in *MsgUpdateRootConfig, opts ...grpc.CallOption) (*MsgUpdateRootConfigResponse, error)
+	// ApproveRootAction approves a pending root action as one of the signers.
+	ApproveRootAction(ctx context.Context, in *MsgApproveRootAction, opts ...grpc.CallOption) (*MsgApproveRootActionResponse, error)
+	// CancelRootAction cancels a pending root action as the guardian or root.
+	CancelRootAction(ctx context.Context, in *MsgCancelRootAction, opts ...grpc.CallOption) (*MsgCancelRootActionResponse, error)
 }
 
 type msgClient struct {
@@ -303,11 +687,45 @@ func (c *msgClient) ChangeRoot(ctx context.Context, in *MsgChangeRoot, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) UpdateRootConfig(ctx context.Context, in *MsgUpdateRootConfig, opts ...grpc.CallOption) (*MsgUpdateRootConfigResponse, error) {
+	out := new(MsgUpdateRootConfigResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/UpdateRootConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveRootAction(ctx context.Context, in *MsgApproveRootAction, opts ...grpc.CallOption) (*MsgApproveRootActionResponse, error) {
+	out := new(MsgApproveRootActionResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/ApproveRootAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRootAction(ctx context.Context, in *MsgCancelRootAction, opts ...grpc.CallOption) (*MsgCancelRootActionResponse, error) {
+	out := new(MsgCancelRootActionResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.sudo.v1.Msg/CancelRootAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EditSudoers updates the "Sudoers" state
 	EditSudoers(context.Context, *MsgEditSudoers) (*MsgEditSudoersResponse, error)
 	ChangeRoot(context.Context, *MsgChangeRoot) (*MsgChangeRootResponse, error)
+	// UpdateRootConfig updates the timelock and the approvals required by the
+	// root actions.
+	UpdateRootConfig(context.Context, *MsgUpdateRootConfig) (*MsgUpdateRootConfigResponse, error)
+	// ApproveRootAction approves a pending root action as one of the signers.
+	ApproveRootAction(context.Context, *MsgApproveRootAction) (*MsgApproveRootActionResponse, error)
+	// CancelRootAction cancels a pending root action as the guardian or root.
+	CancelRootAction(context.Context, *MsgCancelRootAction) (*MsgCancelRootActionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -320,6 +738,15 @@ func (*UnimplementedMsgServer) EditSudoers(ctx context.Context, req *MsgEditSudo
 func (*UnimplementedMsgServer) ChangeRoot(ctx context.Context, req *MsgChangeRoot) (*MsgChangeRootResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeRoot not implemented")
 }
+func (*UnimplementedMsgServer) UpdateRootConfig(ctx context.Context, req *MsgUpdateRootConfig) (*MsgUpdateRootConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRootConfig not implemented")
+}
+func (*UnimplementedMsgServer) ApproveRootAction(ctx context.Context, req *MsgApproveRootAction) (*MsgApproveRootActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRootAction not implemented")
+}
+func (*UnimplementedMsgServer) CancelRootAction(ctx context.Context, req *MsgCancelRootAction) (*MsgCancelRootActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRootAction not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -361,6 +788,60 @@ func _Msg_ChangeRoot_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateRootConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRootConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRootConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/UpdateRootConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRootConfig(ctx, req.(*MsgUpdateRootConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveRootAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveRootAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveRootAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/ApproveRootAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveRootAction(ctx, req.(*MsgApproveRootAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRootAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRootAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRootAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.sudo.v1.Msg/CancelRootAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRootAction(ctx, req.(*MsgCancelRootAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "nibiru.sudo.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -373,9 +854,21 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeRoot",
 			Handler:    _Msg_ChangeRoot_Handler,
 		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "nibiru/sudo/v1/tx.proto",
+		{
+			MethodName: "UpdateRootConfig",
+			Handler:    _Msg_UpdateRootConfig_Handler,
+		},
+		{
+			MethodName: "ApproveRootAction",
+			Handler:    _Msg_ApproveRootAction_Handler,
+		},
+		{
+			MethodName: "CancelRootAction",
+			Handler:    _Msg_CancelRootAction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nibiru/sudo/v1/tx.proto",
 }
 
 func (m *MsgEditSudoers) Marshal() (dAtA []byte, err error) {
@@ -398,6 +891,20 @@ func (m *MsgEditSudoers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Permissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -444,6 +951,21 @@ func (m *MsgEditSudoersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.PendingRootActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PendingRootActionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Queued {
+		i--
+		if m.Queued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -500,6 +1022,220 @@ func (m *MsgChangeRootResponse) MarshalTo(dAtA []byte) (int, error) {
 }
 
 func (m *MsgChangeRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingRootActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PendingRootActionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Queued {
+		i--
+		if m.Queued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRootConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRootConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRootConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRootConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRootConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRootConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingRootActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PendingRootActionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Queued {
+		i--
+		if m.Queued {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveRootAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveRootAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveRootAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveRootActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveRootActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveRootActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Approvals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Approvals))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRootAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRootAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRootAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRootActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRootActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRootActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -534,55 +1270,628 @@ func (m *MsgEditSudoers) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgEditSudoersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgChangeRoot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewRoot)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, e := range m.Permissions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgEditSudoersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queued {
+		n += 2
+	}
+	if m.PendingRootActionId != 0 {
+		n += 1 + sovTx(uint64(m.PendingRootActionId))
+	}
+	return n
+}
+
+func (m *MsgChangeRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queued {
+		n += 2
+	}
+	if m.PendingRootActionId != 0 {
+		n += 1 + sovTx(uint64(m.PendingRootActionId))
+	}
+	return n
+}
+
+func (m *MsgUpdateRootConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateRootConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queued {
+		n += 2
+	}
+	if m.PendingRootActionId != 0 {
+		n += 1 + sovTx(uint64(m.PendingRootActionId))
+	}
+	return n
+}
+
+func (m *MsgApproveRootAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	return n
+}
+
+func (m *MsgApproveRootActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Approvals != 0 {
+		n += 1 + sovTx(uint64(m.Approvals))
+	}
+	return n
+}
+
+func (m *MsgCancelRootAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	return n
+}
+
+func (m *MsgCancelRootActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditSudoers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditSudoers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, Permission{})
+			if err := m.Permissions[len(m.Permissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditSudoersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditSudoersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queued = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRootActionId", wireType)
+			}
+			m.PendingRootActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingRootActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queued = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRootActionId", wireType)
+			}
+			m.PendingRootActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingRootActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgChangeRootResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRootConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -605,15 +1914,15 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditSudoers: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRootConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditSudoers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRootConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -641,13 +1950,13 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -657,25 +1966,165 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contracts = append(m.Contracts, string(dAtA[iNdEx:postIndex]))
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRootConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRootConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRootConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Queued = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRootActionId", wireType)
+			}
+			m.PendingRootActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingRootActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveRootAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveRootAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveRootAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -707,6 +2156,25 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			m.ActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -728,7 +2196,7 @@ func (m *MsgEditSudoers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveRootActionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -751,12 +2219,31 @@ func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditSudoersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveRootActionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditSudoersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveRootActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			m.Approvals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Approvals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -778,7 +2265,7 @@ func (m *MsgEditSudoersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRootAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -801,10 +2288,10 @@ func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeRoot: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRootAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRootAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -840,10 +2327,10 @@ func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRoot", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
 			}
-			var stringLen uint64
+			m.ActionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -853,24 +2340,11 @@ func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ActionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewRoot = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -892,7 +2366,7 @@ func (m *MsgChangeRoot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeRootResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRootActionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -915,10 +2389,10 @@ func (m *MsgChangeRootResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeRootResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRootActionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRootActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
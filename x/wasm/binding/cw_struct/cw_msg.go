@@ -88,6 +88,22 @@ type EditOracleParams struct {
 	TwapLookbackWindow *sdkmath.Int `json:"twap_lookback_window,omitempty"`
 	MinVoters          *sdkmath.Int `json:"min_voters,omitempty"`
 	ValidatorFeeRatio  *sdk.Dec     `json:"validator_fee_ratio,omitempty"`
+	// AggregationMethods replaces the aggregation method overrides of the pairs
+	AggregationMethods []PairAggregationMethod `json:"aggregation_methods,omitempty"`
+	EmaSpan            *sdkmath.Int            `json:"ema_span,omitempty"`
+	// PairEmaSpans replaces the ema span overrides of the pairs
+	PairEmaSpans []PairEmaSpan `json:"pair_ema_spans,omitempty"`
+}
+
+type PairAggregationMethod struct {
+	Pair string `json:"pair"`
+	// Method is the name of the aggregation method, e.g. "TWAP_MEDIAN"
+	Method string `json:"method"`
+}
+
+type PairEmaSpan struct {
+	Pair string      `json:"pair"`
+	Span sdkmath.Int `json:"span"`
 }
 
 type InsuranceFundWithdraw struct {
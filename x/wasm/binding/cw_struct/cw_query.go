@@ -38,6 +38,7 @@ type BindingQuery struct {
 	ModuleAccounts  *ModuleAccountsRequest  `json:"module_accounts,omitempty"`
 	PerpParams      *PerpParamsRequest      `json:"module_params,omitempty"`
 	OraclePrices    *OraclePrices           `json:"oracle_prices,omitempty"`
+	SpotTwap        *SpotTwapRequest        `json:"spot_twap,omitempty"`
 }
 
 type ReservesRequest struct {
@@ -222,6 +223,28 @@ type PerpParams struct {
 	WhitelistedLiquidators  []string          `json:"whitelisted_liquidators"`
 }
 
-type OraclePrices struct{}
+type OraclePrices struct {
+	// Ema selects the exponential moving average prices instead of the latest
+	// prices.
+	Ema bool `json:"ema,omitempty"`
+}
 
 type OraclePricesResponse = map[string]sdk.Dec
+
+type SpotTwapRequest struct {
+	PoolId     uint64 `json:"pool_id"`
+	BaseDenom  string `json:"base_denom"`
+	QuoteDenom string `json:"quote_denom"`
+	// StartTimeMs is the start of the time window in milliseconds.
+	StartTimeMs int64 `json:"start_time_ms"`
+	// EndTimeMs is the end of the time window in milliseconds, the current
+	// block time if zero.
+	EndTimeMs int64 `json:"end_time_ms,omitempty"`
+}
+
+type SpotTwapResponse struct {
+	PoolId     uint64  `json:"pool_id"`
+	BaseDenom  string  `json:"base_denom"`
+	QuoteDenom string  `json:"quote_denom"`
+	Twap       sdk.Dec `json:"twap"`
+}
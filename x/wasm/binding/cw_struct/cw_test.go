@@ -48,6 +48,7 @@ func (s *TestSuiteBindingJsonTypes) TestQueries() {
 		"metrics":          new(cw_struct.MetricsResponse),
 		"module_accounts":  new(cw_struct.ModuleAccountsResponse),
 		"oracle_prices":    new(cw_struct.OraclePricesResponse),
+		"spot_twap":        new(cw_struct.SpotTwapResponse),
 	}
 
 	for name, cwRespPtr := range testCaseMap {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/NibiruChain/nibiru/x/sudo/keeper"
+	sudotypes "github.com/NibiruChain/nibiru/x/sudo/types"
 
 	sdkerrors "cosmossdk.io/errors"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -82,51 +83,69 @@ func (messenger *CustomWasmExecutor) DispatchMsg(
 
 		// Perp module | shifter
 		case contractExecuteMsg.ExecuteMsg.PegShift != nil:
-			if err := messenger.Sudo.CheckPermissions(contractAddr, ctx); err != nil {
+			if err := messenger.Sudo.CheckActionPermission(
+				ctx, contractAddr, sudotypes.PegShift, contractExecuteMsg.ExecuteMsg.PegShift.Pair,
+			); err != nil {
 				return events, data, err
 			}
 			cwMsg := contractExecuteMsg.ExecuteMsg.PegShift
 			err = messenger.Perp.PegShift(cwMsg, contractAddr, ctx)
+			messenger.Sudo.RecordExecution(ctx, contractAddr, sudotypes.PegShift, wasmMsg.Custom, err)
 			return events, data, err
 		case contractExecuteMsg.ExecuteMsg.DepthShift != nil:
-			if err := messenger.Sudo.CheckPermissions(contractAddr, ctx); err != nil {
+			if err := messenger.Sudo.CheckActionPermission(
+				ctx, contractAddr, sudotypes.DepthShift, contractExecuteMsg.ExecuteMsg.DepthShift.Pair,
+			); err != nil {
 				return events, data, err
 			}
 			cwMsg := contractExecuteMsg.ExecuteMsg.DepthShift
 			err = messenger.Perp.DepthShift(cwMsg, ctx)
+			messenger.Sudo.RecordExecution(ctx, contractAddr, sudotypes.DepthShift, wasmMsg.Custom, err)
 			return events, data, err
 
 		// Perp module | controller
 		case contractExecuteMsg.ExecuteMsg.CreateMarket != nil:
-			if err := messenger.Sudo.CheckPermissions(contractAddr, ctx); err != nil {
+			if err := messenger.Sudo.CheckActionPermission(
+				ctx, contractAddr, sudotypes.CreateMarket, contractExecuteMsg.ExecuteMsg.CreateMarket.Pair,
+			); err != nil {
 				return events, data, err
 			}
 			cwMsg := contractExecuteMsg.ExecuteMsg.CreateMarket
 			err = messenger.Perp.CreateMarket(cwMsg, ctx)
+			messenger.Sudo.RecordExecution(ctx, contractAddr, sudotypes.CreateMarket, wasmMsg.Custom, err)
 			return events, data, err
 
 		case contractExecuteMsg.ExecuteMsg.InsuranceFundWithdraw != nil:
-			if err := messenger.Sudo.CheckPermissions(contractAddr, ctx); err != nil {
+			if err := messenger.Sudo.CheckActionPermission(
+				ctx, contractAddr, sudotypes.InsuranceFundWithdraw, "",
+			); err != nil {
 				return events, data, err
 			}
 			cwMsg := contractExecuteMsg.ExecuteMsg.InsuranceFundWithdraw
 			err = messenger.Perp.InsuranceFundWithdraw(cwMsg, ctx)
+			messenger.Sudo.RecordExecution(ctx, contractAddr, sudotypes.InsuranceFundWithdraw, wasmMsg.Custom, err)
 			return events, data, err
 		case contractExecuteMsg.ExecuteMsg.SetMarketEnabled != nil:
-			if err := messenger.Sudo.CheckPermissions(contractAddr, ctx); err != nil {
+			if err := messenger.Sudo.CheckActionPermission(
+				ctx, contractAddr, sudotypes.SetMarketEnabled, contractExecuteMsg.ExecuteMsg.SetMarketEnabled.Pair,
+			); err != nil {
 				return events, data, err
 			}
 			cwMsg := contractExecuteMsg.ExecuteMsg.SetMarketEnabled
 			err = messenger.Perp.SetMarketEnabled(cwMsg, ctx)
+			messenger.Sudo.RecordExecution(ctx, contractAddr, sudotypes.SetMarketEnabled, wasmMsg.Custom, err)
 			return events, data, err
 
 		// Oracle module
 		case contractExecuteMsg.ExecuteMsg.EditOracleParams != nil:
-			if err := messenger.Sudo.CheckPermissions(contractAddr, ctx); err != nil {
+			if err := messenger.Sudo.CheckActionPermission(
+				ctx, contractAddr, sudotypes.EditOracleParams, "",
+			); err != nil {
 				return events, data, err
 			}
 			cwMsg := contractExecuteMsg.ExecuteMsg.EditOracleParams
 			err = messenger.Oracle.SetOracleParams(cwMsg, ctx)
+			messenger.Sudo.RecordExecution(ctx, contractAddr, sudotypes.EditOracleParams, wasmMsg.Custom, err)
 			return events, data, err
 
 		default:
@@ -22,7 +22,10 @@ func (o ExecutorOracle) SetOracleParams(msg *cw_struct.EditOracleParams, ctx sdk
 		return fmt.Errorf("get oracle params error: %s", err.Error())
 	}
 
-	mergedParams := mergeOracleParams(msg, params)
+	mergedParams, err := mergeOracleParams(msg, params)
+	if err != nil {
+		return err
+	}
 
 	o.Oracle.UpdateParams(ctx, mergedParams)
 	return nil
@@ -30,7 +33,7 @@ func (o ExecutorOracle) SetOracleParams(msg *cw_struct.EditOracleParams, ctx sdk
 
 // mergeOracleParams takes the oracle params from the wasm msg and merges them into the existing params
 // keeping any existing values if not set in the wasm msg
-func mergeOracleParams(msg *cw_struct.EditOracleParams, oracleParams oracletypes.Params) oracletypes.Params {
+func mergeOracleParams(msg *cw_struct.EditOracleParams, oracleParams oracletypes.Params) (oracletypes.Params, error) {
 	if msg.VotePeriod != nil {
 		oracleParams.VotePeriod = msg.VotePeriod.Uint64()
 	}
@@ -76,5 +79,37 @@ func mergeOracleParams(msg *cw_struct.EditOracleParams, oracleParams oracletypes
 		oracleParams.ValidatorFeeRatio = *msg.ValidatorFeeRatio
 	}
 
-	return oracleParams
+	if msg.AggregationMethods != nil {
+		aggregationMethods := make([]oracletypes.PairAggregationMethod, len(msg.AggregationMethods))
+		for i, override := range msg.AggregationMethods {
+			method, ok := oracletypes.AggregationMethod_value[override.Method]
+			if !ok {
+				return oracleParams, fmt.Errorf("unknown aggregation method %s for pair %s", override.Method, override.Pair)
+			}
+			aggregationMethods[i] = oracletypes.PairAggregationMethod{
+				Pair:   asset.MustNewPair(override.Pair),
+				Method: oracletypes.AggregationMethod(method),
+			}
+		}
+
+		oracleParams.AggregationMethods = aggregationMethods
+	}
+
+	if msg.EmaSpan != nil {
+		oracleParams.EmaSpan = msg.EmaSpan.Uint64()
+	}
+
+	if msg.PairEmaSpans != nil {
+		pairEmaSpans := make([]oracletypes.PairEmaSpan, len(msg.PairEmaSpans))
+		for i, override := range msg.PairEmaSpans {
+			pairEmaSpans[i] = oracletypes.PairEmaSpan{
+				Pair: asset.MustNewPair(override.Pair),
+				Span: override.Span.Uint64(),
+			}
+		}
+
+		oracleParams.PairEmaSpans = pairEmaSpans
+	}
+
+	return oracleParams, nil
 }
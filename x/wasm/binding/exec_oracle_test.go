@@ -13,6 +13,7 @@ import (
 	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
 	"github.com/NibiruChain/nibiru/x/wasm/binding"
 	"github.com/NibiruChain/nibiru/x/wasm/binding/cw_struct"
 	"github.com/NibiruChain/nibiru/x/wasm/binding/wasmbin"
@@ -230,4 +231,46 @@ func (s *TestSuiteOracleExecutor) TestExecuteOracleParams() {
 	params, err = s.nibiru.OracleKeeper.Params.Get(s.ctx)
 	s.Require().NoError(err)
 	s.Require().Equal(validatorFeeRatio, params.ValidatorFeeRatio)
+
+	// Aggregation Methods
+	params, err = s.nibiru.OracleKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(params.AggregationMethods)
+
+	cwMsg = &cw_struct.EditOracleParams{
+		AggregationMethods: []cw_struct.PairAggregationMethod{
+			{Pair: "ubtc:unusd", Method: "TWAP_MEDIAN"},
+		},
+	}
+
+	err = s.exec.SetOracleParams(cwMsg, s.ctx)
+	s.Require().NoError(err)
+
+	params, err = s.nibiru.OracleKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(oracletypes.AggregationMethod_TWAP_MEDIAN, params.AggregationMethodForPair("ubtc:unusd"))
+
+	cwMsg = &cw_struct.EditOracleParams{
+		AggregationMethods: []cw_struct.PairAggregationMethod{
+			{Pair: "ubtc:unusd", Method: "UNKNOWN"},
+		},
+	}
+	s.Require().ErrorContains(s.exec.SetOracleParams(cwMsg, s.ctx), "unknown aggregation method")
+
+	// Ema Spans
+	emaSpan, pairEmaSpan := sdk.NewInt(20), sdk.NewInt(5)
+	cwMsg = &cw_struct.EditOracleParams{
+		EmaSpan: &emaSpan,
+		PairEmaSpans: []cw_struct.PairEmaSpan{
+			{Pair: "ubtc:unusd", Span: pairEmaSpan},
+		},
+	}
+
+	err = s.exec.SetOracleParams(cwMsg, s.ctx)
+	s.Require().NoError(err)
+
+	params, err = s.nibiru.OracleKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(20), params.EmaSpan)
+	s.Require().Equal(uint64(5), params.EmaSpanForPair("ubtc:unusd"))
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/suite"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -381,6 +382,37 @@ func (s *TestSuiteExecutor) TestPegShift() {
 	contractRespBz, err := s.ExecuteAgainstContract(contract, execMsg)
 	s.NoErrorf(err, "contractRespBz: %s", contractRespBz)
 
+	s.T().Log("The execution is recorded in the audit log")
+	records, _, err := s.keeper.PaginateAuditLog(
+		s.ctx, contract.String(), &query.PageRequest{Limit: 1, Reverse: true})
+	s.NoError(err)
+	s.Len(records, 1)
+	s.Equal(string(sudotypes.PegShift), records[0].Action)
+	s.True(records[0].Success)
+
+	s.T().Log("Executing with a permission on another pair should fail")
+	s.keeper.Permissions.Insert(s.ctx, contract.String(), sudotypes.ContractPermissions{
+		Contract: contract.String(),
+		Permissions: []sudotypes.Permission{{
+			Action: string(sudotypes.PegShift),
+			Pair:   asset.NewPair(denoms.BTC, denoms.NUSD).String(),
+		}},
+	})
+	contractRespBz, err = s.ExecuteAgainstContract(contract, execMsg)
+	s.Errorf(err, "contractRespBz: %s", contractRespBz)
+
+	s.T().Log("Executing with a permission on the pair should succeed")
+	s.keeper.Permissions.Insert(s.ctx, contract.String(), sudotypes.ContractPermissions{
+		Contract: contract.String(),
+		Permissions: []sudotypes.Permission{{
+			Action: string(sudotypes.PegShift),
+			Pair:   pair.String(),
+		}},
+	})
+	contractRespBz, err = s.ExecuteAgainstContract(contract, execMsg)
+	s.NoErrorf(err, "contractRespBz: %s", contractRespBz)
+	s.NoError(s.keeper.Permissions.Delete(s.ctx, contract.String()))
+
 	s.T().Log("Executing without permission should fail")
 	s.keeper.SetSudoContracts(
 		[]string{}, s.ctx,
@@ -14,16 +14,21 @@ import (
 	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
 	perpv2keeper "github.com/NibiruChain/nibiru/x/perp/v2/keeper"
 	perpv2types "github.com/NibiruChain/nibiru/x/perp/v2/types"
+	spotkeeper "github.com/NibiruChain/nibiru/x/spot/keeper"
+	spottypes "github.com/NibiruChain/nibiru/x/spot/types"
 	"github.com/NibiruChain/nibiru/x/wasm/binding/cw_struct"
 )
 
 type QueryPlugin struct {
 	Perp   *PerpQuerier
 	Oracle *OracleQuerier
+	Spot   *SpotQuerier
 }
 
 // NewQueryPlugin returns a pointer to a new QueryPlugin
-func NewQueryPlugin(perp perpv2keeper.Keeper, oracle oraclekeeper.Keeper) QueryPlugin {
+func NewQueryPlugin(
+	perp perpv2keeper.Keeper, oracle oraclekeeper.Keeper, spot spotkeeper.Keeper,
+) QueryPlugin {
 	return QueryPlugin{
 		Perp: &PerpQuerier{
 			perp: perpv2keeper.NewQuerier(perp),
@@ -31,6 +36,9 @@ func NewQueryPlugin(perp perpv2keeper.Keeper, oracle oraclekeeper.Keeper) QueryP
 		Oracle: &OracleQuerier{
 			oracle: oraclekeeper.NewQuerier(oracle),
 		},
+		Spot: &SpotQuerier{
+			spot: spotkeeper.NewQuerier(spot),
+		},
 	}
 }
 
@@ -109,6 +117,11 @@ func CustomQuerier(qp QueryPlugin) func(ctx sdk.Context, request json.RawMessage
 			cwResp, err := qp.Oracle.ExchangeRates(ctx, cwReq)
 			return qp.ToBinary(cwResp, err, cwReq)
 
+		case wasmContractQuery.SpotTwap != nil:
+			cwReq := wasmContractQuery.SpotTwap
+			cwResp, err := qp.Spot.Twap(ctx, cwReq)
+			return qp.ToBinary(cwResp, err, cwReq)
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown nibiru query variant"}
 		}
@@ -320,7 +333,14 @@ func (oracleExt *OracleQuerier) ExchangeRates(
 	ctx sdk.Context, cwReq *cw_struct.OraclePrices,
 ) (*cw_struct.OraclePricesResponse, error) {
 	queryExchangeRatesRequest := oracletypes.QueryExchangeRatesRequest{}
-	queryExchangeRates, err := oracleExt.oracle.ExchangeRates(ctx, &queryExchangeRatesRequest)
+	queryFn := oracleExt.oracle.ExchangeRates
+	if cwReq.Ema {
+		queryFn = oracleExt.oracle.ExchangeRatesEma
+	}
+	queryExchangeRates, err := queryFn(ctx, &queryExchangeRatesRequest)
+	if err != nil {
+		return nil, err
+	}
 
 	// Transform Tuple to Map
 	exchangeRates := make(map[string]sdk.Dec)
@@ -332,3 +352,39 @@ func (oracleExt *OracleQuerier) ExchangeRates(
 	*cwResp = exchangeRates
 	return cwResp, err
 }
+
+// ----------------------------------------------------------------------
+// SpotQuerier
+// ----------------------------------------------------------------------
+
+type SpotQuerier struct {
+	spot spottypes.QueryServer
+}
+
+func (spotExt *SpotQuerier) Twap(
+	ctx sdk.Context, cwReq *cw_struct.SpotTwapRequest,
+) (*cw_struct.SpotTwapResponse, error) {
+	if cwReq == nil {
+		return nil, errors.New("nil request")
+	}
+
+	sdkReq := &spottypes.QueryTwapRequest{
+		PoolId:      cwReq.PoolId,
+		BaseDenom:   cwReq.BaseDenom,
+		QuoteDenom:  cwReq.QuoteDenom,
+		StartTimeMs: cwReq.StartTimeMs,
+		EndTimeMs:   cwReq.EndTimeMs,
+	}
+	goCtx := sdk.WrapSDKContext(ctx)
+	sdkResp, err := spotExt.spot.Twap(goCtx, sdkReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cw_struct.SpotTwapResponse{
+		PoolId:     cwReq.PoolId,
+		BaseDenom:  cwReq.BaseDenom,
+		QuoteDenom: cwReq.QuoteDenom,
+		Twap:       sdkResp.Twap,
+	}, err
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
 	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
 	perpv2types "github.com/NibiruChain/nibiru/x/perp/v2/types"
+	spottypes "github.com/NibiruChain/nibiru/x/spot/types"
 	"github.com/NibiruChain/nibiru/x/wasm/binding"
 	"github.com/NibiruChain/nibiru/x/wasm/binding/cw_struct"
 	"github.com/NibiruChain/nibiru/x/wasm/binding/wasmbin"
@@ -106,6 +107,7 @@ func (s *TestSuitePerpQuerier) SetupSuite() {
 	s.queryPlugin = binding.NewQueryPlugin(
 		nibiru.PerpKeeperV2,
 		nibiru.OracleKeeper,
+		nibiru.SpotKeeper,
 	)
 	s.OnSetupEnd()
 }
@@ -123,6 +125,8 @@ func (s *TestSuitePerpQuerier) OnSetupEnd() {
 // - TestModuleAccounts
 // - TestModuleParams
 // - TestPosition
+// - TestOraclePrices
+// - TestSpotTwap
 // ————————————————————————————————————————————————————————————————————————————
 
 func (s *TestSuitePerpQuerier) TestPremiumFraction() {
@@ -327,3 +331,74 @@ func (s *TestSuitePerpQuerier) DoPositionsTest(
 		s.Assert().EqualValues(resp.Position, pos)
 	}
 }
+
+func (s *TestSuitePerpQuerier) TestOraclePrices() {
+	ctx, _ := s.ctx.CacheContext()
+	pair := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	s.nibiru.OracleKeeper.SetPrice(ctx, pair, sdk.NewDec(1_600))
+
+	cwResp, err := s.queryPlugin.Oracle.ExchangeRates(ctx, &cw_struct.OraclePrices{})
+	s.NoErrorf(err, "\ncwResp: %s", cwResp)
+	s.Assert().EqualValues(sdk.NewDec(1_600).String(), (*cwResp)[pair.String()].String())
+
+	s.T().Log("EMA prices: the span of 15 updates weights the new price by 2/16")
+	cwResp, err = s.queryPlugin.Oracle.ExchangeRates(ctx, &cw_struct.OraclePrices{Ema: true})
+	s.NoErrorf(err, "\ncwResp: %s", cwResp)
+	s.Assert().EqualValues(sdk.NewDec(1_075).String(), (*cwResp)[pair.String()].String())
+}
+
+func (s *TestSuitePerpQuerier) TestSpotTwap() {
+	ctx, _ := s.ctx.CacheContext()
+	spotParams := spottypes.DefaultParams()
+	spotParams.PoolCreationFee = sdk.NewCoins()
+	spotParams.WhitelistedAsset = []string{denoms.NIBI, denoms.NUSD}
+	s.nibiru.SpotKeeper.SetParams(ctx, spotParams)
+
+	sender := testutil.AccAddress()
+	s.NoError(testapp.FundAccount(s.nibiru.BankKeeper, ctx, sender, sdk.NewCoins(
+		sdk.NewInt64Coin(denoms.NIBI, 1_000),
+		sdk.NewInt64Coin(denoms.NUSD, 10_000),
+	)))
+	poolId, err := s.nibiru.SpotKeeper.NewPool(ctx, sender,
+		spottypes.PoolParams{
+			SwapFee:  sdk.ZeroDec(),
+			ExitFee:  sdk.ZeroDec(),
+			PoolType: spottypes.PoolType_BALANCER,
+			A:        sdk.ZeroInt(),
+		},
+		[]spottypes.PoolAsset{
+			{Token: sdk.NewInt64Coin(denoms.NIBI, 1_000), Weight: sdk.OneInt()},
+			{Token: sdk.NewInt64Coin(denoms.NUSD, 10_000), Weight: sdk.OneInt()},
+		})
+	s.NoError(err)
+	pool, err := s.nibiru.SpotKeeper.FetchPool(ctx, poolId)
+	s.NoError(err)
+	// about 10 unusd per unibi
+	spotPrice, err := pool.CalcSpotPrice(denoms.NUSD, denoms.NIBI)
+	s.NoError(err)
+
+	startTimeMs := ctx.BlockTime().UnixMilli()
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+
+	cwReq := &cw_struct.SpotTwapRequest{
+		PoolId:      poolId,
+		BaseDenom:   denoms.NIBI,
+		QuoteDenom:  denoms.NUSD,
+		StartTimeMs: startTimeMs,
+	}
+	cwResp, err := s.queryPlugin.Spot.Twap(ctx, cwReq)
+	s.NoErrorf(err, "\ncwResp: %s", cwResp)
+	s.Assert().EqualValues(spotPrice.String(), cwResp.Twap.String())
+
+	reqBz, err := json.Marshal(cw_struct.BindingQuery{SpotTwap: cwReq})
+	s.NoError(err)
+	respBz, err := binding.CustomQuerier(s.queryPlugin)(ctx, reqBz)
+	s.NoError(err)
+	s.NoError(json.Unmarshal(respBz, cwResp))
+	s.Assert().EqualValues(poolId, cwResp.PoolId)
+	s.Assert().EqualValues(spotPrice.String(), cwResp.Twap.String())
+
+	cwReq.StartTimeMs = startTimeMs - 1
+	_, err = s.queryPlugin.Spot.Twap(ctx, cwReq)
+	s.ErrorIs(err, spottypes.ErrNoValidTwap)
+}
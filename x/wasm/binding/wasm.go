@@ -8,14 +8,16 @@ import (
 
 	oraclekeeper "github.com/NibiruChain/nibiru/x/oracle/keeper"
 	perpv2keeper "github.com/NibiruChain/nibiru/x/perp/v2/keeper"
+	spotkeeper "github.com/NibiruChain/nibiru/x/spot/keeper"
 )
 
 func RegisterWasmOptions(
 	perpv2 perpv2keeper.Keeper,
 	sudoKeeper keeper.Keeper,
 	oracleKeeper oraclekeeper.Keeper,
+	spotKeeper spotkeeper.Keeper,
 ) []wasm.Option {
-	wasmQueryPlugin := NewQueryPlugin(perpv2, oracleKeeper)
+	wasmQueryPlugin := NewQueryPlugin(perpv2, oracleKeeper, spotKeeper)
 	wasmQueryOption := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})